@@ -601,7 +601,7 @@ func initAppModules(keepers *KeeperCache, keys map[string]*store.KVStoreKey, bAp
 			bApp.MsgServiceRouter(),
 			encodingConfig.Codec,
 		),
-		permission.NewAppModule(*GetKeeper[permissionKeeper.Keeper](keepers)),
+		permission.NewAppModule(*GetKeeper[permissionKeeper.Keeper](keepers), bApp.MsgServiceRouter(), encodingConfig.Codec),
 		auxiliary.NewAppModule(encodingConfig.Codec, bApp.MsgServiceRouter()),
 	)
 	return appModules
@@ -644,6 +644,9 @@ func InitCustomAnteDecorators(
 ) []sdk.AnteDecorator {
 	var anteDecorators []sdk.AnteDecorator
 
+	// timelocked messages must be checked before batch messages are unwrapped to tell queued messages apart from direct ones
+	anteDecorators = append(anteDecorators, ante.NewTimelockDecorator(GetKeeper[permissionKeeper.Keeper](keepers)))
+
 	// unwrap batch messages, must be done before any other custom decorators
 	anteDecorators = append(anteDecorators, ante.NewBatchDecorator(encodingConfig.Codec))
 
@@ -690,6 +693,16 @@ func initMessageAnteDecorators(encodingConfig axelarParams.EncodingConfig, keepe
 	)
 }
 
+// messages dispatched by contracts skip the tx ante handler, so timelocks must be enforced on them separately
+func initWasmMessageAnteDecorators(encodingConfig axelarParams.EncodingConfig, keepers *KeeperCache) ante.MessageAnteHandler {
+	timelock := ante.NewMessageTimelockDecorator(GetKeeper[permissionKeeper.Keeper](keepers))
+	messageAnteHandler := initMessageAnteDecorators(encodingConfig, keepers)
+
+	return func(ctx sdk.Context, msgs []sdk.Msg, simulate bool) (sdk.Context, error) {
+		return timelock.AnteHandle(ctx, msgs, simulate, messageAnteHandler)
+	}
+}
+
 func InitModuleAccountPermissions() map[string][]string {
 	return map[string][]string{
 		authtypes.FeeCollectorName:     nil,
@@ -828,9 +841,11 @@ func orderEndBlockers() []string {
 		axelarnetTypes.ModuleName,
 		multisigTypes.ModuleName,
 		evmTypes.ModuleName,
-		nexusTypes.ModuleName,
+		// reward must penalize absent chain maintainers before nexus deregisters them
 		rewardTypes.ModuleName,
+		nexusTypes.ModuleName,
 		voteTypes.ModuleName,
+		permissionTypes.ModuleName,
 	)
 
 	return endBlockerOrder
@@ -186,7 +186,7 @@ func initWasmKeeper(encodingConfig axelarParams.EncodingConfig, keys map[string]
 
 				return WithAnteHandlers(
 					encoders,
-					initMessageAnteDecorators(encodingConfig, keepers),
+					initWasmMessageAnteDecorators(encodingConfig, keepers),
 					// for security reasons we disallow some msg types that can be used for arbitrary calls
 					wasmkeeper.NewMessageHandlerChain(NewMsgTypeBlacklistMessenger(), old, nexusKeeper.NewMessenger(nexusK)))
 			}),
@@ -393,6 +393,7 @@ func (b *batchedBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*s
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	case <-b.backlog.Push(broadcastTask{ctx, msgs, callback}):
+		queueDepth.Set(float64(b.backlog.Len()))
 		ctx = log.Append(ctx, "msg_count", len(msgs))
 		log.FromCtx(ctx).Debug("queuing up messages")
 		break
@@ -411,6 +412,8 @@ func (b *batchedBroadcaster) processBacklog() {
 		// do not batch if there is no backlog pressure to minimize the risk of broadcast errors (and subsequent retries)
 		if b.backlog.Len() < b.batchThreshold {
 			task := b.backlog.Pop()
+			queueDepth.Set(float64(b.backlog.Len()))
+			batchSize.Observe(float64(len(task.Msgs)))
 
 			ctx := log.Append(task.Ctx, "batch_size", len(task.Msgs))
 			log.FromCtx(ctx).Debug("low traffic; no batch merging")
@@ -453,6 +456,9 @@ func (b *batchedBroadcaster) processBacklog() {
 			}
 		}
 
+		queueDepth.Set(float64(b.backlog.Len()))
+		batchSize.Observe(float64(len(msgs)))
+
 		ctx = log.Append(ctx, "batch_size", len(msgs))
 		log.FromCtx(ctx).Debug("high traffic; merging batches")
 
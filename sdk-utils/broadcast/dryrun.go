@@ -0,0 +1,45 @@
+package broadcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DryRunBroadcaster records msgs instead of broadcasting them, so they can be inspected afterwards
+type DryRunBroadcaster struct {
+	lock sync.Mutex
+	msgs []sdk.Msg
+}
+
+// NewDryRunBroadcaster returns a broadcaster that never sends msgs to the blockchain
+func NewDryRunBroadcaster() *DryRunBroadcaster {
+	return &DryRunBroadcaster{}
+}
+
+// Broadcast records the given msgs and returns an empty response
+func (b *DryRunBroadcaster) Broadcast(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no messages to broadcast")
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.msgs = append(b.msgs, msgs...)
+
+	return &sdk.TxResponse{}, nil
+}
+
+// Msgs returns all msgs recorded so far in the order they were broadcast
+func (b *DryRunBroadcaster) Msgs() []sdk.Msg {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	msgs := make([]sdk.Msg, len(b.msgs))
+	copy(msgs, b.msgs)
+
+	return msgs
+}
@@ -0,0 +1,45 @@
+package broadcast_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestDryRunBroadcaster(t *testing.T) {
+	var (
+		broadcaster *broadcast.DryRunBroadcaster
+		msgs        []sdk.Msg
+	)
+
+	Given("a dry-run broadcaster", func() {
+		broadcaster = broadcast.NewDryRunBroadcaster()
+	}).
+		When("msgs are broadcast concurrently", func() {
+			msgs = randomMsgs(20)
+
+			var wg sync.WaitGroup
+			for _, msg := range msgs {
+				wg.Add(1)
+				go func(msg sdk.Msg) {
+					defer wg.Done()
+					_, err := broadcaster.Broadcast(context.Background(), msg)
+					assert.NoError(t, err)
+				}(msg)
+			}
+			wg.Wait()
+		}).
+		Then("all msgs are recorded", func(t *testing.T) {
+			assert.ElementsMatch(t, msgs, broadcaster.Msgs())
+
+			_, err := broadcaster.Broadcast(context.Background())
+			assert.Error(t, err)
+		}).
+		Run(t)
+}
@@ -0,0 +1,32 @@
+package broadcast
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	queueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "queue_depth",
+		Help:      "Number of broadcast requests waiting in the backlog of the batched broadcaster",
+	})
+	batchSize = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "batch_size",
+		Help:      "Number of messages included in a single broadcast by the batched broadcaster",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 9),
+	})
+	retries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "broadcast",
+		Name:      "retries_total",
+		Help:      "Number of broadcast attempts that were retried after an error",
+	})
+)
+
+// Collectors returns the prometheus collectors of the broadcasters
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{queueDepth, batchSize, retries}
+}
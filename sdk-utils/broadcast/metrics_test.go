@@ -0,0 +1,123 @@
+package broadcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestBatched_Metrics(t *testing.T) {
+	var (
+		broadcaster   *mock2.BroadcasterMock
+		batched       broadcast.Broadcaster
+		batchesBefore uint64
+		msgsBefore    float64
+		ctx           context.Context
+		cancel        context.CancelFunc
+	)
+
+	Given("a batched broadcaster", func() {
+		broadcaster = &mock2.BroadcasterMock{}
+		batched = broadcast.Batched(broadcaster, 1, 5, app.MakeEncodingConfig().Codec)
+
+		batchSize := gatherMetric(t, "vald_broadcast_batch_size").GetHistogram()
+		batchesBefore, msgsBefore = batchSize.GetSampleCount(), batchSize.GetSampleSum()
+	}).Branch(
+		When("msgs are broadcast one after another", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		}).
+			Then("record the size of every broadcast", func(t *testing.T) {
+				for i := 0; i < 3; i++ {
+					_, err := batched.Broadcast(context.Background(), randomMsgs(2)...)
+					assert.NoError(t, err)
+				}
+
+				batchSize := gatherMetric(t, "vald_broadcast_batch_size").GetHistogram()
+				assert.Equal(t, batchesBefore+3, batchSize.GetSampleCount())
+				assert.Equal(t, msgsBefore+6, batchSize.GetSampleSum())
+			}),
+
+		When("the broadcast is blocked", func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			broadcastCalled := make(chan struct{})
+			once := &sync.Once{}
+			broadcaster.BroadcastFunc = func(ctx context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+				once.Do(func() { close(broadcastCalled) })
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+
+			go func() { _, _ = batched.Broadcast(ctx, randomMsgs(1)...) }()
+			<-broadcastCalled
+		}).
+			Then("record the requests waiting in the backlog", func(t *testing.T) {
+				defer cancel()
+
+				for i := 0; i < 3; i++ {
+					go func() { _, _ = batched.Broadcast(ctx, randomMsgs(1)...) }()
+				}
+
+				assert.Eventually(t, func() bool {
+					return gatherMetric(t, "vald_broadcast_queue_depth").GetGauge().GetValue() == 3
+				}, time.Second, 10*time.Millisecond)
+			}),
+	).Run(t)
+}
+
+func TestWithRetry_Metrics(t *testing.T) {
+	var (
+		broadcaster   *mock2.BroadcasterMock
+		retry         broadcast.Broadcaster
+		retriesBefore float64
+	)
+
+	Given("a retry broadcaster", func() {
+		broadcaster = &mock2.BroadcasterMock{}
+		retry = broadcast.WithRetry(broadcaster, 3, 1*time.Nanosecond)
+
+		retriesBefore = gatherMetric(t, "vald_broadcast_retries_total").GetCounter().GetValue()
+	}).
+		When("the broadcast keeps failing", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				return nil, errors.New("some error")
+			}
+		}).
+		Then("count every retry", func(t *testing.T) {
+			_, err := retry.Broadcast(context.Background(), randomMsgs(1)...)
+			assert.Error(t, err)
+
+			assert.Equal(t, retriesBefore+3, gatherMetric(t, "vald_broadcast_retries_total").GetCounter().GetValue())
+		}).
+		Run(t)
+}
+
+// gatherMetric returns the unlabeled broadcast metric with the given name
+func gatherMetric(t *testing.T, name string) *dto.Metric {
+	registry := prometheus.NewRegistry()
+	for _, c := range broadcast.Collectors() {
+		assert.NoError(t, registry.Register(c))
+	}
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() == name {
+			return family.GetMetric()[0]
+		}
+	}
+
+	return nil
+}
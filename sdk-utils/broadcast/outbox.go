@@ -0,0 +1,281 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// OutboxEntry is a set of msgs that were handed to the broadcaster together and have not been broadcast yet
+type OutboxEntry struct {
+	ID uint64
+	// Height is the latest block height known to vald when the entry was added
+	Height     int64
+	EnqueuedAt time.Time
+	Msgs       []sdk.Msg
+}
+
+type storedEntry struct {
+	Height     int64             `json:"height"`
+	EnqueuedAt time.Time         `json:"enqueued_at"`
+	Msgs       []json.RawMessage `json:"msgs"`
+}
+
+// Outbox persists msgs that are waiting to be broadcast, so they survive a restart
+type Outbox struct {
+	db  dbm.DB
+	cdc codec.Codec
+	// firstID is the ID of the first entry added since the outbox was opened, all entries before it are left over from a previous run
+	firstID uint64
+	nextID  uint64
+	lock    sync.Mutex
+}
+
+// NewOutbox returns an outbox backed by the given database
+func NewOutbox(db dbm.DB, cdc codec.Codec) (*Outbox, error) {
+	iter, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+
+	var nextID uint64
+	if iter.Valid() {
+		nextID = binary.BigEndian.Uint64(iter.Key()) + 1
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return &Outbox{db: db, cdc: cdc, firstID: nextID, nextID: nextID}, nil
+}
+
+// Add persists the given msgs and returns the ID of the new entry
+func (o *Outbox) Add(height int64, msgs ...sdk.Msg) (uint64, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	id := o.nextID
+	if err := o.write(OutboxEntry{ID: id, Height: height, EnqueuedAt: time.Now().UTC(), Msgs: msgs}); err != nil {
+		return 0, err
+	}
+
+	o.nextID++
+	return id, nil
+}
+
+// Remove deletes the entry with the given ID
+func (o *Outbox) Remove(id uint64) error {
+	return o.db.DeleteSync(outboxKey(id))
+}
+
+// Entries returns all entries in the order they were added
+func (o *Outbox) Entries() ([]OutboxEntry, error) {
+	iter, err := o.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+
+	var entries []OutboxEntry
+	for ; iter.Valid(); iter.Next() {
+		entry, err := o.decode(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, iter.Error()
+}
+
+// Purge deletes the entries with the given IDs, or all entries if no ID is given,
+// and returns how many were deleted. IDs that are not in the outbox are ignored.
+func (o *Outbox) Purge(ids ...uint64) (int, error) {
+	if len(ids) == 0 {
+		entries, err := o.Entries()
+		if err != nil {
+			return 0, err
+		}
+
+		ids = slices.Map(entries, func(entry OutboxEntry) uint64 { return entry.ID })
+	}
+
+	count := 0
+	for _, id := range ids {
+		ok, err := o.db.Has(outboxKey(id))
+		if err != nil {
+			return count, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		if err := o.Remove(id); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// Close closes the underlying database
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+// Replay broadcasts all entries left over from a previous run and blocks until they are processed.
+// Msgs for which isExpired returns true are dropped. Entries are removed from the outbox once the broadcaster returns,
+// so the given broadcaster should not persist them again.
+func (o *Outbox) Replay(ctx context.Context, broadcaster Broadcaster, isExpired func(entry OutboxEntry, msg sdk.Msg) bool) error {
+	entries, err := o.Entries()
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to read outbox")
+	}
+
+	var wg sync.WaitGroup
+	for _, entry := range entries {
+		if entry.ID >= o.firstID {
+			break
+		}
+
+		logger := log.FromCtx(log.AppendKeyVals(ctx, "outbox_id", entry.ID, "height", entry.Height))
+
+		pending := slices.Filter(entry.Msgs, func(msg sdk.Msg) bool { return !isExpired(entry, msg) })
+		if dropped := len(entry.Msgs) - len(pending); dropped > 0 {
+			logger.Infof("dropping %d expired msg(s) from outbox", dropped)
+		}
+
+		if len(pending) == 0 {
+			if err := o.Remove(entry.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		entry.Msgs = pending
+		if err := o.write(entry); err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			logger.Infof("replaying %d msg(s) from outbox", len(entry.Msgs))
+			if _, err := broadcaster.Broadcast(ctx, entry.Msgs...); err != nil {
+				logger.Errorf("failed to replay outbox entry: %s", err.Error())
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err := o.Remove(entry.ID); err != nil {
+				logger.Errorf("failed to remove outbox entry: %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (o *Outbox) write(entry OutboxEntry) error {
+	msgs := make([]json.RawMessage, len(entry.Msgs))
+	for i, msg := range entry.Msgs {
+		bz, err := o.cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode outbox msg")
+		}
+
+		msgs[i] = bz
+	}
+
+	bz, err := json.Marshal(storedEntry{Height: entry.Height, EnqueuedAt: entry.EnqueuedAt, Msgs: msgs})
+	if err != nil {
+		return err
+	}
+
+	return o.db.SetSync(outboxKey(entry.ID), bz)
+}
+
+func (o *Outbox) decode(key, value []byte) (OutboxEntry, error) {
+	if len(key) != 8 {
+		return OutboxEntry{}, fmt.Errorf("invalid outbox key %x", key)
+	}
+
+	var stored storedEntry
+	if err := json.Unmarshal(value, &stored); err != nil {
+		return OutboxEntry{}, errorsmod.Wrapf(err, "failed to decode outbox entry %d", binary.BigEndian.Uint64(key))
+	}
+
+	msgs := make([]sdk.Msg, len(stored.Msgs))
+	for i, bz := range stored.Msgs {
+		if err := o.cdc.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return OutboxEntry{}, errorsmod.Wrapf(err, "failed to decode msgs of outbox entry %d", binary.BigEndian.Uint64(key))
+		}
+	}
+
+	return OutboxEntry{
+		ID:         binary.BigEndian.Uint64(key),
+		Height:     stored.Height,
+		EnqueuedAt: stored.EnqueuedAt,
+		Msgs:       msgs,
+	}, nil
+}
+
+func outboxKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+type outboxBroadcaster struct {
+	broadcaster  Broadcaster
+	outbox       *Outbox
+	latestHeight func() int64
+}
+
+// WithOutbox returns a broadcaster that persists msgs to the outbox until the given broadcaster returns,
+// so they can be replayed if the process stops before they are broadcast
+func WithOutbox(broadcaster Broadcaster, outbox *Outbox, latestHeight func() int64) Broadcaster {
+	return &outboxBroadcaster{broadcaster: broadcaster, outbox: outbox, latestHeight: latestHeight}
+}
+
+// Broadcast implements the Broadcaster interface
+func (b *outboxBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	id, err := b.outbox.Add(b.latestHeight(), msgs...)
+	if err != nil {
+		// losing crash safety for these msgs is better than not broadcasting them at all
+		log.FromCtx(ctx).Errorf("failed to persist msgs to outbox: %s", err.Error())
+		return b.broadcaster.Broadcast(ctx, msgs...)
+	}
+
+	res, err := b.broadcaster.Broadcast(ctx, msgs...)
+
+	// keep the entry if the broadcast was interrupted, so it gets replayed on the next start
+	if errors.Is(err, context.Canceled) {
+		return res, err
+	}
+
+	if err := b.outbox.Remove(id); err != nil {
+		log.FromCtx(ctx).Errorf("failed to remove msgs from outbox: %s", err.Error())
+	}
+
+	return res, err
+}
@@ -0,0 +1,173 @@
+package broadcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestOutbox(t *testing.T) {
+	var (
+		db     dbm.DB
+		outbox *broadcast.Outbox
+	)
+
+	Given("an empty outbox", func() {
+		db = dbm.NewMemDB()
+		outbox = funcs.Must(broadcast.NewOutbox(db, app.MakeEncodingConfig().Codec))
+	}).
+		When("msgs are added", func() {
+			funcs.Must(outbox.Add(10, randomMsgs(2)...))
+			funcs.Must(outbox.Add(11, randomMsgs(1)...))
+		}).
+		Then("they can be read back in order", func(t *testing.T) {
+			entries, err := outbox.Entries()
+			assert.NoError(t, err)
+			assert.Len(t, entries, 2)
+
+			assert.EqualValues(t, 0, entries[0].ID)
+			assert.EqualValues(t, 10, entries[0].Height)
+			assert.Len(t, entries[0].Msgs, 2)
+			assert.IsType(t, &evm.LinkRequest{}, entries[0].Msgs[0])
+
+			assert.EqualValues(t, 1, entries[1].ID)
+			assert.EqualValues(t, 11, entries[1].Height)
+			assert.Len(t, entries[1].Msgs, 1)
+		}).
+		Then("a reopened outbox continues with the next ID", func(t *testing.T) {
+			reopened := funcs.Must(broadcast.NewOutbox(db, app.MakeEncodingConfig().Codec))
+			assert.EqualValues(t, 2, funcs.Must(reopened.Add(12, randomMsgs(1)...)))
+		}).
+		Then("purge with IDs only counts the entries that were removed", func(t *testing.T) {
+			count, err := outbox.Purge(1, 1, 42)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			entries := funcs.Must(outbox.Entries())
+			assert.Len(t, entries, 2)
+			assert.EqualValues(t, 0, entries[0].ID)
+			assert.EqualValues(t, 2, entries[1].ID)
+		}).
+		Then("purge without IDs removes all entries", func(t *testing.T) {
+			count, err := outbox.Purge()
+			assert.NoError(t, err)
+			assert.Equal(t, 2, count)
+			assert.Empty(t, funcs.Must(outbox.Entries()))
+		}).Run(t)
+}
+
+func TestWithOutbox(t *testing.T) {
+	var (
+		outbox      *broadcast.Outbox
+		broadcaster *mock2.BroadcasterMock
+		outboxed    broadcast.Broadcaster
+		entries     []broadcast.OutboxEntry
+	)
+
+	givenOutboxedBroadcaster := Given("a broadcaster with an outbox", func() {
+		outbox = funcs.Must(broadcast.NewOutbox(dbm.NewMemDB(), app.MakeEncodingConfig().Codec))
+		broadcaster = &mock2.BroadcasterMock{}
+		outboxed = broadcast.WithOutbox(broadcaster, outbox, func() int64 { return 42 })
+	})
+
+	givenOutboxedBroadcaster.
+		When("the broadcast is in flight", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				entries = funcs.Must(outbox.Entries())
+				return &sdk.TxResponse{}, nil
+			}
+		}).
+		Then("the msgs are persisted until the broadcast returns", func(t *testing.T) {
+			msgs := randomMsgs(3)
+			_, err := outboxed.Broadcast(context.Background(), msgs...)
+			assert.NoError(t, err)
+
+			assert.Len(t, entries, 1)
+			assert.EqualValues(t, 42, entries[0].Height)
+			assert.Len(t, entries[0].Msgs, len(msgs))
+			assert.Empty(t, funcs.Must(outbox.Entries()))
+		}).Run(t)
+
+	givenOutboxedBroadcaster.
+		When("the broadcast fails", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) {
+				return nil, errors.New("broadcast failed")
+			}
+		}).
+		Then("the msgs are removed from the outbox", func(t *testing.T) {
+			_, err := outboxed.Broadcast(context.Background(), randomMsgs(3)...)
+			assert.Error(t, err)
+			assert.Empty(t, funcs.Must(outbox.Entries()))
+		}).Run(t)
+
+	givenOutboxedBroadcaster.
+		When("the broadcast gets interrupted", func() {
+			broadcaster.BroadcastFunc = func(ctx context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+		}).
+		Then("the msgs stay in the outbox", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := outboxed.Broadcast(ctx, randomMsgs(3)...)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Len(t, funcs.Must(outbox.Entries()), 1)
+		}).Run(t)
+}
+
+func TestOutbox_Replay(t *testing.T) {
+	var (
+		db          dbm.DB
+		outbox      *broadcast.Outbox
+		broadcaster *mock2.BroadcasterMock
+		replayed    [][]sdk.Msg
+		lock        sync.Mutex
+	)
+
+	Given("an outbox with entries left over from a previous run", func() {
+		db = dbm.NewMemDB()
+		previous := funcs.Must(broadcast.NewOutbox(db, app.MakeEncodingConfig().Codec))
+		funcs.Must(previous.Add(10, randomMsgs(2)...))
+		funcs.Must(previous.Add(100, randomMsgs(3)...))
+
+		outbox = funcs.Must(broadcast.NewOutbox(db, app.MakeEncodingConfig().Codec))
+		replayed = nil
+		broadcaster = &mock2.BroadcasterMock{
+			BroadcastFunc: func(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				replayed = append(replayed, msgs)
+				return &sdk.TxResponse{}, nil
+			},
+		}
+	}).
+		When("new msgs are added after the restart", func() {
+			funcs.Must(outbox.Add(101, randomMsgs(1)...))
+		}).
+		Then("only unexpired leftover msgs are replayed", func(t *testing.T) {
+			isExpired := func(entry broadcast.OutboxEntry, _ sdk.Msg) bool { return entry.Height < 50 }
+
+			assert.NoError(t, outbox.Replay(context.Background(), broadcaster, isExpired))
+			assert.Len(t, replayed, 1)
+			assert.Len(t, replayed[0], 3)
+
+			entries := funcs.Must(outbox.Entries())
+			assert.Len(t, entries, 1)
+			assert.EqualValues(t, 101, entries[0].Height)
+		}).Run(t)
+}
@@ -59,6 +59,7 @@ func (p retryPipeline) retry(ctx context.Context, f func(context.Context) error,
 		}
 
 		if i < p.maxRetries {
+			retries.Inc()
 			timeout := p.backOff(i)
 			log.FromCtx(ctx).Infof("backing off (retry in %v )", timeout)
 			time.Sleep(timeout)
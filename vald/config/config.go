@@ -13,6 +13,7 @@ import (
 type ValdConfig struct {
 	tss.TssConfig   `mapstructure:"tss"`
 	BroadcastConfig `mapstructure:"broadcast"`
+	MetricsConfig   `mapstructure:"metrics"`
 	// BatchSizeLimit is the maximum number of messages to include in a single batch when
 	// the broadcaster merges multiple broadcast requests under high traffic.
 	//
@@ -43,8 +44,13 @@ type ValdConfig struct {
 	// This is a safety mechanism to detect and recover from stalled states. Once at least
 	// one block has been seen, vald will panic if it does not see another before the timeout expires.
 	NoNewBlockPanicTimeout time.Duration `mapstructure:"no_new_blocks_timeout"`
+	// SigningPolicyFile is the path of a JSON file with the policy that EVM command batches must satisfy before
+	// vald signs them, e.g. maximum mint amounts per asset. No policy is enforced if empty.
+	SigningPolicyFile string `mapstructure:"signing_policy_file"`
 	// EVMConfig contains the configuration for each EVM chain bridge.
 	EVMConfig []evm.EVMConfig `mapstructure:"axelar_bridge_evm"`
+	// SVMConfig contains the configuration for each chain bridge whose gateway is a Solana program.
+	SVMConfig []evm.SVMConfig `mapstructure:"axelar_bridge_svm"`
 }
 
 // DefaultValdConfig returns a configurations populated with default values
@@ -52,6 +58,7 @@ func DefaultValdConfig() ValdConfig {
 	return ValdConfig{
 		TssConfig:                    tss.DefaultConfig(),
 		BroadcastConfig:              DefaultBroadcastConfig(),
+		MetricsConfig:                DefaultMetricsConfig(),
 		BatchSizeLimit:               250,
 		BatchThreshold:               3,
 		MaxBlocksBehindLatest:        50,
@@ -77,6 +84,9 @@ type BroadcastConfig struct {
 	FeeGranter sdk.AccAddress `mapstructure:"fee_granter"`
 	// Deprecated: ConfirmationPollingInterval is no longer used.
 	ConfirmationPollingInterval time.Duration `mapstructure:"confirmation_polling_interval"`
+	// EnableOutbox persists msgs to a local outbox until they are broadcast, so they can be
+	// replayed after a restart instead of being lost. Disabled by default.
+	EnableOutbox bool `mapstructure:"enable_outbox"`
 }
 
 // DefaultBroadcastConfig returns a configurations populated with default values
@@ -86,5 +96,22 @@ func DefaultBroadcastConfig() BroadcastConfig {
 		MinSleepBeforeRetry:         1 * time.Second,
 		MaxTimeout:                  10 * time.Second,
 		ConfirmationPollingInterval: 400 * time.Millisecond,
+		EnableOutbox:                false,
+	}
+}
+
+// MetricsConfig is the configuration for the prometheus metrics server
+type MetricsConfig struct {
+	// Enabled starts an HTTP server that exposes vald metrics in the prometheus format.
+	Enabled bool `mapstructure:"enabled"`
+	// ListenAddr is the address the metrics server listens on. Metrics are served at /metrics.
+	ListenAddr string `mapstructure:"listen_addr"`
+}
+
+// DefaultMetricsConfig returns a configurations populated with default values
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Enabled:    false,
+		ListenAddr: "localhost:26661",
 	}
 }
@@ -60,10 +60,13 @@ func TestConfig_GoldenFile(t *testing.T) {
 func testConfig() ValdConfig {
 	cfg := DefaultValdConfig()
 	cfg.FeeGranter = sdk.AccAddress("test-fee-granter-addr")
+	rollupRPCAddr := "https://optimism-rollup.example.com"
 	cfg.EVMConfig = []evmtypes.EVMConfig{
 		{
 			Name:             "ethereum",
 			RPCAddr:          "https://eth.example.com",
+			RPCAddrs:         []string{"https://eth2.example.com", "https://eth3.example.com"},
+			RPCQuorum:        2,
 			WithBridge:       true,
 			FinalityOverride: rpc.Confirmation,
 		},
@@ -78,6 +81,21 @@ func testConfig() ValdConfig {
 			RPCAddr:          "https://polygon.example.com",
 			WithBridge:       false,
 			FinalityOverride: rpc.NoOverride,
+			Finality:         rpc.HybridFinality,
+		},
+		{
+			Name:          "optimism",
+			RPCAddr:       "https://optimism.example.com",
+			WithBridge:    true,
+			Finality:      rpc.L1BatchFinality,
+			RollupRPCAddr: &rollupRPCAddr,
+		},
+	}
+	cfg.SVMConfig = []evmtypes.SVMConfig{
+		{
+			Name:       "solana",
+			RPCAddr:    "https://solana.example.com",
+			WithBridge: true,
 		},
 	}
 	return cfg
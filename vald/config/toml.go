@@ -77,6 +77,12 @@ func convertValue(v interface{}) (interface{}, error) {
 		case rpc.Confirmation:
 			return strings.ToLower(d.String()), nil
 		}
+
+	case rpc.Finality:
+		if d == rpc.DefaultFinality {
+			return nil, nil
+		}
+		return string(d), nil
 	}
 
 	val := reflect.ValueOf(v)
@@ -85,6 +91,10 @@ func convertValue(v interface{}) (interface{}, error) {
 		// Convert struct to map using mapstructure
 		return structToMap(v)
 	case reflect.Slice:
+		// Omit nil slices so they decode back to nil
+		if val.IsNil() {
+			return nil, nil
+		}
 		// Convert each element
 		result := make([]interface{}, val.Len())
 		for i := 0; i < val.Len(); i++ {
@@ -22,6 +22,7 @@ var (
 	MultisigTransferOperatorshipSig = crypto.Keccak256Hash([]byte("OperatorshipTransferred(bytes)"))
 	ContractCallSig                 = crypto.Keccak256Hash([]byte("ContractCall(address,string,string,bytes32,bytes)"))
 	ContractCallWithTokenSig        = crypto.Keccak256Hash([]byte("ContractCallWithToken(address,string,string,bytes32,bytes,string,uint256)"))
+	ExecutedSig                     = crypto.Keccak256Hash([]byte("Executed(bytes32)"))
 )
 
 func DecodeERC20TokenDeploymentEvent(log *geth.Log) (types.EventTokenDeployed, error) {
@@ -122,3 +123,18 @@ func DecodeEventContractCall(log *geth.Log) (types.EventContractCall, error) {
 		PayloadHash:      types.Hash(common.BytesToHash(log.Topics[2].Bytes())),
 	}, nil
 }
+
+// DecodeEventCommandExecuted decodes the Executed event emitted by the gateway for each executed command
+func DecodeEventCommandExecuted(log *geth.Log) (types.EventCommandExecuted, error) {
+	if len(log.Topics) != 2 || log.Topics[0] != ExecutedSig {
+		return types.EventCommandExecuted{}, fmt.Errorf("event is not Executed")
+	}
+
+	if len(log.Data) != 0 {
+		return types.EventCommandExecuted{}, fmt.Errorf("wrong data")
+	}
+
+	return types.EventCommandExecuted{
+		CommandID: types.CommandID(log.Topics[1]),
+	}, nil
+}
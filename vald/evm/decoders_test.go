@@ -73,3 +73,27 @@ func TestDecodeTokenDeployEvent_CorrectData(t *testing.T) {
 	assert.Equal(t, expectedSymbol, tokenDeployed.Symbol)
 	assert.Equal(t, types.Address(expectedAddr), tokenDeployed.TokenAddress)
 }
+
+func TestDecodeEventCommandExecuted(t *testing.T) {
+	commandID := common.HexToHash("0x35f3d6f4a2b3c7c8f8bd3e1b58a0f62c0a6f6fdcdb0a3a3f0e0c1d58c4e8b1a2")
+	log := &geth.Log{
+		Topics: []common.Hash{
+			common.HexToHash("0xa74c8847d513feba22a0f0cb38d53081abf97562cdb293926ba243689e7c41ca"),
+			commandID,
+		},
+	}
+
+	actual, err := evm.DecodeEventCommandExecuted(log)
+
+	assert.NoError(t, err)
+	assert.Equal(t, types.EventCommandExecuted{CommandID: types.CommandID(commandID)}, actual)
+
+	log.Data = commandID.Bytes()
+	_, err = evm.DecodeEventCommandExecuted(log)
+	assert.Error(t, err)
+
+	log.Data = nil
+	log.Topics = log.Topics[:1]
+	_, err = evm.DecodeEventCommandExecuted(log)
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/log"
 	"github.com/axelarnetwork/utils/monads/results"
 	"github.com/axelarnetwork/utils/slices"
@@ -31,16 +32,18 @@ var ErrTxFailed = goerrors.New("transaction failed")
 // Mgr manages all communication with Ethereum
 type Mgr struct {
 	rpcs                      map[string]rpc.Client
+	finalities                map[string]rpc.FinalityStrategy
 	broadcaster               broadcast.Broadcaster
 	validator                 sdk.ValAddress
 	proxy                     sdk.AccAddress
 	latestFinalizedBlockCache LatestFinalizedBlockCache
 }
 
-// NewMgr returns a new Mgr instance
-func NewMgr(rpcs map[string]rpc.Client, broadcaster broadcast.Broadcaster, valAddr sdk.ValAddress, proxy sdk.AccAddress, latestFinalizedBlockCache LatestFinalizedBlockCache) *Mgr {
+// NewMgr returns a new Mgr instance. Chains without a finality strategy use the default one of their rpc client.
+func NewMgr(rpcs map[string]rpc.Client, finalities map[string]rpc.FinalityStrategy, broadcaster broadcast.Broadcaster, valAddr sdk.ValAddress, proxy sdk.AccAddress, latestFinalizedBlockCache LatestFinalizedBlockCache) *Mgr {
 	return &Mgr{
 		rpcs:                      rpcs,
+		finalities:                finalities,
 		proxy:                     proxy,
 		broadcaster:               broadcaster,
 		validator:                 valAddr,
@@ -53,6 +56,14 @@ func (mgr Mgr) logger(keyvals ...any) log.Logger {
 	return log.WithKeyVals(keyvals...)
 }
 
+func (mgr Mgr) finality(chain nexus.ChainName) rpc.FinalityStrategy {
+	if finality, ok := mgr.finalities[strings.ToLower(chain.String())]; ok {
+		return finality
+	}
+
+	return funcs.Must(rpc.NewFinalityStrategy(rpc.DefaultFinality))
+}
+
 // ProcessNewChain notifies the operator that vald needs to be restarted/updated for a new chain
 func (mgr Mgr) ProcessNewChain(event *types.ChainAdded) (err error) {
 	mgr.logger().Info(fmt.Sprintf("VALD needs to be updated and restarted for new chain %s", event.Chain.String()))
@@ -73,7 +84,7 @@ func (mgr Mgr) isFinalized(chain nexus.ChainName, txReceipt geth.Receipt, confHe
 		return true, nil
 	}
 
-	latestFinalizedBlockNumber, err := client.LatestFinalizedBlockNumber(context.Background(), confHeight)
+	latestFinalizedBlockNumber, err := mgr.finality(chain).LatestFinalizedBlockNumber(context.Background(), client, confHeight)
 	if err != nil {
 		return false, err
 	}
@@ -31,6 +31,7 @@ func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmGatewayTxsStart
 	}
 
 	var votes []sdk.Msg
+	var eventCounts []int
 	for i, txReceipt := range txReceipts {
 		pollID := event.PollMappings[i].PollID
 		txID := event.PollMappings[i].TxID
@@ -39,16 +40,19 @@ func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmGatewayTxsStart
 
 		if txReceipt.Err() != nil {
 			votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain)))
+			eventCounts = append(eventCounts, 0)
 
 			logger.Infof("broadcasting empty vote for poll %s: %s", pollID.String(), txReceipt.Err().Error())
 		} else {
 			events := mgr.processGatewayTxLogs(event.Chain, event.GatewayAddress, txReceipt.Ok().Logs)
 			if len(events) > types.MaxEventsPerVote {
 				votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain)))
+				eventCounts = append(eventCounts, 0)
 
 				logger.Infof("broadcasting empty vote for poll %s: too many events (%d exceeds maximum of %d)", pollID.String(), len(events), types.MaxEventsPerVote)
 			} else {
 				votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain, events...)))
+				eventCounts = append(eventCounts, len(events))
 
 				logger.Infof("broadcasting vote %v for poll %s", events, pollID.String())
 			}
@@ -56,6 +60,7 @@ func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmGatewayTxsStart
 	}
 
 	_, err = mgr.broadcaster.Broadcast(context.TODO(), votes...)
+	recordVotes(event.Chain, pollTypeGatewayTxsConfirmation, err, eventCounts...)
 
 	return err
 }
@@ -113,6 +118,26 @@ func (mgr Mgr) processGatewayTxLogs(chain nexus.ChainName, gatewayAddress types.
 					ContractCallWithToken: &gatewayEvent,
 				},
 			})
+		case ExecutedSig:
+			gatewayEvent, err := DecodeEventCommandExecuted(txlog)
+			if err != nil {
+				mgr.logger().Debug(errorsmod.Wrap(err, "decode event Executed failed").Error())
+				continue
+			}
+
+			if err := gatewayEvent.ValidateBasic(); err != nil {
+				mgr.logger().Debug(errorsmod.Wrap(err, "invalid event Executed").Error())
+				continue
+			}
+
+			events = append(events, types.Event{
+				Chain: chain,
+				TxID:  types.Hash(txlog.TxHash),
+				Index: uint64(i),
+				Event: &types.Event_CommandExecuted{
+					CommandExecuted: &gatewayEvent,
+				},
+			})
 		default:
 		}
 	}
@@ -85,7 +85,7 @@ func TestMgr_ProcessGatewayTxsConfirmationMissingBlockNumberNotPanics(t *testing
 	}}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	assert.NotPanics(t, func() {
 		require.NoError(t, mgr.ProcessGatewayTxsConfirmation(&types.ConfirmGatewayTxsStarted{
@@ -115,7 +115,7 @@ func TestMgr_ProcessGatewayTxsConfirmationNoTopicsNotPanics(t *testing.T) {
 	}}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	assert.NotPanics(t, func() {
 		require.NoError(t, mgr.ProcessGatewayTxsConfirmation(&types.ConfirmGatewayTxsStarted{
@@ -206,6 +206,7 @@ func TestEIP7702TransactionConfirmation(t *testing.T) {
 	valAddr := rand.ValAddr()
 	mgr := evm.NewMgr(
 		map[string]evmRpc.Client{chain.String(): rpcClient},
+		nil,
 		broadcaster,
 		valAddr,
 		rand.AccAddr(),
@@ -400,7 +401,7 @@ func TestMgr_ProcessGatewayTxsConfirmationTooManyEventsVotesEmpty(t *testing.T)
 	}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	err := mgr.ProcessGatewayTxsConfirmation(&types.ConfirmGatewayTxsStarted{
 		PollMappings:   []types.PollMapping{{TxID: txID, PollID: 10}},
@@ -474,7 +475,7 @@ func TestMgr_ProcessGatewayTxsConfirmationExactlyMaxEventsVotesNormally(t *testi
 	}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmRpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	err := mgr.ProcessGatewayTxsConfirmation(&types.ConfirmGatewayTxsStarted{
 		PollMappings:   []types.PollMapping{{TxID: txID, PollID: 10}},
@@ -19,6 +19,7 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferSta
 	}
 
 	var vote *voteTypes.VoteRequest
+	var eventCount int
 
 	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
@@ -31,11 +32,13 @@ func (mgr Mgr) ProcessTransferKeyConfirmation(event *types.ConfirmKeyTransferSta
 	} else {
 		events := mgr.processTransferKeyLogs(event, txReceipt.Ok().Logs)
 		vote = voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...))
+		eventCount = len(events)
 
 		mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
 	}
 
 	_, err = mgr.broadcaster.Broadcast(context.TODO(), vote)
+	recordVotes(event.Chain, pollTypeKeyTransferConfirmation, err, eventCount)
 
 	return err
 }
@@ -54,7 +54,7 @@ func TestMgr_ProcessTransferKeyConfirmation(t *testing.T) {
 		evmMap := make(map[string]evmrpc.Client)
 		evmMap["ethereum"] = rpc
 		valAddr = rand.ValAddr()
-		mgr = evm.NewMgr(evmMap, broadcaster, valAddr, rand.AccAddr(), &evmmock.LatestFinalizedBlockCacheMock{
+		mgr = evm.NewMgr(evmMap, nil, broadcaster, valAddr, rand.AccAddr(), &evmmock.LatestFinalizedBlockCacheMock{
 			GetFunc: func(_ nexus.ChainName) *big.Int { return big.NewInt(0) },
 			SetFunc: func(_ nexus.ChainName, _ *big.Int) {},
 		})
@@ -207,7 +207,7 @@ func TestMgr_ProcessTransferKeyConfirmationNoTopicsNotPanics(t *testing.T) {
 	}}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmrpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmrpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	assert.NotPanics(t, func() {
 		mgr.ProcessTransferKeyConfirmation(&types.ConfirmKeyTransferStarted{TxID: types.Hash{1},
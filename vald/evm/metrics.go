@@ -0,0 +1,42 @@
+package evm
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+const (
+	pollTypeTokenConfirmation       = "token_confirmation"
+	pollTypeKeyTransferConfirmation = "key_transfer_confirmation"
+	pollTypeGatewayTxsConfirmation  = "gateway_txs_confirmation"
+)
+
+var pollVotes = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "vald",
+	Subsystem: "evm",
+	Name:      "poll_votes_total",
+	Help:      "Number of votes cast in EVM polls by chain, poll type and outcome (events, empty or failed to broadcast)",
+}, []string{"chain", "poll_type", "outcome"})
+
+// Collectors returns the prometheus collectors of the EVM manager
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{pollVotes}
+}
+
+// recordVotes counts the votes cast for the given poll type, where eventCounts holds the number of events of each vote
+func recordVotes(chain nexus.ChainName, pollType string, broadcastErr error, eventCounts ...int) {
+	for _, count := range eventCounts {
+		outcome := "events"
+		switch {
+		case broadcastErr != nil:
+			outcome = "failed"
+		case count == 0:
+			outcome = "empty"
+		}
+
+		pollVotes.WithLabelValues(strings.ToLower(chain.String()), pollType, outcome).Inc()
+	}
+}
@@ -0,0 +1,118 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmmock "github.com/axelarnetwork/axelar-core/vald/evm/mock"
+	evmrpc "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMgr_PollVoteMetrics(t *testing.T) {
+	var (
+		mgr         *evm.Mgr
+		broadcaster *broadcastmock.BroadcasterMock
+		event       *types.ConfirmTokenStarted
+		before      map[string]float64
+	)
+
+	chain := nexus.ChainName(strings.ToLower(rand.NormalizedStr(10)))
+	outcomes := []string{"events", "empty", "failed"}
+
+	votes := func(t *testing.T, outcome string) float64 {
+		registry := prometheus.NewRegistry()
+		for _, c := range evm.Collectors() {
+			assert.NoError(t, registry.Register(c))
+		}
+
+		families, err := registry.Gather()
+		assert.NoError(t, err)
+
+		for _, family := range families {
+			for _, metric := range family.GetMetric() {
+				labels := map[string]string{}
+				for _, label := range metric.GetLabel() {
+					labels[label.GetName()] = label.GetValue()
+				}
+
+				if labels["chain"] == chain.String() && labels["poll_type"] == "token_confirmation" && labels["outcome"] == outcome {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+
+		return 0
+	}
+
+	givenMgr := Given("an EVM manager that cannot find the confirmed tx", func() {
+		valAddr := rand.ValAddr()
+		rpc := &mock.ClientMock{
+			TransactionReceiptsFunc: func(context.Context, []common.Hash) ([]evmrpc.TxReceiptResult, error) {
+				return nil, errors.New("rpc unavailable")
+			},
+		}
+		broadcaster = &broadcastmock.BroadcasterMock{}
+		mgr = evm.NewMgr(map[string]evmrpc.Client{chain.String(): rpc}, nil, broadcaster, valAddr, rand.AccAddr(), &evmmock.LatestFinalizedBlockCacheMock{
+			GetFunc: func(nexus.ChainName) *big.Int { return big.NewInt(0) },
+			SetFunc: func(nexus.ChainName, *big.Int) {},
+		})
+
+		event = &types.ConfirmTokenStarted{
+			TxID:           testutils.RandomHash(),
+			Chain:          chain,
+			GatewayAddress: testutils.RandomAddress(),
+			TokenAddress:   testutils.RandomAddress(),
+			PollParticipants: vote.PollParticipants{
+				PollID:       vote.PollID(rand.PosI64()),
+				Participants: []sdk.ValAddress{valAddr},
+			},
+		}
+
+		before = map[string]float64{}
+		for _, outcome := range outcomes {
+			before[outcome] = votes(t, outcome)
+		}
+	})
+
+	givenMgr.
+		When("the vote is broadcast", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		}).
+		Then("should count an empty vote", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessTokenConfirmation(event))
+
+			assert.Equal(t, before["empty"]+1, votes(t, "empty"))
+			assert.Equal(t, before["events"], votes(t, "events"))
+			assert.Equal(t, before["failed"], votes(t, "failed"))
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the vote fails to broadcast", func() {
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return nil, errors.New("broadcast failed") }
+		}).
+		Then("should count a failed vote", func(t *testing.T) {
+			assert.Error(t, mgr.ProcessTokenConfirmation(event))
+
+			assert.Equal(t, before["failed"]+1, votes(t, "failed"))
+			assert.Equal(t, before["empty"], votes(t, "empty"))
+		}).
+		Run(t)
+}
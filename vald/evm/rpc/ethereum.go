@@ -103,11 +103,20 @@ func (c *EthereumClient) TransactionReceipts(ctx context.Context, txHashes []com
 
 }
 
-// copied from https://github.com/ethereum/go-ethereum/blob/69568c554880b3567bace64f8848ff1be27d084d/ethclient/ethclient.go#L565
+// adapted from https://github.com/ethereum/go-ethereum/blob/v1.16.9/ethclient/ethclient.go,
+// negative numbers are block tags such as rpc.FinalizedBlockNumber
 func toBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
 	}
 
-	return hexutil.EncodeBig(number)
+	if number.Sign() >= 0 {
+		return hexutil.EncodeBig(number)
+	}
+
+	if number.IsInt64() {
+		return rpc.BlockNumber(number.Int64()).String()
+	}
+
+	return fmt.Sprintf("<invalid %d>", number)
 }
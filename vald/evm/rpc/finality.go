@@ -0,0 +1,174 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Finality is the name of a finality strategy that can be selected per chain
+type Finality string
+
+// Supported finality strategies
+const (
+	// DefaultFinality defers to the client, which uses the finalized tag if the chain supports it
+	// and falls back to confirmations otherwise
+	DefaultFinality Finality = ""
+	// ConfirmationFinality considers a block final once it has enough confirmations
+	ConfirmationFinality Finality = "confirmation"
+	// FinalizedTagFinality considers the block with the finalized tag final
+	FinalizedTagFinality Finality = "finalized"
+	// SafeTagFinality considers the block with the safe tag final
+	SafeTagFinality Finality = "safe"
+	// HybridFinality considers a block final once it is either tagged as finalized or has enough confirmations
+	HybridFinality Finality = "hybrid"
+	// L1BatchFinality considers a rollup block final once the batch it was posted in is finalized on the L1
+	L1BatchFinality Finality = "l1_batch"
+)
+
+// FinalityStrategy determines the latest finalized block of an EVM chain
+type FinalityStrategy interface {
+	// LatestFinalizedBlockNumber returns the latest finalized block number
+	LatestFinalizedBlockNumber(ctx context.Context, client Client, confirmations uint64) (*big.Int, error)
+}
+
+// NewFinalityStrategy returns the finality strategy with the given name.
+// L1BatchFinality needs a connection to the rollup node, so it must be created with NewL1BatchFinality instead.
+func NewFinalityStrategy(finality Finality) (FinalityStrategy, error) {
+	switch finality {
+	case DefaultFinality:
+		return defaultFinality{}, nil
+	case ConfirmationFinality:
+		return confirmationFinality{}, nil
+	case FinalizedTagFinality:
+		return tagFinality{tag: rpc.FinalizedBlockNumber}, nil
+	case SafeTagFinality:
+		return tagFinality{tag: rpc.SafeBlockNumber}, nil
+	case HybridFinality:
+		return hybridFinality{}, nil
+	case L1BatchFinality:
+		return nil, fmt.Errorf("finality strategy %s requires a rollup node", finality)
+	default:
+		return nil, fmt.Errorf("unknown finality strategy %s", finality)
+	}
+}
+
+type defaultFinality struct{}
+
+// LatestFinalizedBlockNumber returns the latest finalized block number as determined by the client
+func (defaultFinality) LatestFinalizedBlockNumber(ctx context.Context, client Client, confirmations uint64) (*big.Int, error) {
+	return client.LatestFinalizedBlockNumber(ctx, confirmations)
+}
+
+type confirmationFinality struct{}
+
+// LatestFinalizedBlockNumber returns the latest block number with the given number of confirmations
+func (confirmationFinality) LatestFinalizedBlockNumber(ctx context.Context, client Client, confirmations uint64) (*big.Int, error) {
+	return finalizedBlockNumber(client, func(client Client) (*big.Int, error) {
+		latest, err := headerNumber(ctx, client, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return math.NewIntFromBigInt(latest).SubRaw(int64(confirmations)).AddRaw(1).BigInt(), nil
+	})
+}
+
+type tagFinality struct {
+	tag rpc.BlockNumber
+}
+
+// LatestFinalizedBlockNumber returns the number of the block with the strategy's tag
+func (f tagFinality) LatestFinalizedBlockNumber(ctx context.Context, client Client, _ uint64) (*big.Int, error) {
+	return finalizedBlockNumber(client, func(client Client) (*big.Int, error) {
+		return headerNumber(ctx, client, big.NewInt(f.tag.Int64()))
+	})
+}
+
+type hybridFinality struct{}
+
+// LatestFinalizedBlockNumber returns the later of the finalized block and the latest block with the given number of confirmations.
+// If the chain does not support the finalized tag, only confirmations are considered.
+func (hybridFinality) LatestFinalizedBlockNumber(ctx context.Context, client Client, confirmations uint64) (*big.Int, error) {
+	confirmed, confirmedErr := confirmationFinality{}.LatestFinalizedBlockNumber(ctx, client, confirmations)
+	finalized, finalizedErr := tagFinality{tag: rpc.FinalizedBlockNumber}.LatestFinalizedBlockNumber(ctx, client, confirmations)
+
+	switch {
+	case confirmedErr != nil && finalizedErr != nil:
+		return nil, errors.Join(confirmedErr, finalizedErr)
+	case confirmedErr != nil:
+		return finalized, nil
+	case finalizedErr != nil:
+		return confirmed, nil
+	case finalized.Cmp(confirmed) > 0:
+		return finalized, nil
+	default:
+		return confirmed, nil
+	}
+}
+
+// l1BatchFinality derives finality from the L1 batches of an OP stack rollup, as reported by its rollup node
+type l1BatchFinality struct {
+	rollup JSONRPCClient
+}
+
+// NewL1BatchFinality returns a finality strategy that considers rollup blocks final once they are derived from finalized L1 blocks.
+// The given client must be connected to the rollup node of the chain.
+func NewL1BatchFinality(rollup JSONRPCClient) FinalityStrategy {
+	return l1BatchFinality{rollup: rollup}
+}
+
+type syncStatus struct {
+	FinalizedL2 *struct {
+		Number uint64 `json:"number"`
+	} `json:"finalized_l2"`
+}
+
+// LatestFinalizedBlockNumber returns the latest rollup block that is derived from finalized L1 blocks
+func (f l1BatchFinality) LatestFinalizedBlockNumber(ctx context.Context, _ Client, _ uint64) (*big.Int, error) {
+	var status syncStatus
+	if err := f.rollup.CallContext(ctx, &status, "optimism_syncStatus"); err != nil {
+		return nil, err
+	}
+
+	if status.FinalizedL2 == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return new(big.Int).SetUint64(status.FinalizedL2.Number), nil
+}
+
+// quorumClient is implemented by clients backed by multiple endpoints that need to agree on the finalized block
+type quorumClient interface {
+	finalizedBlockNumber(f func(Client) (*big.Int, error)) (*big.Int, error)
+}
+
+// finalizedBlockNumber applies f to the given client. If the client is backed by multiple endpoints,
+// f is applied to each of them, so the result is subject to the client's quorum.
+// Headers of the latest or tagged blocks differ between endpoints that are a few blocks apart,
+// so agreeing on their hashes like HeaderByNumber does would rarely reach a quorum.
+func finalizedBlockNumber(client Client, f func(Client) (*big.Int, error)) (*big.Int, error) {
+	if client, ok := client.(quorumClient); ok {
+		return client.finalizedBlockNumber(f)
+	}
+
+	return f(client)
+}
+
+func headerNumber(ctx context.Context, client Client, number *big.Int) (*big.Int, error) {
+	header, err := client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if header == nil || header.Number == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return header.Number.ToInt(), nil
+}
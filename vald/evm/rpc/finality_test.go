@@ -0,0 +1,98 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+)
+
+func newHeadersClient(headers map[ethrpc.BlockNumber]int64) *mock.ClientMock {
+	return &mock.ClientMock{
+		HeaderByNumberFunc: func(_ context.Context, number *big.Int) (*rpc.Header, error) {
+			tag := ethrpc.LatestBlockNumber
+			if number != nil {
+				tag = ethrpc.BlockNumber(number.Int64())
+			}
+
+			blockNumber, ok := headers[tag]
+			if !ok {
+				return nil, errors.New("unsupported block tag")
+			}
+
+			return &rpc.Header{Number: (*hexutil.Big)(big.NewInt(blockNumber))}, nil
+		},
+		LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return big.NewInt(42), nil },
+	}
+}
+
+func TestFinalityStrategy(t *testing.T) {
+	headers := map[ethrpc.BlockNumber]int64{
+		ethrpc.LatestBlockNumber:    100,
+		ethrpc.SafeBlockNumber:      95,
+		ethrpc.FinalizedBlockNumber: 80,
+	}
+
+	testCases := []struct {
+		finality      rpc.Finality
+		headers       map[ethrpc.BlockNumber]int64
+		confirmations uint64
+		expected      int64
+	}{
+		{rpc.DefaultFinality, headers, 10, 42},
+		{rpc.ConfirmationFinality, headers, 10, 91},
+		{rpc.FinalizedTagFinality, headers, 10, 80},
+		{rpc.SafeTagFinality, headers, 10, 95},
+		{rpc.HybridFinality, headers, 10, 91},
+		{rpc.HybridFinality, headers, 30, 80},
+		{rpc.HybridFinality, map[ethrpc.BlockNumber]int64{ethrpc.LatestBlockNumber: 100}, 30, 71},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.finality), func(t *testing.T) {
+			strategy, err := rpc.NewFinalityStrategy(tc.finality)
+			assert.NoError(t, err)
+
+			blockNumber, err := strategy.LatestFinalizedBlockNumber(context.Background(), newHeadersClient(tc.headers), tc.confirmations)
+			assert.NoError(t, err)
+			assert.Equal(t, big.NewInt(tc.expected), blockNumber)
+		})
+	}
+
+	t.Run("should fail if the tag is not supported", func(t *testing.T) {
+		strategy, err := rpc.NewFinalityStrategy(rpc.SafeTagFinality)
+		assert.NoError(t, err)
+
+		_, err = strategy.LatestFinalizedBlockNumber(context.Background(), newHeadersClient(map[ethrpc.BlockNumber]int64{}), 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject unknown strategies", func(t *testing.T) {
+		_, err := rpc.NewFinalityStrategy("instant")
+		assert.Error(t, err)
+
+		_, err = rpc.NewFinalityStrategy(rpc.L1BatchFinality)
+		assert.Error(t, err)
+	})
+}
+
+func TestL1BatchFinality(t *testing.T) {
+	rollup := &mock.JSONRPCClientMock{
+		CallContextFunc: func(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+			assert.Equal(t, "optimism_syncStatus", method)
+			return json.Unmarshal([]byte(`{"finalized_l2":{"hash":"0x01","number":1234}}`), result)
+		},
+	}
+
+	blockNumber, err := rpc.NewL1BatchFinality(rollup).LatestFinalizedBlockNumber(context.Background(), nil, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1234), blockNumber)
+}
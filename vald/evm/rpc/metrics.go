@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "vald",
+	Subsystem: "evm_rpc",
+	Name:      "request_duration_seconds",
+	Help:      "Latency of EVM JSON-RPC calls by chain, method and status",
+	Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+}, []string{"chain", "method", "status"})
+
+// Collectors returns the prometheus collectors of the EVM JSON-RPC clients
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{requestDuration}
+}
+
+type instrumentedClient struct {
+	client Client
+	chain  string
+}
+
+// WithMetrics returns a client that records the latency and status of all calls to the given client
+func WithMetrics(client Client, chain string) Client {
+	return instrumentedClient{client: client, chain: chain}
+}
+
+// TransactionReceipts returns transaction receipts for the given transaction hashes
+func (c instrumentedClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]TxReceiptResult, error) {
+	observe := c.observe("TransactionReceipts", time.Now())
+
+	receipts, err := c.client.TransactionReceipts(ctx, txHashes)
+	return receipts, observe(err)
+}
+
+// HeaderByNumber returns the block header for the given block number
+func (c instrumentedClient) HeaderByNumber(ctx context.Context, number *big.Int) (*Header, error) {
+	observe := c.observe("HeaderByNumber", time.Now())
+
+	header, err := c.client.HeaderByNumber(ctx, number)
+	return header, observe(err)
+}
+
+// LatestFinalizedBlockNumber returns the latest finalized block number
+func (c instrumentedClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (*big.Int, error) {
+	observe := c.observe("LatestFinalizedBlockNumber", time.Now())
+
+	blockNumber, err := c.client.LatestFinalizedBlockNumber(ctx, confirmations)
+	return blockNumber, observe(err)
+}
+
+// finalizedBlockNumber lets finality strategies reach the quorum of the wrapped client, if it has one
+func (c instrumentedClient) finalizedBlockNumber(f func(Client) (*big.Int, error)) (*big.Int, error) {
+	observe := c.observe("LatestFinalizedBlockNumber", time.Now())
+
+	blockNumber, err := finalizedBlockNumber(c.client, f)
+	return blockNumber, observe(err)
+}
+
+// Close closes the client connection
+func (c instrumentedClient) Close() {
+	c.client.Close()
+}
+
+// observe returns a function that records the time elapsed since start together with the status of the call
+func (c instrumentedClient) observe(method string, start time.Time) func(error) error {
+	return func(err error) error {
+		status := "success"
+		if err != nil {
+			status = "error"
+		}
+
+		requestDuration.WithLabelValues(c.chain, method, status).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
@@ -0,0 +1,52 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+)
+
+func TestWithMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	for _, c := range rpc.Collectors() {
+		assert.NoError(t, registry.Register(c))
+	}
+
+	inner := &mock.ClientMock{
+		LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return big.NewInt(100), nil },
+		HeaderByNumberFunc:             func(context.Context, *big.Int) (*rpc.Header, error) { return nil, errors.New("unavailable") },
+	}
+	client := rpc.WithMetrics(inner, "ethereum")
+
+	blockNumber, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), blockNumber)
+
+	_, err = client.HeaderByNumber(context.Background(), big.NewInt(1))
+	assert.Error(t, err)
+	_, err = client.HeaderByNumber(context.Background(), big.NewInt(2))
+	assert.Error(t, err)
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, "vald_evm_rpc_request_duration_seconds", metrics[0].GetName())
+
+	samples := map[string]uint64{}
+	for _, m := range metrics[0].GetMetric() {
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		assert.Equal(t, "ethereum", labels["chain"])
+		samples[labels["method"]+"/"+labels["status"]] = m.GetHistogram().GetSampleCount()
+	}
+	assert.Equal(t, map[string]uint64{"LatestFinalizedBlockNumber/success": 1, "HeaderByNumber/error": 2}, samples)
+}
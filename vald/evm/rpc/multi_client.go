@@ -0,0 +1,450 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on the result of a call
+var ErrNoQuorum = errors.New("endpoints did not reach quorum")
+
+const (
+	// healthDecay is the weight of the latest call outcome in an endpoint's health score
+	healthDecay = 0.3
+	// redialInterval is the minimum time between two connection attempts to the same endpoint
+	redialInterval = 30 * time.Second
+)
+
+// Dialer creates a client for a single JSON-RPC endpoint
+type Dialer func() (Client, error)
+
+// DialURL returns a Dialer that connects to the given JSON-RPC endpoint
+func DialURL(url string, override FinalityOverride) Dialer {
+	return func() (Client, error) { return NewClient(url, override) }
+}
+
+// endpoint is a single JSON-RPC endpoint together with its health record
+type endpoint struct {
+	dial       Dialer
+	client     Client
+	lastDialed time.Time
+	score      float64
+	lock       sync.Mutex
+}
+
+func newEndpoint(dial Dialer) *endpoint {
+	return &endpoint{dial: dial, score: 1}
+}
+
+// get returns the endpoint's client, connecting to the endpoint first if necessary
+func (e *endpoint) get() (Client, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	if !e.lastDialed.IsZero() && time.Since(e.lastDialed) < redialInterval {
+		return nil, fmt.Errorf("endpoint unavailable, next connection attempt in %s", redialInterval-time.Since(e.lastDialed))
+	}
+
+	e.lastDialed = time.Now()
+	client, err := e.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	e.client = client
+	return client, nil
+}
+
+// record updates the health score of the endpoint with the outcome of a call
+func (e *endpoint) record(success bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	outcome := 0.0
+	if success {
+		outcome = 1
+	}
+	e.score = (1-healthDecay)*e.score + healthDecay*outcome
+}
+
+func (e *endpoint) health() float64 {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.score
+}
+
+func (e *endpoint) close() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.client != nil {
+		e.client.Close()
+		e.client = nil
+	}
+}
+
+// MultiClient is a Client backed by multiple JSON-RPC endpoints of the same chain.
+// Without a quorum, calls go to the healthiest endpoint and fail over to the next one on error.
+// With a quorum greater than one, transaction receipts, headers and the latest finalized block
+// are requested from all endpoints and only returned if at least quorum endpoints agree on them.
+type MultiClient struct {
+	endpoints []*endpoint
+	quorum    int
+}
+
+// NewMultiClient returns a client that spreads calls across the endpoints created by the given dialers.
+// At least max(quorum, 1) endpoints must be reachable, the remaining ones are retried on later calls.
+func NewMultiClient(dialers []Dialer, quorum int) (*MultiClient, error) {
+	if len(dialers) == 0 {
+		return nil, errors.New("no endpoints given")
+	}
+
+	if quorum > len(dialers) {
+		return nil, fmt.Errorf("quorum %d exceeds the number of endpoints %d", quorum, len(dialers))
+	}
+
+	client := &MultiClient{
+		endpoints: slices.Map(dialers, newEndpoint),
+		quorum:    quorum,
+	}
+
+	var errs []error
+	for i, e := range client.endpoints {
+		if _, err := e.get(); err != nil {
+			e.record(false)
+			errs = append(errs, fmt.Errorf("endpoint %d: %w", i, err))
+		}
+	}
+
+	if len(client.endpoints)-len(errs) < max(quorum, 1) {
+		client.Close()
+		return nil, errors.Join(errs...)
+	}
+
+	return client, nil
+}
+
+// TransactionReceipts returns transaction receipts for the given transaction hashes
+func (c *MultiClient) TransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]TxReceiptResult, error) {
+	if c.quorum > 1 {
+		return c.quorumTransactionReceipts(ctx, txHashes)
+	}
+
+	receipts := make([]TxReceiptResult, len(txHashes))
+	pending := slices.Expand(func(i int) int { return i }, len(txHashes))
+	fetched := false
+
+	err := c.failover(func(client Client) error {
+		batch, err := client.TransactionReceipts(ctx, slices.Map(pending, func(i int) common.Hash { return txHashes[i] }))
+		if err == nil && len(batch) != len(pending) {
+			err = fmt.Errorf("expected %d receipts, got %d", len(pending), len(batch))
+		}
+		if err != nil {
+			return err
+		}
+		fetched = true
+
+		var stillPending []int
+		for j, receipt := range batch {
+			receipts[pending[j]] = receipt
+			if receipt.AsResult().Err() != nil {
+				stillPending = append(stillPending, pending[j])
+			}
+		}
+		pending = stillPending
+
+		// ask the next endpoint for receipts this one could not provide, in case it is lagging behind
+		if len(pending) > 0 {
+			return ethereum.NotFound
+		}
+
+		return nil
+	})
+	if !fetched {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
+// HeaderByNumber returns the block header for the given block number
+func (c *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*Header, error) {
+	if c.quorum > 1 {
+		return c.quorumHeaderByNumber(ctx, number)
+	}
+
+	var header *Header
+	err := c.failover(func(client Client) error {
+		var err error
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+
+	return header, err
+}
+
+// LatestFinalizedBlockNumber returns the latest finalized block number.
+// With a quorum, it returns the highest block number that at least quorum endpoints consider finalized.
+func (c *MultiClient) LatestFinalizedBlockNumber(ctx context.Context, confirmations uint64) (*big.Int, error) {
+	return c.finalizedBlockNumber(func(client Client) (*big.Int, error) {
+		return client.LatestFinalizedBlockNumber(ctx, confirmations)
+	})
+}
+
+// finalizedBlockNumber returns the block number f determines to be finalized.
+// With a quorum, f is applied to every endpoint and the highest block number that at least quorum endpoints consider finalized is returned.
+func (c *MultiClient) finalizedBlockNumber(f func(Client) (*big.Int, error)) (*big.Int, error) {
+	if c.quorum > 1 {
+		return c.quorumFinalizedBlockNumber(f)
+	}
+
+	var blockNumber *big.Int
+	err := c.failover(func(client Client) error {
+		var err error
+		blockNumber, err = f(client)
+		return err
+	})
+
+	return blockNumber, err
+}
+
+// Close closes the connections to all endpoints
+func (c *MultiClient) Close() {
+	slices.ForEach(c.endpoints, (*endpoint).close)
+}
+
+// ranked returns the endpoints ordered by health, healthiest first
+func (c *MultiClient) ranked() []*endpoint {
+	ranked := append([]*endpoint{}, c.endpoints...)
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].health() > ranked[j].health() })
+
+	return ranked
+}
+
+// failover calls f with the client of each endpoint in order of health until one succeeds.
+// ethereum.NotFound is treated as a lagging endpoint rather than an unhealthy one.
+func (c *MultiClient) failover(f func(Client) error) error {
+	var lastErr error
+	for _, e := range c.ranked() {
+		client, err := e.get()
+		if err != nil {
+			e.record(false)
+			lastErr = err
+			continue
+		}
+
+		err = f(client)
+		e.record(err == nil || errors.Is(err, ethereum.NotFound))
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+	}
+
+	return lastErr
+}
+
+// queryAll calls f with the client of every endpoint concurrently
+func queryAll[T any](endpoints []*endpoint, f func(Client) (T, error)) []results.Result[T] {
+	res := make([]results.Result[T], len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			client, err := e.get()
+			if err != nil {
+				res[i] = results.FromErr[T](err)
+				return
+			}
+
+			res[i] = results.New(f(client))
+		}()
+	}
+	wg.Wait()
+
+	return res
+}
+
+// tally groups the values by key and returns the key with the most votes along with its vote count
+func tally[K comparable](keys []K, valid []bool) (K, int) {
+	counts := make(map[K]int)
+	var winner K
+	for i, key := range keys {
+		if !valid[i] {
+			continue
+		}
+
+		counts[key]++
+		if counts[key] > counts[winner] {
+			winner = key
+		}
+	}
+
+	return winner, counts[winner]
+}
+
+func (c *MultiClient) quorumHeaderByNumber(ctx context.Context, number *big.Int) (*Header, error) {
+	res := queryAll(c.endpoints, func(client Client) (*Header, error) { return client.HeaderByNumber(ctx, number) })
+
+	keys := make([]common.Hash, len(res))
+	valid := make([]bool, len(res))
+	for i, header := range res {
+		switch {
+		case errors.Is(header.Err(), ethereum.NotFound):
+			valid[i] = true
+		case header.Err() == nil:
+			keys[i] = header.Ok().Hash
+			valid[i] = true
+		default:
+			c.endpoints[i].record(false)
+		}
+	}
+
+	winner, votes := tally(keys, valid)
+	for i := range res {
+		if valid[i] {
+			c.endpoints[i].record(keys[i] == winner)
+		}
+	}
+
+	if votes < c.quorum {
+		return nil, fmt.Errorf("%w: %d of %d endpoints agree on header %s", ErrNoQuorum, votes, c.quorum, toBlockNumArg(number))
+	}
+
+	if winner == (common.Hash{}) {
+		return nil, ethereum.NotFound
+	}
+
+	for i, header := range res {
+		if valid[i] && keys[i] == winner {
+			return header.Ok(), nil
+		}
+	}
+
+	panic("unreachable")
+}
+
+func (c *MultiClient) quorumFinalizedBlockNumber(f func(Client) (*big.Int, error)) (*big.Int, error) {
+	res := queryAll(c.endpoints, f)
+
+	var blockNumbers []*big.Int
+	for i, blockNumber := range res {
+		c.endpoints[i].record(blockNumber.Err() == nil)
+		if blockNumber.Err() == nil {
+			blockNumbers = append(blockNumbers, blockNumber.Ok())
+		}
+	}
+
+	if len(blockNumbers) < c.quorum {
+		return nil, fmt.Errorf("%w: %d of %d endpoints returned a finalized block", ErrNoQuorum, len(blockNumbers), c.quorum)
+	}
+
+	// each of the first quorum endpoints considers at least this block to be finalized
+	sort.Slice(blockNumbers, func(i, j int) bool { return blockNumbers[i].Cmp(blockNumbers[j]) > 0 })
+	return blockNumbers[c.quorum-1], nil
+}
+
+func (c *MultiClient) quorumTransactionReceipts(ctx context.Context, txHashes []common.Hash) ([]TxReceiptResult, error) {
+	res := queryAll(c.endpoints, func(client Client) ([]TxReceiptResult, error) {
+		receipts, err := client.TransactionReceipts(ctx, txHashes)
+		if err == nil && len(receipts) != len(txHashes) {
+			err = fmt.Errorf("expected %d receipts, got %d", len(txHashes), len(receipts))
+		}
+
+		return receipts, err
+	})
+
+	agrees := slices.Map(res, func(receipts results.Result[[]TxReceiptResult]) bool { return receipts.Err() == nil })
+
+	receipts := make([]TxReceiptResult, len(txHashes))
+	for i := range txHashes {
+		keys := make([]common.Hash, len(res))
+		valid := make([]bool, len(res))
+		for j, batch := range res {
+			if batch.Err() != nil {
+				continue
+			}
+
+			receipt := batch.Ok()[i]
+			switch {
+			case errors.Is(receipt.AsResult().Err(), ethereum.NotFound):
+				valid[j] = true
+			case receipt.AsResult().Err() == nil:
+				keys[j] = receiptKey(receipt.AsResult().Ok())
+				valid[j] = true
+			}
+		}
+
+		winner, votes := tally(keys, valid)
+		for j := range res {
+			if valid[j] && keys[j] != winner {
+				agrees[j] = false
+			}
+		}
+
+		switch {
+		case votes < c.quorum:
+			receipts[i] = TxReceiptResult(results.FromErr[types.Receipt](
+				fmt.Errorf("%w: %d of %d endpoints agree on the receipt of tx %s", ErrNoQuorum, votes, c.quorum, txHashes[i].Hex())))
+		case winner == (common.Hash{}):
+			receipts[i] = TxReceiptResult(results.FromErr[types.Receipt](ethereum.NotFound))
+		default:
+			for j, batch := range res {
+				if valid[j] && keys[j] == winner {
+					receipts[i] = batch.Ok()[i]
+					break
+				}
+			}
+		}
+	}
+
+	for i, e := range c.endpoints {
+		e.record(agrees[i])
+	}
+
+	if !slices.Any(res, func(batch results.Result[[]TxReceiptResult]) bool { return batch.Err() == nil }) {
+		return nil, fmt.Errorf("unable to get receipts from any endpoint: %w", res[0].Err())
+	}
+
+	return receipts, nil
+}
+
+// receiptKey identifies a receipt by all the fields vald relies on
+func receiptKey(receipt types.Receipt) common.Hash {
+	// the consensus encoding covers status, cumulative gas, bloom filter and logs
+	consensus, _ := receipt.MarshalBinary()
+	blockNumber := new(big.Int)
+	if receipt.BlockNumber != nil {
+		blockNumber = receipt.BlockNumber
+	}
+
+	return crypto.Keccak256Hash(
+		consensus,
+		receipt.TxHash.Bytes(),
+		receipt.BlockHash.Bytes(),
+		blockNumber.Bytes(),
+		new(big.Int).SetUint64(uint64(receipt.TransactionIndex)).Bytes(),
+	)
+}
@@ -0,0 +1,256 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/evm/rpc/mock"
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+func dialers(clients ...rpc.Client) []rpc.Dialer {
+	return slices.Map(clients, func(client rpc.Client) rpc.Dialer {
+		return func() (rpc.Client, error) { return client, nil }
+	})
+}
+
+func finalizedAt(blockNumber int64) *mock.ClientMock {
+	return &mock.ClientMock{
+		LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return big.NewInt(blockNumber), nil },
+		CloseFunc:                      func() {},
+	}
+}
+
+func failing() *mock.ClientMock {
+	return &mock.ClientMock{
+		LatestFinalizedBlockNumberFunc: func(context.Context, uint64) (*big.Int, error) { return nil, errors.New("unavailable") },
+		HeaderByNumberFunc:             func(context.Context, *big.Int) (*rpc.Header, error) { return nil, errors.New("unavailable") },
+		TransactionReceiptsFunc: func(context.Context, []common.Hash) ([]rpc.TxReceiptResult, error) {
+			return nil, errors.New("unavailable")
+		},
+		CloseFunc: func() {},
+	}
+}
+
+func withHeader(hash common.Hash) *mock.ClientMock {
+	return &mock.ClientMock{
+		HeaderByNumberFunc: func(_ context.Context, number *big.Int) (*rpc.Header, error) {
+			return &rpc.Header{Hash: hash, Number: (*hexutil.Big)(number)}, nil
+		},
+		CloseFunc: func() {},
+	}
+}
+
+func withReceipts(receipts map[common.Hash]types.Receipt) *mock.ClientMock {
+	return &mock.ClientMock{
+		TransactionReceiptsFunc: func(_ context.Context, txHashes []common.Hash) ([]rpc.TxReceiptResult, error) {
+			return slices.Map(txHashes, func(txHash common.Hash) rpc.TxReceiptResult {
+				receipt, ok := receipts[txHash]
+				if !ok {
+					return rpc.TxReceiptResult(results.FromErr[types.Receipt](ethereum.NotFound))
+				}
+
+				return rpc.TxReceiptResult(results.FromOk(receipt))
+			}), nil
+		},
+		CloseFunc: func() {},
+	}
+}
+
+func TestNewMultiClient(t *testing.T) {
+	t.Run("should reject a quorum larger than the number of endpoints", func(t *testing.T) {
+		_, err := rpc.NewMultiClient(dialers(finalizedAt(1), finalizedAt(1)), 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if fewer endpoints than the quorum are reachable", func(t *testing.T) {
+		unreachable := func() (rpc.Client, error) { return nil, errors.New("connection refused") }
+		_, err := rpc.NewMultiClient(append(dialers(finalizedAt(1)), unreachable, unreachable), 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("should tolerate unreachable endpoints as long as the quorum is reachable", func(t *testing.T) {
+		unreachable := func() (rpc.Client, error) { return nil, errors.New("connection refused") }
+		_, err := rpc.NewMultiClient(append(dialers(finalizedAt(1), finalizedAt(1)), unreachable), 2)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMultiClient_Failover(t *testing.T) {
+	t.Run("should fail over to the next endpoint", func(t *testing.T) {
+		unhealthy := failing()
+		client, err := rpc.NewMultiClient(dialers(unhealthy, finalizedAt(100)), 0)
+		require.NoError(t, err)
+
+		blockNumber, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), blockNumber)
+		assert.Len(t, unhealthy.LatestFinalizedBlockNumberCalls(), 1)
+	})
+
+	t.Run("should prefer healthy endpoints", func(t *testing.T) {
+		unhealthy := failing()
+		healthy := finalizedAt(100)
+		client, err := rpc.NewMultiClient(dialers(unhealthy, healthy), 0)
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			_, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+			assert.NoError(t, err)
+		}
+
+		assert.Len(t, unhealthy.LatestFinalizedBlockNumberCalls(), 1)
+		assert.Len(t, healthy.LatestFinalizedBlockNumberCalls(), 5)
+	})
+
+	t.Run("should return an error if all endpoints fail", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(failing(), failing()), 0)
+		require.NoError(t, err)
+
+		_, err = client.HeaderByNumber(context.Background(), big.NewInt(1))
+		assert.Error(t, err)
+	})
+
+	t.Run("should look up missing receipts on a lagging endpoint's peers", func(t *testing.T) {
+		txA, txB := common.HexToHash("0xa"), common.HexToHash("0xb")
+		lagging := withReceipts(map[common.Hash]types.Receipt{txA: {TxHash: txA}})
+		synced := withReceipts(map[common.Hash]types.Receipt{txA: {TxHash: txA}, txB: {TxHash: txB}})
+
+		client, err := rpc.NewMultiClient(dialers(lagging, synced), 0)
+		require.NoError(t, err)
+
+		receipts, err := client.TransactionReceipts(context.Background(), []common.Hash{txA, txB})
+		assert.NoError(t, err)
+		assert.Equal(t, txA, receipts[0].AsResult().Ok().TxHash)
+		assert.Equal(t, txB, receipts[1].AsResult().Ok().TxHash)
+		assert.Equal(t, []common.Hash{txB}, synced.TransactionReceiptsCalls()[0].TxHashes)
+	})
+}
+
+func TestMultiClient_Quorum(t *testing.T) {
+	t.Run("should return the highest block finalized on a quorum of endpoints", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(finalizedAt(100), finalizedAt(120), finalizedAt(110)), 2)
+		require.NoError(t, err)
+
+		blockNumber, err := client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(110), blockNumber)
+	})
+
+	t.Run("should fail if not enough endpoints return the latest finalized block", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(finalizedAt(100), failing(), failing()), 2)
+		require.NoError(t, err)
+
+		_, err = client.LatestFinalizedBlockNumber(context.Background(), 1)
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+
+	t.Run("should return the header a quorum of endpoints agrees on", func(t *testing.T) {
+		honest := common.HexToHash("0x1")
+		client, err := rpc.NewMultiClient(dialers(withHeader(honest), withHeader(common.HexToHash("0x2")), withHeader(honest)), 2)
+		require.NoError(t, err)
+
+		header, err := client.HeaderByNumber(context.Background(), big.NewInt(1))
+		assert.NoError(t, err)
+		assert.Equal(t, honest, header.Hash)
+	})
+
+	t.Run("should fail if endpoints disagree on the header", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(withHeader(common.HexToHash("0x1")), withHeader(common.HexToHash("0x2"))), 2)
+		require.NoError(t, err)
+
+		_, err = client.HeaderByNumber(context.Background(), big.NewInt(1))
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+
+	t.Run("should only accept receipts a quorum of endpoints agrees on", func(t *testing.T) {
+		txA, txB, txC := common.HexToHash("0xa"), common.HexToHash("0xb"), common.HexToHash("0xc")
+		honest := types.Receipt{TxHash: txA, Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10)}
+		forged := types.Receipt{TxHash: txA, Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10),
+			Logs: []*types.Log{{Address: common.HexToAddress("0xdead")}}}
+		disputed := types.Receipt{TxHash: txB, Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(11)}
+
+		client, err := rpc.NewMultiClient(dialers(
+			withReceipts(map[common.Hash]types.Receipt{txA: honest, txB: disputed}),
+			withReceipts(map[common.Hash]types.Receipt{txA: forged}),
+			withReceipts(map[common.Hash]types.Receipt{txA: honest}),
+		), 2)
+		require.NoError(t, err)
+
+		receipts, err := client.TransactionReceipts(context.Background(), []common.Hash{txA, txB, txC})
+		assert.NoError(t, err)
+		assert.NoError(t, receipts[0].AsResult().Err())
+		assert.Empty(t, receipts[0].AsResult().Ok().Logs)
+		assert.ErrorIs(t, receipts[1].AsResult().Err(), ethereum.NotFound)
+		assert.ErrorIs(t, receipts[2].AsResult().Err(), ethereum.NotFound)
+	})
+
+	t.Run("should not reach quorum on receipts if endpoints are unavailable", func(t *testing.T) {
+		txA := common.HexToHash("0xa")
+		receipt := types.Receipt{TxHash: txA, BlockNumber: big.NewInt(10)}
+
+		client, err := rpc.NewMultiClient(dialers(
+			withReceipts(map[common.Hash]types.Receipt{txA: receipt}),
+			failing(),
+			withReceipts(map[common.Hash]types.Receipt{}),
+		), 2)
+		require.NoError(t, err)
+
+		receipts, err := client.TransactionReceipts(context.Background(), []common.Hash{txA})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, receipts[0].AsResult().Err(), rpc.ErrNoQuorum)
+	})
+}
+
+func TestMultiClient_QuorumFinality(t *testing.T) {
+	headers := func(latest, finalized int64) *mock.ClientMock {
+		return newHeadersClient(map[ethrpc.BlockNumber]int64{ethrpc.LatestBlockNumber: latest, ethrpc.FinalizedBlockNumber: finalized})
+	}
+
+	t.Run("should apply the confirmation strategy to each endpoint and return the block confirmed on a quorum of them", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(headers(100, 80), headers(102, 80), headers(90, 70)), 2)
+		require.NoError(t, err)
+
+		strategy, err := rpc.NewFinalityStrategy(rpc.ConfirmationFinality)
+		require.NoError(t, err)
+
+		blockNumber, err := strategy.LatestFinalizedBlockNumber(context.Background(), rpc.WithMetrics(client, "chain"), 10)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(91), blockNumber)
+	})
+
+	t.Run("should return the tagged block of a quorum of endpoints even if their latest headers differ", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(headers(100, 80), headers(102, 85), headers(90, 70)), 2)
+		require.NoError(t, err)
+
+		strategy, err := rpc.NewFinalityStrategy(rpc.FinalizedTagFinality)
+		require.NoError(t, err)
+
+		blockNumber, err := strategy.LatestFinalizedBlockNumber(context.Background(), client, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(80), blockNumber)
+	})
+
+	t.Run("should not trust a single endpoint with the hybrid strategy", func(t *testing.T) {
+		client, err := rpc.NewMultiClient(dialers(headers(1000, 990), failing(), failing()), 2)
+		require.NoError(t, err)
+
+		strategy, err := rpc.NewFinalityStrategy(rpc.HybridFinality)
+		require.NoError(t, err)
+
+		_, err = strategy.LatestFinalizedBlockNumber(context.Background(), client, 10)
+		assert.ErrorIs(t, err, rpc.ErrNoQuorum)
+	})
+}
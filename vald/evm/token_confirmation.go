@@ -20,6 +20,7 @@ func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error
 	}
 
 	var vote *voteTypes.VoteRequest
+	var eventCount int
 
 	txReceipt, err := mgr.GetTxReceiptIfFinalized(event.Chain, common.Hash(event.TxID), event.ConfirmationHeight)
 	if err != nil {
@@ -32,11 +33,13 @@ func (mgr Mgr) ProcessTokenConfirmation(event *types.ConfirmTokenStarted) error
 	} else {
 		events := mgr.processTokenConfirmationLogs(event, txReceipt.Ok().Logs)
 		vote = voteTypes.NewVoteRequest(mgr.proxy, event.PollID, types.NewVoteEvents(event.Chain, events...))
+		eventCount = len(events)
 
 		mgr.logger().Infof("broadcasting vote %v for poll %s", events, event.PollID.String())
 	}
 
 	_, err = mgr.broadcaster.Broadcast(context.TODO(), vote)
+	recordVotes(event.Chain, pollTypeTokenConfirmation, err, eventCount)
 
 	return err
 }
@@ -99,7 +99,7 @@ func TestMgr_ProccessTokenConfirmation(t *testing.T) {
 		}
 		evmMap := make(map[string]evmrpc.Client)
 		evmMap["ethereum"] = rpc
-		mgr = evm.NewMgr(evmMap, broadcaster, valAddr, rand.AccAddr(), &evmmock.LatestFinalizedBlockCacheMock{
+		mgr = evm.NewMgr(evmMap, nil, broadcaster, valAddr, rand.AccAddr(), &evmmock.LatestFinalizedBlockCacheMock{
 			GetFunc: func(_ nexus.ChainName) *big.Int { return big.NewInt(0) },
 			SetFunc: func(_ nexus.ChainName, _ *big.Int) {},
 		})
@@ -250,7 +250,7 @@ func TestMgr_ProcessTokenConfirmationNoTopicsNotPanics(t *testing.T) {
 	}}
 
 	valAddr := rand.ValAddr()
-	mgr := evm.NewMgr(map[string]evmrpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr(), cache)
+	mgr := evm.NewMgr(map[string]evmrpc.Client{chain.String(): rpcClient}, nil, broadcaster, valAddr, rand.AccAddr(), cache)
 
 	assert.NotPanics(t, func() {
 		mgr.ProcessTokenConfirmation(&types.ConfirmTokenStarted{TxID: types.Hash{1},
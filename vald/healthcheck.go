@@ -15,15 +15,12 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/axelarnetwork/axelar-core/vald/config"
-	"github.com/axelarnetwork/axelar-core/vald/tss"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	snapshotTypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
 )
 
 const (
-	keyID      = "testkey"
 	minBalance = 5000000
 	timeout    = time.Hour
 
@@ -50,7 +47,13 @@ func GetHealthCheckCommand() *cobra.Command {
 			}
 			serverCtx := server.GetServerContextFromCmd(cmd)
 
-			ok := execCheck(context.Background(), clientCtx, serverCtx, "tofnd", skipTofnd, checkTofnd) &&
+			// the check is named after the active signer backend, i.e. "tofnd" or "local"
+			signerBackend := serverCtx.Viper.GetString("tss." + flagSignerBackend)
+			if signerBackend == "" {
+				signerBackend = tssTypes.DefaultConfig().SignerBackend
+			}
+
+			ok := execCheck(context.Background(), clientCtx, serverCtx, signerBackend, skipTofnd, checkSigner) &&
 				execCheck(cmd.Context(), clientCtx, serverCtx, "broadcaster", skipBroadcaster, checkBroadcaster)
 
 			// enforce a non-zero exit code in case health checks fail without printing cobra output
@@ -66,7 +69,7 @@ func GetHealthCheckCommand() *cobra.Command {
 	cmd.PersistentFlags().String(flagTofndHost, defaultConf.Host, "host name for tss daemon")
 	cmd.PersistentFlags().String(flagTofndPort, defaultConf.Port, "port for tss daemon")
 	cmd.PersistentFlags().String(flagOperatorAddr, "", "operator address")
-	cmd.PersistentFlags().BoolVar(&skipTofnd, flagSkipTofnd, false, "skip tofnd check")
+	cmd.PersistentFlags().BoolVar(&skipTofnd, flagSkipTofnd, false, "skip signer backend check (tofnd or local)")
 	cmd.PersistentFlags().BoolVar(&skipBroadcaster, flagSkipBroadcaster, false, "skip broadcaster check")
 	cmd.PersistentFlags().BoolVar(&skipOperator, flagSkipOperator, false, "skip operator check")
 
@@ -92,42 +95,21 @@ func execCheck(ctx context.Context, clientCtx client.Context, serverCtx *server.
 	return true
 }
 
-func checkTofnd(ctx context.Context, _ client.Context, serverCtx *server.Context) error {
+func checkSigner(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
 	valdCfg := config.DefaultValdConfig()
 	if err := serverCtx.Viper.Unmarshal(&valdCfg); err != nil {
 		panic(err)
 	}
 
-	conn, err := tss.Connect(valdCfg.TssConfig.Host, valdCfg.TssConfig.Port, valdCfg.TssConfig.DialTimeout)
+	signer, err := createSigner(valdCfg.TssConfig, clientCtx.HomeDir, nil)
 	if err != nil {
-		return fmt.Errorf("failed to reach tofnd: %s", err.Error())
+		return err
 	}
 
-	// creates client to communicate with the external tofnd process multisig service
-	client := tofnd.NewMultisigClient(conn)
-
 	grpcCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	request := &tofnd.KeyPresenceRequest{
-		// we do not need to look for a key ID that exists to obtain a successful healthcheck,
-		// all we need to do is obtain err == nil && response != FAIL
-		// TODO: this kind of check should have its own dedicated GRPC
-		KeyUid: keyID,
-	}
-
-	response, err := client.KeyPresence(grpcCtx, request)
-
-	if err != nil {
-		return fmt.Errorf("failed to invoke tofnd grpc: %s", err.Error())
-	}
-
-	if response.Response == tofnd.RESPONSE_FAIL ||
-		response.Response == tofnd.RESPONSE_UNSPECIFIED {
-		return fmt.Errorf("obtained FAIL response, tofnd not properly configured")
-	}
-
-	return nil
+	return signer.HealthCheck(grpcCtx)
 }
 
 func checkBroadcaster(ctx context.Context, clientCtx client.Context, serverCtx *server.Context) error {
@@ -0,0 +1,250 @@
+package vald
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	multisigExported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexusTypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+// key verification statuses
+const (
+	keyVerified       = "ok"
+	keyMismatch       = "mismatch"
+	keyMissing        = "missing"
+	keyNotFound       = "not_found"
+	keyNotParticipant = "not_participant"
+)
+
+// GetKeysCommand returns the command to inspect the multisig key shares held by the signer backend of vald
+func GetKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-keys",
+		Short: "Inspect the multisig key shares held by the signer backend of vald",
+	}
+
+	cmd.AddCommand(getKeysVerifyCommand())
+
+	return cmd
+}
+
+func getKeysVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [key-id...]",
+		Short: "Verify that the signer backend holds the key shares matching the public keys registered on chain for the validator",
+		Long: "Verify that the signer backend holds the key shares matching the public keys registered on chain for the validator, e.g. after restoring a backup. " +
+			"Each share is verified by signing a random challenge and checking the signature against the registered public key. " +
+			"If no key ID is given, all active keys and the next keys of all chains that the validator participates in are verified.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			log.Setup(serverCtx.Logger.With("module", "vald-keys"))
+			v := serverCtx.Viper
+
+			if err := bindTssFlags(v, cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := sdkClient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valdConf := config.DefaultValdConfig()
+			if err := v.Unmarshal(&valdConf, config.AddDecodeHooks); err != nil {
+				panic(err)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(v.GetString("validator-addr"))
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid validator operator address")
+			}
+
+			keyIDs := make([]multisigExported.KeyID, 0, len(args))
+			for _, arg := range args {
+				keyID := multisigExported.KeyID(arg)
+				if err := keyID.ValidateBasic(); err != nil {
+					return err
+				}
+
+				keyIDs = append(keyIDs, keyID)
+			}
+
+			if len(keyIDs) == 0 {
+				keyIDs, err = queryChainKeyIDs(cmd.Context(), clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+
+			signer, err := createSigner(valdConf.TssConfig, clientCtx.HomeDir, valAddr)
+			if err != nil {
+				return err
+			}
+
+			report, err := verifyKeys(cmd.Context(), clientCtx, signer, valAddr, keyIDs, len(args) > 0)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\n", funcs.Must(json.MarshalIndent(report, "", "  ")))
+
+			if failed := report.Summary[keyMismatch] + report.Summary[keyMissing]; failed > 0 {
+				return fmt.Errorf("%d key share(s) failed verification", failed)
+			}
+
+			return nil
+		},
+	}
+
+	setPersistentFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+type keyVerificationEntry struct {
+	KeyID   multisigExported.KeyID `json:"key_id"`
+	KeyType string                 `json:"key_type,omitempty"`
+	PubKey  string                 `json:"pub_key,omitempty"`
+	Status  string                 `json:"status"`
+	Error   string                 `json:"error,omitempty"`
+}
+
+type keyVerificationReport struct {
+	Validator string                 `json:"validator"`
+	Summary   map[string]int         `json:"summary"`
+	Entries   []keyVerificationEntry `json:"entries"`
+}
+
+// queryChainKeyIDs returns the IDs of the active and next keys of all chains, without duplicates
+func queryChainKeyIDs(ctx context.Context, clientCtx sdkClient.Context) ([]multisigExported.KeyID, error) {
+	chains, err := nexusTypes.NewQueryServiceClient(clientCtx).Chains(ctx, &nexusTypes.ChainsRequest{})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to query chains")
+	}
+
+	queryClient := multisigTypes.NewQueryServiceClient(clientCtx)
+	seen := make(map[multisigExported.KeyID]bool)
+	var keyIDs []multisigExported.KeyID
+	add := func(keyID multisigExported.KeyID) {
+		if !seen[keyID] {
+			seen[keyID] = true
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+
+	for _, chain := range chains.Chains {
+		res, err := queryClient.ActiveKeyIDs(ctx, &multisigTypes.ActiveKeyIDsRequest{Chain: chain.String()})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to query the active key IDs of chain %s", chain)
+		}
+
+		for _, keyID := range res.KeyIDs {
+			add(keyID)
+		}
+
+		next, err := queryClient.NextKeyID(ctx, &multisigTypes.NextKeyIDRequest{Chain: chain.String()})
+		switch {
+		case status.Code(err) == codes.NotFound:
+		case err != nil:
+			return nil, errorsmod.Wrapf(err, "failed to query the next key ID of chain %s", chain)
+		default:
+			add(next.KeyID)
+		}
+	}
+
+	return keyIDs, nil
+}
+
+// verifyKeys verifies the validator's share of each given key. Keys the validator does not participate in are only
+// reported if they were requested explicitly
+func verifyKeys(ctx context.Context, clientCtx sdkClient.Context, signer multisig.Signer, valAddr sdk.ValAddress, keyIDs []multisigExported.KeyID, explicit bool) (keyVerificationReport, error) {
+	queryClient := multisigTypes.NewQueryServiceClient(clientCtx)
+	report := keyVerificationReport{
+		Validator: valAddr.String(),
+		Summary:   make(map[string]int),
+		Entries:   []keyVerificationEntry{},
+	}
+
+	for _, keyID := range keyIDs {
+		entry := keyVerificationEntry{KeyID: keyID}
+
+		res, err := queryClient.Key(ctx, &multisigTypes.KeyRequest{KeyID: keyID})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			entry.Status = keyNotFound
+			entry.Error = err.Error()
+			report.add(entry)
+			continue
+		case err != nil:
+			return keyVerificationReport{}, errorsmod.Wrapf(err, "failed to query key %s", keyID)
+		}
+		entry.KeyType = res.KeyType.String()
+
+		var pubKeyHex string
+		for _, participant := range res.Participants {
+			if participant.Address == valAddr.String() {
+				pubKeyHex = participant.PubKey
+				break
+			}
+		}
+
+		if pubKeyHex == "" {
+			if explicit {
+				entry.Status = keyNotParticipant
+				report.add(entry)
+			}
+			continue
+		}
+		entry.PubKey = pubKeyHex
+
+		pubKey, err := utils.HexDecode(pubKeyHex)
+		if err != nil {
+			entry.Status = keyMismatch
+			entry.Error = err.Error()
+			report.add(entry)
+			continue
+		}
+
+		err = multisig.VerifyKeyShare(ctx, signer, keyID, res.KeyType, pubKey)
+		switch {
+		case err == nil:
+			entry.Status = keyVerified
+		case errors.Is(err, multisig.ErrPubKeyMismatch):
+			entry.Status = keyMismatch
+			entry.Error = err.Error()
+		default:
+			entry.Status = keyMissing
+			entry.Error = err.Error()
+		}
+
+		if entry.Status != keyVerified {
+			log.Errorf("key share of %s failed verification: %s", keyID, entry.Error)
+		}
+		report.add(entry)
+	}
+
+	return report, nil
+}
+
+func (r *keyVerificationReport) add(entry keyVerificationEntry) {
+	r.Entries = append(r.Entries, entry)
+	r.Summary[entry.Status]++
+}
@@ -0,0 +1,80 @@
+package vald
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/utils/jobs"
+	"github.com/axelarnetwork/utils/log"
+)
+
+var lastProcessedBlock = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "vald",
+	Name:      "last_processed_block_height",
+	Help:      "Height of the latest block for which all events have been processed",
+})
+
+// newMetricsRegistry returns a registry with the collectors of all vald components
+func newMetricsRegistry() (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+
+	cs := []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		lastProcessedBlock,
+	}
+	cs = append(cs, evm.Collectors()...)
+	cs = append(cs, evmRPC.Collectors()...)
+	cs = append(cs, multisig.Collectors()...)
+	cs = append(cs, broadcast.Collectors()...)
+
+	for _, c := range cs {
+		if err := registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// createMetricsServer starts listening on the given address and returns a job that serves the metrics of the registry at /metrics
+func createMetricsServer(addr string, gatherer prometheus.Gatherer) (jobs.Job, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	return func(ctx context.Context) error {
+		go func() {
+			<-ctx.Done()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				log.Errorf("failed to shut down metrics server: %s", err.Error())
+			}
+		}()
+
+		log.Infof("serving metrics at %s/metrics", listener.Addr().String())
+		if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	}, nil
+}
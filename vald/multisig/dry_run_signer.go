@@ -0,0 +1,39 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+)
+
+var _ Signer = DryRunSigner{}
+
+// DryRunSigner never touches any key shares. Instead of signing, it returns the payload hash it was asked to sign,
+// so the resulting signature msgs show which payload would have been signed.
+type DryRunSigner struct{}
+
+// NewDryRunSigner returns a signer that does not sign anything
+func NewDryRunSigner() DryRunSigner {
+	return DryRunSigner{}
+}
+
+// Keygen implements Signer
+func (s DryRunSigner) Keygen(_ context.Context, _ string, _ exported.KeyType) (exported.PublicKey, error) {
+	return nil, errors.New("dry-run signer cannot generate keys")
+}
+
+// Sign implements Signer
+func (s DryRunSigner) Sign(_ context.Context, _ string, keyType exported.KeyType, payloadHash exported.Hash, _ exported.PublicKey) (types.Signature, error) {
+	if err := keyType.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return types.Signature(payloadHash), nil
+}
+
+// HealthCheck implements Signer
+func (s DryRunSigner) HealthCheck(_ context.Context) error {
+	return nil
+}
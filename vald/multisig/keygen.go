@@ -3,7 +3,6 @@ package multisig
 import (
 	"context"
 	"crypto/sha256"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -18,16 +17,16 @@ func (mgr *Mgr) ProcessKeygenStarted(event *types.KeygenStarted) error {
 		return nil
 	}
 
-	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
+	keyUID := KeyUID(event.GetKeyID())
 	partyUID := mgr.participant.String()
 
-	pubKey, err := mgr.generateKey(keyUID)
+	pubKey, err := mgr.generateKey(keyUID, event.GetKeyType())
 	if err != nil {
 		return err
 	}
 
 	payloadHash := sha256.Sum256([]byte(mgr.ctx.FromAddress.String()))
-	sig, err := mgr.sign(keyUID, payloadHash[:], pubKey)
+	sig, err := mgr.sign(keyUID, event.GetKeyType(), payloadHash[:], pubKey)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package multisig_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/vald/multisig"
 	"github.com/axelarnetwork/axelar-core/vald/multisig/mock"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
@@ -41,7 +43,8 @@ func TestMgr_ProcessKeygenStarted(t *testing.T) {
 		participant = rand.ValAddr()
 
 		mgr = multisig.NewMgr(
-			client,
+			multisig.NewTofndSigner(client, participant),
+			nil,
 			sdkclient.Context{FromAddress: rand.AccAddr()},
 			participant,
 			broadcaster,
@@ -51,7 +54,7 @@ func TestMgr_ProcessKeygenStarted(t *testing.T) {
 
 	givenMgr.
 		When("is not part of the listed participants", func() {
-			event = types.NewKeygenStarted(testutils.KeyID(), slices.Expand(func(int) sdk.ValAddress { return rand.ValAddr() }, 10))
+			event = types.NewKeygenStarted(testutils.KeyID(), exported.ECDSA, slices.Expand(func(int) sdk.ValAddress { return rand.ValAddr() }, 10))
 		}).
 		Then("should ignore", func(t *testing.T) {
 			err := mgr.ProcessKeygenStarted(event)
@@ -62,7 +65,7 @@ func TestMgr_ProcessKeygenStarted(t *testing.T) {
 
 	givenMgr.
 		When("is part of the listed participants", func() {
-			event = types.NewKeygenStarted(testutils.KeyID(), []sdk.ValAddress{rand.ValAddr(), participant, rand.ValAddr()})
+			event = types.NewKeygenStarted(testutils.KeyID(), exported.ECDSA, []sdk.ValAddress{rand.ValAddr(), participant, rand.ValAddr()})
 		}).
 		Then("should handle", func(t *testing.T) {
 			sk := funcs.Must(btcec.NewPrivateKey())
@@ -89,4 +92,27 @@ func TestMgr_ProcessKeygenStarted(t *testing.T) {
 			assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 1)
 		}).
 		Run(t)
+
+	givenMgr.
+		When("an ed25519 key is generated", func() {
+			event = types.NewKeygenStarted(testutils.KeyID(), exported.Ed25519, []sdk.ValAddress{participant})
+		}).
+		Then("should request the ed25519 algorithm from tofnd", func(t *testing.T) {
+			pk, sk, err := ed25519.GenerateKey(nil)
+			assert.NoError(t, err)
+			client.KeygenFunc = func(_ context.Context, in *tofnd.KeygenRequest, _ ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+				return &tofnd.KeygenResponse{KeygenResponse: &tofnd.KeygenResponse_PubKey{PubKey: pk}}, nil
+			}
+			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ed25519.Sign(sk, in.MsgToSign)}}, nil
+			}
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+
+			assert.NoError(t, mgr.ProcessKeygenStarted(event))
+
+			assert.Equal(t, tofnd.Algorithm_ALGORITHM_ED25519, client.KeygenCalls()[0].In.Algorithm)
+			assert.Equal(t, tofnd.Algorithm_ALGORITHM_ED25519, client.SignCalls()[0].In.Algorithm)
+			assert.NoError(t, broadcaster.BroadcastCalls()[0].Msgs[0].(*types.SubmitPubKeyRequest).ValidateBasic())
+		}).
+		Run(t)
 }
@@ -0,0 +1,226 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+)
+
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 32
+)
+
+var _ Signer = &LocalSigner{}
+
+// LocalSigner is an in-process signer that derives all key shares from a single mnemonic.
+// It is meant for devnets and CI where running a separate tofnd process is impractical.
+// Every key is derived deterministically from the mnemonic, the key type and the key UID,
+// so keys can be re-derived at any time from the same mnemonic.
+type LocalSigner struct {
+	seed []byte
+}
+
+// NewLocalSigner returns a local signer deriving its keys from the given bip39 mnemonic
+func NewLocalSigner(mnemonic string) (*LocalSigner, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	return &LocalSigner{seed: seed}, nil
+}
+
+// Keygen implements Signer
+func (s *LocalSigner) Keygen(_ context.Context, keyUID string, keyType exported.KeyType) (exported.PublicKey, error) {
+	if err := keyType.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return s.derivePubKey(keyUID, keyType), nil
+}
+
+// Sign implements Signer
+func (s *LocalSigner) Sign(_ context.Context, keyUID string, keyType exported.KeyType, payloadHash exported.Hash, pubKey exported.PublicKey) (types.Signature, error) {
+	if err := keyType.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if derived := s.derivePubKey(keyUID, keyType); !bytes.Equal(derived, pubKey) {
+		return nil, fmt.Errorf("%w: key %s derives public key %x instead of the expected %x", ErrPubKeyMismatch, keyUID, []byte(derived), []byte(pubKey))
+	}
+
+	secret := s.deriveSecret(keyUID, keyType)
+	switch keyType {
+	case exported.ECDSA:
+		sk, _ := btcec.PrivKeyFromBytes(secret)
+		return ec.Sign(sk, payloadHash).Serialize(), nil
+	case exported.Ed25519:
+		return ed25519.Sign(ed25519.NewKeyFromSeed(secret), payloadHash), nil
+	case exported.Schnorr:
+		sk, _ := btcec.PrivKeyFromBytes(secret)
+		sig, err := schnorr.Sign(sk, payloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		return sig.Serialize(), nil
+	default:
+		return nil, fmt.Errorf("unknown key type %s", keyType)
+	}
+}
+
+// HealthCheck implements Signer
+func (s *LocalSigner) HealthCheck(_ context.Context) error {
+	if len(s.seed) == 0 {
+		return fmt.Errorf("local signer has no seed")
+	}
+
+	return nil
+}
+
+func (s *LocalSigner) derivePubKey(keyUID string, keyType exported.KeyType) exported.PublicKey {
+	secret := s.deriveSecret(keyUID, keyType)
+
+	switch keyType {
+	case exported.ECDSA:
+		_, pk := btcec.PrivKeyFromBytes(secret)
+		return pk.SerializeCompressed()
+	case exported.Ed25519:
+		return exported.PublicKey(ed25519.NewKeyFromSeed(secret).Public().(ed25519.PublicKey))
+	case exported.Schnorr:
+		_, pk := btcec.PrivKeyFromBytes(secret)
+		return schnorr.SerializePubKey(pk)
+	default:
+		panic(fmt.Errorf("unknown key type %s", keyType))
+	}
+}
+
+// deriveSecret returns the 32 byte secret of the given key, domain separated by key type
+func (s *LocalSigner) deriveSecret(keyUID string, keyType exported.KeyType) []byte {
+	mac := hmac.New(sha256.New, s.seed)
+	mac.Write([]byte(keyType.String()))
+	mac.Write([]byte{0})
+	mac.Write([]byte(keyUID))
+
+	return mac.Sum(nil)
+}
+
+// localSignerFile is the on-disk format of the encrypted mnemonic of the local signer
+type localSignerFile struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// WriteLocalSignerFile encrypts the mnemonic with the password and writes it to the given path.
+// It fails if the file already exists, so an existing mnemonic is never overwritten by accident.
+func WriteLocalSignerFile(path string, mnemonic string, password string) error {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return fmt.Errorf("invalid mnemonic")
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(password, salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(localSignerFile{
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, []byte(mnemonic), nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// LoadLocalSigner decrypts the mnemonic file at the given path and returns the corresponding local signer
+func LoadLocalSigner(path string, password string) (*LocalSigner, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local signer file: %w", err)
+	}
+
+	var file localSignerFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse local signer file %s: %w", path, err)
+	}
+
+	aead, err := newAEAD(password, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("local signer file %s is corrupted", path)
+	}
+
+	mnemonic, err := aead.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt local signer file %s, wrong password?", path)
+	}
+
+	return NewLocalSigner(string(mnemonic))
+}
+
+func newAEAD(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,97 @@
+package multisig_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestLocalSigner(t *testing.T) {
+	var (
+		mnemonic string
+		signer   *multisig.LocalSigner
+		keyUID   string
+	)
+
+	ctx := context.Background()
+
+	givenLocalSigner := Given("a local signer", func() {
+		mnemonic = funcs.Must(bip39.NewMnemonic(funcs.Must(bip39.NewEntropy(256))))
+		signer = funcs.Must(multisig.NewLocalSigner(mnemonic))
+		keyUID = rand.NormalizedStr(10) + "_0"
+	})
+
+	for _, keyType := range exported.KeyTypes() {
+		givenLocalSigner.
+			When("generating a "+keyType.String()+" key", func() {}).
+			Branch(
+				Then("should derive the same public key every time", func(t *testing.T) {
+					pubKey := funcs.Must(signer.Keygen(ctx, keyUID, keyType))
+					assert.NoError(t, keyType.ValidatePubKey(pubKey))
+
+					recovered := funcs.Must(multisig.NewLocalSigner(mnemonic))
+					assert.Equal(t, pubKey, funcs.Must(recovered.Keygen(ctx, keyUID, keyType)))
+				}),
+				Then("should derive different keys for different key UIDs", func(t *testing.T) {
+					assert.NotEqual(t, funcs.Must(signer.Keygen(ctx, keyUID, keyType)), funcs.Must(signer.Keygen(ctx, rand.NormalizedStr(10)+"_0", keyType)))
+				}),
+				Then("should produce valid signatures", func(t *testing.T) {
+					pubKey := funcs.Must(signer.Keygen(ctx, keyUID, keyType))
+					payloadHash := exported.Hash(rand.Bytes(exported.HashLength))
+
+					sig, err := signer.Sign(ctx, keyUID, keyType, payloadHash, pubKey)
+					assert.NoError(t, err)
+					assert.True(t, types.Signature(sig).Verify(keyType, payloadHash, pubKey))
+				}),
+				Then("should refuse to sign for a mismatching public key", func(t *testing.T) {
+					pubKey := funcs.Must(signer.Keygen(ctx, rand.NormalizedStr(10)+"_0", keyType))
+
+					_, err := signer.Sign(ctx, keyUID, keyType, rand.Bytes(exported.HashLength), pubKey)
+					assert.ErrorIs(t, err, multisig.ErrPubKeyMismatch)
+				}),
+			).
+			Run(t)
+	}
+
+	givenLocalSigner.
+		When("the mnemonic is written to an encrypted file", func() {}).
+		Branch(
+			Then("should load the same signer with the right password", func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "vald", "local_signer.json")
+				assert.NoError(t, multisig.WriteLocalSignerFile(path, mnemonic, "password"))
+
+				loaded, err := multisig.LoadLocalSigner(path, "password")
+				assert.NoError(t, err)
+				assert.Equal(t, funcs.Must(signer.Keygen(ctx, keyUID, exported.ECDSA)), funcs.Must(loaded.Keygen(ctx, keyUID, exported.ECDSA)))
+				assert.NoError(t, loaded.HealthCheck(ctx))
+			}),
+			Then("should fail to load with a wrong password", func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "local_signer.json")
+				assert.NoError(t, multisig.WriteLocalSignerFile(path, mnemonic, "password"))
+
+				_, err := multisig.LoadLocalSigner(path, "wrong")
+				assert.ErrorContains(t, err, "failed to decrypt")
+			}),
+			Then("should not overwrite an existing file", func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "local_signer.json")
+				assert.NoError(t, multisig.WriteLocalSignerFile(path, mnemonic, "password"))
+				assert.Error(t, multisig.WriteLocalSignerFile(path, mnemonic, "password"))
+			}),
+		).
+		Run(t)
+
+	t.Run("invalid mnemonic", func(t *testing.T) {
+		_, err := multisig.NewLocalSigner("not a valid mnemonic")
+		assert.Error(t, err)
+	})
+}
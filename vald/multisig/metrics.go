@@ -0,0 +1,42 @@
+package multisig
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "vald",
+		Subsystem: "multisig",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of keygen and sign requests to tofnd",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"operation"})
+	requestFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "multisig",
+		Name:      "request_failures_total",
+		Help:      "Number of keygen and sign requests to tofnd that returned an error",
+	}, []string{"operation"})
+	signingRefusals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "vald",
+		Subsystem: "multisig",
+		Name:      "signing_refusals_total",
+		Help:      "Number of signing requests refused by the signing policy, by requesting module and reason",
+	}, []string{"requesting_module", "reason"})
+)
+
+// Collectors returns the prometheus collectors of the multisig manager
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{requestDuration, requestFailures, signingRefusals}
+}
+
+// observe records the time elapsed since start for the given operation, and counts it as failed if err is not nil
+func observe(operation string, start time.Time, err error) {
+	requestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		requestFailures.WithLabelValues(operation).Inc()
+	}
+}
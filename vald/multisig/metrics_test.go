@@ -0,0 +1,166 @@
+package multisig_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	broadcastmock "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/multisig/mock"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	typestestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMgr_Metrics(t *testing.T) {
+	var (
+		mgr         *multisig.Mgr
+		participant sdk.ValAddress
+		client      *mock.ClientMock
+		policy      *mock.SigningPolicyMock
+		broadcaster *broadcastmock.BroadcasterMock
+
+		before map[string]float64
+	)
+
+	requestCount := func(t *testing.T, operation string) float64 {
+		labels := map[string]string{"operation": operation}
+		return float64(gatherMetric(t, "vald_multisig_request_duration_seconds", labels).GetHistogram().GetSampleCount())
+	}
+	requestFailures := func(t *testing.T, operation string) float64 {
+		labels := map[string]string{"operation": operation}
+		return gatherMetric(t, "vald_multisig_request_failures_total", labels).GetCounter().GetValue()
+	}
+
+	givenMgr := Given("the multisig manager", func() {
+		client = &mock.ClientMock{}
+		policy = &mock.SigningPolicyMock{}
+		broadcaster = &broadcastmock.BroadcasterMock{
+			BroadcastFunc: func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil },
+		}
+		participant = rand.ValAddr()
+
+		mgr = multisig.NewMgr(
+			multisig.NewTofndSigner(client, participant),
+			policy,
+			sdkclient.Context{FromAddress: rand.AccAddr()},
+			participant,
+			broadcaster,
+			time.Second,
+		)
+
+		before = map[string]float64{
+			"keygen":          requestCount(t, "keygen"),
+			"sign":            requestCount(t, "sign"),
+			"keygen_failures": requestFailures(t, "keygen"),
+			"sign_failures":   requestFailures(t, "sign"),
+		}
+	})
+
+	givenMgr.
+		When("tofnd generates the key", func() {
+			sk := funcs.Must(btcec.NewPrivateKey())
+			client.KeygenFunc = func(context.Context, *tofnd.KeygenRequest, ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+				return &tofnd.KeygenResponse{KeygenResponse: &tofnd.KeygenResponse_PubKey{PubKey: sk.PubKey().SerializeCompressed()}}, nil
+			}
+			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ec.Sign(sk, in.MsgToSign).Serialize()}}, nil
+			}
+		}).
+		Then("should record the keygen and sign requests", func(t *testing.T) {
+			event := types.NewKeygenStarted(testutils.KeyID(), exported.ECDSA, []sdk.ValAddress{participant})
+			assert.NoError(t, mgr.ProcessKeygenStarted(event))
+
+			assert.Equal(t, before["keygen"]+1, requestCount(t, "keygen"))
+			assert.Equal(t, before["sign"]+1, requestCount(t, "sign"))
+			assert.Equal(t, before["keygen_failures"], requestFailures(t, "keygen"))
+			assert.Equal(t, before["sign_failures"], requestFailures(t, "sign"))
+		}).
+		Run(t)
+
+	givenMgr.
+		When("tofnd fails to generate the key", func() {
+			client.KeygenFunc = func(context.Context, *tofnd.KeygenRequest, ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+				return nil, errors.New("tofnd unavailable")
+			}
+		}).
+		Then("should record the failed keygen request", func(t *testing.T) {
+			event := types.NewKeygenStarted(testutils.KeyID(), exported.ECDSA, []sdk.ValAddress{participant})
+			assert.Error(t, mgr.ProcessKeygenStarted(event))
+
+			assert.Equal(t, before["keygen"]+1, requestCount(t, "keygen"))
+			assert.Equal(t, before["keygen_failures"]+1, requestFailures(t, "keygen"))
+			assert.Equal(t, before["sign"], requestCount(t, "sign"))
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the signing policy is violated", func() {
+			policy.CheckFunc = func(context.Context, *types.SigningStarted) error {
+				return fmt.Errorf("%w: mint limit exceeded", multisig.ErrPolicyViolation)
+			}
+		}).
+		Then("should count the refusal by requesting module", func(t *testing.T) {
+			key := typestestutils.Key()
+			key.PubKeys[participant.String()] = funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+			module := rand.NormalizedStr(5)
+
+			labels := map[string]string{"requesting_module": module, "reason": "violation"}
+			assert.Nil(t, gatherMetric(t, "vald_multisig_signing_refusals_total", labels))
+
+			assert.NoError(t, mgr.ProcessSigningStarted(types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), module)))
+
+			assert.Equal(t, 1.0, gatherMetric(t, "vald_multisig_signing_refusals_total", labels).GetCounter().GetValue())
+			assert.Equal(t, before["sign"], requestCount(t, "sign"))
+		}).
+		Run(t)
+}
+
+// gatherMetric returns the multisig metric with the given name and label values, or nil if it has not been recorded yet
+func gatherMetric(t *testing.T, name string, labels map[string]string) *dto.Metric {
+	registry := prometheus.NewRegistry()
+	for _, c := range multisig.Collectors() {
+		assert.NoError(t, registry.Register(c))
+	}
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			matches := 0
+			for _, label := range metric.GetLabel() {
+				if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+					matches++
+				}
+			}
+
+			if matches == len(labels) {
+				return metric
+			}
+		}
+	}
+
+	return nil
+}
@@ -2,33 +2,32 @@ package multisig
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	errorsmod "cosmossdk.io/errors"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 )
 
-// Mgr represents an object that manages all communication with the multisig process
+// Mgr represents an object that manages all communication with the multisig signer
 type Mgr struct {
-	client      Client
+	signer      Signer
+	policy      SigningPolicy
 	ctx         sdkclient.Context
 	participant sdk.ValAddress
 	broadcaster broadcast.Broadcaster
 	timeout     time.Duration
 }
 
-// NewMgr is the constructor of mgr
-func NewMgr(client Client, ctx sdkclient.Context, participant sdk.ValAddress, broadcaster broadcast.Broadcaster, timeout time.Duration) *Mgr {
+// NewMgr is the constructor of mgr. The signing policy is optional, if nil all signing requests are signed
+func NewMgr(signer Signer, policy SigningPolicy, ctx sdkclient.Context, participant sdk.ValAddress, broadcaster broadcast.Broadcaster, timeout time.Duration) *Mgr {
 	return &Mgr{
-		client:      client,
+		signer:      signer,
+		policy:      policy,
 		ctx:         ctx,
 		participant: participant,
 		broadcaster: broadcaster,
@@ -36,52 +35,29 @@ func NewMgr(client Client, ctx sdkclient.Context, participant sdk.ValAddress, br
 	}
 }
 
+// KeyUID returns the UID under which the signer holds the validator's share of the given key
+func KeyUID(keyID exported.KeyID) string {
+	return fmt.Sprintf("%s_%d", keyID.String(), 0)
+}
+
 func (mgr Mgr) isParticipant(p sdk.ValAddress) bool {
 	return mgr.participant.Equals(p)
 }
 
-func (mgr Mgr) generateKey(keyUID string) (exported.PublicKey, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
-	defer cancel()
+func (mgr Mgr) generateKey(keyUID string, keyType exported.KeyType) (pubKey exported.PublicKey, err error) {
+	defer func(start time.Time) { observe("keygen", start, err) }(time.Now())
 
-	res, err := mgr.client.Keygen(grpcCtx, &tofnd.KeygenRequest{
-		KeyUid:   keyUID,
-		PartyUid: mgr.participant.String(),
-	})
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed generating key")
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+	defer cancel()
 
-	switch res.GetKeygenResponse().(type) {
-	case *tofnd.KeygenResponse_PubKey:
-		return res.GetPubKey(), nil
-	case *tofnd.KeygenResponse_Error:
-		return nil, errors.New(res.GetError())
-	default:
-		panic(fmt.Errorf("unknown multisig keygen response %T", res.GetKeygenResponse()))
-	}
+	return mgr.signer.Keygen(ctx, keyUID, keyType)
 }
 
-func (mgr Mgr) sign(keyUID string, payloadHash exported.Hash, pubKey []byte) (types.Signature, error) {
-	grpcCtx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
-	defer cancel()
+func (mgr Mgr) sign(keyUID string, keyType exported.KeyType, payloadHash exported.Hash, pubKey []byte) (sig types.Signature, err error) {
+	defer func(start time.Time) { observe("sign", start, err) }(time.Now())
 
-	res, err := mgr.client.Sign(grpcCtx, &tofnd.SignRequest{
-		KeyUid:    keyUID,
-		MsgToSign: payloadHash,
-		PartyUid:  mgr.participant.String(),
-		PubKey:    pubKey,
-	})
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed signing")
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+	defer cancel()
 
-	switch res.GetSignResponse().(type) {
-	case *tofnd.SignResponse_Signature:
-		return res.GetSignature(), nil
-	case *tofnd.SignResponse_Error:
-		return nil, errors.New(res.GetError())
-	default:
-		panic(fmt.Errorf("unknown multisig sign response %T", res.GetSignResponse()))
-	}
+	return mgr.signer.Sign(ctx, keyUID, keyType, payloadHash, pubKey)
 }
@@ -0,0 +1,19 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+)
+
+//go:generate moq -pkg mock -out ./mock/policy.go . SigningPolicy
+
+// ErrPolicyViolation is returned when a signing request is not allowed by the signing policy
+var ErrPolicyViolation = errors.New("signing policy violation")
+
+// SigningPolicy decides whether vald may sign the payload of a signing session
+type SigningPolicy interface {
+	// Check returns an error if the payload of the given signing session must not be signed
+	Check(ctx context.Context, event *types.SigningStarted) error
+}
@@ -0,0 +1,143 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+)
+
+// ErrPubKeyMismatch is returned when a key share does not match the expected public key
+var ErrPubKeyMismatch = errors.New("public key mismatch")
+
+// Signer generates key shares and signs payloads with them on behalf of the validator
+type Signer interface {
+	// Keygen generates (or re-derives) the key identified by keyUID and returns its public key
+	Keygen(ctx context.Context, keyUID string, keyType exported.KeyType) (exported.PublicKey, error)
+	// Sign signs the payload hash with the key identified by keyUID, which must match the given public key
+	Sign(ctx context.Context, keyUID string, keyType exported.KeyType, payloadHash exported.Hash, pubKey exported.PublicKey) (types.Signature, error)
+	// HealthCheck returns an error if the signer is not able to serve requests
+	HealthCheck(ctx context.Context) error
+}
+
+var _ Signer = tofndSigner{}
+
+// tofndSigner delegates key generation and signing to an external tofnd process
+type tofndSigner struct {
+	client      Client
+	participant sdk.ValAddress
+}
+
+// NewTofndSigner returns a signer that uses the tofnd multisig service behind the given client
+func NewTofndSigner(client Client, participant sdk.ValAddress) Signer {
+	return tofndSigner{
+		client:      client,
+		participant: participant,
+	}
+}
+
+// Keygen implements Signer
+func (s tofndSigner) Keygen(ctx context.Context, keyUID string, keyType exported.KeyType) (exported.PublicKey, error) {
+	algorithm, err := toAlgorithm(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Keygen(ctx, &tofnd.KeygenRequest{
+		KeyUid:    keyUID,
+		PartyUid:  s.participant.String(),
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed generating key")
+	}
+
+	switch res.GetKeygenResponse().(type) {
+	case *tofnd.KeygenResponse_PubKey:
+		return res.GetPubKey(), nil
+	case *tofnd.KeygenResponse_Error:
+		return nil, errors.New(res.GetError())
+	default:
+		panic(fmt.Errorf("unknown multisig keygen response %T", res.GetKeygenResponse()))
+	}
+}
+
+// Sign implements Signer
+func (s tofndSigner) Sign(ctx context.Context, keyUID string, keyType exported.KeyType, payloadHash exported.Hash, pubKey exported.PublicKey) (types.Signature, error) {
+	algorithm, err := toAlgorithm(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Sign(ctx, &tofnd.SignRequest{
+		KeyUid:    keyUID,
+		MsgToSign: payloadHash,
+		PartyUid:  s.participant.String(),
+		PubKey:    pubKey,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed signing")
+	}
+
+	switch res.GetSignResponse().(type) {
+	case *tofnd.SignResponse_Signature:
+		return res.GetSignature(), nil
+	case *tofnd.SignResponse_Error:
+		if s.derivesOtherPubKey(ctx, keyUID, keyType, pubKey) {
+			return nil, fmt.Errorf("%w: %s", ErrPubKeyMismatch, res.GetError())
+		}
+
+		return nil, errors.New(res.GetError())
+	default:
+		panic(fmt.Errorf("unknown multisig sign response %T", res.GetSignResponse()))
+	}
+}
+
+// derivesOtherPubKey returns true if tofnd derives a public key other than the given one for the key UID.
+// tofnd derives multisig keys deterministically from its mnemonic, so this tells a share that does not match
+// the requested public key apart from other signing failures
+func (s tofndSigner) derivesOtherPubKey(ctx context.Context, keyUID string, keyType exported.KeyType, pubKey exported.PublicKey) bool {
+	derived, err := s.Keygen(ctx, keyUID, keyType)
+
+	return err == nil && !bytes.Equal(derived, pubKey)
+}
+
+// HealthCheck implements Signer
+func (s tofndSigner) HealthCheck(ctx context.Context) error {
+	res, err := s.client.KeyPresence(ctx, &tofnd.KeyPresenceRequest{
+		// we do not need to look for a key ID that exists to obtain a successful healthcheck,
+		// all we need to do is obtain err == nil && response != FAIL
+		KeyUid: "testkey",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invoke tofnd grpc: %s", err.Error())
+	}
+
+	if res.Response == tofnd.RESPONSE_FAIL || res.Response == tofnd.RESPONSE_UNSPECIFIED {
+		return fmt.Errorf("obtained FAIL response, tofnd not properly configured")
+	}
+
+	return nil
+}
+
+// toAlgorithm returns the signing algorithm tofnd uses for keys of the given type
+func toAlgorithm(keyType exported.KeyType) (tofnd.Algorithm, error) {
+	switch keyType {
+	case exported.ECDSA:
+		return tofnd.Algorithm_ALGORITHM_ECDSA, nil
+	case exported.Ed25519:
+		return tofnd.Algorithm_ALGORITHM_ED25519, nil
+	case exported.Schnorr:
+		return tofnd.Algorithm_ALGORITHM_SCHNORR, nil
+	default:
+		return 0, fmt.Errorf("unknown key type %s", keyType)
+	}
+}
@@ -2,7 +2,7 @@ package multisig
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -17,10 +17,14 @@ func (mgr *Mgr) ProcessSigningStarted(event *types.SigningStarted) error {
 		return nil
 	}
 
-	keyUID := fmt.Sprintf("%s_%d", event.GetKeyID().String(), 0)
+	if !mgr.isAllowedByPolicy(event) {
+		return nil
+	}
+
+	keyUID := KeyUID(event.GetKeyID())
 	partyUID := mgr.participant.String()
 
-	sig, err := mgr.sign(keyUID, event.GetPayloadHash(), pubKey)
+	sig, err := mgr.sign(keyUID, event.GetKeyType(), event.GetPayloadHash(), pubKey)
 	if err != nil {
 		return err
 	}
@@ -34,3 +38,28 @@ func (mgr *Mgr) ProcessSigningStarted(event *types.SigningStarted) error {
 
 	return nil
 }
+
+// isAllowedByPolicy returns false and raises an alert if the signing policy refuses the given signing request
+func (mgr *Mgr) isAllowedByPolicy(event *types.SigningStarted) bool {
+	if mgr.policy == nil {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.timeout)
+	defer cancel()
+
+	err := mgr.policy.Check(ctx, event)
+	if err == nil {
+		return true
+	}
+
+	reason := "check_failed"
+	if errors.Is(err, ErrPolicyViolation) {
+		reason = "violation"
+	}
+	signingRefusals.WithLabelValues(event.GetRequestingModule(), reason).Inc()
+
+	log.Errorf("ALERT: refusing to sign signing session %d requested by module %s: %s", event.GetSigID(), event.GetRequestingModule(), err.Error())
+
+	return false
+}
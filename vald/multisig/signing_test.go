@@ -2,6 +2,7 @@ package multisig_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 		client      *mock.ClientMock
 		broadcaster *broadcastmock.BroadcasterMock
 		privateKey  *btcec.PrivateKey
+		policy      *mock.SigningPolicyMock
 
 		event *types.SigningStarted
 	)
@@ -42,7 +44,8 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 		participant = rand.ValAddr()
 
 		mgr = multisig.NewMgr(
-			client,
+			multisig.NewTofndSigner(client, participant),
+			nil,
 			sdkclient.Context{FromAddress: rand.AccAddr()},
 			participant,
 			broadcaster,
@@ -91,4 +94,68 @@ func TestMgr_ProcessSigningStarted(t *testing.T) {
 			assert.Len(t, broadcaster.BroadcastCalls()[0].Msgs, 1)
 		}).
 		Run(t)
+	givenMgrWithPolicy := Given("the multisig manager with a signing policy", func() {
+		client = &mock.ClientMock{}
+		broadcaster = &broadcastmock.BroadcasterMock{}
+		participant = rand.ValAddr()
+		policy = &mock.SigningPolicyMock{}
+
+		mgr = multisig.NewMgr(
+			multisig.NewTofndSigner(client, participant),
+			policy,
+			sdkclient.Context{FromAddress: rand.AccAddr()},
+			participant,
+			broadcaster,
+			time.Second,
+		)
+	}).
+		When("is part of the listed participants", func() {
+			key := typestestutils.Key()
+			privateKey = funcs.Must(btcec.NewPrivateKey())
+			key.PubKeys[participant.String()] = privateKey.PubKey().SerializeCompressed()
+
+			event = types.NewSigningStarted(uint64(rand.PosI64()), key, rand.Bytes(exported.HashLength), rand.NormalizedStr(3))
+
+			client.SignFunc = func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+				return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ec.Sign(privateKey, in.MsgToSign).Serialize()}}, nil
+			}
+			broadcaster.BroadcastFunc = func(context.Context, ...sdk.Msg) (*sdk.TxResponse, error) { return &sdk.TxResponse{}, nil }
+		})
+
+	givenMgrWithPolicy.
+		When("the policy allows the signing request", func() {
+			policy.CheckFunc = func(context.Context, *types.SigningStarted) error { return nil }
+		}).
+		Then("should sign", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessSigningStarted(event))
+
+			assert.Len(t, policy.CheckCalls(), 1)
+			assert.Len(t, client.SignCalls(), 1)
+			assert.Len(t, broadcaster.BroadcastCalls(), 1)
+		}).
+		Run(t)
+
+	shouldRefuse := Then("should refuse to sign", func(t *testing.T) {
+		assert.NoError(t, mgr.ProcessSigningStarted(event))
+
+		assert.Len(t, policy.CheckCalls(), 1)
+		assert.Empty(t, client.SignCalls())
+		assert.Empty(t, broadcaster.BroadcastCalls())
+	})
+
+	givenMgrWithPolicy.
+		Branch(
+			When("the policy is violated", func() {
+				policy.CheckFunc = func(context.Context, *types.SigningStarted) error {
+					return fmt.Errorf("%w: mint limit exceeded", multisig.ErrPolicyViolation)
+				}
+			}).
+				Then2(shouldRefuse),
+
+			When("the policy check fails", func() {
+				policy.CheckFunc = func(context.Context, *types.SigningStarted) error { return errors.New("query failed") }
+			}).
+				Then2(shouldRefuse),
+		).
+		Run(t)
 }
@@ -0,0 +1,30 @@
+package multisig
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+)
+
+// VerifyKeyShare checks that the signer holds the validator's share of the given key and that it matches the expected public key.
+// Since signer backends cannot export key material, the share is verified by signing a random challenge and checking the signature
+// against the expected public key. Returns ErrPubKeyMismatch if the share does not derive the expected public key.
+func VerifyKeyShare(ctx context.Context, signer Signer, keyID exported.KeyID, keyType exported.KeyType, pubKey exported.PublicKey) error {
+	challenge := make([]byte, exported.HashLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return err
+	}
+
+	sig, err := signer.Sign(ctx, KeyUID(keyID), keyType, challenge, pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !sig.Verify(keyType, challenge, pubKey) {
+		return fmt.Errorf("%w: signature of key %s does not verify against public key %s", ErrPubKeyMismatch, keyID, pubKey)
+	}
+
+	return nil
+}
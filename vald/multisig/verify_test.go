@@ -0,0 +1,105 @@
+package multisig_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/multisig/mock"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestVerifyKeyShare(t *testing.T) {
+	var (
+		signer multisig.Signer
+		keyID  exported.KeyID
+		pubKey exported.PublicKey
+	)
+
+	ctx := context.Background()
+
+	Given("a key ID", func() {
+		keyID = testutils.KeyID()
+	}).
+		Branch(
+			When("the local signer holds the key share", func() {
+				local := funcs.Must(multisig.NewLocalSigner(funcs.Must(bip39.NewMnemonic(funcs.Must(bip39.NewEntropy(256))))))
+				signer = local
+				pubKey = funcs.Must(local.Keygen(ctx, multisig.KeyUID(keyID), exported.Ed25519))
+			}).
+				Then("should succeed", func(t *testing.T) {
+					assert.NoError(t, multisig.VerifyKeyShare(ctx, signer, keyID, exported.Ed25519, pubKey))
+				}),
+
+			When("the local signer derives a different public key", func() {
+				signer = funcs.Must(multisig.NewLocalSigner(funcs.Must(bip39.NewMnemonic(funcs.Must(bip39.NewEntropy(256))))))
+				pubKey = funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+			}).
+				Then("should return a mismatch", func(t *testing.T) {
+					assert.ErrorIs(t, multisig.VerifyKeyShare(ctx, signer, keyID, exported.ECDSA, pubKey), multisig.ErrPubKeyMismatch)
+				}),
+
+			When("tofnd signs with a different key share", func() {
+				client := &mock.ClientMock{
+					SignFunc: func(_ context.Context, in *tofnd.SignRequest, _ ...grpc.CallOption) (*tofnd.SignResponse, error) {
+						sk := funcs.Must(btcec.NewPrivateKey())
+						return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Signature{Signature: ec.Sign(sk, in.MsgToSign).Serialize()}}, nil
+					},
+				}
+				signer = multisig.NewTofndSigner(client, rand.ValAddr())
+				pubKey = funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+			}).
+				Then("should return a mismatch", func(t *testing.T) {
+					assert.ErrorIs(t, multisig.VerifyKeyShare(ctx, signer, keyID, exported.ECDSA, pubKey), multisig.ErrPubKeyMismatch)
+				}),
+
+			When("tofnd rejects the requested public key", func() {
+				client := &mock.ClientMock{
+					SignFunc: func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+						return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Error{Error: "wrong public key"}}, nil
+					},
+					KeygenFunc: func(context.Context, *tofnd.KeygenRequest, ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+						derived := funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+						return &tofnd.KeygenResponse{KeygenResponse: &tofnd.KeygenResponse_PubKey{PubKey: derived}}, nil
+					},
+				}
+				signer = multisig.NewTofndSigner(client, rand.ValAddr())
+				pubKey = funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+			}).
+				Then("should return a mismatch", func(t *testing.T) {
+					err := multisig.VerifyKeyShare(ctx, signer, keyID, exported.ECDSA, pubKey)
+					assert.ErrorIs(t, err, multisig.ErrPubKeyMismatch)
+					assert.ErrorContains(t, err, "wrong public key")
+				}),
+
+			When("tofnd fails to sign for another reason", func() {
+				pubKey = funcs.Must(btcec.NewPrivateKey()).PubKey().SerializeCompressed()
+				client := &mock.ClientMock{
+					SignFunc: func(context.Context, *tofnd.SignRequest, ...grpc.CallOption) (*tofnd.SignResponse, error) {
+						return &tofnd.SignResponse{SignResponse: &tofnd.SignResponse_Error{Error: "key not found"}}, nil
+					},
+					KeygenFunc: func(context.Context, *tofnd.KeygenRequest, ...grpc.CallOption) (*tofnd.KeygenResponse, error) {
+						return &tofnd.KeygenResponse{KeygenResponse: &tofnd.KeygenResponse_PubKey{PubKey: pubKey}}, nil
+					},
+				}
+				signer = multisig.NewTofndSigner(client, rand.ValAddr())
+			}).
+				Then("should return the signer error", func(t *testing.T) {
+					err := multisig.VerifyKeyShare(ctx, signer, keyID, exported.ECDSA, pubKey)
+					assert.ErrorContains(t, err, "key not found")
+					assert.NotErrorIs(t, err, multisig.ErrPubKeyMismatch)
+				}),
+		).
+		Run(t)
+}
@@ -0,0 +1,240 @@
+package vald
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+const outboxDBName = "outbox"
+
+// openOutbox opens the broadcast outbox in the given vald home directory, creating it if necessary
+func openOutbox(valdHome string) (*broadcast.Outbox, error) {
+	db, err := dbm.NewGoLevelDB(outboxDBName, valdHome, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open outbox in %s (it cannot be opened while vald is running): %w", valdHome, err)
+	}
+
+	outbox, err := broadcast.NewOutbox(db, app.MakeEncodingConfig().Codec)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return outbox, nil
+}
+
+// GetOutboxCommand returns the command to inspect the broadcast outbox of vald
+func GetOutboxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-outbox",
+		Short: "Manage the msgs vald has queued for broadcast (vald must be stopped)",
+	}
+
+	cmd.AddCommand(getOutboxListCommand(), getOutboxPurgeCommand())
+
+	return cmd
+}
+
+func getOutboxListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all msgs waiting in the outbox",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			outbox, err := openOutbox(filepath.Join(clientCtx.HomeDir, "vald"))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = outbox.Close() }()
+
+			entries, err := outbox.Entries()
+			if err != nil {
+				return err
+			}
+
+			type outboxEntry struct {
+				ID         uint64            `json:"id"`
+				Height     int64             `json:"height"`
+				EnqueuedAt time.Time         `json:"enqueued_at"`
+				Msgs       []json.RawMessage `json:"msgs"`
+			}
+
+			cdc := app.MakeEncodingConfig().Codec
+			out := make([]outboxEntry, len(entries))
+			for i, entry := range entries {
+				out[i] = outboxEntry{ID: entry.ID, Height: entry.Height, EnqueuedAt: entry.EnqueuedAt}
+				for _, msg := range entry.Msgs {
+					out[i].Msgs = append(out[i].Msgs, funcs.Must(cdc.MarshalInterfaceJSON(msg)))
+				}
+			}
+
+			fmt.Printf("%s\n", funcs.Must(json.MarshalIndent(out, "", "  ")))
+
+			return nil
+		},
+	}
+}
+
+func getOutboxPurgeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge [id...]",
+		Short: "Remove the entries with the given IDs from the outbox, or all entries if no ID is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var ids []uint64
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid outbox entry ID %s", arg)
+				}
+
+				ids = append(ids, id)
+			}
+
+			outbox, err := openOutbox(filepath.Join(clientCtx.HomeDir, "vald"))
+			if err != nil {
+				return err
+			}
+			defer func() { _ = outbox.Close() }()
+
+			count, err := outbox.Purge(ids...)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("removed %d entries from the outbox\n", count)
+			return nil
+		},
+	}
+}
+
+// newOutboxExpiryCheck returns a function that reports whether the poll or signing session a msg
+// from the outbox belongs to has expired by the given block height.
+// Votes are also dropped if their poll no longer accepts the validator's vote.
+// Msgs are kept if their expiry cannot be determined.
+func newOutboxExpiryCheck(clientCtx client.Context, valAddr sdk.ValAddress, latestHeight int64) func(broadcast.OutboxEntry, sdk.Msg) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var multisigParams *multisigTypes.Params
+	res, err := multisigTypes.NewQueryServiceClient(clientCtx).Params(ctx, &multisigTypes.ParamsRequest{})
+	if err != nil {
+		log.Errorf("failed to query multisig params, keeping all signatures in the outbox: %s", err.Error())
+	} else {
+		multisigParams = &res.Params
+	}
+
+	revoteLockingPeriods := make(map[string]int64)
+	revoteLockingPeriod := func(chain string) (int64, bool) {
+		chain = strings.ToLower(chain)
+		if period, ok := revoteLockingPeriods[chain]; ok {
+			return period, true
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		res, err := evmTypes.NewQueryServiceClient(clientCtx).Params(ctx, &evmTypes.ParamsRequest{Chain: chain})
+		if err != nil {
+			log.Errorf("failed to query params of chain %s, keeping all its votes in the outbox: %s", chain, err.Error())
+			return 0, false
+		}
+
+		revoteLockingPeriods[chain] = res.Params.RevoteLockingPeriod
+		return res.Params.RevoteLockingPeriod, true
+	}
+
+	isPollClosed := func(pollID vote.PollID) (bool, bool) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		res, err := voteTypes.NewQueryServiceClient(clientCtx).Poll(ctx, &voteTypes.PollRequest{PollID: pollID})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			return true, true
+		case err != nil:
+			log.Errorf("failed to query poll %s: %s", pollID.String(), err.Error())
+			return false, false
+		default:
+			return !acceptsVote(res.Poll, valAddr, latestHeight), true
+		}
+	}
+
+	return func(entry broadcast.OutboxEntry, msg sdk.Msg) bool {
+		var timeout int64
+		switch msg := msg.(type) {
+		case *voteTypes.VoteRequest:
+			if closed, ok := isPollClosed(msg.PollID); ok {
+				return closed
+			}
+
+			events, ok := msg.Vote.GetCachedValue().(*evmTypes.VoteEvents)
+			if !ok {
+				return false
+			}
+
+			period, ok := revoteLockingPeriod(events.Chain.String())
+			if !ok {
+				return false
+			}
+			timeout = period
+		case *multisigTypes.SubmitSignatureRequest:
+			if multisigParams == nil {
+				return false
+			}
+			timeout = multisigParams.SigningTimeout
+		case *multisigTypes.SubmitPubKeyRequest:
+			if multisigParams == nil {
+				return false
+			}
+			timeout = multisigParams.KeygenTimeout
+		default:
+			return false
+		}
+
+		// the poll or session started at or before the entry height, so it has expired by then at the latest
+		return entry.Height+timeout < latestHeight
+	}
+}
+
+// acceptsVote reports whether the given poll would still accept a vote of the voter at the given block height.
+// Completed polls accept late votes until the end of their grace period.
+func acceptsVote(poll voteTypes.PollInfo, voter sdk.ValAddress, height int64) bool {
+	if slices.Any(poll.Voted, func(v string) bool { return v == voter.String() }) {
+		return false
+	}
+
+	switch poll.Metadata.State {
+	case vote.Pending:
+		return height < poll.Metadata.ExpiresAt
+	case vote.Completed:
+		return height <= poll.Metadata.CompletedAt+poll.Metadata.GracePeriod
+	default:
+		return false
+	}
+}
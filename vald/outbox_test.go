@@ -0,0 +1,68 @@
+package vald
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestAcceptsVote(t *testing.T) {
+	var (
+		valAddr sdk.ValAddress
+		poll    voteTypes.PollInfo
+	)
+
+	givenPoll := Given("a poll the validator participates in", func() {
+		valAddr = rand.ValAddr()
+		poll = voteTypes.PollInfo{
+			Metadata: vote.PollMetadata{ExpiresAt: 100, GracePeriod: 3},
+			NotVoted: []string{valAddr.String(), rand.ValAddr().String()},
+		}
+	})
+
+	givenPoll.
+		When("the poll is pending", func() { poll.Metadata.State = vote.Pending }).
+		Then("should accept the vote until the poll expires", func(t *testing.T) {
+			assert.True(t, acceptsVote(poll, valAddr, 99))
+			assert.False(t, acceptsVote(poll, valAddr, 100))
+		}).
+		Run(t)
+
+	givenPoll.
+		When("the poll completed", func() {
+			poll.Metadata.State = vote.Completed
+			poll.Metadata.CompletedAt = 50
+		}).
+		Then("should accept a late vote within the grace period", func(t *testing.T) {
+			assert.True(t, acceptsVote(poll, valAddr, 51))
+			assert.True(t, acceptsVote(poll, valAddr, 53))
+		}).
+		Then("should reject the vote after the grace period", func(t *testing.T) {
+			assert.False(t, acceptsVote(poll, valAddr, 54))
+		}).
+		Run(t)
+
+	givenPoll.
+		When("the poll failed", func() { poll.Metadata.State = vote.Failed }).
+		Then("should reject the vote", func(t *testing.T) {
+			assert.False(t, acceptsVote(poll, valAddr, 10))
+		}).
+		Run(t)
+
+	givenPoll.
+		When("the validator has voted already", func() {
+			poll.Metadata.State = vote.Pending
+			poll.Voted = []string{valAddr.String()}
+			poll.NotVoted = poll.NotVoted[1:]
+		}).
+		Then("should reject the vote", func(t *testing.T) {
+			assert.False(t, acceptsVote(poll, valAddr, 10))
+		}).
+		Run(t)
+}
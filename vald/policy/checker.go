@@ -0,0 +1,123 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+var _ multisig.SigningPolicy = Checker{}
+
+// Checker checks the EVM command batch behind a signing session against a local policy before vald signs it
+type Checker struct {
+	policy   Policy
+	multisig multisigTypes.QueryServiceClient
+	evm      evmTypes.QueryServiceClient
+}
+
+// NewChecker is the constructor of Checker
+func NewChecker(policy Policy, clientCtx sdkclient.Context) Checker {
+	return Checker{
+		policy:   policy,
+		multisig: multisigTypes.NewQueryServiceClient(clientCtx),
+		evm:      evmTypes.NewQueryServiceClient(clientCtx),
+	}
+}
+
+// Check returns an error if the signing session of the given event is for an EVM command batch that violates the policy.
+// Signing sessions requested by other modules are not restricted. If the command batch cannot be fetched or does not match
+// the payload to sign, the check fails closed.
+func (c Checker) Check(ctx context.Context, event *multisigTypes.SigningStarted) error {
+	if event.GetRequestingModule() != evmTypes.ModuleName {
+		return nil
+	}
+
+	session, err := c.multisig.SigningSession(ctx, &multisigTypes.SigningSessionRequest{ID: event.GetSigID()})
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to query signing session %d", event.GetSigID())
+	}
+
+	metadata, ok := session.SigningSession.ModuleMetadata.GetCachedValue().(*evmTypes.SigMetadata)
+	if !ok {
+		return fmt.Errorf("%w: unexpected metadata of signing session %d", multisig.ErrPolicyViolation, event.GetSigID())
+	}
+
+	if metadata.Type != evmTypes.SigCommand || !c.policy.Restricts(metadata.Chain) {
+		return nil
+	}
+
+	batch, err := c.evm.BatchedCommands(ctx, &evmTypes.BatchedCommandsRequest{
+		Chain: metadata.Chain.String(),
+		Id:    utils.HexEncode(metadata.CommandBatchID),
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to query command batch %s of chain %s", utils.HexEncode(metadata.CommandBatchID), metadata.Chain)
+	}
+
+	data, err := utils.HexDecode(batch.Data)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid data of command batch %s", batch.ID)
+	}
+
+	if signHash := evmTypes.GetSignHash(data); !bytes.Equal(signHash.Bytes(), event.GetPayloadHash()) {
+		return fmt.Errorf("%w: payload hash of signing session %d does not match command batch %s", multisig.ErrPolicyViolation, event.GetSigID(), batch.ID)
+	}
+
+	_, commands, err := evmTypes.DecodeCommandBatchData(data)
+	if err != nil {
+		return fmt.Errorf("%w: %s", multisig.ErrPolicyViolation, err)
+	}
+
+	nextKeyOperators, err := c.queryNextKeyOperators(ctx, metadata.Chain, commands)
+	if err != nil {
+		return err
+	}
+
+	if violations := c.policy.CheckCommands(metadata.Chain, commands, nextKeyOperators); len(violations) > 0 {
+		return fmt.Errorf("%w: command batch %s of chain %s: %s", multisig.ErrPolicyViolation, batch.ID, metadata.Chain,
+			strings.Join(slices.Map(violations, Violation.String), "; "))
+	}
+
+	return nil
+}
+
+// queryNextKeyOperators returns the operator addresses of the key assigned on chain for the next rotation of the given chain.
+// It only queries them if the commands transfer operatorship and returns none if no key is assigned
+func (c Checker) queryNextKeyOperators(ctx context.Context, chain nexus.ChainName, commands []evmTypes.Command) ([]common.Address, error) {
+	if !slices.Any(commands, func(command evmTypes.Command) bool {
+		return command.Type == evmTypes.COMMAND_TYPE_TRANSFER_OPERATORSHIP
+	}) {
+		return nil, nil
+	}
+
+	nextKey, err := c.multisig.NextKeyID(ctx, &multisigTypes.NextKeyIDRequest{Chain: chain.String()})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil, nil
+	case err != nil:
+		return nil, errorsmod.Wrapf(err, "failed to query the next key ID of chain %s", chain)
+	}
+
+	res, err := c.evm.KeyAddress(ctx, &evmTypes.KeyAddressRequest{Chain: chain.String(), KeyID: nextKey.KeyID})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to query the operators of key %s", nextKey.KeyID)
+	}
+
+	return slices.Map(res.Addresses, func(address evmTypes.KeyAddressResponse_WeightedAddress) common.Address {
+		return common.HexToAddress(address.Address)
+	}), nil
+}
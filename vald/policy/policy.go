@@ -0,0 +1,224 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// AnySymbol is the max_mint key whose limit applies to all assets without a limit of their own
+const AnySymbol = "*"
+
+// Config is the content of a local signing policy file
+type Config struct {
+	// Chains maps chain names to the restrictions on the command batches of that chain. Batches of chains that are not listed are not restricted
+	Chains map[string]ChainConfig `json:"chains"`
+}
+
+// ChainConfig contains the restrictions on the command batches of a single chain
+type ChainConfig struct {
+	// MaxMint is the maximum amount of each asset symbol that a single command batch may mint
+	MaxMint map[string]string `json:"max_mint,omitempty"`
+	// AllowedContracts are the only contracts that contract calls may be approved for. Any contract is allowed if empty
+	AllowedContracts []string `json:"allowed_contracts,omitempty"`
+	// AllowedOperators are addresses that operatorship may be transferred to in addition to the operators of the key
+	// that is assigned on chain for the next rotation
+	AllowedOperators []string `json:"allowed_operators,omitempty"`
+}
+
+// Policy restricts the commands of EVM command batches that vald is willing to sign
+type Policy struct {
+	chains map[string]chainPolicy
+}
+
+type chainPolicy struct {
+	maxMint          map[string]*big.Int
+	allowedContracts map[common.Address]bool
+	allowedOperators map[common.Address]bool
+}
+
+// Violation describes a command that is not allowed by the policy
+type Violation struct {
+	CommandID evmTypes.CommandID
+	Rule      string
+	Reason    string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("command %s violates %s: %s", v.CommandID.Hex(), v.Rule, v.Reason)
+}
+
+// policy rules
+const (
+	RuleInvalidCommand = "valid_command"
+	RuleMaxMint        = "max_mint"
+	RuleContracts      = "allowed_contracts"
+	RuleOperators      = "allowed_operators"
+)
+
+// Load reads the policy from the JSON file at the given path
+func Load(path string) (Policy, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, fmt.Errorf("failed to read signing policy file: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return Policy{}, fmt.Errorf("invalid signing policy file %s: %w", path, err)
+	}
+
+	return NewPolicy(config)
+}
+
+// NewPolicy validates the given config and returns the policy it describes
+func NewPolicy(config Config) (Policy, error) {
+	policy := Policy{chains: make(map[string]chainPolicy, len(config.Chains))}
+
+	for chain, chainConfig := range config.Chains {
+		if err := nexus.ChainName(chain).Validate(); err != nil {
+			return Policy{}, fmt.Errorf("invalid chain %s: %w", chain, err)
+		}
+
+		p := chainPolicy{
+			maxMint:          make(map[string]*big.Int, len(chainConfig.MaxMint)),
+			allowedContracts: make(map[common.Address]bool, len(chainConfig.AllowedContracts)),
+			allowedOperators: make(map[common.Address]bool, len(chainConfig.AllowedOperators)),
+		}
+
+		for symbol, limit := range chainConfig.MaxMint {
+			amount, ok := new(big.Int).SetString(limit, 10)
+			if !ok || amount.Sign() < 0 {
+				return Policy{}, fmt.Errorf("invalid max_mint limit %s for asset %s of chain %s", limit, symbol, chain)
+			}
+
+			p.maxMint[symbol] = amount
+		}
+
+		for _, addr := range chainConfig.AllowedContracts {
+			if !common.IsHexAddress(addr) {
+				return Policy{}, fmt.Errorf("invalid contract address %s for chain %s", addr, chain)
+			}
+
+			p.allowedContracts[common.HexToAddress(addr)] = true
+		}
+
+		for _, addr := range chainConfig.AllowedOperators {
+			if !common.IsHexAddress(addr) {
+				return Policy{}, fmt.Errorf("invalid operator address %s for chain %s", addr, chain)
+			}
+
+			p.allowedOperators[common.HexToAddress(addr)] = true
+		}
+
+		key := strings.ToLower(chain)
+		if _, ok := policy.chains[key]; ok {
+			return Policy{}, fmt.Errorf("duplicate policy for chain %s", chain)
+		}
+		policy.chains[key] = p
+	}
+
+	return policy, nil
+}
+
+// Restricts returns true if the policy restricts the command batches of the given chain
+func (p Policy) Restricts(chain nexus.ChainName) bool {
+	_, ok := p.chains[strings.ToLower(chain.String())]
+	return ok
+}
+
+// CheckCommands returns all violations of the policy by the given commands of a single command batch of the given chain.
+// Operatorship may only be transferred to the given operators of the chain's next key or to the allowed operators of the policy
+func (p Policy) CheckCommands(chain nexus.ChainName, commands []evmTypes.Command, nextKeyOperators []common.Address) []Violation {
+	chainPolicy, ok := p.chains[strings.ToLower(chain.String())]
+	if !ok {
+		return nil
+	}
+
+	var violations []Violation
+	minted := make(map[string]*big.Int)
+
+	for _, command := range commands {
+		params, err := decodeParams(command)
+		if err != nil {
+			violations = append(violations, Violation{CommandID: command.ID, Rule: RuleInvalidCommand, Reason: err.Error()})
+			continue
+		}
+
+		switch command.Type {
+		case evmTypes.COMMAND_TYPE_MINT_TOKEN, evmTypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL_WITH_MINT:
+			amount, _ := new(big.Int).SetString(params["amount"], 10)
+			total, ok := minted[params["symbol"]]
+			if !ok {
+				total = new(big.Int)
+				minted[params["symbol"]] = total
+			}
+			total.Add(total, amount)
+
+			if limit, ok := chainPolicy.mintLimit(params["symbol"]); ok && total.Cmp(limit) > 0 {
+				violations = append(violations, Violation{
+					CommandID: command.ID,
+					Rule:      RuleMaxMint,
+					Reason:    fmt.Sprintf("batch mints %s %s, exceeding the limit of %s", total, params["symbol"], limit),
+				})
+			}
+		}
+
+		switch command.Type {
+		case evmTypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL, evmTypes.COMMAND_TYPE_APPROVE_CONTRACT_CALL_WITH_MINT:
+			contract := common.HexToAddress(params["contractAddress"])
+			if len(chainPolicy.allowedContracts) > 0 && !chainPolicy.allowedContracts[contract] {
+				violations = append(violations, Violation{
+					CommandID: command.ID,
+					Rule:      RuleContracts,
+					Reason:    fmt.Sprintf("contract %s is not allowed", contract.Hex()),
+				})
+			}
+		case evmTypes.COMMAND_TYPE_TRANSFER_OPERATORSHIP:
+			for _, operator := range strings.Split(params["newOperators"], ";") {
+				address := common.HexToAddress(operator)
+				if !chainPolicy.allowedOperators[address] && !slices.Any(nextKeyOperators, func(o common.Address) bool { return o == address }) {
+					violations = append(violations, Violation{
+						CommandID: command.ID,
+						Rule:      RuleOperators,
+						Reason:    fmt.Sprintf("operator %s is neither an operator of the next key nor allowed by the policy", operator),
+					})
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func (p chainPolicy) mintLimit(symbol string) (*big.Int, bool) {
+	if limit, ok := p.maxMint[symbol]; ok {
+		return limit, true
+	}
+
+	limit, ok := p.maxMint[AnySymbol]
+	return limit, ok
+}
+
+// decodeParams decodes the params of the given command. The decoders panic on malformed params, so this recovers into an error
+func decodeParams(command evmTypes.Command) (params map[string]string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed params for command type %s: %v", command.Type, r)
+		}
+	}()
+
+	return command.DecodeParams()
+}
@@ -0,0 +1,214 @@
+package policy_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/policy"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	multisigTypesTestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestPolicy_CheckCommands(t *testing.T) {
+	var (
+		p          policy.Policy
+		chain      nexus.ChainName
+		contract   common.Address
+		commands   []evmTypes.Command
+		keyOps     []common.Address
+		violations []policy.Violation
+	)
+
+	chainID := math.NewInt(rand.PosI64())
+	keyID := multisigTestutils.KeyID()
+
+	mint := func(symbol string, amount int64) evmTypes.Command {
+		return evmTypes.NewMintTokenCommand(keyID, nexus.TransferID(rand.PosI64()), symbol, common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(amount))
+	}
+
+	approve := func(contract common.Address) evmTypes.Command {
+		return evmTypes.NewApproveContractCallCommand(chainID, keyID, nexus.ChainName(rand.Denom(3, 10)), evmTypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))), uint64(rand.PosI64()), evmTypes.EventContractCall{
+			Sender:           evmTypes.Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			ContractAddress:  contract.Hex(),
+			PayloadHash:      evmTypes.Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			DestinationChain: chain,
+		})
+	}
+
+	nextKey := multisigTypesTestutils.Key()
+	transferOperatorship := evmTypes.NewMultisigTransferCommand(chainID, keyID, &nextKey)
+	operators, _, _ := evmTypes.DecodeTransferMultisigParams(transferOperatorship.Params)
+
+	givenPolicy := Given("a signing policy for a chain", func() {
+		chain = nexus.ChainName(rand.Denom(3, 10))
+		contract = common.BytesToAddress(rand.Bytes(common.AddressLength))
+		keyOps = nil
+
+		p = funcs.Must(policy.NewPolicy(policy.Config{Chains: map[string]policy.ChainConfig{
+			chain.String(): {
+				MaxMint:          map[string]string{"usdc": "1000", policy.AnySymbol: "10"},
+				AllowedContracts: []string{contract.Hex()},
+				AllowedOperators: []string{operators[0].Hex()},
+			},
+		}}))
+	})
+
+	checkCommands := func() { violations = p.CheckCommands(chain, commands, keyOps) }
+
+	givenPolicy.
+		When("the batch satisfies the policy", func() {
+			commands = []evmTypes.Command{mint("usdc", 600), mint("usdc", 400), mint("weth", 10), approve(contract)}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should have no violations", func(t *testing.T) {
+			assert.Empty(t, violations)
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch mints more than the limit of an asset in total", func() {
+			commands = []evmTypes.Command{mint("usdc", 600), mint("usdc", 401)}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should report the mint limit", func(t *testing.T) {
+			assert.Len(t, violations, 1)
+			assert.Equal(t, policy.RuleMaxMint, violations[0].Rule)
+			assert.Equal(t, commands[1].ID, violations[0].CommandID)
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch mints more than the default limit of an asset without its own limit", func() {
+			commands = []evmTypes.Command{mint("weth", 11)}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should report the mint limit", func(t *testing.T) {
+			assert.Len(t, violations, 1)
+			assert.Equal(t, policy.RuleMaxMint, violations[0].Rule)
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch approves a call to an unknown contract", func() {
+			commands = []evmTypes.Command{approve(common.BytesToAddress(rand.Bytes(common.AddressLength)))}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should report the contract", func(t *testing.T) {
+			assert.Len(t, violations, 1)
+			assert.Equal(t, policy.RuleContracts, violations[0].Rule)
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch transfers operatorship", func() {
+			commands = []evmTypes.Command{transferOperatorship}
+		}).
+		Branch(
+			When("the operators are not the ones of the next key", func() {
+				keyOps = []common.Address{common.BytesToAddress(rand.Bytes(common.AddressLength))}
+			}).
+				When("the commands are checked", checkCommands).
+				Then("should report all operators that are not allowed by the policy either", func(t *testing.T) {
+					assert.Len(t, violations, len(operators)-1)
+					for _, violation := range violations {
+						assert.Equal(t, policy.RuleOperators, violation.Rule)
+					}
+				}),
+
+			When("the operators are the ones of the next key", func() {
+				keyOps = operators[1:]
+			}).
+				When("the commands are checked", checkCommands).
+				Then("should have no violations", func(t *testing.T) {
+					assert.Empty(t, violations)
+				}),
+		).
+		Run(t)
+
+	Given("a signing policy for a chain without allowed operators", func() {
+		chain = nexus.ChainName(rand.Denom(3, 10))
+		p = funcs.Must(policy.NewPolicy(policy.Config{Chains: map[string]policy.ChainConfig{chain.String(): {}}}))
+	}).
+		When("the batch transfers operatorship while no next key is assigned", func() {
+			commands = []evmTypes.Command{transferOperatorship}
+			keyOps = nil
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should report all operators", func(t *testing.T) {
+			assert.Len(t, violations, len(operators))
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch contains a command with malformed params", func() {
+			command := mint("usdc", 1)
+			command.Params = rand.Bytes(10)
+			commands = []evmTypes.Command{command}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should report the command as invalid", func(t *testing.T) {
+			assert.Len(t, violations, 1)
+			assert.Equal(t, policy.RuleInvalidCommand, violations[0].Rule)
+		}).
+		Run(t)
+
+	givenPolicy.
+		When("the batch belongs to a chain without policy", func() {
+			chain = nexus.ChainName(rand.Denom(3, 10))
+			commands = []evmTypes.Command{mint("usdc", 1001), approve(common.BytesToAddress(rand.Bytes(common.AddressLength)))}
+		}).
+		When("the commands are checked", checkCommands).
+		Then("should have no violations", func(t *testing.T) {
+			assert.False(t, p.Restricts(chain))
+			assert.Empty(t, violations)
+		}).
+		Run(t)
+}
+
+func TestLoad(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "signing_policy.json")
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	t.Run("valid policy", func(t *testing.T) {
+		path := write(t, `{"chains": {"Ethereum": {"max_mint": {"usdc": "1000"}, "allowed_contracts": ["0x0000000000000000000000000000000000000001"]}}}`)
+
+		p, err := policy.Load(path)
+		assert.NoError(t, err)
+		assert.True(t, p.Restricts("ethereum"))
+		assert.False(t, p.Restricts("avalanche"))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := policy.Load(write(t, `{"chains": {"ethereum": {"max_mints": {"usdc": "1000"}}}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := policy.Load(write(t, `{"chains": {"ethereum": {"max_mint": {"usdc": "-1"}}}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		_, err := policy.Load(write(t, `{"chains": {"ethereum": {"allowed_operators": ["not an address"]}}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := policy.Load(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,501 @@
+package vald
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkClient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/vald/config"
+	"github.com/axelarnetwork/axelar-core/vald/evm"
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	"github.com/axelarnetwork/axelar-core/vald/svm"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	snapshotTypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	tmEvents "github.com/axelarnetwork/tm-events/events"
+	"github.com/axelarnetwork/tm-events/tendermint"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+const (
+	flagReplayFrom        = "from"
+	flagReplayTo          = "to"
+	flagReplayOutput      = "report"
+	flagReplayGraceBlocks = "grace-blocks"
+)
+
+// replay entry statuses
+const (
+	replayMatch          = "match"
+	replayMismatch       = "mismatch"
+	replayMissingOnChain = "missing_on_chain"
+	replayUnexpected     = "unexpected_on_chain"
+)
+
+// GetReplayCommand returns the command to replay vald over historical blocks without broadcasting anything
+func GetReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-replay",
+		Short: "Replay vald's vote and signing processors over historical blocks and compare the would-be votes and signatures to the ones stored on-chain",
+		Long: "Replay vald's vote and signing processors over historical blocks and compare the would-be votes and signatures to the ones stored on-chain. " +
+			"Msgs are never broadcast and nothing is signed, signing sessions are compared by the payload that would have been signed. " +
+			"Polls and signing sessions are queried at historical heights, so the node must not have pruned the replayed range. " +
+			"External chains are queried at their current state, so votes on txs that were not final at the time may differ. " +
+			"Keygen events are skipped so no new keys are generated.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			log.Setup(serverCtx.Logger.With("module", "vald-replay"))
+			v := serverCtx.Viper
+
+			if err := bindTssFlags(v, cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := sdkClient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid block range [%d, %d]", from, to)
+			}
+
+			graceBlocks, err := cmd.Flags().GetInt64(flagReplayGraceBlocks)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagReplayOutput)
+			if err != nil {
+				return err
+			}
+
+			valdConf := config.DefaultValdConfig()
+			if err := v.Unmarshal(&valdConf, config.AddDecodeHooks); err != nil {
+				panic(err)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(v.GetString("validator-addr"))
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid validator operator address")
+			}
+
+			report, err := runReplay(cmd.Context(), clientCtx, valdConf, valAddr, from, to, graceBlocks)
+			if err != nil {
+				return err
+			}
+
+			bz := funcs.Must(json.MarshalIndent(report, "", "  "))
+			if output == "" {
+				fmt.Printf("%s\n", bz)
+				return nil
+			}
+
+			return os.WriteFile(output, bz, RW)
+		},
+	}
+
+	setPersistentFlags(cmd)
+	cmd.Flags().Int64(flagReplayFrom, 0, "first block height to replay")
+	cmd.Flags().Int64(flagReplayTo, 0, "last block height to replay")
+	cmd.Flags().Int64(flagReplayGraceBlocks, 100, "number of blocks after the last replayed block to wait for votes and signatures that were broadcast late")
+	cmd.Flags().String(flagReplayOutput, "", "file to write the JSON report to, prints to stdout if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+type replayEntry struct {
+	Key      string          `json:"key"`
+	Status   string          `json:"status"`
+	Height   int64           `json:"height,omitempty"`
+	Expected json.RawMessage `json:"expected,omitempty"`
+	Actual   json.RawMessage `json:"actual,omitempty"`
+}
+
+type replayReport struct {
+	From      int64          `json:"from"`
+	To        int64          `json:"to"`
+	Validator string         `json:"validator"`
+	Proxy     string         `json:"proxy"`
+	Summary   map[string]int `json:"summary"`
+	Entries   []replayEntry  `json:"entries"`
+}
+
+// onChainLookup queries the on-chain record of a poll or signing session
+type onChainLookup func(ctx context.Context, clientCtx sdkClient.Context) (proto.Message, error)
+
+// replayedSession is a poll or signing session that was started in the replayed block range
+type replayedSession struct {
+	Key    string
+	Height int64
+	Lookup onChainLookup
+}
+
+// onChainRecord is the on-chain record of a poll or signing session at the last height it was found in state
+type onChainRecord struct {
+	Height int64
+	Record proto.Message
+}
+
+func runReplay(ctx context.Context, clientCtx sdkClient.Context, valdConf config.ValdConfig, valAddr sdk.ValAddress, from, to, graceBlocks int64) (*replayReport, error) {
+	defer once.Do(cleanUp)
+
+	res, err := snapshotTypes.NewQueryServiceClient(clientCtx).ProxyByOperator(ctx, &snapshotTypes.ProxyByOperatorRequest{OperatorAddress: valAddr.String()})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to query the proxy of validator %s", valAddr.String())
+	}
+
+	proxy, err := sdk.AccAddressFromBech32(res.ProxyAddress)
+	if err != nil {
+		return nil, err
+	}
+	clientCtx = clientCtx.WithFromAddress(proxy)
+
+	robustClient := createRobustClient(clientCtx.NodeURI)
+	syncCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	syncInfo, err := robustClient.LatestSyncInfo(syncCtx)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	if to > syncInfo.LatestBlockHeight {
+		return nil, fmt.Errorf("block %d is ahead of the node height %d", to, syncInfo.LatestBlockHeight)
+	}
+
+	dryRun := broadcast.NewDryRunBroadcaster()
+	evmMgr := createEVMMgr(valdConf, clientCtx, dryRun, valAddr)
+	svmMgr := createSVMMgr(valdConf, clientCtx, dryRun, valAddr)
+	multisigMgr := createMultisigMgrWithSigner(multisig.NewDryRunSigner(), dryRun, clientCtx, valdConf, valAddr)
+
+	started, err := replayEvents(ctx, robustClient, valdConf, from, to, evmMgr, svmMgr, multisigMgr)
+	if err != nil {
+		return nil, err
+	}
+
+	onChain, err := queryOnChainRecords(ctx, clientCtx, started, min(to+graceBlocks, syncInfo.LatestBlockHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := compareReplayedMsgs(app.MakeEncodingConfig().Codec.MarshalJSON, valAddr, dryRun.Msgs(), onChain)
+
+	summary := make(map[string]int)
+	for _, entry := range entries {
+		summary[entry.Status]++
+	}
+
+	return &replayReport{
+		From:      from,
+		To:        to,
+		Validator: valAddr.String(),
+		Proxy:     proxy.String(),
+		Summary:   summary,
+		Entries:   entries,
+	}, nil
+}
+
+// replayEvents feeds the events of the given block range to the processors and waits until all of them are handled.
+// It returns all polls and signing sessions that were started in the range.
+func replayEvents(ctx context.Context, robustClient *tendermint.RobustClient, valdConf config.ValdConfig, from, to int64, evmMgr *evm.Mgr, svmMgr *svm.Mgr, multisigMgr *multisig.Mgr) ([]replayedSession, error) {
+	eventBus := createEventBus(robustClient, from, valdConf.EventNotificationsMaxRetries, valdConf.EventNotificationsBackOff)
+
+	r := &replayer{to: to}
+
+	pastRange := eventBus.Subscribe(func(event tmEvents.ABCIEventWithHeight) bool { return event.Height > to })
+	tokConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmTokenStarted]())
+	traConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmKeyTransferStarted]())
+	gatewayTxsConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmGatewayTxsStarted]())
+	svmGatewayTxsConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmSVMGatewayTxsStarted]())
+	signing := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.SigningStarted]())
+
+	fetchCtx, stopFetching := context.WithCancel(ctx)
+	defer stopFetching()
+
+	var eGroup errgroup.Group
+	eGroup.Go(func() error {
+		select {
+		case <-fetchCtx.Done():
+			return nil
+		case err := <-eventBus.FetchEvents(fetchCtx):
+			stopFetching()
+			return err
+		}
+	})
+	eGroup.Go(func() error {
+		// subscriptions are only closed after all events that were already fetched have been delivered,
+		// so it is safe to stop as soon as the first block after the range shows up
+		for range pastRange {
+			stopFetching()
+		}
+
+		return nil
+	})
+	eGroup.Go(replayJob(r, tokConf, func(e *evmTypes.ConfirmTokenStarted) []replayedSession {
+		return []replayedSession{replayedPoll(e.PollID)}
+	}, evmMgr.ProcessTokenConfirmation))
+	eGroup.Go(replayJob(r, traConf, func(e *evmTypes.ConfirmKeyTransferStarted) []replayedSession {
+		return []replayedSession{replayedPoll(e.PollID)}
+	}, evmMgr.ProcessTransferKeyConfirmation))
+	eGroup.Go(replayJob(r, gatewayTxsConf, func(e *evmTypes.ConfirmGatewayTxsStarted) []replayedSession {
+		return slices.Map(e.PollMappings, func(m evmTypes.PollMapping) replayedSession { return replayedPoll(m.PollID) })
+	}, evmMgr.ProcessGatewayTxsConfirmation))
+	eGroup.Go(replayJob(r, svmGatewayTxsConf, func(e *evmTypes.ConfirmSVMGatewayTxsStarted) []replayedSession {
+		return slices.Map(e.PollMappings, func(m evmTypes.SVMPollMapping) replayedSession { return replayedPoll(m.PollID) })
+	}, svmMgr.ProcessGatewayTxsConfirmation))
+	eGroup.Go(replayJob(r, signing, func(e *multisigTypes.SigningStarted) []replayedSession {
+		return []replayedSession{replayedSigning(e.SigID)}
+	}, multisigMgr.ProcessSigningStarted))
+
+	if err := eGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	<-eventBus.Done()
+	r.processing.Wait()
+
+	return r.started, nil
+}
+
+type replayer struct {
+	to         int64
+	processing sync.WaitGroup
+	lock       sync.Mutex
+	started    []replayedSession
+}
+
+func (r *replayer) markStarted(height int64, sessions ...replayedSession) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, session := range sessions {
+		session.Height = height
+		r.started = append(r.started, session)
+	}
+}
+
+func replayJob[T proto.Message](r *replayer, sub <-chan tmEvents.ABCIEventWithHeight, sessions func(T) []replayedSession, processor func(T) error) func() error {
+	return func() error {
+		for e := range sub {
+			if e.Height > r.to {
+				continue
+			}
+
+			event := funcs.Must(sdk.ParseTypedEvent(e.Event)).(T)
+			r.markStarted(e.Height, sessions(event)...)
+
+			r.processing.Add(1)
+			go func() {
+				defer r.processing.Done()
+
+				if err := processor(event); err != nil {
+					log.Errorf("failed to process event at height %d: %s", e.Height, err.Error())
+				}
+			}()
+		}
+
+		return nil
+	}
+}
+
+func replayedPoll(pollID vote.PollID) replayedSession {
+	return replayedSession{
+		Key: pollKey(pollID),
+		Lookup: func(ctx context.Context, clientCtx sdkClient.Context) (proto.Message, error) {
+			return voteTypes.NewQueryServiceClient(clientCtx).PollVotes(ctx, &voteTypes.PollVotesRequest{PollID: pollID})
+		},
+	}
+}
+
+func replayedSigning(sigID uint64) replayedSession {
+	return replayedSession{
+		Key: signingKey(sigID),
+		Lookup: func(ctx context.Context, clientCtx sdkClient.Context) (proto.Message, error) {
+			return multisigTypes.NewQueryServiceClient(clientCtx).SigningSession(ctx, &multisigTypes.SigningSessionRequest{ID: sigID})
+		},
+	}
+}
+
+// queryOnChainRecords looks up every replayed poll and signing session at the last height it can be found in state,
+// up to the given height, so the record contains all votes and signatures that were submitted in time
+func queryOnChainRecords(ctx context.Context, clientCtx sdkClient.Context, sessions []replayedSession, lastHeight int64) (map[string]onChainRecord, error) {
+	records := make(map[string]onChainRecord)
+	for _, session := range sessions {
+		height, err := lastHeightInState(ctx, clientCtx, session, lastHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := session.Lookup(ctx, clientCtx.WithHeight(height))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to query %s at height %d", session.Key, height)
+		}
+
+		records[session.Key] = onChainRecord{Height: height, Record: record}
+	}
+
+	return records, nil
+}
+
+// lastHeightInState returns the last height at which the given session is stored on-chain.
+// Polls and signing sessions stay in state from the height they are started until they are deleted after they expire,
+// so the height can be found with a binary search.
+func lastHeightInState(ctx context.Context, clientCtx sdkClient.Context, session replayedSession, lastHeight int64) (int64, error) {
+	var queryErr error
+	deletedAfter := sort.Search(int(lastHeight-session.Height)+1, func(i int) bool {
+		if queryErr != nil {
+			return true
+		}
+
+		_, err := session.Lookup(ctx, clientCtx.WithHeight(session.Height+int64(i)))
+		switch {
+		case err == nil:
+			return false
+		case status.Code(err) == codes.NotFound:
+			return true
+		default:
+			queryErr = err
+			return true
+		}
+	})
+
+	switch {
+	case queryErr != nil:
+		return 0, errorsmod.Wrapf(queryErr, "failed to query %s", session.Key)
+	case deletedAfter == 0:
+		return 0, fmt.Errorf("%s not found on-chain at height %d, the node might have pruned it", session.Key, session.Height)
+	default:
+		return session.Height + int64(deletedAfter) - 1, nil
+	}
+}
+
+func pollKey(pollID vote.PollID) string { return fmt.Sprintf("poll/%s", pollID.String()) }
+
+func signingKey(sigID uint64) string { return fmt.Sprintf("signing/%d", sigID) }
+
+func replayKey(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *voteTypes.VoteRequest:
+		return pollKey(msg.PollID), true
+	case *multisigTypes.SubmitSignatureRequest:
+		return signingKey(msg.SigID), true
+	default:
+		return "", false
+	}
+}
+
+// participation returns the vote the validator cast in a poll or the signing session it signed, if any
+func participation(record proto.Message, valAddr sdk.ValAddress) (proto.Message, bool) {
+	switch record := record.(type) {
+	case *voteTypes.PollVotesResponse:
+		for _, talliedVote := range record.Votes {
+			if _, ok := talliedVote.IsVoterLate[valAddr.String()]; ok {
+				return &talliedVote, true
+			}
+		}
+
+		return nil, false
+	case *multisigTypes.SigningSessionResponse:
+		signed := slices.Any(record.SigningSession.Signers, func(signer string) bool { return signer == valAddr.String() })
+		return &record.SigningSession, signed
+	default:
+		return nil, false
+	}
+}
+
+func isEquivalent(expected sdk.Msg, actual proto.Message) bool {
+	switch expected := expected.(type) {
+	case *voteTypes.VoteRequest:
+		actual, ok := actual.(*voteTypes.TalliedVote)
+
+		return ok && expected.Vote != nil && actual.Data != nil &&
+			expected.Vote.TypeUrl == actual.Data.TypeUrl && bytes.Equal(expected.Vote.Value, actual.Data.Value)
+	case *multisigTypes.SubmitSignatureRequest:
+		// signatures are not necessarily deterministic, so the replayed signature only carries the signed payload hash
+		actual, ok := actual.(*multisigTypes.SigningSessionInfo)
+
+		return ok && expected.SigID == actual.ID && bytes.Equal(expected.Signature, actual.PayloadHash)
+	default:
+		return false
+	}
+}
+
+// compareReplayedMsgs matches the replayed msgs with the votes and signatures of the validator stored on-chain.
+// Polls and signing sessions that were not started in the replayed block range are ignored.
+func compareReplayedMsgs(toJSON func(proto.Message) ([]byte, error), valAddr sdk.ValAddress, expected []sdk.Msg, onChain map[string]onChainRecord) []replayEntry {
+	var entries []replayEntry
+	seen := make(map[string]bool)
+	for _, msg := range expected {
+		key, ok := replayKey(msg)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		record, ok := onChain[key]
+		if !ok {
+			continue
+		}
+
+		entry := replayEntry{Key: key, Status: replayMissingOnChain, Height: record.Height, Expected: funcs.Must(toJSON(msg))}
+		if actual, ok := participation(record.Record, valAddr); ok {
+			entry.Actual = funcs.Must(toJSON(actual))
+			entry.Status = replayMismatch
+			if isEquivalent(msg, actual) {
+				entry.Status = replayMatch
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	keys := maps.Keys(onChain)
+	sort.Strings(keys)
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+
+		record := onChain[key]
+		if actual, ok := participation(record.Record, valAddr); ok {
+			entries = append(entries, replayEntry{Key: key, Status: replayUnexpected, Height: record.Height, Actual: funcs.Must(toJSON(actual))})
+		}
+	}
+
+	return entries
+}
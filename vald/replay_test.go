@@ -0,0 +1,136 @@
+package vald
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestCompareReplayedMsgs(t *testing.T) {
+	var (
+		valAddr     sdk.ValAddress
+		pollID      vote.PollID
+		sigID       uint64
+		payloadHash []byte
+		expected    []sdk.Msg
+		onChain     map[string]onChainRecord
+		entries     []replayEntry
+	)
+
+	toJSON := app.MakeEncodingConfig().Codec.MarshalJSON
+	chain := nexus.ChainName(rand.Str(5))
+
+	talliedVote := func(events *evmTypes.VoteEvents, voters ...sdk.ValAddress) voteTypes.TalliedVote {
+		isVoterLate := make(map[string]bool)
+		for _, voter := range voters {
+			isVoterLate[voter.String()] = false
+		}
+
+		return voteTypes.TalliedVote{PollID: pollID, Data: funcs.Must(codectypes.NewAnyWithValue(events)), IsVoterLate: isVoterLate}
+	}
+
+	signingSession := func(payloadHash []byte, signers ...sdk.ValAddress) *multisigTypes.SigningSessionResponse {
+		info := multisigTypes.SigningSessionInfo{ID: sigID, PayloadHash: payloadHash}
+		for _, signer := range signers {
+			info.Signers = append(info.Signers, signer.String())
+		}
+
+		return &multisigTypes.SigningSessionResponse{SigningSession: info}
+	}
+
+	givenReplayedMsgs := Given("a vote and a signature that vald would have sent", func() {
+		proxy := rand.AccAddr()
+		valAddr = rand.ValAddr()
+		pollID = vote.PollID(rand.PosI64())
+		sigID = uint64(rand.PosI64())
+		payloadHash = rand.Bytes(32)
+
+		// the dry-run signer returns the payload hash in place of a signature
+		expected = []sdk.Msg{
+			voteTypes.NewVoteRequest(proxy, pollID, evmTypes.NewVoteEvents(chain)),
+			multisigTypes.NewSubmitSignatureRequest(proxy, sigID, payloadHash),
+		}
+	})
+
+	compare := func() { entries = compareReplayedMsgs(toJSON, valAddr, expected, onChain) }
+
+	givenReplayedMsgs.
+		When("the validator cast the same vote and signed the same payload on-chain", func() {
+			onChain = map[string]onChainRecord{
+				pollKey(pollID):   {Height: 10, Record: &voteTypes.PollVotesResponse{Votes: []voteTypes.TalliedVote{talliedVote(evmTypes.NewVoteEvents(chain), rand.ValAddr(), valAddr)}}},
+				signingKey(sigID): {Height: 11, Record: signingSession(payloadHash, valAddr)},
+			}
+		}).
+		When("the msgs are compared", compare).
+		Then("all entries match", func(t *testing.T) {
+			assert.Len(t, entries, 2)
+			for _, entry := range entries {
+				assert.Equal(t, replayMatch, entry.Status)
+				assert.NotEmpty(t, entry.Actual)
+			}
+			assert.EqualValues(t, 10, entries[0].Height)
+		}).
+		Run(t)
+
+	givenReplayedMsgs.
+		When("the validator cast a different vote and did not sign on-chain", func() {
+			events := evmTypes.NewVoteEvents(chain, evmTypes.Event{Chain: chain, TxID: evmTypes.Hash(rand.Bytes(32)), Index: 1})
+			onChain = map[string]onChainRecord{
+				pollKey(pollID): {Height: 10, Record: &voteTypes.PollVotesResponse{Votes: []voteTypes.TalliedVote{
+					talliedVote(evmTypes.NewVoteEvents(chain), rand.ValAddr()),
+					talliedVote(events, valAddr),
+				}}},
+				signingKey(sigID): {Height: 11, Record: signingSession(payloadHash, rand.ValAddr())},
+			}
+		}).
+		When("the msgs are compared", compare).
+		Then("the vote mismatches and the signature is missing", func(t *testing.T) {
+			assert.Len(t, entries, 2)
+			assert.Equal(t, replayMismatch, entries[0].Status)
+			assert.Equal(t, replayMissingOnChain, entries[1].Status)
+			assert.Empty(t, entries[1].Actual)
+		}).
+		Run(t)
+
+	givenReplayedMsgs.
+		When("the validator signed a different payload on-chain", func() {
+			onChain = map[string]onChainRecord{
+				signingKey(sigID): {Height: 11, Record: signingSession(rand.Bytes(32), valAddr)},
+			}
+		}).
+		When("the msgs are compared", compare).
+		Then("the signature mismatches", func(t *testing.T) {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, signingKey(sigID), entries[0].Key)
+			assert.Equal(t, replayMismatch, entries[0].Status)
+		}).
+		Run(t)
+
+	givenReplayedMsgs.
+		When("vald would not have sent anything", func() {
+			expected = nil
+			onChain = map[string]onChainRecord{
+				pollKey(pollID):   {Height: 10, Record: &voteTypes.PollVotesResponse{Votes: []voteTypes.TalliedVote{talliedVote(evmTypes.NewVoteEvents(chain), valAddr)}}},
+				signingKey(sigID): {Height: 11, Record: signingSession(payloadHash, rand.ValAddr())},
+			}
+		}).
+		When("the msgs are compared", compare).
+		Then("only the sessions the validator participated in are unexpected", func(t *testing.T) {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, replayUnexpected, entries[0].Status)
+			assert.Equal(t, pollKey(pollID), entries[0].Key)
+		}).
+		Run(t)
+}
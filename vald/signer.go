@@ -0,0 +1,162 @@
+package vald
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/axelarnetwork/axelar-core/vald/multisig"
+	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
+	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
+	"github.com/axelarnetwork/utils/log"
+)
+
+const (
+	// localSignerPasswordEnv is the environment variable holding the password of the local signer file
+	localSignerPasswordEnv = "VALD_LOCAL_SIGNER_PASSWORD"
+	localSignerFileName    = "local_signer.json"
+
+	flagSignerBackend   = "signer-backend"
+	flagLocalSignerFile = "local-signer-file"
+	flagRecover         = "recover"
+)
+
+// bindTssFlags binds the signer flags set by setPersistentFlags to the tss section of the vald config
+func bindTssFlags(v *viper.Viper, cmd *cobra.Command) error {
+	for key, flag := range map[string]string{
+		"tss.tofnd-host":         "tofnd-host",
+		"tss.tofnd-port":         "tofnd-port",
+		"tss.tofnd-dial-timeout": "tofnd-dial-timeout",
+		"tss.signer-backend":     flagSignerBackend,
+		"tss.local-signer-file":  flagLocalSignerFile,
+	} {
+		if err := v.BindPFlag(key, cmd.PersistentFlags().Lookup(flag)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// localSignerPath returns the path of the local signer file, defaulting to the vald home directory
+func localSignerPath(cfg tssTypes.TssConfig, homeDir string) string {
+	if cfg.LocalSignerFile != "" {
+		return cfg.LocalSignerFile
+	}
+
+	return filepath.Join(homeDir, "vald", localSignerFileName)
+}
+
+// createSigner returns the signer backend selected by the given config
+func createSigner(cfg tssTypes.TssConfig, homeDir string, valAddr sdk.ValAddress) (multisig.Signer, error) {
+	if err := cfg.ValidateSignerBackend(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.SignerBackend {
+	case tssTypes.LocalSignerBackend:
+		path := localSignerPath(cfg, homeDir)
+		signer, err := multisig.LoadLocalSigner(path, os.Getenv(localSignerPasswordEnv))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to load local signer (password is read from %s)", localSignerPasswordEnv)
+		}
+		log.Infof("using local signer backend with key file %s", path)
+
+		return signer, nil
+	default:
+		conn, err := grpc.Connect(cfg.Host, cfg.Port, cfg.DialTimeout)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to reach tofnd")
+		}
+		log.Debug("successful connection to tofnd gRPC server")
+
+		return multisig.NewTofndSigner(tofnd.NewMultisigClient(conn), valAddr), nil
+	}
+}
+
+// GetLocalSignerCommand returns the command to manage the key file of the local signer backend
+func GetLocalSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vald-local-signer",
+		Short: "Manage the encrypted mnemonic of the local signer backend (an alternative to tofnd for devnets and tests)",
+	}
+
+	cmd.AddCommand(getLocalSignerInitCommand())
+
+	return cmd
+}
+
+func getLocalSignerInitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: fmt.Sprintf("Create the encrypted mnemonic file of the local signer. The password is read from %s if set", localSignerPasswordEnv),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+
+			path, err := cmd.Flags().GetString(flagLocalSignerFile)
+			if err != nil {
+				return err
+			}
+			path = localSignerPath(tssTypes.TssConfig{LocalSignerFile: path}, clientCtx.HomeDir)
+
+			recoverMnemonic, err := cmd.Flags().GetBool(flagRecover)
+			if err != nil {
+				return err
+			}
+
+			var mnemonic string
+			if recoverMnemonic {
+				mnemonic, err = input.GetString("Enter your bip39 mnemonic", inBuf)
+				if err != nil {
+					return err
+				}
+			} else {
+				entropy, err := bip39.NewEntropy(256)
+				if err != nil {
+					return err
+				}
+
+				mnemonic, err = bip39.NewMnemonic(entropy)
+				if err != nil {
+					return err
+				}
+			}
+
+			password, ok := os.LookupEnv(localSignerPasswordEnv)
+			if !ok {
+				password, err = input.GetPassword("Enter the password to encrypt the mnemonic with:", inBuf)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := multisig.WriteLocalSignerFile(path, mnemonic, password); err != nil {
+				return err
+			}
+
+			cmd.Printf("local signer file written to %s\n", path)
+			if !recoverMnemonic {
+				cmd.Printf("\n**Important** write this mnemonic phrase in a safe place, it is the only way to recover the keys of the local signer:\n\n%s\n", mnemonic)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagLocalSignerFile, "", fmt.Sprintf("path of the local signer file (default \"<home>/vald/%s\")", localSignerFileName))
+	cmd.Flags().Bool(flagRecover, false, "provide an existing mnemonic instead of generating a new one")
+
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	ethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -32,11 +34,12 @@ import (
 	"github.com/axelarnetwork/axelar-core/vald/evm"
 	evmRPC "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
 	"github.com/axelarnetwork/axelar-core/vald/multisig"
-	grpc "github.com/axelarnetwork/axelar-core/vald/tofnd_grpc"
+	"github.com/axelarnetwork/axelar-core/vald/policy"
+	"github.com/axelarnetwork/axelar-core/vald/svm"
+	svmRPC "github.com/axelarnetwork/axelar-core/vald/svm/rpc"
 	axelarnet "github.com/axelarnetwork/axelar-core/x/axelarnet/exported"
 	evmTypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
-	"github.com/axelarnetwork/axelar-core/x/tss/tofnd"
 	tssTypes "github.com/axelarnetwork/axelar-core/x/tss/types"
 	tmEvents "github.com/axelarnetwork/tm-events/events"
 	"github.com/axelarnetwork/tm-events/pubsub"
@@ -75,13 +78,7 @@ func GetValdCommand() *cobra.Command {
 			log.Setup(logger)
 			v := serverCtx.Viper
 
-			if err := v.BindPFlag("tss.tofnd-host", cmd.PersistentFlags().Lookup("tofnd-host")); err != nil {
-				return err
-			}
-			if err := v.BindPFlag("tss.tofnd-port", cmd.PersistentFlags().Lookup("tofnd-port")); err != nil {
-				return err
-			}
-			if err := v.BindPFlag("tss.tofnd-dial-timeout", cmd.PersistentFlags().Lookup("tofnd-dial-timeout")); err != nil {
+			if err := bindTssFlags(v, cmd); err != nil {
 				return err
 			}
 
@@ -155,8 +152,17 @@ func runVald(cliCtx sdkClient.Context, txf tx.Factory, viper *viper.Viper) error
 	fPath := filepath.Join(valdHome, "state.json")
 	stateSource := NewRWFile(fPath)
 
+	var outbox *broadcast.Outbox
+	if valdConf.EnableOutbox {
+		outbox, err = openOutbox(valdHome)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = outbox.Close() }()
+	}
+
 	log.Info("start listening to events")
-	listen(cliCtx, txf, valdConf, valAddr, stateSource)
+	listen(cliCtx, txf, valdConf, valAddr, stateSource, outbox)
 	log.Info("shutting down")
 	return nil
 }
@@ -172,11 +178,13 @@ func setPersistentFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("tofnd-host", defaultConf.Host, "host name for tss daemon")
 	cmd.PersistentFlags().String("tofnd-port", defaultConf.Port, "port for tss daemon")
 	cmd.PersistentFlags().String("tofnd-dial-timeout", defaultConf.DialTimeout.String(), "dialup timeout to the tss daemon")
+	cmd.PersistentFlags().String(flagSignerBackend, defaultConf.SignerBackend, fmt.Sprintf("backend used to generate keys and sign (%s|%s)", tssTypes.TofndSignerBackend, tssTypes.LocalSignerBackend))
+	cmd.PersistentFlags().String(flagLocalSignerFile, defaultConf.LocalSignerFile, fmt.Sprintf("path of the encrypted mnemonic of the local signer backend (default \"<home>/vald/%s\")", localSignerFileName))
 	cmd.PersistentFlags().String("validator-addr", "", "the address of the validator operator, i.e axelarvaloper1..")
 	cmd.PersistentFlags().String(flags.FlagChainID, app.Name, "The network chain ID")
 }
 
-func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter) {
+func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdConfig, valAddr sdk.ValAddress, stateSource ReadWriter, outbox *broadcast.Outbox) {
 	sender, err := clientCtx.Keyring.Key(clientCtx.From)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to read broadcaster account info from keyring"))
@@ -185,27 +193,26 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 		WithFromAddress(funcs.Must(sender.GetAddress())).
 		WithFromName(sender.Name)
 
-	robustClient := tendermint.NewRobustClient(func() (rpcclient.Client, error) {
-		cl, err := sdkClient.NewClientFromNode(clientCtx.NodeURI)
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to create a new client")
-		}
-
-		err = cl.Start()
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to start client")
-		}
-		return cl, nil
-	})
+	robustClient := createRobustClient(clientCtx.NodeURI)
 
 	bc := createRefundableBroadcaster(txf, clientCtx, axelarCfg, robustClient)
-	evmMgr := createEVMMgr(axelarCfg, clientCtx, bc, valAddr)
-	multisigMgr := createMultisigMgr(bc, clientCtx, axelarCfg, valAddr)
+
+	// msgs are persisted at the latest block height, so they can be discarded on replay once their poll or signing session has expired
+	var latestBlock atomic.Int64
+	outboxed := bc
+	if outbox != nil {
+		outboxed = broadcast.WithOutbox(bc, outbox, latestBlock.Load)
+	}
+
+	evmMgr := createEVMMgr(axelarCfg, clientCtx, outboxed, valAddr)
+	svmMgr := createSVMMgr(axelarCfg, clientCtx, outboxed, valAddr)
+	multisigMgr := createMultisigMgr(outboxed, clientCtx, axelarCfg, valAddr)
 
 	nodeHeight, err := waitUntilNetworkSync(axelarCfg, robustClient)
 	if err != nil {
 		panic(err)
 	}
+	latestBlock.Store(nodeHeight)
 
 	stateStore := NewStateStore(stateSource)
 	startBlock, err := getStartBlock(axelarCfg, stateStore, nodeHeight)
@@ -227,6 +234,7 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 	evmTokConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmTokenStarted]())
 	evmTraConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmKeyTransferStarted]())
 	evmGatewayTxsConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmGatewayTxsStarted]())
+	svmGatewayTxsConf := eventBus.Subscribe(tmEvents.Filter[*evmTypes.ConfirmSVMGatewayTxsStarted]())
 
 	multisigKeygen := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.KeygenStarted]())
 	multisigSigning := eventBus.Subscribe(tmEvents.Filter[*multisigTypes.SigningStarted]())
@@ -263,6 +271,7 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 	processBlockHeader := func(event tmEvents.Event) error {
 		timer.Stop()
 		timer = time.AfterFunc(axelarCfg.NoNewBlockPanicTimeout, timeoutCancel)
+		latestBlock.Store(event.Height)
 
 		return stateStore.SetState(event.Height)
 	}
@@ -284,10 +293,19 @@ func listen(clientCtx sdkClient.Context, txf tx.Factory, axelarCfg config.ValdCo
 		createJobTyped(evmTokConf, evmMgr.ProcessTokenConfirmation, cancelEventCtx),
 		createJobTyped(evmTraConf, evmMgr.ProcessTransferKeyConfirmation, cancelEventCtx),
 		createJobTyped(evmGatewayTxsConf, evmMgr.ProcessGatewayTxsConfirmation, cancelEventCtx),
+		createJobTyped(svmGatewayTxsConf, svmMgr.ProcessGatewayTxsConfirmation, cancelEventCtx),
 		createJobTyped(multisigKeygen, multisigMgr.ProcessKeygenStarted, cancelEventCtx),
 		createJobTyped(multisigSigning, multisigMgr.ProcessSigningStarted, cancelEventCtx),
 	}
 
+	if outbox != nil {
+		js = append(js, createOutboxReplayJob(outbox, bc, newOutboxExpiryCheck(clientCtx, valAddr, nodeHeight)))
+	}
+
+	if axelarCfg.MetricsConfig.Enabled {
+		js = append(js, createMetricsJob(axelarCfg.MetricsConfig))
+	}
+
 	slices.ForEach(js, func(job jobs.Job) {
 		eGroup.Go(func() error { return job(eventCtx) })
 	})
@@ -389,6 +407,21 @@ func getStartBlock(cfg config.ValdConfig, stateStore StateStore, nodeHeight int6
 	return startBlock, nil
 }
 
+func createRobustClient(nodeURI string) *tendermint.RobustClient {
+	return tendermint.NewRobustClient(func() (rpcclient.Client, error) {
+		cl, err := sdkClient.NewClientFromNode(nodeURI)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create a new client")
+		}
+
+		err = cl.Start()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to start client")
+		}
+		return cl, nil
+	})
+}
+
 func createEventBus(client *tendermint.RobustClient, startBlock int64, retries int, backOff time.Duration) *tmEvents.Bus {
 	notifier := tmEvents.NewBlockNotifier(client, tmEvents.Retries(retries), tmEvents.BackOff(backOff)).StartingAt(startBlock)
 	return tmEvents.NewEventBus(tmEvents.NewBlockSource(client, notifier, tmEvents.Retries(retries), tmEvents.BackOff(backOff)), pubsub.NewBus[tmEvents.ABCIEventWithHeight]())
@@ -412,21 +445,93 @@ func createRefundableBroadcaster(txf tx.Factory, ctx sdkClient.Context, axelarCf
 }
 
 func createMultisigMgr(broadcaster broadcast.Broadcaster, cliCtx sdkClient.Context, axelarCfg config.ValdConfig, valAddr sdk.ValAddress) *multisig.Mgr {
-	conn, err := grpc.Connect(axelarCfg.TssConfig.Host, axelarCfg.TssConfig.Port, axelarCfg.TssConfig.DialTimeout)
+	signer, err := createSigner(axelarCfg.TssConfig, cliCtx.HomeDir, valAddr)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "failed to create multisig manager"))
 	}
-	log.Debug("successful connection to tofnd gRPC server")
 
-	return multisig.NewMgr(tofnd.NewMultisigClient(conn), cliCtx, valAddr, broadcaster, timeout)
+	return createMultisigMgrWithSigner(signer, broadcaster, cliCtx, axelarCfg, valAddr)
+}
+
+func createMultisigMgrWithSigner(signer multisig.Signer, broadcaster broadcast.Broadcaster, cliCtx sdkClient.Context, axelarCfg config.ValdConfig, valAddr sdk.ValAddress) *multisig.Mgr {
+	var signingPolicy multisig.SigningPolicy
+	if axelarCfg.SigningPolicyFile != "" {
+		p, err := policy.Load(axelarCfg.SigningPolicyFile)
+		if err != nil {
+			panic(errorsmod.Wrap(err, "failed to create multisig manager"))
+		}
+		log.Infof("enforcing signing policy from %s", axelarCfg.SigningPolicyFile)
+
+		signingPolicy = policy.NewChecker(p, cliCtx)
+	}
+
+	return multisig.NewMgr(signer, signingPolicy, cliCtx, valAddr, broadcaster, timeout)
+}
+
+func createOutboxReplayJob(outbox *broadcast.Outbox, broadcaster broadcast.Broadcaster, isExpired func(broadcast.OutboxEntry, sdk.Msg) bool) jobs.Job {
+	return func(ctx context.Context) error {
+		// failing to replay the outbox must not stop vald from processing new events
+		if err := outbox.Replay(ctx, broadcaster, isExpired); err != nil {
+			log.Error(err.Error())
+		}
+
+		return nil
+	}
+}
+
+func createMetricsJob(cfg config.MetricsConfig) jobs.Job {
+	registry, err := newMetricsRegistry()
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to register metrics"))
+	}
+
+	job, err := createMetricsServer(cfg.ListenAddr, registry)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to start metrics server"))
+	}
+
+	return job
 }
 
 func createEVMClient(config evmTypes.EVMConfig) (evmRPC.Client, error) {
-	return evmRPC.NewClient(config.RPCAddr, config.FinalityOverride)
+	endpoints := config.RPCEndpoints()
+	if len(endpoints) == 1 && config.RPCQuorum <= 1 {
+		return evmRPC.NewClient(config.RPCAddr, config.FinalityOverride)
+	}
+
+	client, err := evmRPC.NewMultiClient(slices.Map(endpoints, func(url string) evmRPC.Dialer {
+		return evmRPC.DialURL(url, config.FinalityOverride)
+	}), config.RPCQuorum)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func createFinalityStrategy(config evmTypes.EVMConfig) (evmRPC.FinalityStrategy, error) {
+	if config.Finality != evmRPC.L1BatchFinality {
+		return evmRPC.NewFinalityStrategy(config.Finality)
+	}
+
+	if config.RollupRPCAddr == nil {
+		return nil, fmt.Errorf("finality strategy %s requires rollup_rpc_addr to be set", config.Finality)
+	}
+
+	rollup, err := ethRPC.DialContext(context.Background(), *config.RollupRPCAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// clean up rollup node connection on process shutdown
+	cleanupCommands = append(cleanupCommands, rollup.Close)
+
+	return evmRPC.NewL1BatchFinality(rollup), nil
 }
 
 func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, valAddr sdk.ValAddress) *evm.Mgr {
 	rpcs := make(map[string]evmRPC.Client)
+	finalities := make(map[string]evmRPC.FinalityStrategy)
 
 	chainConfigs := slices.Filter(axelarCfg.EVMConfig, func(config evmTypes.EVMConfig) bool {
 		return config.WithBridge
@@ -451,17 +556,58 @@ func createEVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broad
 			panic(err)
 		}
 
-		log.WithKeyVals("chain", config.Name, "url", config.RPCAddr).
+		log.WithKeyVals("chain", config.Name, "urls", config.RPCEndpoints(), "quorum", config.RPCQuorum).
 			Debugf("created JSON-RPC client of type %T", client)
 
 		// clean up evmRPC connection on process shutdown
 		cleanupCommands = append(cleanupCommands, client.Close)
 
-		rpcs[chainName] = client
+		rpcs[chainName] = evmRPC.WithMetrics(client, chainName)
 		log.Infof("successfully connected to EVM bridge for chain %s", chainName)
+
+		finality, err := createFinalityStrategy(config)
+		if err != nil {
+			err = errorsmod.Wrap(err, fmt.Sprintf("failed to set up the finality strategy for EVM chain %s. Verify your RPC config.", config.Name))
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		finalities[chainName] = finality
+	})
+
+	return evm.NewMgr(rpcs, finalities, b, valAddr, cliCtx.FromAddress, evm.NewLatestFinalizedBlockCache())
+}
+
+func createSVMMgr(axelarCfg config.ValdConfig, cliCtx sdkClient.Context, b broadcast.Broadcaster, valAddr sdk.ValAddress) *svm.Mgr {
+	rpcs := make(map[string]svmRPC.Client)
+
+	chainConfigs := slices.Filter(axelarCfg.SVMConfig, func(config evmTypes.SVMConfig) bool {
+		return config.WithBridge
+	})
+
+	slices.ForEach(chainConfigs, func(config evmTypes.SVMConfig) {
+		chainName := strings.ToLower(config.Name)
+		if _, ok := rpcs[chainName]; ok {
+			err := fmt.Errorf("duplicate bridge configuration found for SVM chain %s", config.Name)
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		client, err := svmRPC.NewClient(config.RPCAddr)
+		if err != nil {
+			err = errorsmod.Wrap(err, fmt.Sprintf("failed to create an RPC connection for SVM chain %s. Verify your RPC config.", config.Name))
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		// clean up svmRPC connection on process shutdown
+		cleanupCommands = append(cleanupCommands, client.Close)
+
+		rpcs[chainName] = client
+		log.Infof("successfully connected to SVM bridge for chain %s", chainName)
 	})
 
-	return evm.NewMgr(rpcs, b, valAddr, cliCtx.FromAddress, evm.NewLatestFinalizedBlockCache())
+	return svm.NewMgr(rpcs, b, valAddr, cliCtx.FromAddress)
 }
 
 // RWFile implements the ReadWriter interface for an underlying file
@@ -54,5 +54,11 @@ func (s StateStore) SetState(completed int64) error {
 	if err != nil {
 		return err
 	}
-	return s.rw.WriteAll(bz)
+
+	if err := s.rw.WriteAll(bz); err != nil {
+		return err
+	}
+
+	lastProcessedBlock.Set(float64(completed))
+	return nil
 }
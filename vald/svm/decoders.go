@@ -0,0 +1,184 @@
+package svm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// Gateway program event discriminators
+var (
+	ContractCallDiscriminator          = eventDiscriminator("CallContractEvent")
+	ContractCallWithTokenDiscriminator = eventDiscriminator("CallContractWithTokenEvent")
+)
+
+const programDataPrefix = "Program data: "
+
+var (
+	programInvokeRegex = regexp.MustCompile(`^Program (\w+) invoke \[\d+\]$`)
+	programExitRegex   = regexp.MustCompile(`^Program (\w+) (success|failed)`)
+)
+
+// eventDiscriminator returns the prefix that identifies an event emitted by an anchor program
+func eventDiscriminator(name string) [8]byte {
+	hash := sha256.Sum256([]byte("event:" + name))
+	return [8]byte(hash[:8])
+}
+
+// ProgramLog is the data emitted by a program through a "Program data:" log message
+type ProgramLog struct {
+	// Index is the position of the log message in the transaction logs
+	Index uint64
+	Data  []byte
+}
+
+// ParseProgramLogs returns the data emitted directly by the given program in the order it appears in the transaction logs.
+// Data emitted by programs invoked through a cross-program invocation is attributed to the invoked program.
+func ParseProgramLogs(programID string, logMessages []string) []ProgramLog {
+	var invoked []string
+	var logs []ProgramLog
+	for i, msg := range logMessages {
+		if match := programInvokeRegex.FindStringSubmatch(msg); match != nil {
+			invoked = append(invoked, match[1])
+			continue
+		}
+
+		if match := programExitRegex.FindStringSubmatch(msg); match != nil {
+			if len(invoked) > 0 && invoked[len(invoked)-1] == match[1] {
+				invoked = invoked[:len(invoked)-1]
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(msg, programDataPrefix) || len(invoked) == 0 || invoked[len(invoked)-1] != programID {
+			continue
+		}
+
+		var data []byte
+		for _, chunk := range strings.Fields(strings.TrimPrefix(msg, programDataPrefix)) {
+			bz, err := base64.StdEncoding.DecodeString(chunk)
+			if err != nil {
+				data = nil
+				break
+			}
+
+			data = append(data, bz...)
+		}
+
+		if len(data) == 0 {
+			continue
+		}
+
+		logs = append(logs, ProgramLog{Index: uint64(i), Data: data})
+	}
+
+	return logs
+}
+
+// DecodeEventContractCall decodes a CallContractEvent of the gateway program.
+// The event is borsh encoded as (sender: Pubkey, payload_hash: [u8; 32], destination_chain: String,
+// destination_contract_address: String, payload: Vec<u8>), prefixed by its discriminator.
+func DecodeEventContractCall(data []byte) (types.EventContractCall, error) {
+	if !bytes.HasPrefix(data, ContractCallDiscriminator[:]) {
+		return types.EventContractCall{}, fmt.Errorf("event is not for a contract call")
+	}
+
+	r := borshReader{data: data[len(ContractCallDiscriminator):]}
+	sender := r.fixed(32)
+	payloadHash := r.fixed(32)
+	destinationChain := r.string()
+	contractAddress := r.string()
+	if r.err != nil {
+		return types.EventContractCall{}, r.err
+	}
+
+	return types.EventContractCall{
+		SVMSender:        base58.Encode(sender),
+		DestinationChain: nexus.ChainName(destinationChain),
+		ContractAddress:  contractAddress,
+		PayloadHash:      types.Hash(payloadHash),
+	}, nil
+}
+
+// DecodeEventContractCallWithToken decodes a CallContractWithTokenEvent of the gateway program.
+// The event is borsh encoded as (sender: Pubkey, payload_hash: [u8; 32], destination_chain: String,
+// destination_contract_address: String, payload: Vec<u8>, symbol: String, amount: u64), prefixed by its discriminator.
+func DecodeEventContractCallWithToken(data []byte) (types.EventContractCallWithToken, error) {
+	if !bytes.HasPrefix(data, ContractCallWithTokenDiscriminator[:]) {
+		return types.EventContractCallWithToken{}, fmt.Errorf("event is not for a contract call with token")
+	}
+
+	r := borshReader{data: data[len(ContractCallWithTokenDiscriminator):]}
+	sender := r.fixed(32)
+	payloadHash := r.fixed(32)
+	destinationChain := r.string()
+	contractAddress := r.string()
+	r.bytes() // payload
+	symbol := r.string()
+	amount := r.u64()
+	if r.err != nil {
+		return types.EventContractCallWithToken{}, r.err
+	}
+
+	return types.EventContractCallWithToken{
+		SVMSender:        base58.Encode(sender),
+		DestinationChain: nexus.ChainName(destinationChain),
+		ContractAddress:  contractAddress,
+		PayloadHash:      types.Hash(payloadHash),
+		Symbol:           symbol,
+		Amount:           math.NewUint(amount),
+	}, nil
+}
+
+type borshReader struct {
+	data []byte
+	err  error
+}
+
+func (r *borshReader) fixed(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if len(r.data) < n {
+		r.err = fmt.Errorf("unexpected end of data")
+		return nil
+	}
+
+	bz := r.data[:n]
+	r.data = r.data[n:]
+
+	return bz
+}
+
+func (r *borshReader) u64() uint64 {
+	bz := r.fixed(8)
+	if r.err != nil {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(bz)
+}
+
+func (r *borshReader) bytes() []byte {
+	length := r.fixed(4)
+	if r.err != nil {
+		return nil
+	}
+
+	return r.fixed(int(binary.LittleEndian.Uint32(length)))
+}
+
+func (r *borshReader) string() string {
+	return string(r.bytes())
+}
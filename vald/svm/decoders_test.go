@@ -0,0 +1,136 @@
+package svm_test
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/svm"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+const (
+	gatewayProgramID = "gtwLjHAsfKAR6GWB4hzTUAA1w4SDdFMKamtGA5ttMEe"
+	otherProgramID   = "memoq4BEFGcXLtHHKUcM4KDFKQxjbSaRe3ABBvfY1EC"
+)
+
+func borshString(s string) []byte {
+	return append(binary.LittleEndian.AppendUint32(nil, uint32(len(s))), s...)
+}
+
+func encodeContractCall(sender []byte, payloadHash types.Hash, destinationChain, contractAddress string) []byte {
+	data := append(svm.ContractCallDiscriminator[:], sender...)
+	data = append(data, payloadHash.Bytes()...)
+	data = append(data, borshString(destinationChain)...)
+	data = append(data, borshString(contractAddress)...)
+
+	// payload
+	return append(data, borshString("payload")...)
+}
+
+func encodeContractCallWithToken(sender []byte, payloadHash types.Hash, destinationChain, contractAddress, symbol string, amount uint64) []byte {
+	data := append(svm.ContractCallWithTokenDiscriminator[:], sender...)
+	data = append(data, payloadHash.Bytes()...)
+	data = append(data, borshString(destinationChain)...)
+	data = append(data, borshString(contractAddress)...)
+	data = append(data, borshString("payload")...)
+	data = append(data, borshString(symbol)...)
+
+	return binary.LittleEndian.AppendUint64(data, amount)
+}
+
+func programData(data []byte) string {
+	return "Program data: " + base64.StdEncoding.EncodeToString(data)
+}
+
+func TestParseProgramLogs(t *testing.T) {
+	logMessages := []string{
+		"Program " + gatewayProgramID + " invoke [1]",
+		"Program log: Instruction: CallContract",
+		programData([]byte{1}),
+		"Program " + otherProgramID + " invoke [2]",
+		programData([]byte{2}),
+		"Program " + otherProgramID + " success",
+		"Program data: " + base64.StdEncoding.EncodeToString([]byte{3}) + " " + base64.StdEncoding.EncodeToString([]byte{4}),
+		"Program data: not base64",
+		"Program " + gatewayProgramID + " consumed 1000 of 200000 compute units",
+		"Program " + gatewayProgramID + " success",
+		programData([]byte{5}),
+	}
+
+	assert.Equal(t, []svm.ProgramLog{
+		{Index: 2, Data: []byte{1}},
+		{Index: 6, Data: []byte{3, 4}},
+	}, svm.ParseProgramLogs(gatewayProgramID, logMessages))
+	assert.Equal(t, []svm.ProgramLog{
+		{Index: 4, Data: []byte{2}},
+	}, svm.ParseProgramLogs(otherProgramID, logMessages))
+}
+
+func TestDecodeEventContractCall(t *testing.T) {
+	sender := rand.Bytes(32)
+	payloadHash := types.Hash(rand.Bytes(32))
+
+	t.Run("should decode a valid event", func(t *testing.T) {
+		event, err := svm.DecodeEventContractCall(encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"))
+		assert.NoError(t, err)
+		assert.Equal(t, types.EventContractCall{
+			SVMSender:        base58.Encode(sender),
+			DestinationChain: nexus.ChainName("ethereum"),
+			ContractAddress:  "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+			PayloadHash:      payloadHash,
+		}, event)
+	})
+
+	t.Run("should fail for a different event", func(t *testing.T) {
+		data := encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8")
+		data[0]++
+
+		_, err := svm.DecodeEventContractCall(data)
+		assert.ErrorContains(t, err, "not for a contract call")
+	})
+
+	t.Run("should fail for truncated data", func(t *testing.T) {
+		data := encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8")
+
+		_, err := svm.DecodeEventContractCall(data[:len(svm.ContractCallDiscriminator)+70])
+		assert.ErrorContains(t, err, "unexpected end of data")
+	})
+}
+
+func TestDecodeEventContractCallWithToken(t *testing.T) {
+	sender := rand.Bytes(32)
+	payloadHash := types.Hash(rand.Bytes(32))
+
+	t.Run("should decode a valid event", func(t *testing.T) {
+		event, err := svm.DecodeEventContractCallWithToken(encodeContractCallWithToken(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8", "USDC", 1000000))
+		assert.NoError(t, err)
+		assert.Equal(t, types.EventContractCallWithToken{
+			SVMSender:        base58.Encode(sender),
+			DestinationChain: nexus.ChainName("ethereum"),
+			ContractAddress:  "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+			PayloadHash:      payloadHash,
+			Symbol:           "USDC",
+			Amount:           math.NewUint(1000000),
+		}, event)
+		assert.NoError(t, event.ValidateBasic())
+	})
+
+	t.Run("should fail for a contract call without token", func(t *testing.T) {
+		_, err := svm.DecodeEventContractCallWithToken(encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"))
+		assert.ErrorContains(t, err, "not for a contract call with token")
+	})
+
+	t.Run("should fail for truncated data", func(t *testing.T) {
+		data := encodeContractCallWithToken(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8", "USDC", 1000000)
+
+		_, err := svm.DecodeEventContractCallWithToken(data[:len(data)-1])
+		assert.ErrorContains(t, err, "unexpected end of data")
+	})
+}
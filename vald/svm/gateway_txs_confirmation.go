@@ -0,0 +1,99 @@
+package svm
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
+	voteTypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// ProcessGatewayTxsConfirmation votes on the correctness of multiple gateway transactions of a Solana compatible chain
+func (mgr Mgr) ProcessGatewayTxsConfirmation(event *types.ConfirmSVMGatewayTxsStarted) error {
+	if !mgr.isParticipantOf(event.Participants) {
+		pollIDs := slices.Map(event.PollMappings, func(m types.SVMPollMapping) vote.PollID { return m.PollID })
+		mgr.logger("poll_ids", pollIDs).Debug("ignoring gateway txs confirmation poll: not a participant")
+		return nil
+	}
+
+	signatures := slices.Map(event.PollMappings, func(poll types.SVMPollMapping) string { return poll.TxSignature })
+	txs, err := mgr.GetTxsIfFinalized(event.Chain, signatures)
+	if err != nil {
+		return err
+	}
+
+	var votes []sdk.Msg
+	for i, tx := range txs {
+		pollID := event.PollMappings[i].PollID
+		signature := event.PollMappings[i].TxSignature
+
+		logger := mgr.logger("chain", event.Chain, "poll_id", pollID.String(), "tx_signature", signature)
+
+		if tx.Err() != nil {
+			votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain)))
+			logger.Infof("broadcasting empty vote for poll %s: %s", pollID.String(), tx.Err().Error())
+			continue
+		}
+
+		events := mgr.processGatewayTxLogs(event.Chain, event.GatewayProgramID, signature, tx.Ok().LogMessages)
+		if len(events) > types.MaxEventsPerVote {
+			votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain)))
+			logger.Infof("broadcasting empty vote for poll %s: too many events (%d exceeds maximum of %d)", pollID.String(), len(events), types.MaxEventsPerVote)
+			continue
+		}
+
+		votes = append(votes, voteTypes.NewVoteRequest(mgr.proxy, pollID, types.NewVoteEvents(event.Chain, events...)))
+		logger.Infof("broadcasting vote %v for poll %s", events, pollID.String())
+	}
+
+	_, err = mgr.broadcaster.Broadcast(context.TODO(), votes...)
+	return err
+}
+
+// processGatewayTxLogs extracts events from the logs of a gateway transaction
+func (mgr Mgr) processGatewayTxLogs(chain nexus.ChainName, gatewayProgramID string, signature string, logMessages []string) []types.Event {
+	var events []types.Event
+	for _, programLog := range ParseProgramLogs(gatewayProgramID, logMessages) {
+		event := types.Event{
+			Chain:          chain,
+			SVMTxSignature: signature,
+			Index:          programLog.Index,
+		}
+
+		switch {
+		case bytes.HasPrefix(programLog.Data, ContractCallDiscriminator[:]):
+			gatewayEvent, err := DecodeEventContractCall(programLog.Data)
+			if err != nil {
+				mgr.logger().Debug(errorsmod.Wrap(err, "decode event ContractCall failed").Error())
+				continue
+			}
+
+			event.Event = &types.Event_ContractCall{ContractCall: &gatewayEvent}
+		case bytes.HasPrefix(programLog.Data, ContractCallWithTokenDiscriminator[:]):
+			gatewayEvent, err := DecodeEventContractCallWithToken(programLog.Data)
+			if err != nil {
+				mgr.logger().Debug(errorsmod.Wrap(err, "decode event ContractCallWithToken failed").Error())
+				continue
+			}
+
+			event.Event = &types.Event_ContractCallWithToken{ContractCallWithToken: &gatewayEvent}
+		default:
+			continue
+		}
+
+		if err := event.ValidateBasic(); err != nil {
+			mgr.logger().Debug(errorsmod.Wrapf(err, "invalid event %s", event.GetEventType()).Error())
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
@@ -0,0 +1,182 @@
+package svm_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	mock2 "github.com/axelarnetwork/axelar-core/sdk-utils/broadcast/mock"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/vald/svm"
+	svmRpc "github.com/axelarnetwork/axelar-core/vald/svm/rpc"
+	"github.com/axelarnetwork/axelar-core/vald/svm/rpc/mock"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/monads/results"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestMgr_ProcessGatewayTxsConfirmation(t *testing.T) {
+	var (
+		mgr         *svm.Mgr
+		rpcClient   *mock.ClientMock
+		broadcaster *mock2.BroadcasterMock
+		event       *types.ConfirmSVMGatewayTxsStarted
+		valAddr     sdk.ValAddress
+		chain       nexus.ChainName
+		signature   string
+		sender      []byte
+		payloadHash types.Hash
+	)
+
+	votedEvents := func() []types.Event {
+		msgs := broadcaster.BroadcastCalls()[0].Msgs
+		return msgs[0].(*votetypes.VoteRequest).Vote.GetCachedValue().(*types.VoteEvents).Events
+	}
+
+	givenMgr := Given("a svm manager", func() {
+		chain = nexus.ChainName(strings.ToLower(rand.NormalizedStr(5)))
+		valAddr = rand.ValAddr()
+		signature = base58.Encode(rand.Bytes(64))
+		sender = rand.Bytes(32)
+		payloadHash = types.Hash(rand.Bytes(32))
+
+		rpcClient = &mock.ClientMock{}
+		broadcaster = &mock2.BroadcasterMock{BroadcastFunc: func(_ context.Context, _ ...sdk.Msg) (*sdk.TxResponse, error) {
+			return nil, nil
+		}}
+		mgr = svm.NewMgr(map[string]svmRpc.Client{chain.String(): rpcClient}, broadcaster, valAddr, rand.AccAddr())
+	}).
+		Given("a gateway txs confirmation event", func() {
+			event = &types.ConfirmSVMGatewayTxsStarted{
+				PollMappings:     []types.SVMPollMapping{{PollID: 10, TxSignature: signature}},
+				Chain:            chain,
+				GatewayProgramID: gatewayProgramID,
+				Participants:     []sdk.ValAddress{valAddr},
+			}
+		})
+
+	withLogs := func(logMessages ...string) func() {
+		return func() {
+			rpcClient.TransactionsFunc = func(_ context.Context, signatures []string) ([]svmRpc.TxResult, error) {
+				return []svmRpc.TxResult{svmRpc.TxResult(results.FromOk(svmRpc.Transaction{
+					Signature:   signatures[0],
+					LogMessages: logMessages,
+				}))}, nil
+			}
+		}
+	}
+
+	givenMgr.
+		When("the transaction calls a contract through the gateway", withLogs(
+			"Program "+gatewayProgramID+" invoke [1]",
+			"Program log: Instruction: CallContract",
+			programData(encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8")),
+			"Program "+gatewayProgramID+" success",
+		)).
+		Then("vote for the contract call", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+			assert.Equal(t, []string{signature}, rpcClient.TransactionsCalls()[0].Signatures)
+			assert.Len(t, broadcaster.BroadcastCalls(), 1)
+
+			events := votedEvents()
+			assert.Len(t, events, 1)
+			assert.Equal(t, signature, events[0].SVMTxSignature)
+			assert.True(t, events[0].TxID.IsZero())
+			assert.EqualValues(t, 2, events[0].Index)
+			assert.Equal(t, types.NewSVMEventID(signature, 2), events[0].GetID())
+			assert.Equal(t, types.EventContractCall{
+				SVMSender:        base58.Encode(sender),
+				DestinationChain: "ethereum",
+				ContractAddress:  "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+				PayloadHash:      payloadHash,
+			}, *events[0].GetContractCall())
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the transaction calls a contract with token through the gateway", withLogs(
+			"Program "+gatewayProgramID+" invoke [1]",
+			"Program log: Instruction: CallContractWithToken",
+			programData(encodeContractCallWithToken(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8", "USDC", 1000000)),
+			"Program "+gatewayProgramID+" success",
+		)).
+		Then("vote for the contract call with token", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+
+			events := votedEvents()
+			assert.Len(t, events, 1)
+			assert.Equal(t, signature, events[0].SVMTxSignature)
+			assert.Equal(t, types.EventContractCallWithToken{
+				SVMSender:        base58.Encode(sender),
+				DestinationChain: "ethereum",
+				ContractAddress:  "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+				PayloadHash:      payloadHash,
+				Symbol:           "USDC",
+				Amount:           math.NewUint(1000000),
+			}, *events[0].GetContractCallWithToken())
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the contract call is emitted by a different program", withLogs(
+			"Program "+otherProgramID+" invoke [1]",
+			programData(encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8")),
+			"Program "+otherProgramID+" success",
+		)).
+		Then("vote for no events", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+			assert.Empty(t, votedEvents())
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the transaction failed", func() {
+			rpcClient.TransactionsFunc = func(_ context.Context, signatures []string) ([]svmRpc.TxResult, error) {
+				return []svmRpc.TxResult{svmRpc.TxResult(results.FromOk(svmRpc.Transaction{
+					Signature: signatures[0],
+					Err:       errors.New("InstructionError"),
+					LogMessages: []string{
+						"Program " + gatewayProgramID + " invoke [1]",
+						programData(encodeContractCall(sender, payloadHash, "ethereum", "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8")),
+						"Program " + gatewayProgramID + " failed: custom program error: 0x1",
+					},
+				}))}, nil
+			}
+		}).
+		Then("vote for no events", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+			assert.Empty(t, votedEvents())
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the transaction is not found", func() {
+			rpcClient.TransactionsFunc = func(context.Context, []string) ([]svmRpc.TxResult, error) {
+				return []svmRpc.TxResult{svmRpc.TxResult(results.FromErr[svmRpc.Transaction](svmRpc.ErrNotFound))}, nil
+			}
+		}).
+		Then("vote for no events", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+			assert.Empty(t, votedEvents())
+		}).
+		Run(t)
+
+	givenMgr.
+		When("the validator is not a participant", func() {
+			event.Participants = []sdk.ValAddress{rand.ValAddr()}
+		}).
+		Then("do nothing", func(t *testing.T) {
+			assert.NoError(t, mgr.ProcessGatewayTxsConfirmation(event))
+			assert.Empty(t, rpcClient.TransactionsCalls())
+			assert.Empty(t, broadcaster.BroadcastCalls())
+		}).
+		Run(t)
+}
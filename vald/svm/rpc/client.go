@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/axelarnetwork/utils/monads/results"
+)
+
+//go:generate moq -out ./mock/client.go -pkg mock . Client
+
+// ErrNotFound is returned when a transaction does not exist or is not finalized yet
+var ErrNotFound = errors.New("transaction not found")
+
+// Transaction is a finalized Solana transaction
+type Transaction struct {
+	Signature string
+	Slot      uint64
+	// Err is the reason the transaction failed, it is nil if the transaction succeeded
+	Err         error
+	LogMessages []string
+}
+
+// TxResult is a custom type that allows moq to correctly generate the mock for
+// results.Result with Transaction.
+type TxResult results.Result[Transaction]
+
+// AsResult returns the TxResult as the underlying type results.Result[Transaction]
+func (r TxResult) AsResult() results.Result[Transaction] {
+	return results.Result[Transaction](r)
+}
+
+// Client provides calls to Solana JSON-RPC endpoints
+type Client interface {
+	// Transactions returns the finalized transactions for the given base58 encoded signatures
+	Transactions(ctx context.Context, signatures []string) ([]TxResult, error)
+	// Close closes the client connection
+	Close()
+}
+
+// NewClient returns a Solana JSON-RPC client
+func NewClient(url string) (Client, error) {
+	rpc, err := rpc.DialContext(context.Background(), url)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewSolanaClient(rpc)
+	if err != nil {
+		rpc.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// JSONRPCClient represents the functionality of github.com/ethereum/go-ethereum/rpc.Client
+type JSONRPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+	Close()
+}
+
+// SolanaClient is a JSON-RPC client of Solana compatible chains
+type SolanaClient struct {
+	rpc JSONRPCClient
+}
+
+// NewSolanaClient is the constructor
+func NewSolanaClient(rpc JSONRPCClient) (*SolanaClient, error) {
+	client := &SolanaClient{rpc: rpc}
+
+	// validate that the given url implements the Solana JSON-RPC
+	var version map[string]any
+	if err := client.rpc.CallContext(context.Background(), &version, "getVersion"); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+type txResponse struct {
+	Slot uint64 `json:"slot"`
+	Meta *struct {
+		Err         json.RawMessage `json:"err"`
+		LogMessages []string        `json:"logMessages"`
+	} `json:"meta"`
+}
+
+// Transactions returns the finalized transactions for the given base58 encoded signatures
+func (c *SolanaClient) Transactions(ctx context.Context, signatures []string) ([]TxResult, error) {
+	batch := slices.Map(signatures, func(signature string) rpc.BatchElem {
+		var tx *txResponse
+		return rpc.BatchElem{
+			Method: "getTransaction",
+			Args: []interface{}{signature, map[string]interface{}{
+				"encoding":                       "json",
+				"commitment":                     "finalized",
+				"maxSupportedTransactionVersion": 0,
+			}},
+			Result: &tx,
+		}
+	})
+
+	if err := c.rpc.BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("unable to send batch request: %v", err)
+	}
+
+	return slices.Map(batch, func(elem rpc.BatchElem) TxResult {
+		if elem.Error != nil {
+			return TxResult(results.FromErr[Transaction](elem.Error))
+		}
+
+		tx := *elem.Result.(**txResponse)
+		if tx == nil {
+			return TxResult(results.FromErr[Transaction](ErrNotFound))
+		}
+
+		if tx.Meta == nil {
+			return TxResult(results.FromErr[Transaction](fmt.Errorf("transaction metadata is missing")))
+		}
+
+		var txErr error
+		if len(tx.Meta.Err) > 0 && string(tx.Meta.Err) != "null" {
+			txErr = fmt.Errorf("%s", tx.Meta.Err)
+		}
+
+		return TxResult(results.FromOk(Transaction{
+			Signature:   elem.Args[0].(string),
+			Slot:        tx.Slot,
+			Err:         txErr,
+			LogMessages: tx.Meta.LogMessages,
+		}))
+	}), nil
+}
+
+// Close closes the client connection
+func (c *SolanaClient) Close() {
+	c.rpc.Close()
+}
@@ -0,0 +1,88 @@
+package svm
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/sdk-utils/broadcast"
+	"github.com/axelarnetwork/axelar-core/utils/errors"
+	"github.com/axelarnetwork/axelar-core/vald/svm/rpc"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/log"
+	"github.com/axelarnetwork/utils/monads/results"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// ErrTxFailed is returned when a transaction has failed
+var ErrTxFailed = goerrors.New("transaction failed")
+
+// Mgr manages all communication with Solana compatible chains
+type Mgr struct {
+	rpcs        map[string]rpc.Client
+	broadcaster broadcast.Broadcaster
+	validator   sdk.ValAddress
+	proxy       sdk.AccAddress
+}
+
+// NewMgr returns a new Mgr instance
+func NewMgr(rpcs map[string]rpc.Client, broadcaster broadcast.Broadcaster, valAddr sdk.ValAddress, proxy sdk.AccAddress) *Mgr {
+	return &Mgr{
+		rpcs:        rpcs,
+		proxy:       proxy,
+		broadcaster: broadcaster,
+		validator:   valAddr,
+	}
+}
+
+func (mgr Mgr) logger(keyvals ...any) log.Logger {
+	keyvals = append([]any{"listener", "svm"}, keyvals...)
+	return log.WithKeyVals(keyvals...)
+}
+
+// GetTxsIfFinalized retrieves the transactions with the given base58 encoded signatures.
+//
+// # Individual result is
+//
+// - Ok(tx) if the transaction is finalized and successful
+//
+// - Err(rpc.ErrNotFound) if the transaction is not found or not finalized
+//
+// - Err(ErrTxFailed) if the transaction is finalized but failed
+//
+// - Err(err) otherwise
+func (mgr Mgr) GetTxsIfFinalized(chain nexus.ChainName, signatures []string) ([]results.Result[rpc.Transaction], error) {
+	client, ok := mgr.rpcs[strings.ToLower(chain.String())]
+	if !ok {
+		return nil, fmt.Errorf("rpc client not found for chain %s", chain.String())
+	}
+
+	txs, err := client.Transactions(context.Background(), signatures)
+	if err != nil {
+		return slices.Map(signatures, func(_ string) results.Result[rpc.Transaction] {
+			return results.FromErr[rpc.Transaction](
+				errorsmod.Wrapf(
+					errors.With(err, "chain", chain.String(), "tx_signatures", signatures),
+					"cannot get transactions"),
+			)
+		}), nil
+	}
+
+	return slices.Map(txs, func(tx rpc.TxResult) results.Result[rpc.Transaction] {
+		return results.Pipe(tx.AsResult(), func(tx rpc.Transaction) results.Result[rpc.Transaction] {
+			if tx.Err != nil {
+				return results.FromErr[rpc.Transaction](errorsmod.Wrap(ErrTxFailed, tx.Err.Error()))
+			}
+
+			return results.FromOk(tx)
+		})
+	}), nil
+}
+
+func (mgr Mgr) isParticipantOf(participants []sdk.ValAddress) bool {
+	return slices.Any(participants, func(v sdk.ValAddress) bool { return v.Equals(mgr.validator) })
+}
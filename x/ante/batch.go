@@ -9,6 +9,7 @@ import (
 
 	auxiliarytypes "github.com/axelarnetwork/axelar-core/x/auxiliary/types"
 	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
+	permissiontypes "github.com/axelarnetwork/axelar-core/x/permission/types"
 	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
 )
 
@@ -85,6 +86,10 @@ func unpackMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
 			if inner := m.GetInnerMessage(); inner != nil {
 				unpackedMsgs = append(unpackedMsgs, inner)
 			}
+		case *permissiontypes.QueueActionRequest:
+			if inner := m.UnwrapMessage(); inner != nil {
+				unpackedMsgs = append(unpackedMsgs, inner)
+			}
 		}
 	}
 
@@ -92,7 +97,7 @@ func unpackMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
 }
 
 // ValidateWrappedMsgs rejects an authz MsgExec that wraps a role-restricted msg or another
-// wrapper (MsgExec, BatchRequest, RefundMsgRequest), and a BatchRequest that wraps a MsgExec.
+// wrapper (MsgExec, BatchRequest, RefundMsgRequest, QueueActionRequest), and a BatchRequest that wraps a MsgExec.
 func ValidateWrappedMsgs(msgs []sdk.Msg) error {
 	for _, msg := range msgs {
 		switch m := msg.(type) {
@@ -109,7 +114,7 @@ func ValidateWrappedMsgs(msgs []sdk.Msg) error {
 				return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "authz MsgExec must not wrap role-restricted messages")
 			}
 			if containsWrapperMsg(innerMsgs) {
-				return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "authz MsgExec must not wrap a MsgExec, BatchRequest, or RefundMsgRequest")
+				return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "authz MsgExec must not wrap a MsgExec, BatchRequest, RefundMsgRequest, or QueueActionRequest")
 			}
 		case *auxiliarytypes.BatchRequest:
 			if containsMsgExec(m.UnwrapMessages()) {
@@ -149,7 +154,7 @@ func containsMsgExec(msgs []sdk.Msg) bool {
 func containsWrapperMsg(msgs []sdk.Msg) bool {
 	for _, msg := range msgs {
 		switch msg.(type) {
-		case *authz.MsgExec, *auxiliarytypes.BatchRequest, *rewardtypes.RefundMsgRequest:
+		case *authz.MsgExec, *auxiliarytypes.BatchRequest, *rewardtypes.RefundMsgRequest, *permissiontypes.QueueActionRequest:
 			return true
 		}
 	}
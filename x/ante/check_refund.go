@@ -45,7 +45,8 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return next(ctx, tx, simulate)
 	}
 
-	if err := d.validateRefundQualification(ctx, msgs); err != nil {
+	validators, err := d.validateRefundQualification(ctx, msgs)
+	if err != nil {
 		return ctx, err
 	}
 
@@ -54,6 +55,12 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
 	}
 
+	// a tx that requests more gas than the refund policy allows is still executed, but at the sender's cost
+	policy := d.reward.GetRefundPolicy(ctx)
+	if !withinRefundGasLimit(policy, feeTx.GetGas(), msgs) {
+		return next(ctx, tx, simulate)
+	}
+
 	fees := feeTx.GetFee()
 	if len(fees) > 0 {
 		feePayer := feeTx.FeeGranter()
@@ -66,13 +73,20 @@ func (d CheckRefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		for _, msg := range msgs {
 			switch msg := msg.(type) {
 			case *rewardtypes.RefundMsgRequest:
+				// when messages are batched not all are refundable, so we cannot use the msg index directly
+				fee, validator := splitFees[0], validators[0]
+				splitFees, validators = splitFees[1:], validators[1:]
+
+				// messages the refund policy does not cover are still executed, but at the sender's cost
+				if _, ok := policy.GetRefundableMsg(msg.InnerMessage.TypeUrl); !ok {
+					continue
+				}
+
 				req := *msg
-				err := d.reward.SetPendingRefund(ctx, req, rewardtypes.Refund{Payer: feePayer, Fees: splitFees[0]})
+				err := d.reward.SetPendingRefund(ctx, req, rewardtypes.Refund{Payer: feePayer, Fees: fee, Validator: validator})
 				if err != nil {
 					return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 				}
-				// when messages are batched not all are refundable, so we cannot use the msg index directly
-				splitFees = splitFees[1:]
 			}
 		}
 	}
@@ -104,7 +118,8 @@ func refundInnerMsgsSet(msgs []sdk.Msg) map[sdk.Msg]struct{} {
 	return inners
 }
 
-func (d CheckRefundFeeDecorator) validateRefundQualification(ctx sdk.Context, msgs []sdk.Msg) error {
+// validateRefundQualification returns the validator of each refundable message, in the order they appear in the tx
+func (d CheckRefundFeeDecorator) validateRefundQualification(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.ValAddress, error) {
 	// If we allow txs to be refunded when there are msgs that are not RefundMsgRequests we open the door to slip all kinds of msgs in to get them refunded.
 	// So we need to make sure that all msgs in the batch are refundable, otherwise reject the tx.
 
@@ -112,6 +127,8 @@ func (d CheckRefundFeeDecorator) validateRefundQualification(ctx sdk.Context, ms
 	// decorators can inspect it; those inners are validated through their wrapper, so skip them here
 	refundInnerMsgs := refundInnerMsgsSet(msgs)
 
+	var validators []sdk.ValAddress
+
 	for _, msg := range msgs {
 		if _, isRefundMsgInner := refundInnerMsgs[msg]; isRefundMsgInner {
 			continue
@@ -120,38 +137,61 @@ func (d CheckRefundFeeDecorator) validateRefundQualification(ctx sdk.Context, ms
 		switch msg := msg.(type) {
 		case *rewardtypes.RefundMsgRequest:
 			if !msgRegistered(d.cdc.InterfaceRegistry(), msg.InnerMessage.TypeUrl) {
-				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("message type %s is not refundable", msg.InnerMessage.TypeUrl))
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("message type %s is not refundable", msg.InnerMessage.TypeUrl))
 			}
 
 			signers, _, err := d.cdc.GetMsgV1Signers(msg)
 			if err != nil {
-				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+				return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 			}
 			if len(signers) == 0 {
-				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message has no signers")
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message has no signers")
 			}
 
 			operatorAddr := d.snapshotter.GetOperator(ctx, signers[0])
 			if operatorAddr == nil {
-				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signer is not a registered proxy")
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signer is not a registered proxy")
 			}
 
 			validator, err := d.staking.Validator(ctx, operatorAddr)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if validator == nil {
-				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signer is not associated with a validator")
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signer is not associated with a validator")
 			}
+
+			validators = append(validators, operatorAddr)
 		// ignore the batch request message, as long as all other messages are refundable
 		case *auxiliarytypes.BatchRequest:
 			continue
 		default:
-			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("all messages in a transaction must be refundable, message type %T is not refundable", msg))
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("all messages in a transaction must be refundable, message type %T is not refundable", msg))
 		}
 	}
 
-	return nil
+	return validators, nil
+}
+
+// withinRefundGasLimit returns false if the tx requests more gas than the messages the refund policy covers are allowed to use.
+// Messages the policy does not cover do not add to the allowance
+func withinRefundGasLimit(policy rewardtypes.RefundPolicy, gas uint64, msgs []sdk.Msg) bool {
+	var maxGas uint64
+	for _, msg := range slices.Filter(msgs, isRefundMsgRequest) {
+		refundable, ok := policy.GetRefundableMsg(msg.(*rewardtypes.RefundMsgRequest).InnerMessage.TypeUrl)
+		if !ok {
+			continue
+		}
+
+		// a message type without gas limit lifts the limit of the whole tx
+		if refundable.MaxGas == 0 {
+			return true
+		}
+
+		maxGas += refundable.MaxGas
+	}
+
+	return gas <= maxGas
 }
 
 func msgRegistered(r cdctypes.InterfaceRegistry, targetURL string) bool {
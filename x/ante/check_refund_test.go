@@ -39,6 +39,7 @@ import (
 	snapshottypes "github.com/axelarnetwork/axelar-core/x/snapshot/types"
 	votetypes "github.com/axelarnetwork/axelar-core/x/vote/types"
 	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
 )
 
 func TestCheckRefundFeeDecorator_AnteHandle(t *testing.T) {
@@ -197,6 +198,75 @@ func TestCheckRefundFeeDecorator_AnteHandle(t *testing.T) {
 	}
 }
 
+func TestCheckRefundFeeDecorator_RefundPolicy(t *testing.T) {
+	app.SetConfig()
+	app.WasmEnabled = "true"
+	app.IBCWasmHooksEnabled = "true"
+	version.Version = "0.35.0"
+	encConfig := app.MakeEncodingConfig()
+	sender := rand.AccAddr()
+
+	var (
+		ctx          sdk.Context
+		anteHandler  sdk.AnteHandler
+		rewardKeeper *mock.RewardMock
+		policy       rewardtypes.RefundPolicy
+		msgs         []sdk.Msg
+		err          error
+	)
+
+	Given("a refund ante handler", func() {
+		t.Cleanup(func() {
+			funcs.MustNoErr(os.RemoveAll("wasm"))
+		})
+
+		ctx = prepareCtx(t)
+		anteHandler, rewardKeeper = prepareAnteHandler(ctx, sender, encConfig, t)
+		rewardKeeper.SetPendingRefundFunc = func(sdk.Context, rewardtypes.RefundMsgRequest, rewardtypes.Refund) error { return nil }
+
+		policy = rewardtypes.DefaultRefundPolicy()
+		rewardKeeper.GetRefundPolicyFunc = func(sdk.Context) rewardtypes.RefundPolicy { return policy }
+
+		msgs = []sdk.Msg{
+			rewardtypes.NewRefundMsgRequest(sender, &votetypes.VoteRequest{}),
+			rewardtypes.NewRefundMsgRequest(sender, &multisig.SubmitSignatureRequest{}),
+		}
+	}).
+		Branch(
+			When("a message type is not listed by the policy", func() {
+				policy.RefundableMsgs = policy.RefundableMsgs[:1]
+			}).
+				When("the tx is handled", func() { _, err = anteHandler(ctx, prepareTx(encConfig, msgs), false) }).
+				Then("should only record a refund for the listed message", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Len(t, rewardKeeper.SetPendingRefundCalls(), 1)
+					assert.Equal(t, msgs[0].(*rewardtypes.RefundMsgRequest).InnerMessage.TypeUrl, rewardKeeper.SetPendingRefundCalls()[0].Req.InnerMessage.TypeUrl)
+				}),
+
+			When("the tx requests more gas than the refunded messages are allowed", func() {
+				for i := range policy.RefundableMsgs {
+					policy.RefundableMsgs[i].MaxGas = 1000
+				}
+			}).
+				When("the tx is handled", func() { _, err = anteHandler(ctx, prepareTx(encConfig, msgs), false) }).
+				Then("should not record any refund", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Empty(t, rewardKeeper.SetPendingRefundCalls())
+				}),
+
+			When("the tx complies with the policy", func() {}).
+				When("the tx is handled", func() { _, err = anteHandler(ctx, prepareTx(encConfig, msgs), false) }).
+				Then("should record a refund charged to the validator for each message", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Len(t, rewardKeeper.SetPendingRefundCalls(), 2)
+					for _, call := range rewardKeeper.SetPendingRefundCalls() {
+						assert.NoError(t, call.Refund.ValidateBasic())
+					}
+				}),
+		).
+		Run(t)
+}
+
 func prepareAnteHandler(ctx sdk.Context, sender sdk.AccAddress, encConfig params.EncodingConfig, t log.TestingT) (sdk.AnteHandler, *mock.RewardMock) {
 	axelarApp := app.NewAxelarApp(
 		log.NewTestLogger(t),
@@ -226,7 +296,9 @@ func prepareAnteHandler(ctx sdk.Context, sender sdk.AccAddress, encConfig params
 	snapshotKeeper.SetParams(ctx, snapshottypes.DefaultParams())
 	funcs.MustNoErr(snapshotKeeper.ActivateProxy(ctx, valAddr, sender))
 
-	rewardKeeper := &mock.RewardMock{}
+	rewardKeeper := &mock.RewardMock{
+		GetRefundPolicyFunc: func(sdk.Context) rewardtypes.RefundPolicy { return rewardtypes.DefaultRefundPolicy() },
+	}
 
 	anteHandler := ante.NewCheckRefundFeeDecorator(
 		encConfig.Codec,
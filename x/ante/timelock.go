@@ -0,0 +1,86 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/axelarnetwork/axelar-core/x/ante/types"
+	auxiliarytypes "github.com/axelarnetwork/axelar-core/x/auxiliary/types"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+// TimelockDecorator rejects messages that are subject to a timelock unless they are queued with a QueueActionRequest
+type TimelockDecorator struct {
+	permission types.Permission
+}
+
+// NewTimelockDecorator is the constructor for TimelockDecorator
+func NewTimelockDecorator(permission types.Permission) TimelockDecorator {
+	return TimelockDecorator{
+		permission,
+	}
+}
+
+// AnteHandle fails if the tx contains a timelocked message that is not queued
+func (d TimelockDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkTimelock(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MessageTimelockDecorator rejects timelocked messages that skip the tx ante handler, i.e. messages dispatched by contracts.
+// Contracts can still queue their own timelocked messages with a QueueActionRequest.
+type MessageTimelockDecorator struct {
+	TimelockDecorator
+}
+
+// NewMessageTimelockDecorator is the constructor for MessageTimelockDecorator
+func NewMessageTimelockDecorator(permission types.Permission) MessageTimelockDecorator {
+	return MessageTimelockDecorator{
+		NewTimelockDecorator(permission),
+	}
+}
+
+// AnteHandle fails if the messages contain a timelocked message that is not queued
+func (d MessageTimelockDecorator) AnteHandle(ctx sdk.Context, msgs []sdk.Msg, simulate bool, next MessageAnteHandler) (sdk.Context, error) {
+	if err := d.checkTimelock(ctx, msgs); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, msgs, simulate)
+}
+
+// checkTimelock recurses into wrapped messages, except for the messages queued by a QueueActionRequest
+func (d TimelockDecorator) checkTimelock(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if _, ok := d.permission.GetTimelockDelay(ctx, sdk.MsgTypeURL(msg)); ok {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is subject to a timelock and must be queued", sdk.MsgTypeURL(msg))
+		}
+
+		var inner []sdk.Msg
+		switch m := msg.(type) {
+		case *auxiliarytypes.BatchRequest:
+			inner = m.UnwrapMessages()
+		case *authz.MsgExec:
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+			inner = innerMsgs
+		case *rewardtypes.RefundMsgRequest:
+			if innerMsg := m.GetInnerMessage(); innerMsg != nil {
+				inner = []sdk.Msg{innerMsg}
+			}
+		}
+
+		if err := d.checkTimelock(ctx, inner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
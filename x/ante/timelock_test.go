@@ -0,0 +1,100 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/ante"
+	"github.com/axelarnetwork/axelar-core/x/ante/types/mock"
+	auxiliarytypes "github.com/axelarnetwork/axelar-core/x/auxiliary/types"
+	permissiontypes "github.com/axelarnetwork/axelar-core/x/permission/types"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestTimelockDecorator(t *testing.T) {
+	var (
+		handler sdk.AnteDecorator
+		tx      *mock.FeeTxMock
+	)
+
+	sender := rand.AccAddr()
+	timelocked := permissiontypes.NewRegisterControllerRequest(sender, rand.AccAddr())
+	notTimelocked := permissiontypes.NewDeregisterControllerRequest(sender, rand.AccAddr())
+
+	withMsgs := func(msgs ...sdk.Msg) func() {
+		return func() {
+			tx = &mock.FeeTxMock{GetMsgsFunc: func() []sdk.Msg { return msgs }}
+		}
+	}
+
+	anteHandle := func() error {
+		_, err := handler.AnteHandle(sdk.Context{}, tx, false,
+			func(sdk.Context, sdk.Tx, bool) (sdk.Context, error) { return sdk.Context{}, nil })
+		return err
+	}
+
+	Given("a timelock on registering controllers", func() {
+		handler = ante.NewTimelockDecorator(&mock.PermissionMock{
+			GetTimelockDelayFunc: func(_ sdk.Context, msgTypeURL string) (int64, bool) {
+				return 10, msgTypeURL == sdk.MsgTypeURL(timelocked)
+			},
+		})
+	}).
+		Branch(
+			When("the timelocked message is sent directly", withMsgs(notTimelocked, timelocked)).
+				Then("the tx is rejected", func(t *testing.T) {
+					assert.ErrorContains(t, anteHandle(), "must be queued")
+				}),
+
+			When("the timelocked message is wrapped in a batch", withMsgs(auxiliarytypes.NewBatchRequest(sender, []sdk.Msg{notTimelocked, timelocked}))).
+				Then("the tx is rejected", func(t *testing.T) {
+					assert.ErrorContains(t, anteHandle(), "must be queued")
+				}),
+
+			When("the timelocked message is queued", withMsgs(notTimelocked, permissiontypes.NewQueueActionRequest(sender, timelocked))).
+				Then("the tx passes", func(t *testing.T) {
+					assert.NoError(t, anteHandle())
+				}),
+
+			When("the timelocked message is queued in a batch", withMsgs(auxiliarytypes.NewBatchRequest(sender, []sdk.Msg{permissiontypes.NewQueueActionRequest(sender, timelocked)}))).
+				Then("the tx passes", func(t *testing.T) {
+					assert.NoError(t, anteHandle())
+				}),
+		).
+		Run(t)
+}
+
+func TestMessageTimelockDecorator(t *testing.T) {
+	var msgs []sdk.Msg
+
+	sender := rand.AccAddr()
+	timelocked := permissiontypes.NewRegisterControllerRequest(sender, rand.AccAddr())
+
+	handler := ante.ChainMessageAnteDecorators(ante.NewMessageTimelockDecorator(&mock.PermissionMock{
+		GetTimelockDelayFunc: func(_ sdk.Context, msgTypeURL string) (int64, bool) {
+			return 10, msgTypeURL == sdk.MsgTypeURL(timelocked)
+		},
+	}))
+
+	anteHandle := func() error {
+		_, err := handler(sdk.Context{}, msgs, false)
+		return err
+	}
+
+	Given("a timelock on registering controllers", func() {}).
+		Branch(
+			When("a contract dispatches the timelocked message", func() { msgs = []sdk.Msg{timelocked} }).
+				Then("the message is rejected", func(t *testing.T) {
+					assert.ErrorContains(t, anteHandle(), "must be queued")
+				}),
+
+			When("a contract queues the timelocked message", func() { msgs = []sdk.Msg{permissiontypes.NewQueueActionRequest(sender, timelocked)} }).
+				Then("the message passes", func(t *testing.T) {
+					assert.NoError(t, anteHandle())
+				}),
+		).
+		Run(t)
+}
@@ -42,9 +42,11 @@ type Staking interface {
 // Reward provides access to the reward functionality
 type Reward interface {
 	SetPendingRefund(ctx sdk.Context, req rewardtypes.RefundMsgRequest, refund rewardtypes.Refund) error
+	GetRefundPolicy(ctx sdk.Context) rewardtypes.RefundPolicy
 }
 
 // Permission provides access to the permission functionality
 type Permission interface {
 	GetRole(ctx sdk.Context, address sdk.AccAddress) permission.Role
+	GetTimelockDelay(ctx sdk.Context, msgTypeURL string) (int64, bool)
 }
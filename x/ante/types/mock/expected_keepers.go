@@ -26,6 +26,9 @@ var _ types.Permission = &PermissionMock{}
 //			GetRoleFunc: func(ctx sdk.Context, address sdk.AccAddress) permission.Role {
 //				panic("mock out the GetRole method")
 //			},
+//			GetTimelockDelayFunc: func(ctx sdk.Context, msgTypeURL string) (int64, bool) {
+//				panic("mock out the GetTimelockDelay method")
+//			},
 //		}
 //
 //		// use mockedPermission in code that requires types.Permission
@@ -36,6 +39,9 @@ type PermissionMock struct {
 	// GetRoleFunc mocks the GetRole method.
 	GetRoleFunc func(ctx sdk.Context, address sdk.AccAddress) permission.Role
 
+	// GetTimelockDelayFunc mocks the GetTimelockDelay method.
+	GetTimelockDelayFunc func(ctx sdk.Context, msgTypeURL string) (int64, bool)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetRole holds details about calls to the GetRole method.
@@ -45,8 +51,16 @@ type PermissionMock struct {
 			// Address is the address argument value.
 			Address sdk.AccAddress
 		}
+		// GetTimelockDelay holds details about calls to the GetTimelockDelay method.
+		GetTimelockDelay []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// MsgTypeURL is the msgTypeURL argument value.
+			MsgTypeURL string
+		}
 	}
-	lockGetRole sync.RWMutex
+	lockGetRole          sync.RWMutex
+	lockGetTimelockDelay sync.RWMutex
 }
 
 // GetRole calls GetRoleFunc.
@@ -85,6 +99,42 @@ func (mock *PermissionMock) GetRoleCalls() []struct {
 	return calls
 }
 
+// GetTimelockDelay calls GetTimelockDelayFunc.
+func (mock *PermissionMock) GetTimelockDelay(ctx sdk.Context, msgTypeURL string) (int64, bool) {
+	if mock.GetTimelockDelayFunc == nil {
+		panic("PermissionMock.GetTimelockDelayFunc: method is nil but Permission.GetTimelockDelay was just called")
+	}
+	callInfo := struct {
+		Ctx        sdk.Context
+		MsgTypeURL string
+	}{
+		Ctx:        ctx,
+		MsgTypeURL: msgTypeURL,
+	}
+	mock.lockGetTimelockDelay.Lock()
+	mock.calls.GetTimelockDelay = append(mock.calls.GetTimelockDelay, callInfo)
+	mock.lockGetTimelockDelay.Unlock()
+	return mock.GetTimelockDelayFunc(ctx, msgTypeURL)
+}
+
+// GetTimelockDelayCalls gets all the calls that were made to GetTimelockDelay.
+// Check the length with:
+//
+//	len(mockedPermission.GetTimelockDelayCalls())
+func (mock *PermissionMock) GetTimelockDelayCalls() []struct {
+	Ctx        sdk.Context
+	MsgTypeURL string
+} {
+	var calls []struct {
+		Ctx        sdk.Context
+		MsgTypeURL string
+	}
+	mock.lockGetTimelockDelay.RLock()
+	calls = mock.calls.GetTimelockDelay
+	mock.lockGetTimelockDelay.RUnlock()
+	return calls
+}
+
 // Ensure, that StakingMock does implement types.Staking.
 // If this is not the case, regenerate this file with moq.
 var _ types.Staking = &StakingMock{}
@@ -167,6 +217,9 @@ var _ types.Reward = &RewardMock{}
 //
 //		// make and configure a mocked types.Reward
 //		mockedReward := &RewardMock{
+//			GetRefundPolicyFunc: func(ctx sdk.Context) rewardtypes.RefundPolicy {
+//				panic("mock out the GetRefundPolicy method")
+//			},
 //			SetPendingRefundFunc: func(ctx sdk.Context, req rewardtypes.RefundMsgRequest, refund rewardtypes.Refund) error {
 //				panic("mock out the SetPendingRefund method")
 //			},
@@ -177,11 +230,19 @@ var _ types.Reward = &RewardMock{}
 //
 //	}
 type RewardMock struct {
+	// GetRefundPolicyFunc mocks the GetRefundPolicy method.
+	GetRefundPolicyFunc func(ctx sdk.Context) rewardtypes.RefundPolicy
+
 	// SetPendingRefundFunc mocks the SetPendingRefund method.
 	SetPendingRefundFunc func(ctx sdk.Context, req rewardtypes.RefundMsgRequest, refund rewardtypes.Refund) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetRefundPolicy holds details about calls to the GetRefundPolicy method.
+		GetRefundPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
 		// SetPendingRefund holds details about calls to the SetPendingRefund method.
 		SetPendingRefund []struct {
 			// Ctx is the ctx argument value.
@@ -192,9 +253,42 @@ type RewardMock struct {
 			Refund rewardtypes.Refund
 		}
 	}
+	lockGetRefundPolicy  sync.RWMutex
 	lockSetPendingRefund sync.RWMutex
 }
 
+// GetRefundPolicy calls GetRefundPolicyFunc.
+func (mock *RewardMock) GetRefundPolicy(ctx sdk.Context) rewardtypes.RefundPolicy {
+	if mock.GetRefundPolicyFunc == nil {
+		panic("RewardMock.GetRefundPolicyFunc: method is nil but Reward.GetRefundPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetRefundPolicy.Lock()
+	mock.calls.GetRefundPolicy = append(mock.calls.GetRefundPolicy, callInfo)
+	mock.lockGetRefundPolicy.Unlock()
+	return mock.GetRefundPolicyFunc(ctx)
+}
+
+// GetRefundPolicyCalls gets all the calls that were made to GetRefundPolicy.
+// Check the length with:
+//
+//	len(mockedReward.GetRefundPolicyCalls())
+func (mock *RewardMock) GetRefundPolicyCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetRefundPolicy.RLock()
+	calls = mock.calls.GetRefundPolicy
+	mock.lockGetRefundPolicy.RUnlock()
+	return calls
+}
+
 // SetPendingRefund calls SetPendingRefundFunc.
 func (mock *RewardMock) SetPendingRefund(ctx sdk.Context, req rewardtypes.RefundMsgRequest, refund rewardtypes.Refund) error {
 	if mock.SetPendingRefundFunc == nil {
@@ -3,6 +3,7 @@ package axelarnet
 import (
 	"encoding/json"
 	"fmt"
+	stdmath "math"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,8 +28,13 @@ type Fee struct {
 	Amount          string  `json:"amount"`
 	Recipient       string  `json:"recipient"`
 	RefundRecipient *string `json:"refund_recipient"`
+	// Optional, gas limit for executing the message on the destination chain
+	GasLimit uint64 `json:"gas_limit,omitempty"`
 }
 
+// maxFeeGasLimit is the largest gas limit a fee can request, as no destination chain allows more gas per command batch
+const maxFeeGasLimit = uint64(stdmath.MaxUint32)
+
 // ValidateBasic validates the fee
 func (f Fee) ValidateBasic() error {
 	amount, ok := math.NewIntFromString(f.Amount)
@@ -48,6 +54,10 @@ func (f Fee) ValidateBasic() error {
 		}
 	}
 
+	if f.GasLimit > maxFeeGasLimit {
+		return fmt.Errorf("gas limit must not exceed %d", maxFeeGasLimit)
+	}
+
 	return nil
 }
 
@@ -91,6 +101,8 @@ type Message struct {
 	Payload            []byte `json:"payload"`
 	Type               int64  `json:"type"`
 	Fee                *Fee   `json:"fee"` // Optional
+	// Optional, returns the token to the sender as soon as the message fails on the destination chain
+	RefundOnFailure bool `json:"refund_on_failure,omitempty"`
 }
 
 // OnRecvMessage handles general message from a cosmos chain
@@ -200,6 +212,11 @@ func validateMessage(ctx sdk.Context, ibcK keeper.IBCKeeper, n types.Nexus, ibcP
 		}
 	}
 
+	// only messages with token are tracked by nexus and can be refunded once they fail
+	if msg.RefundOnFailure && msg.Type != nexus.TypeGeneralMessageWithToken {
+		return fmt.Errorf("refund on failure is only supported for messages with token")
+	}
+
 	switch msg.Type {
 	case nexus.TypeGeneralMessage:
 		return nil
@@ -243,6 +260,9 @@ func handleMessage(ctx sdk.Context, n types.Nexus, b types.BankKeeper, ibcK type
 		nonce,
 		nil,
 	)
+	if msg.Fee != nil {
+		m.GasLimit = msg.Fee.GasLimit
+	}
 
 	events.Emit(ctx, &types.ContractCallSubmitted{
 		MessageID:        m.ID,
@@ -281,6 +301,12 @@ func handleMessageWithToken(ctx sdk.Context, n types.Nexus, b types.BankKeeper,
 		nonce,
 		&coin,
 	)
+	if msg.RefundOnFailure {
+		m.RefundPolicy = &nexus.RefundPolicy{MaxFailures: 1}
+	}
+	if msg.Fee != nil {
+		m.GasLimit = msg.Fee.GasLimit
+	}
 
 	events.Emit(ctx, &types.ContractCallWithTokenSubmitted{
 		MessageID:        m.ID,
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	mathrand "math/rand"
 	"strconv"
 	"strings"
@@ -373,6 +374,31 @@ func TestHandleMessage(t *testing.T) {
 			}).
 			Run(t)
 
+		whenMessageIsValid.
+			When("fee denom is registered", isAssetRegistered(true)).
+			When("message with fee and gas limit", func() {
+				setFee(funcs.MustOk(sdkmath.NewIntFromString(ics20Packet.Amount)), rand.AccAddr())
+				message.Fee.GasLimit = uint64(rand.I64Between(1, math.MaxUint32+1))
+				ics20Packet.Memo = string(funcs.Must(json.Marshal(message)))
+				packet = axelartestutils.RandomPacket(ics20Packet, ibctransfertypes.PortID, sourceChannel, ibctransfertypes.PortID, receiverChannel)
+			}).
+			Then("should set the gas limit of the message", func(t *testing.T) {
+				assert.True(t, axelarnet.OnRecvMessage(ctx, k, ibcK, n, b, packet).Success())
+				assert.Equal(t, message.Fee.GasLimit, genMsg.GasLimit)
+			}).
+			Run(t)
+
+		whenMessageIsValid.
+			When("fee denom is registered", isAssetRegistered(true)).
+			When("message with fee and a gas limit no chain can execute", func() {
+				setFee(funcs.MustOk(sdkmath.NewIntFromString(ics20Packet.Amount)), rand.AccAddr())
+				message.Fee.GasLimit = uint64(rand.I64Between(math.MaxUint32+1, math.MaxInt64))
+				ics20Packet.Memo = string(funcs.Must(json.Marshal(message)))
+				packet = axelartestutils.RandomPacket(ics20Packet, ibctransfertypes.PortID, sourceChannel, ibctransfertypes.PortID, receiverChannel)
+			}).
+			Then("should return ack error", ackError()).
+			Run(t)
+
 		whenMessageIsValid.
 			When("receiver is in uppercase", func() {
 				ics20Packet.Receiver = strings.ToUpper(ics20Packet.Receiver)
@@ -590,6 +616,19 @@ func TestHandleMessageWithToken(t *testing.T) {
 		}).
 		Run(t)
 
+	givenPacketWithMessageWithToken.
+		When("asset is registered on source chain", isAssetRegistered(srcChain, true)).
+		When("asset is registered on dest chain", isAssetRegistered(destChain, true)).
+		When("refund on failure is requested for a token transfer", func() {
+			message.Type = nexus.TypeSendToken
+			message.RefundOnFailure = true
+			ics20Packet.Memo = string(funcs.Must(json.Marshal(message)))
+			packet = axelartestutils.RandomPacket(ics20Packet, ibctransfertypes.PortID, sourceChannel, ibctransfertypes.PortID, receiverChannel)
+		}).
+		When("lock coin succeeds", lockCoin(true)).
+		Then("should return ack error", ackError()).
+		Run(t)
+
 	setFee := func(amount sdkmath.Int, recipient sdk.AccAddress) {
 		fee := axelarnet.Fee{
 			Amount:    amount.String(),
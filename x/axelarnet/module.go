@@ -234,7 +234,7 @@ func (m AxelarnetIBCModule) OnAcknowledgementPacket(
 	case *channeltypes.Acknowledgement_Result:
 		return setRoutedPacketCompleted(ctx, m.keeper, m.nexus, port, channel, sequence)
 	default:
-		return m.setRoutedPacketFailed(ctx, packet, m.bank)
+		return m.setRoutedPacketFailed(ctx, packet, m.bank, ack.GetError())
 	}
 }
 
@@ -250,7 +250,7 @@ func (m AxelarnetIBCModule) OnTimeoutPacket(
 		return err
 	}
 
-	return m.setRoutedPacketFailed(ctx, packet, m.bank)
+	return m.setRoutedPacketFailed(ctx, packet, m.bank, "packet timed out")
 }
 
 // returns the transfer id and delete the existing mapping
@@ -297,7 +297,7 @@ func setRoutedPacketCompleted(ctx sdk.Context, k keeper.Keeper, n types.Nexus, p
 	return nil
 }
 
-func (m AxelarnetIBCModule) setRoutedPacketFailed(ctx sdk.Context, packet channeltypes.Packet, bank types.BankKeeper) error {
+func (m AxelarnetIBCModule) setRoutedPacketFailed(ctx sdk.Context, packet channeltypes.Packet, bank types.BankKeeper, reason string) error {
 	// IBC ack/timeout packets, by convention, use the source port/channel to represent native chain -> counterparty chain channel id
 	// https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#definitions
 	port, channel, sequence := packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()
@@ -346,10 +346,16 @@ func (m AxelarnetIBCModule) setRoutedPacketFailed(ctx sdk.Context, packet channe
 			return err
 		}
 
-		m.keeper.Logger(ctx).Debug(fmt.Sprintf("general message %s failed to execute", messageID),
+		m.keeper.Logger(ctx).Debug(fmt.Sprintf("general message %s failed to execute: %s", messageID, reason),
 			"messageID", messageID, "portID", port, "channelID", channel, "sequence", sequence)
 
-		return m.nexus.SetMessageFailed(ctx, messageID)
+		if err := m.nexus.SetMessageFailed(ctx, messageID, reason); err != nil {
+			return err
+		}
+
+		// the asset is locked again at this point, so it can be returned to the sender if the message's refund policy allows it
+		_, err = m.nexus.RefundMessage(ctx, messageID)
+		return err
 	}
 
 	return nil
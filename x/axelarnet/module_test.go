@@ -145,13 +145,15 @@ func TestIBCModule(t *testing.T) {
 
 		n.GetMessageFunc = func(sdk.Context, string) (nexus.GeneralMessage, bool) { return message, true }
 		n.IsAssetRegisteredFunc = func(sdk.Context, nexus.Chain, string) bool { return true }
-		n.SetMessageFailedFunc = func(ctx sdk.Context, id string) error {
+		n.SetMessageFailedFunc = func(ctx sdk.Context, id string, reason string) error {
 			if id == message.ID {
 				message.Status = nexus.Failed
+				message.FailureReason = reason
 			}
 
 			return nil
 		}
+		n.RefundMessageFunc = func(sdk.Context, string) (bool, error) { return false, nil }
 		n.GetChainByNativeAssetFunc = func(sdk.Context, string) (nexus.Chain, bool) { return nexus.Chain{}, false }
 	})
 
@@ -244,9 +246,34 @@ func TestIBCModule(t *testing.T) {
 				When2(seqMapsToMessageID).
 				When("lock coin succeeds", lockCoin(true)).
 				When2(whenOnAck).
-				Then("should set message to failed", func(t *testing.T) {
+				Then("should set message to failed with the ack error and try to refund it", func(t *testing.T) {
 					assert.Equal(t, nexus.Failed, message.Status)
+					assert.Equal(t, ack.GetError(), message.FailureReason)
 					assert.Len(t, lockableAsset.LockFromCalls(), 1)
+					assert.Len(t, n.RefundMessageCalls(), 1)
+					assert.Equal(t, message.ID, n.RefundMessageCalls()[0].ID)
+				}),
+
+			seqMapsToMessageID.
+				When2(whenChainIsActivated).
+				When("lock coin succeeds", lockCoin(true)).
+				When2(whenOnTimeout).
+				Then("should set message to failed because of the timeout", func(t *testing.T) {
+					assert.Equal(t, nexus.Failed, message.Status)
+					assert.Equal(t, "packet timed out", message.FailureReason)
+					assert.Len(t, n.RefundMessageCalls(), 1)
+				}),
+
+			whenGetValidAckError.
+				When2(whenChainIsActivated).
+				When2(seqMapsToMessageID).
+				When("lock coin succeeds", lockCoin(true)).
+				When("refunding the message fails", func() {
+					n.RefundMessageFunc = func(sdk.Context, string) (bool, error) { return false, fmt.Errorf("refund failed") }
+				}).
+				Then("should return an error", func(t *testing.T) {
+					err := ibcModule.OnAcknowledgementPacket(ctx, ibctransfertypes.V1, packet, ack.Acknowledgement(), nil)
+					assert.ErrorContains(t, err, "refund failed")
 				}),
 
 			seqMapsToID.
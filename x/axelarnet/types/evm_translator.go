@@ -3,10 +3,13 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	evm "github.com/axelarnetwork/axelar-core/x/evm/types"
@@ -29,6 +32,7 @@ const (
 	// - bytes4(0) To Native
 	// - bytes4(1) To CosmWasm Contract
 	// - bytes4(2) To CosmWasm Contract with json encoded payload
+	// - bytes4(3) To CosmWasm Contract with complex abi types
 	versionSize = 4
 
 	maxArgCost     = 50 * 1024 // 50kb inflation-cost budget
@@ -96,6 +100,11 @@ func TranslateMessage(msg nexus.GeneralMessage, versionedPayload []byte) ([]byte
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "failed to construct wasm payload")
 		}
+	case CosmWasmV3:
+		bz, err = ConstructWasmMessageV3(msg, payload)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to construct wasm payload")
+		}
 	default:
 		return nil, fmt.Errorf("unknown payload version")
 	}
@@ -123,50 +132,95 @@ func unpackVersionedPayload(versionedPayload []byte) (version, []byte, error) {
 // - argument types ([]string)
 // - argument values (bytes)
 func ConstructWasmMessageV1(gm nexus.GeneralMessage, payload []byte) ([]byte, error) {
-	if err := evm.ABIInflationGuard(payloadArguments, payload, maxArgCost); err != nil {
+	methodName, argNames, _, argValues, err := decodeABIPayload(payload, buildArguments)
+	if err != nil {
 		return nil, err
 	}
 
-	args, err := evm.StrictDecode(payloadArguments, payload)
+	// convert to execute msg payload
+	executeMsg := make(map[string]interface{})
+	for i := 0; i < len(argNames); i++ {
+		executeMsg[argNames[i]] = argValues[i]
+	}
+
+	return constructWasmMessage(gm, methodName, executeMsg)
+}
+
+// ConstructWasmMessageV3 creates a json serialized wasm message from an abi encoded payload with the same layout as CosmWasmV1.
+// In addition to the CosmWasmV1 argument types, it supports
+// - signed and unsigned integers of any valid size, e.g. int24 or uint256
+// - fixed-size bytes, e.g. bytes32
+// - nested fixed-size and dynamic arrays, e.g. uint256[2][]
+// - tuples with named components, e.g. (address recipient,(string denom,uint256 amount)[] coins)
+//
+// Values are converted to the json representation CosmWasm contracts expect:
+// tuples become objects keyed by their component names, integers wider than 32 bits become decimal strings,
+// bytes become base64 strings and addresses become checksummed hex strings
+func ConstructWasmMessageV3(gm nexus.GeneralMessage, payload []byte) ([]byte, error) {
+	methodName, argNames, abiArguments, argValues, err := decodeABIPayload(payload, buildArgumentsV3)
 	if err != nil {
 		return nil, err
 	}
 
+	executeMsg := make(map[string]interface{})
+	for i := 0; i < len(argNames); i++ {
+		if _, ok := executeMsg[argNames[i]]; ok {
+			return nil, fmt.Errorf("duplicate argument name %s", argNames[i])
+		}
+
+		executeMsg[argNames[i]], err = toWasmJSON(abiArguments[i].Type, reflect.ValueOf(argValues[i]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return constructWasmMessage(gm, methodName, executeMsg)
+}
+
+// decodeABIPayload decodes an abi encoded payload with the layout
+// (method name, argument names, argument types, argument values) and returns the decoded argument values
+func decodeABIPayload(payload []byte, buildArgs func(argTypes []string) (abi.Arguments, error)) (string, []string, abi.Arguments, []interface{}, error) {
+	if err := evm.ABIInflationGuard(payloadArguments, payload, maxArgCost); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	args, err := evm.StrictDecode(payloadArguments, payload)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
 	methodName := args[0].(string)
 	argNames := args[1].([]string)
 	argTypes := args[2].([]string)
 
 	if len(argNames) != len(argTypes) {
-		return nil, fmt.Errorf("payload argument name and type length mismatch")
+		return "", nil, nil, nil, fmt.Errorf("payload argument name and type length mismatch")
 	}
 
 	if err := checkBrackets(argTypes); err != nil {
-		return nil, err
+		return "", nil, nil, nil, err
 	}
 
-	abiArguments, err := buildArguments(argTypes)
+	abiArguments, err := buildArgs(argTypes)
 	if err != nil {
-		return nil, err
+		return "", nil, nil, nil, err
 	}
 
 	if err := evm.ABIInflationGuard(abiArguments, args[3].([]byte), maxArgCost); err != nil {
-		return nil, err
+		return "", nil, nil, nil, err
 	}
 
 	// unpack to actual argument values
 	argValues, err := evm.StrictDecode(abiArguments, args[3].([]byte))
 	if err != nil {
-		return nil, err
+		return "", nil, nil, nil, err
 	}
 
-	// convert to execute msg payload
-	executeMsg := make(map[string]interface{})
-	for i := 0; i < len(argNames); i++ {
-		executeMsg[argNames[i]] = argValues[i]
-	}
+	return methodName, argNames, abiArguments, argValues, nil
+}
 
-	err = checkSourceInfo(gm.Sender, executeMsg)
-	if err != nil {
+func constructWasmMessage(gm nexus.GeneralMessage, methodName string, executeMsg map[string]interface{}) ([]byte, error) {
+	if err := checkSourceInfo(gm.Sender, executeMsg); err != nil {
 		return nil, err
 	}
 
@@ -267,10 +321,220 @@ func buildArguments(argTypes []string) (abi.Arguments, error) {
 	return arguments, nil
 }
 
+// build abi arguments based on the extended argument types of CosmWasmV3
+func buildArgumentsV3(argTypes []string) (abi.Arguments, error) {
+	var arguments abi.Arguments
+	for _, typeStr := range argTypes {
+		argType, err := parseArgType(typeStr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid argument type %s", typeStr)
+		}
+
+		arguments = append(arguments, abi.Argument{Type: argType})
+	}
+
+	return arguments, nil
+}
+
+// parseArgType parses a type of the form T, T[], T[k] or (T1 name1,...,Tn namen), arbitrarily nested
+func parseArgType(typeStr string) (abi.Type, error) {
+	p := argTypeParser{input: typeStr}
+
+	arg, err := p.parseType()
+	if err != nil {
+		return abi.Type{}, err
+	}
+
+	if p.pos != len(p.input) {
+		return abi.Type{}, fmt.Errorf("unexpected character at position %d", p.pos)
+	}
+
+	return abi.NewType(arg.Type, "", arg.Components)
+}
+
+type argTypeParser struct {
+	input string
+	pos   int
+}
+
+func (p *argTypeParser) parseType() (abi.ArgumentMarshaling, error) {
+	var arg abi.ArgumentMarshaling
+
+	if p.peek() == '(' {
+		components, err := p.parseTuple()
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+
+		arg = abi.ArgumentMarshaling{Type: "tuple", Components: components}
+	} else {
+		elementary := p.readWhile(func(c byte) bool { return isLower(c) || isDigit(c) })
+		if !isElementaryType(elementary) {
+			return abi.ArgumentMarshaling{}, fmt.Errorf("unsupported type %q", elementary)
+		}
+
+		arg.Type = elementary
+	}
+
+	for p.peek() == '[' {
+		p.pos++
+		size := p.readWhile(isDigit)
+		if p.next() != ']' {
+			return abi.ArgumentMarshaling{}, fmt.Errorf("unclosed array at position %d", p.pos)
+		}
+
+		if size != "" {
+			if n, err := strconv.ParseUint(size, 10, 16); err != nil || n == 0 || size[0] == '0' {
+				return abi.ArgumentMarshaling{}, fmt.Errorf("invalid array size %s", size)
+			}
+		}
+
+		arg.Type += "[" + size + "]"
+	}
+
+	return arg, nil
+}
+
+func (p *argTypeParser) parseTuple() ([]abi.ArgumentMarshaling, error) {
+	p.pos++ // skip the opening parenthesis
+
+	var components []abi.ArgumentMarshaling
+	names := make(map[string]bool)
+	for {
+		component, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next() != ' ' {
+			return nil, fmt.Errorf("tuple components must be named")
+		}
+
+		name := p.readWhile(func(c byte) bool { return isLower(c) || isUpper(c) || isDigit(c) || c == '_' })
+		if name == "" || isDigit(name[0]) {
+			return nil, fmt.Errorf("invalid tuple component name %q", name)
+		}
+
+		if names[name] {
+			return nil, fmt.Errorf("duplicate tuple component name %s", name)
+		}
+		names[name] = true
+
+		component.Name = name
+		components = append(components, component)
+
+		switch p.next() {
+		case ',':
+			continue
+		case ')':
+			return components, nil
+		default:
+			return nil, fmt.Errorf("unclosed tuple at position %d", p.pos)
+		}
+	}
+}
+
+// peek returns the next character without consuming it, or 0 at the end of the input
+func (p *argTypeParser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+// next consumes and returns the next character, or 0 at the end of the input
+func (p *argTypeParser) next() byte {
+	c := p.peek()
+	if c != 0 {
+		p.pos++
+	}
+
+	return c
+}
+
+func (p *argTypeParser) readWhile(accept func(c byte) bool) string {
+	start := p.pos
+	for p.pos < len(p.input) && accept(p.input[p.pos]) {
+		p.pos++
+	}
+
+	return p.input[start:p.pos]
+}
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
+func isUpper(c byte) bool { return c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isElementaryType(typeStr string) bool {
+	switch {
+	case typeStr == "bool", typeStr == "address", typeStr == "string", typeStr == "bytes":
+		return true
+	case strings.HasPrefix(typeStr, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typeStr, "bytes"))
+		return err == nil && size >= 1 && size <= 32 && strconv.Itoa(size) == strings.TrimPrefix(typeStr, "bytes")
+	case strings.HasPrefix(typeStr, "uint"), strings.HasPrefix(typeStr, "int"):
+		bits := strings.TrimPrefix(strings.TrimPrefix(typeStr, "u"), "int")
+		size, err := strconv.Atoi(bits)
+		return err == nil && size >= 8 && size <= 256 && size%8 == 0 && strconv.Itoa(size) == bits
+	default:
+		return false
+	}
+}
+
+// toWasmJSON converts a decoded abi value of the given type into its CosmWasm json representation
+func toWasmJSON(t abi.Type, value reflect.Value) (interface{}, error) {
+	switch t.T {
+	case abi.TupleTy:
+		obj := make(map[string]interface{}, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			v, err := toWasmJSON(*elem, value.Field(i))
+			if err != nil {
+				return nil, err
+			}
+
+			obj[t.TupleRawNames[i]] = v
+		}
+
+		return obj, nil
+	case abi.SliceTy, abi.ArrayTy:
+		arr := make([]interface{}, value.Len())
+		for i := range arr {
+			v, err := toWasmJSON(*t.Elem, value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			arr[i] = v
+		}
+
+		return arr, nil
+	case abi.IntTy, abi.UintTy:
+		// json numbers are only guaranteed to be exact up to 53 bits, so CosmWasm expects wider integers as strings
+		if t.Size <= 32 {
+			return value.Interface(), nil
+		}
+
+		return fmt.Sprint(value.Interface()), nil
+	case abi.FixedBytesTy:
+		bz := make([]byte, t.Size)
+		reflect.Copy(reflect.ValueOf(bz), value)
+
+		return bz, nil
+	case abi.AddressTy:
+		return value.Interface().(common.Address).Hex(), nil
+	case abi.BoolTy, abi.StringTy, abi.BytesTy:
+		return value.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", t.String())
+	}
+}
+
+// checkBrackets limits the nesting of arrays and tuples in the given argument types
 func checkBrackets(argTypes []string) error {
 	brackets := 0
 	for _, typeStr := range argTypes {
-		brackets += strings.Count(typeStr, "[")
+		brackets += strings.Count(typeStr, "[") + strings.Count(typeStr, "(")
 	}
 
 	if brackets > maxArgBrackets {
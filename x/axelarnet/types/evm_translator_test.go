@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"testing"
@@ -695,6 +696,174 @@ func TestConstructWasmMessageV2(t *testing.T) {
 	})
 }
 
+func TestConstructWasmMessageV3(t *testing.T) {
+	coinType := funcs.Must(abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "denom", Type: "string"},
+		{Name: "amount", Type: "uint256"},
+	}))
+	routeType := funcs.Must(abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "recipient", Type: "address"},
+		{Name: "hops", Type: "tuple[2]", Components: []abi.ArgumentMarshaling{
+			{Name: "pool_id", Type: "uint64"},
+			{Name: "min_out", Type: "int128"},
+		}},
+		{Name: "memo", Type: "bytes"},
+	}))
+
+	type coin struct {
+		Denom  string
+		Amount *big.Int
+	}
+	type hop struct {
+		PoolId uint64
+		MinOut *big.Int
+	}
+	type route struct {
+		Recipient common.Address
+		Hops      [2]hop
+		Memo      []byte
+	}
+
+	recipient := common.Address(evmtestutils.RandomAddress())
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	testCases := []struct {
+		name     string
+		argType  abi.Type
+		value    interface{}
+		expected string
+	}{
+		{"int8", funcs.Must(abi.NewType("int8", "", nil)), int8(math.MinInt8), `-128`},
+		{"uint24", funcs.Must(abi.NewType("uint24", "", nil)), big.NewInt(1<<24 - 1), `16777215`},
+		{"int32", funcs.Must(abi.NewType("int32", "", nil)), int32(math.MinInt32), `-2147483648`},
+		{"uint64", funcs.Must(abi.NewType("uint64", "", nil)), uint64(math.MaxUint64), `"18446744073709551615"`},
+		{"int64", funcs.Must(abi.NewType("int64", "", nil)), int64(math.MinInt64), `"-9223372036854775808"`},
+		{"uint256", funcs.Must(abi.NewType("uint256", "", nil)), utils.MaxUint.BigInt(), fmt.Sprintf(`"%s"`, utils.MaxUint.String())},
+		{"int256", funcs.Must(abi.NewType("int256", "", nil)), minInt256, fmt.Sprintf(`"%s"`, minInt256.String())},
+		{"bytes1", funcs.Must(abi.NewType("bytes1", "", nil)), [1]byte{0xff}, `"/w=="`},
+		{"bytes32", funcs.Must(abi.NewType("bytes32", "", nil)), [32]byte{1, 2, 3}, fmt.Sprintf(`"%s"`, b64.StdEncoding.EncodeToString(append([]byte{1, 2, 3}, make([]byte, 29)...)))},
+		{"address", addressType, recipient, fmt.Sprintf(`"%s"`, recipient.Hex())},
+		{"nested dynamic arrays", funcs.Must(abi.NewType("uint8[][]", "", nil)), [][]uint8{{1, 2}, {}, {3}}, `[[1,2],[],[3]]`},
+		{"fixed-size array of dynamic arrays", funcs.Must(abi.NewType("string[][2]", "", nil)), [2][]string{{"a"}, {"b", "c"}}, `[["a"],["b","c"]]`},
+		{"dynamic array of fixed-size arrays", funcs.Must(abi.NewType("bool[2][]", "", nil)), [][2]bool{{true, false}}, `[[true,false]]`},
+		{"array of fixed-size bytes", funcs.Must(abi.NewType("bytes2[]", "", nil)), [][2]byte{{0, 1}}, `["AAE="]`},
+		{"tuple array", coinType, []coin{{"uaxl", big.NewInt(100)}, {"uusdc", big.NewInt(1)}}, `[{"denom":"uaxl","amount":"100"},{"denom":"uusdc","amount":"1"}]`},
+		{
+			"nested tuple",
+			routeType,
+			route{Recipient: recipient, Hops: [2]hop{{1, big.NewInt(-1)}, {math.MaxUint64, big.NewInt(0)}}, Memo: []byte("memo")},
+			fmt.Sprintf(`{"recipient":"%s","hops":[{"pool_id":"1","min_out":"-1"},{"pool_id":"18446744073709551615","min_out":"0"}],"memo":"bWVtbw=="}`, recipient.Hex()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("should translate %s", testCase.name), func(t *testing.T) {
+			msg := nexustestutils.RandomMessage()
+			payload := funcs.Must(constructABIPayloadV3("method", []string{"arg"}, []abi.Type{testCase.argType}, []interface{}{testCase.value}))
+
+			bz, err := types.TranslateMessage(msg, payload)
+			assert.NoError(t, err)
+
+			var actual struct {
+				Wasm struct {
+					Msg map[string]map[string]json.RawMessage `json:"msg"`
+				} `json:"wasm"`
+			}
+			assert.NoError(t, json.Unmarshal(bz, &actual))
+			assert.JSONEq(t, testCase.expected, string(actual.Wasm.Msg["method"]["arg"]))
+		})
+	}
+
+	t.Run("should translate all arguments and check the source info", func(t *testing.T) {
+		msg := nexustestutils.RandomMessage()
+		msg.Sender.Address = evmtestutils.RandomAddress().Hex()
+
+		payload := funcs.Must(constructABIPayloadV3(
+			"swap",
+			[]string{"source_chain", "source_address", "coins", "route"},
+			[]abi.Type{stringType, addressType, coinType, routeType},
+			[]interface{}{
+				msg.GetSourceChain().String(),
+				common.HexToAddress(msg.GetSourceAddress()),
+				[]coin{{"uaxl", big.NewInt(1)}},
+				route{Recipient: recipient, Hops: [2]hop{{1, big.NewInt(1)}, {2, big.NewInt(2)}}, Memo: []byte{}},
+			},
+		))
+
+		bz, err := types.TranslateMessage(msg, payload)
+		assert.NoError(t, err)
+		checkWasmMsg(t, bz, msg, "swap", map[string]interface{}{
+			"source_chain":   msg.GetSourceChain().String(),
+			"source_address": common.HexToAddress(msg.GetSourceAddress()).Hex(),
+			"coins":          []interface{}{map[string]interface{}{"denom": "uaxl", "amount": "1"}},
+			"route": map[string]interface{}{
+				"recipient": recipient.Hex(),
+				"hops": []interface{}{
+					map[string]interface{}{"pool_id": "1", "min_out": "1"},
+					map[string]interface{}{"pool_id": "2", "min_out": "2"},
+				},
+				"memo": "",
+			},
+		})
+
+		msg.Sender.Address = evmtestutils.RandomAddress().Hex()
+		_, err = types.TranslateMessage(msg, payload)
+		assert.ErrorContains(t, err, "source address does not match expected")
+	})
+
+	t.Run("should return error if argument types are invalid", func(t *testing.T) {
+		for _, argType := range []string{
+			"(uint256,string)",
+			"(uint256 amount,string amount)",
+			"(uint256 amount",
+			"(uint256 1amount)",
+			"uint",
+			"uint7",
+			"uint264",
+			"int0",
+			"bytes0",
+			"bytes33",
+			"fixed128x18",
+			"function",
+			"uint8[0]",
+			"uint8[01]",
+			"uint8[2",
+			"uint8[]]",
+			"uint8 ",
+			"tuple",
+			"",
+		} {
+			payload := funcs.Must(payloadArgumentsV3.Pack("method", []string{"arg"}, []string{argType}, []byte{}))
+
+			_, err := types.TranslateMessage(nexustestutils.RandomMessage(), axelartestutils.PackPayloadWithVersion(types.CosmWasmV3, payload))
+			assert.ErrorContains(t, err, "invalid argument type", argType)
+		}
+	})
+
+	t.Run("should return error if argument types are nested too deeply", func(t *testing.T) {
+		argType := strings.Repeat("(", 101) + "uint8 a" + strings.Repeat(") a", 100) + ")"
+		payload := funcs.Must(payloadArgumentsV3.Pack("method", []string{"arg"}, []string{argType}, []byte{}))
+
+		_, err := types.TranslateMessage(nexustestutils.RandomMessage(), axelartestutils.PackPayloadWithVersion(types.CosmWasmV3, payload))
+		assert.ErrorContains(t, err, "exceeds maximum nesting")
+	})
+
+	t.Run("should return error if argument names are duplicated", func(t *testing.T) {
+		payload := funcs.Must(constructABIPayloadV3("method", []string{"arg", "arg"}, []abi.Type{uint8Type, uint8Type}, []interface{}{uint8(1), uint8(2)}))
+
+		_, err := types.TranslateMessage(nexustestutils.RandomMessage(), payload)
+		assert.ErrorContains(t, err, "duplicate argument name")
+	})
+
+	t.Run("should return error if argument values do not match the types", func(t *testing.T) {
+		values := funcs.Must(abi.Arguments{{Type: uint64Type}}.Pack(uint64(1)))
+		payload := funcs.Must(payloadArgumentsV3.Pack("method", []string{"arg"}, []string{"(uint64 a,string b)[]"}, values))
+
+		_, err := types.TranslateMessage(nexustestutils.RandomMessage(), axelartestutils.PackPayloadWithVersion(types.CosmWasmV3, payload))
+		assert.Error(t, err)
+	})
+}
+
 func TestConstructNativeV1Message(t *testing.T) {
 	t.Run("should translate native payload", func(t *testing.T) {
 		payloadMsg := rand.Bytes(int(rand.I64Between(1, 50)))
@@ -745,6 +914,41 @@ func constructABIPayload(method string, argNames []string, argTypes []abi.Type,
 	return axelartestutils.PackPayloadWithVersion(types.CosmWasmV1, payload), nil
 }
 
+var payloadArgumentsV3 = abi.Arguments{{Type: stringType}, {Type: stringArrayType}, {Type: stringArrayType}, {Type: bytesType}}
+
+func constructABIPayloadV3(method string, argNames []string, argTypes []abi.Type, args []interface{}) ([]byte, error) {
+	argValues, err := abi.Arguments(slices.Map(argTypes, func(argType abi.Type) abi.Argument { return abi.Argument{Type: argType} })).Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := payloadArgumentsV3.Pack(method, argNames, slices.Map(argTypes, v3TypeString), argValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return axelartestutils.PackPayloadWithVersion(types.CosmWasmV3, payload), nil
+}
+
+// v3TypeString returns the type string of the given abi type including the names of tuple components
+func v3TypeString(t abi.Type) string {
+	switch t.T {
+	case abi.TupleTy:
+		components := make([]string, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			components[i] = fmt.Sprintf("%s %s", v3TypeString(*elem), t.TupleRawNames[i])
+		}
+
+		return fmt.Sprintf("(%s)", strings.Join(components, ","))
+	case abi.SliceTy:
+		return v3TypeString(*t.Elem) + "[]"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", v3TypeString(*t.Elem), t.Size)
+	default:
+		return t.String()
+	}
+}
+
 // checkWasmMsg checks that a wasm msg is correctly formatted
 func checkWasmMsg[T any](t assert.TestingT, payload []byte, msg nexus.GeneralMessage, method string, args T) {
 	// json unmarshalling behaviour differs when unmarshalling to map[string]interface{} vs a struct, so cover both cases
@@ -84,7 +84,8 @@ type Nexus interface {
 	SetChain(ctx sdk.Context, chain nexus.Chain)
 	GetTransferFees(ctx sdk.Context) sdk.Coins
 	SubTransferFee(ctx sdk.Context, coin sdk.Coin)
-	SetMessageFailed(ctx sdk.Context, id string) error
+	SetMessageFailed(ctx sdk.Context, id string, reason string) error
+	RefundMessage(ctx sdk.Context, id string) (bool, error)
 	GenerateMessageID(ctx sdk.Context) (string, []byte, uint64)
 	ValidateAddress(ctx sdk.Context, address nexus.CrossChainAddress) error
 	NewLockableAsset(ctx sdk.Context, ibc nexustypes.IBCKeeper, bank nexustypes.BankKeeper, coin sdk.Coin) (nexus.LockableAsset, error)
@@ -758,6 +758,9 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //			NewLockableAssetFunc: func(ctx cosmossdktypes.Context, ibc nexustypes.IBCKeeper, bank nexustypes.BankKeeper, coin cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.LockableAsset, error) {
 //				panic("mock out the NewLockableAsset method")
 //			},
+//			RefundMessageFunc: func(ctx cosmossdktypes.Context, id string) (bool, error) {
+//				panic("mock out the RefundMessage method")
+//			},
 //			RegisterAssetFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error {
 //				panic("mock out the RegisterAsset method")
 //			},
@@ -776,7 +779,7 @@ var _ axelarnettypes.Nexus = &NexusMock{}
 //			SetMessageExecutedFunc: func(ctx cosmossdktypes.Context, id string) error {
 //				panic("mock out the SetMessageExecuted method")
 //			},
-//			SetMessageFailedFunc: func(ctx cosmossdktypes.Context, id string) error {
+//			SetMessageFailedFunc: func(ctx cosmossdktypes.Context, id string, reason string) error {
 //				panic("mock out the SetMessageFailed method")
 //			},
 //			SetNewMessageFunc: func(ctx cosmossdktypes.Context, msg github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error {
@@ -891,6 +894,9 @@ type NexusMock struct {
 	// NewLockableAssetFunc mocks the NewLockableAsset method.
 	NewLockableAssetFunc func(ctx cosmossdktypes.Context, ibc nexustypes.IBCKeeper, bank nexustypes.BankKeeper, coin cosmossdktypes.Coin) (github_com_axelarnetwork_axelar_core_x_nexus_exported.LockableAsset, error)
 
+	// RefundMessageFunc mocks the RefundMessage method.
+	RefundMessageFunc func(ctx cosmossdktypes.Context, id string) (bool, error)
+
 	// RegisterAssetFunc mocks the RegisterAsset method.
 	RegisterAssetFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error
 
@@ -910,7 +916,7 @@ type NexusMock struct {
 	SetMessageExecutedFunc func(ctx cosmossdktypes.Context, id string) error
 
 	// SetMessageFailedFunc mocks the SetMessageFailed method.
-	SetMessageFailedFunc func(ctx cosmossdktypes.Context, id string) error
+	SetMessageFailedFunc func(ctx cosmossdktypes.Context, id string, reason string) error
 
 	// SetNewMessageFunc mocks the SetNewMessage method.
 	SetNewMessageFunc func(ctx cosmossdktypes.Context, msg github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error
@@ -1147,6 +1153,13 @@ type NexusMock struct {
 			// Coin is the coin argument value.
 			Coin cosmossdktypes.Coin
 		}
+		// RefundMessage holds details about calls to the RefundMessage method.
+		RefundMessage []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Id is the id argument value.
+			Id string
+		}
 		// RegisterAsset holds details about calls to the RegisterAsset method.
 		RegisterAsset []struct {
 			// Ctx is the ctx argument value.
@@ -1203,6 +1216,8 @@ type NexusMock struct {
 			Ctx cosmossdktypes.Context
 			// ID is the id argument value.
 			ID string
+			// Reason is the reason argument value.
+			Reason string
 		}
 		// SetNewMessage holds details about calls to the SetNewMessage method.
 		SetNewMessage []struct {
@@ -1264,6 +1279,7 @@ type NexusMock struct {
 	lockLogger                        sync.RWMutex
 	lockMarkTransferAsFailed          sync.RWMutex
 	lockNewLockableAsset              sync.RWMutex
+	lockRefundMessage                 sync.RWMutex
 	lockRegisterAsset                 sync.RWMutex
 	lockRegisterFee                   sync.RWMutex
 	lockRemoveChainMaintainer         sync.RWMutex
@@ -2401,6 +2417,42 @@ func (mock *NexusMock) NewLockableAssetCalls() []struct {
 	return calls
 }
 
+// RefundMessage calls RefundMessageFunc.
+func (mock *NexusMock) RefundMessage(ctx cosmossdktypes.Context, id string) (bool, error) {
+	if mock.RefundMessageFunc == nil {
+		panic("NexusMock.RefundMessageFunc: method is nil but Nexus.RefundMessage was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+		Id  string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockRefundMessage.Lock()
+	mock.calls.RefundMessage = append(mock.calls.RefundMessage, callInfo)
+	mock.lockRefundMessage.Unlock()
+	return mock.RefundMessageFunc(ctx, id)
+}
+
+// RefundMessageCalls gets all the calls that were made to RefundMessage.
+// Check the length with:
+//
+//	len(mockedNexus.RefundMessageCalls())
+func (mock *NexusMock) RefundMessageCalls() []struct {
+	Ctx cosmossdktypes.Context
+	Id  string
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+		Id  string
+	}
+	mock.lockRefundMessage.RLock()
+	calls = mock.calls.RefundMessage
+	mock.lockRefundMessage.RUnlock()
+	return calls
+}
+
 // RegisterAsset calls RegisterAssetFunc.
 func (mock *NexusMock) RegisterAsset(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error {
 	if mock.RegisterAssetFunc == nil {
@@ -2634,21 +2686,23 @@ func (mock *NexusMock) SetMessageExecutedCalls() []struct {
 }
 
 // SetMessageFailed calls SetMessageFailedFunc.
-func (mock *NexusMock) SetMessageFailed(ctx cosmossdktypes.Context, id string) error {
+func (mock *NexusMock) SetMessageFailed(ctx cosmossdktypes.Context, id string, reason string) error {
 	if mock.SetMessageFailedFunc == nil {
 		panic("NexusMock.SetMessageFailedFunc: method is nil but Nexus.SetMessageFailed was just called")
 	}
 	callInfo := struct {
-		Ctx cosmossdktypes.Context
-		ID  string
+		Ctx    cosmossdktypes.Context
+		ID     string
+		Reason string
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
 	}
 	mock.lockSetMessageFailed.Lock()
 	mock.calls.SetMessageFailed = append(mock.calls.SetMessageFailed, callInfo)
 	mock.lockSetMessageFailed.Unlock()
-	return mock.SetMessageFailedFunc(ctx, id)
+	return mock.SetMessageFailedFunc(ctx, id, reason)
 }
 
 // SetMessageFailedCalls gets all the calls that were made to SetMessageFailed.
@@ -2656,12 +2710,14 @@ func (mock *NexusMock) SetMessageFailed(ctx cosmossdktypes.Context, id string) e
 //
 //	len(mockedNexus.SetMessageFailedCalls())
 func (mock *NexusMock) SetMessageFailedCalls() []struct {
-	Ctx cosmossdktypes.Context
-	ID  string
+	Ctx    cosmossdktypes.Context
+	ID     string
+	Reason string
 } {
 	var calls []struct {
-		Ctx cosmossdktypes.Context
-		ID  string
+		Ctx    cosmossdktypes.Context
+		ID     string
+		Reason string
 	}
 	mock.lockSetMessageFailed.RLock()
 	calls = mock.calls.SetMessageFailed
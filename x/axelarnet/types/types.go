@@ -242,6 +242,8 @@ const (
 	CosmWasmV1 = "0x00000001"
 	// CosmWasmV2 indicates the payload is json encoded
 	CosmWasmV2 = "0x00000002"
+	// CosmWasmV3 indicates the payload is abi encoded like CosmWasmV1, with support for tuples, nested arrays, fixed-size bytes and signed integers
+	CosmWasmV3 = "0x00000003"
 )
 
 var (
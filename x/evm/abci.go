@@ -137,6 +137,8 @@ func processConfirmedEvent(ctx sdk.Context, event types.Event, bk types.BaseKeep
 		return applyTokenDeployment(ctx, event, bk, n)
 	case *types.Event_MultisigOperatorshipTransferred:
 		return applyKeyRotation(ctx, event, bk, n, m)
+	case *types.Event_CommandExecuted:
+		return applyCommandExecution(ctx, event, bk)
 	default:
 		panic(fmt.Errorf("unsupported event type %T", event))
 	}
@@ -152,7 +154,7 @@ func validateEvent(ctx sdk.Context, event types.Event, bk types.BaseKeeper, n ty
 	case *types.Event_ContractCallWithToken:
 		destinationChainName = event.ContractCallWithToken.DestinationChain
 		contractAddress = event.ContractCallWithToken.ContractAddress
-	case *types.Event_TokenDeployed, *types.Event_MultisigOperatorshipTransferred:
+	case *types.Event_TokenDeployed, *types.Event_MultisigOperatorshipTransferred, *types.Event_CommandExecuted:
 		// skip checks for non-gateway tx event
 		return nil
 	default:
@@ -212,7 +214,8 @@ func routeContractCallWithToken(ctx sdk.Context, event types.Event, bk types.Bas
 }
 
 // routeEventToNexus stores the confirmed event as a nexus general message. The
-// message ID is set to the string event ID ("0x<txHash>-<index>"), which becomes
+// message ID is set to the string event ID ("0x<txHash>-<index>", or
+// "<base58 tx signature>-<index>" for Solana compatible chains), which becomes
 // the commandID preimage on the destination chain (see evm/types.NewCommandID).
 // Off-chain express predictors derive the commandID from it, so the format must
 // stay stable; it is not an internal-only field.
@@ -224,7 +227,7 @@ func routeEventToNexus(ctx sdk.Context, n types.Nexus, event types.Event, asset
 	case *types.Event_ContractCall:
 		sender := nexus.CrossChainAddress{
 			Chain:   sourceChain,
-			Address: e.ContractCall.Sender.Hex(),
+			Address: e.ContractCall.GetSenderAddress(),
 		}
 
 		recipient := nexus.CrossChainAddress{
@@ -237,7 +240,7 @@ func routeEventToNexus(ctx sdk.Context, n types.Nexus, event types.Event, asset
 			sender,
 			recipient,
 			e.ContractCall.PayloadHash.Bytes(),
-			event.TxID.Bytes(),
+			event.GetSourceTxID(),
 			event.Index,
 			nil,
 		)
@@ -249,7 +252,7 @@ func routeEventToNexus(ctx sdk.Context, n types.Nexus, event types.Event, asset
 
 		sender := nexus.CrossChainAddress{
 			Chain:   sourceChain,
-			Address: e.ContractCallWithToken.Sender.Hex(),
+			Address: e.ContractCallWithToken.GetSenderAddress(),
 		}
 
 		destChain, ok := n.GetChain(ctx, e.ContractCallWithToken.DestinationChain)
@@ -267,7 +270,7 @@ func routeEventToNexus(ctx sdk.Context, n types.Nexus, event types.Event, asset
 			sender,
 			recipient,
 			e.ContractCallWithToken.PayloadHash.Bytes(),
-			event.TxID.Bytes(),
+			event.GetSourceTxID(),
 			event.Index,
 			asset,
 		)
@@ -326,6 +329,17 @@ func applyTokenDeployment(ctx sdk.Context, event types.Event, bk types.BaseKeepe
 	return nil
 }
 
+func applyCommandExecution(ctx sdk.Context, event types.Event, bk types.BaseKeeper) error {
+	e := event.GetEvent().(*types.Event_CommandExecuted).CommandExecuted
+	if e == nil {
+		panic(fmt.Errorf("event is nil"))
+	}
+
+	ck := funcs.Must(bk.ForChain(ctx, event.Chain))
+
+	return ck.SetCommandExecuted(ctx, e.CommandID, event.TxID)
+}
+
 func applyKeyRotation(ctx sdk.Context, event types.Event, bk types.BaseKeeper, n types.Nexus, multisig types.MultisigKeeper) error {
 	e := event.GetEvent().(*types.Event_MultisigOperatorshipTransferred).MultisigOperatorshipTransferred
 	if e == nil {
@@ -413,12 +427,14 @@ func deliverPendingMessages(ctx sdk.Context, bk types.BaseKeeper, n types.Nexus,
 
 		for _, msg := range msgs {
 			success := false
+			failureReason := "failed to create the delivery command"
 			_ = utils.RunCached(ctx, bk, func(ctx sdk.Context) (bool, error) {
 				if err := validateMessageForDelivery(ctx, destCk, n, m, chain, msg); err != nil {
 					bk.Logger(ctx).Info(fmt.Sprintf("failed validating message for delivery: %s", err.Error()),
 						types.AttributeKeyChain, msg.GetDestinationChain(),
 						types.AttributeKeyMessageID, msg.ID,
 					)
+					failureReason = err.Error()
 					return false, err
 				}
 
@@ -449,7 +465,7 @@ func deliverPendingMessages(ctx sdk.Context, bk types.BaseKeeper, n types.Nexus,
 					MessageID: msg.ID,
 				})
 
-				funcs.MustNoErr(n.SetMessageFailed(ctx, msg.ID))
+				funcs.MustNoErr(n.SetMessageFailed(ctx, msg.ID, failureReason))
 
 				continue
 			}
@@ -492,7 +508,7 @@ func validateMessageForDelivery(ctx sdk.Context, ck types.ChainKeeper, n types.N
 }
 
 func deliverMessage(ctx sdk.Context, ck types.ChainKeeper, chainID math.Int, keyID multisig.KeyID, msg nexus.GeneralMessage) {
-	cmd := types.NewApproveContractCallCommandGeneric(chainID, keyID, common.HexToAddress(msg.GetDestinationAddress()), common.BytesToHash(msg.PayloadHash), common.BytesToHash(msg.SourceTxID), msg.GetSourceChain(), msg.GetSourceAddress(), msg.SourceTxIndex, msg.ID)
+	cmd := types.NewApproveContractCallCommandGeneric(chainID, keyID, common.HexToAddress(msg.GetDestinationAddress()), common.BytesToHash(msg.PayloadHash), common.BytesToHash(msg.SourceTxID), msg.GetSourceChain(), msg.GetSourceAddress(), msg.SourceTxIndex, msg.ID, msg.GasLimit)
 	funcs.MustNoErr(ck.EnqueueCommand(ctx, cmd))
 
 	events.Emit(ctx, &types.ContractCallApproved{
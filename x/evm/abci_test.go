@@ -2,10 +2,12 @@ package evm
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil/base58"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -334,6 +336,31 @@ func TestProcessConfirmedEvents(t *testing.T) {
 			assert.Equal(t, msg.ID, s.nexus.EnqueueRouteMessageCalls()[0].ID)
 		})
 
+		t.Run("svm event creates message with the native tx signature and sender", func(t *testing.T) {
+			s := newRoutingTestSetup(t)
+			signature := rand.Bytes(64)
+			sender := rand.Bytes(32)
+
+			event := s.createContractCallEvent()
+			event.TxID = types.Hash{}
+			event.SVMTxSignature = base58.Encode(signature)
+			event.GetContractCall().Sender = types.Address{}
+			event.GetContractCall().SVMSender = base58.Encode(sender)
+			assert.NoError(t, event.ValidateBasic())
+			s.queueEvent(event)
+
+			_, err := EndBlocker(s.ctx, s.baseKeeper, s.nexus, s.multisig)
+			assert.NoError(t, err)
+
+			assert.Len(t, s.sourceChainKeeper.SetEventCompletedCalls(), 1, "event should be marked completed")
+			assert.Len(t, s.nexus.SetNewMessageCalls(), 1, "message should be created")
+
+			msg := s.nexus.SetNewMessageCalls()[0].M
+			assert.Equal(t, fmt.Sprintf("%s-%d", base58.Encode(signature), event.Index), msg.ID)
+			assert.Equal(t, base58.Encode(sender), msg.Sender.Address)
+			assert.Equal(t, signature, msg.SourceTxID)
+		})
+
 		t.Run("routing does not create command", func(t *testing.T) {
 			s := newRoutingTestSetup(t)
 			s.queueEvent(s.createContractCallEvent())
@@ -883,7 +910,7 @@ func newDeliveryTestSetup(t *testing.T) *deliveryTestSetup {
 
 	// Configure nexus for delivery
 	s.nexus.SetMessageExecutedFunc = func(ctx sdk.Context, id string) error { return nil }
-	s.nexus.SetMessageFailedFunc = func(ctx sdk.Context, id string) error { return nil }
+	s.nexus.SetMessageFailedFunc = func(ctx sdk.Context, id string, reason string) error { return nil }
 
 	// Configure multisig for delivery
 	s.multisig.GetCurrentKeyIDFunc = func(ctx sdk.Context, chainName nexus.ChainName) (multisig.KeyID, bool) {
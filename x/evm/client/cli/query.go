@@ -38,6 +38,8 @@ func GetQueryCmd() *cobra.Command {
 		getCmdLatestBatchedCommands(),
 		getCmdPendingCommands(),
 		getCmdCommand(),
+		getCmdCommandStatus(),
+		getCmdExecutedCommands(),
 		getCmdChains(),
 		getCmdConfirmationHeight(),
 		getCmdERC20Tokens(),
@@ -271,6 +273,80 @@ func getCmdCommand() *cobra.Command {
 	return cmd
 }
 
+// getCmdCommandStatus returns the query for the batch, signing session and execution of a command
+func getCmdCommandStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "command-status [chain] [id]",
+		Short: "Get the batch, signing session and execution of an EVM gateway command given a chain and the command ID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.CommandStatus(cmd.Context(),
+				&types.CommandStatusRequest{
+					Chain: args[0],
+					Id:    args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// getCmdExecutedCommands returns the query for the history of commands executed on the gateway of a chain
+func getCmdExecutedCommands() *cobra.Command {
+	cmdName := "executed-commands"
+	cmd := &cobra.Command{
+		Use:   cmdName + " [chain]",
+		Short: "Returns the commands executed on the gateway of the given chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+			if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+				pageReq.Key = nil
+			}
+
+			res, err := queryClient.ExecutedCommands(cmd.Context(),
+				&types.ExecutedCommandsRequest{
+					Chain:      args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+	return cmd
+}
+
 // getCmdChains returns the query to get all EVM chains
 func getCmdChains() *cobra.Command {
 	cmd := &cobra.Command{
@@ -36,10 +36,12 @@ func GetTxCmd() *cobra.Command {
 
 	evmTxCmd.AddCommand(
 		GetCmdSetGateway(),
+		GetCmdSetSVMGateway(),
 		GetCmdConfirmERC20TokenDeployment(),
 		GetCmdConfirmTransferOperatorship(),
 		GetCmdCreateConfirmGatewayTx(),
 		GetCmdCreateConfirmGatewayTxs(),
+		GetCmdCreateConfirmSVMGatewayTxs(),
 		GetCmdCreateDeployToken(),
 		GetCmdCreateTransferOperatorship(),
 		GetCmdSignCommands(),
@@ -78,6 +80,28 @@ func GetCmdSetGateway() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetSVMGateway returns the cli command to set the gateway program of a chain whose gateway is a Solana program
+func GetCmdSetSVMGateway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-svm-gateway [chain] [program id]",
+		Short: "Set the gateway program for the given chain whose gateway is a Solana program",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewSetSVMGatewayRequest(cliCtx.GetFromAddress(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdConfirmERC20TokenDeployment returns the cli command to confirm a ERC20 token deployment
 func GetCmdConfirmERC20TokenDeployment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -179,6 +203,28 @@ func GetCmdCreateConfirmGatewayTxs() *cobra.Command {
 	return cmd
 }
 
+// GetCmdCreateConfirmSVMGatewayTxs returns the cli command to confirm gateway transactions of a chain whose gateway is a Solana program
+func GetCmdCreateConfirmSVMGatewayTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-svm-gateway-txs [chain] [txSignature]...",
+		Short: "Confirm gateway transactions in a Solana compatible chain",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := nexus.ChainName(utils.NormalizeString(args[0]))
+			msg := types.NewConfirmSVMGatewayTxsRequest(cliCtx.GetFromAddress(), chain, args[1:])
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdCreateDeployToken returns the cli command to create deploy-token command for an EVM chain
 func GetCmdCreateDeployToken() *cobra.Command {
 	cmd := &cobra.Command{
@@ -193,7 +193,7 @@ func (k chainKeeper) GetConfirmedEventQueue(ctx sdk.Context) utils.KVQueue {
 			binary.BigEndian.PutUint64(indexBz, event.Index)
 
 			return utils.KeyFromBz(blockHeightBz).
-				Append(utils.KeyFromBz(event.TxID.Bytes())).
+				Append(utils.KeyFromBz(event.GetSourceTxID())).
 				Append(utils.KeyFromBz(indexBz))
 		},
 	)
@@ -276,6 +276,7 @@ func (k chainKeeper) GetChainIDByNetwork(ctx sdk.Context, network string) (math.
 func (k chainKeeper) setCommandBatchMetadata(ctx sdk.Context, meta types.CommandBatchMetadata) {
 	funcs.MustNoErr(
 		k.getStore(ctx).SetNewValidated(key.FromStr(commandBatchPrefix).Append(key.FromBz(meta.ID)), &meta))
+	k.setBatchIDByCommands(ctx, meta)
 }
 
 // GetBatchByID retrieves the specified batch if it exists
@@ -364,6 +365,7 @@ func (k chainKeeper) CreateNewBatchToSign(ctx sdk.Context) (types.CommandBatch,
 
 	chainID := math.NewIntFromBigInt(k.getSigner(ctx).ChainID())
 	gasLimit := k.getCommandsGasLimit(ctx)
+	// only the gateway's gas is packed, as the gas limits of approved contract calls are merely a hint for relayers
 	gasCost := firstCmd.MaxGasCost
 	keyID := firstCmd.KeyID
 	filter := func(value codec.ProtoMarshaler) bool {
@@ -566,9 +568,23 @@ func (k chainKeeper) GetGatewayAddress(ctx sdk.Context) (types.Address, bool) {
 	return types.Address{}, false
 }
 
+// SetSVMGateway sets the address of the gateway program of a chain whose gateway is a Solana program
+func (k chainKeeper) SetSVMGateway(ctx sdk.Context, programID string) {
+	k.setGateway(ctx, types.Gateway{SVMProgramID: programID})
+}
+
+// GetSVMGatewayProgramID returns the address of the gateway program if the chain's gateway is a Solana program
+func (k chainKeeper) GetSVMGatewayProgramID(ctx sdk.Context) (string, bool) {
+	if gateway := k.getGateway(ctx); len(gateway.SVMProgramID) != 0 {
+		return gateway.SVMProgramID, true
+	}
+
+	return "", false
+}
+
 func (k chainKeeper) setGateway(ctx sdk.Context, gateway types.Gateway) {
 	// TODO: remove this guard clause once genesis state can have nil Gateway
-	if gateway.Address.IsZeroAddress() {
+	if gateway.Address.IsZeroAddress() && len(gateway.SVMProgramID) == 0 {
 		return
 	}
 
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+var (
+	batchIDByCommandPrefix   = key.FromStr("batch_id_by_command")
+	executionByCommandPrefix = key.FromStr("execution_by_command")
+	executionHistoryPrefix   = key.FromStr("execution_history")
+	executionCountKey        = key.FromStr("execution_count")
+)
+
+func getBatchIDByCommandKey(id types.CommandID) key.Key {
+	return batchIDByCommandPrefix.Append(key.FromStr(id.Hex()))
+}
+
+func getExecutionByCommandKey(id types.CommandID) key.Key {
+	return executionByCommandPrefix.Append(key.FromStr(id.Hex()))
+}
+
+func getExecutionHistoryKey(seq uint64) key.Key {
+	return executionHistoryPrefix.Append(key.FromUInt(seq))
+}
+
+// setBatchIDByCommands indexes the commands of the given batch so their batch can be looked up
+func (k chainKeeper) setBatchIDByCommands(ctx sdk.Context, meta types.CommandBatchMetadata) {
+	for _, id := range meta.CommandIDs {
+		k.getStore(ctx).SetRawNew(getBatchIDByCommandKey(id), meta.ID)
+	}
+}
+
+// GetBatchIDByCommandID returns the ID of the latest batch the given command was included in
+func (k chainKeeper) GetBatchIDByCommandID(ctx sdk.Context, id types.CommandID) ([]byte, bool) {
+	batchID := k.getStore(ctx).GetRawNew(getBatchIDByCommandKey(id))
+
+	return batchID, batchID != nil
+}
+
+// SetCommandExecuted records that the given command has been executed on the gateway by the given transaction
+func (k chainKeeper) SetCommandExecuted(ctx sdk.Context, id types.CommandID, txID types.Hash) error {
+	if _, ok := k.GetCommand(ctx, id); !ok {
+		return fmt.Errorf("command %s does not exist", id.Hex())
+	}
+
+	if _, ok := k.GetCommandExecution(ctx, id); ok {
+		return fmt.Errorf("command %s has already been executed", id.Hex())
+	}
+
+	batchID, ok := k.GetBatchIDByCommandID(ctx, id)
+	if !ok {
+		return fmt.Errorf("command %s has not been batched", id.Hex())
+	}
+
+	execution := types.CommandExecution{
+		CommandID: id,
+		BatchID:   batchID,
+		TxID:      txID,
+		Height:    ctx.BlockHeight(),
+	}
+	k.appendCommandExecution(ctx, execution)
+
+	events.Emit(ctx, &types.CommandExecuted{
+		Chain:     k.chain,
+		CommandID: id,
+		BatchID:   batchID,
+		TxID:      txID,
+	})
+
+	k.Logger(ctx).Info(fmt.Sprintf("command %s of batch %s executed on chain %s", id.Hex(), hex.EncodeToString(batchID), k.chain),
+		"chain", k.chain,
+		"commandID", id.Hex(),
+		"commandBatchID", hex.EncodeToString(batchID),
+		"txID", txID.Hex(),
+	)
+
+	return nil
+}
+
+func (k chainKeeper) appendCommandExecution(ctx sdk.Context, execution types.CommandExecution) {
+	seq := utils.NewCounter[uint64](executionCountKey, k.getStore(ctx)).Incr(ctx)
+
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getExecutionHistoryKey(seq), &execution))
+	k.getStore(ctx).SetRawNew(getExecutionByCommandKey(execution.CommandID), sdk.Uint64ToBigEndian(seq))
+}
+
+// GetCommandExecution returns the execution of the given command, if it has been executed
+func (k chainKeeper) GetCommandExecution(ctx sdk.Context, id types.CommandID) (types.CommandExecution, bool) {
+	bz := k.getStore(ctx).GetRawNew(getExecutionByCommandKey(id))
+	if bz == nil {
+		return types.CommandExecution{}, false
+	}
+
+	var execution types.CommandExecution
+	if !k.getStore(ctx).GetNew(getExecutionHistoryKey(sdk.BigEndianToUint64(bz)), &execution) {
+		panic(fmt.Errorf("execution of command %s not found", id.Hex()))
+	}
+
+	return execution, true
+}
+
+// GetCommandExecutionsPaginated returns the executed commands in the order they were executed
+func (k chainKeeper) GetCommandExecutionsPaginated(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.CommandExecution, *query.PageResponse, error) {
+	var executions []types.CommandExecution
+
+	store := prefix.NewStore(k.getStore(ctx).KVStore, append(executionHistoryPrefix.Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		var execution types.CommandExecution
+		k.cdc.MustUnmarshalLengthPrefixed(value, &execution)
+		executions = append(executions, execution)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return executions, resp, nil
+}
+
+func (k chainKeeper) getCommandExecutions(ctx sdk.Context) []types.CommandExecution {
+	iter := k.getStore(ctx).IteratorNew(executionHistoryPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var executions []types.CommandExecution
+	for ; iter.Valid(); iter.Next() {
+		var execution types.CommandExecution
+		iter.UnmarshalValue(&execution)
+		executions = append(executions, execution)
+	}
+
+	return executions
+}
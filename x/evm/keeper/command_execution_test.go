@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	store "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramsKeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	evmKeeper "github.com/axelarnetwork/axelar-core/x/evm/keeper"
+	"github.com/axelarnetwork/axelar-core/x/evm/types"
+	evmtestutils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	multisigTestUtils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestCommandExecution(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		ck       types.ChainKeeper
+		commands []types.Command
+		batch    types.CommandBatch
+	)
+
+	givenBatchedCommands := Given("a batch of commands", func() {
+		encCfg := app.MakeEncodingConfig()
+		paramsK := paramsKeeper.NewKeeper(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("params"), store.NewKVStoreKey("tparams"))
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.NewTestLogger(t))
+		k := evmKeeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey("evm"), paramsK)
+		k.InitChains(ctx)
+
+		funcs.MustNoErr(k.CreateChain(ctx, types.DefaultParams()[0]))
+		ck = funcs.Must(k.ForChain(ctx, types.DefaultParams()[0].Chain))
+		chainID := funcs.MustOk(ck.GetChainID(ctx))
+
+		keyID := multisigTestUtils.KeyID()
+		commands = slices.Expand(func(int) types.Command {
+			cmd := types.NewDeployTokenCommand(chainID, keyID, rand.Str(5), createDetails(rand.NormalizedStr(10), rand.NormalizedStr(10)), types.ZeroAddress, math.NewUint(uint64(rand.PosI64())))
+			cmd.MaxGasCost = 1
+
+			funcs.MustNoErr(ck.EnqueueCommand(ctx, cmd))
+			return cmd
+		}, int(rand.I64Between(5, 20)))
+
+		batch = funcs.Must(ck.CreateNewBatchToSign(ctx))
+	})
+
+	givenBatchedCommands.
+		When("commands are batched", func() {}).
+		Then("should look up their batch", func(t *testing.T) {
+			assert.Len(t, batch.GetCommandIDs(), len(commands))
+
+			for _, cmd := range commands {
+				batchID, ok := ck.GetBatchIDByCommandID(ctx, cmd.ID)
+				assert.True(t, ok)
+				assert.Equal(t, batch.GetID(), batchID)
+			}
+
+			_, ok := ck.GetBatchIDByCommandID(ctx, evmtestutils.RandomCommandID())
+			assert.False(t, ok)
+		}).
+		Run(t)
+
+	givenBatchedCommands.
+		When("commands are executed", func() {
+			for _, cmd := range commands {
+				funcs.MustNoErr(ck.SetCommandExecuted(ctx, cmd.ID, evmtestutils.RandomHash()))
+			}
+		}).
+		Branch(
+			Then("should return the executions", func(t *testing.T) {
+				for _, cmd := range commands {
+					execution, ok := ck.GetCommandExecution(ctx, cmd.ID)
+					assert.True(t, ok)
+					assert.Equal(t, cmd.ID, execution.CommandID)
+					assert.Equal(t, batch.GetID(), execution.BatchID)
+					assert.Equal(t, ctx.BlockHeight(), execution.Height)
+				}
+			}),
+
+			Then("should return the history in order of execution", func(t *testing.T) {
+				var executions []types.CommandExecution
+
+				pageRequest := &query.PageRequest{Limit: 3}
+				for {
+					page, pageResponse, err := ck.GetCommandExecutionsPaginated(ctx, pageRequest)
+					assert.NoError(t, err)
+					assert.LessOrEqual(t, len(page), 3)
+
+					executions = append(executions, page...)
+					if len(pageResponse.NextKey) == 0 {
+						break
+					}
+
+					pageRequest = &query.PageRequest{Key: pageResponse.NextKey, Limit: 3}
+				}
+
+				assert.Equal(t,
+					slices.Map(commands, func(cmd types.Command) types.CommandID { return cmd.ID }),
+					slices.Map(executions, func(e types.CommandExecution) types.CommandID { return e.CommandID }))
+			}),
+
+			Then("should not execute a command twice", func(t *testing.T) {
+				assert.ErrorContains(t, ck.SetCommandExecuted(ctx, commands[0].ID, evmtestutils.RandomHash()), "already been executed")
+			}),
+		).
+		Run(t)
+
+	givenBatchedCommands.
+		When("a command is unknown or not batched yet", func() {}).
+		Then("should fail to execute it", func(t *testing.T) {
+			assert.ErrorContains(t, ck.SetCommandExecuted(ctx, evmtestutils.RandomCommandID(), evmtestutils.RandomHash()), "does not exist")
+
+			cmd := types.NewDeployTokenCommand(funcs.MustOk(ck.GetChainID(ctx)), multisigTestUtils.KeyID(), rand.Str(5), createDetails(rand.NormalizedStr(10), rand.NormalizedStr(10)), types.ZeroAddress, math.NewUint(uint64(rand.PosI64())))
+			funcs.MustNoErr(ck.EnqueueCommand(ctx, cmd))
+			assert.ErrorContains(t, ck.SetCommandExecuted(ctx, cmd.ID, evmtestutils.RandomHash()), "has not been batched")
+		}).
+		Run(t)
+}
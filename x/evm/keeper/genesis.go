@@ -45,6 +45,10 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 			panic(err)
 		}
 		ck.GetConfirmedEventQueue(ctx).(utils.GeneralKVQueue).ImportState(chain.ConfirmedEventQueue)
+
+		for _, execution := range chain.CommandExecutions {
+			ck.appendCommandExecution(ctx, execution)
+		}
 	}
 }
 
@@ -69,6 +73,7 @@ func (k BaseKeeper) getChains(ctx sdk.Context) []types.GenesisState_Chain {
 			Tokens:              ck.getTokensMetadata(ctx),
 			Events:              ck.getEvents(ctx),
 			ConfirmedEventQueue: ck.GetConfirmedEventQueue(ctx).(utils.GeneralKVQueue).ExportState(),
+			CommandExecutions:   ck.getCommandExecutions(ctx),
 		}
 		chains = append(chains, chain)
 	}
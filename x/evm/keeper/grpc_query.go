@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	stdmath "math"
+	"math/bits"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
@@ -18,6 +20,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
@@ -122,9 +125,93 @@ func (q Querier) Command(c context.Context, req *types.CommandRequest) (*types.C
 		Params:     resp.Params,
 		KeyID:      resp.KeyID,
 		MaxGasCost: resp.MaxGasCost,
+		GasLimit:   resp.GasLimit,
 	}, nil
 }
 
+// CommandStatus returns the batch, signing session and execution of the specified command
+func (q Querier) CommandStatus(c context.Context, req *types.CommandStatusRequest) (*types.CommandStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ck, err := q.keeper.ForChain(ctx, nexustypes.ChainName(req.Chain))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", req.Chain)).Error())
+	}
+
+	cmdID, err := types.HexToCommandID(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(types.ErrEVM, err.Error()).Error())
+	}
+
+	cmd, ok := ck.GetCommand(ctx, cmdID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrEVM, fmt.Sprintf("could not find command '%s'", req.Id)).Error())
+	}
+
+	cmdResp, err := GetCommandResponse(cmd)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrEVM, err.Error())
+	}
+
+	resp := types.CommandStatusResponse{
+		Command: types.CommandResponse{
+			ID:         cmdResp.ID,
+			Type:       cmdResp.Type,
+			Params:     cmdResp.Params,
+			KeyID:      cmdResp.KeyID,
+			MaxGasCost: cmdResp.MaxGasCost,
+			GasLimit:   cmdResp.GasLimit,
+		},
+	}
+
+	batchID, ok := ck.GetBatchIDByCommandID(ctx, cmdID)
+	if !ok {
+		return &resp, nil
+	}
+
+	batch := ck.GetBatchByID(ctx, batchID)
+	resp.BatchID = hex.EncodeToString(batchID)
+	resp.BatchStatus = batch.GetStatus()
+	resp.SigningSessionID = batch.GetSigningSessionID()
+
+	if session, ok := q.multisig.GetSigningSession(ctx, batch.GetSigningSessionID()); ok && isSigningSessionOfBatch(session, batchID) {
+		resp.SigningSession = &session
+	}
+
+	if execution, ok := ck.GetCommandExecution(ctx, cmdID); ok {
+		resp.Execution = &execution
+	}
+
+	return &resp, nil
+}
+
+// signing session IDs of batches created before they were recorded default to zero, so the session must be checked to belong to the batch
+func isSigningSessionOfBatch(session multisigtypes.SigningSession, batchID []byte) bool {
+	if session.GetModule() != types.ModuleName {
+		return false
+	}
+
+	metadata, ok := session.GetMetadata().(*types.SigMetadata)
+	return ok && bytes.Equal(metadata.CommandBatchID, batchID)
+}
+
+// ExecutedCommands returns the history of commands executed on the gateway of the specified chain
+func (q Querier) ExecutedCommands(c context.Context, req *types.ExecutedCommandsRequest) (*types.ExecutedCommandsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ck, err := q.keeper.ForChain(ctx, nexustypes.ChainName(req.Chain))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", req.Chain)).Error())
+	}
+
+	executions, pageResp, err := ck.GetCommandExecutionsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(types.ErrEVM, err.Error()).Error())
+	}
+
+	return &types.ExecutedCommandsResponse{Executions: executions, Pagination: pageResp}, nil
+}
+
 // optimizeSignatureSet returns optimized signature set, sorted in ascending order by corresponding evm address
 func optimizeSignatureSet(operators []types.Operator, minPassingWeight math.Uint) [][]byte {
 	sort.SliceStable(operators, func(i, j int) bool {
@@ -267,10 +354,29 @@ func (q Querier) BatchedCommands(c context.Context, req *types.BatchedCommandsRe
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	resp.EstimatedGas = estimateBatchGas(ctx, ck, commandBatch)
 
 	return &resp, nil
 }
 
+// estimateBatchGas returns the gas needed to execute all commands of the given batch, saturating at math.MaxUint64
+func estimateBatchGas(ctx sdk.Context, ck types.ChainKeeper, commandBatch types.CommandBatch) uint64 {
+	var gas uint64
+	for _, cmdID := range commandBatch.GetCommandIDs() {
+		cmd, ok := ck.GetCommand(ctx, cmdID)
+		if !ok {
+			continue
+		}
+
+		var carry uint64
+		if gas, carry = bits.Add64(gas, cmd.EstimatedGas(), 0); carry != 0 {
+			return stdmath.MaxUint64
+		}
+	}
+
+	return gas
+}
+
 // ConfirmationHeight implements the confirmation height grpc query
 func (q Querier) ConfirmationHeight(c context.Context, req *types.ConfirmationHeightRequest) (*types.ConfirmationHeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -318,6 +424,7 @@ func GetCommandResponse(cmd types.Command) (types.QueryCommandResponse, error) {
 		Type:       cmd.Type.String(),
 		KeyID:      string(cmd.KeyID),
 		MaxGasCost: cmd.MaxGasCost,
+		GasLimit:   cmd.GasLimit,
 		Params:     params,
 	}, nil
 }
@@ -349,6 +456,10 @@ func queryAddressByKeyID(ctx sdk.Context, multisig types.MultisigKeeper, chain n
 		return types.KeyAddressResponse{}, errorsmod.Wrapf(types.ErrEVM, "key %s not found for chain %s", keyID, chain.Name)
 	}
 
+	if err := types.ValidateMultisigKeyType(key); err != nil {
+		return types.KeyAddressResponse{}, errorsmod.Wrap(types.ErrEVM, err.Error())
+	}
+
 	addresses, weights, threshold := types.GetMultisigAddressesAndWeights(key)
 	weightedAddresses := make([]types.KeyAddressResponse_WeightedAddress, 0, len(weights))
 	for i, address := range addresses {
@@ -399,6 +510,10 @@ func (q Querier) GatewayAddress(c context.Context, req *types.GatewayAddressRequ
 		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrEVM, fmt.Sprintf("%s is not a registered chain", req.Chain)).Error())
 	}
 
+	if programID, ok := ck.GetSVMGatewayProgramID(ctx); ok {
+		return &types.GatewayAddressResponse{Address: programID}, nil
+	}
+
 	address, ok := ck.GetGatewayAddress(ctx)
 	if !ok {
 		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrEVM, fmt.Sprintf("axelar gateway not set for chain [%s]", req.Chain)).Error())
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -25,8 +26,10 @@ import (
 	evmTest "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
 	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
+	"github.com/axelarnetwork/utils/funcs"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -182,6 +185,7 @@ func TestGateway(t *testing.T) {
 			GetGatewayAddressFunc: func(ctx sdk.Context) (types.Address, bool) {
 				return address, true
 			},
+			GetSVMGatewayProgramIDFunc: func(ctx sdk.Context) (string, bool) { return "", false },
 		}
 
 		existingChain = "existing"
@@ -236,6 +240,7 @@ func TestGateway(t *testing.T) {
 			GetGatewayAddressFunc: func(ctx sdk.Context) (types.Address, bool) {
 				return address, false
 			},
+			GetSVMGatewayProgramIDFunc: func(ctx sdk.Context) (string, bool) { return "", false },
 		}
 
 		_, err := grpcQuerier.GatewayAddress(sdk.WrapSDKContext(ctx), &types.GatewayAddressRequest{
@@ -664,3 +669,135 @@ func TestTokenInfo(t *testing.T) {
 		assert.Error(err)
 	}).Repeat(repeatCount))
 }
+
+func TestCommandStatus(t *testing.T) {
+	var (
+		ctx         sdk.Context
+		querier     evmKeeper.Querier
+		chainKeeper *mock.ChainKeeperMock
+		multisigK   *mock.MultisigKeeperMock
+		chain       nexus.ChainName
+		cmd         types.Command
+		batch       types.CommandBatchMetadata
+		session     multisigtypes.SigningSession
+		execution   types.CommandExecution
+	)
+
+	query := func() (*types.CommandStatusResponse, error) {
+		return querier.CommandStatus(sdk.WrapSDKContext(ctx), &types.CommandStatusRequest{Chain: chain.String(), Id: cmd.ID.Hex()})
+	}
+
+	givenCommand := Given("a command", func() {
+		ctx = sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.NewTestLogger(t))
+		chain = nexustestutils.RandomChainName()
+		cmd = evmTest.RandomCommand()
+
+		chainKeeper = &mock.ChainKeeperMock{
+			GetCommandFunc:            func(_ sdk.Context, id types.CommandID) (types.Command, bool) { return cmd, id == cmd.ID },
+			GetBatchIDByCommandIDFunc: func(sdk.Context, types.CommandID) ([]byte, bool) { return nil, false },
+			GetCommandExecutionFunc: func(sdk.Context, types.CommandID) (types.CommandExecution, bool) {
+				return types.CommandExecution{}, false
+			},
+		}
+		baseKeeper := &mock.BaseKeeperMock{
+			ForChainFunc: func(_ sdk.Context, c nexus.ChainName) (types.ChainKeeper, error) {
+				if c != chain {
+					return nil, errors.New("not found")
+				}
+
+				return chainKeeper, nil
+			},
+		}
+		multisigK = &mock.MultisigKeeperMock{
+			GetSigningSessionFunc: func(sdk.Context, uint64) (multisigtypes.SigningSession, bool) {
+				return multisigtypes.SigningSession{}, false
+			},
+		}
+
+		querier = evmKeeper.NewGRPCQuerier(baseKeeper, &mock.NexusMock{}, multisigK)
+	})
+
+	whenCommandIsBatched := When("the command is batched and being signed", func() {
+		batch = evmTest.RandomBatch()
+		batch.CommandIDs = append(batch.CommandIDs, cmd.ID)
+		batch.Status = types.BatchSigning
+		batch.Signature = nil
+		batch.SigningSessionID = uint64(rand.PosI64())
+
+		chainKeeper.GetBatchIDByCommandIDFunc = func(_ sdk.Context, id types.CommandID) ([]byte, bool) { return batch.ID, id == cmd.ID }
+		chainKeeper.GetBatchByIDFunc = func(_ sdk.Context, id []byte) types.CommandBatch {
+			return types.NewCommandBatch(batch, func(types.CommandBatchMetadata) {})
+		}
+
+		session = multisigtypes.SigningSession{
+			ID:             batch.SigningSessionID,
+			Module:         types.ModuleName,
+			ModuleMetadata: funcs.Must(codectypes.NewAnyWithValue(types.NewSigMetadata(types.SigCommand, chain, batch.ID))),
+		}
+		multisigK.GetSigningSessionFunc = func(_ sdk.Context, id uint64) (multisigtypes.SigningSession, bool) {
+			return session, id == session.ID
+		}
+	})
+
+	givenCommand.
+		When("the chain does not exist", func() {}).
+		Then("should fail", func(t *testing.T) {
+			_, err := querier.CommandStatus(sdk.WrapSDKContext(ctx), &types.CommandStatusRequest{Chain: nexustestutils.RandomChainName().String(), Id: cmd.ID.Hex()})
+			assert.ErrorContains(t, err, "is not a registered chain")
+		}).
+		Run(t)
+
+	givenCommand.
+		When("the command is not batched yet", func() {}).
+		Then("should only return the command", func(t *testing.T) {
+			res, err := query()
+			assert.NoError(t, err)
+			assert.Equal(t, cmd.ID.Hex(), res.Command.ID)
+			assert.Empty(t, res.BatchID)
+			assert.Equal(t, types.BatchNonExistent, res.BatchStatus)
+			assert.Nil(t, res.SigningSession)
+			assert.Nil(t, res.Execution)
+		}).
+		Run(t)
+
+	givenCommand.
+		When2(whenCommandIsBatched).
+		Branch(
+			Then("should return the batch and its signing session", func(t *testing.T) {
+				res, err := query()
+				assert.NoError(t, err)
+				assert.Equal(t, hex.EncodeToString(batch.ID), res.BatchID)
+				assert.Equal(t, types.BatchSigning, res.BatchStatus)
+				assert.Equal(t, batch.SigningSessionID, res.SigningSessionID)
+				assert.Equal(t, &session, res.SigningSession)
+			}),
+
+			When("the signing session belongs to another batch", func() {
+				session.ModuleMetadata = funcs.Must(codectypes.NewAnyWithValue(types.NewSigMetadata(types.SigCommand, chain, rand.Bytes(32))))
+			}).
+				Then("should not return the signing session", func(t *testing.T) {
+					res, err := query()
+					assert.NoError(t, err)
+					assert.Nil(t, res.SigningSession)
+				}),
+
+			When("the command is executed", func() {
+				batch.Status = types.BatchSigned
+				execution = types.CommandExecution{CommandID: cmd.ID, BatchID: batch.ID, TxID: evmTest.RandomHash(), Height: rand.PosI64()}
+				chainKeeper.GetCommandExecutionFunc = func(_ sdk.Context, id types.CommandID) (types.CommandExecution, bool) {
+					return execution, id == cmd.ID
+				}
+				multisigK.GetSigningSessionFunc = func(sdk.Context, uint64) (multisigtypes.SigningSession, bool) {
+					return multisigtypes.SigningSession{}, false
+				}
+			}).
+				Then("should return the execution", func(t *testing.T) {
+					res, err := query()
+					assert.NoError(t, err)
+					assert.Equal(t, types.BatchSigned, res.BatchStatus)
+					assert.Nil(t, res.SigningSession)
+					assert.Equal(t, &execution, res.Execution)
+				}),
+		).
+		Run(t)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	stdmath "math"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -27,6 +28,7 @@ import (
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
 	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -91,6 +93,30 @@ func TestCommands(t *testing.T) {
 			}
 		}
 	}).Repeat(repeats))
+
+	t.Run("batch contract call approvals regardless of their gas limit", testutils.Func(func(t *testing.T) {
+		setup()
+		assert.NoError(t, k.CreateChain(ctx, types.DefaultParams()[0]))
+		chainKeeper := funcs.Must(k.ForChain(ctx, chain))
+		chainID := funcs.MustOk(chainKeeper.GetChainID(ctx))
+		gasLimit := uint64(chainKeeper.GetParams(ctx).CommandsGasLimit)
+		keyID := multisigTestUtils.KeyID()
+
+		newCommand := func(gasLimit uint64) types.Command {
+			return types.NewApproveContractCallCommandGeneric(chainID, keyID, common.BytesToAddress(rand.Bytes(common.AddressLength)), common.BytesToHash(rand.Bytes(common.HashLength)),
+				common.BytesToHash(rand.Bytes(common.HashLength)), nexustestutils.RandomChainName(), rand.AccAddr().String(), uint64(rand.PosI64()), rand.NormalizedStr(20), gasLimit)
+		}
+
+		commands := []types.Command{newCommand(stdmath.MaxUint64), newCommand(gasLimit), newCommand(0), newCommand(stdmath.MaxUint32)}
+		for _, cmd := range commands {
+			assert.NoError(t, chainKeeper.EnqueueCommand(ctx, cmd))
+		}
+
+		batch := funcs.Must(chainKeeper.CreateNewBatchToSign(ctx))
+
+		assert.Equal(t, slices.Map(commands, func(cmd types.Command) types.CommandID { return cmd.ID }), batch.GetCommandIDs())
+		assert.Empty(t, chainKeeper.GetPendingCommands(ctx))
+	}).Repeat(repeats))
 }
 
 func TestGetTokenAddress(t *testing.T) {
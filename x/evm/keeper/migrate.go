@@ -14,6 +14,29 @@ func NoOpMigration(_ sdk.Context) error {
 	return nil
 }
 
+// Migrate11To12 returns the handler that performs in-place store migrations
+func Migrate11To12(k *BaseKeeper, n types.Nexus) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		for _, chain := range slices.Filter(n.GetChains(ctx), types.IsEVMChain) {
+			ck, err := k.ForChain(ctx, chain.Name)
+			if err != nil {
+				return err
+			}
+
+			indexBatchedCommands(ctx, ck.(chainKeeper))
+		}
+
+		return nil
+	}
+}
+
+// indexBatchedCommands indexes the commands of all existing batches by their batch ID
+func indexBatchedCommands(ctx sdk.Context, ck chainKeeper) {
+	for _, batch := range ck.getCommandBatchesMetadata(ctx) {
+		ck.setBatchIDByCommands(ctx, batch)
+	}
+}
+
 // AlwaysMigrateBytecode migrates contracts bytecode for all evm chains (CRUCIAL, DO NOT DELETE AND ALWAYS REGISTER)
 func AlwaysMigrateBytecode(k *BaseKeeper, n types.Nexus, otherMigrations func(ctx sdk.Context) error) func(ctx sdk.Context) error {
 	return func(ctx sdk.Context) error {
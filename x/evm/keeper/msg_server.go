@@ -148,6 +148,49 @@ func (s msgServer) ConfirmGatewayTxs(c context.Context, req *types.ConfirmGatewa
 	return &types.ConfirmGatewayTxsResponse{}, nil
 }
 
+// ConfirmSVMGatewayTxs starts the polls to confirm the given transactions of a chain whose gateway is a Solana program
+func (s msgServer) ConfirmSVMGatewayTxs(c context.Context, req *types.ConfirmSVMGatewayTxsRequest) (*types.ConfirmSVMGatewayTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a registered chain", req.Chain)
+	}
+
+	if err := validateChainActivated(ctx, s.nexus, chain); err != nil {
+		return nil, err
+	}
+
+	keeper, err := s.ForChain(ctx, chain.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	programID, ok := keeper.GetSVMGatewayProgramID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%s has no axelar gateway program", chain.Name)
+	}
+
+	snapshot, err := s.CreateSnapshot(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	pollMappings, err := s.initializeSVMPolls(ctx, chain, snapshot, req.TxSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	events.Emit(ctx, &types.ConfirmSVMGatewayTxsStarted{
+		PollMappings:     pollMappings,
+		Chain:            chain.Name,
+		GatewayProgramID: programID,
+		Participants:     snapshot.GetParticipantAddresses(),
+	})
+
+	return &types.ConfirmSVMGatewayTxsResponse{}, nil
+}
+
 func (s msgServer) SetGateway(c context.Context, req *types.SetGatewayRequest) (*types.SetGatewayResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -172,14 +215,24 @@ func (s msgServer) SetGateway(c context.Context, req *types.SetGatewayRequest) (
 		return nil, fmt.Errorf("%s gateway already set", req.Chain)
 	}
 
-	keeper.SetGateway(ctx, req.Address)
+	if _, ok := keeper.GetSVMGatewayProgramID(ctx); ok {
+		return nil, fmt.Errorf("%s gateway already set", req.Chain)
+	}
+
+	address := req.Address.Hex()
+	if len(req.SVMProgramID) != 0 {
+		keeper.SetSVMGateway(ctx, req.SVMProgramID)
+		address = req.SVMProgramID
+	} else {
+		keeper.SetGateway(ctx, req.Address)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeGateway,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm),
 			sdk.NewAttribute(types.AttributeKeyChain, chain.Name.String()),
-			sdk.NewAttribute(types.AttributeKeyAddress, req.Address.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
 		),
 	)
 
@@ -430,6 +483,15 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 		return types.Command{}, errorsmod.Wrapf(types.ErrRotationInProgress, "finish rotating to next key for chain %s first", chain.Name)
 	}
 
+	nextKey, ok := s.multisigKeeper.GetKey(ctx, nextKeyID)
+	if !ok {
+		return types.Command{}, fmt.Errorf("could not find threshold key '%s'", nextKeyID)
+	}
+
+	if err := types.ValidateMultisigKeyType(nextKey); err != nil {
+		return types.Command{}, err
+	}
+
 	if err := s.multisigKeeper.AssignKey(ctx, chain.Name, nextKeyID); err != nil {
 		return types.Command{}, err
 	}
@@ -439,11 +501,6 @@ func (s msgServer) createTransferKeyCommand(ctx sdk.Context, keeper types.ChainK
 		return types.Command{}, fmt.Errorf("current key not set for chain %s", chain.Name)
 	}
 
-	nextKey, ok := s.multisigKeeper.GetKey(ctx, nextKeyID)
-	if !ok {
-		return types.Command{}, fmt.Errorf("could not find threshold key '%s'", nextKeyID)
-	}
-
 	return types.NewMultisigTransferCommand(chainID, keyID, nextKey), nil
 }
 
@@ -488,17 +545,18 @@ func (s msgServer) SignCommands(c context.Context, req *types.SignCommandsReques
 		return &types.SignCommandsResponse{CommandCount: 0, BatchedCommandsID: nil}, nil
 	}
 
-	if err := s.multisigKeeper.Sign(
+	sigID, err := s.multisigKeeper.Sign(
 		ctx,
 		commandBatch.GetKeyID(),
 		commandBatch.GetSigHash().Bytes(),
 		types.ModuleName,
 		types.NewSigMetadata(types.SigCommand, chain.Name, commandBatch.GetID()),
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	if !commandBatch.SetStatus(types.BatchSigning) {
+	if !commandBatch.SetSigning(sigID) {
 		return nil, fmt.Errorf("failed setting status of command batch %s to be signing", hex.EncodeToString(commandBatch.GetID()))
 	}
 
@@ -574,7 +632,8 @@ func (s msgServer) RetryFailedEvent(c context.Context, req *types.RetryFailedEve
 		case *types.Event_ContractCall,
 			*types.Event_ContractCallWithToken,
 			*types.Event_TokenDeployed,
-			*types.Event_MultisigOperatorshipTransferred:
+			*types.Event_MultisigOperatorshipTransferred,
+			*types.Event_CommandExecuted:
 		default:
 			return nil, fmt.Errorf("event %s has deprecated type %T and cannot be retried", req.EventID, event.GetEvent())
 		}
@@ -620,11 +679,10 @@ func (s msgServer) CreateSnapshot(ctx sdk.Context, chain nexus.Chain) (snapshot.
 	}
 	params := keeper.GetParams(ctx)
 
-	return s.snapshotter.CreateSnapshot(
+	return s.snapshotter.CreateWeightedSnapshot(
 		ctx,
 		s.nexus.GetChainMaintainers(ctx, chain),
 		excludeJailedOrTombstoned(ctx, s.slashing, s.snapshotter),
-		snapshot.QuadraticWeightFunc,
 		params.VotingThreshold,
 	)
 }
@@ -660,27 +718,12 @@ func (s msgServer) initializePoll(ctx sdk.Context, chain nexus.Chain, txID types
 }
 
 func (s msgServer) initializePolls(ctx sdk.Context, chain nexus.Chain, snapshot snapshot.Snapshot, txIDs []types.Hash) ([]types.PollMapping, error) {
-	keeper, err := s.ForChain(ctx, chain.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	params := keeper.GetParams(ctx)
-	expiresAt := ctx.BlockHeight() + params.RevoteLockingPeriod
-
 	pollMappings := make([]types.PollMapping, len(txIDs))
 	for i, txID := range txIDs {
-		pollID, err := s.voter.InitializePoll(
-			ctx,
-			vote.NewPollBuilder(types.ModuleName, params.VotingThreshold, snapshot, expiresAt).
-				MinVoterCount(params.MinVoterCount).
-				RewardPoolName(chain.Name.String()).
-				GracePeriod(keeper.GetParams(ctx).VotingGracePeriod).
-				ModuleMetadata(&types.PollMetadata{
-					Chain: chain.Name,
-					TxID:  txID,
-				}),
-		)
+		pollID, err := s.startPoll(ctx, chain, snapshot, &types.PollMetadata{
+			Chain: chain.Name,
+			TxID:  txID,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -693,3 +736,42 @@ func (s msgServer) initializePolls(ctx sdk.Context, chain nexus.Chain, snapshot
 
 	return pollMappings, nil
 }
+
+func (s msgServer) initializeSVMPolls(ctx sdk.Context, chain nexus.Chain, snapshot snapshot.Snapshot, signatures []string) ([]types.SVMPollMapping, error) {
+	pollMappings := make([]types.SVMPollMapping, len(signatures))
+	for i, signature := range signatures {
+		pollID, err := s.startPoll(ctx, chain, snapshot, &types.PollMetadata{
+			Chain:          chain.Name,
+			SVMTxSignature: signature,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pollMappings[i] = types.SVMPollMapping{
+			TxSignature: signature,
+			PollID:      pollID,
+		}
+	}
+
+	return pollMappings, nil
+}
+
+func (s msgServer) startPoll(ctx sdk.Context, chain nexus.Chain, snapshot snapshot.Snapshot, metadata *types.PollMetadata) (vote.PollID, error) {
+	keeper, err := s.ForChain(ctx, chain.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	params := keeper.GetParams(ctx)
+	expiresAt := ctx.BlockHeight() + params.RevoteLockingPeriod
+
+	return s.voter.InitializePoll(
+		ctx,
+		vote.NewPollBuilder(types.ModuleName, params.VotingThreshold, snapshot, expiresAt).
+			MinVoterCount(params.MinVoterCount).
+			RewardPoolName(chain.Name.String()).
+			GracePeriod(params.VotingGracePeriod).
+			ModuleMetadata(metadata),
+	)
+}
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	store "cosmossdk.io/store/types"
+	"github.com/btcsuite/btcutil/base58"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -146,6 +147,7 @@ func TestSetGateway(t *testing.T) {
 		chainKeeper.GetGatewayAddressFunc = func(ctx sdk.Context) (types.Address, bool) {
 			return types.Address{}, false
 		}
+		chainKeeper.GetSVMGatewayProgramIDFunc = func(ctx sdk.Context) (string, bool) { return "", false }
 		chainKeeper.SetGatewayFunc = func(ctx sdk.Context, address types.Address) {}
 
 		_, err := msgServer.SetGateway(sdk.WrapSDKContext(ctx), req)
@@ -153,6 +155,35 @@ func TestSetGateway(t *testing.T) {
 		assert.Len(t, chainKeeper.SetGatewayCalls(), 1)
 		assert.Equal(t, req.Address, chainKeeper.SetGatewayCalls()[0].Address)
 	})
+
+	t.Run("should set svm gateway program", func(t *testing.T) {
+		ctx, msgServer, baseKeeper, nexusKeeper, _, _, multisigKeeper, _ := setup(t)
+		chainKeeper := &mock.ChainKeeperMock{}
+		svmReq := types.NewSetSVMGatewayRequest(rand.AccAddr(), rand.Str(5), base58.Encode(rand.Bytes(32)))
+
+		nexusKeeper.GetChainFunc = func(ctx sdk.Context, chain nexus.ChainName) (nexus.Chain, bool) {
+			return nexus.Chain{Name: chain}, true
+		}
+		nexusKeeper.IsChainActivatedFunc = func(ctx sdk.Context, chain nexus.Chain) bool { return true }
+		multisigKeeper.GetCurrentKeyIDFunc = func(ctx sdk.Context, chain nexus.ChainName) (multisig.KeyID, bool) {
+			return multisigTestUtils.KeyID(), true
+		}
+		baseKeeper.ForChainFunc = func(_ sdk.Context, chain nexus.ChainName) (types.ChainKeeper, error) { return chainKeeper, nil }
+		chainKeeper.GetGatewayAddressFunc = func(ctx sdk.Context) (types.Address, bool) { return types.Address{}, false }
+		chainKeeper.GetSVMGatewayProgramIDFunc = func(ctx sdk.Context) (string, bool) { return "", false }
+		chainKeeper.SetSVMGatewayFunc = func(ctx sdk.Context, programID string) {}
+
+		assert.NoError(t, svmReq.ValidateBasic())
+		_, err := msgServer.SetGateway(sdk.WrapSDKContext(ctx), svmReq)
+		assert.NoError(t, err)
+		assert.Empty(t, chainKeeper.SetGatewayCalls())
+		assert.Len(t, chainKeeper.SetSVMGatewayCalls(), 1)
+		assert.Equal(t, svmReq.SVMProgramID, chainKeeper.SetSVMGatewayCalls()[0].ProgramID)
+
+		chainKeeper.GetSVMGatewayProgramIDFunc = func(ctx sdk.Context) (string, bool) { return svmReq.SVMProgramID, true }
+		_, err = msgServer.SetGateway(sdk.WrapSDKContext(ctx), svmReq)
+		assert.ErrorContains(t, err, "gateway already set")
+	})
 }
 
 func TestUpdateParams(t *testing.T) {
@@ -286,8 +317,8 @@ func TestSignCommands(t *testing.T) {
 		chainKeeper.CreateNewBatchToSignFunc = func(ctx sdk.Context) (types.CommandBatch, error) {
 			return types.NewCommandBatch(expected, func(batch types.CommandBatchMetadata) {}), nil
 		}
-		multisigKeeper.SignFunc = func(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error {
-			return nil
+		multisigKeeper.SignFunc = func(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error) {
+			return uint64(rand.PosI64()), nil
 		}
 
 		res, err := msgServer.SignCommands(sdk.WrapSDKContext(ctx), types.NewSignCommandsRequest(rand.AccAddr(), rand.Str(5)))
@@ -318,13 +349,15 @@ func TestSignCommands(t *testing.T) {
 		evmBaseKeeper.LoggerFunc = func(ctx sdk.Context) log.Logger { return ctx.Logger() }
 		evmBaseKeeper.ForChainFunc = func(_ sdk.Context, chain nexus.ChainName) (types.ChainKeeper, error) { return chainKeeper, nil }
 		chainKeeper.GetChainIDFunc = func(ctx sdk.Context) (math.Int, bool) { return math.NewInt(0), true }
+		sigID := uint64(rand.PosI64())
 		chainKeeper.GetLatestCommandBatchFunc = func(ctx sdk.Context) types.CommandBatch {
 			return types.NewCommandBatch(commandBatch, func(batch types.CommandBatchMetadata) {
 				assert.Equal(t, types.BatchSigning, batch.Status)
+				assert.Equal(t, sigID, batch.SigningSessionID)
 			})
 		}
-		signerKeeper.SignFunc = func(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error {
-			return nil
+		signerKeeper.SignFunc = func(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error) {
+			return sigID, nil
 		}
 
 		res, err := msgServer.SignCommands(sdk.WrapSDKContext(ctx), types.NewSignCommandsRequest(rand.AccAddr(), rand.Str(5)))
@@ -502,7 +535,7 @@ func TestHandleMsgConfirmTokenDeploy(t *testing.T) {
 			Asset:  types.NewAsset(axelarnet.Axelarnet.Name.String(), axelarnet.NativeAsset),
 		}
 		snapshotKeeper := &mock.SnapshotterMock{
-			CreateSnapshotFunc: func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, func(consensusPower math.Uint) math.Uint, utils.Threshold) (snapshot.Snapshot, error) {
+			CreateWeightedSnapshotFunc: func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, utils.Threshold) (snapshot.Snapshot, error) {
 				return snapshot.Snapshot{}, nil
 			},
 		}
@@ -956,7 +989,7 @@ func TestHandleMsgConfirmGatewayTxs(t *testing.T) {
 			ForChainFunc: func(sdk.Context, nexus.ChainName) (types.ChainKeeper, error) { return nil, fmt.Errorf("unknown chain") },
 		}
 		snapshotter = &mock.SnapshotterMock{
-			CreateSnapshotFunc: func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, func(consensusPower math.Uint) math.Uint, utils.Threshold) (snapshot.Snapshot, error) {
+			CreateWeightedSnapshotFunc: func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, utils.Threshold) (snapshot.Snapshot, error) {
 				return snapshot.NewSnapshot(ctx.BlockTime(), ctx.BlockHeight(), validators, math.NewUint(10)), nil
 			},
 		}
@@ -1001,7 +1034,7 @@ func TestHandleMsgConfirmGatewayTxs(t *testing.T) {
 		givenMsgServer.Branch(
 			whenChainIsValid.
 				When("failed to create snapshot", func() {
-					snapshotter.CreateSnapshotFunc = func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, func(consensusPower math.Uint) math.Uint, utils.Threshold) (snapshot.Snapshot, error) {
+					snapshotter.CreateWeightedSnapshotFunc = func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, utils.Threshold) (snapshot.Snapshot, error) {
 						return snapshot.Snapshot{}, fmt.Errorf("failed to create snapshot")
 					}
 				}).
@@ -1032,6 +1065,84 @@ func TestHandleMsgConfirmGatewayTxs(t *testing.T) {
 	})
 }
 
+func TestHandleMsgConfirmSVMGatewayTxs(t *testing.T) {
+	validators := slices.Expand(func(int) snapshot.Participant { return snapshot.NewParticipant(rand2.ValAddr(), math.OneUint()) }, 10)
+	signatures := slices.Expand(func(int) string { return base58.Encode(rand.Bytes(64)) }, int(rand.I64Between(1, types.TxLimit+1)))
+	programID := base58.Encode(rand.Bytes(32))
+
+	var (
+		ctx       sdk.Context
+		req       *types.ConfirmSVMGatewayTxsRequest
+		bk        *mock.BaseKeeperMock
+		ck        *mock.ChainKeeperMock
+		n         *mock.NexusMock
+		v         *mock.VoterMock
+		msgServer types.MsgServiceServer
+		pollID    vote.PollID
+	)
+
+	Given("an EVM msg server", func() {
+		ctx = rand2.Context(fake.NewMultiStore(), t)
+		req = types.NewConfirmSVMGatewayTxsRequest(rand.AccAddr(), nexus.ChainName(rand.Str(5)), signatures)
+
+		ck = &mock.ChainKeeperMock{
+			GetParamsFunc: func(sdk.Context) types.Params { return types.DefaultParams()[0] },
+		}
+		bk = &mock.BaseKeeperMock{
+			LoggerFunc:   func(ctx sdk.Context) log.Logger { return ctx.Logger() },
+			ForChainFunc: func(sdk.Context, nexus.ChainName) (types.ChainKeeper, error) { return ck, nil },
+		}
+		snapshotter := &mock.SnapshotterMock{
+			CreateWeightedSnapshotFunc: func(sdk.Context, []sdk.ValAddress, func(snapshot.ValidatorI) bool, utils.Threshold) (snapshot.Snapshot, error) {
+				return snapshot.NewSnapshot(ctx.BlockTime(), ctx.BlockHeight(), validators, math.NewUint(10)), nil
+			},
+		}
+		n = &mock.NexusMock{
+			GetChainFunc:         func(_ sdk.Context, chain nexus.ChainName) (nexus.Chain, bool) { return nexus.Chain{Name: chain}, true },
+			IsChainActivatedFunc: func(sdk.Context, nexus.Chain) bool { return true },
+			GetChainMaintainersFunc: func(sdk.Context, nexus.Chain) []sdk.ValAddress {
+				return slices.Expand2(rand2.ValAddr, 10)
+			},
+		}
+		v = &mock.VoterMock{
+			InitializePollFunc: func(sdk.Context, vote.PollBuilder) (vote.PollID, error) {
+				pollID += 1
+				return pollID, nil
+			},
+		}
+
+		msgServer = keeper.NewMsgServerImpl(bk, n, v, snapshotter, &mock.StakingKeeperMock{}, &mock.SlashingKeeperMock{}, &mock.MultisigKeeperMock{}, &mock.PermissionMock{})
+	}).
+		Branch(
+			When("the chain has no gateway program", func() {
+				ck.GetSVMGatewayProgramIDFunc = func(sdk.Context) (string, bool) { return "", false }
+			}).
+				Then("should return error", func(t *testing.T) {
+					_, err := msgServer.ConfirmSVMGatewayTxs(sdk.WrapSDKContext(ctx), req)
+					assert.ErrorContains(t, err, "no axelar gateway program")
+				}),
+
+			When("the chain has a gateway program", func() {
+				ck.GetSVMGatewayProgramIDFunc = func(sdk.Context) (string, bool) { return programID, true }
+			}).
+				Then("should start a poll per transaction and emit ConfirmSVMGatewayTxsStarted", func(t *testing.T) {
+					_, err := msgServer.ConfirmSVMGatewayTxs(sdk.WrapSDKContext(ctx), req)
+					assert.NoError(t, err)
+					assert.Len(t, v.InitializePollCalls(), len(signatures))
+
+					for i, call := range v.InitializePollCalls() {
+						metadata := funcs.Must(call.PollBuilder.Build(ctx.BlockHeight())).ModuleMetadata
+						assert.Equal(t, signatures[i], metadata.GetCachedValue().(*types.PollMetadata).SVMTxSignature)
+					}
+
+					events := ctx.EventManager().Events()
+					assert.Len(t, events, 1)
+					assert.Equal(t, "axelar.evm.v1beta1.ConfirmSVMGatewayTxsStarted", events[0].Type)
+				}),
+		).
+		Run(t)
+}
+
 func createSignedDeployTx() *evmTypes.Transaction {
 	generator := rand.PInt64Gen()
 
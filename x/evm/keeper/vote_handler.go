@@ -9,6 +9,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/utils/events"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	vote "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	"github.com/axelarnetwork/utils/funcs"
 )
@@ -34,6 +35,8 @@ func NewVoteHandler(cdc codec.Codec, keeper types.BaseKeeper, nexus types.Nexus,
 
 func (v voteHandler) HandleFailedPoll(ctx sdk.Context, poll vote.Poll) error {
 	md := mustGetMetadata(poll)
+	v.nexus.RecordChainPoll(ctx, md.Chain, true)
+
 	events.Emit(ctx, &types.PollFailed{
 		TxID:   md.TxID,
 		Chain:  md.Chain,
@@ -59,6 +62,8 @@ func (v voteHandler) HandleExpiredPoll(ctx sdk.Context, poll vote.Poll) error {
 	if !ok {
 		return fmt.Errorf("%s is not a registered chain", md.Chain)
 	}
+	v.nexus.RecordChainPoll(ctx, chain.Name, true)
+
 	// Penalize voters who failed to vote
 	for _, voter := range poll.GetVoters() {
 		hasVoted := poll.HasVoted(voter)
@@ -74,7 +79,7 @@ func (v voteHandler) HandleExpiredPoll(ctx sdk.Context, poll vote.Poll) error {
 		}
 
 		if !hasVoted {
-			rewardPool.ClearRewards(voter)
+			rewardPool.ClearRewards(voter, reward.MissedVote)
 			v.keeper.Logger(ctx).Debug(fmt.Sprintf("penalized voter %s due to timeout", voter.String()),
 				"voter", voter.String(),
 				"poll", poll.GetID().String())
@@ -104,6 +109,7 @@ func (v voteHandler) HandleCompletedPoll(ctx sdk.Context, poll vote.Poll) error
 	}
 
 	rewardPool := v.rewarder.GetPool(ctx, rewardPoolName)
+	v.nexus.RecordChainPoll(ctx, chain.Name, false)
 
 	for _, voter := range poll.GetVoters() {
 		maintainerState, ok := v.nexus.GetChainMaintainerState(ctx, chain, voter)
@@ -126,9 +132,14 @@ func (v voteHandler) HandleCompletedPoll(ctx sdk.Context, poll vote.Poll) error
 		)
 
 		switch {
-		case hasVotedIncorrectly, !hasVoted:
-			rewardPool.ClearRewards(voter)
-			v.keeper.Logger(ctx).Debug(fmt.Sprintf("penalized voter %s due to incorrect vote or missing vote", voter.String()),
+		case hasVotedIncorrectly:
+			rewardPool.ClearRewards(voter, reward.IncorrectVote)
+			v.keeper.Logger(ctx).Debug(fmt.Sprintf("penalized voter %s due to incorrect vote", voter.String()),
+				"voter", voter.String(),
+				"poll", poll.GetID().String())
+		case !hasVoted:
+			rewardPool.ClearRewards(voter, reward.MissedVote)
+			v.keeper.Logger(ctx).Debug(fmt.Sprintf("penalized voter %s due to missing vote", voter.String()),
 				"voter", voter.String(),
 				"poll", poll.GetID().String())
 		default:
@@ -192,14 +203,14 @@ func (v voteHandler) handleEvent(ctx sdk.Context, ck types.ChainKeeper, event ty
 
 	funcs.MustNoErr(ck.EnqueueConfirmedEvent(ctx, event.GetID()))
 
-	ck.Logger(ctx).Info(fmt.Sprintf("confirmed %s event %s in transaction %s", chain.Name, event.GetID(), event.TxID.Hex()))
+	ck.Logger(ctx).Info(fmt.Sprintf("confirmed %s event %s in transaction %s", chain.Name, event.GetID(), event.GetSourceTxIDString()))
 
 	// Deprecated
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeEventConfirmation,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(types.AttributeKeyChain, event.Chain.String()),
-			sdk.NewAttribute(types.AttributeKeyTxID, event.TxID.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTxID, event.GetSourceTxIDString()),
 			sdk.NewAttribute(types.AttributeKeyEventID, string(event.GetID())),
 			sdk.NewAttribute(types.AttributeKeyEventType, event.GetEventType()),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueConfirm)),
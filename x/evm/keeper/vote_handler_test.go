@@ -56,6 +56,7 @@ func TestHandleExpiredPoll(t *testing.T) {
 					Module:                types.ModuleName,
 				}, true
 			},
+			RecordChainPollFunc: func(sdk.Context, nexus.ChainName, bool) {},
 		}
 		rewardPool = &rewardmock.RewardPoolMock{}
 		r := &mock.RewarderMock{
@@ -85,7 +86,7 @@ func TestHandleExpiredPoll(t *testing.T) {
 		Then("should clear rewards and mark voter missing vote", func(t *testing.T) {
 			maintainerState.MarkMissingVoteFunc = func(bool) {}
 			n.SetChainMaintainerStateFunc = func(ctx sdk.Context, maintainerState nexus.MaintainerState) error { return nil }
-			rewardPool.ClearRewardsFunc = func(sdk.ValAddress) {}
+			rewardPool.ClearRewardsFunc = func(sdk.ValAddress, reward.ClearReason) {}
 
 			err := handler.HandleExpiredPoll(ctx, poll)
 
@@ -97,6 +98,9 @@ func TestHandleExpiredPoll(t *testing.T) {
 			assert.Len(t, n.SetChainMaintainerStateCalls(), 11)
 			assert.Len(t, rewardPool.ClearRewardsCalls(), 1)
 			assert.Equal(t, missingVoter, rewardPool.ClearRewardsCalls()[0].ValAddress)
+			assert.Equal(t, reward.MissedVote, rewardPool.ClearRewardsCalls()[0].ClearReason)
+			assert.Len(t, n.RecordChainPollCalls(), 1)
+			assert.True(t, n.RecordChainPollCalls()[0].Failed)
 		}).
 		Run(t)
 
@@ -119,13 +123,14 @@ func TestHandleExpiredPoll(t *testing.T) {
 			}
 		}).
 		Then("should clear rewards and not mark voter missing vote", func(t *testing.T) {
-			rewardPool.ClearRewardsFunc = func(sdk.ValAddress) {}
+			rewardPool.ClearRewardsFunc = func(sdk.ValAddress, reward.ClearReason) {}
 
 			err := handler.HandleExpiredPoll(ctx, poll)
 
 			assert.NoError(t, err)
 			assert.Len(t, rewardPool.ClearRewardsCalls(), 1)
 			assert.Equal(t, missingVoter, rewardPool.ClearRewardsCalls()[0].ValAddress)
+			assert.Equal(t, reward.MissedVote, rewardPool.ClearRewardsCalls()[0].ClearReason)
 		}).
 		Run(t)
 
@@ -150,7 +155,7 @@ func TestHandleExpiredPoll(t *testing.T) {
 		Then("should clear rewards and mark voters missing vote", func(t *testing.T) {
 			maintainerState.MarkMissingVoteFunc = func(bool) {}
 			n.SetChainMaintainerStateFunc = func(ctx sdk.Context, maintainerState nexus.MaintainerState) error { return nil }
-			rewardPool.ClearRewardsFunc = func(sdk.ValAddress) {}
+			rewardPool.ClearRewardsFunc = func(sdk.ValAddress, reward.ClearReason) {}
 
 			err := handler.HandleExpiredPoll(ctx, poll)
 
@@ -183,7 +188,7 @@ func TestHandleExpiredPoll(t *testing.T) {
 			}
 		}).
 		Then("should clear rewards and not mark voters missing vote", func(t *testing.T) {
-			rewardPool.ClearRewardsFunc = func(sdk.ValAddress) {}
+			rewardPool.ClearRewardsFunc = func(sdk.ValAddress, reward.ClearReason) {}
 
 			err := handler.HandleExpiredPoll(ctx, poll)
 
@@ -429,10 +434,11 @@ func TestHandleCompletedPoll(t *testing.T) {
 				}, true
 			},
 			SetChainMaintainerStateFunc: func(sdk.Context, nexus.MaintainerState) error { return nil },
+			RecordChainPollFunc:         func(sdk.Context, nexus.ChainName, bool) {},
 		}
 		rewardPool = &rewardmock.RewardPoolMock{
 			ReleaseRewardsFunc: func(sdk.ValAddress) error { return nil },
-			ClearRewardsFunc:   func(sdk.ValAddress) {},
+			ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 		}
 		r := &mock.RewarderMock{
 			GetPoolFunc: func(sdk.Context, string) reward.RewardPool { return rewardPool },
@@ -467,6 +473,8 @@ func TestHandleCompletedPoll(t *testing.T) {
 			assert.Len(t, rewardPool.ReleaseRewardsCalls(), 1)
 			assert.Equal(t, voter, rewardPool.ReleaseRewardsCalls()[0].ValAddress)
 			assert.Empty(t, rewardPool.ClearRewardsCalls())
+			assert.Len(t, n.RecordChainPollCalls(), 1)
+			assert.False(t, n.RecordChainPollCalls()[0].Failed)
 		}).
 		Run(t)
 }
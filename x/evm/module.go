@@ -139,8 +139,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServiceServer(grpc.ServerWithSDKErrors{Server: cfg.MsgServer(), Err: types.ErrEVM, Logger: am.keeper.Logger}, msgServer)
 	types.RegisterQueryServiceServer(cfg.QueryServer(), keeper.NewGRPCQuerier(am.keeper, am.nexus, am.multisig))
 
-	// The wrapped migration is currently a no-op; add future store migrations there and bump ConsensusVersion
-	err := cfg.RegisterMigration(types.ModuleName, 10, keeper.AlwaysMigrateBytecode(am.keeper, am.nexus, keeper.NoOpMigration))
+	err := cfg.RegisterMigration(types.ModuleName, 11, keeper.AlwaysMigrateBytecode(am.keeper, am.nexus, keeper.Migrate11To12(am.keeper, am.nexus)))
 	if err != nil {
 		panic(err)
 	}
@@ -154,7 +153,7 @@ func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 11 }
+func (AppModule) ConsensusVersion() uint64 { return 12 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() { // marker
@@ -23,6 +23,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&SetGatewayRequest{}, "evm/SetGatewayRequest", nil)
 	cdc.RegisterConcrete(&ConfirmGatewayTxRequest{}, "evm/ConfirmGatewayTxRequest", nil)
 	cdc.RegisterConcrete(&ConfirmGatewayTxsRequest{}, "evm/ConfirmGatewayTxsRequest", nil)
+	cdc.RegisterConcrete(&ConfirmSVMGatewayTxsRequest{}, "evm/ConfirmSVMGatewayTxsRequest", nil)
 	cdc.RegisterConcrete(&RetryFailedEventRequest{}, "evm/RetryFailedEvent", nil)
 	cdc.RegisterConcrete(&UpdateParamsRequest{}, "evm/UpdateParams", nil)
 }
@@ -43,6 +44,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&SetGatewayRequest{},
 		&ConfirmGatewayTxRequest{},
 		&ConfirmGatewayTxsRequest{},
+		&ConfirmSVMGatewayTxsRequest{},
 		&RetryFailedEventRequest{},
 		&UpdateParamsRequest{},
 	)
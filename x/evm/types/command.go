@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/binary"
 	"fmt"
+	stdmath "math"
 	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
 
@@ -149,6 +151,7 @@ func NewApproveContractCallCommandGeneric(
 	sender string,
 	sourceEventIndex uint64,
 	ID string,
+	gasLimit uint64,
 ) Command {
 	// The bytes passed as data (the message ID) are part of the commandID
 	// protocol invariant that off-chain relayers re-derive. See NewCommandID.
@@ -159,6 +162,7 @@ func NewApproveContractCallCommandGeneric(
 		Params:     createApproveContractCallParamsGeneric(contractAddress, payloadHash, sourceTxID, string(sourceChain), sender, sourceEventIndex),
 		KeyID:      keyID,
 		MaxGasCost: approveContractCallMaxGasCost,
+		GasLimit:   gasLimit,
 	}
 }
 
@@ -206,6 +210,7 @@ func NewApproveContractCallWithMintGeneric(
 		Params:     createApproveContractCallWithMintParamsGeneric(contractAddress, payloadHash, sourceTxID, message.Sender, sourceEventIndex, message.Asset.Amount.BigInt(), symbol),
 		KeyID:      keyID,
 		MaxGasCost: approveContractCallWithMintMaxGasCost,
+		GasLimit:   message.GasLimit,
 	}
 }
 
@@ -267,6 +272,17 @@ func (m Command) DecodeParams() (map[string]string, error) {
 	return params, nil
 }
 
+// EstimatedGas returns the gas needed to execute the command, including the gas limit of the contract call it approves.
+// The result saturates at math.MaxUint64 instead of overflowing
+func (m Command) EstimatedGas() uint64 {
+	gas, carry := bits.Add64(uint64(m.MaxGasCost), m.GasLimit, 0)
+	if carry != 0 {
+		return stdmath.MaxUint64
+	}
+
+	return gas
+}
+
 // Clone returns an exacy copy of Command
 func (m Command) Clone() Command {
 	clone := Command{
@@ -274,6 +290,7 @@ func (m Command) Clone() Command {
 		Type:       m.Type,
 		KeyID:      m.KeyID,
 		MaxGasCost: m.MaxGasCost,
+		GasLimit:   m.GasLimit,
 		Params:     make([]byte, len(m.Params)),
 	}
 	copy(clone.Params, m.Params)
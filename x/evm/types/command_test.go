@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	fmt "fmt"
+	stdmath "math"
 	"math/big"
 	"strings"
 	"testing"
@@ -39,9 +40,10 @@ func TestNewApproveContractCallCommandFromGeneralMessage(t *testing.T) {
 	sender := nexus.CrossChainAddress{Chain: srcChain, Address: rand.AccAddr().String()}
 	receiver := nexus.CrossChainAddress{Chain: destChain, Address: testutils.RandomAddress().Hex()}
 	msg := nexus.NewGeneralMessage(txID.Hex(), sender, receiver, payloadHash, txID[:], eventIndex, nil)
+	msg.GasLimit = uint64(rand.I64Between(1, 1000000))
 
 	actual := types.NewApproveContractCallCommandGeneric(chainID, keyID,
-		common.HexToAddress(msg.GetDestinationAddress()), common.BytesToHash(msg.PayloadHash), common.BytesToHash(msg.SourceTxID), msg.GetSourceChain(), msg.GetSourceAddress(), msg.SourceTxIndex, msg.ID)
+		common.HexToAddress(msg.GetDestinationAddress()), common.BytesToHash(msg.PayloadHash), common.BytesToHash(msg.SourceTxID), msg.GetSourceChain(), msg.GetSourceAddress(), msg.SourceTxIndex, msg.ID, msg.GasLimit)
 	// abi encoding pads strings to lengths divisible by 32
 	sourceChainPadded := []byte(msg.GetSourceChain().String())
 	for len(sourceChainPadded)%32 != 0 {
@@ -61,6 +63,11 @@ func TestNewApproveContractCallCommandFromGeneralMessage(t *testing.T) {
 	assert.Equal(t, common.BytesToHash(msg.SourceTxID), actualSourceTxID)
 	assert.Equal(t, uint64(eventIndex), actualSourceEventIndex.Uint64())
 
+	assert.Equal(t, msg.GasLimit, actual.GasLimit)
+	assert.Equal(t, uint64(actual.MaxGasCost)+msg.GasLimit, actual.EstimatedGas())
+
+	actual.GasLimit = stdmath.MaxUint64
+	assert.Equal(t, uint64(stdmath.MaxUint64), actual.EstimatedGas())
 }
 
 func TestNewApproveContractCallWithMintCommand(t *testing.T) {
@@ -4,11 +4,25 @@ import "github.com/axelarnetwork/axelar-core/vald/evm/rpc"
 
 // EVMConfig contains all EVM module configuration values
 type EVMConfig struct {
-	Name             string               `mapstructure:"name"`
-	RPCAddr          string               `mapstructure:"rpc_addr"`
+	Name    string `mapstructure:"name"`
+	RPCAddr string `mapstructure:"rpc_addr"`
+	// RPCAddrs are additional endpoints of the same chain that vald fails over to
+	RPCAddrs []string `mapstructure:"rpc_addrs"`
+	// RPCQuorum is the number of endpoints that must agree on receipts, headers and the latest
+	// finalized block before vald uses them. Values below 2 disable quorum reads.
+	RPCQuorum        int                  `mapstructure:"rpc_quorum"`
 	WithBridge       bool                 `mapstructure:"start-with-bridge"`
 	L1ChainName      *string              `mapstructure:"l1_chain_name"` // Deprecated: Do not use.
 	FinalityOverride rpc.FinalityOverride `mapstructure:"finality_override"`
+	// Finality selects the strategy that determines which blocks are final. It takes precedence over FinalityOverride.
+	Finality rpc.Finality `mapstructure:"finality"`
+	// RollupRPCAddr is the endpoint of the rollup node, required by the l1_batch finality strategy
+	RollupRPCAddr *string `mapstructure:"rollup_rpc_addr"`
+}
+
+// RPCEndpoints returns all configured JSON-RPC endpoints, starting with RPCAddr
+func (c EVMConfig) RPCEndpoints() []string {
+	return append([]string{c.RPCAddr}, c.RPCAddrs...)
 }
 
 // DefaultConfig returns a configuration populated with default values
@@ -19,3 +33,10 @@ func DefaultConfig() []EVMConfig {
 		WithBridge: true,
 	}}
 }
+
+// SVMConfig contains the configuration of a chain whose gateway is a Solana program
+type SVMConfig struct {
+	Name       string `mapstructure:"name"`
+	RPCAddr    string `mapstructure:"rpc_addr"`
+	WithBridge bool   `mapstructure:"start-with-bridge"`
+}
@@ -464,6 +464,132 @@ func (*ConfirmGatewayTxsStarted) XXX_MessageName() string {
 	return "axelar.evm.v1beta1.ConfirmGatewayTxsStarted"
 }
 
+// SVMPollMapping maps a poll to the Solana transaction it verifies
+type SVMPollMapping struct {
+	// tx_signature is the base58 encoded signature identifying the transaction
+	TxSignature string                                                      `protobuf:"bytes,1,opt,name=tx_signature,json=txSignature,proto3" json:"tx_signature,omitempty"`
+	PollID      github_com_axelarnetwork_axelar_core_x_vote_exported.PollID `protobuf:"varint,2,opt,name=poll_id,json=pollId,proto3,customtype=github.com/axelarnetwork/axelar-core/x/vote/exported.PollID" json:"poll_id"`
+}
+
+func (m *SVMPollMapping) Reset()         { *m = SVMPollMapping{} }
+func (m *SVMPollMapping) String() string { return proto.CompactTextString(m) }
+func (*SVMPollMapping) ProtoMessage()    {}
+func (*SVMPollMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_091c22a44ca6f0af, []int{8}
+}
+func (m *SVMPollMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SVMPollMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SVMPollMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SVMPollMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SVMPollMapping.Merge(m, src)
+}
+func (m *SVMPollMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *SVMPollMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_SVMPollMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SVMPollMapping proto.InternalMessageInfo
+
+func (m *SVMPollMapping) GetTxSignature() string {
+	if m != nil {
+		return m.TxSignature
+	}
+	return ""
+}
+
+func (*SVMPollMapping) XXX_MessageName() string {
+	return "axelar.evm.v1beta1.SVMPollMapping"
+}
+
+// ConfirmSVMGatewayTxsStarted is the counterpart of ConfirmGatewayTxsStarted
+// for chains whose gateway is a Solana program
+type ConfirmSVMGatewayTxsStarted struct {
+	PollMappings []SVMPollMapping                                                `protobuf:"bytes,1,rep,name=poll_mappings,json=pollMappings,proto3" json:"poll_mappings,omitempty"`
+	Chain        github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	// gateway_program_id is the base58 encoded address of the gateway program
+	GatewayProgramID string                                          `protobuf:"bytes,3,opt,name=gateway_program_id,json=gatewayProgramId,proto3" json:"gateway_program_id,omitempty"`
+	Participants     []github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,4,rep,name=participants,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"participants,omitempty"`
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) Reset()         { *m = ConfirmSVMGatewayTxsStarted{} }
+func (m *ConfirmSVMGatewayTxsStarted) String() string { return proto.CompactTextString(m) }
+func (*ConfirmSVMGatewayTxsStarted) ProtoMessage()    {}
+func (*ConfirmSVMGatewayTxsStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_091c22a44ca6f0af, []int{9}
+}
+func (m *ConfirmSVMGatewayTxsStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmSVMGatewayTxsStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmSVMGatewayTxsStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmSVMGatewayTxsStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmSVMGatewayTxsStarted.Merge(m, src)
+}
+func (m *ConfirmSVMGatewayTxsStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmSVMGatewayTxsStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmSVMGatewayTxsStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmSVMGatewayTxsStarted proto.InternalMessageInfo
+
+func (m *ConfirmSVMGatewayTxsStarted) GetPollMappings() []SVMPollMapping {
+	if m != nil {
+		return m.PollMappings
+	}
+	return nil
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) GetGatewayProgramID() string {
+	if m != nil {
+		return m.GatewayProgramID
+	}
+	return ""
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) GetParticipants() []github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (*ConfirmSVMGatewayTxsStarted) XXX_MessageName() string {
+	return "axelar.evm.v1beta1.ConfirmSVMGatewayTxsStarted"
+}
+
 type ConfirmDepositStarted struct {
 	TxID                      Hash                                                            `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
 	Chain                     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
@@ -478,7 +604,7 @@ func (m *ConfirmDepositStarted) Reset()         { *m = ConfirmDepositStarted{} }
 func (m *ConfirmDepositStarted) String() string { return proto.CompactTextString(m) }
 func (*ConfirmDepositStarted) ProtoMessage()    {}
 func (*ConfirmDepositStarted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{8}
+	return fileDescriptor_091c22a44ca6f0af, []int{10}
 }
 func (m *ConfirmDepositStarted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +672,7 @@ func (m *ConfirmTokenStarted) Reset()         { *m = ConfirmTokenStarted{} }
 func (m *ConfirmTokenStarted) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTokenStarted) ProtoMessage()    {}
 func (*ConfirmTokenStarted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{9}
+	return fileDescriptor_091c22a44ca6f0af, []int{11}
 }
 func (m *ConfirmTokenStarted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +734,7 @@ func (m *ChainAdded) Reset()         { *m = ChainAdded{} }
 func (m *ChainAdded) String() string { return proto.CompactTextString(m) }
 func (*ChainAdded) ProtoMessage()    {}
 func (*ChainAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{10}
+	return fileDescriptor_091c22a44ca6f0af, []int{12}
 }
 func (m *ChainAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +783,7 @@ func (m *CommandBatchSigned) Reset()         { *m = CommandBatchSigned{} }
 func (m *CommandBatchSigned) String() string { return proto.CompactTextString(m) }
 func (*CommandBatchSigned) ProtoMessage()    {}
 func (*CommandBatchSigned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{11}
+	return fileDescriptor_091c22a44ca6f0af, []int{13}
 }
 func (m *CommandBatchSigned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +839,7 @@ func (m *CommandBatchAborted) Reset()         { *m = CommandBatchAborted{} }
 func (m *CommandBatchAborted) String() string { return proto.CompactTextString(m) }
 func (*CommandBatchAborted) ProtoMessage()    {}
 func (*CommandBatchAborted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{12}
+	return fileDescriptor_091c22a44ca6f0af, []int{14}
 }
 func (m *CommandBatchAborted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,6 +886,64 @@ func (*CommandBatchAborted) XXX_MessageName() string {
 	return "axelar.evm.v1beta1.CommandBatchAborted"
 }
 
+type CommandExecuted struct {
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	CommandID CommandID                                                       `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3,customtype=CommandID" json:"command_id"`
+	BatchID   []byte                                                          `protobuf:"bytes,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	TxID      Hash                                                            `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+}
+
+func (m *CommandExecuted) Reset()         { *m = CommandExecuted{} }
+func (m *CommandExecuted) String() string { return proto.CompactTextString(m) }
+func (*CommandExecuted) ProtoMessage()    {}
+func (*CommandExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_091c22a44ca6f0af, []int{15}
+}
+func (m *CommandExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandExecuted.Merge(m, src)
+}
+func (m *CommandExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandExecuted proto.InternalMessageInfo
+
+func (m *CommandExecuted) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *CommandExecuted) GetBatchID() []byte {
+	if m != nil {
+		return m.BatchID
+	}
+	return nil
+}
+
+func (*CommandExecuted) XXX_MessageName() string {
+	return "axelar.evm.v1beta1.CommandExecuted"
+}
+
 type EVMEventConfirmed struct {
 	Chain   github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	EventID EventID                                                         `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,casttype=EventID" json:"event_id,omitempty"`
@@ -770,7 +954,7 @@ func (m *EVMEventConfirmed) Reset()         { *m = EVMEventConfirmed{} }
 func (m *EVMEventConfirmed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventConfirmed) ProtoMessage()    {}
 func (*EVMEventConfirmed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{13}
+	return fileDescriptor_091c22a44ca6f0af, []int{16}
 }
 func (m *EVMEventConfirmed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1018,7 @@ func (m *EVMEventCompleted) Reset()         { *m = EVMEventCompleted{} }
 func (m *EVMEventCompleted) String() string { return proto.CompactTextString(m) }
 func (*EVMEventCompleted) ProtoMessage()    {}
 func (*EVMEventCompleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{14}
+	return fileDescriptor_091c22a44ca6f0af, []int{17}
 }
 func (m *EVMEventCompleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1082,7 @@ func (m *EVMEventFailed) Reset()         { *m = EVMEventFailed{} }
 func (m *EVMEventFailed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventFailed) ProtoMessage()    {}
 func (*EVMEventFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{15}
+	return fileDescriptor_091c22a44ca6f0af, []int{18}
 }
 func (m *EVMEventFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,7 +1146,7 @@ func (m *EVMEventRetryFailed) Reset()         { *m = EVMEventRetryFailed{} }
 func (m *EVMEventRetryFailed) String() string { return proto.CompactTextString(m) }
 func (*EVMEventRetryFailed) ProtoMessage()    {}
 func (*EVMEventRetryFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{16}
+	return fileDescriptor_091c22a44ca6f0af, []int{19}
 }
 func (m *EVMEventRetryFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1214,7 @@ func (m *ContractCallApproved) Reset()         { *m = ContractCallApproved{} }
 func (m *ContractCallApproved) String() string { return proto.CompactTextString(m) }
 func (*ContractCallApproved) ProtoMessage()    {}
 func (*ContractCallApproved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{17}
+	return fileDescriptor_091c22a44ca6f0af, []int{20}
 }
 func (m *ContractCallApproved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1291,7 @@ func (m *ContractCallFailed) Reset()         { *m = ContractCallFailed{} }
 func (m *ContractCallFailed) String() string { return proto.CompactTextString(m) }
 func (*ContractCallFailed) ProtoMessage()    {}
 func (*ContractCallFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{18}
+	return fileDescriptor_091c22a44ca6f0af, []int{21}
 }
 func (m *ContractCallFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1353,7 @@ func (m *ContractCallWithMintApproved) Reset()         { *m = ContractCallWithMi
 func (m *ContractCallWithMintApproved) String() string { return proto.CompactTextString(m) }
 func (*ContractCallWithMintApproved) ProtoMessage()    {}
 func (*ContractCallWithMintApproved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{19}
+	return fileDescriptor_091c22a44ca6f0af, []int{22}
 }
 func (m *ContractCallWithMintApproved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,7 +1445,7 @@ func (m *TokenSent) Reset()         { *m = TokenSent{} }
 func (m *TokenSent) String() string { return proto.CompactTextString(m) }
 func (*TokenSent) ProtoMessage()    {}
 func (*TokenSent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{20}
+	return fileDescriptor_091c22a44ca6f0af, []int{23}
 }
 func (m *TokenSent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1540,7 @@ func (m *MintCommand) Reset()         { *m = MintCommand{} }
 func (m *MintCommand) String() string { return proto.CompactTextString(m) }
 func (*MintCommand) ProtoMessage()    {}
 func (*MintCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{21}
+	return fileDescriptor_091c22a44ca6f0af, []int{24}
 }
 func (m *MintCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1620,7 @@ func (m *BurnCommand) Reset()         { *m = BurnCommand{} }
 func (m *BurnCommand) String() string { return proto.CompactTextString(m) }
 func (*BurnCommand) ProtoMessage()    {}
 func (*BurnCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_091c22a44ca6f0af, []int{22}
+	return fileDescriptor_091c22a44ca6f0af, []int{25}
 }
 func (m *BurnCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,11 +1689,14 @@ func init() {
 	proto.RegisterType((*ConfirmGatewayTxStarted)(nil), "axelar.evm.v1beta1.ConfirmGatewayTxStarted")
 	proto.RegisterType((*PollMapping)(nil), "axelar.evm.v1beta1.PollMapping")
 	proto.RegisterType((*ConfirmGatewayTxsStarted)(nil), "axelar.evm.v1beta1.ConfirmGatewayTxsStarted")
+	proto.RegisterType((*SVMPollMapping)(nil), "axelar.evm.v1beta1.SVMPollMapping")
+	proto.RegisterType((*ConfirmSVMGatewayTxsStarted)(nil), "axelar.evm.v1beta1.ConfirmSVMGatewayTxsStarted")
 	proto.RegisterType((*ConfirmDepositStarted)(nil), "axelar.evm.v1beta1.ConfirmDepositStarted")
 	proto.RegisterType((*ConfirmTokenStarted)(nil), "axelar.evm.v1beta1.ConfirmTokenStarted")
 	proto.RegisterType((*ChainAdded)(nil), "axelar.evm.v1beta1.ChainAdded")
 	proto.RegisterType((*CommandBatchSigned)(nil), "axelar.evm.v1beta1.CommandBatchSigned")
 	proto.RegisterType((*CommandBatchAborted)(nil), "axelar.evm.v1beta1.CommandBatchAborted")
+	proto.RegisterType((*CommandExecuted)(nil), "axelar.evm.v1beta1.CommandExecuted")
 	proto.RegisterType((*EVMEventConfirmed)(nil), "axelar.evm.v1beta1.EVMEventConfirmed")
 	proto.RegisterType((*EVMEventCompleted)(nil), "axelar.evm.v1beta1.EVMEventCompleted")
 	proto.RegisterType((*EVMEventFailed)(nil), "axelar.evm.v1beta1.EVMEventFailed")
@@ -1525,87 +1712,95 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/events.proto", fileDescriptor_091c22a44ca6f0af) }
 
 var fileDescriptor_091c22a44ca6f0af = []byte{
-	// 1279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xf3, 0xd9, 0x4c, 0xd2, 0x2f, 0xb7, 0xec, 0x66, 0x2b, 0x14, 0x47, 0x11, 0x12, 0x41,
-	0xa2, 0x0e, 0x5d, 0x58, 0x09, 0xf1, 0x21, 0xa8, 0x93, 0xc2, 0x46, 0xab, 0x56, 0x2b, 0x6f, 0x59,
-	0x04, 0x42, 0xaa, 0x26, 0xf6, 0x34, 0xb1, 0x6a, 0x7b, 0x2c, 0xcf, 0x34, 0x9b, 0x1c, 0x91, 0x38,
-	0x70, 0xe4, 0xc2, 0x15, 0xf1, 0x0f, 0xc0, 0x61, 0x25, 0xc4, 0xbf, 0x50, 0x6e, 0x3d, 0xae, 0x38,
-	0x58, 0x28, 0x3d, 0x20, 0xad, 0x38, 0x73, 0x28, 0x42, 0x42, 0x1e, 0x8f, 0x63, 0xa7, 0x2d, 0x6a,
-	0x77, 0x69, 0x96, 0x6c, 0xbb, 0xa7, 0x78, 0x66, 0xde, 0xbc, 0xf9, 0xbd, 0xf7, 0x7b, 0x6f, 0xe6,
-	0xcd, 0x04, 0x48, 0xb0, 0x87, 0x4c, 0xe8, 0xd6, 0x50, 0xd7, 0xaa, 0x75, 0x57, 0x5b, 0x88, 0xc2,
-	0xd5, 0x1a, 0xea, 0x22, 0x9b, 0x12, 0xd9, 0x71, 0x31, 0xc5, 0xa2, 0x18, 0x08, 0xc8, 0xa8, 0x6b,
-	0xc9, 0x5c, 0x60, 0x79, 0xa9, 0x8d, 0xdb, 0x98, 0x0d, 0xd7, 0xfc, 0xaf, 0x40, 0x72, 0xb9, 0xca,
-	0x55, 0x75, 0x31, 0x45, 0x35, 0xd4, 0x73, 0xb0, 0x4b, 0x91, 0x3e, 0x54, 0x4a, 0xfb, 0x0e, 0xe2,
-	0x3a, 0x97, 0x4b, 0xa7, 0x2c, 0x3a, 0x32, 0xae, 0x61, 0x62, 0x61, 0x52, 0x6b, 0x41, 0x82, 0x86,
-	0x02, 0x1a, 0x36, 0xec, 0x60, 0xbc, 0x72, 0x24, 0x00, 0x70, 0x17, 0x9b, 0xe6, 0x47, 0xd0, 0x30,
-	0x91, 0x2e, 0xbe, 0x06, 0xd2, 0xb4, 0xb7, 0x6d, 0xe8, 0x45, 0xa1, 0x2c, 0x54, 0x0b, 0xca, 0xd2,
-	0xbe, 0x27, 0x4d, 0xfd, 0xea, 0x49, 0xa9, 0xdb, 0x90, 0x74, 0x06, 0x9e, 0x94, 0xda, 0xea, 0x35,
-	0x1b, 0x6a, 0x8a, 0xf6, 0x9a, 0xba, 0xf8, 0x19, 0x48, 0x6b, 0x1d, 0x68, 0xd8, 0xc5, 0x44, 0x59,
-	0xa8, 0xe6, 0x94, 0xfa, 0x91, 0x27, 0x7d, 0xd0, 0x36, 0x68, 0x67, 0xaf, 0x25, 0x6b, 0xd8, 0xaa,
-	0x05, 0xb8, 0x6c, 0x44, 0x1f, 0x60, 0x77, 0x97, 0xb7, 0x56, 0x34, 0xec, 0xa2, 0x5a, 0xaf, 0x66,
-	0xa3, 0xde, 0x1e, 0x19, 0xda, 0x25, 0xd7, 0x7d, 0x35, 0x9b, 0xd0, 0x42, 0x6a, 0xa0, 0x51, 0xdc,
-	0x01, 0x59, 0x07, 0x9b, 0xa6, 0x8f, 0x23, 0x59, 0x16, 0xaa, 0x29, 0x65, 0x83, 0xe3, 0x78, 0xf7,
-	0x9c, 0x0b, 0x8c, 0xf8, 0x4d, 0xf6, 0xed, 0x6b, 0x36, 0x06, 0x9e, 0x94, 0x09, 0xbe, 0xd4, 0x8c,
-	0xaf, 0xbd, 0xa9, 0x57, 0xfe, 0x12, 0x40, 0xde, 0xef, 0x5a, 0xef, 0x39, 0x86, 0x7b, 0xe5, 0xac,
-	0xff, 0x5b, 0x00, 0x33, 0x7e, 0x57, 0x1d, 0x5b, 0x8e, 0x89, 0xe8, 0x95, 0xb3, 0xff, 0xcb, 0x04,
-	0x58, 0xd8, 0xc4, 0xeb, 0x2c, 0x43, 0xeb, 0xd8, 0xde, 0x31, 0x5c, 0xeb, 0xca, 0xf9, 0xe0, 0x71,
-	0x02, 0xdc, 0xe0, 0xb6, 0xdf, 0x41, 0xfd, 0x2d, 0x17, 0xda, 0x64, 0x07, 0xb9, 0xf7, 0x28, 0xf4,
-	0xa7, 0x45, 0x06, 0x0a, 0x17, 0x6e, 0xe0, 0xd0, 0xcd, 0x89, 0x33, 0xdd, 0xfc, 0x36, 0x98, 0x6b,
-	0x43, 0x8a, 0x1e, 0xc0, 0xfe, 0x36, 0xd4, 0x75, 0x17, 0x11, 0xc2, 0x7c, 0x52, 0x50, 0xe6, 0xf8,
-	0xa4, 0xec, 0x5a, 0xd0, 0xad, 0xce, 0x72, 0x39, 0xde, 0x16, 0x6b, 0x60, 0x51, 0x0b, 0x8c, 0x83,
-	0xd4, 0xc0, 0xf6, 0x76, 0x07, 0x19, 0xed, 0x0e, 0x2d, 0xa6, 0x7c, 0x8f, 0xaa, 0x62, 0x7c, 0xe8,
-	0x36, 0x1b, 0x11, 0xbf, 0x00, 0x05, 0x07, 0xba, 0xd4, 0xd0, 0x0c, 0x07, 0xda, 0x94, 0x14, 0xd3,
-	0x65, 0xa1, 0x9a, 0xbf, 0x29, 0xcb, 0x7c, 0xe3, 0xf6, 0x9d, 0x2a, 0x0f, 0x6d, 0xe2, 0xbb, 0x29,
-	0x73, 0xee, 0xdd, 0xd8, 0x2c, 0x65, 0xda, 0xc7, 0x75, 0xe0, 0x49, 0x82, 0x3a, 0xa2, 0xad, 0xf2,
-	0x47, 0x02, 0x5c, 0xe7, 0xce, 0xfe, 0x38, 0x00, 0xba, 0xd5, 0x0b, 0x5d, 0x3d, 0x19, 0x61, 0x77,
-	0x59, 0x5c, 0xfd, 0x4e, 0xa2, 0x28, 0x54, 0xbe, 0xe7, 0xbb, 0xfb, 0x06, 0x74, 0x1c, 0xc3, 0x6e,
-	0x3f, 0x89, 0x8b, 0x63, 0xe9, 0x97, 0x18, 0x67, 0xfa, 0x7d, 0x97, 0x04, 0xc5, 0xe3, 0x11, 0x41,
-	0xc2, 0x90, 0x40, 0x60, 0x86, 0x81, 0xb0, 0x02, 0xfc, 0xa4, 0x28, 0x94, 0x93, 0xd5, 0xfc, 0x4d,
-	0x49, 0x3e, 0x59, 0x46, 0xc8, 0x31, 0x3b, 0x15, 0xc9, 0xc7, 0xfa, 0xd8, 0x93, 0xae, 0x8f, 0xcc,
-	0x7e, 0x1d, 0x5b, 0x06, 0x45, 0x96, 0x43, 0xfb, 0x6a, 0xc1, 0x89, 0xa4, 0xc9, 0x25, 0x09, 0xa7,
-	0x4f, 0x4e, 0x84, 0x53, 0xb2, 0x5a, 0x50, 0x56, 0x8f, 0x3c, 0x69, 0x25, 0x66, 0x0c, 0x2f, 0x86,
-	0x82, 0x9f, 0x15, 0xa2, 0xef, 0xf2, 0x5a, 0xe9, 0x3e, 0x34, 0x43, 0x24, 0xa3, 0x29, 0xfb, 0x30,
-	0x09, 0x5e, 0xe2, 0x04, 0x35, 0x90, 0x83, 0x89, 0x41, 0x27, 0x2e, 0x61, 0xf5, 0x00, 0xd7, 0x99,
-	0x1e, 0xe6, 0x72, 0xa1, 0x87, 0xdf, 0x02, 0x33, 0x14, 0xef, 0x22, 0x7b, 0x38, 0x2f, 0x75, 0xfa,
-	0xbc, 0x02, 0x93, 0x3a, 0x83, 0x97, 0xf4, 0xb9, 0xd3, 0x3c, 0x73, 0x91, 0x69, 0x2e, 0x2e, 0x81,
-	0x34, 0x24, 0x04, 0xd1, 0x62, 0xd6, 0xf7, 0xac, 0x1a, 0x34, 0x2a, 0xbf, 0x27, 0xc1, 0x22, 0x27,
-	0x6d, 0xcb, 0x07, 0x7f, 0x59, 0xf6, 0xd8, 0xa7, 0xa3, 0xec, 0x4e, 0x38, 0x4b, 0x47, 0x14, 0x1a,
-	0x66, 0xb8, 0xd3, 0x96, 0x4f, 0xdb, 0x46, 0x98, 0xbb, 0x1a, 0x81, 0x9c, 0x92, 0xf2, 0xf5, 0x72,
-	0x65, 0xbc, 0xef, 0xdf, 0xf8, 0xcf, 0x9c, 0x9b, 0xff, 0xec, 0x85, 0x9e, 0xa8, 0x6d, 0x00, 0x98,
-	0x7f, 0xd7, 0x74, 0x7d, 0xac, 0xe5, 0x4a, 0xe5, 0x07, 0x01, 0x88, 0x75, 0x6c, 0x59, 0xd0, 0xd6,
-	0x15, 0x48, 0xb5, 0xce, 0x3d, 0xa3, 0x6d, 0xa3, 0xb1, 0x86, 0xc9, 0x7b, 0x60, 0x5e, 0x0b, 0x16,
-	0xdc, 0x6e, 0xf9, 0x2b, 0x86, 0xa5, 0x60, 0x41, 0x11, 0x07, 0x9e, 0x34, 0x1b, 0x07, 0xd3, 0x6c,
-	0xa8, 0xb3, 0x5a, 0xbc, 0xad, 0x57, 0x7e, 0x14, 0xfc, 0x14, 0x88, 0xba, 0xd6, 0x5a, 0x78, 0xb4,
-	0xa2, 0x9b, 0x34, 0xc0, 0x3f, 0x09, 0x60, 0x61, 0xfd, 0xfe, 0x06, 0xab, 0xc6, 0xa3, 0x62, 0x7c,
-	0x8c, 0x05, 0xe8, 0x2a, 0x98, 0x66, 0x97, 0xf3, 0xf0, 0x8c, 0xcf, 0x29, 0xd7, 0x06, 0x9e, 0x94,
-	0x65, 0x00, 0x9a, 0x8d, 0xa3, 0xe8, 0x53, 0xcd, 0x32, 0xb9, 0xa6, 0x2e, 0x8a, 0x20, 0xe5, 0x1f,
-	0x17, 0xcc, 0xaa, 0x9c, 0xca, 0xbe, 0x8f, 0xe1, 0x0e, 0x2f, 0x52, 0x93, 0x8f, 0xfb, 0xa1, 0x00,
-	0x66, 0x43, 0xdc, 0xfc, 0xee, 0x3f, 0xf9, 0xa0, 0x7f, 0x16, 0xc0, 0x62, 0x08, 0x5a, 0x45, 0xd4,
-	0xed, 0x3f, 0x37, 0xc8, 0x7f, 0x49, 0x82, 0xa5, 0x3a, 0xb6, 0xa9, 0x0b, 0x35, 0x5a, 0x87, 0xa6,
-	0xb9, 0xe6, 0x38, 0x2e, 0xee, 0x4e, 0x1c, 0xf4, 0xf7, 0x01, 0x08, 0x73, 0x78, 0x98, 0xbd, 0x25,
-	0x7e, 0xbc, 0xe4, 0x78, 0x06, 0xb3, 0x42, 0x36, 0x6a, 0xa8, 0x39, 0x3e, 0xa3, 0xa9, 0x8b, 0xd7,
-	0x40, 0x86, 0x20, 0x5b, 0x47, 0x2e, 0x3b, 0x99, 0x72, 0x2a, 0x6f, 0x89, 0x0e, 0x58, 0xd0, 0x11,
-	0xa1, 0x86, 0x1d, 0x1c, 0x1a, 0x81, 0xc1, 0xe9, 0x8b, 0x33, 0x78, 0x3e, 0xa6, 0xbd, 0xce, 0xaf,
-	0x97, 0xf3, 0x1a, 0x77, 0xf7, 0xf0, 0xb4, 0xcc, 0x30, 0x4c, 0x73, 0x61, 0x7f, 0x54, 0xd2, 0x14,
-	0x1c, 0xd8, 0x37, 0x31, 0xd4, 0xb7, 0x3b, 0x90, 0x74, 0xd8, 0x09, 0x55, 0x50, 0x0a, 0xf1, 0xe2,
-	0x40, 0xcd, 0x73, 0x09, 0xbf, 0x51, 0xf9, 0x96, 0x9d, 0x05, 0x11, 0x97, 0xe3, 0x0f, 0xc2, 0x57,
-	0x40, 0xc6, 0x22, 0xed, 0x88, 0xc7, 0x19, 0x9f, 0x81, 0x0d, 0x44, 0x08, 0x6c, 0xa3, 0x66, 0x43,
-	0x4d, 0x5b, 0xa4, 0xdd, 0xd4, 0x2b, 0x5f, 0xa7, 0xc0, 0xcb, 0x71, 0x5c, 0x9f, 0x1a, 0xb4, 0xb3,
-	0x61, 0xd8, 0xf4, 0x45, 0xac, 0x3d, 0xb7, 0xb1, 0x26, 0xde, 0x0a, 0x0b, 0xdc, 0x69, 0x56, 0x37,
-	0xdd, 0x90, 0x83, 0xab, 0x8b, 0xdc, 0x82, 0x04, 0x0d, 0xcb, 0xa5, 0x3a, 0x36, 0x6c, 0x5e, 0xad,
-	0xf1, 0x0a, 0xf8, 0xab, 0x14, 0xc8, 0x05, 0xa5, 0x2f, 0xb2, 0xe9, 0x84, 0xf1, 0x4e, 0x40, 0x9e,
-	0xf2, 0x77, 0xa6, 0xe8, 0x79, 0x4b, 0x1d, 0x78, 0x12, 0x08, 0x9f, 0x9f, 0xd8, 0xc4, 0x0f, 0x9f,
-	0x0e, 0x61, 0xa4, 0x43, 0x05, 0xe1, 0x32, 0x13, 0x15, 0x2d, 0x35, 0xb0, 0x18, 0x5f, 0x71, 0x34,
-	0x60, 0xc4, 0xd8, 0x50, 0x18, 0x33, 0xb7, 0xe2, 0x77, 0x9c, 0x73, 0x87, 0x00, 0x7b, 0x01, 0xf9,
-	0x33, 0x09, 0xf2, 0xfe, 0x0e, 0xc0, 0x13, 0x68, 0x9c, 0x81, 0x70, 0x8c, 0xd5, 0xc4, 0x33, 0x61,
-	0xf5, 0x3f, 0x6e, 0x21, 0xa7, 0x92, 0x9f, 0xfa, 0x1f, 0xc8, 0x4f, 0x9f, 0x4d, 0x7e, 0xe6, 0x89,
-	0xf2, 0xff, 0x51, 0x02, 0xe4, 0x95, 0x3d, 0xd7, 0x7e, 0x06, 0xc4, 0x8f, 0x72, 0x90, 0xb8, 0x10,
-	0x0e, 0x92, 0xe3, 0xe4, 0xe0, 0xd5, 0x93, 0x4f, 0x26, 0xc1, 0x9e, 0x70, 0xfc, 0x85, 0x64, 0xf8,
-	0xb8, 0x90, 0x8e, 0x3d, 0x2e, 0x28, 0x9b, 0xfb, 0x83, 0x92, 0x70, 0x30, 0x28, 0x09, 0xbf, 0x0d,
-	0x4a, 0xc2, 0x37, 0x87, 0xa5, 0xa9, 0xfd, 0xc3, 0x92, 0x70, 0x70, 0x58, 0x9a, 0x7a, 0x74, 0x58,
-	0x9a, 0xfa, 0xfc, 0x8d, 0x73, 0xe2, 0x45, 0x5d, 0x2b, 0x78, 0x7a, 0x6a, 0x65, 0xd8, 0xff, 0x70,
-	0x6f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x93, 0xf3, 0x03, 0x3e, 0x1c, 0x00, 0x00,
+	// 1407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x6b, 0x3b, 0x7e, 0x76, 0x3e, 0xba, 0x09, 0xad, 0x5b, 0x90, 0x37, 0x58, 0x08,
+	0x8c, 0x44, 0xd7, 0xa4, 0x50, 0x09, 0xf1, 0x21, 0xc8, 0xda, 0x81, 0x5a, 0x95, 0xab, 0x6a, 0x13,
+	0x82, 0x40, 0x48, 0xd1, 0x78, 0x77, 0xba, 0x5e, 0xd5, 0xbb, 0xb3, 0xda, 0x99, 0xa4, 0xf6, 0x11,
+	0x89, 0x03, 0x47, 0x2e, 0x9c, 0x90, 0x10, 0xff, 0x00, 0x1c, 0x2a, 0x21, 0xfe, 0x85, 0x72, 0xeb,
+	0xb1, 0xe2, 0x60, 0x21, 0xe7, 0x80, 0x54, 0x71, 0xe6, 0x10, 0x54, 0x84, 0x76, 0x77, 0xd6, 0x5e,
+	0x27, 0x46, 0x49, 0xdb, 0xb8, 0xb8, 0x0d, 0x27, 0xef, 0x7c, 0xff, 0xde, 0x7b, 0xbf, 0xf7, 0xe6,
+	0xcd, 0x33, 0xc8, 0xa8, 0x83, 0xdb, 0xc8, 0xab, 0xe0, 0x5d, 0xbb, 0xb2, 0xbb, 0xda, 0xc4, 0x0c,
+	0xad, 0x56, 0xf0, 0x2e, 0x76, 0x18, 0x55, 0x5c, 0x8f, 0x30, 0x22, 0x49, 0xe1, 0x04, 0x05, 0xef,
+	0xda, 0x0a, 0x9f, 0x70, 0x61, 0xd9, 0x24, 0x26, 0x09, 0x86, 0x2b, 0xfe, 0x57, 0x38, 0xf3, 0x42,
+	0x99, 0x6f, 0xb5, 0x4b, 0x18, 0xae, 0xe0, 0x8e, 0x4b, 0x3c, 0x86, 0x8d, 0xc1, 0xa6, 0xac, 0xeb,
+	0x62, 0xbe, 0xe7, 0x85, 0xe2, 0x98, 0x43, 0x47, 0xc6, 0x75, 0x42, 0x6d, 0x42, 0x2b, 0x4d, 0x44,
+	0xf1, 0x60, 0x82, 0x4e, 0x2c, 0x27, 0x1c, 0x2f, 0xed, 0x0b, 0x00, 0xd7, 0x49, 0xbb, 0xfd, 0x21,
+	0xb2, 0xda, 0xd8, 0x90, 0x5e, 0x85, 0x14, 0xeb, 0x6c, 0x5b, 0x46, 0x41, 0x58, 0x11, 0xca, 0x79,
+	0x75, 0xf9, 0x4e, 0x4f, 0x9e, 0xf9, 0xb5, 0x27, 0x8b, 0x57, 0x10, 0x6d, 0xf5, 0x7b, 0xb2, 0xb8,
+	0xd9, 0xa9, 0xd7, 0x34, 0x91, 0x75, 0xea, 0x86, 0xf4, 0x29, 0xa4, 0xf4, 0x16, 0xb2, 0x9c, 0x42,
+	0x62, 0x45, 0x28, 0x67, 0xd5, 0xea, 0x7e, 0x4f, 0x7e, 0xdf, 0xb4, 0x58, 0x6b, 0xa7, 0xa9, 0xe8,
+	0xc4, 0xae, 0x84, 0xb8, 0x1c, 0xcc, 0x6e, 0x11, 0xef, 0x26, 0x6f, 0x5d, 0xd4, 0x89, 0x87, 0x2b,
+	0x9d, 0x8a, 0x83, 0x3b, 0x3b, 0x74, 0x20, 0x97, 0x52, 0xf5, 0xb7, 0xb9, 0x86, 0x6c, 0xac, 0x85,
+	0x3b, 0x4a, 0x37, 0x20, 0xe3, 0x92, 0x76, 0xdb, 0xc7, 0x91, 0x5c, 0x11, 0xca, 0xa2, 0xda, 0xe0,
+	0x38, 0xde, 0x39, 0xe6, 0x01, 0x23, 0x7a, 0x53, 0x7c, 0xf9, 0xea, 0xb5, 0x7e, 0x4f, 0x4e, 0x87,
+	0x5f, 0x5a, 0xda, 0xdf, 0xbd, 0x6e, 0x94, 0xfe, 0x12, 0x20, 0xe7, 0x77, 0xad, 0x77, 0x5c, 0xcb,
+	0x3b, 0x75, 0xd2, 0x3f, 0x10, 0x60, 0xce, 0xef, 0xaa, 0x12, 0xdb, 0x6d, 0x63, 0x76, 0xea, 0xe4,
+	0xff, 0x22, 0x01, 0x67, 0xae, 0x91, 0xf5, 0xc0, 0x43, 0xab, 0xc4, 0xb9, 0x61, 0x79, 0xf6, 0xa9,
+	0xd3, 0xc1, 0xfd, 0x04, 0x9c, 0xe7, 0xb2, 0x5f, 0xc5, 0xdd, 0x4d, 0x0f, 0x39, 0xf4, 0x06, 0xf6,
+	0x36, 0x18, 0xf2, 0x97, 0x0d, 0x05, 0x14, 0x4e, 0x5c, 0xc0, 0x81, 0x9a, 0x13, 0x47, 0xaa, 0xf9,
+	0x2d, 0x58, 0x30, 0x11, 0xc3, 0xb7, 0x50, 0x77, 0x1b, 0x19, 0x86, 0x87, 0x29, 0x0d, 0x74, 0x92,
+	0x57, 0x17, 0xf8, 0xa2, 0xcc, 0x5a, 0xd8, 0xad, 0xcd, 0xf3, 0x79, 0xbc, 0x2d, 0x55, 0x60, 0x49,
+	0x0f, 0x85, 0x43, 0xcc, 0x22, 0xce, 0x76, 0x0b, 0x5b, 0x66, 0x8b, 0x15, 0x44, 0x5f, 0xa3, 0x9a,
+	0x14, 0x1f, 0xba, 0x12, 0x8c, 0x48, 0x9f, 0x43, 0xde, 0x45, 0x1e, 0xb3, 0x74, 0xcb, 0x45, 0x0e,
+	0xa3, 0x85, 0xd4, 0x8a, 0x50, 0xce, 0x5d, 0x52, 0x14, 0x1e, 0xb8, 0x7d, 0xa5, 0x2a, 0x03, 0x99,
+	0x78, 0x34, 0x0d, 0x94, 0x7b, 0x3d, 0xb6, 0x4a, 0x9d, 0xf5, 0x71, 0xdd, 0xed, 0xc9, 0x82, 0x36,
+	0xb2, 0x5b, 0xe9, 0x8f, 0x04, 0x9c, 0xe3, 0xca, 0xfe, 0x28, 0x04, 0xba, 0xd9, 0x89, 0x54, 0x3d,
+	0x1d, 0xb4, 0x7b, 0x56, 0x54, 0xfd, 0x76, 0xa2, 0x20, 0x94, 0xbe, 0xe7, 0xd1, 0xbd, 0x81, 0x5c,
+	0xd7, 0x72, 0xcc, 0x87, 0x51, 0x71, 0xcc, 0xfd, 0x12, 0x93, 0x74, 0xbf, 0xef, 0x92, 0x50, 0x38,
+	0xc8, 0x08, 0x1a, 0x51, 0x02, 0xc3, 0x5c, 0x00, 0xc2, 0x0e, 0xf1, 0xd3, 0x82, 0xb0, 0x92, 0x2c,
+	0xe7, 0x2e, 0xc9, 0xca, 0xe1, 0x34, 0x42, 0x89, 0xc9, 0xa9, 0xca, 0x3e, 0xd6, 0xfb, 0x3d, 0xf9,
+	0xdc, 0xc8, 0xea, 0xd7, 0x88, 0x6d, 0x31, 0x6c, 0xbb, 0xac, 0xab, 0xe5, 0xdd, 0xe1, 0x6c, 0xfa,
+	0x8c, 0xd0, 0xe9, 0xe3, 0x43, 0x74, 0x4a, 0x96, 0xf3, 0xea, 0xea, 0x7e, 0x4f, 0xbe, 0x18, 0x13,
+	0x86, 0x27, 0x43, 0xe1, 0xcf, 0x45, 0x6a, 0xdc, 0xe4, 0xb9, 0xd2, 0x16, 0x6a, 0x47, 0x48, 0x46,
+	0x5d, 0xf6, 0x5b, 0x01, 0xe6, 0x37, 0xb6, 0x1a, 0x71, 0x1a, 0xbd, 0x08, 0x79, 0xd6, 0xd9, 0xa6,
+	0x96, 0xe9, 0x20, 0xb6, 0xe3, 0xe1, 0x30, 0x36, 0x6a, 0x39, 0xd6, 0xd9, 0x88, 0xba, 0x9e, 0x18,
+	0x7d, 0x1e, 0x24, 0xe0, 0x79, 0x4e, 0x9f, 0x8d, 0xad, 0xc6, 0x61, 0x06, 0xb5, 0xc6, 0x33, 0xa8,
+	0x34, 0x8e, 0x41, 0xa3, 0x52, 0x4e, 0x11, 0x89, 0x54, 0x90, 0x22, 0x12, 0xb9, 0x1e, 0x31, 0x3d,
+	0x64, 0x47, 0xb7, 0x62, 0x56, 0x5d, 0xee, 0xf7, 0xe4, 0x45, 0x2e, 0xf7, 0xf5, 0x70, 0xb0, 0x5e,
+	0xd3, 0x16, 0xcd, 0xd1, 0x1e, 0xe3, 0x10, 0x3b, 0xc4, 0x93, 0x61, 0xc7, 0xed, 0x24, 0x3c, 0xc7,
+	0xf5, 0x5f, 0xc3, 0x2e, 0xa1, 0x16, 0x9b, 0xba, 0x70, 0x6e, 0x84, 0xb8, 0x8e, 0xf4, 0x3f, 0x3e,
+	0x2f, 0xf2, 0xbf, 0x37, 0x61, 0x8e, 0x91, 0x9b, 0xd8, 0x19, 0xac, 0x13, 0xc7, 0xaf, 0xcb, 0x07,
+	0xb3, 0x8e, 0xf0, 0xda, 0xd4, 0xb1, 0x2f, 0x81, 0xf4, 0x49, 0x5e, 0x02, 0xd2, 0x32, 0xa4, 0x10,
+	0xa5, 0x98, 0x15, 0x32, 0x81, 0x8b, 0x86, 0x8d, 0xd2, 0xef, 0x49, 0x58, 0xe2, 0x46, 0xdb, 0xf4,
+	0xc1, 0x3f, 0x2b, 0x37, 0xf0, 0xa3, 0x99, 0xec, 0x6a, 0xb4, 0xca, 0xc0, 0x0c, 0x59, 0xed, 0xe8,
+	0x1e, 0x5e, 0x19, 0x17, 0x22, 0x02, 0x75, 0xd5, 0xc2, 0x79, 0xaa, 0xe8, 0xef, 0xcb, 0x37, 0xe3,
+	0x7d, 0xff, 0x66, 0xff, 0xf4, 0xb1, 0xed, 0x9f, 0x39, 0xd1, 0x7c, 0xcb, 0x04, 0x08, 0xf4, 0xbb,
+	0x66, 0x18, 0x13, 0x4d, 0x66, 0x4b, 0x3f, 0x08, 0x20, 0x55, 0x89, 0x6d, 0x23, 0xc7, 0x50, 0x11,
+	0xd3, 0x5b, 0xfe, 0x4d, 0x80, 0x27, 0x4a, 0x93, 0x77, 0x61, 0x51, 0x0f, 0x0f, 0xdc, 0x6e, 0xfa,
+	0x27, 0x46, 0x21, 0x31, 0xaf, 0x4a, 0xfd, 0x9e, 0x3c, 0x1f, 0x07, 0x53, 0xaf, 0x69, 0xf3, 0x7a,
+	0xbc, 0x6d, 0x94, 0x7e, 0x14, 0x7c, 0x17, 0x18, 0x76, 0xad, 0x35, 0x89, 0xc7, 0xa6, 0x19, 0xf0,
+	0xdf, 0x02, 0x2c, 0xf0, 0x29, 0xeb, 0x1d, 0xac, 0xef, 0x4c, 0xf8, 0x71, 0xf2, 0x1e, 0x40, 0x04,
+	0x76, 0xf0, 0x42, 0x29, 0x72, 0x3f, 0xca, 0x72, 0x1c, 0xc1, 0x85, 0x3c, 0x6c, 0x68, 0x59, 0xbe,
+	0xa2, 0x6e, 0x48, 0x2f, 0xc3, 0xec, 0x01, 0x19, 0x73, 0xfd, 0x9e, 0x9c, 0x89, 0x84, 0xcb, 0x34,
+	0x43, 0xa9, 0x86, 0x11, 0x47, 0x3c, 0x2a, 0xe2, 0x94, 0x7e, 0x12, 0xe0, 0xcc, 0xfa, 0x56, 0x23,
+	0x78, 0xac, 0x0e, 0xdf, 0xaa, 0x13, 0x54, 0xc1, 0x2a, 0xcc, 0x06, 0xb5, 0xab, 0x48, 0x01, 0x59,
+	0xf5, 0xac, 0x2f, 0x43, 0x00, 0xa0, 0x5e, 0xdb, 0x1f, 0x7e, 0x6a, 0x99, 0x60, 0x5e, 0xdd, 0x90,
+	0x24, 0x10, 0xfd, 0xfb, 0x32, 0xbc, 0x9a, 0xb5, 0xe0, 0xfb, 0x00, 0xee, 0xa8, 0xce, 0x30, 0xfd,
+	0xb8, 0x6f, 0x0b, 0x30, 0x1f, 0xe1, 0xe6, 0xa5, 0xb1, 0xe9, 0x07, 0xfd, 0xb3, 0x00, 0x4b, 0x11,
+	0x68, 0x0d, 0x33, 0xaf, 0xfb, 0xd4, 0x20, 0xff, 0x25, 0x09, 0xcb, 0x55, 0xe2, 0x30, 0x0f, 0xe9,
+	0xac, 0x8a, 0xda, 0xed, 0x35, 0xd7, 0xf5, 0xc8, 0xee, 0xd4, 0x41, 0x1f, 0x8d, 0x0b, 0xc9, 0x87,
+	0x8d, 0x0b, 0x67, 0x21, 0x4d, 0xb1, 0x63, 0x60, 0x2f, 0x70, 0xf8, 0xac, 0xc6, 0x5b, 0x92, 0x0b,
+	0x67, 0x0c, 0x4c, 0x99, 0xe5, 0x84, 0xb7, 0x66, 0x28, 0x70, 0xea, 0xe4, 0x04, 0x5e, 0x8c, 0xed,
+	0x5e, 0xe5, 0xd5, 0x97, 0x45, 0x9d, 0xab, 0x7b, 0x90, 0x2e, 0xa4, 0x03, 0x4c, 0x0b, 0x51, 0xff,
+	0x30, 0xa7, 0xcb, 0xbb, 0xa8, 0xdb, 0x26, 0xc8, 0xd8, 0x6e, 0x21, 0xda, 0x0a, 0xae, 0xe8, 0xbc,
+	0x9a, 0x8f, 0xc7, 0x2a, 0x2d, 0xc7, 0x67, 0xf8, 0x8d, 0xd2, 0x37, 0xc1, 0x65, 0x38, 0xb4, 0xe5,
+	0xe4, 0x49, 0xf8, 0x12, 0xa4, 0x6d, 0x6a, 0x0e, 0xed, 0x38, 0xe7, 0x5b, 0xa0, 0x81, 0x29, 0x45,
+	0x26, 0xae, 0xd7, 0xb4, 0x94, 0x4d, 0xcd, 0xba, 0x51, 0xfa, 0x4a, 0x84, 0x17, 0xe2, 0xb8, 0x3e,
+	0xb1, 0x58, 0xab, 0x61, 0x39, 0xec, 0x7f, 0xae, 0x3d, 0xb5, 0x5c, 0x93, 0x2e, 0x47, 0x19, 0xfe,
+	0x6c, 0x90, 0x38, 0x9e, 0x57, 0xc2, 0xb7, 0x9b, 0xe2, 0xff, 0xdb, 0x31, 0xc8, 0x17, 0xab, 0xc4,
+	0x72, 0x78, 0xba, 0xca, 0x9f, 0x00, 0x5f, 0x8a, 0x90, 0x0d, 0x73, 0x7f, 0xec, 0xb0, 0x29, 0xb3,
+	0x3b, 0x85, 0x1c, 0xe3, 0x65, 0xd8, 0x61, 0xf5, 0x57, 0xeb, 0xf7, 0x64, 0x88, 0xaa, 0xb3, 0xc1,
+	0xc2, 0x0f, 0x1e, 0x0d, 0xe1, 0x70, 0x0f, 0x0d, 0xa2, 0x63, 0xa6, 0x8a, 0x2d, 0x15, 0x58, 0x8a,
+	0x9f, 0x38, 0x4a, 0x18, 0x29, 0x36, 0x14, 0x71, 0xe6, 0x72, 0xfc, 0x91, 0x77, 0x6c, 0x0a, 0x04,
+	0x05, 0xc2, 0x3f, 0x93, 0x90, 0xf3, 0x23, 0x00, 0x77, 0xa0, 0x49, 0x12, 0xe1, 0x80, 0x55, 0x13,
+	0x4f, 0xc4, 0xaa, 0x8f, 0x19, 0x42, 0xc6, 0x1a, 0x5f, 0xfc, 0x0f, 0x8c, 0x9f, 0x3a, 0xda, 0xf8,
+	0xe9, 0x87, 0xf2, 0xff, 0x7b, 0x09, 0xc8, 0xa9, 0x3b, 0x9e, 0xf3, 0x04, 0x0c, 0xff, 0x98, 0x4f,
+	0x89, 0xb1, 0x36, 0x48, 0x4e, 0xd2, 0x06, 0xaf, 0x1c, 0xae, 0x19, 0x85, 0x31, 0xe1, 0x60, 0x89,
+	0x68, 0x50, 0x5d, 0x49, 0xc5, 0xaa, 0x2b, 0xea, 0xb5, 0x3b, 0xfd, 0xa2, 0x70, 0xb7, 0x5f, 0x14,
+	0x7e, 0xeb, 0x17, 0x85, 0xaf, 0xf7, 0x8a, 0x33, 0x77, 0xf6, 0x8a, 0xc2, 0xdd, 0xbd, 0xe2, 0xcc,
+	0xbd, 0xbd, 0xe2, 0xcc, 0x67, 0xaf, 0x1f, 0x13, 0xaf, 0xff, 0x6f, 0x76, 0x50, 0x7b, 0x6b, 0xa6,
+	0x83, 0xbf, 0xa9, 0xdf, 0xf8, 0x67, 0x00, 0x66, 0xc2, 0x00, 0xda, 0x5d, 0x1f, 0x00, 0x00,
 }
 
 func (m *PollFailed) Marshal() (dAtA []byte, err error) {
@@ -2024,6 +2219,101 @@ func (m *ConfirmGatewayTxsStarted) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *SVMPollMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SVMPollMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SVMPollMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PollID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxSignature) > 0 {
+		i -= len(m.TxSignature)
+		copy(dAtA[i:], m.TxSignature)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxSignature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GatewayProgramID) > 0 {
+		i -= len(m.GatewayProgramID)
+		copy(dAtA[i:], m.GatewayProgramID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GatewayProgramID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PollMappings) > 0 {
+		for iNdEx := len(m.PollMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PollMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConfirmDepositStarted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2295,7 +2585,7 @@ func (m *CommandBatchAborted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EVMEventConfirmed) Marshal() (dAtA []byte, err error) {
+func (m *CommandExecuted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2305,30 +2595,43 @@ func (m *EVMEventConfirmed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EVMEventConfirmed) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommandExecuted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EVMEventConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommandExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+	{
+		size := m.TxID.Size()
+		i -= size
+		if _, err := m.TxID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BatchID) > 0 {
+		i -= len(m.BatchID)
+		copy(dAtA[i:], m.BatchID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BatchID)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.EventID) > 0 {
-		i -= len(m.EventID)
-		copy(dAtA[i:], m.EventID)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.EventID)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.CommandID.Size()
+		i -= size
+		if _, err := m.CommandID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Chain) > 0 {
 		i -= len(m.Chain)
 		copy(dAtA[i:], m.Chain)
@@ -2339,7 +2642,7 @@ func (m *EVMEventConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EVMEventCompleted) Marshal() (dAtA []byte, err error) {
+func (m *EVMEventConfirmed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2349,12 +2652,56 @@ func (m *EVMEventCompleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EVMEventCompleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EVMEventConfirmed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EVMEventCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EVMEventConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventID) > 0 {
+		i -= len(m.EventID)
+		copy(dAtA[i:], m.EventID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EventID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMEventCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMEventCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMEventCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3048,6 +3395,51 @@ func (m *ConfirmGatewayTxsStarted) Size() (n int) {
 	return n
 }
 
+func (m *SVMPollMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxSignature)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PollID != 0 {
+		n += 1 + sovEvents(uint64(m.PollID))
+	}
+	return n
+}
+
+func (m *ConfirmSVMGatewayTxsStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PollMappings) > 0 {
+		for _, e := range m.PollMappings {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GatewayProgramID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, b := range m.Participants {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ConfirmDepositStarted) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3149,6 +3541,27 @@ func (m *CommandBatchAborted) Size() (n int) {
 	return n
 }
 
+func (m *CommandExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.CommandID.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BatchID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.TxID.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EVMEventConfirmed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4651,7 +5064,7 @@ func (m *ConfirmGatewayTxsStarted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
+func (m *SVMPollMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4674,17 +5087,17 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfirmDepositStarted: wiretype end group for non-group")
+			return fmt.Errorf("proto: SVMPollMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfirmDepositStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SVMPollMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSignature", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4694,30 +5107,29 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollID", wireType)
 			}
-			var stringLen uint64
+			m.PollID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4727,29 +5139,66 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PollID |= github_com_axelarnetwork_axelar_core_x_vote_exported.PollID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmSVMGatewayTxsStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollMappings", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4759,30 +5208,31 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PollMappings = append(m.PollMappings, SVMPollMapping{})
+			if err := m.PollMappings[len(m.PollMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4792,49 +5242,29 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationHeight", wireType)
-			}
-			m.ConfirmationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfirmationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollParticipants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayProgramID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4844,30 +5274,29 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PollParticipants.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GatewayProgramID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4877,16 +5306,281 @@ func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, make([]byte, postIndex-iNdEx))
+			copy(m.Participants[len(m.Participants)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmDepositStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmDepositStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmDepositStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationHeight", wireType)
+			}
+			m.ConfirmationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollParticipants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PollParticipants.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
@@ -5496,6 +6190,188 @@ func (m *CommandBatchAborted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommandExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommandID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchID = append(m.BatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchID == nil {
+				m.BatchID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EVMEventConfirmed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
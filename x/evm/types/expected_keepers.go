@@ -12,6 +12,7 @@ import (
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
@@ -46,6 +47,8 @@ type ChainKeeper interface {
 	GetTokenByteCode(ctx sdk.Context) []byte
 	SetGateway(ctx sdk.Context, address Address)
 	GetGatewayAddress(ctx sdk.Context) (Address, bool)
+	SetSVMGateway(ctx sdk.Context, programID string)
+	GetSVMGatewayProgramID(ctx sdk.Context) (string, bool)
 	GetNetworkByID(ctx sdk.Context, id math.Int) (string, bool)
 	GetChainIDByNetwork(ctx sdk.Context, network string) (math.Int, bool)
 	GetVotingThreshold(ctx sdk.Context) utils.Threshold
@@ -65,6 +68,11 @@ type ChainKeeper interface {
 	GetLatestCommandBatch(ctx sdk.Context) CommandBatch
 	GetBatchByID(ctx sdk.Context, id []byte) CommandBatch
 	DeleteUnsignedCommandBatchID(ctx sdk.Context)
+	GetBatchIDByCommandID(ctx sdk.Context, id CommandID) ([]byte, bool)
+
+	SetCommandExecuted(ctx sdk.Context, id CommandID, txID Hash) error
+	GetCommandExecution(ctx sdk.Context, id CommandID) (CommandExecution, bool)
+	GetCommandExecutionsPaginated(ctx sdk.Context, pageRequest *query.PageRequest) ([]CommandExecution, *query.PageResponse, error)
 
 	GetConfirmedEventQueue(ctx sdk.Context) utils.KVQueue
 	GetEvent(ctx sdk.Context, eventID EventID) (Event, bool)
@@ -103,9 +111,10 @@ type Nexus interface {
 	AddTransferFee(ctx sdk.Context, coin sdk.Coin)
 	GetChainMaintainerState(ctx sdk.Context, chain nexus.Chain, address sdk.ValAddress) (nexus.MaintainerState, bool)
 	SetChainMaintainerState(ctx sdk.Context, maintainerState nexus.MaintainerState) error
+	RecordChainPoll(ctx sdk.Context, chain nexus.ChainName, failed bool)
 	SetNewMessage(ctx sdk.Context, m nexus.GeneralMessage) error
 	GetProcessingMessages(ctx sdk.Context, chain nexus.ChainName, limit int64) []nexus.GeneralMessage
-	SetMessageFailed(ctx sdk.Context, id string) error
+	SetMessageFailed(ctx sdk.Context, id string, reason string) error
 	SetMessageExecuted(ctx sdk.Context, id string) error
 	EnqueueRouteMessage(ctx sdk.Context, id string) error
 }
@@ -119,7 +128,7 @@ type InitPoller = interface {
 
 // Snapshotter provides access to the snapshot functionality
 type Snapshotter interface {
-	CreateSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, weightFunc func(consensusPower math.Uint) math.Uint, threshold utils.Threshold) (snapshot.Snapshot, error)
+	CreateWeightedSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, threshold utils.Threshold) (snapshot.Snapshot, error)
 	GetProxy(ctx sdk.Context, principal sdk.ValAddress) (addr sdk.AccAddress, active bool)
 }
 
@@ -146,7 +155,8 @@ type MultisigKeeper interface {
 	GetKey(ctx sdk.Context, keyID multisig.KeyID) (multisig.Key, bool)
 	AssignKey(ctx sdk.Context, chainName nexus.ChainName, keyID multisig.KeyID) error
 	RotateKey(ctx sdk.Context, chainName nexus.ChainName) error
-	Sign(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error
+	Sign(ctx sdk.Context, keyID multisig.KeyID, payloadHash multisig.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error)
+	GetSigningSession(ctx sdk.Context, id uint64) (multisigtypes.SigningSession, bool)
 }
 
 // Permission provides access to the permission functionality
@@ -110,6 +110,18 @@ func (m GenesisState) Validate() error {
 			return getValidateError(j, errorsmod.Wrapf(err, "invalid confirmed event queue state"))
 		}
 
+		executedCommandSeen := make(map[CommandID]bool)
+		for _, execution := range chain.CommandExecutions {
+			if executedCommandSeen[execution.CommandID] {
+				return getValidateError(j, fmt.Errorf("duplicate execution of command %s", execution.CommandID.Hex()))
+			}
+
+			if err := execution.ValidateBasic(); err != nil {
+				return getValidateError(j, errorsmod.Wrapf(err, "invalid execution of command %s", execution.CommandID.Hex()))
+			}
+
+			executedCommandSeen[execution.CommandID] = true
+		}
 	}
 
 	return nil
@@ -66,6 +66,7 @@ type GenesisState_Chain struct {
 	Tokens              []ERC20TokenMetadata   `protobuf:"bytes,10,rep,name=tokens,proto3" json:"tokens"`
 	Events              []Event                `protobuf:"bytes,11,rep,name=events,proto3" json:"events"`
 	ConfirmedEventQueue utils.QueueState       `protobuf:"bytes,12,opt,name=confirmed_event_queue,json=confirmedEventQueue,proto3" json:"confirmed_event_queue"`
+	CommandExecutions   []CommandExecution     `protobuf:"bytes,15,rep,name=command_executions,json=commandExecutions,proto3" json:"command_executions"`
 }
 
 func (m *GenesisState_Chain) Reset()         { *m = GenesisState_Chain{} }
@@ -109,37 +110,39 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/genesis.proto", fileDescriptor_dd3ecf743c731821) }
 
 var fileDescriptor_dd3ecf743c731821 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x63, 0x72, 0x31, 0xe6, 0x92, 0xa6, 0xd6, 0x01, 0x92, 0x31, 0x92, 0x13, 0x18, 0x50,
-	0x16, 0xec, 0x36, 0x0c, 0x20, 0xd8, 0x12, 0x10, 0x92, 0x11, 0x08, 0x0a, 0x12, 0x52, 0x97, 0xea,
-	0xe2, 0x3c, 0x1c, 0xab, 0xb5, 0x2f, 0xd8, 0x97, 0xb4, 0xfd, 0x2f, 0x18, 0x59, 0x18, 0xf8, 0x6f,
-	0x32, 0x76, 0x64, 0x42, 0x90, 0xfc, 0x17, 0x4c, 0xc8, 0xef, 0xce, 0x51, 0x05, 0x66, 0x60, 0x3b,
-	0xdf, 0xfb, 0x7d, 0xdf, 0x7d, 0xfe, 0xf4, 0x68, 0x9f, 0x9f, 0xc1, 0x09, 0xcf, 0x03, 0x58, 0xa6,
-	0xc1, 0x72, 0x7f, 0x02, 0x92, 0xef, 0x07, 0x31, 0x64, 0x50, 0x24, 0x85, 0x3f, 0xcf, 0x85, 0x14,
-	0x8c, 0x29, 0xc2, 0x87, 0x65, 0xea, 0x6b, 0xc2, 0xbd, 0xa3, 0x55, 0x0b, 0x99, 0x9c, 0x14, 0x5b,
-	0xdd, 0xc7, 0x05, 0x2c, 0x20, 0x57, 0x32, 0xf7, 0x46, 0x2c, 0x62, 0x81, 0xc7, 0xa0, 0x3c, 0xe9,
-	0xdb, 0x5e, 0xcd, 0x73, 0x73, 0x9e, 0xf3, 0x54, 0xbf, 0xe6, 0x7a, 0x35, 0x80, 0x3c, 0x9f, 0x83,
-	0x9e, 0xdf, 0xfd, 0x45, 0x68, 0xe7, 0xb9, 0xca, 0xf7, 0x56, 0x72, 0x09, 0xec, 0x29, 0x35, 0xa3,
-	0x19, 0x4f, 0xb2, 0xc2, 0x69, 0xf6, 0x9b, 0x83, 0xf6, 0xf0, 0x9e, 0xff, 0x77, 0x5e, 0xff, 0xb2,
-	0xc2, 0x1f, 0x97, 0xf8, 0x88, 0xac, 0xbe, 0xf7, 0x1a, 0x07, 0x5a, 0xeb, 0x7e, 0x21, 0xb4, 0x85,
-	0xf7, 0xec, 0x11, 0x35, 0x55, 0x20, 0xc7, 0xe8, 0x1b, 0x83, 0xf6, 0xd0, 0xad, 0xf3, 0x7b, 0x8d,
-	0x44, 0xe5, 0xa1, 0x78, 0xf6, 0x82, 0xee, 0x44, 0x22, 0x4d, 0x79, 0x36, 0x3d, 0xc2, 0x26, 0x9c,
-	0x26, 0x1a, 0xf4, 0x2b, 0x03, 0x2c, 0x6b, 0x6b, 0xf1, 0xa6, 0x44, 0x30, 0x90, 0xb6, 0xe9, 0x68,
-	0x31, 0x0e, 0xd8, 0x7b, 0xba, 0x5b, 0x99, 0x4d, 0xb8, 0x8c, 0x66, 0x50, 0x38, 0x16, 0xfe, 0xdf,
-	0xa0, 0x2e, 0xcf, 0x58, 0xa1, 0xa3, 0x92, 0x7c, 0x09, 0x92, 0x4f, 0xb9, 0xe4, 0xda, 0xb6, 0x1b,
-	0x5d, 0x9a, 0x41, 0xc1, 0x9e, 0xd0, 0xab, 0x31, 0x97, 0x70, 0xca, 0xcf, 0x9d, 0x6b, 0x98, 0xef,
-	0x76, 0x6d, 0x61, 0x0a, 0xd1, 0x1e, 0x95, 0xa2, 0x2c, 0x5b, 0x8a, 0x63, 0xc8, 0x0a, 0x87, 0xfe,
-	0xbb, 0xec, 0x67, 0x07, 0xe3, 0xe1, 0xde, 0xbb, 0x12, 0xfb, 0x23, 0x8a, 0xd6, 0xb2, 0x87, 0xd4,
-	0x84, 0x25, 0x64, 0xb2, 0x70, 0xda, 0xe8, 0x72, 0xab, 0xd6, 0xa5, 0x24, 0x2a, 0xa1, 0xc2, 0xd9,
-	0x21, 0xbd, 0x19, 0x89, 0xec, 0x43, 0x92, 0xa7, 0x30, 0x3d, 0xc2, 0x3b, 0xdd, 0x74, 0xe7, 0xbf,
-	0x9a, 0xbe, 0xbe, 0x35, 0xc1, 0x47, 0x70, 0x1e, 0x12, 0xeb, 0x8a, 0xdd, 0x0c, 0x89, 0x45, 0xec,
-	0x56, 0x48, 0xac, 0x96, 0x6d, 0x86, 0xc4, 0xda, 0xb1, 0xbb, 0x21, 0xb1, 0xba, 0xf6, 0xee, 0x63,
-	0xf2, 0xf9, 0x6b, 0xcf, 0x18, 0xbd, 0x5a, 0xfd, 0xf4, 0x1a, 0xab, 0xb5, 0x67, 0x5c, 0xac, 0x3d,
-	0xe3, 0xc7, 0xda, 0x33, 0x3e, 0x6d, 0xbc, 0xc6, 0xc5, 0xc6, 0x6b, 0x7c, 0xdb, 0x78, 0x8d, 0xc3,
-	0xbd, 0x38, 0x91, 0xb3, 0xc5, 0xc4, 0x8f, 0x44, 0x1a, 0xa8, 0x20, 0x19, 0xc8, 0x53, 0x91, 0x1f,
-	0xeb, 0xaf, 0xfb, 0x91, 0xc8, 0x21, 0x38, 0xc3, 0xd5, 0xc6, 0x95, 0x9e, 0x98, 0xb8, 0xd3, 0x0f,
-	0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x86, 0x04, 0x24, 0x85, 0x03, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc5, 0x13, 0x9b, 0xc6, 0x38, 0xdb, 0x6d, 0xe3, 0xa8, 0x10, 0x23, 0xa4, 0x55, 0x44, 0x7a,
+	0x31, 0xd9, 0xad, 0x07, 0x45, 0x6f, 0xad, 0x8b, 0x10, 0x51, 0x74, 0x15, 0xc4, 0xbd, 0x2c, 0xd3,
+	0xf4, 0x6f, 0x1a, 0x76, 0x93, 0xa9, 0x99, 0x49, 0xb7, 0xfb, 0x2d, 0x3c, 0x7a, 0xf5, 0xdb, 0xf4,
+	0xb8, 0x17, 0xc1, 0x93, 0x68, 0xfb, 0x25, 0x3c, 0x4a, 0x66, 0x26, 0x65, 0x59, 0xa3, 0xe0, 0x6d,
+	0xf2, 0x9f, 0xdf, 0x7b, 0xf3, 0xf2, 0xe0, 0x8f, 0x7a, 0x64, 0x01, 0xc7, 0x24, 0x0f, 0x60, 0x9e,
+	0x06, 0xf3, 0xdd, 0x31, 0x70, 0xb2, 0x1b, 0xc4, 0x90, 0x01, 0x4b, 0x98, 0x3f, 0xcb, 0x29, 0xa7,
+	0x18, 0x4b, 0xc2, 0x87, 0x79, 0xea, 0x2b, 0xc2, 0xbd, 0xad, 0x54, 0x05, 0x4f, 0x8e, 0xd9, 0x46,
+	0xf7, 0xb1, 0x80, 0x02, 0x72, 0x29, 0x73, 0xaf, 0xc7, 0x34, 0xa6, 0xe2, 0x18, 0x94, 0x27, 0x35,
+	0xed, 0xd6, 0x3c, 0x37, 0x23, 0x39, 0x49, 0xd5, 0x6b, 0xae, 0x57, 0x03, 0xf0, 0xd3, 0x19, 0xa8,
+	0xfb, 0x3b, 0x5f, 0x9b, 0xa8, 0xf5, 0x4c, 0xe6, 0x7b, 0xc3, 0x09, 0x07, 0xfc, 0x14, 0x99, 0xd1,
+	0x94, 0x24, 0x19, 0x73, 0x1a, 0xbd, 0x46, 0x7f, 0x6b, 0x70, 0xcf, 0xff, 0x33, 0xaf, 0x7f, 0x5e,
+	0xe1, 0x8f, 0x4a, 0x7c, 0x68, 0x2c, 0xbf, 0x77, 0xb5, 0x7d, 0xa5, 0x75, 0x7f, 0x19, 0xa8, 0x29,
+	0xe6, 0xf8, 0x11, 0x32, 0x65, 0x20, 0x47, 0xef, 0xe9, 0xfd, 0xad, 0x81, 0x5b, 0xe7, 0xf7, 0x4a,
+	0x10, 0x95, 0x87, 0xe4, 0xf1, 0x73, 0xb4, 0x1d, 0xd1, 0x34, 0x25, 0xd9, 0xe4, 0x50, 0x34, 0xe1,
+	0x34, 0x84, 0x41, 0xaf, 0x32, 0x10, 0x65, 0x6d, 0x2c, 0x5e, 0x97, 0x88, 0x08, 0xa4, 0x6c, 0x5a,
+	0x4a, 0x2c, 0x2e, 0xf0, 0x3b, 0xd4, 0xa9, 0xcc, 0xc6, 0x84, 0x47, 0x53, 0x60, 0x8e, 0x25, 0xfe,
+	0xaf, 0x5f, 0x97, 0x67, 0x24, 0xd1, 0x61, 0x49, 0xbe, 0x00, 0x4e, 0x26, 0x84, 0x13, 0x65, 0xdb,
+	0x8e, 0xce, 0xdd, 0x01, 0xc3, 0x4f, 0xd0, 0xe5, 0x98, 0x70, 0x38, 0x21, 0xa7, 0xce, 0x15, 0x91,
+	0xef, 0x56, 0x6d, 0x61, 0x12, 0x51, 0x1e, 0x95, 0xa2, 0x2c, 0x9b, 0xd3, 0x23, 0xc8, 0x98, 0x83,
+	0xfe, 0x5e, 0xf6, 0xde, 0xfe, 0x68, 0xb0, 0xf3, 0xb6, 0xc4, 0x2e, 0x44, 0x51, 0x5a, 0xfc, 0x10,
+	0x99, 0x30, 0x87, 0x8c, 0x33, 0x67, 0x4b, 0xb8, 0xdc, 0xac, 0x75, 0x29, 0x89, 0x4a, 0x28, 0x71,
+	0x7c, 0x80, 0x6e, 0x44, 0x34, 0xfb, 0x90, 0xe4, 0x29, 0x4c, 0x0e, 0xc5, 0x4c, 0x35, 0xdd, 0xfa,
+	0xaf, 0xa6, 0xaf, 0x6d, 0x4c, 0xc4, 0x23, 0xb2, 0xf0, 0xf7, 0x08, 0x57, 0x85, 0xc3, 0x02, 0xa2,
+	0x82, 0x27, 0x34, 0x63, 0x4e, 0x47, 0x04, 0xbc, 0xfb, 0x8f, 0xce, 0xf7, 0x2a, 0x58, 0x99, 0x5f,
+	0x8d, 0x2e, 0xcc, 0x59, 0x68, 0x58, 0x97, 0xec, 0x46, 0x68, 0x58, 0x86, 0xdd, 0x0c, 0x0d, 0xab,
+	0x69, 0x9b, 0xa1, 0x61, 0x6d, 0xdb, 0xed, 0xd0, 0xb0, 0xda, 0x76, 0xe7, 0xb1, 0xf1, 0xf9, 0x4b,
+	0x57, 0x1f, 0xbe, 0x5c, 0xfe, 0xf4, 0xb4, 0xe5, 0xca, 0xd3, 0xcf, 0x56, 0x9e, 0xfe, 0x63, 0xe5,
+	0xe9, 0x9f, 0xd6, 0x9e, 0x76, 0xb6, 0xf6, 0xb4, 0x6f, 0x6b, 0x4f, 0x3b, 0xd8, 0x89, 0x13, 0x3e,
+	0x2d, 0xc6, 0x7e, 0x44, 0xd3, 0x40, 0x46, 0xc9, 0x80, 0x9f, 0xd0, 0xfc, 0x48, 0x7d, 0xdd, 0x8f,
+	0x68, 0x0e, 0xc1, 0x42, 0x6c, 0x8d, 0xd8, 0x96, 0xb1, 0x29, 0xd6, 0xe5, 0xc1, 0xef, 0x01, 0x00,
+	0xb4, 0x91, 0xcd, 0xa7, 0xe0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +202,20 @@ func (m *GenesisState_Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommandExecutions) > 0 {
+		for iNdEx := len(m.CommandExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommandExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size, err := m.ConfirmedEventQueue.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -342,6 +359,12 @@ func (m *GenesisState_Chain) Size() (n int) {
 	}
 	l = m.ConfirmedEventQueue.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CommandExecutions) > 0 {
+		for _, e := range m.CommandExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -698,6 +721,40 @@ func (m *GenesisState_Chain) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandExecutions = append(m.CommandExecutions, CommandExecution{})
+			if err := m.CommandExecutions[len(m.CommandExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
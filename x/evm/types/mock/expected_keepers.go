@@ -10,6 +10,7 @@ import (
 	utils "github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/x/evm/types"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	github_com_axelarnetwork_axelar_core_x_multisig_types "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	permission "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
@@ -145,6 +146,9 @@ var _ types.Nexus = &NexusMock{}
 //			IsChainActivatedFunc: func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain) bool {
 //				panic("mock out the IsChainActivated method")
 //			},
+//			RecordChainPollFunc: func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, failed bool)  {
+//				panic("mock out the RecordChainPoll method")
+//			},
 //			RegisterAssetFunc: func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error {
 //				panic("mock out the RegisterAsset method")
 //			},
@@ -157,7 +161,7 @@ var _ types.Nexus = &NexusMock{}
 //			SetMessageExecutedFunc: func(ctx sdk.Context, id string) error {
 //				panic("mock out the SetMessageExecuted method")
 //			},
-//			SetMessageFailedFunc: func(ctx sdk.Context, id string) error {
+//			SetMessageFailedFunc: func(ctx sdk.Context, id string, reason string) error {
 //				panic("mock out the SetMessageFailed method")
 //			},
 //			SetNewMessageFunc: func(ctx sdk.Context, m github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error {
@@ -212,6 +216,9 @@ type NexusMock struct {
 	// IsChainActivatedFunc mocks the IsChainActivated method.
 	IsChainActivatedFunc func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain) bool
 
+	// RecordChainPollFunc mocks the RecordChainPoll method.
+	RecordChainPollFunc func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, failed bool)
+
 	// RegisterAssetFunc mocks the RegisterAsset method.
 	RegisterAssetFunc func(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error
 
@@ -225,7 +232,7 @@ type NexusMock struct {
 	SetMessageExecutedFunc func(ctx sdk.Context, id string) error
 
 	// SetMessageFailedFunc mocks the SetMessageFailed method.
-	SetMessageFailedFunc func(ctx sdk.Context, id string) error
+	SetMessageFailedFunc func(ctx sdk.Context, id string, reason string) error
 
 	// SetNewMessageFunc mocks the SetNewMessage method.
 	SetNewMessageFunc func(ctx sdk.Context, m github_com_axelarnetwork_axelar_core_x_nexus_exported.GeneralMessage) error
@@ -346,6 +353,15 @@ type NexusMock struct {
 			// Chain is the chain argument value.
 			Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain
 		}
+		// RecordChainPoll holds details about calls to the RecordChainPoll method.
+		RecordChainPoll []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Chain is the chain argument value.
+			Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+			// Failed is the failed argument value.
+			Failed bool
+		}
 		// RegisterAsset holds details about calls to the RegisterAsset method.
 		RegisterAsset []struct {
 			// Ctx is the ctx argument value.
@@ -382,6 +398,8 @@ type NexusMock struct {
 			Ctx sdk.Context
 			// ID is the id argument value.
 			ID string
+			// Reason is the reason argument value.
+			Reason string
 		}
 		// SetNewMessage holds details about calls to the SetNewMessage method.
 		SetNewMessage []struct {
@@ -405,6 +423,7 @@ type NexusMock struct {
 	lockGetTransfersForChainPaginated sync.RWMutex
 	lockIsAssetRegistered             sync.RWMutex
 	lockIsChainActivated              sync.RWMutex
+	lockRecordChainPoll               sync.RWMutex
 	lockRegisterAsset                 sync.RWMutex
 	lockSetChain                      sync.RWMutex
 	lockSetChainMaintainerState       sync.RWMutex
@@ -949,6 +968,46 @@ func (mock *NexusMock) IsChainActivatedCalls() []struct {
 	return calls
 }
 
+// RecordChainPoll calls RecordChainPollFunc.
+func (mock *NexusMock) RecordChainPoll(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, failed bool) {
+	if mock.RecordChainPollFunc == nil {
+		panic("NexusMock.RecordChainPollFunc: method is nil but Nexus.RecordChainPoll was just called")
+	}
+	callInfo := struct {
+		Ctx    sdk.Context
+		Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Failed bool
+	}{
+		Ctx:    ctx,
+		Chain:  chain,
+		Failed: failed,
+	}
+	mock.lockRecordChainPoll.Lock()
+	mock.calls.RecordChainPoll = append(mock.calls.RecordChainPoll, callInfo)
+	mock.lockRecordChainPoll.Unlock()
+	mock.RecordChainPollFunc(ctx, chain, failed)
+}
+
+// RecordChainPollCalls gets all the calls that were made to RecordChainPoll.
+// Check the length with:
+//
+//	len(mockedNexus.RecordChainPollCalls())
+func (mock *NexusMock) RecordChainPollCalls() []struct {
+	Ctx    sdk.Context
+	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	Failed bool
+} {
+	var calls []struct {
+		Ctx    sdk.Context
+		Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Failed bool
+	}
+	mock.lockRecordChainPoll.RLock()
+	calls = mock.calls.RecordChainPoll
+	mock.lockRecordChainPoll.RUnlock()
+	return calls
+}
+
 // RegisterAsset calls RegisterAssetFunc.
 func (mock *NexusMock) RegisterAsset(ctx sdk.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, asset github_com_axelarnetwork_axelar_core_x_nexus_exported.Asset) error {
 	if mock.RegisterAssetFunc == nil {
@@ -1098,21 +1157,23 @@ func (mock *NexusMock) SetMessageExecutedCalls() []struct {
 }
 
 // SetMessageFailed calls SetMessageFailedFunc.
-func (mock *NexusMock) SetMessageFailed(ctx sdk.Context, id string) error {
+func (mock *NexusMock) SetMessageFailed(ctx sdk.Context, id string, reason string) error {
 	if mock.SetMessageFailedFunc == nil {
 		panic("NexusMock.SetMessageFailedFunc: method is nil but Nexus.SetMessageFailed was just called")
 	}
 	callInfo := struct {
-		Ctx sdk.Context
-		ID  string
+		Ctx    sdk.Context
+		ID     string
+		Reason string
 	}{
-		Ctx: ctx,
-		ID:  id,
+		Ctx:    ctx,
+		ID:     id,
+		Reason: reason,
 	}
 	mock.lockSetMessageFailed.Lock()
 	mock.calls.SetMessageFailed = append(mock.calls.SetMessageFailed, callInfo)
 	mock.lockSetMessageFailed.Unlock()
-	return mock.SetMessageFailedFunc(ctx, id)
+	return mock.SetMessageFailedFunc(ctx, id, reason)
 }
 
 // SetMessageFailedCalls gets all the calls that were made to SetMessageFailed.
@@ -1120,12 +1181,14 @@ func (mock *NexusMock) SetMessageFailed(ctx sdk.Context, id string) error {
 //
 //	len(mockedNexus.SetMessageFailedCalls())
 func (mock *NexusMock) SetMessageFailedCalls() []struct {
-	Ctx sdk.Context
-	ID  string
+	Ctx    sdk.Context
+	ID     string
+	Reason string
 } {
 	var calls []struct {
-		Ctx sdk.Context
-		ID  string
+		Ctx    sdk.Context
+		ID     string
+		Reason string
 	}
 	mock.lockSetMessageFailed.RLock()
 	calls = mock.calls.SetMessageFailed
@@ -1179,8 +1242,8 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //		// make and configure a mocked types.Snapshotter
 //		mockedSnapshotter := &SnapshotterMock{
-//			CreateSnapshotFunc: func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (snapshot.Snapshot, error) {
-//				panic("mock out the CreateSnapshot method")
+//			CreateWeightedSnapshotFunc: func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, threshold utils.Threshold) (snapshot.Snapshot, error) {
+//				panic("mock out the CreateWeightedSnapshot method")
 //			},
 //			GetProxyFunc: func(ctx sdk.Context, principal sdk.ValAddress) (sdk.AccAddress, bool) {
 //				panic("mock out the GetProxy method")
@@ -1192,24 +1255,22 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //	}
 type SnapshotterMock struct {
-	// CreateSnapshotFunc mocks the CreateSnapshot method.
-	CreateSnapshotFunc func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (snapshot.Snapshot, error)
+	// CreateWeightedSnapshotFunc mocks the CreateWeightedSnapshot method.
+	CreateWeightedSnapshotFunc func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, threshold utils.Threshold) (snapshot.Snapshot, error)
 
 	// GetProxyFunc mocks the GetProxy method.
 	GetProxyFunc func(ctx sdk.Context, principal sdk.ValAddress) (sdk.AccAddress, bool)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// CreateSnapshot holds details about calls to the CreateSnapshot method.
-		CreateSnapshot []struct {
+		// CreateWeightedSnapshot holds details about calls to the CreateWeightedSnapshot method.
+		CreateWeightedSnapshot []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 			// Candidates is the candidates argument value.
 			Candidates []sdk.ValAddress
 			// FilterFunc is the filterFunc argument value.
 			FilterFunc func(snapshot.ValidatorI) bool
-			// WeightFunc is the weightFunc argument value.
-			WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 			// Threshold is the threshold argument value.
 			Threshold utils.Threshold
 		}
@@ -1221,55 +1282,51 @@ type SnapshotterMock struct {
 			Principal sdk.ValAddress
 		}
 	}
-	lockCreateSnapshot sync.RWMutex
-	lockGetProxy       sync.RWMutex
+	lockCreateWeightedSnapshot sync.RWMutex
+	lockGetProxy               sync.RWMutex
 }
 
-// CreateSnapshot calls CreateSnapshotFunc.
-func (mock *SnapshotterMock) CreateSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (snapshot.Snapshot, error) {
-	if mock.CreateSnapshotFunc == nil {
-		panic("SnapshotterMock.CreateSnapshotFunc: method is nil but Snapshotter.CreateSnapshot was just called")
+// CreateWeightedSnapshot calls CreateWeightedSnapshotFunc.
+func (mock *SnapshotterMock) CreateWeightedSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(snapshot.ValidatorI) bool, threshold utils.Threshold) (snapshot.Snapshot, error) {
+	if mock.CreateWeightedSnapshotFunc == nil {
+		panic("SnapshotterMock.CreateWeightedSnapshotFunc: method is nil but Snapshotter.CreateWeightedSnapshot was just called")
 	}
 	callInfo := struct {
 		Ctx        sdk.Context
 		Candidates []sdk.ValAddress
 		FilterFunc func(snapshot.ValidatorI) bool
-		WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 		Threshold  utils.Threshold
 	}{
 		Ctx:        ctx,
 		Candidates: candidates,
 		FilterFunc: filterFunc,
-		WeightFunc: weightFunc,
 		Threshold:  threshold,
 	}
-	mock.lockCreateSnapshot.Lock()
-	mock.calls.CreateSnapshot = append(mock.calls.CreateSnapshot, callInfo)
-	mock.lockCreateSnapshot.Unlock()
-	return mock.CreateSnapshotFunc(ctx, candidates, filterFunc, weightFunc, threshold)
+	mock.lockCreateWeightedSnapshot.Lock()
+	mock.calls.CreateWeightedSnapshot = append(mock.calls.CreateWeightedSnapshot, callInfo)
+	mock.lockCreateWeightedSnapshot.Unlock()
+	return mock.CreateWeightedSnapshotFunc(ctx, candidates, filterFunc, threshold)
 }
 
-// CreateSnapshotCalls gets all the calls that were made to CreateSnapshot.
+// CreateWeightedSnapshotCalls gets all the calls that were made to CreateWeightedSnapshot.
 // Check the length with:
 //
-//	len(mockedSnapshotter.CreateSnapshotCalls())
-func (mock *SnapshotterMock) CreateSnapshotCalls() []struct {
+//	len(mockedSnapshotter.CreateWeightedSnapshotCalls())
+func (mock *SnapshotterMock) CreateWeightedSnapshotCalls() []struct {
 	Ctx        sdk.Context
 	Candidates []sdk.ValAddress
 	FilterFunc func(snapshot.ValidatorI) bool
-	WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 	Threshold  utils.Threshold
 } {
 	var calls []struct {
 		Ctx        sdk.Context
 		Candidates []sdk.ValAddress
 		FilterFunc func(snapshot.ValidatorI) bool
-		WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 		Threshold  utils.Threshold
 	}
-	mock.lockCreateSnapshot.RLock()
-	calls = mock.calls.CreateSnapshot
-	mock.lockCreateSnapshot.RUnlock()
+	mock.lockCreateWeightedSnapshot.RLock()
+	calls = mock.calls.CreateWeightedSnapshot
+	mock.lockCreateWeightedSnapshot.RUnlock()
 	return calls
 }
 
@@ -1503,6 +1560,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //			GetBatchByIDFunc: func(ctx sdk.Context, id []byte) types.CommandBatch {
 //				panic("mock out the GetBatchByID method")
 //			},
+//			GetBatchIDByCommandIDFunc: func(ctx sdk.Context, id types.CommandID) ([]byte, bool) {
+//				panic("mock out the GetBatchIDByCommandID method")
+//			},
 //			GetBurnerByteCodeFunc: func(ctx sdk.Context) []byte {
 //				panic("mock out the GetBurnerByteCode method")
 //			},
@@ -1515,6 +1575,12 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //			GetCommandFunc: func(ctx sdk.Context, id types.CommandID) (types.Command, bool) {
 //				panic("mock out the GetCommand method")
 //			},
+//			GetCommandExecutionFunc: func(ctx sdk.Context, id types.CommandID) (types.CommandExecution, bool) {
+//				panic("mock out the GetCommandExecution method")
+//			},
+//			GetCommandExecutionsPaginatedFunc: func(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.CommandExecution, *query.PageResponse, error) {
+//				panic("mock out the GetCommandExecutionsPaginated method")
+//			},
 //			GetConfirmedEventQueueFunc: func(ctx sdk.Context) utils.KVQueue {
 //				panic("mock out the GetConfirmedEventQueue method")
 //			},
@@ -1560,6 +1626,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //			GetRevoteLockingPeriodFunc: func(ctx sdk.Context) int64 {
 //				panic("mock out the GetRevoteLockingPeriod method")
 //			},
+//			GetSVMGatewayProgramIDFunc: func(ctx sdk.Context) (string, bool) {
+//				panic("mock out the GetSVMGatewayProgramID method")
+//			},
 //			GetTokenByteCodeFunc: func(ctx sdk.Context) []byte {
 //				panic("mock out the GetTokenByteCode method")
 //			},
@@ -1575,6 +1644,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //			RetryEventFunc: func(ctx sdk.Context, eventID types.EventID) error {
 //				panic("mock out the RetryEvent method")
 //			},
+//			SetCommandExecutedFunc: func(ctx sdk.Context, id types.CommandID, txID types.Hash) error {
+//				panic("mock out the SetCommandExecuted method")
+//			},
 //			SetConfirmedEventFunc: func(ctx sdk.Context, event types.Event) error {
 //				panic("mock out the SetConfirmedEvent method")
 //			},
@@ -1593,6 +1665,9 @@ var _ types.ChainKeeper = &ChainKeeperMock{}
 //			SetParamsFunc: func(ctx sdk.Context, params types.Params)  {
 //				panic("mock out the SetParams method")
 //			},
+//			SetSVMGatewayFunc: func(ctx sdk.Context, programID string)  {
+//				panic("mock out the SetSVMGateway method")
+//			},
 //		}
 //
 //		// use mockedChainKeeper in code that requires types.ChainKeeper
@@ -1618,6 +1693,9 @@ type ChainKeeperMock struct {
 	// GetBatchByIDFunc mocks the GetBatchByID method.
 	GetBatchByIDFunc func(ctx sdk.Context, id []byte) types.CommandBatch
 
+	// GetBatchIDByCommandIDFunc mocks the GetBatchIDByCommandID method.
+	GetBatchIDByCommandIDFunc func(ctx sdk.Context, id types.CommandID) ([]byte, bool)
+
 	// GetBurnerByteCodeFunc mocks the GetBurnerByteCode method.
 	GetBurnerByteCodeFunc func(ctx sdk.Context) []byte
 
@@ -1630,6 +1708,12 @@ type ChainKeeperMock struct {
 	// GetCommandFunc mocks the GetCommand method.
 	GetCommandFunc func(ctx sdk.Context, id types.CommandID) (types.Command, bool)
 
+	// GetCommandExecutionFunc mocks the GetCommandExecution method.
+	GetCommandExecutionFunc func(ctx sdk.Context, id types.CommandID) (types.CommandExecution, bool)
+
+	// GetCommandExecutionsPaginatedFunc mocks the GetCommandExecutionsPaginated method.
+	GetCommandExecutionsPaginatedFunc func(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.CommandExecution, *query.PageResponse, error)
+
 	// GetConfirmedEventQueueFunc mocks the GetConfirmedEventQueue method.
 	GetConfirmedEventQueueFunc func(ctx sdk.Context) utils.KVQueue
 
@@ -1675,6 +1759,9 @@ type ChainKeeperMock struct {
 	// GetRevoteLockingPeriodFunc mocks the GetRevoteLockingPeriod method.
 	GetRevoteLockingPeriodFunc func(ctx sdk.Context) int64
 
+	// GetSVMGatewayProgramIDFunc mocks the GetSVMGatewayProgramID method.
+	GetSVMGatewayProgramIDFunc func(ctx sdk.Context) (string, bool)
+
 	// GetTokenByteCodeFunc mocks the GetTokenByteCode method.
 	GetTokenByteCodeFunc func(ctx sdk.Context) []byte
 
@@ -1690,6 +1777,9 @@ type ChainKeeperMock struct {
 	// RetryEventFunc mocks the RetryEvent method.
 	RetryEventFunc func(ctx sdk.Context, eventID types.EventID) error
 
+	// SetCommandExecutedFunc mocks the SetCommandExecuted method.
+	SetCommandExecutedFunc func(ctx sdk.Context, id types.CommandID, txID types.Hash) error
+
 	// SetConfirmedEventFunc mocks the SetConfirmedEvent method.
 	SetConfirmedEventFunc func(ctx sdk.Context, event types.Event) error
 
@@ -1708,6 +1798,9 @@ type ChainKeeperMock struct {
 	// SetParamsFunc mocks the SetParams method.
 	SetParamsFunc func(ctx sdk.Context, params types.Params)
 
+	// SetSVMGatewayFunc mocks the SetSVMGateway method.
+	SetSVMGatewayFunc func(ctx sdk.Context, programID string)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CreateERC20Token holds details about calls to the CreateERC20Token method.
@@ -1752,6 +1845,13 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID []byte
 		}
+		// GetBatchIDByCommandID holds details about calls to the GetBatchIDByCommandID method.
+		GetBatchIDByCommandID []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Id is the id argument value.
+			Id types.CommandID
+		}
 		// GetBurnerByteCode holds details about calls to the GetBurnerByteCode method.
 		GetBurnerByteCode []struct {
 			// Ctx is the ctx argument value.
@@ -1776,6 +1876,20 @@ type ChainKeeperMock struct {
 			// ID is the id argument value.
 			ID types.CommandID
 		}
+		// GetCommandExecution holds details about calls to the GetCommandExecution method.
+		GetCommandExecution []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Id is the id argument value.
+			Id types.CommandID
+		}
+		// GetCommandExecutionsPaginated holds details about calls to the GetCommandExecutionsPaginated method.
+		GetCommandExecutionsPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
 		// GetConfirmedEventQueue holds details about calls to the GetConfirmedEventQueue method.
 		GetConfirmedEventQueue []struct {
 			// Ctx is the ctx argument value.
@@ -1859,6 +1973,11 @@ type ChainKeeperMock struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 		}
+		// GetSVMGatewayProgramID holds details about calls to the GetSVMGatewayProgramID method.
+		GetSVMGatewayProgramID []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+		}
 		// GetTokenByteCode holds details about calls to the GetTokenByteCode method.
 		GetTokenByteCode []struct {
 			// Ctx is the ctx argument value.
@@ -1886,6 +2005,15 @@ type ChainKeeperMock struct {
 			// EventID is the eventID argument value.
 			EventID types.EventID
 		}
+		// SetCommandExecuted holds details about calls to the SetCommandExecuted method.
+		SetCommandExecuted []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Id is the id argument value.
+			Id types.CommandID
+			// TxID is the txID argument value.
+			TxID types.Hash
+		}
 		// SetConfirmedEvent holds details about calls to the SetConfirmedEvent method.
 		SetConfirmedEvent []struct {
 			// Ctx is the ctx argument value.
@@ -1928,6 +2056,13 @@ type ChainKeeperMock struct {
 			// Params is the params argument value.
 			Params types.Params
 		}
+		// SetSVMGateway holds details about calls to the SetSVMGateway method.
+		SetSVMGateway []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// ProgramID is the programID argument value.
+			ProgramID string
+		}
 	}
 	lockCreateERC20Token              sync.RWMutex
 	lockCreateNewBatchToSign          sync.RWMutex
@@ -1935,10 +2070,13 @@ type ChainKeeperMock struct {
 	lockEnqueueCommand                sync.RWMutex
 	lockEnqueueConfirmedEvent         sync.RWMutex
 	lockGetBatchByID                  sync.RWMutex
+	lockGetBatchIDByCommandID         sync.RWMutex
 	lockGetBurnerByteCode             sync.RWMutex
 	lockGetChainID                    sync.RWMutex
 	lockGetChainIDByNetwork           sync.RWMutex
 	lockGetCommand                    sync.RWMutex
+	lockGetCommandExecution           sync.RWMutex
+	lockGetCommandExecutionsPaginated sync.RWMutex
 	lockGetConfirmedEventQueue        sync.RWMutex
 	lockGetERC20TokenByAddress        sync.RWMutex
 	lockGetERC20TokenByAsset          sync.RWMutex
@@ -1954,17 +2092,20 @@ type ChainKeeperMock struct {
 	lockGetPendingCommands            sync.RWMutex
 	lockGetRequiredConfirmationHeight sync.RWMutex
 	lockGetRevoteLockingPeriod        sync.RWMutex
+	lockGetSVMGatewayProgramID        sync.RWMutex
 	lockGetTokenByteCode              sync.RWMutex
 	lockGetTokens                     sync.RWMutex
 	lockGetVotingThreshold            sync.RWMutex
 	lockLogger                        sync.RWMutex
 	lockRetryEvent                    sync.RWMutex
+	lockSetCommandExecuted            sync.RWMutex
 	lockSetConfirmedEvent             sync.RWMutex
 	lockSetEventCompleted             sync.RWMutex
 	lockSetEventFailed                sync.RWMutex
 	lockSetGateway                    sync.RWMutex
 	lockSetLatestSignedCommandBatchID sync.RWMutex
 	lockSetParams                     sync.RWMutex
+	lockSetSVMGateway                 sync.RWMutex
 }
 
 // CreateERC20Token calls CreateERC20TokenFunc.
@@ -2183,6 +2324,42 @@ func (mock *ChainKeeperMock) GetBatchByIDCalls() []struct {
 	return calls
 }
 
+// GetBatchIDByCommandID calls GetBatchIDByCommandIDFunc.
+func (mock *ChainKeeperMock) GetBatchIDByCommandID(ctx sdk.Context, id types.CommandID) ([]byte, bool) {
+	if mock.GetBatchIDByCommandIDFunc == nil {
+		panic("ChainKeeperMock.GetBatchIDByCommandIDFunc: method is nil but ChainKeeper.GetBatchIDByCommandID was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		Id  types.CommandID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetBatchIDByCommandID.Lock()
+	mock.calls.GetBatchIDByCommandID = append(mock.calls.GetBatchIDByCommandID, callInfo)
+	mock.lockGetBatchIDByCommandID.Unlock()
+	return mock.GetBatchIDByCommandIDFunc(ctx, id)
+}
+
+// GetBatchIDByCommandIDCalls gets all the calls that were made to GetBatchIDByCommandID.
+// Check the length with:
+//
+//	len(mockedChainKeeper.GetBatchIDByCommandIDCalls())
+func (mock *ChainKeeperMock) GetBatchIDByCommandIDCalls() []struct {
+	Ctx sdk.Context
+	Id  types.CommandID
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		Id  types.CommandID
+	}
+	mock.lockGetBatchIDByCommandID.RLock()
+	calls = mock.calls.GetBatchIDByCommandID
+	mock.lockGetBatchIDByCommandID.RUnlock()
+	return calls
+}
+
 // GetBurnerByteCode calls GetBurnerByteCodeFunc.
 func (mock *ChainKeeperMock) GetBurnerByteCode(ctx sdk.Context) []byte {
 	if mock.GetBurnerByteCodeFunc == nil {
@@ -2319,6 +2496,78 @@ func (mock *ChainKeeperMock) GetCommandCalls() []struct {
 	return calls
 }
 
+// GetCommandExecution calls GetCommandExecutionFunc.
+func (mock *ChainKeeperMock) GetCommandExecution(ctx sdk.Context, id types.CommandID) (types.CommandExecution, bool) {
+	if mock.GetCommandExecutionFunc == nil {
+		panic("ChainKeeperMock.GetCommandExecutionFunc: method is nil but ChainKeeper.GetCommandExecution was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		Id  types.CommandID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetCommandExecution.Lock()
+	mock.calls.GetCommandExecution = append(mock.calls.GetCommandExecution, callInfo)
+	mock.lockGetCommandExecution.Unlock()
+	return mock.GetCommandExecutionFunc(ctx, id)
+}
+
+// GetCommandExecutionCalls gets all the calls that were made to GetCommandExecution.
+// Check the length with:
+//
+//	len(mockedChainKeeper.GetCommandExecutionCalls())
+func (mock *ChainKeeperMock) GetCommandExecutionCalls() []struct {
+	Ctx sdk.Context
+	Id  types.CommandID
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		Id  types.CommandID
+	}
+	mock.lockGetCommandExecution.RLock()
+	calls = mock.calls.GetCommandExecution
+	mock.lockGetCommandExecution.RUnlock()
+	return calls
+}
+
+// GetCommandExecutionsPaginated calls GetCommandExecutionsPaginatedFunc.
+func (mock *ChainKeeperMock) GetCommandExecutionsPaginated(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.CommandExecution, *query.PageResponse, error) {
+	if mock.GetCommandExecutionsPaginatedFunc == nil {
+		panic("ChainKeeperMock.GetCommandExecutionsPaginatedFunc: method is nil but ChainKeeper.GetCommandExecutionsPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         sdk.Context
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetCommandExecutionsPaginated.Lock()
+	mock.calls.GetCommandExecutionsPaginated = append(mock.calls.GetCommandExecutionsPaginated, callInfo)
+	mock.lockGetCommandExecutionsPaginated.Unlock()
+	return mock.GetCommandExecutionsPaginatedFunc(ctx, pageRequest)
+}
+
+// GetCommandExecutionsPaginatedCalls gets all the calls that were made to GetCommandExecutionsPaginated.
+// Check the length with:
+//
+//	len(mockedChainKeeper.GetCommandExecutionsPaginatedCalls())
+func (mock *ChainKeeperMock) GetCommandExecutionsPaginatedCalls() []struct {
+	Ctx         sdk.Context
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         sdk.Context
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetCommandExecutionsPaginated.RLock()
+	calls = mock.calls.GetCommandExecutionsPaginated
+	mock.lockGetCommandExecutionsPaginated.RUnlock()
+	return calls
+}
+
 // GetConfirmedEventQueue calls GetConfirmedEventQueueFunc.
 func (mock *ChainKeeperMock) GetConfirmedEventQueue(ctx sdk.Context) utils.KVQueue {
 	if mock.GetConfirmedEventQueueFunc == nil {
@@ -2814,6 +3063,38 @@ func (mock *ChainKeeperMock) GetRevoteLockingPeriodCalls() []struct {
 	return calls
 }
 
+// GetSVMGatewayProgramID calls GetSVMGatewayProgramIDFunc.
+func (mock *ChainKeeperMock) GetSVMGatewayProgramID(ctx sdk.Context) (string, bool) {
+	if mock.GetSVMGatewayProgramIDFunc == nil {
+		panic("ChainKeeperMock.GetSVMGatewayProgramIDFunc: method is nil but ChainKeeper.GetSVMGatewayProgramID was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSVMGatewayProgramID.Lock()
+	mock.calls.GetSVMGatewayProgramID = append(mock.calls.GetSVMGatewayProgramID, callInfo)
+	mock.lockGetSVMGatewayProgramID.Unlock()
+	return mock.GetSVMGatewayProgramIDFunc(ctx)
+}
+
+// GetSVMGatewayProgramIDCalls gets all the calls that were made to GetSVMGatewayProgramID.
+// Check the length with:
+//
+//	len(mockedChainKeeper.GetSVMGatewayProgramIDCalls())
+func (mock *ChainKeeperMock) GetSVMGatewayProgramIDCalls() []struct {
+	Ctx sdk.Context
+} {
+	var calls []struct {
+		Ctx sdk.Context
+	}
+	mock.lockGetSVMGatewayProgramID.RLock()
+	calls = mock.calls.GetSVMGatewayProgramID
+	mock.lockGetSVMGatewayProgramID.RUnlock()
+	return calls
+}
+
 // GetTokenByteCode calls GetTokenByteCodeFunc.
 func (mock *ChainKeeperMock) GetTokenByteCode(ctx sdk.Context) []byte {
 	if mock.GetTokenByteCodeFunc == nil {
@@ -2978,6 +3259,46 @@ func (mock *ChainKeeperMock) RetryEventCalls() []struct {
 	return calls
 }
 
+// SetCommandExecuted calls SetCommandExecutedFunc.
+func (mock *ChainKeeperMock) SetCommandExecuted(ctx sdk.Context, id types.CommandID, txID types.Hash) error {
+	if mock.SetCommandExecutedFunc == nil {
+		panic("ChainKeeperMock.SetCommandExecutedFunc: method is nil but ChainKeeper.SetCommandExecuted was just called")
+	}
+	callInfo := struct {
+		Ctx  sdk.Context
+		Id   types.CommandID
+		TxID types.Hash
+	}{
+		Ctx:  ctx,
+		Id:   id,
+		TxID: txID,
+	}
+	mock.lockSetCommandExecuted.Lock()
+	mock.calls.SetCommandExecuted = append(mock.calls.SetCommandExecuted, callInfo)
+	mock.lockSetCommandExecuted.Unlock()
+	return mock.SetCommandExecutedFunc(ctx, id, txID)
+}
+
+// SetCommandExecutedCalls gets all the calls that were made to SetCommandExecuted.
+// Check the length with:
+//
+//	len(mockedChainKeeper.SetCommandExecutedCalls())
+func (mock *ChainKeeperMock) SetCommandExecutedCalls() []struct {
+	Ctx  sdk.Context
+	Id   types.CommandID
+	TxID types.Hash
+} {
+	var calls []struct {
+		Ctx  sdk.Context
+		Id   types.CommandID
+		TxID types.Hash
+	}
+	mock.lockSetCommandExecuted.RLock()
+	calls = mock.calls.SetCommandExecuted
+	mock.lockSetCommandExecuted.RUnlock()
+	return calls
+}
+
 // SetConfirmedEvent calls SetConfirmedEventFunc.
 func (mock *ChainKeeperMock) SetConfirmedEvent(ctx sdk.Context, event types.Event) error {
 	if mock.SetConfirmedEventFunc == nil {
@@ -3194,6 +3515,42 @@ func (mock *ChainKeeperMock) SetParamsCalls() []struct {
 	return calls
 }
 
+// SetSVMGateway calls SetSVMGatewayFunc.
+func (mock *ChainKeeperMock) SetSVMGateway(ctx sdk.Context, programID string) {
+	if mock.SetSVMGatewayFunc == nil {
+		panic("ChainKeeperMock.SetSVMGatewayFunc: method is nil but ChainKeeper.SetSVMGateway was just called")
+	}
+	callInfo := struct {
+		Ctx       sdk.Context
+		ProgramID string
+	}{
+		Ctx:       ctx,
+		ProgramID: programID,
+	}
+	mock.lockSetSVMGateway.Lock()
+	mock.calls.SetSVMGateway = append(mock.calls.SetSVMGateway, callInfo)
+	mock.lockSetSVMGateway.Unlock()
+	mock.SetSVMGatewayFunc(ctx, programID)
+}
+
+// SetSVMGatewayCalls gets all the calls that were made to SetSVMGateway.
+// Check the length with:
+//
+//	len(mockedChainKeeper.SetSVMGatewayCalls())
+func (mock *ChainKeeperMock) SetSVMGatewayCalls() []struct {
+	Ctx       sdk.Context
+	ProgramID string
+} {
+	var calls []struct {
+		Ctx       sdk.Context
+		ProgramID string
+	}
+	mock.lockSetSVMGateway.RLock()
+	calls = mock.calls.SetSVMGateway
+	mock.lockSetSVMGateway.RUnlock()
+	return calls
+}
+
 // Ensure, that RewarderMock does implement types.Rewarder.
 // If this is not the case, regenerate this file with moq.
 var _ types.Rewarder = &RewarderMock{}
@@ -3426,10 +3783,13 @@ var _ types.MultisigKeeper = &MultisigKeeperMock{}
 //			GetNextKeyIDFunc: func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, bool) {
 //				panic("mock out the GetNextKeyID method")
 //			},
+//			GetSigningSessionFunc: func(ctx sdk.Context, id uint64) (github_com_axelarnetwork_axelar_core_x_multisig_types.SigningSession, bool) {
+//				panic("mock out the GetSigningSession method")
+//			},
 //			RotateKeyFunc: func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) error {
 //				panic("mock out the RotateKey method")
 //			},
-//			SignFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error {
+//			SignFunc: func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error) {
 //				panic("mock out the Sign method")
 //			},
 //		}
@@ -3451,11 +3811,14 @@ type MultisigKeeperMock struct {
 	// GetNextKeyIDFunc mocks the GetNextKeyID method.
 	GetNextKeyIDFunc func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, bool)
 
+	// GetSigningSessionFunc mocks the GetSigningSession method.
+	GetSigningSessionFunc func(ctx sdk.Context, id uint64) (github_com_axelarnetwork_axelar_core_x_multisig_types.SigningSession, bool)
+
 	// RotateKeyFunc mocks the RotateKey method.
 	RotateKeyFunc func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) error
 
 	// SignFunc mocks the Sign method.
-	SignFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error
+	SignFunc func(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -3489,6 +3852,13 @@ type MultisigKeeperMock struct {
 			// ChainName is the chainName argument value.
 			ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
 		}
+		// GetSigningSession holds details about calls to the GetSigningSession method.
+		GetSigningSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Id is the id argument value.
+			Id uint64
+		}
 		// RotateKey holds details about calls to the RotateKey method.
 		RotateKey []struct {
 			// Ctx is the ctx argument value.
@@ -3510,12 +3880,13 @@ type MultisigKeeperMock struct {
 			ModuleMetadata []codec.ProtoMarshaler
 		}
 	}
-	lockAssignKey       sync.RWMutex
-	lockGetCurrentKeyID sync.RWMutex
-	lockGetKey          sync.RWMutex
-	lockGetNextKeyID    sync.RWMutex
-	lockRotateKey       sync.RWMutex
-	lockSign            sync.RWMutex
+	lockAssignKey         sync.RWMutex
+	lockGetCurrentKeyID   sync.RWMutex
+	lockGetKey            sync.RWMutex
+	lockGetNextKeyID      sync.RWMutex
+	lockGetSigningSession sync.RWMutex
+	lockRotateKey         sync.RWMutex
+	lockSign              sync.RWMutex
 }
 
 // AssignKey calls AssignKeyFunc.
@@ -3666,6 +4037,42 @@ func (mock *MultisigKeeperMock) GetNextKeyIDCalls() []struct {
 	return calls
 }
 
+// GetSigningSession calls GetSigningSessionFunc.
+func (mock *MultisigKeeperMock) GetSigningSession(ctx sdk.Context, id uint64) (github_com_axelarnetwork_axelar_core_x_multisig_types.SigningSession, bool) {
+	if mock.GetSigningSessionFunc == nil {
+		panic("MultisigKeeperMock.GetSigningSessionFunc: method is nil but MultisigKeeper.GetSigningSession was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		Id  uint64
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetSigningSession.Lock()
+	mock.calls.GetSigningSession = append(mock.calls.GetSigningSession, callInfo)
+	mock.lockGetSigningSession.Unlock()
+	return mock.GetSigningSessionFunc(ctx, id)
+}
+
+// GetSigningSessionCalls gets all the calls that were made to GetSigningSession.
+// Check the length with:
+//
+//	len(mockedMultisigKeeper.GetSigningSessionCalls())
+func (mock *MultisigKeeperMock) GetSigningSessionCalls() []struct {
+	Ctx sdk.Context
+	Id  uint64
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		Id  uint64
+	}
+	mock.lockGetSigningSession.RLock()
+	calls = mock.calls.GetSigningSession
+	mock.lockGetSigningSession.RUnlock()
+	return calls
+}
+
 // RotateKey calls RotateKeyFunc.
 func (mock *MultisigKeeperMock) RotateKey(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) error {
 	if mock.RotateKeyFunc == nil {
@@ -3703,7 +4110,7 @@ func (mock *MultisigKeeperMock) RotateKeyCalls() []struct {
 }
 
 // Sign calls SignFunc.
-func (mock *MultisigKeeperMock) Sign(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error {
+func (mock *MultisigKeeperMock) Sign(ctx sdk.Context, keyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, payloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error) {
 	if mock.SignFunc == nil {
 		panic("MultisigKeeperMock.SignFunc: method is nil but MultisigKeeper.Sign was just called")
 	}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/slices"
+)
+
+// NewConfirmSVMGatewayTxsRequest creates a message of type ConfirmSVMGatewayTxsRequest
+func NewConfirmSVMGatewayTxsRequest(sender sdk.AccAddress, chain nexus.ChainName, txSignatures []string) *ConfirmSVMGatewayTxsRequest {
+	return &ConfirmSVMGatewayTxsRequest{
+		Sender:       sender.String(),
+		Chain:        chain,
+		TxSignatures: txSignatures,
+	}
+}
+
+// Route implements sdk.Msg
+func (m ConfirmSVMGatewayTxsRequest) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (m ConfirmSVMGatewayTxsRequest) Type() string {
+	return "ConfirmSVMGatewayTxs"
+}
+
+// ValidateBasic implements sdk.Msg
+func (m ConfirmSVMGatewayTxsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, errorsmod.Wrap(err, "sender").Error())
+	}
+
+	if err := m.Chain.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid chain")
+	}
+
+	if len(m.TxSignatures) == 0 {
+		return errors.New("tx signatures cannot be empty")
+	}
+
+	if len(m.TxSignatures) > TxLimit {
+		return errors.New("tx signatures limit exceeded")
+	}
+
+	for _, signature := range m.TxSignatures {
+		if err := ValidateSVMSignature(signature); err != nil {
+			return err
+		}
+	}
+
+	if slices.HasDuplicates(m.TxSignatures) {
+		return errors.New("duplicate tx signatures")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (m ConfirmSVMGatewayTxsRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
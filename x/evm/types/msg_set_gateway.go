@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +20,15 @@ func NewSetGatewayRequest(sender sdk.AccAddress, chain string, address Address)
 	}
 }
 
+// NewSetSVMGatewayRequest creates a message of type SetGatewayRequest for a chain whose gateway is a Solana program
+func NewSetSVMGatewayRequest(sender sdk.AccAddress, chain string, programID string) *SetGatewayRequest {
+	return &SetGatewayRequest{
+		Sender:       sender.String(),
+		Chain:        nexus.ChainName(utils.NormalizeString(chain)),
+		SVMProgramID: programID,
+	}
+}
+
 // Route implements sdk.Msg
 func (m SetGatewayRequest) Route() string {
 	return RouterKey
@@ -38,6 +49,16 @@ func (m SetGatewayRequest) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid chain name")
 	}
 
+	if len(m.SVMProgramID) != 0 {
+		if !m.Address.IsZeroAddress() {
+			return fmt.Errorf("address and svm program id cannot both be set")
+		}
+
+		if err := ValidateSVMAddress(m.SVMProgramID); err != nil {
+			return errorsmod.Wrap(err, "invalid svm program id")
+		}
+	}
+
 	return nil
 }
 
@@ -6,7 +6,9 @@ package types
 import (
 	fmt "fmt"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	types "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -171,6 +173,9 @@ type BatchedCommandsResponse struct {
 	PrevBatchedCommandsID string                                                         `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
 	CommandIDs            []string                                                       `protobuf:"bytes,8,rep,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
 	Proof                 *Proof                                                         `protobuf:"bytes,9,opt,name=proof,proto3" json:"proof,omitempty"`
+	// estimated gas to execute the batch, i.e. the sum of the gas costs and gas
+	// limits of its commands
+	EstimatedGas uint64 `protobuf:"varint,10,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
 }
 
 func (m *BatchedCommandsResponse) Reset()         { *m = BatchedCommandsResponse{} }
@@ -714,6 +719,7 @@ type CommandResponse struct {
 	Params     map[string]string `protobuf:"bytes,3,rep,name=params,proto3" json:"params" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	KeyID      string            `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	MaxGasCost uint32            `protobuf:"varint,5,opt,name=max_gas_cost,json=maxGasCost,proto3" json:"max_gas_cost,omitempty"`
+	GasLimit   uint64            `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *CommandResponse) Reset()         { *m = CommandResponse{} }
@@ -749,6 +755,169 @@ func (m *CommandResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommandResponse proto.InternalMessageInfo
 
+type CommandStatusRequest struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *CommandStatusRequest) Reset()         { *m = CommandStatusRequest{} }
+func (m *CommandStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*CommandStatusRequest) ProtoMessage()    {}
+func (*CommandStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{16}
+}
+func (m *CommandStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandStatusRequest.Merge(m, src)
+}
+func (m *CommandStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandStatusRequest proto.InternalMessageInfo
+
+// CommandStatusResponse joins a command to the batch it was included in, the
+// batch's signing session and the command's execution on the gateway
+type CommandStatusResponse struct {
+	Command CommandResponse `protobuf:"bytes,1,opt,name=command,proto3" json:"command"`
+	// batch_id is empty if the command has not been batched yet
+	BatchID          string                `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	BatchStatus      BatchedCommandsStatus `protobuf:"varint,3,opt,name=batch_status,json=batchStatus,proto3,enum=axelar.evm.v1beta1.BatchedCommandsStatus" json:"batch_status,omitempty"`
+	SigningSessionID uint64                `protobuf:"varint,4,opt,name=signing_session_id,json=signingSessionId,proto3" json:"signing_session_id,omitempty"`
+	// signing_session is only set while the signing session is still in
+	// progress or within its grace period
+	SigningSession *types.SigningSession `protobuf:"bytes,5,opt,name=signing_session,json=signingSession,proto3" json:"signing_session,omitempty"`
+	// execution is only set once the execution of the command has been
+	// confirmed
+	Execution *CommandExecution `protobuf:"bytes,6,opt,name=execution,proto3" json:"execution,omitempty"`
+}
+
+func (m *CommandStatusResponse) Reset()         { *m = CommandStatusResponse{} }
+func (m *CommandStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*CommandStatusResponse) ProtoMessage()    {}
+func (*CommandStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{17}
+}
+func (m *CommandStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandStatusResponse.Merge(m, src)
+}
+func (m *CommandStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandStatusResponse proto.InternalMessageInfo
+
+type ExecutedCommandsRequest struct {
+	Chain      string             `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ExecutedCommandsRequest) Reset()         { *m = ExecutedCommandsRequest{} }
+func (m *ExecutedCommandsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutedCommandsRequest) ProtoMessage()    {}
+func (*ExecutedCommandsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
+}
+func (m *ExecutedCommandsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedCommandsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedCommandsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedCommandsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedCommandsRequest.Merge(m, src)
+}
+func (m *ExecutedCommandsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedCommandsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedCommandsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedCommandsRequest proto.InternalMessageInfo
+
+type ExecutedCommandsResponse struct {
+	Executions []CommandExecution  `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ExecutedCommandsResponse) Reset()         { *m = ExecutedCommandsResponse{} }
+func (m *ExecutedCommandsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutedCommandsResponse) ProtoMessage()    {}
+func (*ExecutedCommandsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
+}
+func (m *ExecutedCommandsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedCommandsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedCommandsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedCommandsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedCommandsResponse.Merge(m, src)
+}
+func (m *ExecutedCommandsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedCommandsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedCommandsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedCommandsResponse proto.InternalMessageInfo
+
 type PendingCommandsRequest struct {
 	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 }
@@ -757,7 +926,7 @@ func (m *PendingCommandsRequest) Reset()         { *m = PendingCommandsRequest{}
 func (m *PendingCommandsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingCommandsRequest) ProtoMessage()    {}
 func (*PendingCommandsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{16}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
 }
 func (m *PendingCommandsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +963,7 @@ func (m *PendingCommandsResponse) Reset()         { *m = PendingCommandsResponse
 func (m *PendingCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingCommandsResponse) ProtoMessage()    {}
 func (*PendingCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{17}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
 }
 func (m *PendingCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,13 +998,14 @@ type QueryCommandResponse struct {
 	Params     map[string]string `protobuf:"bytes,3,rep,name=params,proto3" json:"params" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	KeyID      string            `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	MaxGasCost uint32            `protobuf:"varint,5,opt,name=max_gas_cost,json=maxGasCost,proto3" json:"max_gas_cost,omitempty"`
+	GasLimit   uint64            `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *QueryCommandResponse) Reset()         { *m = QueryCommandResponse{} }
 func (m *QueryCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommandResponse) ProtoMessage()    {}
 func (*QueryCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{18}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
 }
 func (m *QueryCommandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +1046,7 @@ func (m *BurnerInfoRequest) Reset()         { *m = BurnerInfoRequest{} }
 func (m *BurnerInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoRequest) ProtoMessage()    {}
 func (*BurnerInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{19}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
 }
 func (m *BurnerInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1088,7 @@ func (m *BurnerInfoResponse) Reset()         { *m = BurnerInfoResponse{} }
 func (m *BurnerInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfoResponse) ProtoMessage()    {}
 func (*BurnerInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{20}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
 }
 func (m *BurnerInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1125,7 @@ func (m *ConfirmationHeightRequest) Reset()         { *m = ConfirmationHeightReq
 func (m *ConfirmationHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightRequest) ProtoMessage()    {}
 func (*ConfirmationHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{21}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
 }
 func (m *ConfirmationHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -992,7 +1162,7 @@ func (m *ConfirmationHeightResponse) Reset()         { *m = ConfirmationHeightRe
 func (m *ConfirmationHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationHeightResponse) ProtoMessage()    {}
 func (*ConfirmationHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{22}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
 }
 func (m *ConfirmationHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1199,7 @@ func (m *GatewayAddressRequest) Reset()         { *m = GatewayAddressRequest{} }
 func (m *GatewayAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressRequest) ProtoMessage()    {}
 func (*GatewayAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{23}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
 }
 func (m *GatewayAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1236,7 @@ func (m *GatewayAddressResponse) Reset()         { *m = GatewayAddressResponse{}
 func (m *GatewayAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*GatewayAddressResponse) ProtoMessage()    {}
 func (*GatewayAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{24}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
 }
 func (m *GatewayAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1276,7 @@ func (m *BytecodeRequest) Reset()         { *m = BytecodeRequest{} }
 func (m *BytecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*BytecodeRequest) ProtoMessage()    {}
 func (*BytecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{25}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
 }
 func (m *BytecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1313,7 @@ func (m *BytecodeResponse) Reset()         { *m = BytecodeResponse{} }
 func (m *BytecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*BytecodeResponse) ProtoMessage()    {}
 func (*BytecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{26}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{30}
 }
 func (m *BytecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1353,7 @@ func (m *ERC20TokensRequest) Reset()         { *m = ERC20TokensRequest{} }
 func (m *ERC20TokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensRequest) ProtoMessage()    {}
 func (*ERC20TokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{27}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{31}
 }
 func (m *ERC20TokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1392,7 @@ func (m *ERC20TokensResponse) Reset()         { *m = ERC20TokensResponse{} }
 func (m *ERC20TokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse) ProtoMessage()    {}
 func (*ERC20TokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{28}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{32}
 }
 func (m *ERC20TokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1430,7 @@ func (m *ERC20TokensResponse_Token) Reset()         { *m = ERC20TokensResponse_T
 func (m *ERC20TokensResponse_Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokensResponse_Token) ProtoMessage()    {}
 func (*ERC20TokensResponse_Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{28, 0}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{32, 0}
 }
 func (m *ERC20TokensResponse_Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +1472,7 @@ func (m *TokenInfoRequest) Reset()         { *m = TokenInfoRequest{} }
 func (m *TokenInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoRequest) ProtoMessage()    {}
 func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{29}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{33}
 }
 func (m *TokenInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1401,7 +1571,7 @@ func (m *TokenInfoResponse) Reset()         { *m = TokenInfoResponse{} }
 func (m *TokenInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TokenInfoResponse) ProtoMessage()    {}
 func (*TokenInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{30}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{34}
 }
 func (m *TokenInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1611,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{31}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{35}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1479,7 +1649,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{32}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{36}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1516,7 +1686,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fa0caa3a44d5acb, []int{33}
+	return fileDescriptor_8fa0caa3a44d5acb, []int{37}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1566,6 +1736,10 @@ func init() {
 	proto.RegisterType((*CommandRequest)(nil), "axelar.evm.v1beta1.CommandRequest")
 	proto.RegisterType((*CommandResponse)(nil), "axelar.evm.v1beta1.CommandResponse")
 	proto.RegisterMapType((map[string]string)(nil), "axelar.evm.v1beta1.CommandResponse.ParamsEntry")
+	proto.RegisterType((*CommandStatusRequest)(nil), "axelar.evm.v1beta1.CommandStatusRequest")
+	proto.RegisterType((*CommandStatusResponse)(nil), "axelar.evm.v1beta1.CommandStatusResponse")
+	proto.RegisterType((*ExecutedCommandsRequest)(nil), "axelar.evm.v1beta1.ExecutedCommandsRequest")
+	proto.RegisterType((*ExecutedCommandsResponse)(nil), "axelar.evm.v1beta1.ExecutedCommandsResponse")
 	proto.RegisterType((*PendingCommandsRequest)(nil), "axelar.evm.v1beta1.PendingCommandsRequest")
 	proto.RegisterType((*PendingCommandsResponse)(nil), "axelar.evm.v1beta1.PendingCommandsResponse")
 	proto.RegisterType((*QueryCommandResponse)(nil), "axelar.evm.v1beta1.QueryCommandResponse")
@@ -1591,114 +1765,131 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/query.proto", fileDescriptor_8fa0caa3a44d5acb) }
 
 var fileDescriptor_8fa0caa3a44d5acb = []byte{
-	// 1703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xe3, 0xc6,
-	0x19, 0x16, 0xa9, 0x0f, 0x4b, 0xaf, 0x6c, 0x59, 0x3b, 0xeb, 0xd5, 0xca, 0x44, 0x2a, 0x29, 0x04,
-	0x02, 0x78, 0x13, 0xac, 0x94, 0x55, 0xd2, 0x6d, 0x92, 0xc3, 0x6e, 0xf4, 0xd5, 0x35, 0xed, 0xd6,
-	0x75, 0xb9, 0x72, 0xd3, 0x4d, 0x51, 0x08, 0x94, 0x38, 0x96, 0x08, 0x5b, 0xa4, 0x42, 0x8e, 0xb4,
-	0xd2, 0xa1, 0x40, 0x7b, 0x2b, 0xdc, 0x4b, 0x2e, 0x3d, 0xf4, 0x60, 0x14, 0x68, 0x7b, 0xe8, 0x0f,
-	0x28, 0x50, 0xf4, 0x1f, 0x2c, 0xd0, 0x4b, 0x8e, 0x45, 0x0f, 0x42, 0xab, 0xbd, 0xf7, 0x07, 0xe4,
-	0x54, 0x70, 0x66, 0x28, 0x51, 0x32, 0x2d, 0xbb, 0x40, 0xb6, 0xc8, 0x4d, 0x33, 0xf3, 0xbe, 0xcf,
-	0x3c, 0xf3, 0x7e, 0x53, 0x90, 0xd3, 0xc6, 0xf8, 0x5c, 0xb3, 0x4b, 0x78, 0xd4, 0x2f, 0x8d, 0x1e,
-	0xb5, 0x31, 0xd1, 0x1e, 0x95, 0xbe, 0x18, 0x62, 0x7b, 0x52, 0x1c, 0xd8, 0x16, 0xb1, 0x10, 0x62,
-	0xe7, 0x45, 0x3c, 0xea, 0x17, 0xf9, 0xb9, 0xb4, 0xd3, 0xb5, 0xba, 0x16, 0x3d, 0x2e, 0xb9, 0xbf,
-	0x98, 0xa4, 0x14, 0x84, 0x44, 0x26, 0x03, 0xec, 0xf0, 0xf3, 0x7c, 0xc0, 0xf9, 0x40, 0xb3, 0xb5,
-	0x3e, 0x17, 0x90, 0x7f, 0x2f, 0x00, 0xaa, 0xe3, 0x81, 0xe5, 0x18, 0xe4, 0xc7, 0x2e, 0x83, 0x63,
-	0x7a, 0x88, 0xb2, 0xb0, 0xa1, 0xe9, 0xba, 0x8d, 0x1d, 0x27, 0x2b, 0x14, 0x84, 0xbd, 0x84, 0xea,
-	0x2d, 0xd1, 0x0e, 0x44, 0x35, 0xc7, 0xc1, 0x24, 0x2b, 0xd2, 0x7d, 0xb6, 0x40, 0x2f, 0x20, 0xda,
-	0xe9, 0x69, 0x86, 0x99, 0x0d, 0xbb, 0xbb, 0xd5, 0xda, 0xd7, 0xd3, 0xfc, 0xd3, 0xae, 0x41, 0x7a,
-	0xc3, 0x76, 0xb1, 0x63, 0xf5, 0x4b, 0x8c, 0x85, 0x89, 0xc9, 0x4b, 0xcb, 0x3e, 0xe3, 0xab, 0x87,
-	0x1d, 0xcb, 0xc6, 0xa5, 0x71, 0xc9, 0xc4, 0xe3, 0xa1, 0x53, 0xc2, 0xe3, 0x81, 0x65, 0x13, 0xac,
-	0x17, 0x6b, 0x2e, 0xcc, 0x91, 0xd6, 0xc7, 0x2a, 0x43, 0x94, 0x9f, 0x40, 0xa6, 0xaa, 0x91, 0x4e,
-	0x0f, 0xeb, 0x35, 0xab, 0xdf, 0xd7, 0x4c, 0xdd, 0x51, 0xf1, 0x17, 0x43, 0xec, 0x10, 0x97, 0x0a,
-	0xbb, 0x94, 0x51, 0x64, 0x0b, 0x94, 0x02, 0xd1, 0xd0, 0x39, 0x3b, 0xd1, 0xd0, 0xe5, 0xbf, 0x87,
-	0xe1, 0xfe, 0x15, 0x00, 0x67, 0x60, 0x99, 0x0e, 0x46, 0x19, 0x2a, 0x4b, 0xd5, 0xab, 0xb1, 0xd9,
-	0x34, 0x2f, 0x2a, 0x75, 0x57, 0x07, 0x21, 0x88, 0xe8, 0x1a, 0xd1, 0x38, 0x0a, 0xfd, 0x8d, 0x2a,
-	0x10, 0x73, 0x88, 0x46, 0x86, 0x0e, 0x7d, 0x63, 0xaa, 0xfc, 0xa0, 0x78, 0xd5, 0x4b, 0xc5, 0x95,
-	0x8b, 0x9e, 0x53, 0x05, 0x95, 0x2b, 0xa2, 0x36, 0xc4, 0xce, 0xf0, 0xa4, 0x65, 0xe8, 0xd9, 0x08,
-	0xbd, 0xf2, 0x70, 0x36, 0xcd, 0x47, 0x0f, 0xf1, 0x44, 0xa9, 0x7f, 0x3d, 0xcd, 0x3f, 0xb9, 0xa5,
-	0xbd, 0xfa, 0xc3, 0x73, 0x62, 0x38, 0x46, 0x77, 0x61, 0x32, 0x8a, 0xa0, 0x46, 0xcf, 0xf0, 0x44,
-	0xd1, 0xd1, 0xdb, 0xb0, 0x89, 0xc7, 0xb8, 0x33, 0x24, 0xb8, 0x45, 0x9f, 0x10, 0xa3, 0x4f, 0x48,
-	0xf2, 0xbd, 0xba, 0xfb, 0x12, 0x15, 0xb2, 0x03, 0x1b, 0x8f, 0x5a, 0x6d, 0x46, 0xb6, 0xd5, 0xe1,
-	0x6c, 0x5d, 0x62, 0x1b, 0x94, 0xd8, 0xee, 0x6c, 0x9a, 0xbf, 0x77, 0x6c, 0xe3, 0xd1, 0xca, 0x7b,
-	0x94, 0xba, 0x7a, 0x6f, 0x10, 0xb0, 0xad, 0xa3, 0x12, 0x24, 0x39, 0x4c, 0xcb, 0xd0, 0x9d, 0x6c,
-	0xbc, 0x10, 0xde, 0x4b, 0x54, 0x53, 0xb3, 0x69, 0x1e, 0xb8, 0x90, 0x52, 0x77, 0x54, 0xe0, 0x22,
-	0x8a, 0xee, 0xa0, 0x12, 0x44, 0x07, 0xb6, 0x65, 0x9d, 0x66, 0x13, 0x05, 0x61, 0x2f, 0x59, 0xde,
-	0x0d, 0xb2, 0xe6, 0xb1, 0x2b, 0xa0, 0x32, 0xb9, 0x83, 0x48, 0x3c, 0x9a, 0x8e, 0xc9, 0xbf, 0x13,
-	0xe0, 0xce, 0x21, 0x9e, 0x54, 0x58, 0x34, 0xae, 0x8f, 0x84, 0xff, 0x83, 0xb9, 0x0f, 0x22, 0x71,
-	0x31, 0x1d, 0x3e, 0x88, 0xc4, 0xc3, 0xe9, 0x88, 0xfc, 0x57, 0x11, 0x90, 0x9f, 0x1b, 0x0f, 0xb2,
-	0x05, 0x0d, 0xe1, 0x8d, 0x79, 0xfd, 0x73, 0x48, 0xf0, 0x04, 0xc5, 0x4e, 0x56, 0x2c, 0x84, 0xf7,
-	0x92, 0xe5, 0xc7, 0x41, 0x16, 0xbd, 0x4a, 0xaf, 0xf8, 0x19, 0x36, 0xba, 0x3d, 0x82, 0x75, 0xbe,
-	0x5f, 0x8d, 0xbc, 0x9a, 0xe6, 0x43, 0xea, 0x02, 0x0e, 0xbd, 0x05, 0x09, 0xd2, 0xb3, 0xb1, 0xd3,
-	0xb3, 0xce, 0x75, 0x96, 0xdf, 0xea, 0x62, 0x43, 0xaa, 0xc1, 0xf6, 0x0a, 0xc2, 0x9a, 0xe2, 0x91,
-	0x81, 0xd8, 0x4b, 0x2a, 0xcc, 0x33, 0x8b, 0xaf, 0xe4, 0xcf, 0x60, 0x97, 0x56, 0x9f, 0xa6, 0x75,
-	0x86, 0xcd, 0x55, 0xfb, 0x5d, 0x0f, 0xf7, 0x16, 0x24, 0x3a, 0x96, 0x79, 0x6a, 0xd8, 0x7d, 0xcc,
-	0x32, 0x3e, 0xae, 0x2e, 0x36, 0x3e, 0x11, 0xb3, 0x82, 0xfc, 0x4b, 0x01, 0xee, 0x53, 0x64, 0x5e,
-	0xe3, 0xdc, 0x84, 0xc4, 0xbc, 0xc6, 0x3d, 0x80, 0x28, 0x19, 0x7b, 0x6e, 0xd9, 0xac, 0xee, 0xb8,
-	0xef, 0xfe, 0xe7, 0x34, 0x1f, 0xd9, 0xd7, 0x9c, 0xde, 0x6c, 0x9a, 0x8f, 0x34, 0xc7, 0x4a, 0x5d,
-	0x8d, 0x90, 0xb1, 0xa2, 0xa3, 0xc7, 0x90, 0x6a, 0x0f, 0x6d, 0x13, 0xdb, 0x2d, 0x8f, 0x89, 0x48,
-	0x75, 0xb6, 0xb9, 0xce, 0x86, 0xc7, 0x79, 0x8b, 0x89, 0xf1, 0x25, 0xa5, 0xf0, 0x37, 0x01, 0xee,
-	0xfa, 0x6f, 0xf7, 0x62, 0xf6, 0xc5, 0x52, 0xcc, 0x7e, 0x93, 0x25, 0x13, 0xd5, 0x20, 0xc6, 0x8a,
-	0x3c, 0xa5, 0x99, 0x2c, 0xbf, 0x17, 0x14, 0x0a, 0xd7, 0x98, 0x45, 0xe5, 0xaa, 0x94, 0xfb, 0x09,
-	0xec, 0x2c, 0x53, 0xe7, 0x2e, 0xf9, 0x78, 0x5e, 0x0b, 0x45, 0x5a, 0x0b, 0xdf, 0x0e, 0xba, 0xc0,
-	0xa7, 0xb9, 0xa8, 0x81, 0x14, 0xf6, 0x29, 0x6c, 0x36, 0x46, 0xd8, 0x24, 0xeb, 0xd3, 0x77, 0x17,
-	0xe2, 0xd8, 0x95, 0x6a, 0xcd, 0xcb, 0xf9, 0x06, 0x5d, 0x2b, 0xba, 0xfc, 0x29, 0x6c, 0x71, 0x00,
-	0x4e, 0xa8, 0x04, 0x51, 0x7a, 0x46, 0x11, 0xae, 0xa9, 0x26, 0x4c, 0x83, 0xc9, 0xc9, 0x8f, 0x41,
-	0xa2, 0x06, 0xa8, 0xfa, 0xfd, 0x75, 0x73, 0xc8, 0xc9, 0xfb, 0xb0, 0x45, 0xcd, 0x3d, 0x2f, 0x3d,
-	0xdf, 0x9b, 0x9b, 0x42, 0xa0, 0xa6, 0xc8, 0x07, 0x5d, 0x4d, 0x55, 0x96, 0x0d, 0x21, 0xf7, 0x21,
-	0xe5, 0x21, 0xf1, 0x5b, 0x7f, 0x06, 0x31, 0xfa, 0x72, 0x17, 0x2a, 0xfc, 0x4d, 0x85, 0x04, 0x87,
-	0x94, 0x9f, 0x40, 0x8a, 0x57, 0xe2, 0xf5, 0x56, 0xcf, 0x2c, 0xda, 0xa7, 0xbf, 0x25, 0xca, 0xbf,
-	0x11, 0x61, 0x7b, 0x0e, 0x70, 0x73, 0xfb, 0x74, 0x87, 0x10, 0xaf, 0x7d, 0xba, 0xbf, 0xd1, 0x0f,
-	0xe7, 0x31, 0x19, 0xa6, 0xe5, 0xa9, 0x14, 0x68, 0xa7, 0xe5, 0x0b, 0x8a, 0x2c, 0x24, 0x1b, 0x26,
-	0xb1, 0x27, 0xbc, 0x2e, 0x71, 0x10, 0x54, 0x58, 0xa9, 0xed, 0x89, 0x79, 0x51, 0xf5, 0x4a, 0x62,
-	0x01, 0x36, 0xfb, 0xda, 0xb8, 0xd5, 0xd5, 0x9c, 0x56, 0xc7, 0x72, 0x48, 0x36, 0x5a, 0x10, 0xf6,
-	0xb6, 0x54, 0xe8, 0x6b, 0xe3, 0x67, 0x9a, 0x53, 0xb3, 0x1c, 0x22, 0x7d, 0x0c, 0x49, 0xdf, 0x05,
-	0x28, 0x0d, 0xe1, 0x33, 0x3c, 0xe1, 0xd6, 0x70, 0x7f, 0xba, 0x16, 0x1a, 0x69, 0xe7, 0x43, 0xef,
-	0x21, 0x6c, 0xf1, 0x89, 0xf8, 0x91, 0x20, 0x17, 0x21, 0x73, 0x8c, 0x4d, 0xdd, 0x30, 0xbb, 0xb7,
-	0x1a, 0x4a, 0x64, 0x0c, 0xf7, 0xaf, 0xc8, 0x73, 0x23, 0x1e, 0x40, 0xdc, 0x6b, 0xc0, 0xd4, 0xef,
-	0xc9, 0xf2, 0xde, 0xb5, 0xe9, 0xba, 0x62, 0x1f, 0x6e, 0x93, 0xb9, 0xbe, 0xfc, 0x5b, 0x11, 0x76,
-	0x82, 0x04, 0xff, 0x27, 0x4f, 0xa9, 0x2b, 0x9e, 0xfa, 0xf0, 0xb6, 0x74, 0xbe, 0xbd, 0xee, 0xaa,
-	0xc2, 0x1d, 0x96, 0xe8, 0x8a, 0x79, 0x6a, 0x79, 0x9e, 0x7a, 0xb0, 0x9c, 0xe4, 0x01, 0xd5, 0xdc,
-	0x3b, 0xa7, 0x45, 0xeb, 0x2f, 0x02, 0x20, 0x3f, 0x08, 0xb7, 0xec, 0x1b, 0x2c, 0xe3, 0x4f, 0x21,
-	0xc9, 0xbb, 0x8e, 0x61, 0x9e, 0x5a, 0xbc, 0x96, 0xe7, 0x02, 0xc7, 0xce, 0x05, 0x2f, 0x68, 0xcf,
-	0x7f, 0x53, 0xda, 0x8f, 0x60, 0xb7, 0xc6, 0x5a, 0xa2, 0x46, 0x0c, 0xcb, 0xdc, 0xa7, 0x0d, 0x77,
-	0x7d, 0xb0, 0x7e, 0x08, 0x52, 0x90, 0xca, 0x3c, 0x94, 0x62, 0x3d, 0xd6, 0xc3, 0x5d, 0xa5, 0x88,
-	0xca, 0x57, 0xf2, 0x43, 0xb8, 0xf7, 0x4c, 0x23, 0xf8, 0xa5, 0x76, 0xab, 0xe1, 0x4c, 0x2e, 0x43,
-	0x66, 0x55, 0xfc, 0xc6, 0xe2, 0xfb, 0x0c, 0xb6, 0xab, 0x13, 0x82, 0x3b, 0x96, 0x8e, 0xd7, 0x17,
-	0xb1, 0x9c, 0x9b, 0x53, 0x26, 0xb1, 0xb5, 0x0e, 0x9f, 0x34, 0xaa, 0x62, 0x56, 0x50, 0xe7, 0x7b,
-	0x72, 0x11, 0xd2, 0x0b, 0x20, 0x7e, 0xad, 0x04, 0xf1, 0x36, 0xdf, 0xe3, 0x60, 0xf3, 0xb5, 0xfc,
-	0x73, 0x40, 0x0d, 0xb5, 0x56, 0x7e, 0x9f, 0xce, 0x27, 0x37, 0x4c, 0x9d, 0x8f, 0x7c, 0x29, 0x95,
-	0x2a, 0x7f, 0x27, 0xc8, 0x5d, 0x14, 0xa6, 0x39, 0x19, 0x60, 0x96, 0x71, 0xee, 0x50, 0x7b, 0x77,
-	0x09, 0x9f, 0x53, 0x3a, 0x84, 0x18, 0xa1, 0x3b, 0xbc, 0x30, 0x3c, 0x0c, 0x6c, 0x6b, 0x57, 0x15,
-	0xd9, 0x05, 0x5e, 0x0a, 0x32, 0x08, 0xe9, 0xbb, 0x10, 0xa5, 0xdb, 0x8b, 0x2f, 0x38, 0xc1, 0xff,
-	0x05, 0x97, 0x81, 0x98, 0x33, 0xe9, 0xb7, 0xad, 0x73, 0x6f, 0x34, 0x63, 0x2b, 0xf9, 0x57, 0x02,
-	0xa4, 0xa9, 0x9e, 0x3f, 0x75, 0xae, 0x6b, 0x1d, 0xfe, 0x4f, 0xc3, 0xfd, 0x90, 0x07, 0x9d, 0x9d,
-	0x43, 0x87, 0xf9, 0x01, 0x5f, 0x23, 0x69, 0xe1, 0xea, 0x08, 0x3f, 0xf2, 0x36, 0xaa, 0x09, 0xd8,
-	0x38, 0x35, 0x4c, 0xbd, 0xd5, 0x9e, 0xc8, 0xff, 0x11, 0xe0, 0x8e, 0x8f, 0x03, 0xb7, 0x4e, 0xf0,
-	0x3b, 0x3e, 0x85, 0x0d, 0x1d, 0x13, 0xcd, 0x38, 0xf7, 0x86, 0x9f, 0xc2, 0xb5, 0x1e, 0xa8, 0x33,
-	0x39, 0x6e, 0x27, 0x4f, 0xcd, 0x1f, 0x7f, 0xe1, 0x35, 0xf3, 0x66, 0x64, 0x65, 0xde, 0x44, 0x79,
-	0x48, 0x1a, 0x4e, 0x0b, 0x8f, 0x09, 0xb6, 0x4d, 0xed, 0x9c, 0x16, 0xb0, 0xb8, 0x0a, 0x86, 0xd3,
-	0xe0, 0x3b, 0x68, 0x0f, 0xd2, 0x3c, 0x9f, 0xdd, 0xa0, 0x6a, 0xf5, 0x34, 0xa7, 0xc7, 0x3f, 0xcf,
-	0xf8, 0x74, 0x59, 0xb3, 0x74, 0xec, 0x4e, 0x9f, 0xf2, 0x2f, 0x20, 0x4a, 0xbf, 0x7d, 0xdc, 0x1b,
-	0x17, 0x73, 0x3d, 0x9d, 0x0a, 0xfc, 0x93, 0x79, 0x16, 0x36, 0xd8, 0x00, 0xcd, 0x66, 0xfe, 0x84,
-	0xea, 0x2d, 0xd7, 0xcf, 0xec, 0x28, 0x07, 0xe0, 0x18, 0x5d, 0x53, 0x23, 0x43, 0x1b, 0xbb, 0x96,
-	0x77, 0x55, 0x7d, 0x3b, 0xf2, 0x3b, 0xb0, 0xc5, 0x87, 0xc1, 0xb5, 0x29, 0x7c, 0x00, 0x29, 0x4f,
-	0x8c, 0xbb, 0xe4, 0xa3, 0x79, 0xeb, 0x60, 0x73, 0x98, 0x14, 0xf8, 0x55, 0x47, 0x25, 0x96, 0x1b,
-	0xc4, 0xbb, 0x7f, 0x10, 0x20, 0xe9, 0x9b, 0x92, 0xd0, 0x07, 0x90, 0xad, 0xed, 0x57, 0x94, 0xa3,
-	0xd6, 0xf3, 0x66, 0xa5, 0x79, 0xf2, 0xbc, 0x75, 0x72, 0xf4, 0xfc, 0xb8, 0x51, 0x53, 0xbe, 0xaf,
-	0x34, 0xea, 0xe9, 0x90, 0x74, 0xef, 0xe2, 0xb2, 0x70, 0x87, 0x49, 0x9e, 0x98, 0xce, 0x00, 0x77,
-	0x8c, 0x53, 0x03, 0xeb, 0xe8, 0x01, 0x64, 0x96, 0x94, 0x2a, 0xb5, 0xa6, 0xf2, 0x93, 0x4a, 0xb3,
-	0x51, 0x4f, 0x0b, 0xd2, 0xd6, 0xc5, 0x65, 0x21, 0x51, 0xe9, 0x10, 0x63, 0xa4, 0x11, 0xac, 0xa3,
-	0x87, 0x2b, 0xf8, 0xf5, 0xc6, 0x42, 0x58, 0x94, 0xb6, 0x2f, 0x2e, 0x0b, 0xc9, 0x3a, 0xd6, 0x3c,
-	0x71, 0x29, 0xf2, 0xeb, 0x3f, 0xe6, 0x42, 0xef, 0x7e, 0x29, 0x40, 0x62, 0x9e, 0xbb, 0xe8, 0x3d,
-	0xc8, 0x34, 0x7f, 0x74, 0xd8, 0x38, 0x6a, 0x35, 0x5f, 0x1c, 0x37, 0x56, 0x08, 0x52, 0x00, 0x3f,
-	0xb5, 0x77, 0xe0, 0xae, 0x4f, 0x58, 0x39, 0x6a, 0x36, 0xd4, 0xa3, 0xca, 0x0f, 0xd2, 0x82, 0xb4,
-	0x79, 0x71, 0x59, 0x88, 0x2b, 0x26, 0x0f, 0x91, 0x65, 0xb1, 0xc6, 0x4f, 0xb9, 0x98, 0xc8, 0xc4,
-	0xbc, 0x48, 0x92, 0xe2, 0x2e, 0x9d, 0x3f, 0xff, 0x29, 0x27, 0x54, 0x8f, 0x5e, 0xfd, 0x3b, 0x17,
-	0x7a, 0x35, 0xcb, 0x09, 0x5f, 0xcd, 0x72, 0xc2, 0xbf, 0x66, 0x39, 0xe1, 0xcb, 0xd7, 0xb9, 0xd0,
-	0x57, 0xaf, 0x73, 0xa1, 0x7f, 0xbc, 0xce, 0x85, 0x3e, 0x7f, 0xff, 0x96, 0x9d, 0x08, 0x8f, 0xfa,
-	0xec, 0x6f, 0xa3, 0x76, 0x8c, 0xfe, 0x2d, 0xf4, 0xc1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xad,
-	0xe4, 0xe0, 0x7f, 0xa3, 0x12, 0x00, 0x00,
+	// 1981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x9b, 0x8f, 0xfa, 0xa0, 0x27, 0x12, 0x4d, 0xb1, 0x29, 0xc9, 0xac, 0x9b, 0x44,
+	0x76, 0x60, 0x32, 0x56, 0x52, 0x37, 0x09, 0x0a, 0x3b, 0xe2, 0x47, 0xa4, 0x95, 0x5c, 0x55, 0x5d,
+	0xd1, 0x4d, 0x9d, 0xa2, 0x20, 0x96, 0xdc, 0x11, 0xb9, 0x90, 0xb8, 0xcb, 0xec, 0x0c, 0x65, 0xf2,
+	0x50, 0xa0, 0xbd, 0x15, 0x3e, 0xe5, 0xda, 0x83, 0x51, 0xa0, 0xed, 0x21, 0x87, 0x02, 0x05, 0x8a,
+	0x02, 0x45, 0xff, 0x03, 0x1f, 0x73, 0x2c, 0x7a, 0x20, 0x5a, 0xfa, 0x5e, 0xf4, 0x9c, 0x53, 0xb1,
+	0x33, 0xb3, 0xcb, 0x25, 0xb5, 0xa2, 0x94, 0x22, 0x29, 0xd0, 0xdb, 0xce, 0x9b, 0xf7, 0x7e, 0xf3,
+	0x9b, 0x37, 0x6f, 0xde, 0x7b, 0xb3, 0x90, 0xd7, 0x86, 0xf8, 0x4c, 0xb3, 0xcb, 0xf8, 0xbc, 0x57,
+	0x3e, 0xbf, 0xd7, 0xc2, 0x54, 0xbb, 0x57, 0xfe, 0x74, 0x80, 0xed, 0x51, 0xa9, 0x6f, 0x5b, 0xd4,
+	0x42, 0x88, 0xcf, 0x97, 0xf0, 0x79, 0xaf, 0x24, 0xe6, 0x73, 0xeb, 0x1d, 0xab, 0x63, 0xb1, 0xe9,
+	0xb2, 0xf3, 0xc5, 0x35, 0x73, 0x41, 0x48, 0x74, 0xd4, 0xc7, 0x44, 0xcc, 0x17, 0x02, 0xe6, 0xfb,
+	0x9a, 0xad, 0xf5, 0x5c, 0x85, 0x5b, 0x42, 0xa1, 0x37, 0x38, 0xa3, 0x06, 0x31, 0x3a, 0x81, 0x28,
+	0x77, 0xda, 0x16, 0xe9, 0x59, 0xa4, 0xdc, 0xd2, 0x08, 0xe6, 0x44, 0x7d, 0x60, 0x1d, 0xc3, 0xd4,
+	0xa8, 0x61, 0x99, 0x5c, 0x57, 0xfe, 0x8d, 0x04, 0xa8, 0x86, 0xfb, 0x16, 0x31, 0xe8, 0x8f, 0x1c,
+	0xcd, 0x23, 0xb6, 0x1a, 0xca, 0x42, 0x5c, 0xd3, 0x75, 0x1b, 0x13, 0x92, 0x95, 0x8a, 0xd2, 0x56,
+	0x52, 0x75, 0x87, 0x68, 0x1d, 0xa2, 0x1a, 0x21, 0x98, 0x66, 0x43, 0x4c, 0xce, 0x07, 0xe8, 0x09,
+	0x44, 0xdb, 0x5d, 0xcd, 0x30, 0xb3, 0x61, 0x47, 0x5a, 0xa9, 0x7e, 0x39, 0x2e, 0x3c, 0xec, 0x18,
+	0xb4, 0x3b, 0x68, 0x95, 0xda, 0x56, 0xaf, 0xcc, 0x59, 0x9b, 0x98, 0x3e, 0xb5, 0xec, 0x53, 0x31,
+	0xba, 0xdb, 0xb6, 0x6c, 0x5c, 0x1e, 0x96, 0x4d, 0x3c, 0x1c, 0x90, 0x32, 0x1e, 0xf6, 0x2d, 0x9b,
+	0x62, 0xbd, 0x54, 0x75, 0x60, 0x0e, 0xb5, 0x1e, 0x56, 0x39, 0xa2, 0xfc, 0x00, 0x32, 0x15, 0x8d,
+	0xb6, 0xbb, 0x58, 0xaf, 0x5a, 0xbd, 0x9e, 0x66, 0xea, 0x44, 0xc5, 0x9f, 0x0e, 0x30, 0xa1, 0x0e,
+	0x15, 0xbe, 0x28, 0xa7, 0xc8, 0x07, 0x68, 0x15, 0x42, 0x86, 0x2e, 0xd8, 0x85, 0x0c, 0x5d, 0xfe,
+	0x77, 0x18, 0x6e, 0x5e, 0x00, 0x20, 0x7d, 0xcb, 0x24, 0x18, 0x65, 0x98, 0x2e, 0x33, 0xaf, 0xc4,
+	0x26, 0xe3, 0x42, 0x48, 0xa9, 0x39, 0x36, 0x08, 0x41, 0x44, 0xd7, 0xa8, 0x26, 0x50, 0xd8, 0x37,
+	0xda, 0x81, 0x18, 0xa1, 0x1a, 0x1d, 0x10, 0xb6, 0xc7, 0xd5, 0xed, 0xdb, 0xa5, 0x8b, 0xc7, 0x5e,
+	0x9a, 0x5b, 0xe8, 0x98, 0x19, 0xa8, 0xc2, 0x10, 0xb5, 0x20, 0x76, 0x8a, 0x47, 0x4d, 0x43, 0xcf,
+	0x46, 0xd8, 0x92, 0x07, 0x93, 0x71, 0x21, 0x7a, 0x80, 0x47, 0x4a, 0xed, 0xcb, 0x71, 0xe1, 0xc1,
+	0x35, 0xfd, 0xe5, 0x1d, 0xbd, 0xe7, 0x32, 0x86, 0xa0, 0x46, 0x4f, 0xf1, 0x48, 0xd1, 0xd1, 0x6b,
+	0xb0, 0x8c, 0x87, 0xb8, 0x3d, 0xa0, 0xb8, 0xc9, 0xb6, 0x10, 0x63, 0x5b, 0x48, 0x09, 0x59, 0xcd,
+	0xd9, 0x89, 0x0a, 0xd9, 0xbe, 0x8d, 0xcf, 0x9b, 0x2d, 0x4e, 0xb6, 0xd9, 0x16, 0x6c, 0x1d, 0x62,
+	0x71, 0x46, 0x6c, 0x73, 0x32, 0x2e, 0x6c, 0x1c, 0xd9, 0xf8, 0x7c, 0x6e, 0x3f, 0x4a, 0x4d, 0xdd,
+	0xe8, 0x07, 0x88, 0x75, 0x54, 0x86, 0x94, 0x80, 0x69, 0x1a, 0x3a, 0xc9, 0x26, 0x8a, 0xe1, 0xad,
+	0x64, 0x65, 0x75, 0x32, 0x2e, 0x80, 0x50, 0x52, 0x6a, 0x44, 0x05, 0xa1, 0xa2, 0xe8, 0x04, 0x95,
+	0x21, 0xda, 0xb7, 0x2d, 0xeb, 0x24, 0x9b, 0x2c, 0x4a, 0x5b, 0xa9, 0xed, 0xcd, 0x20, 0x6f, 0x1e,
+	0x39, 0x0a, 0x2a, 0xd7, 0x43, 0xb7, 0x60, 0x05, 0x13, 0x6a, 0xf4, 0x34, 0x8a, 0xf5, 0x66, 0x47,
+	0x23, 0x59, 0x28, 0x4a, 0x5b, 0x11, 0x75, 0xd9, 0x13, 0xee, 0x6a, 0x64, 0x3f, 0x92, 0x88, 0xa6,
+	0x63, 0xf2, 0xaf, 0x25, 0xb8, 0x71, 0x80, 0x47, 0x3b, 0x3c, 0x64, 0x17, 0x87, 0xcb, 0xff, 0xe0,
+	0x4c, 0xf6, 0x23, 0x89, 0x50, 0x3a, 0xbc, 0x1f, 0x49, 0x84, 0xd3, 0x11, 0xf9, 0x2f, 0x21, 0x40,
+	0x7e, 0x6e, 0x22, 0x12, 0xa7, 0x34, 0xa4, 0x6f, 0x2c, 0x34, 0x3e, 0x81, 0xa4, 0xb8, 0xc5, 0x98,
+	0x64, 0x43, 0xc5, 0xf0, 0x56, 0x6a, 0xfb, 0x7e, 0x90, 0xdb, 0x2f, 0xd2, 0x2b, 0x7d, 0x8c, 0x8d,
+	0x4e, 0x97, 0x62, 0x5d, 0xc8, 0x2b, 0x91, 0x17, 0xe3, 0xc2, 0x92, 0x3a, 0x85, 0x43, 0xaf, 0x42,
+	0x92, 0x76, 0x6d, 0x4c, 0xba, 0xd6, 0x99, 0xce, 0x93, 0x80, 0x3a, 0x15, 0xe4, 0xaa, 0xb0, 0x36,
+	0x87, 0xb0, 0x20, 0xc3, 0x64, 0x20, 0xf6, 0x94, 0x29, 0x8b, 0xeb, 0x27, 0x46, 0xf2, 0xc7, 0xb0,
+	0xc9, 0x52, 0x54, 0xc3, 0x3a, 0xc5, 0xe6, 0xbc, 0xff, 0x2e, 0x87, 0x7b, 0x15, 0x92, 0x6d, 0xcb,
+	0x3c, 0x31, 0xec, 0x1e, 0xe6, 0x69, 0x21, 0xa1, 0x4e, 0x05, 0x1f, 0x84, 0xb2, 0x92, 0xfc, 0x0b,
+	0x09, 0x6e, 0x32, 0x64, 0x91, 0x08, 0x9d, 0x5b, 0x8b, 0x45, 0x22, 0xbc, 0x0d, 0x51, 0x3a, 0x74,
+	0x8f, 0x65, 0xb9, 0xb2, 0xee, 0xec, 0xfb, 0xef, 0xe3, 0x42, 0x64, 0x4f, 0x23, 0xdd, 0xc9, 0xb8,
+	0x10, 0x69, 0x0c, 0x95, 0x9a, 0x1a, 0xa1, 0x43, 0x45, 0x47, 0xf7, 0x61, 0xb5, 0x35, 0xb0, 0x4d,
+	0x6c, 0x37, 0x5d, 0x26, 0x21, 0x66, 0xb3, 0x26, 0x6c, 0xe2, 0x2e, 0xe7, 0x15, 0xae, 0x26, 0x86,
+	0x8c, 0xc2, 0x5f, 0x25, 0x78, 0xc5, 0xbf, 0xba, 0x1b, 0xb3, 0x4f, 0x66, 0x62, 0xf6, 0xeb, 0xcc,
+	0xab, 0xa8, 0x0a, 0x31, 0x5e, 0x5a, 0x18, 0xcd, 0xd4, 0xf6, 0x5b, 0x41, 0xa1, 0x70, 0x89, 0x5b,
+	0x54, 0x61, 0xca, 0xb8, 0x3f, 0x86, 0xf5, 0x59, 0xea, 0xe2, 0x48, 0xde, 0xf7, 0x12, 0x66, 0x88,
+	0x25, 0xcc, 0xd7, 0x82, 0x16, 0xf0, 0x59, 0x4e, 0x13, 0x25, 0x83, 0x7d, 0x08, 0xcb, 0xf5, 0x73,
+	0x6c, 0xd2, 0xc5, 0xd7, 0x77, 0x13, 0x12, 0xd8, 0xd1, 0x6a, 0x7a, 0x39, 0x3f, 0xce, 0xc6, 0x8a,
+	0x2e, 0x7f, 0x08, 0x2b, 0x02, 0x40, 0x10, 0x2a, 0x43, 0x94, 0xcd, 0x65, 0xa5, 0xcb, 0x53, 0x0e,
+	0xb7, 0xe0, 0x7a, 0xf2, 0x7d, 0xc8, 0x31, 0x07, 0x54, 0xfc, 0xe7, 0x75, 0x75, 0xc8, 0xc9, 0x7b,
+	0xb0, 0xc2, 0xdc, 0xed, 0xa5, 0x9e, 0xef, 0x79, 0xae, 0x90, 0x98, 0x2b, 0x0a, 0x41, 0x4b, 0x33,
+	0x93, 0x59, 0x47, 0xc8, 0x3d, 0x58, 0x75, 0x91, 0xc4, 0xaa, 0x3f, 0x85, 0x18, 0xdb, 0xb9, 0x03,
+	0x15, 0xfe, 0xba, 0x42, 0x42, 0x40, 0xca, 0x0f, 0x60, 0x55, 0xa4, 0xeb, 0xc5, 0x5e, 0xcf, 0x4c,
+	0x6b, 0xac, 0xbf, 0x6e, 0xca, 0x9f, 0x87, 0x60, 0xcd, 0x03, 0xb8, 0xba, 0xc6, 0x3a, 0x4d, 0x8b,
+	0x5b, 0x63, 0x9d, 0x6f, 0xf4, 0x03, 0x2f, 0x26, 0xc3, 0x2c, 0x3d, 0x95, 0x03, 0xfd, 0x34, 0xbb,
+	0x40, 0x89, 0x87, 0x64, 0xdd, 0xa4, 0xf6, 0x48, 0xe4, 0x25, 0x01, 0x82, 0x8a, 0x73, 0xb9, 0x3d,
+	0xe9, 0x25, 0x55, 0x37, 0x25, 0x16, 0x61, 0xb9, 0xa7, 0x0d, 0x9d, 0x72, 0xd2, 0x6c, 0x5b, 0x84,
+	0x66, 0xa3, 0x45, 0x69, 0x6b, 0x45, 0x85, 0x9e, 0x36, 0xdc, 0xd5, 0x48, 0xd5, 0x22, 0x14, 0x7d,
+	0x0b, 0x92, 0xce, 0xec, 0x99, 0xd1, 0x33, 0x28, 0x2b, 0xa6, 0x11, 0x35, 0xd1, 0xd1, 0xc8, 0x23,
+	0x67, 0x9c, 0x7b, 0x1f, 0x52, 0xbe, 0xd5, 0x51, 0x1a, 0xc2, 0xa7, 0x78, 0x24, 0x5c, 0xe5, 0x7c,
+	0x3a, 0xee, 0x3b, 0xd7, 0xce, 0x06, 0xee, 0x2e, 0xf9, 0xe0, 0x83, 0xd0, 0x7b, 0x92, 0xfc, 0x7d,
+	0x58, 0x17, 0x1b, 0x11, 0x47, 0xfe, 0x95, 0x9a, 0x9a, 0x3f, 0x84, 0x61, 0x63, 0xce, 0x5c, 0xb8,
+	0xbb, 0x0a, 0x71, 0x51, 0x65, 0x45, 0x98, 0xdf, 0xba, 0x86, 0x0f, 0x85, 0xdf, 0x5c, 0x4b, 0xf4,
+	0x06, 0x24, 0x58, 0x73, 0xe0, 0xdd, 0xaa, 0x4a, 0x6a, 0x32, 0x2e, 0xc4, 0x59, 0xd9, 0x57, 0x6a,
+	0x6a, 0x9c, 0x4d, 0x2a, 0x3a, 0x7a, 0x04, 0xcb, 0x5c, 0xef, 0xbf, 0xed, 0x8c, 0x52, 0xcc, 0x9c,
+	0x0f, 0x50, 0x05, 0x10, 0x31, 0x3a, 0xa6, 0x61, 0x76, 0x9a, 0x04, 0x13, 0x62, 0x58, 0xa6, 0x7b,
+	0x74, 0x91, 0xca, 0xfa, 0x64, 0x5c, 0x48, 0x1f, 0xf3, 0xd9, 0x63, 0x3e, 0xa9, 0xd4, 0xd4, 0x34,
+	0x99, 0x95, 0xe8, 0xe8, 0x08, 0xd6, 0xe6, 0x30, 0xd8, 0x99, 0xa6, 0xb6, 0xdf, 0x74, 0x49, 0xb9,
+	0x45, 0xd2, 0x63, 0x36, 0x8b, 0xaa, 0xae, 0xce, 0x62, 0xa2, 0x0a, 0x24, 0x79, 0xf3, 0xe4, 0x60,
+	0xc5, 0x18, 0xd6, 0x77, 0x16, 0xb8, 0xb4, 0xee, 0xea, 0xaa, 0x53, 0x33, 0xf9, 0x29, 0xdc, 0xe4,
+	0xf2, 0xeb, 0x36, 0xb1, 0x1f, 0x01, 0x4c, 0x5b, 0x75, 0x91, 0xa0, 0xdf, 0x28, 0xf1, 0xbe, 0xbe,
+	0xe4, 0xf4, 0xf5, 0x25, 0xfe, 0x00, 0xf1, 0x3a, 0x25, 0xad, 0xe3, 0xd6, 0x0c, 0xd5, 0x67, 0x29,
+	0xff, 0x51, 0x82, 0xec, 0xc5, 0x95, 0x45, 0xa8, 0xec, 0x03, 0x78, 0x14, 0x79, 0x3a, 0xb9, 0xe6,
+	0xd6, 0x44, 0xb8, 0xf8, 0xac, 0xd1, 0x6e, 0x00, 0xe1, 0x37, 0xaf, 0x24, 0xcc, 0x89, 0xcc, 0x30,
+	0x2e, 0x41, 0xe6, 0x08, 0x9b, 0xba, 0x61, 0x76, 0xae, 0xe5, 0x29, 0x19, 0xc3, 0xcd, 0x0b, 0xfa,
+	0xde, 0xfe, 0x12, 0x6e, 0x6b, 0x2b, 0x76, 0xb7, 0x75, 0x69, 0x8d, 0x0b, 0xbe, 0x10, 0x9e, 0xbd,
+	0xfc, 0xa7, 0x10, 0xac, 0x07, 0x29, 0x7e, 0xa5, 0xf4, 0xa6, 0xce, 0xa5, 0xb7, 0x77, 0xaf, 0x4b,
+	0xe7, 0xff, 0x34, 0xc7, 0x55, 0xe0, 0x06, 0x2f, 0x9d, 0x8a, 0x79, 0x62, 0xb9, 0xc7, 0x78, 0x7b,
+	0xb6, 0x6c, 0x06, 0xf4, 0x47, 0xee, 0x3c, 0x6b, 0x03, 0xfe, 0x2c, 0x01, 0xf2, 0x83, 0x08, 0xb7,
+	0x7f, 0x83, 0x8d, 0xd1, 0x43, 0x48, 0x89, 0x3e, 0xce, 0x30, 0x4f, 0x2c, 0x11, 0xcb, 0xf9, 0xc0,
+	0x9c, 0x36, 0xe5, 0x05, 0x2d, 0xef, 0x9b, 0xd1, 0xbe, 0x07, 0x9b, 0x55, 0xde, 0x64, 0xb2, 0xb0,
+	0xde, 0x63, 0x2d, 0xec, 0xe2, 0x48, 0x7e, 0x17, 0x72, 0x41, 0x26, 0x5e, 0x9c, 0xc5, 0xba, 0x4c,
+	0xc2, 0x8c, 0x22, 0xaa, 0x18, 0xc9, 0x77, 0x61, 0x63, 0x57, 0xa3, 0xf8, 0xa9, 0x76, 0xad, 0xe7,
+	0x8e, 0xbc, 0x0d, 0x99, 0x79, 0xf5, 0x2b, 0xdb, 0x99, 0x5d, 0x58, 0xab, 0x8c, 0x28, 0x6e, 0x5b,
+	0x3a, 0x5e, 0x9c, 0xb5, 0xf2, 0xce, 0x85, 0x33, 0xa9, 0xad, 0xb5, 0x45, 0xef, 0x5e, 0x09, 0x65,
+	0x25, 0xd5, 0x93, 0xc9, 0x25, 0x48, 0x4f, 0x81, 0xc4, 0xb2, 0x39, 0x48, 0xb4, 0x84, 0x4c, 0x80,
+	0x79, 0x63, 0xf9, 0x67, 0x80, 0xea, 0x6a, 0x75, 0xfb, 0x6d, 0xd6, 0xf1, 0x5f, 0x91, 0x31, 0xef,
+	0xf9, 0xee, 0xdb, 0xea, 0xf6, 0xb7, 0x83, 0x8e, 0x8b, 0xc1, 0x34, 0x46, 0x7d, 0xcc, 0xaf, 0xa3,
+	0xf3, 0x4c, 0x7c, 0x65, 0x06, 0x5f, 0x50, 0x3a, 0x80, 0x18, 0x65, 0x12, 0x91, 0x35, 0xee, 0x06,
+	0x36, 0x8a, 0x17, 0x0d, 0xf9, 0x02, 0xee, 0xfd, 0xe4, 0x10, 0xb9, 0xef, 0x42, 0x94, 0x89, 0xa7,
+	0x3f, 0x4e, 0x24, 0xff, 0x8f, 0x93, 0x0c, 0xc4, 0xc8, 0xa8, 0xd7, 0xb2, 0xce, 0xdc, 0xc7, 0x0e,
+	0x1f, 0xc9, 0xbf, 0x94, 0x20, 0xcd, 0xec, 0xfc, 0x57, 0xe7, 0xb2, 0x66, 0xcc, 0xff, 0x47, 0x66,
+	0x6f, 0xc9, 0x85, 0xce, 0x7a, 0xd0, 0x61, 0x31, 0x21, 0xc6, 0x28, 0x37, 0x3d, 0xea, 0x88, 0x98,
+	0x72, 0x05, 0x95, 0x24, 0xc4, 0x4f, 0x0c, 0x53, 0x6f, 0xb6, 0x46, 0xf2, 0xbf, 0x24, 0xb8, 0xe1,
+	0xe3, 0x20, 0xbc, 0x13, 0xbc, 0x8f, 0x0f, 0x21, 0xae, 0x63, 0xaa, 0x19, 0x67, 0xee, 0x73, 0xa2,
+	0x78, 0xe9, 0x09, 0xd4, 0xb8, 0x9e, 0xdb, 0x73, 0x08, 0x33, 0x7f, 0xfc, 0x85, 0x17, 0xbc, 0xe0,
+	0x22, 0x73, 0x2f, 0x38, 0x54, 0x80, 0x94, 0x41, 0x9a, 0x78, 0x48, 0xb1, 0x6d, 0x6a, 0x67, 0x2c,
+	0xbb, 0x25, 0x54, 0x30, 0x48, 0x5d, 0x48, 0xd0, 0x16, 0xa4, 0xc5, 0x7d, 0x76, 0x82, 0xaa, 0xd9,
+	0xd5, 0x48, 0x57, 0xfc, 0x15, 0x11, 0xef, 0xb5, 0xaa, 0xa5, 0x63, 0xe7, 0x3d, 0x27, 0xff, 0x1c,
+	0xa2, 0xec, 0x97, 0x83, 0xb3, 0xe2, 0xf4, 0xa5, 0xcc, 0xfa, 0x6c, 0xff, 0x5b, 0x37, 0x0b, 0x71,
+	0xfe, 0x24, 0xe5, 0xaf, 0xe8, 0xa4, 0xea, 0x0e, 0x17, 0xbf, 0x82, 0x51, 0x1e, 0xc0, 0xe9, 0x2d,
+	0x34, 0x3a, 0xb0, 0xb1, 0xe3, 0x79, 0xc7, 0xd4, 0x27, 0x91, 0x5f, 0x87, 0x15, 0xf1, 0xbc, 0x5a,
+	0x78, 0x85, 0xf7, 0x61, 0xd5, 0x55, 0x13, 0x47, 0xf2, 0x9e, 0x57, 0x57, 0x78, 0xcb, 0x97, 0x0b,
+	0xfc, 0x99, 0xc2, 0x34, 0x66, 0xab, 0xc7, 0x9d, 0xdf, 0x4a, 0x90, 0xf2, 0xbd, 0x3b, 0xd0, 0x3b,
+	0x90, 0xad, 0xee, 0xed, 0x28, 0x87, 0xcd, 0xe3, 0xc6, 0x4e, 0xe3, 0xf1, 0x71, 0xf3, 0xf1, 0xe1,
+	0xf1, 0x51, 0xbd, 0xaa, 0x7c, 0xa4, 0xd4, 0x6b, 0xe9, 0xa5, 0xdc, 0xc6, 0xb3, 0xe7, 0xc5, 0x1b,
+	0x5c, 0xf3, 0xb1, 0x49, 0xfa, 0xb8, 0x6d, 0x9c, 0x18, 0x58, 0x47, 0xb7, 0x21, 0x33, 0x63, 0xb4,
+	0x53, 0x6d, 0x28, 0x3f, 0xde, 0x69, 0xd4, 0x6b, 0x69, 0x29, 0xb7, 0xf2, 0xec, 0x79, 0x31, 0xb9,
+	0xd3, 0xa6, 0xc6, 0xb9, 0x46, 0xb1, 0x8e, 0xee, 0xce, 0xe1, 0xd7, 0xea, 0x53, 0xe5, 0x50, 0x6e,
+	0xed, 0xd9, 0xf3, 0x62, 0xaa, 0x86, 0x35, 0x57, 0x3d, 0x17, 0xf9, 0xd5, 0xef, 0xf2, 0x4b, 0x77,
+	0x3e, 0x93, 0x20, 0xe9, 0xdd, 0x5d, 0xf4, 0x16, 0x64, 0x1a, 0x3f, 0x3c, 0xa8, 0x1f, 0x36, 0x1b,
+	0x4f, 0x8e, 0xea, 0x73, 0x04, 0x19, 0x80, 0x9f, 0xda, 0xeb, 0xf0, 0x8a, 0x4f, 0x59, 0x39, 0x6c,
+	0xd4, 0xd5, 0xc3, 0x9d, 0x47, 0x69, 0x29, 0xb7, 0xfc, 0xec, 0x79, 0x31, 0xa1, 0x98, 0x22, 0x44,
+	0x66, 0xd5, 0xea, 0x3f, 0x11, 0x6a, 0x21, 0xae, 0xe6, 0x46, 0x52, 0x2e, 0xe1, 0xd0, 0xf9, 0xfc,
+	0xf7, 0x79, 0xa9, 0x72, 0xf8, 0xe2, 0x9f, 0xf9, 0xa5, 0x17, 0x93, 0xbc, 0xf4, 0xc5, 0x24, 0x2f,
+	0xfd, 0x63, 0x92, 0x97, 0x3e, 0x7b, 0x99, 0x5f, 0xfa, 0xe2, 0x65, 0x7e, 0xe9, 0x6f, 0x2f, 0xf3,
+	0x4b, 0x9f, 0xbc, 0x7d, 0xcd, 0x4a, 0xe4, 0xfc, 0xe6, 0x65, 0x3f, 0x6e, 0x5b, 0x31, 0xf6, 0x37,
+	0xf6, 0x9d, 0xff, 0x0c, 0x00, 0xe6, 0x86, 0x2b, 0x6d, 0x6b, 0x16, 0x00, 0x00,
 }
 
 func (m *DepositQueryParams) Marshal() (dAtA []byte, err error) {
@@ -1802,6 +1993,11 @@ func (m *BatchedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.EstimatedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Proof != nil {
 		{
 			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
@@ -2363,6 +2559,11 @@ func (m *CommandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.MaxGasCost != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGasCost))
 		i--
@@ -2411,7 +2612,7 @@ func (m *CommandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingCommandsRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommandStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2421,16 +2622,23 @@ func (m *PendingCommandsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingCommandsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommandStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingCommandsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommandStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Chain) > 0 {
 		i -= len(m.Chain)
 		copy(dAtA[i:], m.Chain)
@@ -2441,7 +2649,7 @@ func (m *PendingCommandsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingCommandsResponse) Marshal() (dAtA []byte, err error) {
+func (m *CommandStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2451,34 +2659,71 @@ func (m *PendingCommandsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingCommandsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommandStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommandStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commands) > 0 {
-		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SigningSession != nil {
+		{
+			size, err := m.SigningSession.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SigningSessionID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SigningSessionID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BatchID) > 0 {
+		i -= len(m.BatchID)
+		copy(dAtA[i:], m.BatchID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Command.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommandResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExecutedCommandsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2488,65 +2733,39 @@ func (m *QueryCommandResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommandResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutedCommandsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutedCommandsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxGasCost != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGasCost))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Params) > 0 {
-		for k := range m.Params {
-			v := m.Params[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BurnerInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *ExecutedCommandsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2556,29 +2775,218 @@ func (m *BurnerInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BurnerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutedCommandsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BurnerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutedCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Address.Size()
-		i -= size
-		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCommandsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommandsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommandsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCommandsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommandsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commands) > 0 {
+		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxGasCost != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGasCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Params) > 0 {
+		for k := range m.Params {
+			v := m.Params[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Address.Size()
+		i -= size
+		if _, err := m.Address.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BurnerInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3278,6 +3686,9 @@ func (m *BatchedCommandsResponse) Size() (n int) {
 		l = m.Proof.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.EstimatedGas != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	}
 	return n
 }
 
@@ -3512,6 +3923,91 @@ func (m *CommandResponse) Size() (n int) {
 	if m.MaxGasCost != 0 {
 		n += 1 + sovQuery(uint64(m.MaxGasCost))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *CommandStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CommandStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Command.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BatchID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BatchStatus))
+	}
+	if m.SigningSessionID != 0 {
+		n += 1 + sovQuery(uint64(m.SigningSessionID))
+	}
+	if m.SigningSession != nil {
+		l = m.SigningSession.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedCommandsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedCommandsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3572,6 +4068,9 @@ func (m *QueryCommandResponse) Size() (n int) {
 	if m.MaxGasCost != 0 {
 		n += 1 + sovQuery(uint64(m.MaxGasCost))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -4404,13 +4903,32 @@ func (m *BatchedCommandsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGas", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.EstimatedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
@@ -6024,6 +6542,25 @@ func (m *CommandResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6045,7 +6582,7 @@ func (m *CommandResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingCommandsRequest) Unmarshal(dAtA []byte) error {
+func (m *CommandStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6068,10 +6605,10 @@ func (m *PendingCommandsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingCommandsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingCommandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6106,6 +6643,38 @@ func (m *PendingCommandsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6127,7 +6696,7 @@ func (m *PendingCommandsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
+func (m *CommandStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6150,15 +6719,15 @@ func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingCommandsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingCommandsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6185,8 +6754,149 @@ func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commands = append(m.Commands, QueryCommandResponse{})
-			if err := m.Commands[len(m.Commands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Command.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStatus", wireType)
+			}
+			m.BatchStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchStatus |= BatchedCommandsStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSessionID", wireType)
+			}
+			m.SigningSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSession", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SigningSession == nil {
+				m.SigningSession = &types.SigningSession{}
+			}
+			if err := m.SigningSession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &CommandExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6211,7 +6921,7 @@ func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutedCommandsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6234,15 +6944,15 @@ func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommandResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutedCommandsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutedCommandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6270,13 +6980,13 @@ func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Chain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6286,56 +6996,460 @@ func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = make(map[string]string)
-			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedCommandsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedCommandsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedCommandsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, CommandExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommandsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommandsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommandsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommandsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommandsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, QueryCommandResponse{})
+			if err := m.Commands[len(m.Commands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = make(map[string]string)
+			}
 			var mapkey string
 			var mapvalue string
 			for iNdEx < postIndex {
@@ -6482,6 +7596,25 @@ func (m *QueryCommandResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
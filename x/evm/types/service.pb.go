@@ -35,79 +35,85 @@ func init() {
 }
 
 var fileDescriptor_69cc2ee75499a0b3 = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcd, 0x4f, 0x24, 0x45,
-	0x1b, 0xc0, 0xa9, 0x37, 0x79, 0x71, 0x2d, 0xd1, 0x5d, 0x2b, 0x1a, 0xe3, 0x88, 0x03, 0x34, 0xb0,
-	0xb0, 0xc3, 0xce, 0x34, 0x1f, 0x7e, 0xc4, 0x3d, 0x98, 0x2c, 0xec, 0xae, 0x6b, 0xd6, 0x0f, 0x04,
-	0x34, 0x86, 0x4b, 0xa7, 0xe8, 0x2e, 0x7a, 0x5a, 0x98, 0xae, 0xd9, 0xae, 0x82, 0x65, 0x42, 0x88,
-	0xc9, 0x9e, 0xf6, 0x60, 0xcc, 0x26, 0x1e, 0xf5, 0xe0, 0xc5, 0x9b, 0x89, 0x47, 0xaf, 0x5e, 0x4c,
-	0x8c, 0x17, 0x37, 0xf1, 0xe2, 0xc5, 0xc4, 0x80, 0xff, 0x83, 0x57, 0x53, 0x4f, 0x57, 0xcd, 0xf4,
-	0xcc, 0x14, 0x45, 0x7b, 0x63, 0x52, 0xbf, 0xa7, 0x9f, 0xdf, 0x53, 0x5f, 0x4f, 0x81, 0x27, 0xe9,
-	0x11, 0xdb, 0xa7, 0x99, 0xcf, 0x0e, 0x5b, 0xfe, 0xe1, 0xd2, 0x0e, 0x93, 0x74, 0xc9, 0x17, 0x2c,
-	0x3b, 0x4c, 0x42, 0xd6, 0x68, 0x67, 0x5c, 0x72, 0x42, 0x72, 0xa2, 0xc1, 0x0e, 0x5b, 0x0d, 0x4d,
-	0x54, 0x5e, 0x88, 0x79, 0xcc, 0x61, 0xd8, 0x57, 0x7f, 0xe5, 0x64, 0x65, 0x3c, 0xe6, 0x3c, 0xde,
-	0x67, 0x3e, 0x6d, 0x27, 0x3e, 0x4d, 0x53, 0x2e, 0xa9, 0x4c, 0x78, 0x2a, 0xf4, 0xe8, 0x2b, 0x96,
-	0x4c, 0xf2, 0x48, 0x0f, 0x56, 0x2d, 0x83, 0xf7, 0x0f, 0x58, 0xd6, 0xc9, 0xc7, 0x97, 0x7f, 0xbc,
-	0x82, 0xf1, 0xfb, 0x22, 0xde, 0xcc, 0xcd, 0xc8, 0x77, 0x08, 0xe3, 0x4d, 0x26, 0xdf, 0xa1, 0x92,
-	0x3d, 0xa0, 0x1d, 0x32, 0xdb, 0x18, 0x76, 0x6c, 0xf4, 0xc6, 0x37, 0xd8, 0xfd, 0x03, 0x26, 0x64,
-	0xe5, 0xea, 0x45, 0x98, 0x68, 0xf3, 0x54, 0x30, 0xef, 0xee, 0xc3, 0xdf, 0xff, 0xfe, 0xea, 0x7f,
-	0xab, 0x37, 0x50, 0x6d, 0x7b, 0xfc, 0x06, 0xaa, 0x79, 0x2f, 0xf9, 0x05, 0x3b, 0xc1, 0x64, 0x10,
-	0xe7, 0x01, 0xde, 0x84, 0x6f, 0x9d, 0xbd, 0x2e, 0x40, 0x7e, 0x46, 0xf8, 0xca, 0x1a, 0x4f, 0x77,
-	0x93, 0xac, 0xa5, 0x93, 0x6c, 0x1d, 0x91, 0x05, 0x9b, 0xc6, 0x20, 0x65, 0x9c, 0xaf, 0x97, 0x83,
-	0xb5, 0xf9, 0x27, 0x60, 0xbe, 0xae, 0xcc, 0xa7, 0x95, 0x79, 0xb5, 0x28, 0x18, 0xe6, 0x51, 0x46,
-	0x2e, 0x90, 0x47, 0xde, 0x55, 0x5b, 0x01, 0xc3, 0x1c, 0xf9, 0x15, 0xe1, 0xe7, 0x07, 0x93, 0x0a,
-	0x52, 0xca, 0x4d, 0x98, 0x4a, 0xea, 0x25, 0x69, 0x5d, 0xca, 0xa7, 0x50, 0xca, 0x86, 0x2a, 0x65,
-	0x46, 0x95, 0x32, 0xe1, 0x2e, 0x45, 0x78, 0x73, 0xe5, 0x6a, 0x11, 0xe4, 0x07, 0x84, 0xc7, 0x74,
-	0xde, 0x2d, 0xbe, 0xc7, 0x52, 0x32, 0xe7, 0x30, 0x03, 0xc2, 0x94, 0x30, 0x7f, 0x31, 0xa8, 0xed,
-	0xdf, 0x03, 0xfb, 0x3b, 0xca, 0xbe, 0xaa, 0xec, 0x5f, 0xb6, 0xd9, 0x4b, 0x15, 0xe2, 0x4d, 0xb9,
-	0xbc, 0x01, 0x21, 0xbf, 0x21, 0x4c, 0x4c, 0x9a, 0x8c, 0xa6, 0x62, 0x97, 0x65, 0xf7, 0x58, 0x87,
-	0xb8, 0x66, 0xb4, 0xc0, 0x19, 0xfb, 0x46, 0x59, 0x5c, 0xd7, 0xb0, 0x0d, 0x35, 0x6c, 0xa9, 0x1a,
-	0x66, 0x55, 0x0d, 0x93, 0xd6, 0x1a, 0x74, 0x60, 0xb0, 0xc7, 0x3a, 0xde, 0xbc, 0xb3, 0x94, 0x02,
-	0x99, 0x6f, 0xa8, 0x8c, 0x51, 0xc9, 0x6e, 0xb1, 0xf6, 0x3e, 0xef, 0xe4, 0x0b, 0x61, 0xdf, 0x50,
-	0x83, 0x98, 0x7b, 0x43, 0x0d, 0xd3, 0xa5, 0x36, 0x14, 0x84, 0x05, 0x11, 0xc4, 0xe9, 0x85, 0xb1,
-	0x6f, 0xa8, 0x61, 0x90, 0xfc, 0x83, 0x70, 0x25, 0xcf, 0x6b, 0xa6, 0xf1, 0xc3, 0x36, 0xcb, 0xa8,
-	0xe4, 0x99, 0x68, 0x26, 0x6d, 0xf2, 0xfa, 0xf9, 0x9e, 0x36, 0xde, 0x94, 0xf7, 0xc6, 0x7f, 0x0d,
-	0xd3, 0x75, 0x7e, 0x06, 0x75, 0x46, 0xaa, 0xce, 0xba, 0xaa, 0x73, 0xde, 0x52, 0x67, 0x77, 0x2d,
-	0x78, 0xe1, 0x0b, 0xde, 0xa2, 0xa3, 0x60, 0x6b, 0x04, 0x1c, 0xa5, 0xcd, 0x24, 0x4e, 0xd7, 0x78,
-	0xab, 0x45, 0xd3, 0x48, 0xd8, 0x8f, 0x52, 0x91, 0x70, 0x1e, 0xa5, 0x7e, 0xb0, 0xcc, 0x51, 0x12,
-	0x49, 0x9c, 0x06, 0xa1, 0x0e, 0xb1, 0x1f, 0xa5, 0x3e, 0x84, 0x7c, 0x8d, 0xf0, 0xa5, 0x9b, 0x51,
-	0xb4, 0xd6, 0xa4, 0x49, 0x4a, 0xa6, 0x6d, 0x12, 0x66, 0xd4, 0x98, 0xce, 0xb8, 0x21, 0x6d, 0x79,
-	0x0b, 0x2c, 0xdf, 0x56, 0x96, 0x15, 0x65, 0xf9, 0x62, 0x51, 0x85, 0x46, 0x51, 0x10, 0x2a, 0xdc,
-	0x7b, 0xd5, 0x66, 0xd8, 0x1d, 0x86, 0x7e, 0xb1, 0xc1, 0x64, 0xd6, 0xb9, 0x43, 0x93, 0x7d, 0x16,
-	0xdd, 0x3e, 0x64, 0xa9, 0xb4, 0xf7, 0x8b, 0x41, 0xca, 0xd9, 0x2f, 0x86, 0xe1, 0x32, 0xfd, 0x22,
-	0x53, 0x51, 0xf5, 0x5d, 0x08, 0xab, 0x33, 0x15, 0x67, 0xef, 0x17, 0xc0, 0x05, 0x39, 0x17, 0x00,
-	0x47, 0x1e, 0x21, 0x3c, 0xf6, 0x71, 0x3b, 0xa2, 0x92, 0xad, 0xd3, 0x8c, 0xb6, 0xce, 0xd9, 0x17,
-	0x45, 0xc2, 0xb9, 0x2f, 0xfa, 0x41, 0xed, 0x3e, 0x0b, 0xee, 0x13, 0x4a, 0xbb, 0x62, 0xd3, 0x6a,
-	0x03, 0xbe, 0xfc, 0xe7, 0xb3, 0x78, 0xec, 0x23, 0xf5, 0x92, 0x28, 0xbc, 0x1d, 0x2e, 0xaf, 0x52,
-	0x19, 0x36, 0x59, 0xd4, 0xdd, 0xb6, 0x35, 0x5b, 0xd6, 0x01, 0xc8, 0x18, 0x2e, 0x94, 0x62, 0xb5,
-	0xe4, 0x5b, 0x20, 0xb9, 0x42, 0x96, 0x6c, 0x86, 0x3b, 0x79, 0x50, 0x77, 0x73, 0xfa, 0xc7, 0xb0,
-	0x0b, 0x4e, 0xfc, 0xe3, 0x24, 0x3a, 0x21, 0xdf, 0xf7, 0x2e, 0x7d, 0x78, 0x47, 0xdd, 0x65, 0x49,
-	0xdc, 0x94, 0xce, 0x4b, 0xbf, 0xc0, 0x95, 0xb9, 0xf4, 0xfb, 0x70, 0x2d, 0xfc, 0x26, 0x08, 0x2f,
-	0x11, 0xdf, 0x71, 0x95, 0x43, 0x5c, 0xd0, 0x84, 0x40, 0xe3, 0x4c, 0xbe, 0x45, 0xf8, 0xf2, 0x3a,
-	0x4b, 0xa3, 0x24, 0x8d, 0xdd, 0xd3, 0x3a, 0x00, 0x39, 0xa7, 0x75, 0x88, 0xd5, 0x96, 0xaf, 0x81,
-	0x65, 0x83, 0x5c, 0xb7, 0x2e, 0x7c, 0x1e, 0x34, 0x34, 0xad, 0x44, 0xe0, 0x51, 0x38, 0xb4, 0x82,
-	0x4c, 0x59, 0x67, 0x05, 0xc6, 0x8c, 0x8f, 0xe7, 0x42, 0xb4, 0x86, 0x07, 0x1a, 0xe3, 0xc4, 0xba,
-	0xff, 0xc2, 0x3c, 0xd5, 0xe7, 0xf8, 0x29, 0xad, 0x4f, 0xec, 0x9f, 0xcc, 0x07, 0x4d, 0xda, 0x69,
-	0x27, 0xa3, 0xf3, 0x2e, 0x40, 0xde, 0x59, 0x32, 0x6d, 0x5f, 0x24, 0x80, 0x83, 0x2c, 0xff, 0x22,
-	0xf9, 0x02, 0x61, 0x7c, 0x8f, 0x75, 0x6e, 0x46, 0x51, 0xc6, 0x84, 0xb0, 0xbf, 0x95, 0x7b, 0xe3,
-	0xce, 0xb7, 0x72, 0x11, 0xd3, 0x2a, 0x3e, 0xa8, 0x5c, 0x23, 0xd6, 0x66, 0xb9, 0xc7, 0x3a, 0x01,
-	0xcd, 0x03, 0xba, 0x8b, 0xf0, 0x0d, 0xc2, 0xcf, 0xe9, 0xe7, 0x9e, 0x51, 0xba, 0x66, 0xcb, 0xd5,
-	0xcf, 0x18, 0xad, 0x5a, 0x19, 0x54, 0xab, 0xad, 0x80, 0x5a, 0x9d, 0x2c, 0xd8, 0xd4, 0xcc, 0x83,
-	0x70, 0x50, 0xef, 0x4b, 0x84, 0x2f, 0xad, 0x76, 0x24, 0x0b, 0x79, 0xc4, 0xec, 0xfd, 0xc1, 0x8c,
-	0x3a, 0xfb, 0x43, 0x0f, 0x2a, 0x73, 0xae, 0x76, 0x34, 0xdd, 0xbb, 0x00, 0x42, 0x9e, 0xca, 0x8c,
-	0x86, 0xf2, 0x84, 0x3c, 0x44, 0xf8, 0xff, 0x79, 0x1f, 0x98, 0xb4, 0x25, 0xea, 0xbb, 0xfc, 0xa7,
-	0x1c, 0x44, 0x99, 0x93, 0x03, 0x97, 0x77, 0x4f, 0x02, 0x7e, 0x06, 0xea, 0x2e, 0x7a, 0x8c, 0xf0,
-	0x33, 0xb7, 0x37, 0xd6, 0x96, 0x17, 0xe1, 0x49, 0x25, 0x88, 0x75, 0x77, 0x14, 0x00, 0x23, 0x34,
-	0x77, 0x21, 0xa7, 0xb5, 0x16, 0x41, 0xab, 0x46, 0xac, 0x2f, 0x48, 0x96, 0x85, 0xcb, 0x8b, 0xf9,
-	0x5b, 0xab, 0xb7, 0x50, 0x8f, 0x10, 0x7e, 0x1a, 0x3e, 0xf2, 0x6e, 0xba, 0xcb, 0x89, 0x75, 0x11,
-	0xba, 0xc3, 0x46, 0x67, 0xf6, 0x02, 0x4a, 0xcb, 0x34, 0x40, 0x66, 0x9e, 0x58, 0xbb, 0x1d, 0x68,
-	0x04, 0x49, 0xba, 0xcb, 0xbb, 0x2a, 0xc7, 0x78, 0x54, 0xb7, 0x39, 0xeb, 0x02, 0xf4, 0x37, 0x38,
-	0xcf, 0x85, 0x68, 0x81, 0x1a, 0x08, 0xcc, 0x10, 0xef, 0xfc, 0xbe, 0x66, 0x92, 0xaf, 0x7e, 0xf0,
-	0xcb, 0x69, 0x15, 0x3d, 0x39, 0xad, 0xa2, 0xbf, 0x4e, 0xab, 0xe8, 0xf1, 0x59, 0x75, 0xe4, 0xa7,
-	0xb3, 0x2a, 0x7a, 0x72, 0x56, 0x1d, 0xf9, 0xe3, 0xac, 0x3a, 0xb2, 0xbd, 0x18, 0x27, 0xb2, 0x79,
-	0xb0, 0xd3, 0x08, 0x79, 0x4b, 0x7f, 0x2b, 0x65, 0xf2, 0x01, 0xcf, 0xf6, 0xf4, 0xaf, 0x7a, 0xc8,
-	0x33, 0xe6, 0x1f, 0x41, 0x02, 0xd9, 0x69, 0x33, 0xb1, 0x33, 0x0a, 0xff, 0x70, 0xaf, 0xfc, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x33, 0xe6, 0xaf, 0x19, 0x10, 0x00, 0x00,
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0x80, 0x3b, 0x48, 0x84, 0x32, 0x04, 0x9a, 0x8e, 0x8a, 0x10, 0x26, 0x38, 0xc9, 0x26, 0x69,
+	0x52, 0xa7, 0xf6, 0x3a, 0x09, 0x2d, 0xa2, 0x07, 0xa4, 0x26, 0x4d, 0x29, 0x2a, 0x85, 0x90, 0x84,
+	0x0a, 0xe5, 0xb2, 0x9a, 0xec, 0x4e, 0xec, 0x25, 0xf1, 0x8e, 0xbb, 0x33, 0x71, 0x6d, 0x45, 0x11,
+	0x52, 0x4f, 0x3d, 0x20, 0x54, 0x89, 0x23, 0x20, 0x71, 0x28, 0x37, 0x24, 0xc4, 0x3f, 0xe0, 0x82,
+	0x84, 0xb8, 0x50, 0x89, 0x0b, 0x47, 0x94, 0x70, 0xe3, 0x07, 0x70, 0x45, 0xfb, 0x76, 0xc6, 0x5e,
+	0xdb, 0x93, 0xc9, 0x72, 0x6b, 0x34, 0xdf, 0x9b, 0xf7, 0xbd, 0x99, 0x37, 0x3b, 0xe3, 0xe2, 0x49,
+	0xda, 0x66, 0xfb, 0x34, 0x76, 0x59, 0xab, 0xe1, 0xb6, 0x16, 0x77, 0x98, 0xa4, 0x8b, 0xae, 0x60,
+	0x71, 0x2b, 0xf4, 0x59, 0xa5, 0x19, 0x73, 0xc9, 0x09, 0x49, 0x89, 0x0a, 0x6b, 0x35, 0x2a, 0x8a,
+	0x28, 0x5c, 0xaa, 0xf1, 0x1a, 0x87, 0x61, 0x37, 0xf9, 0x57, 0x4a, 0x16, 0xc6, 0x6b, 0x9c, 0xd7,
+	0xf6, 0x99, 0x4b, 0x9b, 0xa1, 0x4b, 0xa3, 0x88, 0x4b, 0x2a, 0x43, 0x1e, 0x09, 0x35, 0xfa, 0x86,
+	0x21, 0x93, 0x6c, 0xab, 0xc1, 0xa2, 0x61, 0xf0, 0xc1, 0x01, 0x8b, 0x3b, 0xe9, 0xf8, 0xd2, 0x3f,
+	0x17, 0x31, 0xbe, 0x27, 0x6a, 0x9b, 0xa9, 0x19, 0xf9, 0x1e, 0x61, 0xbc, 0xc9, 0xe4, 0x7b, 0x54,
+	0xb2, 0x87, 0xb4, 0x43, 0x66, 0x2b, 0xc3, 0x8e, 0x95, 0xde, 0xf8, 0x06, 0x7b, 0x70, 0xc0, 0x84,
+	0x2c, 0x5c, 0x3e, 0x0b, 0x13, 0x4d, 0x1e, 0x09, 0xe6, 0xdc, 0x79, 0xf4, 0xc7, 0xdf, 0x5f, 0x3d,
+	0xb7, 0x72, 0x03, 0x95, 0xb6, 0xc7, 0x6f, 0xa0, 0x92, 0xf3, 0x9a, 0x9b, 0xb1, 0x13, 0x4c, 0x7a,
+	0xb5, 0x34, 0xc0, 0x99, 0x70, 0x8d, 0xab, 0xd7, 0x05, 0xc8, 0x2f, 0x08, 0x8f, 0xad, 0xf2, 0x68,
+	0x37, 0x8c, 0x1b, 0x2a, 0xc9, 0x56, 0x9b, 0x2c, 0x98, 0x34, 0x06, 0x29, 0xed, 0x7c, 0x35, 0x1f,
+	0xac, 0xcc, 0xef, 0x83, 0xf9, 0x7a, 0x62, 0x3e, 0x9d, 0x98, 0x17, 0xb3, 0x82, 0x7e, 0x1a, 0xa5,
+	0xe5, 0x3c, 0xd9, 0x76, 0x2e, 0x9b, 0x0a, 0x18, 0xe6, 0xc8, 0x6f, 0x08, 0x5f, 0x1c, 0x4c, 0x2a,
+	0x48, 0x2e, 0x37, 0xa1, 0x2b, 0x29, 0xe7, 0xa4, 0x55, 0x29, 0x9f, 0x42, 0x29, 0x1b, 0x49, 0x29,
+	0x33, 0x49, 0x29, 0x13, 0xf6, 0x52, 0x84, 0x33, 0x97, 0xaf, 0x16, 0x41, 0x7e, 0x42, 0xf8, 0x92,
+	0xca, 0xbb, 0x79, 0xff, 0x5e, 0xa6, 0x1e, 0xd7, 0x62, 0xd8, 0x47, 0xea, 0x92, 0xaa, 0xf9, 0x03,
+	0x54, 0x55, 0xd7, 0xa1, 0xaa, 0x6a, 0x52, 0xd0, 0x82, 0xcd, 0x57, 0xb4, 0xfa, 0x9d, 0x7f, 0x44,
+	0x78, 0x54, 0x4d, 0xbc, 0xc5, 0xf7, 0x58, 0x44, 0xe6, 0x2c, 0xa9, 0x81, 0xd0, 0x8e, 0xf3, 0x67,
+	0x83, 0xca, 0xed, 0x03, 0x70, 0xbb, 0x9d, 0xac, 0x78, 0x31, 0x11, 0x7c, 0xdd, 0xb4, 0xe2, 0x32,
+	0x09, 0x71, 0xa6, 0x6c, 0xee, 0x80, 0x90, 0xdf, 0x11, 0x26, 0x3a, 0x4d, 0x4c, 0x23, 0xb1, 0xcb,
+	0xe2, 0xbb, 0xac, 0x43, 0x6c, 0x5d, 0x90, 0xe1, 0xb4, 0x7d, 0x25, 0x2f, 0xae, 0x6a, 0xd8, 0x86,
+	0x1a, 0xb6, 0x92, 0x1a, 0x66, 0x93, 0x1a, 0x26, 0x8d, 0x35, 0xa8, 0x40, 0x6f, 0x8f, 0x75, 0x9c,
+	0x79, 0x6b, 0x29, 0x19, 0x32, 0x3d, 0x04, 0x31, 0xa3, 0x92, 0xdd, 0x62, 0xcd, 0x7d, 0xde, 0x49,
+	0x37, 0xc2, 0x7c, 0x08, 0x06, 0x31, 0xfb, 0x21, 0x18, 0xa6, 0x73, 0x1d, 0x02, 0x08, 0xf3, 0x02,
+	0x88, 0x53, 0x1b, 0x63, 0x3e, 0x04, 0xc3, 0x20, 0xf9, 0x17, 0xe1, 0x42, 0x9a, 0x57, 0x2f, 0xe3,
+	0x47, 0x4d, 0x16, 0x53, 0xc9, 0x63, 0x51, 0x0f, 0x9b, 0xe4, 0xda, 0xe9, 0x9e, 0x26, 0x5e, 0x97,
+	0x77, 0xfd, 0xff, 0x86, 0xa9, 0x3a, 0x3f, 0x83, 0x3a, 0x83, 0xa4, 0xce, 0x72, 0x52, 0xe7, 0xbc,
+	0xa1, 0xce, 0xee, 0x5e, 0xf0, 0xcc, 0x0c, 0x4e, 0xd5, 0x52, 0xb0, 0x31, 0x02, 0x8e, 0xd2, 0x66,
+	0x58, 0x8b, 0x56, 0x79, 0xa3, 0x41, 0xa3, 0x40, 0x98, 0x8f, 0x52, 0x96, 0xb0, 0x1e, 0xa5, 0x7e,
+	0x30, 0xcf, 0x51, 0x12, 0x61, 0x2d, 0xf2, 0x7c, 0x15, 0x62, 0x3e, 0x4a, 0x7d, 0x08, 0xf9, 0x1a,
+	0xe1, 0xf3, 0x37, 0x83, 0x60, 0xb5, 0x4e, 0xc3, 0x88, 0x4c, 0x9b, 0x24, 0xf4, 0xa8, 0x36, 0x9d,
+	0xb1, 0x43, 0xca, 0xf2, 0x16, 0x58, 0xbe, 0x9b, 0x58, 0x16, 0x12, 0xcb, 0x57, 0xb3, 0x2a, 0x34,
+	0x08, 0x3c, 0x3f, 0xc1, 0x9d, 0x37, 0x4d, 0x86, 0xdd, 0x61, 0xb8, 0xe3, 0x36, 0x98, 0x8c, 0x3b,
+	0xb7, 0x69, 0xb8, 0xcf, 0x82, 0xb5, 0x16, 0x8b, 0xa4, 0xf9, 0x8e, 0x1b, 0xa4, 0xac, 0x77, 0xdc,
+	0x30, 0x9c, 0xe7, 0x8e, 0x8b, 0x93, 0xa8, 0xf2, 0x2e, 0x84, 0x95, 0x59, 0x12, 0x67, 0xbe, 0xe3,
+	0x80, 0xf3, 0x52, 0xce, 0x03, 0x8e, 0x3c, 0x46, 0x78, 0xf4, 0x93, 0x66, 0x40, 0x25, 0x5b, 0xa7,
+	0x31, 0x6d, 0x9c, 0xd2, 0x17, 0x59, 0xc2, 0xda, 0x17, 0xfd, 0xa0, 0x72, 0x9f, 0x05, 0xf7, 0x89,
+	0x44, 0xbb, 0x60, 0xd2, 0x6a, 0x02, 0xbe, 0xf4, 0x74, 0x0c, 0x8f, 0x7e, 0x9c, 0xbc, 0x7e, 0x32,
+	0xef, 0x9d, 0x0b, 0x2b, 0x54, 0xfa, 0x75, 0x16, 0x74, 0xdb, 0xb6, 0x64, 0xca, 0x3a, 0x00, 0x69,
+	0xc3, 0x85, 0x5c, 0xac, 0x92, 0x7c, 0x07, 0x24, 0x97, 0xc9, 0xa2, 0xc9, 0x70, 0x27, 0x0d, 0xea,
+	0x36, 0xa7, 0x7b, 0x08, 0x5d, 0x70, 0xe4, 0x1e, 0x86, 0xc1, 0x11, 0xf9, 0xa1, 0xf7, 0xd1, 0x87,
+	0xb7, 0xdf, 0x1d, 0x16, 0xd6, 0xea, 0xd2, 0xfa, 0xd1, 0xcf, 0x70, 0x79, 0x3e, 0xfa, 0x7d, 0xb8,
+	0x12, 0x7e, 0x1b, 0x84, 0x17, 0x89, 0x6b, 0xf9, 0x94, 0x43, 0x9c, 0x57, 0x87, 0x40, 0xed, 0x4c,
+	0xbe, 0x43, 0xf8, 0xc2, 0x3a, 0x8b, 0x82, 0x30, 0xaa, 0xd9, 0x97, 0x75, 0x00, 0xb2, 0x2e, 0xeb,
+	0x10, 0xab, 0x2c, 0xdf, 0x02, 0xcb, 0x0a, 0xb9, 0x6a, 0xdc, 0xf8, 0x34, 0x68, 0x68, 0x59, 0x89,
+	0xc0, 0x23, 0x70, 0x68, 0x05, 0x99, 0x32, 0xae, 0x0a, 0x8c, 0x69, 0x1f, 0xc7, 0x86, 0x28, 0x0d,
+	0x07, 0x34, 0xc6, 0x89, 0xb1, 0xff, 0xfc, 0x34, 0xd5, 0xe7, 0xf8, 0x05, 0xa5, 0x4f, 0xcc, 0x53,
+	0xa6, 0x83, 0x3a, 0xed, 0xb4, 0x95, 0x51, 0x79, 0x17, 0x20, 0xef, 0x2c, 0x99, 0x36, 0x6f, 0x12,
+	0xc0, 0x5e, 0x9c, 0xce, 0x48, 0xbe, 0x45, 0xf8, 0x65, 0x35, 0xc1, 0xa6, 0xa4, 0xf2, 0x40, 0x90,
+	0x79, 0x4b, 0x8e, 0x14, 0xd1, 0x36, 0x57, 0x72, 0x90, 0xf9, 0x1a, 0x27, 0x75, 0x12, 0x10, 0xd3,
+	0xdf, 0xe7, 0x4f, 0x11, 0x1e, 0x5b, 0x6b, 0x33, 0xff, 0x40, 0x66, 0x0e, 0xa4, 0xb1, 0x1b, 0x06,
+	0x29, 0xeb, 0x37, 0x6f, 0x18, 0x56, 0xa2, 0xd7, 0x40, 0xd4, 0x25, 0x65, 0x93, 0x28, 0x53, 0x51,
+	0xc3, 0xcd, 0xf3, 0x05, 0xc2, 0xf8, 0x2e, 0xeb, 0xdc, 0x0c, 0x82, 0x98, 0x09, 0x61, 0xfe, 0x99,
+	0xd4, 0x1b, 0xb7, 0xfe, 0x4c, 0xca, 0x62, 0x4a, 0xca, 0x05, 0xa9, 0x2b, 0xc4, 0xf8, 0xe6, 0xd8,
+	0x63, 0x1d, 0x8f, 0xa6, 0x01, 0x5d, 0x9d, 0x6f, 0x10, 0x7e, 0x45, 0xbd, 0x89, 0xb5, 0x92, 0x71,
+	0xb3, 0xfa, 0x19, 0xad, 0x55, 0xca, 0x83, 0x2a, 0xb5, 0x65, 0x50, 0x2b, 0x13, 0xe3, 0x1b, 0x5b,
+	0xbf, 0xab, 0x07, 0xf5, 0xbe, 0x44, 0xf8, 0xfc, 0x4a, 0x47, 0x32, 0x9f, 0x07, 0xcc, 0x7c, 0xcd,
+	0xea, 0x51, 0xeb, 0x35, 0xdb, 0x83, 0xf2, 0x74, 0xd9, 0x8e, 0xa2, 0x7b, 0xfd, 0xe5, 0xf3, 0x48,
+	0xc6, 0xd4, 0x97, 0x47, 0xe4, 0x11, 0xc2, 0xcf, 0xa7, 0xd7, 0xe9, 0xa4, 0xb1, 0x5b, 0xb2, 0x77,
+	0xe8, 0x94, 0x85, 0xc8, 0xf3, 0x01, 0x82, 0x3b, 0xb0, 0x27, 0x01, 0x7f, 0x7a, 0x49, 0xab, 0x3f,
+	0x41, 0xf8, 0xa5, 0xb5, 0x8d, 0xd5, 0xa5, 0x2a, 0xbc, 0x4c, 0x05, 0x31, 0x76, 0x47, 0x06, 0xd0,
+	0x42, 0x73, 0x67, 0x72, 0x4a, 0xab, 0x0a, 0x5a, 0x25, 0x62, 0x7c, 0x88, 0xb3, 0xd8, 0x5f, 0xaa,
+	0xa6, 0x4f, 0xd6, 0xde, 0x46, 0x3d, 0x46, 0xf8, 0x45, 0x98, 0xe4, 0xfd, 0x68, 0x97, 0x13, 0xe3,
+	0x26, 0x74, 0x87, 0xb5, 0xce, 0xec, 0x19, 0x94, 0x92, 0xa9, 0x80, 0xcc, 0x3c, 0x31, 0x3e, 0x1a,
+	0x40, 0xc3, 0x0b, 0xa3, 0x5d, 0xde, 0x55, 0x39, 0xc4, 0x23, 0xea, 0xb5, 0x60, 0xdc, 0x80, 0xfe,
+	0x77, 0x82, 0x63, 0x43, 0x94, 0x40, 0x09, 0x04, 0x66, 0x88, 0x73, 0xfa, 0xf3, 0x40, 0x27, 0x5f,
+	0xf9, 0xf0, 0xd7, 0xe3, 0x22, 0x7a, 0x76, 0x5c, 0x44, 0x7f, 0x1d, 0x17, 0xd1, 0x93, 0x93, 0xe2,
+	0xb9, 0x9f, 0x4f, 0x8a, 0xe8, 0xd9, 0x49, 0xf1, 0xdc, 0x9f, 0x27, 0xc5, 0x73, 0xdb, 0xd5, 0x5a,
+	0x28, 0xeb, 0x07, 0x3b, 0x15, 0x9f, 0x37, 0xd4, 0x5c, 0x11, 0x93, 0x0f, 0x79, 0xbc, 0xa7, 0xfe,
+	0x2a, 0xfb, 0x3c, 0x66, 0x6e, 0x1b, 0x12, 0xc8, 0x4e, 0x93, 0x89, 0x9d, 0x11, 0xf8, 0xbf, 0x96,
+	0xe5, 0xff, 0x06, 0x00, 0x87, 0x17, 0x65, 0xb3, 0x14, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -126,6 +132,7 @@ type MsgServiceClient interface {
 	// Deprecated: use ConfirmGatewayTxs instead
 	ConfirmGatewayTx(ctx context.Context, in *ConfirmGatewayTxRequest, opts ...grpc.CallOption) (*ConfirmGatewayTxResponse, error)
 	ConfirmGatewayTxs(ctx context.Context, in *ConfirmGatewayTxsRequest, opts ...grpc.CallOption) (*ConfirmGatewayTxsResponse, error)
+	ConfirmSVMGatewayTxs(ctx context.Context, in *ConfirmSVMGatewayTxsRequest, opts ...grpc.CallOption) (*ConfirmSVMGatewayTxsResponse, error)
 	ConfirmToken(ctx context.Context, in *ConfirmTokenRequest, opts ...grpc.CallOption) (*ConfirmTokenResponse, error)
 	ConfirmTransferKey(ctx context.Context, in *ConfirmTransferKeyRequest, opts ...grpc.CallOption) (*ConfirmTransferKeyResponse, error)
 	CreateDeployToken(ctx context.Context, in *CreateDeployTokenRequest, opts ...grpc.CallOption) (*CreateDeployTokenResponse, error)
@@ -171,6 +178,15 @@ func (c *msgServiceClient) ConfirmGatewayTxs(ctx context.Context, in *ConfirmGat
 	return out, nil
 }
 
+func (c *msgServiceClient) ConfirmSVMGatewayTxs(ctx context.Context, in *ConfirmSVMGatewayTxsRequest, opts ...grpc.CallOption) (*ConfirmSVMGatewayTxsResponse, error) {
+	out := new(ConfirmSVMGatewayTxsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.MsgService/ConfirmSVMGatewayTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgServiceClient) ConfirmToken(ctx context.Context, in *ConfirmTokenRequest, opts ...grpc.CallOption) (*ConfirmTokenResponse, error) {
 	out := new(ConfirmTokenResponse)
 	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.MsgService/ConfirmToken", in, out, opts...)
@@ -249,6 +265,7 @@ type MsgServiceServer interface {
 	// Deprecated: use ConfirmGatewayTxs instead
 	ConfirmGatewayTx(context.Context, *ConfirmGatewayTxRequest) (*ConfirmGatewayTxResponse, error)
 	ConfirmGatewayTxs(context.Context, *ConfirmGatewayTxsRequest) (*ConfirmGatewayTxsResponse, error)
+	ConfirmSVMGatewayTxs(context.Context, *ConfirmSVMGatewayTxsRequest) (*ConfirmSVMGatewayTxsResponse, error)
 	ConfirmToken(context.Context, *ConfirmTokenRequest) (*ConfirmTokenResponse, error)
 	ConfirmTransferKey(context.Context, *ConfirmTransferKeyRequest) (*ConfirmTransferKeyResponse, error)
 	CreateDeployToken(context.Context, *CreateDeployTokenRequest) (*CreateDeployTokenResponse, error)
@@ -272,6 +289,9 @@ func (*UnimplementedMsgServiceServer) ConfirmGatewayTx(ctx context.Context, req
 func (*UnimplementedMsgServiceServer) ConfirmGatewayTxs(ctx context.Context, req *ConfirmGatewayTxsRequest) (*ConfirmGatewayTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmGatewayTxs not implemented")
 }
+func (*UnimplementedMsgServiceServer) ConfirmSVMGatewayTxs(ctx context.Context, req *ConfirmSVMGatewayTxsRequest) (*ConfirmSVMGatewayTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSVMGatewayTxs not implemented")
+}
 func (*UnimplementedMsgServiceServer) ConfirmToken(ctx context.Context, req *ConfirmTokenRequest) (*ConfirmTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmToken not implemented")
 }
@@ -355,6 +375,24 @@ func _MsgService_ConfirmGatewayTxs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_ConfirmSVMGatewayTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSVMGatewayTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).ConfirmSVMGatewayTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.MsgService/ConfirmSVMGatewayTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).ConfirmSVMGatewayTxs(ctx, req.(*ConfirmSVMGatewayTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MsgService_ConfirmToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmTokenRequest)
 	if err := dec(in); err != nil {
@@ -515,6 +553,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmGatewayTxs",
 			Handler:    _MsgService_ConfirmGatewayTxs_Handler,
 		},
+		{
+			MethodName: "ConfirmSVMGatewayTxs",
+			Handler:    _MsgService_ConfirmSVMGatewayTxs_Handler,
+		},
 		{
 			MethodName: "ConfirmToken",
 			Handler:    _MsgService_ConfirmToken_Handler,
@@ -568,6 +610,12 @@ type QueryServiceClient interface {
 	Chains(ctx context.Context, in *ChainsRequest, opts ...grpc.CallOption) (*ChainsResponse, error)
 	// Command queries the command of a chain provided the command id
 	Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	// CommandStatus queries the batch, signing session and execution of a
+	// command of a chain provided the command id
+	CommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandStatusResponse, error)
+	// ExecutedCommands queries the history of commands executed on the gateway
+	// of a chain
+	ExecutedCommands(ctx context.Context, in *ExecutedCommandsRequest, opts ...grpc.CallOption) (*ExecutedCommandsResponse, error)
 	// KeyAddress queries the address of key of a chain
 	KeyAddress(ctx context.Context, in *KeyAddressRequest, opts ...grpc.CallOption) (*KeyAddressResponse, error)
 	// GatewayAddress queries the address of axelar gateway at the specified
@@ -638,6 +686,24 @@ func (c *queryServiceClient) Command(ctx context.Context, in *CommandRequest, op
 	return out, nil
 }
 
+func (c *queryServiceClient) CommandStatus(ctx context.Context, in *CommandStatusRequest, opts ...grpc.CallOption) (*CommandStatusResponse, error) {
+	out := new(CommandStatusResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/CommandStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ExecutedCommands(ctx context.Context, in *ExecutedCommandsRequest, opts ...grpc.CallOption) (*ExecutedCommandsResponse, error) {
+	out := new(ExecutedCommandsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/ExecutedCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) KeyAddress(ctx context.Context, in *KeyAddressRequest, opts ...grpc.CallOption) (*KeyAddressResponse, error) {
 	out := new(KeyAddressResponse)
 	err := c.cc.Invoke(ctx, "/axelar.evm.v1beta1.QueryService/KeyAddress", in, out, opts...)
@@ -715,6 +781,12 @@ type QueryServiceServer interface {
 	Chains(context.Context, *ChainsRequest) (*ChainsResponse, error)
 	// Command queries the command of a chain provided the command id
 	Command(context.Context, *CommandRequest) (*CommandResponse, error)
+	// CommandStatus queries the batch, signing session and execution of a
+	// command of a chain provided the command id
+	CommandStatus(context.Context, *CommandStatusRequest) (*CommandStatusResponse, error)
+	// ExecutedCommands queries the history of commands executed on the gateway
+	// of a chain
+	ExecutedCommands(context.Context, *ExecutedCommandsRequest) (*ExecutedCommandsResponse, error)
 	// KeyAddress queries the address of key of a chain
 	KeyAddress(context.Context, *KeyAddressRequest) (*KeyAddressResponse, error)
 	// GatewayAddress queries the address of axelar gateway at the specified
@@ -751,6 +823,12 @@ func (*UnimplementedQueryServiceServer) Chains(ctx context.Context, req *ChainsR
 func (*UnimplementedQueryServiceServer) Command(ctx context.Context, req *CommandRequest) (*CommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Command not implemented")
 }
+func (*UnimplementedQueryServiceServer) CommandStatus(ctx context.Context, req *CommandStatusRequest) (*CommandStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandStatus not implemented")
+}
+func (*UnimplementedQueryServiceServer) ExecutedCommands(ctx context.Context, req *ExecutedCommandsRequest) (*ExecutedCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedCommands not implemented")
+}
 func (*UnimplementedQueryServiceServer) KeyAddress(ctx context.Context, req *KeyAddressRequest) (*KeyAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeyAddress not implemented")
 }
@@ -867,6 +945,42 @@ func _QueryService_Command_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_CommandStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).CommandStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/CommandStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).CommandStatus(ctx, req.(*CommandStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ExecutedCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutedCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ExecutedCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.evm.v1beta1.QueryService/ExecutedCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ExecutedCommands(ctx, req.(*ExecutedCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_KeyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyAddressRequest)
 	if err := dec(in); err != nil {
@@ -1017,6 +1131,14 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Command",
 			Handler:    _QueryService_Command_Handler,
 		},
+		{
+			MethodName: "CommandStatus",
+			Handler:    _QueryService_CommandStatus_Handler,
+		},
+		{
+			MethodName: "ExecutedCommands",
+			Handler:    _QueryService_ExecutedCommands_Handler,
+		},
 		{
 			MethodName: "KeyAddress",
 			Handler:    _QueryService_KeyAddress_Handler,
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+const (
+	svmSignatureLength = 64
+	svmPubKeyLength    = 32
+)
+
+// ValidateSVMSignature returns an error if the given string is not a base58 encoded Solana transaction signature
+func ValidateSVMSignature(signature string) error {
+	if len(base58.Decode(signature)) != svmSignatureLength {
+		return fmt.Errorf("%s is not a valid base58 encoded transaction signature", signature)
+	}
+
+	return nil
+}
+
+// ValidateSVMAddress returns an error if the given string is not a base58 encoded Solana account address
+func ValidateSVMAddress(address string) error {
+	if len(base58.Decode(address)) != svmPubKeyLength {
+		return fmt.Errorf("%s is not a valid base58 encoded account address", address)
+	}
+
+	return nil
+}
@@ -46,14 +46,17 @@ func RandomChain(cdc codec.Codec) types.GenesisState_Chain {
 		events[i] = RandomGatewayEvent(types.EventConfirmed, types.EventCompleted, types.EventFailed)
 	}
 
+	batches := RandomBatches()
+
 	chain := types.GenesisState_Chain{
 		Params:              RandomParams(),
 		Gateway:             RandomGateway(),
 		CommandQueue:        RandomCommandQueue(cdc),
-		CommandBatches:      RandomBatches(),
+		CommandBatches:      batches,
 		Events:              events,
 		ConfirmedEventQueue: getConfirmedEventQueue(cdc, events),
 		Tokens:              RandomTokens(),
+		CommandExecutions:   RandomCommandExecutions(batches),
 	}
 
 	return chain
@@ -219,6 +222,25 @@ func RandomBatch() types.CommandBatchMetadata {
 	return md
 }
 
+// RandomCommandExecutions returns random (valid) executions of the commands of the given batches for testing
+func RandomCommandExecutions(batches []types.CommandBatchMetadata) []types.CommandExecution {
+	var executions []types.CommandExecution
+	for _, batch := range batches {
+		for _, id := range batch.CommandIDs {
+			if rand.Bools(0.5).Next() {
+				executions = append(executions, types.CommandExecution{
+					CommandID: id,
+					BatchID:   batch.ID,
+					TxID:      RandomHash(),
+					Height:    rand.PosI64(),
+				})
+			}
+		}
+	}
+
+	return executions
+}
+
 // RandomCommandIDs returns a random (valid) slice of command IDs for testing
 func RandomCommandIDs() []types.CommandID {
 	idCount := rand.I64Between(1, 20)
@@ -40,6 +40,9 @@ type SetGatewayRequest struct {
 	Chain            github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Address          Address                                                         `protobuf:"bytes,3,opt,name=address,proto3,customtype=Address" json:"address"`
 	Sender           string                                                          `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// svm_program_id is the base58 encoded address of the gateway program. It is
+	// set instead of address for chains whose gateway is a Solana program
+	SVMProgramID string `protobuf:"bytes,5,opt,name=svm_program_id,json=svmProgramId,proto3" json:"svm_program_id,omitempty"`
 }
 
 func (m *SetGatewayRequest) Reset()         { *m = SetGatewayRequest{} }
@@ -275,6 +278,84 @@ func (m *ConfirmGatewayTxsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfirmGatewayTxsResponse proto.InternalMessageInfo
 
+// ConfirmSVMGatewayTxsRequest is the counterpart of ConfirmGatewayTxsRequest
+// for chains whose gateway is a Solana program
+type ConfirmSVMGatewayTxsRequest struct {
+	Sender string                                                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	// tx_signatures are the base58 encoded signatures of the transactions
+	TxSignatures []string `protobuf:"bytes,3,rep,name=tx_signatures,json=txSignatures,proto3" json:"tx_signatures,omitempty"`
+}
+
+func (m *ConfirmSVMGatewayTxsRequest) Reset()         { *m = ConfirmSVMGatewayTxsRequest{} }
+func (m *ConfirmSVMGatewayTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmSVMGatewayTxsRequest) ProtoMessage()    {}
+func (*ConfirmSVMGatewayTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43a3259b9722fdab, []int{6}
+}
+func (m *ConfirmSVMGatewayTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmSVMGatewayTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmSVMGatewayTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmSVMGatewayTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmSVMGatewayTxsRequest.Merge(m, src)
+}
+func (m *ConfirmSVMGatewayTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmSVMGatewayTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmSVMGatewayTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmSVMGatewayTxsRequest proto.InternalMessageInfo
+
+type ConfirmSVMGatewayTxsResponse struct {
+}
+
+func (m *ConfirmSVMGatewayTxsResponse) Reset()         { *m = ConfirmSVMGatewayTxsResponse{} }
+func (m *ConfirmSVMGatewayTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmSVMGatewayTxsResponse) ProtoMessage()    {}
+func (*ConfirmSVMGatewayTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43a3259b9722fdab, []int{7}
+}
+func (m *ConfirmSVMGatewayTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmSVMGatewayTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmSVMGatewayTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmSVMGatewayTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmSVMGatewayTxsResponse.Merge(m, src)
+}
+func (m *ConfirmSVMGatewayTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmSVMGatewayTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmSVMGatewayTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmSVMGatewayTxsResponse proto.InternalMessageInfo
+
 // MsgConfirmDeposit represents an erc20 deposit confirmation message
 type ConfirmDepositRequest struct {
 	// DEPRECATED: This field is deprecated but must remain to ensure backward
@@ -292,7 +373,7 @@ func (m *ConfirmDepositRequest) Reset()         { *m = ConfirmDepositRequest{} }
 func (m *ConfirmDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmDepositRequest) ProtoMessage()    {}
 func (*ConfirmDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{6}
+	return fileDescriptor_43a3259b9722fdab, []int{8}
 }
 func (m *ConfirmDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +409,7 @@ func (m *ConfirmDepositResponse) Reset()         { *m = ConfirmDepositResponse{}
 func (m *ConfirmDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmDepositResponse) ProtoMessage()    {}
 func (*ConfirmDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{7}
+	return fileDescriptor_43a3259b9722fdab, []int{9}
 }
 func (m *ConfirmDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +454,7 @@ func (m *ConfirmTokenRequest) Reset()         { *m = ConfirmTokenRequest{} }
 func (m *ConfirmTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTokenRequest) ProtoMessage()    {}
 func (*ConfirmTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{8}
+	return fileDescriptor_43a3259b9722fdab, []int{10}
 }
 func (m *ConfirmTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +490,7 @@ func (m *ConfirmTokenResponse) Reset()         { *m = ConfirmTokenResponse{} }
 func (m *ConfirmTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTokenResponse) ProtoMessage()    {}
 func (*ConfirmTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{9}
+	return fileDescriptor_43a3259b9722fdab, []int{11}
 }
 func (m *ConfirmTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +541,7 @@ func (m *ConfirmTransferKeyRequest) Reset()         { *m = ConfirmTransferKeyReq
 func (m *ConfirmTransferKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTransferKeyRequest) ProtoMessage()    {}
 func (*ConfirmTransferKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{10}
+	return fileDescriptor_43a3259b9722fdab, []int{12}
 }
 func (m *ConfirmTransferKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +577,7 @@ func (m *ConfirmTransferKeyResponse) Reset()         { *m = ConfirmTransferKeyRe
 func (m *ConfirmTransferKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfirmTransferKeyResponse) ProtoMessage()    {}
 func (*ConfirmTransferKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{11}
+	return fileDescriptor_43a3259b9722fdab, []int{13}
 }
 func (m *ConfirmTransferKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +624,7 @@ func (m *LinkRequest) Reset()         { *m = LinkRequest{} }
 func (m *LinkRequest) String() string { return proto.CompactTextString(m) }
 func (*LinkRequest) ProtoMessage()    {}
 func (*LinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{12}
+	return fileDescriptor_43a3259b9722fdab, []int{14}
 }
 func (m *LinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +661,7 @@ func (m *LinkResponse) Reset()         { *m = LinkResponse{} }
 func (m *LinkResponse) String() string { return proto.CompactTextString(m) }
 func (*LinkResponse) ProtoMessage()    {}
 func (*LinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{13}
+	return fileDescriptor_43a3259b9722fdab, []int{15}
 }
 func (m *LinkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +705,7 @@ func (m *CreateBurnTokensRequest) Reset()         { *m = CreateBurnTokensRequest
 func (m *CreateBurnTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBurnTokensRequest) ProtoMessage()    {}
 func (*CreateBurnTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{14}
+	return fileDescriptor_43a3259b9722fdab, []int{16}
 }
 func (m *CreateBurnTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +741,7 @@ func (m *CreateBurnTokensResponse) Reset()         { *m = CreateBurnTokensRespon
 func (m *CreateBurnTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateBurnTokensResponse) ProtoMessage()    {}
 func (*CreateBurnTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{15}
+	return fileDescriptor_43a3259b9722fdab, []int{17}
 }
 func (m *CreateBurnTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +795,7 @@ func (m *CreateDeployTokenRequest) Reset()         { *m = CreateDeployTokenReque
 func (m *CreateDeployTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeployTokenRequest) ProtoMessage()    {}
 func (*CreateDeployTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{16}
+	return fileDescriptor_43a3259b9722fdab, []int{18}
 }
 func (m *CreateDeployTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +831,7 @@ func (m *CreateDeployTokenResponse) Reset()         { *m = CreateDeployTokenResp
 func (m *CreateDeployTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateDeployTokenResponse) ProtoMessage()    {}
 func (*CreateDeployTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{17}
+	return fileDescriptor_43a3259b9722fdab, []int{19}
 }
 func (m *CreateDeployTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +874,7 @@ func (m *CreatePendingTransfersRequest) Reset()         { *m = CreatePendingTran
 func (m *CreatePendingTransfersRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePendingTransfersRequest) ProtoMessage()    {}
 func (*CreatePendingTransfersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{18}
+	return fileDescriptor_43a3259b9722fdab, []int{20}
 }
 func (m *CreatePendingTransfersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +913,7 @@ func (m *CreatePendingTransfersResponse) Reset()         { *m = CreatePendingTra
 func (m *CreatePendingTransfersResponse) String() string { return proto.CompactTextString(m) }
 func (*CreatePendingTransfersResponse) ProtoMessage()    {}
 func (*CreatePendingTransfersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{19}
+	return fileDescriptor_43a3259b9722fdab, []int{21}
 }
 func (m *CreatePendingTransfersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +959,7 @@ func (m *CreateTransferOwnershipRequest) Reset()         { *m = CreateTransferOw
 func (m *CreateTransferOwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOwnershipRequest) ProtoMessage()    {}
 func (*CreateTransferOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{20}
+	return fileDescriptor_43a3259b9722fdab, []int{22}
 }
 func (m *CreateTransferOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +998,7 @@ func (m *CreateTransferOwnershipResponse) Reset()         { *m = CreateTransferO
 func (m *CreateTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOwnershipResponse) ProtoMessage()    {}
 func (*CreateTransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{21}
+	return fileDescriptor_43a3259b9722fdab, []int{23}
 }
 func (m *CreateTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,7 +1041,7 @@ func (m *CreateTransferOperatorshipRequest) Reset()         { *m = CreateTransfe
 func (m *CreateTransferOperatorshipRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOperatorshipRequest) ProtoMessage()    {}
 func (*CreateTransferOperatorshipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{22}
+	return fileDescriptor_43a3259b9722fdab, []int{24}
 }
 func (m *CreateTransferOperatorshipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1077,7 @@ func (m *CreateTransferOperatorshipResponse) Reset()         { *m = CreateTransf
 func (m *CreateTransferOperatorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateTransferOperatorshipResponse) ProtoMessage()    {}
 func (*CreateTransferOperatorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{23}
+	return fileDescriptor_43a3259b9722fdab, []int{25}
 }
 func (m *CreateTransferOperatorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,7 +1119,7 @@ func (m *SignCommandsRequest) Reset()         { *m = SignCommandsRequest{} }
 func (m *SignCommandsRequest) String() string { return proto.CompactTextString(m) }
 func (*SignCommandsRequest) ProtoMessage()    {}
 func (*SignCommandsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{24}
+	return fileDescriptor_43a3259b9722fdab, []int{26}
 }
 func (m *SignCommandsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1157,7 @@ func (m *SignCommandsResponse) Reset()         { *m = SignCommandsResponse{} }
 func (m *SignCommandsResponse) String() string { return proto.CompactTextString(m) }
 func (*SignCommandsResponse) ProtoMessage()    {}
 func (*SignCommandsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{25}
+	return fileDescriptor_43a3259b9722fdab, []int{27}
 }
 func (m *SignCommandsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,7 +1205,7 @@ func (m *AddChainRequest) Reset()         { *m = AddChainRequest{} }
 func (m *AddChainRequest) String() string { return proto.CompactTextString(m) }
 func (*AddChainRequest) ProtoMessage()    {}
 func (*AddChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{26}
+	return fileDescriptor_43a3259b9722fdab, []int{28}
 }
 func (m *AddChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1241,7 @@ func (m *AddChainResponse) Reset()         { *m = AddChainResponse{} }
 func (m *AddChainResponse) String() string { return proto.CompactTextString(m) }
 func (*AddChainResponse) ProtoMessage()    {}
 func (*AddChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{27}
+	return fileDescriptor_43a3259b9722fdab, []int{29}
 }
 func (m *AddChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,7 +1284,7 @@ func (m *RetryFailedEventRequest) Reset()         { *m = RetryFailedEventRequest
 func (m *RetryFailedEventRequest) String() string { return proto.CompactTextString(m) }
 func (*RetryFailedEventRequest) ProtoMessage()    {}
 func (*RetryFailedEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{28}
+	return fileDescriptor_43a3259b9722fdab, []int{30}
 }
 func (m *RetryFailedEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1320,7 @@ func (m *RetryFailedEventResponse) Reset()         { *m = RetryFailedEventRespon
 func (m *RetryFailedEventResponse) String() string { return proto.CompactTextString(m) }
 func (*RetryFailedEventResponse) ProtoMessage()    {}
 func (*RetryFailedEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{29}
+	return fileDescriptor_43a3259b9722fdab, []int{31}
 }
 func (m *RetryFailedEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1277,7 +1358,7 @@ func (m *UpdateParamsRequest) Reset()         { *m = UpdateParamsRequest{} }
 func (m *UpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateParamsRequest) ProtoMessage()    {}
 func (*UpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{30}
+	return fileDescriptor_43a3259b9722fdab, []int{32}
 }
 func (m *UpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1394,7 @@ func (m *UpdateParamsResponse) Reset()         { *m = UpdateParamsResponse{} }
 func (m *UpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateParamsResponse) ProtoMessage()    {}
 func (*UpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43a3259b9722fdab, []int{31}
+	return fileDescriptor_43a3259b9722fdab, []int{33}
 }
 func (m *UpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,6 +1430,8 @@ func init() {
 	proto.RegisterType((*ConfirmGatewayTxResponse)(nil), "axelar.evm.v1beta1.ConfirmGatewayTxResponse")
 	proto.RegisterType((*ConfirmGatewayTxsRequest)(nil), "axelar.evm.v1beta1.ConfirmGatewayTxsRequest")
 	proto.RegisterType((*ConfirmGatewayTxsResponse)(nil), "axelar.evm.v1beta1.ConfirmGatewayTxsResponse")
+	proto.RegisterType((*ConfirmSVMGatewayTxsRequest)(nil), "axelar.evm.v1beta1.ConfirmSVMGatewayTxsRequest")
+	proto.RegisterType((*ConfirmSVMGatewayTxsResponse)(nil), "axelar.evm.v1beta1.ConfirmSVMGatewayTxsResponse")
 	proto.RegisterType((*ConfirmDepositRequest)(nil), "axelar.evm.v1beta1.ConfirmDepositRequest")
 	proto.RegisterType((*ConfirmDepositResponse)(nil), "axelar.evm.v1beta1.ConfirmDepositResponse")
 	proto.RegisterType((*ConfirmTokenRequest)(nil), "axelar.evm.v1beta1.ConfirmTokenRequest")
@@ -1380,104 +1463,110 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/tx.proto", fileDescriptor_43a3259b9722fdab) }
 
 var fileDescriptor_43a3259b9722fdab = []byte{
-	// 1547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0xfa, 0x2b, 0x64, 0xe2, 0x7c, 0x6d, 0x9c, 0x64, 0x49, 0xa8, 0x1d, 0x96, 0x00, 0x01,
-	0x11, 0x9b, 0x80, 0x8a, 0x10, 0x87, 0x56, 0x71, 0x42, 0xdb, 0x28, 0x7c, 0x69, 0x09, 0xaa, 0xda,
-	0x8b, 0x3b, 0xf1, 0x0e, 0xce, 0xc8, 0xde, 0xd9, 0xed, 0xce, 0x38, 0xd8, 0x37, 0xc4, 0xa1, 0x87,
-	0xaa, 0x87, 0xfe, 0x1d, 0x9c, 0x50, 0x55, 0xce, 0xed, 0x31, 0x52, 0x2f, 0xa8, 0x27, 0xc4, 0xc1,
-	0x6a, 0x83, 0x5a, 0xd4, 0x7f, 0x81, 0x53, 0x35, 0x1f, 0x6b, 0x2f, 0xf6, 0x86, 0x86, 0x88, 0xf6,
-	0xe0, 0xf4, 0x92, 0x78, 0xe7, 0xbd, 0x7d, 0xf3, 0xe6, 0xf7, 0x7b, 0xef, 0xcd, 0x7b, 0x36, 0x98,
-	0x83, 0x0d, 0x54, 0x83, 0x7e, 0x01, 0xed, 0x38, 0x85, 0x9d, 0xe5, 0x2d, 0xc4, 0xe0, 0x72, 0x81,
-	0x35, 0xf2, 0x9e, 0xef, 0x32, 0x57, 0xd7, 0xa5, 0x30, 0x8f, 0x76, 0x9c, 0xbc, 0x12, 0xce, 0x66,
-	0x2a, 0x6e, 0xc5, 0x15, 0xe2, 0x02, 0xff, 0x24, 0x35, 0x67, 0xcf, 0x2a, 0x33, 0x8c, 0xd2, 0x02,
-	0x6a, 0x78, 0xae, 0xcf, 0x90, 0xdd, 0xb1, 0xd7, 0xf4, 0x10, 0x55, 0x8a, 0xd9, 0xa8, 0xfd, 0x42,
-	0xf2, 0x5c, 0x84, 0xdc, 0x83, 0x3e, 0x74, 0x02, 0x85, 0xbc, 0x52, 0xf0, 0x90, 0xef, 0x60, 0x4a,
-	0xb1, 0x4b, 0xde, 0xbe, 0xe1, 0xf1, 0xb2, 0x4b, 0x1d, 0x97, 0x96, 0xa4, 0xcb, 0xf2, 0x41, 0x89,
-	0x66, 0xe4, 0x53, 0xc1, 0xa1, 0x95, 0xc2, 0xce, 0x32, 0xff, 0xa7, 0x04, 0x13, 0xd0, 0xc1, 0xc4,
-	0x2d, 0x88, 0xbf, 0x72, 0xc9, 0x7c, 0x1e, 0x03, 0x13, 0x77, 0x11, 0xfb, 0x14, 0x32, 0xf4, 0x00,
-	0x36, 0x2d, 0xf4, 0x75, 0x1d, 0x51, 0xa6, 0x7f, 0x05, 0x26, 0x28, 0x22, 0x36, 0xf2, 0x4b, 0x36,
-	0xf2, 0x7c, 0x54, 0x86, 0x0c, 0xd9, 0x86, 0x36, 0xaf, 0x2d, 0xa6, 0x8b, 0x97, 0x5f, 0xb7, 0x72,
-	0x4b, 0x15, 0xcc, 0xb6, 0xeb, 0x5b, 0xf9, 0xb2, 0xeb, 0xa8, 0x9d, 0xd5, 0xbf, 0x25, 0x6a, 0x57,
-	0x95, 0x97, 0x2b, 0xe5, 0xf2, 0x8a, 0x6d, 0xfb, 0x88, 0x52, 0x43, 0xb3, 0xc6, 0xa5, 0xb5, 0xb5,
-	0xb6, 0x31, 0xfd, 0x0b, 0x90, 0x2c, 0x6f, 0x43, 0x4c, 0x8c, 0xd8, 0xbc, 0xb6, 0x38, 0x54, 0x5c,
-	0x7d, 0xdd, 0xca, 0x7d, 0x1c, 0xb2, 0x2a, 0xc1, 0x20, 0x88, 0x3d, 0x70, 0xfd, 0xaa, 0x7a, 0x5a,
-	0x2a, 0xbb, 0x3e, 0x2a, 0x34, 0x0a, 0x04, 0x35, 0xea, 0x1d, 0x36, 0xf2, 0xab, 0xdc, 0xcc, 0x2d,
-	0xe8, 0x20, 0x4b, 0x5a, 0xd4, 0xcf, 0x81, 0x41, 0xa8, 0x76, 0x8e, 0x0b, 0x97, 0xc7, 0x76, 0x5b,
-	0xb9, 0x81, 0x17, 0xad, 0xdc, 0xa0, 0x72, 0xc8, 0x0a, 0xe4, 0xfa, 0x45, 0x90, 0x92, 0x9e, 0x19,
-	0x09, 0xe1, 0x86, 0xf1, 0xeb, 0x8f, 0x4b, 0x19, 0x85, 0xa5, 0x52, 0xbe, 0xcb, 0x7c, 0x4c, 0x2a,
-	0x96, 0xd2, 0xbb, 0x76, 0xee, 0xe1, 0x53, 0x23, 0xfe, 0xe8, 0xd5, 0x93, 0xf3, 0x6a, 0xe1, 0xdb,
-	0x57, 0x4f, 0xce, 0x4f, 0x71, 0x52, 0x7b, 0x40, 0x34, 0x33, 0x40, 0x0f, 0x2f, 0x52, 0xcf, 0x25,
-	0x14, 0x99, 0x7f, 0xc6, 0xc0, 0xcc, 0xaa, 0x4b, 0xee, 0x63, 0xdf, 0x51, 0xa2, 0xcd, 0x46, 0x9f,
-	0xc0, 0x9e, 0x64, 0x8d, 0x12, 0xb6, 0x15, 0xe8, 0x19, 0x05, 0x7a, 0xe2, 0x33, 0x48, 0xb7, 0xf7,
-	0x5a, 0xb9, 0xc4, 0x66, 0x63, 0x7d, 0xcd, 0x4a, 0xb0, 0xc6, 0xba, 0x7d, 0x08, 0xd8, 0x97, 0x1f,
-	0x3e, 0x35, 0xb4, 0x2e, 0xd8, 0xe7, 0x38, 0xec, 0xfb, 0x40, 0x69, 0x68, 0x66, 0x16, 0x18, 0xbd,
-	0x42, 0x49, 0xc2, 0xb5, 0x98, 0xa1, 0x99, 0x7f, 0xc5, 0x7a, 0x15, 0x68, 0x5f, 0x30, 0x71, 0x01,
-	0xa4, 0x04, 0x13, 0x3c, 0xfe, 0xe3, 0x8b, 0xe9, 0xe2, 0x54, 0x17, 0x15, 0x49, 0x4e, 0x05, 0xb5,
-	0x92, 0x9c, 0x8b, 0xc3, 0xe4, 0xc0, 0xc5, 0x08, 0x32, 0x4e, 0x44, 0x91, 0x11, 0xc0, 0x69, 0xce,
-	0x81, 0xe3, 0x11, 0x32, 0x95, 0x11, 0xbf, 0xc4, 0xc1, 0x94, 0x92, 0xae, 0x21, 0xcf, 0xa5, 0x98,
-	0x1d, 0xb5, 0x7c, 0xb8, 0x0a, 0x52, 0xd0, 0x71, 0xeb, 0x84, 0x09, 0x0a, 0xd2, 0xc5, 0x79, 0xa5,
-	0x3b, 0x2d, 0x8f, 0x43, 0xed, 0x6a, 0x1e, 0xbb, 0x05, 0x07, 0xb2, 0xed, 0xfc, 0x3d, 0x4c, 0x98,
-	0xa1, 0x59, 0x4a, 0x5f, 0xbf, 0x02, 0x46, 0xb7, 0xea, 0x3e, 0x41, 0x7e, 0x29, 0x28, 0x79, 0xc9,
-	0xe8, 0x92, 0x37, 0x22, 0xd5, 0x56, 0x7a, 0x0a, 0x5f, 0xea, 0x80, 0xa4, 0x9f, 0x89, 0x20, 0x5d,
-	0x0f, 0x91, 0xae, 0xa8, 0x33, 0x0d, 0x30, 0xdd, 0x4d, 0xa6, 0xe2, 0xf9, 0xbb, 0x38, 0x98, 0x54,
-	0xa2, 0x4d, 0xb7, 0x8a, 0xc8, 0x51, 0x63, 0xf9, 0x43, 0x90, 0x84, 0x94, 0x22, 0x49, 0xf2, 0xf0,
-	0xa5, 0xe3, 0xf9, 0xde, 0x2e, 0x24, 0xbf, 0xc2, 0x15, 0x8a, 0x09, 0x6e, 0xc5, 0x92, 0xda, 0x21,
-	0xaa, 0x92, 0x07, 0xa4, 0x6a, 0x21, 0x82, 0xaa, 0xf1, 0x10, 0x55, 0x02, 0x7d, 0x73, 0x1a, 0x64,
-	0xde, 0x64, 0x43, 0xd1, 0xf4, 0x53, 0xa2, 0x9d, 0xac, 0x9b, 0x3e, 0x24, 0xf4, 0x3e, 0xf2, 0x37,
-	0x50, 0xf3, 0xa8, 0x91, 0x05, 0x81, 0xc1, 0xd4, 0xe9, 0x4b, 0xdc, 0xed, 0xf0, 0x71, 0x39, 0x7f,
-	0xa3, 0x97, 0x4e, 0x45, 0xf1, 0x17, 0x42, 0x6c, 0xb3, 0xe9, 0xa1, 0x62, 0xcc, 0xd0, 0xac, 0xe9,
-	0xc0, 0x10, 0x5f, 0x09, 0x1d, 0xb4, 0x09, 0x26, 0xaa, 0xa8, 0x59, 0xc2, 0x76, 0xd8, 0xb6, 0xe4,
-	0xf8, 0x26, 0xaf, 0xd2, 0x1b, 0xa8, 0xb9, 0xbe, 0xf6, 0xba, 0x95, 0xfb, 0xe8, 0x80, 0xa7, 0x77,
-	0xea, 0x35, 0x86, 0x29, 0xae, 0x74, 0x00, 0x10, 0x16, 0x0c, 0xcd, 0x1a, 0xab, 0xa2, 0xe6, 0xba,
-	0x1d, 0xda, 0xfa, 0xdd, 0xd3, 0xff, 0x7c, 0x44, 0x4c, 0x4d, 0x87, 0x63, 0xaa, 0x73, 0x70, 0xf3,
-	0x04, 0x98, 0x8d, 0x0a, 0x20, 0x15, 0x5f, 0xbb, 0x71, 0x30, 0x7c, 0x03, 0x93, 0x6a, 0x5f, 0x44,
-	0xd4, 0x69, 0x30, 0xea, 0xa3, 0x32, 0xf6, 0x30, 0x22, 0x4c, 0x94, 0x60, 0x11, 0x5a, 0x43, 0xd6,
-	0x48, 0x7b, 0x95, 0x3b, 0xa6, 0x67, 0xc2, 0xa9, 0x3f, 0x14, 0x64, 0x76, 0x0d, 0x8c, 0x75, 0x5e,
-	0x96, 0x1e, 0x26, 0xdf, 0x9f, 0x87, 0x1d, 0xc7, 0xc4, 0xda, 0x21, 0x38, 0xcf, 0x45, 0x70, 0x3e,
-	0xcc, 0x39, 0xbf, 0x49, 0x2b, 0x9c, 0x41, 0x73, 0x19, 0xa4, 0x25, 0x93, 0x92, 0x5a, 0xfd, 0x24,
-	0x48, 0xdb, 0xb2, 0xe8, 0x4b, 0x2c, 0x34, 0x71, 0xda, 0x61, 0xb5, 0xc6, 0x37, 0x31, 0x7f, 0xe0,
-	0xed, 0xaf, 0x8f, 0x20, 0x43, 0xc5, 0xba, 0x4f, 0x44, 0xe5, 0xe9, 0x8f, 0xa6, 0xab, 0x03, 0x6f,
-	0xfc, 0x80, 0xf0, 0x2e, 0x46, 0xc0, 0x9b, 0x11, 0x29, 0xd5, 0x85, 0x8f, 0x39, 0x0b, 0x8c, 0x5e,
-	0xcc, 0x54, 0x3a, 0xed, 0x25, 0x02, 0xe1, 0x1a, 0xf2, 0x6a, 0x6e, 0xb3, 0x7f, 0xae, 0xd6, 0xf6,
-	0x7d, 0x19, 0x7f, 0xa7, 0xfb, 0x72, 0x03, 0x8c, 0x30, 0x8e, 0x41, 0xc9, 0x46, 0x0c, 0xe2, 0x1a,
-	0x55, 0xd7, 0xed, 0x7c, 0x64, 0xb9, 0xe6, 0x8a, 0x6b, 0x52, 0x4f, 0x59, 0x49, 0xb3, 0xd0, 0x9a,
-	0x7e, 0x1b, 0x4c, 0x39, 0x98, 0x94, 0x64, 0xb7, 0xd5, 0x5d, 0xa7, 0xd3, 0xc5, 0xb9, 0x17, 0xad,
-	0xdc, 0x54, 0x6f, 0x93, 0xb6, 0x2e, 0x7a, 0xb4, 0x49, 0x07, 0x93, 0x15, 0xf1, 0x62, 0x08, 0xaf,
-	0xd0, 0x70, 0x9a, 0xfa, 0x87, 0xe1, 0xf4, 0x02, 0x18, 0xb7, 0x21, 0xae, 0x35, 0x4b, 0x0e, 0x26,
-	0xac, 0x54, 0xc3, 0x0e, 0x66, 0xc6, 0xa0, 0x40, 0x99, 0xdf, 0x2a, 0xa3, 0x42, 0x76, 0x13, 0x13,
-	0x76, 0x83, 0x4b, 0x42, 0xf1, 0x77, 0xec, 0x1d, 0x46, 0xd9, 0x58, 0xd4, 0x28, 0xdb, 0x13, 0x4e,
-	0xa2, 0x7f, 0xef, 0x8d, 0x31, 0x15, 0x81, 0x3f, 0xc7, 0xc0, 0x07, 0x52, 0x7a, 0x07, 0x11, 0x1b,
-	0x93, 0x4a, 0x50, 0xf5, 0x8f, 0x6a, 0x62, 0x47, 0xcd, 0x47, 0xb3, 0x1d, 0x60, 0xbb, 0x51, 0x32,
-	0x34, 0x73, 0x01, 0x64, 0xf7, 0x43, 0x30, 0x34, 0xb1, 0x7e, 0x13, 0x0f, 0xd4, 0x02, 0xf9, 0xed,
-	0x07, 0x04, 0xf9, 0x74, 0x1b, 0x7b, 0x7d, 0x81, 0xf4, 0x16, 0x48, 0xc9, 0x86, 0x48, 0x21, 0xbd,
-	0xf1, 0x1e, 0xbb, 0x20, 0x2b, 0x29, 0x7a, 0xa0, 0x43, 0x4c, 0xbb, 0x13, 0x5d, 0x69, 0x62, 0x68,
-	0xe6, 0x69, 0x90, 0xdb, 0x97, 0x87, 0x10, 0x5f, 0x8f, 0xe3, 0xe0, 0x64, 0x97, 0x9e, 0x87, 0x7c,
-	0xc8, 0xdc, 0xff, 0x29, 0xfb, 0x77, 0x29, 0xbb, 0x14, 0x51, 0xd9, 0xb2, 0x9d, 0x04, 0x8c, 0x62,
-	0xc3, 0x5c, 0x00, 0xe6, 0xdb, 0xb8, 0x52, 0xb5, 0xee, 0x71, 0x0c, 0x4c, 0xde, 0xc5, 0x15, 0xb2,
-	0xea, 0x3a, 0x0e, 0x24, 0xf6, 0x51, 0xad, 0x70, 0xfb, 0x4e, 0x98, 0x61, 0x6c, 0xcc, 0x47, 0x1a,
-	0xc8, 0xbc, 0x09, 0x96, 0xea, 0x13, 0xaf, 0x83, 0xc9, 0x2d, 0xc8, 0xca, 0xdb, 0xc8, 0x2e, 0x95,
-	0x95, 0x8c, 0x87, 0x90, 0xc4, 0x6b, 0x6a, 0xaf, 0x95, 0x9b, 0x28, 0x4a, 0x71, 0xf0, 0xe6, 0xfa,
-	0x9a, 0x35, 0xb1, 0xd5, 0xb5, 0x64, 0xeb, 0xa7, 0xc0, 0x88, 0x7a, 0xbd, 0x54, 0x16, 0xdf, 0x9e,
-	0x70, 0x68, 0x46, 0xac, 0xb4, 0x5a, 0x5c, 0xe5, 0x6b, 0xe6, 0xb3, 0x38, 0x18, 0x5b, 0xb1, 0x6d,
-	0x71, 0xe8, 0xff, 0x8e, 0xad, 0xcf, 0x41, 0x82, 0x40, 0x07, 0xbd, 0x4f, 0xb2, 0x84, 0x41, 0xfd,
-	0x1a, 0x98, 0x21, 0x90, 0xe1, 0x1d, 0x54, 0x12, 0xdd, 0x4e, 0xf8, 0x00, 0xf1, 0x76, 0x6f, 0x30,
-	0x25, 0x55, 0x44, 0x73, 0x14, 0x72, 0x6a, 0x15, 0x1c, 0xe3, 0xc9, 0xca, 0x0f, 0xa0, 0x66, 0xd8,
-	0x85, 0xa0, 0x29, 0x62, 0x94, 0xe6, 0xdb, 0x7b, 0x06, 0xdd, 0x51, 0x78, 0x88, 0x1d, 0xac, 0xca,
-	0x07, 0xfd, 0x0c, 0x48, 0xc9, 0xdf, 0x2d, 0x54, 0x0b, 0x34, 0xaa, 0xfa, 0x97, 0xd4, 0x1d, 0xb1,
-	0x6a, 0x29, 0xe9, 0x21, 0xc6, 0x8d, 0xb3, 0x11, 0x5f, 0xad, 0x4f, 0xf2, 0xa0, 0xea, 0xa2, 0xcf,
-	0xd4, 0xc1, 0x78, 0x67, 0x49, 0x25, 0xe6, 0x1f, 0x31, 0x30, 0x63, 0x21, 0xe6, 0x37, 0x3f, 0x81,
-	0xb8, 0x86, 0xec, 0xeb, 0x3b, 0x88, 0xf4, 0xc7, 0xd7, 0x88, 0xcb, 0xe0, 0x18, 0xe2, 0x87, 0xe9,
-	0xd4, 0xd8, 0xe9, 0xbd, 0x56, 0x6e, 0x50, 0x1c, 0x50, 0x54, 0xd9, 0xe0, 0xa3, 0x35, 0x28, 0xf4,
-	0x0e, 0x55, 0x30, 0xf7, 0x1d, 0x45, 0xba, 0x21, 0xe5, 0xa3, 0x48, 0x2f, 0xcc, 0x8a, 0x83, 0x27,
-	0x1a, 0x98, 0xbc, 0xe7, 0xd9, 0xbc, 0x8d, 0x91, 0xb1, 0xa0, 0xf0, 0xbf, 0x02, 0x86, 0x60, 0x9d,
-	0x6d, 0xbb, 0x3e, 0x66, 0x4d, 0x39, 0x13, 0xbe, 0xc5, 0xa5, 0x8e, 0xaa, 0x7e, 0xb5, 0x1d, 0x6a,
-	0x31, 0xd1, 0xc2, 0xcf, 0x46, 0xb5, 0xf0, 0x72, 0x2b, 0xd5, 0xbc, 0x2b, 0x7d, 0x11, 0x4a, 0xe2,
-	0x02, 0xe8, 0x58, 0x6b, 0x97, 0xa8, 0xb0, 0x87, 0xe6, 0x34, 0xc8, 0xbc, 0xe9, 0xb1, 0x3c, 0x4a,
-	0xf1, 0xd6, 0xee, 0xef, 0xd9, 0x81, 0xdd, 0xbd, 0xac, 0xf6, 0x6c, 0x2f, 0xab, 0xfd, 0xb6, 0x97,
-	0xd5, 0xbe, 0x7f, 0x99, 0x1d, 0x78, 0xf6, 0x32, 0x3b, 0xf0, 0xfc, 0x65, 0x76, 0xe0, 0xcb, 0x8b,
-	0x07, 0xe4, 0x96, 0x6f, 0x27, 0xe2, 0x67, 0x2b, 0x25, 0x7e, 0x6d, 0xbb, 0xfc, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x56, 0x62, 0x1c, 0x8b, 0x97, 0x1c, 0x00, 0x00,
+	// 1641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xfa, 0x57, 0xc8, 0xc4, 0xf9, 0xb5, 0x71, 0x92, 0x25, 0xe1, 0x6b, 0x87, 0x25, 0x40,
+	0x40, 0xc4, 0x26, 0xa0, 0x2f, 0xfa, 0x2a, 0x87, 0x6f, 0x15, 0xc7, 0xb4, 0x8d, 0x42, 0x00, 0x6d,
+	0x02, 0x55, 0x7b, 0xd9, 0x4e, 0xbc, 0x83, 0x33, 0xb2, 0x77, 0xd7, 0xdd, 0x19, 0x1b, 0xfb, 0x86,
+	0x50, 0xd5, 0x43, 0xd5, 0x03, 0x7f, 0x07, 0x27, 0x54, 0x95, 0x73, 0x7b, 0x8c, 0xd4, 0x0b, 0xea,
+	0xa9, 0xe2, 0x60, 0xb5, 0x46, 0x2d, 0xea, 0xbf, 0xc0, 0xa9, 0x9a, 0x1f, 0x6b, 0x2f, 0xf6, 0x06,
+	0x42, 0x04, 0x3d, 0x24, 0xbd, 0x24, 0xde, 0x79, 0x6f, 0xdf, 0xbc, 0xf9, 0x7c, 0xde, 0x7b, 0xf3,
+	0x66, 0x16, 0xcc, 0xc1, 0x06, 0xaa, 0x40, 0x2f, 0x87, 0xea, 0x76, 0xae, 0xbe, 0xbc, 0x83, 0x28,
+	0x5c, 0xce, 0xd1, 0x46, 0xb6, 0xea, 0xb9, 0xd4, 0x55, 0x55, 0x21, 0xcc, 0xa2, 0xba, 0x9d, 0x95,
+	0xc2, 0xd9, 0x54, 0xc9, 0x2d, 0xb9, 0x5c, 0x9c, 0x63, 0xbf, 0x84, 0xe6, 0xec, 0x79, 0x69, 0x86,
+	0x12, 0x92, 0x43, 0x8d, 0xaa, 0xeb, 0x51, 0x64, 0x75, 0xed, 0x35, 0xab, 0x88, 0x48, 0xc5, 0x74,
+	0xd8, 0x7c, 0x01, 0x79, 0x26, 0x44, 0x5e, 0x85, 0x1e, 0xb4, 0x7d, 0x85, 0xac, 0x54, 0xa8, 0x22,
+	0xcf, 0xc6, 0x84, 0x60, 0xd7, 0x79, 0xf3, 0x84, 0x27, 0x8b, 0x2e, 0xb1, 0x5d, 0x62, 0x0a, 0x97,
+	0xc5, 0x83, 0x14, 0xcd, 0x88, 0xa7, 0x9c, 0x4d, 0x4a, 0xb9, 0xfa, 0x32, 0xfb, 0x27, 0x05, 0x13,
+	0xd0, 0xc6, 0x8e, 0x9b, 0xe3, 0x7f, 0xc5, 0x90, 0xfe, 0x28, 0x0a, 0x26, 0xb6, 0x10, 0xfd, 0x04,
+	0x52, 0x74, 0x1f, 0x36, 0x0d, 0xf4, 0x55, 0x0d, 0x11, 0xaa, 0x7e, 0x09, 0x26, 0x08, 0x72, 0x2c,
+	0xe4, 0x99, 0x16, 0xaa, 0x7a, 0xa8, 0x08, 0x29, 0xb2, 0x34, 0x65, 0x5e, 0x59, 0x4c, 0xe6, 0xaf,
+	0xbe, 0x6a, 0x65, 0x96, 0x4a, 0x98, 0xee, 0xd6, 0x76, 0xb2, 0x45, 0xd7, 0x96, 0x33, 0xcb, 0x7f,
+	0x4b, 0xc4, 0x2a, 0x4b, 0x2f, 0x57, 0x8b, 0xc5, 0x55, 0xcb, 0xf2, 0x10, 0x21, 0x9a, 0x62, 0x8c,
+	0x0b, 0x6b, 0x85, 0x8e, 0x31, 0xf5, 0x73, 0x10, 0x2f, 0xee, 0x42, 0xec, 0x68, 0x91, 0x79, 0x65,
+	0x71, 0x28, 0xbf, 0xf6, 0xaa, 0x95, 0xf9, 0x28, 0x60, 0x55, 0x80, 0xe1, 0x20, 0x7a, 0xdf, 0xf5,
+	0xca, 0xf2, 0x69, 0xa9, 0xe8, 0x7a, 0x28, 0xd7, 0xc8, 0x39, 0xa8, 0x51, 0xeb, 0xb2, 0x91, 0x5d,
+	0x63, 0x66, 0x6e, 0x42, 0x1b, 0x19, 0xc2, 0xa2, 0x7a, 0x01, 0x0c, 0x42, 0x39, 0x73, 0x94, 0xbb,
+	0x3c, 0xb6, 0xd7, 0xca, 0x0c, 0x3c, 0x6f, 0x65, 0x06, 0xa5, 0x43, 0x86, 0x2f, 0x57, 0x2f, 0x83,
+	0x84, 0xf0, 0x4c, 0x8b, 0x71, 0x37, 0xb4, 0x5f, 0x7e, 0x58, 0x4a, 0x49, 0x2c, 0xa5, 0xf2, 0x16,
+	0xf5, 0xb0, 0x53, 0x32, 0xa4, 0x9e, 0x7a, 0x0d, 0x8c, 0x92, 0xba, 0xcd, 0x50, 0x2f, 0x79, 0xd0,
+	0x36, 0xb1, 0xa5, 0xc5, 0xf9, 0x9b, 0xe3, 0xed, 0x56, 0x26, 0xb9, 0x75, 0x77, 0xf3, 0xb6, 0x10,
+	0xac, 0x17, 0x8c, 0x24, 0xa9, 0xdb, 0xfe, 0x93, 0xb5, 0x72, 0xe1, 0xc1, 0x53, 0x2d, 0xfa, 0xf0,
+	0xe5, 0x93, 0x8b, 0xd2, 0xd0, 0xb7, 0x2f, 0x9f, 0x5c, 0x9c, 0x62, 0xc1, 0xd0, 0x07, 0xbe, 0x9e,
+	0x02, 0x6a, 0x70, 0x90, 0x54, 0x5d, 0x87, 0x20, 0xfd, 0xcf, 0x08, 0x98, 0x59, 0x73, 0x9d, 0x7b,
+	0xd8, 0xb3, 0xa5, 0x68, 0xbb, 0x71, 0x44, 0xe8, 0x8a, 0xd3, 0x06, 0x03, 0x52, 0x90, 0x95, 0x92,
+	0x64, 0xc5, 0x3e, 0x85, 0x64, 0xb7, 0xdd, 0xca, 0xc4, 0xb6, 0x1b, 0xeb, 0x05, 0x23, 0x46, 0x1b,
+	0xeb, 0xd6, 0xbb, 0xd3, 0xb5, 0xb2, 0xfc, 0xe0, 0xa9, 0xa6, 0xf4, 0xc0, 0x3e, 0xc7, 0x60, 0xdf,
+	0x07, 0x4a, 0x4d, 0xd1, 0xd3, 0x40, 0xeb, 0x17, 0x0a, 0x12, 0x56, 0x22, 0x9a, 0xa2, 0xff, 0x15,
+	0xe9, 0x57, 0x20, 0x47, 0x82, 0x89, 0x4b, 0x20, 0xc1, 0x99, 0x60, 0x79, 0x13, 0x5d, 0x4c, 0xe6,
+	0xa7, 0x7a, 0xa8, 0x88, 0x33, 0x2a, 0x88, 0x11, 0x67, 0x5c, 0x1c, 0x22, 0x77, 0x56, 0x2e, 0x87,
+	0x90, 0x71, 0x2a, 0x8c, 0x0c, 0x1f, 0x4e, 0x7d, 0x0e, 0x9c, 0x0c, 0x91, 0xc9, 0x8c, 0xf8, 0x3a,
+	0x02, 0xe6, 0xa4, 0x74, 0xeb, 0xee, 0x66, 0x3f, 0x17, 0x5d, 0x07, 0x95, 0x03, 0x26, 0xf7, 0x07,
+	0xc4, 0xf6, 0x0c, 0x18, 0xa1, 0x0d, 0x93, 0xe0, 0x92, 0x03, 0x69, 0xcd, 0x43, 0x02, 0xe2, 0x21,
+	0x23, 0x49, 0x1b, 0x5b, 0x9d, 0xb1, 0x95, 0xab, 0x21, 0x00, 0x65, 0x02, 0x00, 0x85, 0x2d, 0x53,
+	0x4f, 0x83, 0x53, 0xe1, 0x62, 0x09, 0xd3, 0xcf, 0x51, 0x30, 0x25, 0x15, 0x0a, 0xa8, 0xea, 0x12,
+	0x4c, 0x8f, 0x5b, 0xd9, 0xf8, 0x1f, 0x48, 0x40, 0xdb, 0xad, 0x39, 0x94, 0x47, 0x6a, 0x32, 0x3f,
+	0x2f, 0x75, 0xa7, 0xc5, 0x72, 0x88, 0x55, 0xce, 0x62, 0x37, 0x67, 0x43, 0xba, 0x9b, 0xbd, 0x83,
+	0x1d, 0xaa, 0x29, 0x86, 0xd4, 0x67, 0xd5, 0x7e, 0xa7, 0xe6, 0x39, 0xc8, 0x33, 0xfd, 0x1d, 0x25,
+	0x1e, 0xbe, 0xa3, 0x8c, 0x08, 0xb5, 0xd5, 0xbe, 0x7d, 0x25, 0x71, 0xc0, 0xdc, 0x38, 0x17, 0x42,
+	0xbd, 0x1a, 0xa0, 0x5e, 0x52, 0xa7, 0x6b, 0x60, 0xba, 0x97, 0x4c, 0xc9, 0xf3, 0x77, 0x51, 0x30,
+	0x29, 0x45, 0xdb, 0x6e, 0x19, 0x39, 0xc7, 0x8d, 0xe5, 0xff, 0x82, 0x38, 0x24, 0x04, 0x09, 0x92,
+	0x87, 0xaf, 0x9c, 0xcc, 0xf6, 0x37, 0x79, 0xd9, 0x55, 0xa6, 0x90, 0x8f, 0x31, 0x2b, 0x86, 0xd0,
+	0x0e, 0x50, 0x15, 0x3f, 0x20, 0x55, 0x0b, 0x21, 0x54, 0x8d, 0x07, 0xa8, 0xe2, 0xe8, 0xeb, 0xd3,
+	0x20, 0xf5, 0x3a, 0x1b, 0x92, 0xa6, 0x1f, 0x63, 0x9d, 0x9a, 0xb6, 0xed, 0x41, 0x87, 0xdc, 0x43,
+	0xde, 0x06, 0x6a, 0x1e, 0x37, 0xb2, 0x20, 0xd0, 0xa8, 0x5c, 0xbd, 0xc9, 0xdc, 0x0e, 0x2e, 0x97,
+	0xf1, 0x37, 0x7a, 0xe5, 0x4c, 0x18, 0x7f, 0x01, 0xc4, 0xb6, 0x9b, 0x55, 0x94, 0x8f, 0x68, 0x8a,
+	0x31, 0xed, 0x1b, 0x62, 0x23, 0x81, 0x85, 0x36, 0xc1, 0x44, 0x19, 0x35, 0x4d, 0x6c, 0x05, 0x6d,
+	0x0b, 0x8e, 0x37, 0xd9, 0x66, 0xb6, 0x81, 0x9a, 0xeb, 0x85, 0x57, 0xad, 0xcc, 0xff, 0x0f, 0xb8,
+	0x7a, 0xbb, 0x56, 0xa1, 0x98, 0xe0, 0x52, 0x17, 0x00, 0x6e, 0x41, 0x53, 0x8c, 0xb1, 0x32, 0x6a,
+	0xae, 0x5b, 0x81, 0xa9, 0xdf, 0x3d, 0xfd, 0x2f, 0x86, 0xc4, 0xd4, 0x74, 0x30, 0xa6, 0xba, 0x0b,
+	0xd7, 0x4f, 0x81, 0xd9, 0xb0, 0x00, 0x92, 0xf1, 0xb5, 0x17, 0x05, 0xc3, 0x37, 0xb0, 0x53, 0x3e,
+	0x12, 0x11, 0x75, 0x16, 0x8c, 0x7a, 0xa8, 0x88, 0xab, 0x18, 0x39, 0x94, 0x97, 0x60, 0x1e, 0x5a,
+	0x43, 0xc6, 0x48, 0x67, 0x94, 0x39, 0xa6, 0xa6, 0x82, 0xa9, 0x3f, 0xe4, 0x67, 0x76, 0x05, 0x8c,
+	0x75, 0x5f, 0x16, 0x1e, 0xc6, 0xdf, 0x9f, 0x87, 0x5d, 0xc7, 0xf8, 0xd8, 0x21, 0x38, 0xcf, 0x84,
+	0x70, 0x3e, 0xcc, 0x38, 0xdf, 0x24, 0x25, 0xc6, 0xa0, 0xbe, 0x0c, 0x92, 0x82, 0x49, 0x41, 0xad,
+	0x7a, 0x1a, 0x24, 0x2d, 0x51, 0xf4, 0x05, 0x16, 0xbc, 0xad, 0x31, 0x86, 0xe5, 0x18, 0x9b, 0x44,
+	0xff, 0x9e, 0x9d, 0x12, 0x3c, 0x04, 0x29, 0xca, 0xd7, 0x3c, 0x87, 0x57, 0x9e, 0xa3, 0xd1, 0x9b,
+	0x76, 0xe1, 0x8d, 0x1e, 0x10, 0xde, 0xc5, 0x10, 0x78, 0x53, 0x3c, 0xa5, 0x7a, 0xf0, 0xd1, 0x67,
+	0x81, 0xd6, 0x8f, 0x99, 0x4c, 0xa7, 0x76, 0xcc, 0x17, 0x16, 0x50, 0xb5, 0xe2, 0x36, 0x8f, 0xce,
+	0xd6, 0xda, 0xd9, 0x2f, 0xa3, 0xef, 0xb4, 0x5f, 0x6e, 0x80, 0x11, 0xca, 0x30, 0x30, 0x2d, 0x44,
+	0x21, 0xae, 0x10, 0xb9, 0xdd, 0xce, 0x87, 0x96, 0x6b, 0xa6, 0x58, 0x10, 0x7a, 0xd2, 0x4a, 0x92,
+	0x06, 0xc6, 0xd4, 0x5b, 0x60, 0xca, 0xc6, 0x8e, 0x29, 0xba, 0xad, 0xde, 0x3a, 0x9d, 0xcc, 0xcf,
+	0x3d, 0x6f, 0x65, 0xa6, 0xfa, 0x9b, 0xb4, 0x75, 0xde, 0xa3, 0x4d, 0xda, 0xd8, 0x59, 0xe5, 0x2f,
+	0x06, 0xf0, 0x0a, 0x9c, 0xfd, 0x13, 0x6f, 0x39, 0xfb, 0x5f, 0x02, 0xe3, 0x16, 0xc4, 0x95, 0xa6,
+	0x69, 0x63, 0x87, 0x9a, 0x15, 0x6c, 0x63, 0xaa, 0x0d, 0x72, 0x94, 0xd9, 0xae, 0x32, 0xca, 0x65,
+	0x9b, 0xd8, 0xa1, 0x37, 0x98, 0x24, 0x10, 0x7f, 0x27, 0x0e, 0x18, 0x7f, 0xec, 0xc4, 0x1f, 0x09,
+	0x3b, 0xf1, 0xf7, 0x85, 0x13, 0x3f, 0xe6, 0xf4, 0xc7, 0x98, 0x8c, 0xc0, 0x9f, 0x22, 0xe0, 0x3f,
+	0x42, 0x7a, 0x1b, 0x39, 0x16, 0x76, 0x4a, 0x7e, 0xd5, 0x3f, 0xae, 0x89, 0x1d, 0x76, 0x8c, 0x9c,
+	0xed, 0x02, 0xdb, 0x8b, 0x92, 0xa6, 0xe8, 0x0b, 0x20, 0xbd, 0x1f, 0x82, 0x81, 0x83, 0xfd, 0x37,
+	0x51, 0x5f, 0xcd, 0x97, 0xdf, 0xba, 0xef, 0x20, 0x8f, 0xec, 0xe2, 0xea, 0x91, 0x40, 0x7a, 0x07,
+	0x24, 0x44, 0x43, 0x24, 0x91, 0xde, 0x78, 0x8f, 0x5d, 0x90, 0x11, 0xe7, 0x3d, 0xd0, 0x21, 0x2e,
+	0x05, 0x26, 0x7a, 0xd2, 0x44, 0x53, 0xf4, 0xb3, 0x20, 0xb3, 0x2f, 0x0f, 0x01, 0xbe, 0x1e, 0x47,
+	0xc1, 0xe9, 0x1e, 0xbd, 0x2a, 0xf2, 0x20, 0x75, 0xff, 0xa5, 0xec, 0xc3, 0x52, 0x76, 0x25, 0xa4,
+	0xb2, 0xa5, 0xbb, 0x09, 0x18, 0xc6, 0x86, 0xbe, 0x00, 0xf4, 0x37, 0x71, 0x25, 0x6b, 0xdd, 0xe3,
+	0x08, 0x98, 0x64, 0xf7, 0x21, 0x6b, 0xae, 0x6d, 0x43, 0xc7, 0x3a, 0xae, 0x15, 0x6e, 0xdf, 0x13,
+	0x66, 0x10, 0x1b, 0xfd, 0xa1, 0x02, 0x52, 0xaf, 0x83, 0x25, 0xfb, 0xc4, 0xeb, 0x60, 0x72, 0x07,
+	0xd2, 0xe2, 0x2e, 0xb2, 0xcc, 0xa2, 0x94, 0x99, 0xd8, 0xc7, 0x6b, 0xaa, 0xdd, 0xca, 0x4c, 0xe4,
+	0x85, 0xd8, 0x7f, 0x73, 0xbd, 0x60, 0x4c, 0xec, 0xf4, 0x0c, 0x59, 0xec, 0xca, 0x4a, 0xbe, 0x6e,
+	0x16, 0xf9, 0xed, 0x09, 0x83, 0x66, 0xc4, 0x48, 0xca, 0xc1, 0x35, 0x36, 0xa6, 0x3f, 0x8b, 0x82,
+	0xb1, 0x55, 0xcb, 0xe2, 0x8b, 0xfe, 0xe7, 0xd8, 0xfa, 0x0c, 0xc4, 0x1c, 0x68, 0xa3, 0xf7, 0x49,
+	0x16, 0x37, 0xa8, 0xae, 0x80, 0x19, 0x07, 0x52, 0x5c, 0x47, 0x26, 0xef, 0x76, 0x82, 0x0b, 0x88,
+	0x76, 0x7a, 0x83, 0x29, 0xa1, 0xc2, 0x9b, 0xa3, 0x80, 0x53, 0x6b, 0xe0, 0x04, 0x4b, 0x56, 0xb6,
+	0x00, 0x79, 0x86, 0x5d, 0xf0, 0x9b, 0x22, 0x4a, 0x48, 0xb6, 0x33, 0xa7, 0xdf, 0x1d, 0x05, 0x0f,
+	0xb1, 0x83, 0x65, 0xf1, 0xa0, 0x9e, 0x03, 0x09, 0xf1, 0x59, 0x48, 0xb6, 0x40, 0xa3, 0xb2, 0x7f,
+	0x49, 0xdc, 0xe6, 0xa3, 0x86, 0x94, 0x1e, 0xe2, 0xb8, 0x71, 0x3e, 0xe4, 0x0b, 0xc4, 0x24, 0x0b,
+	0xaa, 0x1e, 0xfa, 0x74, 0x15, 0x8c, 0x77, 0x87, 0x64, 0x62, 0xfe, 0x11, 0x01, 0x33, 0x06, 0xa2,
+	0x5e, 0xf3, 0x63, 0x88, 0x2b, 0xc8, 0xba, 0x5e, 0x47, 0xce, 0xd1, 0xb8, 0x46, 0x5c, 0x06, 0x27,
+	0x10, 0x5b, 0x4c, 0xb7, 0xc6, 0x4e, 0xb7, 0x5b, 0x99, 0x41, 0xbe, 0x40, 0x5e, 0x65, 0xfd, 0x9f,
+	0xc6, 0x20, 0xd7, 0x3b, 0x54, 0xc1, 0xdc, 0xf7, 0x28, 0xd2, 0x0b, 0x29, 0x3b, 0x8a, 0xf4, 0xc3,
+	0x2c, 0x39, 0x78, 0xa2, 0x80, 0xc9, 0x3b, 0x55, 0x8b, 0xb5, 0x31, 0x22, 0x16, 0x24, 0xfe, 0xd7,
+	0xc0, 0x10, 0xac, 0xd1, 0x5d, 0xd7, 0xc3, 0xb4, 0xf9, 0xd6, 0xab, 0xee, 0xae, 0x2a, 0xbb, 0x16,
+	0x95, 0xa1, 0x16, 0xe1, 0x2d, 0xfc, 0x6c, 0x58, 0x0b, 0x2f, 0xa6, 0x92, 0xcd, 0xbb, 0xd4, 0x5f,
+	0x39, 0xef, 0x6f, 0x00, 0x5d, 0x6b, 0x9d, 0x12, 0x15, 0xf4, 0x90, 0x5d, 0x82, 0xbd, 0xee, 0xb1,
+	0x58, 0x4a, 0xfe, 0xe6, 0xde, 0xef, 0xe9, 0x81, 0xbd, 0x76, 0x5a, 0x79, 0xd6, 0x4e, 0x2b, 0xbf,
+	0xb5, 0xd3, 0xca, 0xa3, 0x17, 0xe9, 0x81, 0x67, 0x2f, 0xd2, 0x03, 0xbf, 0xbe, 0x48, 0x0f, 0x7c,
+	0x71, 0xf9, 0x80, 0xdc, 0xb2, 0xe9, 0x78, 0xfc, 0xec, 0x24, 0xf8, 0xc7, 0xcc, 0xab, 0x7f, 0x0f,
+	0x00, 0x97, 0x06, 0x31, 0xc5, 0xf6, 0x1d, 0x00, 0x00,
 }
 
 func (m *SetGatewayRequest) Marshal() (dAtA []byte, err error) {
@@ -1500,6 +1589,13 @@ func (m *SetGatewayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMProgramID) > 0 {
+		i -= len(m.SVMProgramID)
+		copy(dAtA[i:], m.SVMProgramID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SVMProgramID)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1715,6 +1811,75 @@ func (m *ConfirmGatewayTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfirmSVMGatewayTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmSVMGatewayTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmSVMGatewayTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxSignatures) > 0 {
+		for iNdEx := len(m.TxSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxSignatures[iNdEx])
+			copy(dAtA[i:], m.TxSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmSVMGatewayTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmSVMGatewayTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmSVMGatewayTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ConfirmDepositRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2817,6 +2982,10 @@ func (m *SetGatewayRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.SVMProgramID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2897,6 +3066,38 @@ func (m *ConfirmGatewayTxsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ConfirmSVMGatewayTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TxSignatures) > 0 {
+		for _, s := range m.TxSignatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfirmSVMGatewayTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ConfirmDepositRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3524,6 +3725,38 @@ func (m *SetGatewayRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMProgramID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMProgramID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4059,6 +4292,202 @@ func (m *ConfirmGatewayTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfirmSVMGatewayTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxSignatures = append(m.TxSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmSVMGatewayTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmSVMGatewayTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConfirmDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"reflect"
 	"sort"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -554,6 +556,11 @@ func (b CommandBatch) GetCommandIDs() []CommandID {
 	return b.metadata.CommandIDs
 }
 
+// GetSigningSessionID returns the ID of the latest signing session of the batch
+func (b CommandBatch) GetSigningSessionID() uint64 {
+	return b.metadata.SigningSessionID
+}
+
 // GetSignature returns the batch's signature
 func (b CommandBatch) GetSignature() utils.ValidatedProtoMarshaler {
 	if b.metadata.Signature == nil {
@@ -579,6 +586,18 @@ func (b *CommandBatch) SetStatus(status BatchedCommandsStatus) bool {
 	return false
 }
 
+// SetSigning sets the batch to be signed by the signing session with the given ID, returning true if the status was updated
+func (b *CommandBatch) SetSigning(signingSessionID uint64) bool {
+	if b.metadata.Status != BatchNonExistent && b.metadata.Status != BatchSigned {
+		b.metadata.Status = BatchSigning
+		b.metadata.SigningSessionID = signingSessionID
+		b.setter(b.metadata)
+		return true
+	}
+
+	return false
+}
+
 // SetSigned sets the signature and signed status for the batch
 func (b *CommandBatch) SetSigned(signature utils.ValidatedProtoMarshaler) error {
 	if b.metadata.Status != BatchSigning {
@@ -851,6 +870,55 @@ func packArguments(chainID math.Int, commandIDs []CommandID, commands []CommandT
 	return result, nil
 }
 
+// DecodeCommandBatchData decodes the data of a command batch into the chain ID and the commands it contains.
+// The decoded commands only carry their ID, type and params
+func DecodeCommandBatchData(data []byte) (math.Int, []Command, error) {
+	bytes32ArrayType := funcs.Must(abi.NewType("bytes32[]", "bytes32[]", nil))
+	stringArrayType := funcs.Must(abi.NewType("string[]", "string[]", nil))
+	bytesArrayType := funcs.Must(abi.NewType("bytes[]", "bytes[]", nil))
+
+	arguments := abi.Arguments{{Type: uint256Type}, {Type: bytes32ArrayType}, {Type: stringArrayType}, {Type: bytesArrayType}}
+	values, err := StrictDecode(arguments, data)
+	if err != nil {
+		return math.Int{}, nil, fmt.Errorf("invalid command batch data: %w", err)
+	}
+
+	chainID := values[0].(*big.Int)
+	commandIDs := values[1].([][common.HashLength]byte)
+	commandTypes := values[2].([]string)
+	commandParams := values[3].([][]byte)
+
+	if len(commandIDs) != len(commandTypes) || len(commandIDs) != len(commandParams) {
+		return math.Int{}, nil, fmt.Errorf("length mismatch for command arguments")
+	}
+
+	commands := make([]Command, len(commandIDs))
+	for i := range commandIDs {
+		commandType, err := commandTypeFromString(commandTypes[i])
+		if err != nil {
+			return math.Int{}, nil, err
+		}
+
+		commands[i] = Command{
+			ID:     CommandID(commandIDs[i]),
+			Type:   commandType,
+			Params: commandParams[i],
+		}
+	}
+
+	return math.NewIntFromBigInt(chainID), commands, nil
+}
+
+func commandTypeFromString(s string) (CommandType, error) {
+	for value := range CommandType_name {
+		if commandType := CommandType(value); commandType != COMMAND_TYPE_UNSPECIFIED && commandType.String() == s {
+			return commandType, nil
+		}
+	}
+
+	return COMMAND_TYPE_UNSPECIFIED, fmt.Errorf("unknown command type '%s'", s)
+}
+
 // ValidateBasic does stateless validation of the object
 func (m *BurnerInfo) ValidateBasic() error {
 	if err := m.DestinationChain.Validate(); err != nil {
@@ -929,16 +997,58 @@ func CommandIDsToStrings(commandIDs []CommandID) []string {
 
 // GetID returns an unique ID for the event
 func (m Event) GetID() EventID {
+	if m.IsSVM() {
+		return NewSVMEventID(m.SVMTxSignature, m.Index)
+	}
+
 	return NewEventID(m.TxID, m.Index)
 }
 
+// IsSVM returns true if the event was emitted by a gateway program on a Solana compatible chain
+func (m Event) IsSVM() bool {
+	return len(m.SVMTxSignature) != 0
+}
+
+// GetSourceTxID returns the native ID of the transaction that emitted the event
+func (m Event) GetSourceTxID() []byte {
+	if m.IsSVM() {
+		return base58.Decode(m.SVMTxSignature)
+	}
+
+	return m.TxID.Bytes()
+}
+
+// GetSourceTxIDString returns the native string encoding of the ID of the transaction that emitted the event
+func (m Event) GetSourceTxIDString() string {
+	if m.IsSVM() {
+		return m.SVMTxSignature
+	}
+
+	return m.TxID.Hex()
+}
+
 // ValidateBasic returns an error if the event is invalid
 func (m Event) ValidateBasic() error {
 	if err := m.Chain.Validate(); err != nil {
 		return errorsmod.Wrap(err, "invalid source chain")
 	}
 
-	if m.TxID.IsZero() {
+	switch {
+	case m.IsSVM():
+		if !m.TxID.IsZero() {
+			return fmt.Errorf("tx id and svm tx signature cannot both be set")
+		}
+
+		if err := ValidateSVMSignature(m.SVMTxSignature); err != nil {
+			return errorsmod.Wrap(err, "invalid svm tx signature")
+		}
+
+		switch m.GetEvent().(type) {
+		case *Event_ContractCall, *Event_ContractCallWithToken:
+		default:
+			return fmt.Errorf("unsupported type of svm event")
+		}
+	case m.TxID.IsZero():
 		return fmt.Errorf("invalid tx id")
 	}
 
@@ -973,6 +1083,13 @@ func (m Event) ValidateBasic() error {
 		if err := event.MultisigOperatorshipTransferred.ValidateBasic(); err != nil {
 			return errorsmod.Wrap(err, "invalid event MultisigOperatorshipTransferred")
 		}
+	case *Event_CommandExecuted:
+		if event.CommandExecuted == nil {
+			return fmt.Errorf("missing event CommandExecuted")
+		}
+		if err := event.CommandExecuted.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid event CommandExecuted")
+		}
 	default:
 		return fmt.Errorf("unknown type of event")
 	}
@@ -989,8 +1106,8 @@ const maxReceiverLength = 128
 
 // ValidateBasic returns an error if the event contract call is invalid
 func (m EventContractCall) ValidateBasic() error {
-	if m.Sender.IsZeroAddress() {
-		return fmt.Errorf("invalid sender")
+	if err := validateSender(m.Sender, m.SVMSender); err != nil {
+		return err
 	}
 
 	if err := m.DestinationChain.Validate(); err != nil {
@@ -1012,10 +1129,48 @@ func (m EventContractCall) ValidateBasic() error {
 	return nil
 }
 
+// GetSenderAddress returns the native string encoding of the sender
+func (m EventContractCall) GetSenderAddress() string {
+	if len(m.SVMSender) != 0 {
+		return m.SVMSender
+	}
+
+	return m.Sender.Hex()
+}
+
+// GetSenderAddress returns the native string encoding of the sender
+func (m EventContractCallWithToken) GetSenderAddress() string {
+	if len(m.SVMSender) != 0 {
+		return m.SVMSender
+	}
+
+	return m.Sender.Hex()
+}
+
+func validateSender(sender Address, svmSender string) error {
+	if len(svmSender) == 0 {
+		if sender.IsZeroAddress() {
+			return fmt.Errorf("invalid sender")
+		}
+
+		return nil
+	}
+
+	if !sender.IsZeroAddress() {
+		return fmt.Errorf("sender and svm sender cannot both be set")
+	}
+
+	if err := ValidateSVMAddress(svmSender); err != nil {
+		return errorsmod.Wrap(err, "invalid svm sender")
+	}
+
+	return nil
+}
+
 // ValidateBasic returns an error if the event contract call with token is invalid
 func (m EventContractCallWithToken) ValidateBasic() error {
-	if m.Sender.IsZeroAddress() {
-		return fmt.Errorf("invalid sender")
+	if err := validateSender(m.Sender, m.SVMSender); err != nil {
+		return err
 	}
 
 	if err := m.DestinationChain.Validate(); err != nil {
@@ -1078,6 +1233,36 @@ func (m EventMultisigOperatorshipTransferred) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic returns an error if the event command executed is invalid
+func (m EventCommandExecuted) ValidateBasic() error {
+	if m.CommandID == (CommandID{}) {
+		return fmt.Errorf("invalid command ID")
+	}
+
+	return nil
+}
+
+// ValidateBasic returns an error if the command execution is invalid
+func (m CommandExecution) ValidateBasic() error {
+	if m.CommandID == (CommandID{}) {
+		return fmt.Errorf("invalid command ID")
+	}
+
+	if len(m.BatchID) != 32 {
+		return fmt.Errorf("batch ID must be of length 32")
+	}
+
+	if m.TxID.IsZero() {
+		return fmt.Errorf("invalid tx id")
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("height must be >0")
+	}
+
+	return nil
+}
+
 // StrictDecode performs strict decode on evm encoded data, e.g. no byte can be left after the decoding
 // Never use user-typed arguments without proper input sanitisation mechanisms like checkBrackets and ABIInflationGuard.
 // And preferable only for simple non array types.
@@ -1160,7 +1345,12 @@ func NewEventID(txID Hash, index uint64) EventID {
 	return EventID(fmt.Sprintf("%s-%d", txID.Hex(), index))
 }
 
-// Validate returns an error, if the event ID is not in format of txID-index
+// NewSVMEventID returns a new event ID for an event of a Solana compatible chain, formatted as "<base58 tx signature>-<index>"
+func NewSVMEventID(signature string, index uint64) EventID {
+	return EventID(fmt.Sprintf("%s-%d", signature, index))
+}
+
+// Validate returns an error, if the event ID is not in format of txID-index. The txID is either a hex encoded tx hash or a base58 encoded svm tx signature
 func (id EventID) Validate() error {
 	if err := utils.ValidateString(string(id)); err != nil {
 		return err
@@ -1171,23 +1361,33 @@ func (id EventID) Validate() error {
 		return fmt.Errorf("event ID should be in foramt of txID-index")
 	}
 
-	bz, err := hexutil.Decode(arr[0])
-	if err != nil {
-		return errorsmod.Wrap(err, "invalid tx hash hex encoding")
-	}
+	if ValidateSVMSignature(arr[0]) != nil {
+		bz, err := hexutil.Decode(arr[0])
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid tx hash hex encoding")
+		}
 
-	if len(bz) != common.HashLength {
-		return fmt.Errorf("invalid tx hash length")
+		if len(bz) != common.HashLength {
+			return fmt.Errorf("invalid tx hash length")
+		}
 	}
 
-	_, err = strconv.ParseInt(arr[1], 10, 64)
-	if err != nil {
+	if _, err := strconv.ParseInt(arr[1], 10, 64); err != nil {
 		return errorsmod.Wrap(err, "invalid index")
 	}
 
 	return nil
 }
 
+// ValidateMultisigKeyType returns an error if the given key cannot be used by EVM chains, i.e. it is not an ECDSA key
+func ValidateMultisigKeyType(key multisig.Key) error {
+	if key.GetKeyType() != multisig.ECDSA {
+		return fmt.Errorf("key %s is of type %s, but EVM chains require %s keys", key.GetID(), key.GetKeyType(), multisig.ECDSA)
+	}
+
+	return nil
+}
+
 // ParseMultisigKey parses the given multisig key and returns the weight for
 // each particpant evm address and the threshold
 func ParseMultisigKey(key multisig.Key) (map[string]math.Uint, math.Uint) {
@@ -1261,6 +1461,14 @@ type Operator struct {
 
 // ValidateBasic returns an error if the Gateway address is invalid
 func (m Gateway) ValidateBasic() error {
+	if len(m.SVMProgramID) != 0 {
+		if !m.Address.IsZeroAddress() {
+			return errors.New("address and svm program id cannot both be set")
+		}
+
+		return ValidateSVMAddress(m.SVMProgramID)
+	}
+
 	if m.Address.IsZeroAddress() {
 		return errors.New("address must not be empty")
 	}
@@ -291,6 +291,10 @@ type Event struct {
 	TxID   Hash                                                            `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
 	Index  uint64                                                          `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
 	Status Event_Status                                                    `protobuf:"varint,4,opt,name=status,proto3,enum=axelar.evm.v1beta1.Event_Status" json:"status,omitempty"`
+	// svm_tx_signature is the base58 encoded signature of the transaction that
+	// emitted the event on a chain whose gateway is a Solana program. It is set
+	// instead of tx_id for such chains
+	SVMTxSignature string `protobuf:"bytes,15,opt,name=svm_tx_signature,json=svmTxSignature,proto3" json:"svm_tx_signature,omitempty"`
 	// Types that are valid to be assigned to Event:
 	//	*Event_TokenSent
 	//	*Event_ContractCall
@@ -299,6 +303,7 @@ type Event struct {
 	//	*Event_TokenDeployed
 	//	*Event_MultisigOwnershipTransferred
 	//	*Event_MultisigOperatorshipTransferred
+	//	*Event_CommandExecuted
 	Event isEvent_Event `protobuf_oneof:"event"`
 }
 
@@ -362,6 +367,9 @@ type Event_MultisigOwnershipTransferred struct {
 type Event_MultisigOperatorshipTransferred struct {
 	MultisigOperatorshipTransferred *EventMultisigOperatorshipTransferred `protobuf:"bytes,11,opt,name=multisig_operatorship_transferred,json=multisigOperatorshipTransferred,proto3,oneof" json:"multisig_operatorship_transferred,omitempty"`
 }
+type Event_CommandExecuted struct {
+	CommandExecuted *EventCommandExecuted `protobuf:"bytes,14,opt,name=command_executed,json=commandExecuted,proto3,oneof" json:"command_executed,omitempty"`
+}
 
 func (*Event_TokenSent) isEvent_Event()                       {}
 func (*Event_ContractCall) isEvent_Event()                    {}
@@ -370,6 +378,7 @@ func (*Event_Transfer) isEvent_Event()                        {}
 func (*Event_TokenDeployed) isEvent_Event()                   {}
 func (*Event_MultisigOwnershipTransferred) isEvent_Event()    {}
 func (*Event_MultisigOperatorshipTransferred) isEvent_Event() {}
+func (*Event_CommandExecuted) isEvent_Event()                 {}
 
 func (m *Event) GetEvent() isEvent_Event {
 	if m != nil {
@@ -430,6 +439,13 @@ func (m *Event) GetMultisigOperatorshipTransferred() *EventMultisigOperatorshipT
 	return nil
 }
 
+func (m *Event) GetCommandExecuted() *EventCommandExecuted {
+	if x, ok := m.GetEvent().(*Event_CommandExecuted); ok {
+		return x.CommandExecuted
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -440,6 +456,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_TokenDeployed)(nil),
 		(*Event_MultisigOwnershipTransferred)(nil),
 		(*Event_MultisigOperatorshipTransferred)(nil),
+		(*Event_CommandExecuted)(nil),
 	}
 }
 
@@ -492,6 +509,9 @@ type EventContractCall struct {
 	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
 	ContractAddress  string                                                          `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	PayloadHash      Hash                                                            `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3,customtype=Hash" json:"payload_hash"`
+	// svm_sender is the base58 encoded sender account on a chain whose gateway is
+	// a Solana program. It is set instead of sender for such chains
+	SVMSender string `protobuf:"bytes,5,opt,name=svm_sender,json=svmSender,proto3" json:"svm_sender,omitempty"`
 }
 
 func (m *EventContractCall) Reset()         { *m = EventContractCall{} }
@@ -534,6 +554,9 @@ type EventContractCallWithToken struct {
 	PayloadHash      Hash                                                            `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3,customtype=Hash" json:"payload_hash"`
 	Symbol           string                                                          `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Amount           cosmossdk_io_math.Uint                                          `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	// svm_sender is the base58 encoded sender account on a chain whose gateway is
+	// a Solana program. It is set instead of sender for such chains
+	SVMSender string `protobuf:"bytes,7,opt,name=svm_sender,json=svmSender,proto3" json:"svm_sender,omitempty"`
 }
 
 func (m *EventContractCallWithToken) Reset()         { *m = EventContractCallWithToken{} }
@@ -691,6 +714,43 @@ func (m *EventMultisigOwnershipTransferred) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventMultisigOwnershipTransferred proto.InternalMessageInfo
 
+type EventCommandExecuted struct {
+	CommandID CommandID `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3,customtype=CommandID" json:"command_id"`
+}
+
+func (m *EventCommandExecuted) Reset()         { *m = EventCommandExecuted{} }
+func (m *EventCommandExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventCommandExecuted) ProtoMessage()    {}
+func (*EventCommandExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea224848ef0a2f28, []int{8}
+}
+func (m *EventCommandExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommandExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommandExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommandExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommandExecuted.Merge(m, src)
+}
+func (m *EventCommandExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommandExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommandExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommandExecuted proto.InternalMessageInfo
+
 type EventMultisigOperatorshipTransferred struct {
 	NewOperators []Address                `protobuf:"bytes,3,rep,name=new_operators,json=newOperators,proto3,customtype=Address" json:"new_operators"`
 	NewThreshold cosmossdk_io_math.Uint   `protobuf:"bytes,4,opt,name=new_threshold,json=newThreshold,proto3,customtype=cosmossdk.io/math.Uint" json:"new_threshold"`
@@ -701,7 +761,7 @@ func (m *EventMultisigOperatorshipTransferred) Reset()         { *m = EventMulti
 func (m *EventMultisigOperatorshipTransferred) String() string { return proto.CompactTextString(m) }
 func (*EventMultisigOperatorshipTransferred) ProtoMessage()    {}
 func (*EventMultisigOperatorshipTransferred) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{8}
+	return fileDescriptor_ea224848ef0a2f28, []int{9}
 }
 func (m *EventMultisigOperatorshipTransferred) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +800,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{9}
+	return fileDescriptor_ea224848ef0a2f28, []int{10}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +844,7 @@ func (m *BurnerInfo) Reset()         { *m = BurnerInfo{} }
 func (m *BurnerInfo) String() string { return proto.CompactTextString(m) }
 func (*BurnerInfo) ProtoMessage()    {}
 func (*BurnerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{10}
+	return fileDescriptor_ea224848ef0a2f28, []int{11}
 }
 func (m *BurnerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,7 +887,7 @@ func (m *ERC20Deposit) Reset()         { *m = ERC20Deposit{} }
 func (m *ERC20Deposit) String() string { return proto.CompactTextString(m) }
 func (*ERC20Deposit) ProtoMessage()    {}
 func (*ERC20Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{11}
+	return fileDescriptor_ea224848ef0a2f28, []int{12}
 }
 func (m *ERC20Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,7 +932,7 @@ func (m *ERC20TokenMetadata) Reset()         { *m = ERC20TokenMetadata{} }
 func (m *ERC20TokenMetadata) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokenMetadata) ProtoMessage()    {}
 func (*ERC20TokenMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{12}
+	return fileDescriptor_ea224848ef0a2f28, []int{13}
 }
 func (m *ERC20TokenMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +970,7 @@ func (m *TransactionMetadata) Reset()         { *m = TransactionMetadata{} }
 func (m *TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*TransactionMetadata) ProtoMessage()    {}
 func (*TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{13}
+	return fileDescriptor_ea224848ef0a2f28, []int{14}
 }
 func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,13 +1006,16 @@ type Command struct {
 	KeyID      github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	MaxGasCost uint32                                                         `protobuf:"varint,5,opt,name=max_gas_cost,json=maxGasCost,proto3" json:"max_gas_cost,omitempty"`
 	Type       CommandType                                                    `protobuf:"varint,6,opt,name=type,proto3,enum=axelar.evm.v1beta1.CommandType" json:"type,omitempty"`
+	// optional gas limit of the contract call approved by the command, 0 if
+	// not set
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *Command) Reset()         { *m = Command{} }
 func (m *Command) String() string { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()    {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{14}
+	return fileDescriptor_ea224848ef0a2f28, []int{15}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,13 +1053,14 @@ type CommandBatchMetadata struct {
 	KeyID                 github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	PrevBatchedCommandsID []byte                                                         `protobuf:"bytes,7,opt,name=prev_batched_commands_id,json=prevBatchedCommandsId,proto3" json:"prev_batched_commands_id,omitempty"`
 	Signature             *types.Any                                                     `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	SigningSessionID      uint64                                                         `protobuf:"varint,9,opt,name=signing_session_id,json=signingSessionId,proto3" json:"signing_session_id,omitempty"`
 }
 
 func (m *CommandBatchMetadata) Reset()         { *m = CommandBatchMetadata{} }
 func (m *CommandBatchMetadata) String() string { return proto.CompactTextString(m) }
 func (*CommandBatchMetadata) ProtoMessage()    {}
 func (*CommandBatchMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{15}
+	return fileDescriptor_ea224848ef0a2f28, []int{16}
 }
 func (m *CommandBatchMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,6 +1089,47 @@ func (m *CommandBatchMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommandBatchMetadata proto.InternalMessageInfo
 
+// CommandExecution records the execution of a command on the gateway
+type CommandExecution struct {
+	CommandID CommandID `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3,customtype=CommandID" json:"command_id"`
+	BatchID   []byte    `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	TxID      Hash      `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+	Height    int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CommandExecution) Reset()         { *m = CommandExecution{} }
+func (m *CommandExecution) String() string { return proto.CompactTextString(m) }
+func (*CommandExecution) ProtoMessage()    {}
+func (*CommandExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea224848ef0a2f28, []int{17}
+}
+func (m *CommandExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommandExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommandExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommandExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommandExecution.Merge(m, src)
+}
+func (m *CommandExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommandExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommandExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommandExecution proto.InternalMessageInfo
+
 // SigMetadata stores necessary information for external apps to map signature
 // results to evm relay transaction types
 type SigMetadata struct {
@@ -1037,7 +1142,7 @@ func (m *SigMetadata) Reset()         { *m = SigMetadata{} }
 func (m *SigMetadata) String() string { return proto.CompactTextString(m) }
 func (*SigMetadata) ProtoMessage()    {}
 func (*SigMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{16}
+	return fileDescriptor_ea224848ef0a2f28, []int{18}
 }
 func (m *SigMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1181,7 @@ func (m *TransferKey) Reset()         { *m = TransferKey{} }
 func (m *TransferKey) String() string { return proto.CompactTextString(m) }
 func (*TransferKey) ProtoMessage()    {}
 func (*TransferKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{17}
+	return fileDescriptor_ea224848ef0a2f28, []int{19}
 }
 func (m *TransferKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1219,7 @@ func (m *Asset) Reset()         { *m = Asset{} }
 func (m *Asset) String() string { return proto.CompactTextString(m) }
 func (*Asset) ProtoMessage()    {}
 func (*Asset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{18}
+	return fileDescriptor_ea224848ef0a2f28, []int{20}
 }
 func (m *Asset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,7 +1259,7 @@ func (m *TokenDetails) Reset()         { *m = TokenDetails{} }
 func (m *TokenDetails) String() string { return proto.CompactTextString(m) }
 func (*TokenDetails) ProtoMessage()    {}
 func (*TokenDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{19}
+	return fileDescriptor_ea224848ef0a2f28, []int{21}
 }
 func (m *TokenDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1185,13 +1290,17 @@ var xxx_messageInfo_TokenDetails proto.InternalMessageInfo
 
 type Gateway struct {
 	Address Address `protobuf:"bytes,1,opt,name=address,proto3,customtype=Address" json:"address"`
+	// svm_program_id is the base58 encoded address of the gateway program of a
+	// chain whose gateway is a Solana program. It is set instead of address for
+	// such chains
+	SVMProgramID string `protobuf:"bytes,3,opt,name=svm_program_id,json=svmProgramId,proto3" json:"svm_program_id,omitempty"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
 func (m *Gateway) String() string { return proto.CompactTextString(m) }
 func (*Gateway) ProtoMessage()    {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{20}
+	return fileDescriptor_ea224848ef0a2f28, []int{22}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1221,15 +1330,16 @@ func (m *Gateway) XXX_DiscardUnknown() {
 var xxx_messageInfo_Gateway proto.InternalMessageInfo
 
 type PollMetadata struct {
-	Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
-	TxID  Hash                                                            `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+	Chain          github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	TxID           Hash                                                            `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,customtype=Hash" json:"tx_id"`
+	SVMTxSignature string                                                          `protobuf:"bytes,3,opt,name=svm_tx_signature,json=svmTxSignature,proto3" json:"svm_tx_signature,omitempty"`
 }
 
 func (m *PollMetadata) Reset()         { *m = PollMetadata{} }
 func (m *PollMetadata) String() string { return proto.CompactTextString(m) }
 func (*PollMetadata) ProtoMessage()    {}
 func (*PollMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ea224848ef0a2f28, []int{21}
+	return fileDescriptor_ea224848ef0a2f28, []int{23}
 }
 func (m *PollMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1274,6 +1384,7 @@ func init() {
 	proto.RegisterType((*EventTransfer)(nil), "axelar.evm.v1beta1.EventTransfer")
 	proto.RegisterType((*EventTokenDeployed)(nil), "axelar.evm.v1beta1.EventTokenDeployed")
 	proto.RegisterType((*EventMultisigOwnershipTransferred)(nil), "axelar.evm.v1beta1.EventMultisigOwnershipTransferred")
+	proto.RegisterType((*EventCommandExecuted)(nil), "axelar.evm.v1beta1.EventCommandExecuted")
 	proto.RegisterType((*EventMultisigOperatorshipTransferred)(nil), "axelar.evm.v1beta1.EventMultisigOperatorshipTransferred")
 	proto.RegisterType((*NetworkInfo)(nil), "axelar.evm.v1beta1.NetworkInfo")
 	proto.RegisterType((*BurnerInfo)(nil), "axelar.evm.v1beta1.BurnerInfo")
@@ -1282,6 +1393,7 @@ func init() {
 	proto.RegisterType((*TransactionMetadata)(nil), "axelar.evm.v1beta1.TransactionMetadata")
 	proto.RegisterType((*Command)(nil), "axelar.evm.v1beta1.Command")
 	proto.RegisterType((*CommandBatchMetadata)(nil), "axelar.evm.v1beta1.CommandBatchMetadata")
+	proto.RegisterType((*CommandExecution)(nil), "axelar.evm.v1beta1.CommandExecution")
 	proto.RegisterType((*SigMetadata)(nil), "axelar.evm.v1beta1.SigMetadata")
 	proto.RegisterType((*TransferKey)(nil), "axelar.evm.v1beta1.TransferKey")
 	proto.RegisterType((*Asset)(nil), "axelar.evm.v1beta1.Asset")
@@ -1293,164 +1405,179 @@ func init() {
 func init() { proto.RegisterFile("axelar/evm/v1beta1/types.proto", fileDescriptor_ea224848ef0a2f28) }
 
 var fileDescriptor_ea224848ef0a2f28 = []byte{
-	// 2497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xe7, 0xae, 0xf8, 0xf9, 0xf8, 0x61, 0x66, 0x22, 0x3b, 0x34, 0x1d, 0x93, 0x0c, 0x13, 0xc7,
-	0x8a, 0x9b, 0x90, 0x89, 0xf2, 0xd1, 0x34, 0x68, 0x9b, 0xf0, 0xcb, 0xd2, 0x5a, 0x12, 0x49, 0x2c,
-	0xe9, 0x38, 0xce, 0xa1, 0x8b, 0x21, 0x77, 0x4c, 0x2e, 0x44, 0xee, 0x12, 0xbb, 0x23, 0x89, 0xec,
-	0xad, 0x97, 0x22, 0xe0, 0x29, 0x40, 0x7b, 0x15, 0x50, 0x20, 0x05, 0x5a, 0xf4, 0xd2, 0x1e, 0x7a,
-	0xe8, 0xa1, 0x87, 0xa2, 0xa7, 0xa0, 0x87, 0x22, 0xe8, 0xa5, 0x45, 0x0f, 0x44, 0x2b, 0xdf, 0xfb,
-	0x07, 0xe4, 0xd2, 0x62, 0x67, 0x87, 0xe4, 0x52, 0x22, 0x25, 0x39, 0xb0, 0x81, 0x00, 0xbd, 0x71,
-	0xf6, 0x7d, 0xbf, 0xf9, 0xcd, 0x7b, 0x33, 0x8f, 0x90, 0xc2, 0x43, 0xd2, 0xc3, 0x66, 0x9e, 0x1c,
-	0xf6, 0xf3, 0x87, 0x6f, 0xb5, 0x08, 0xc5, 0x6f, 0xe5, 0xe9, 0x68, 0x40, 0xac, 0xdc, 0xc0, 0x34,
-	0xa8, 0x81, 0x90, 0x43, 0xcf, 0x91, 0xc3, 0x7e, 0x8e, 0xd3, 0x93, 0xd7, 0x3b, 0x86, 0xd1, 0xe9,
-	0x91, 0x3c, 0xe3, 0x68, 0x1d, 0x3c, 0xca, 0x63, 0x7d, 0xe4, 0xb0, 0x27, 0xd7, 0x3b, 0x46, 0xc7,
-	0x60, 0x3f, 0xf3, 0xf6, 0x2f, 0xfe, 0xf5, 0x7a, 0xdb, 0xb0, 0xfa, 0x86, 0xa5, 0x38, 0x04, 0x67,
-	0xe1, 0x90, 0xb2, 0xbf, 0x10, 0x00, 0x3e, 0x36, 0x28, 0xa9, 0x1c, 0x12, 0x9d, 0x5a, 0xe8, 0x21,
-	0xf8, 0xda, 0x5d, 0xac, 0xe9, 0x09, 0x21, 0x23, 0x6c, 0x84, 0x8a, 0xa5, 0xaf, 0x27, 0xe9, 0x0f,
-	0x3b, 0x1a, 0xed, 0x1e, 0xb4, 0x72, 0x6d, 0xa3, 0x9f, 0x77, 0x9c, 0xd1, 0x09, 0x3d, 0x32, 0xcc,
-	0x7d, 0xbe, 0x7a, 0xa3, 0x6d, 0x98, 0x24, 0x3f, 0xcc, 0xeb, 0x64, 0x78, 0x60, 0xe5, 0xc9, 0x70,
-	0x60, 0x98, 0x94, 0xa8, 0xb9, 0x92, 0xad, 0xa6, 0x8a, 0xfb, 0x44, 0x76, 0x34, 0xa2, 0xef, 0x82,
-	0x9f, 0x30, 0x23, 0x09, 0x31, 0xb3, 0xb6, 0x11, 0xde, 0xbc, 0x9e, 0x3b, 0x1b, 0x5a, 0x8e, 0xb9,
-	0x51, 0xf4, 0x7e, 0x39, 0x49, 0x7b, 0x64, 0xce, 0x9e, 0xfd, 0x5b, 0x10, 0x7c, 0xec, 0xfb, 0xb3,
-	0xf4, 0xee, 0x35, 0xf0, 0xd1, 0xa1, 0xa2, 0xa9, 0x09, 0x31, 0x23, 0x6c, 0x44, 0x8a, 0xeb, 0xb6,
-	0x07, 0xff, 0x9c, 0xa4, 0xbd, 0xdb, 0xd8, 0xea, 0x9e, 0x4c, 0xd2, 0xde, 0xe6, 0x50, 0x2a, 0xcb,
-	0x5e, 0x3a, 0x94, 0x54, 0xb4, 0x0e, 0x3e, 0x4d, 0x57, 0xc9, 0x30, 0xb1, 0x96, 0x11, 0x36, 0xbc,
-	0xb2, 0xb3, 0x40, 0xef, 0x83, 0xdf, 0xa2, 0x98, 0x1e, 0x58, 0x09, 0x6f, 0x46, 0xd8, 0x88, 0x6d,
-	0x66, 0x56, 0x86, 0x97, 0x6b, 0x30, 0x3e, 0x99, 0xf3, 0xa3, 0x2d, 0x00, 0x6a, 0xec, 0x13, 0x5d,
-	0xb1, 0x88, 0x4e, 0x13, 0xbe, 0x8c, 0xb0, 0x11, 0xde, 0xcc, 0xae, 0x94, 0x6e, 0xda, 0xac, 0x0d,
-	0x3b, 0x4b, 0x62, 0x42, 0xd8, 0xf6, 0xc8, 0x21, 0x3a, 0xfd, 0x80, 0x76, 0x21, 0xda, 0x36, 0x74,
-	0x6a, 0xe2, 0x36, 0x55, 0xda, 0xb8, 0xd7, 0x4b, 0xf8, 0x99, 0xae, 0x5b, 0x2b, 0x75, 0x95, 0x38,
-	0x77, 0x09, 0xf7, 0x7a, 0xdb, 0x1e, 0x39, 0xd2, 0x76, 0xad, 0x91, 0x06, 0x89, 0x05, 0x6d, 0xca,
-	0x91, 0x46, 0xbb, 0x0a, 0xb3, 0x96, 0x08, 0x30, 0xc5, 0xb9, 0x4b, 0x29, 0x7e, 0xa0, 0xd1, 0x2e,
-	0x73, 0x7a, 0xdb, 0x23, 0x5f, 0x6d, 0x2f, 0x23, 0xa0, 0x12, 0x04, 0xa9, 0x89, 0x75, 0xeb, 0x11,
-	0x31, 0x13, 0x41, 0xa6, 0xfa, 0xa5, 0xd5, 0xf1, 0x73, 0x46, 0x1e, 0xfe, 0x4c, 0x10, 0xd5, 0x20,
-	0xe6, 0xa4, 0x51, 0x25, 0x83, 0x9e, 0x31, 0x22, 0x6a, 0x22, 0xc4, 0x54, 0xbd, 0x7a, 0x7e, 0x2a,
-	0xcb, 0x9c, 0x7b, 0xdb, 0x23, 0x47, 0xa9, 0xfb, 0x03, 0xfa, 0x89, 0x00, 0xa9, 0xfe, 0x41, 0x8f,
-	0x6a, 0x96, 0xd6, 0x51, 0x8c, 0x23, 0x9d, 0x98, 0x56, 0x57, 0x1b, 0x28, 0x53, 0x83, 0x26, 0x51,
-	0x13, 0xc0, 0x2c, 0xbc, 0xbb, 0xd2, 0xc2, 0x1e, 0x17, 0xaf, 0x4d, 0xa5, 0x9b, 0x73, 0x61, 0x1e,
-	0xc0, 0x8b, 0xfd, 0x73, 0x78, 0xd0, 0x4f, 0x05, 0x78, 0x69, 0xee, 0xc3, 0x80, 0x98, 0x98, 0x1a,
-	0x67, 0xdd, 0x08, 0x33, 0x37, 0xde, 0xbf, 0xd8, 0x0d, 0x97, 0x02, 0x97, 0x95, 0x6d, 0x8f, 0x9c,
-	0xee, 0x9f, 0xcf, 0x92, 0xfd, 0x83, 0x00, 0x7e, 0x07, 0xb7, 0xe8, 0x75, 0x40, 0x8d, 0x66, 0xa1,
-	0x79, 0xbf, 0xa1, 0xdc, 0xaf, 0x36, 0xea, 0x95, 0x92, 0x74, 0x57, 0xaa, 0x94, 0xe3, 0x9e, 0xe4,
-	0xfa, 0xf8, 0x38, 0x13, 0x67, 0xf6, 0xaa, 0x86, 0x5e, 0x19, 0x6a, 0x16, 0xb5, 0x41, 0xb9, 0x01,
-	0x71, 0xce, 0x5d, 0xaa, 0x55, 0xef, 0x4a, 0xf2, 0x5e, 0xa5, 0x1c, 0x17, 0x92, 0x68, 0x7c, 0x9c,
-	0x89, 0x4d, 0xa1, 0xf2, 0x48, 0x33, 0xfb, 0x44, 0x5d, 0xe0, 0xdc, 0xab, 0xef, 0x56, 0x9a, 0x95,
-	0x72, 0x5c, 0x5c, 0xe0, 0xec, 0x0f, 0x7a, 0x84, 0x12, 0x15, 0x65, 0x21, 0xca, 0x39, 0xef, 0x16,
-	0xa4, 0xdd, 0x4a, 0x39, 0xbe, 0x96, 0xbc, 0x32, 0x3e, 0xce, 0x84, 0x19, 0xdb, 0x5d, 0xac, 0xf5,
-	0x88, 0x9a, 0x0c, 0x7e, 0xf6, 0x45, 0xca, 0xf3, 0xeb, 0x5f, 0xa6, 0x84, 0x62, 0x00, 0x7c, 0xac,
-	0x92, 0xdc, 0xf3, 0x06, 0x23, 0xf1, 0xe8, 0x3d, 0x6f, 0x30, 0x1a, 0x8f, 0x65, 0x7f, 0x27, 0x42,
-	0x6c, 0xf1, 0x3c, 0xa1, 0xdb, 0xe0, 0xb7, 0x88, 0xae, 0x12, 0x93, 0x95, 0x97, 0x48, 0xf1, 0x0a,
-	0xaf, 0x01, 0x81, 0x82, 0xaa, 0x9a, 0xc4, 0xb2, 0x0f, 0x2c, 0x23, 0xa3, 0x01, 0x3c, 0xa7, 0x12,
-	0x8b, 0x6a, 0x3a, 0xa6, 0x9a, 0xa1, 0x2b, 0x4e, 0x49, 0x12, 0x9f, 0x5e, 0x49, 0x8a, 0xbb, 0xb4,
-	0xb3, 0xaf, 0x28, 0x0f, 0xcf, 0xbb, 0x2d, 0x62, 0xc7, 0x21, 0x56, 0x80, 0x42, 0x32, 0x72, 0x91,
-	0xb8, 0xab, 0xe8, 0x1a, 0xf8, 0xad, 0x51, 0xbf, 0x65, 0xf4, 0x58, 0x35, 0x0a, 0xc9, 0x7c, 0x85,
-	0xde, 0x03, 0x3f, 0xee, 0x1b, 0x07, 0xbc, 0xce, 0x44, 0x8a, 0x29, 0x1e, 0xe3, 0x35, 0xa7, 0x29,
-	0x58, 0xea, 0x7e, 0x4e, 0x33, 0xf2, 0x7d, 0x4c, 0xbb, 0xb9, 0xfb, 0x9a, 0x4e, 0x65, 0xce, 0xfd,
-	0x81, 0x98, 0x10, 0xb2, 0x63, 0x11, 0x9e, 0x3b, 0x73, 0xba, 0xbf, 0xcd, 0x59, 0x7b, 0x0d, 0xe2,
-	0xb3, 0x0a, 0xb6, 0x98, 0xb2, 0x2b, 0xd3, 0xef, 0xd3, 0x7c, 0xe5, 0x21, 0x32, 0xc0, 0xa3, 0x9e,
-	0x81, 0x55, 0xa5, 0x8b, 0xad, 0x2e, 0xcb, 0x5a, 0xa4, 0x18, 0x71, 0x77, 0x01, 0x39, 0xcc, 0x39,
-	0xec, 0x45, 0xf6, 0xb1, 0x08, 0xc9, 0xd5, 0xa5, 0xee, 0xff, 0x34, 0x2b, 0x2e, 0xd8, 0xf9, 0x56,
-	0xc0, 0xce, 0xff, 0x24, 0xb0, 0xcb, 0xf6, 0x20, 0xba, 0x50, 0xf4, 0x51, 0x1a, 0x44, 0x6a, 0xac,
-	0xca, 0xa9, 0x48, 0x0d, 0x97, 0x25, 0xf1, 0x89, 0x01, 0xde, 0x02, 0x74, 0xb6, 0x2f, 0xb8, 0x62,
-	0x12, 0x16, 0x62, 0x7a, 0x07, 0x9c, 0x7e, 0x31, 0x4b, 0xa2, 0xb8, 0xdc, 0xab, 0x08, 0xe3, 0xe2,
-	0xab, 0xec, 0xcf, 0x44, 0x78, 0xe9, 0xc2, 0xd6, 0x80, 0x72, 0x00, 0x03, 0x93, 0xf0, 0xa6, 0x93,
-	0x10, 0x32, 0x6b, 0xcb, 0x14, 0x87, 0x06, 0x26, 0x71, 0xa4, 0x51, 0x05, 0x62, 0x03, 0x93, 0x1c,
-	0x2a, 0xb4, 0x6b, 0x12, 0xab, 0x6b, 0xf4, 0xd4, 0x4b, 0x46, 0x1f, 0xb5, 0xa5, 0x9a, 0x53, 0x21,
-	0xdb, 0xac, 0x4e, 0x8e, 0xa6, 0x66, 0xd7, 0x56, 0x98, 0xd5, 0xc9, 0x11, 0x37, 0x5b, 0x82, 0xa8,
-	0xcd, 0x3f, 0xb7, 0xea, 0xbd, 0x94, 0xd5, 0x88, 0x4e, 0x8e, 0x66, 0x46, 0x59, 0xe6, 0xff, 0x23,
-	0xc0, 0x2b, 0x97, 0xe9, 0x54, 0x76, 0xd2, 0x99, 0x87, 0x53, 0xf2, 0x2a, 0x27, 0x6d, 0x13, 0x33,
-	0x1d, 0x4f, 0xc5, 0x4f, 0xf4, 0x21, 0x84, 0x6d, 0x25, 0x47, 0x44, 0xeb, 0x74, 0xa9, 0x95, 0xf0,
-	0x31, 0xc3, 0x17, 0xa9, 0xb0, 0xf3, 0xf9, 0xc0, 0x91, 0xb8, 0xe7, 0x0d, 0x0a, 0x71, 0xf1, 0x9e,
-	0x37, 0x28, 0xc6, 0xd7, 0xb2, 0x75, 0x08, 0x57, 0x9d, 0x83, 0x2c, 0xe9, 0x8f, 0x0c, 0x84, 0xc0,
-	0xab, 0xe3, 0x3e, 0xe1, 0x08, 0x63, 0xbf, 0xd1, 0x1b, 0x20, 0xce, 0xae, 0xa3, 0x37, 0xb9, 0x99,
-	0xab, 0x67, 0xcd, 0x48, 0x3a, 0x95, 0x45, 0x4d, 0xcd, 0xfe, 0x51, 0x04, 0x28, 0x1e, 0x98, 0x3a,
-	0x31, 0x99, 0xc6, 0xf7, 0x20, 0xd6, 0x62, 0xab, 0x19, 0x3c, 0x57, 0x1c, 0x9a, 0xa8, 0xc3, 0x36,
-	0x3d, 0xf2, 0xdf, 0x08, 0xd5, 0xcb, 0xab, 0xd8, 0xda, 0xb3, 0xac, 0x62, 0xab, 0x1a, 0xdc, 0x3a,
-	0xf8, 0xb0, 0x65, 0x11, 0xca, 0x0b, 0x90, 0xb3, 0x40, 0x19, 0xf0, 0x5a, 0xb8, 0x37, 0xad, 0x3e,
-	0x8b, 0x05, 0x8c, 0x51, 0xb2, 0x7f, 0x15, 0x21, 0x52, 0x91, 0x4b, 0x9b, 0x6f, 0x96, 0xc9, 0xc0,
-	0xb0, 0x34, 0x3a, 0x7f, 0x10, 0x08, 0x17, 0x3e, 0x08, 0xbe, 0x61, 0xcd, 0x99, 0xfb, 0xba, 0xe6,
-	0xf6, 0x75, 0x69, 0x2e, 0xbd, 0xcf, 0x32, 0x97, 0x67, 0xb1, 0xe2, 0xbb, 0x14, 0x56, 0x6e, 0x40,
-	0xa8, 0x67, 0x74, 0x14, 0xe7, 0x31, 0xe4, 0x67, 0x8f, 0xa1, 0x60, 0xcf, 0xe8, 0x48, 0xf6, 0x3a,
-	0x3b, 0x5e, 0x03, 0xc4, 0x12, 0xca, 0xaa, 0xe9, 0x1e, 0xa1, 0x58, 0xc5, 0x14, 0xcf, 0x63, 0x16,
-	0xdc, 0x31, 0x97, 0x20, 0xc8, 0xe2, 0x9c, 0x3f, 0xc0, 0x36, 0xce, 0x45, 0xfc, 0xc9, 0x24, 0x1d,
-	0x60, 0xbe, 0x4b, 0x65, 0x39, 0xc0, 0x24, 0x25, 0x15, 0x7d, 0x04, 0x01, 0x95, 0x50, 0xac, 0xf5,
-	0x9c, 0x7e, 0x16, 0x5e, 0xfe, 0x04, 0xe3, 0xc5, 0x9d, 0xf1, 0xf1, 0x87, 0xe6, 0x54, 0xec, 0x2c,
-	0xf8, 0x9d, 0xb4, 0x5f, 0x00, 0xfe, 0x5b, 0x10, 0xa0, 0x43, 0xa7, 0x41, 0x32, 0xd0, 0x9d, 0xc2,
-	0x97, 0x9f, 0x0e, 0x59, 0x6f, 0xdc, 0x9c, 0x3d, 0x10, 0x03, 0xec, 0x81, 0x98, 0x5c, 0xe6, 0xdd,
-	0xa9, 0xa7, 0x61, 0x1a, 0xc2, 0x9a, 0xa5, 0x90, 0x21, 0x25, 0xa6, 0x8e, 0x7b, 0xec, 0x6d, 0x14,
-	0x94, 0x41, 0xb3, 0x2a, 0xfc, 0x8b, 0xcd, 0xc0, 0xb7, 0xae, 0x6d, 0xa8, 0x84, 0xbd, 0x78, 0x22,
-	0x32, 0x38, 0x9f, 0x4a, 0x86, 0x4a, 0xee, 0x79, 0x83, 0xfe, 0x78, 0x20, 0x5b, 0x87, 0xe7, 0x59,
-	0x15, 0xc5, 0x6d, 0x7b, 0xd7, 0x67, 0x9b, 0x91, 0x01, 0xbf, 0x89, 0x8f, 0x14, 0x3a, 0xe4, 0x20,
-	0x0f, 0x9d, 0x4c, 0xd2, 0x3e, 0x19, 0x1f, 0x35, 0x3f, 0x91, 0x7d, 0x26, 0x3e, 0x6a, 0x0e, 0xd1,
-	0x0b, 0x10, 0x18, 0x1c, 0xb4, 0x94, 0x7d, 0x32, 0x72, 0xf6, 0x45, 0xf6, 0x0f, 0x0e, 0x5a, 0x3b,
-	0x64, 0x94, 0xfd, 0x42, 0x84, 0x40, 0xc9, 0xe8, 0xf7, 0xb1, 0xae, 0xa2, 0xdb, 0xac, 0x52, 0x39,
-	0x2a, 0x5e, 0xe0, 0xb1, 0x87, 0x38, 0x51, 0x2a, 0x9f, 0x4c, 0xd2, 0xa2, 0x54, 0xb6, 0x6b, 0x14,
-	0x7a, 0x11, 0x02, 0x6d, 0xe7, 0x33, 0xbf, 0xe2, 0x88, 0x09, 0x41, 0x9e, 0x7e, 0xb2, 0x8f, 0xf4,
-	0x00, 0x9b, 0xb8, 0xef, 0x6c, 0x9f, 0x6d, 0x8a, 0xad, 0x50, 0x0b, 0xfc, 0xfb, 0x64, 0x64, 0x43,
-	0xc3, 0xd9, 0x8e, 0x1d, 0xdb, 0xcb, 0x1d, 0x32, 0x92, 0xca, 0x5f, 0x4f, 0xd2, 0x3f, 0xbc, 0xe4,
-	0x71, 0x98, 0xbe, 0x6f, 0xe6, 0x27, 0x82, 0x69, 0x90, 0x7d, 0xfb, 0x64, 0x24, 0xa9, 0x28, 0x03,
-	0x91, 0x3e, 0x1e, 0x2a, 0x1d, 0x6c, 0x29, 0x6d, 0xc3, 0x72, 0xaa, 0x47, 0x54, 0x86, 0x3e, 0x1e,
-	0x6e, 0x61, 0xab, 0x64, 0x58, 0x14, 0xbd, 0x0d, 0x5e, 0x3a, 0x1a, 0x10, 0x86, 0xf3, 0xd8, 0x66,
-	0x7a, 0xd9, 0xe6, 0xf1, 0x90, 0x9b, 0xa3, 0x01, 0x91, 0x19, 0x73, 0xf6, 0x33, 0x2f, 0xac, 0xf3,
-	0xaf, 0x45, 0x4c, 0xdb, 0xdd, 0x59, 0xe6, 0xaf, 0xb9, 0x52, 0xe6, 0x77, 0x65, 0xe8, 0x23, 0x08,
-	0xf3, 0x74, 0x28, 0x9a, 0xea, 0x4c, 0x4a, 0x22, 0xc5, 0xf4, 0xb2, 0x9c, 0xc2, 0x6c, 0x61, 0xc9,
-	0xc0, 0x65, 0x24, 0xd5, 0xb2, 0x5b, 0x89, 0x6d, 0x81, 0xe7, 0x90, 0xfd, 0x46, 0xb7, 0x21, 0x68,
-	0xbf, 0x1f, 0x57, 0xde, 0xe1, 0x02, 0x96, 0xd6, 0x61, 0x18, 0x2d, 0xcc, 0x30, 0xea, 0x63, 0x61,
-	0xbe, 0xb6, 0x2c, 0x4c, 0x16, 0x09, 0x51, 0xb9, 0x7d, 0xeb, 0x14, 0x64, 0xe7, 0xbb, 0xe5, 0x7f,
-	0x66, 0xbb, 0x25, 0x43, 0x82, 0x5d, 0x77, 0x5a, 0x8e, 0x27, 0x0a, 0x0f, 0xdf, 0xb2, 0xad, 0x06,
-	0x58, 0x7c, 0xd7, 0x4f, 0x26, 0xe9, 0xab, 0x75, 0x93, 0x1c, 0x9e, 0x72, 0x56, 0x2a, 0xcb, 0x57,
-	0x07, 0x4b, 0x3e, 0xab, 0xe8, 0x47, 0x10, 0xb2, 0xb4, 0x8e, 0x8e, 0xe9, 0x81, 0x49, 0xf8, 0x10,
-	0x62, 0x3d, 0xe7, 0x0c, 0xda, 0x72, 0xd3, 0x41, 0x5b, 0xae, 0xa0, 0x8f, 0x8a, 0x77, 0xfe, 0xf2,
-	0xfb, 0x37, 0x5e, 0x75, 0xc5, 0xe1, 0x14, 0xa8, 0xbc, 0x7d, 0xfe, 0xda, 0xf9, 0xba, 0xcd, 0xb9,
-	0x87, 0x4d, 0xab, 0x8b, 0x7b, 0xc4, 0x94, 0xe7, 0x2a, 0xb3, 0x7f, 0x17, 0x20, 0xdc, 0xd0, 0x3a,
-	0x33, 0x04, 0xe4, 0x39, 0x9e, 0x04, 0x96, 0xe8, 0x1b, 0x4b, 0x8b, 0x81, 0xd6, 0x99, 0x63, 0x69,
-	0x3e, 0xfc, 0x12, 0x9f, 0xfa, 0xf0, 0xeb, 0xfb, 0xf6, 0x93, 0xc0, 0x41, 0x1d, 0x4b, 0xa9, 0x9d,
-	0x47, 0x86, 0x9f, 0x22, 0x3a, 0x99, 0xa4, 0x63, 0x6e, 0x04, 0x4b, 0x65, 0x39, 0xd6, 0x76, 0xaf,
-	0xd5, 0xec, 0x6f, 0x05, 0x08, 0x4f, 0xef, 0x68, 0x3b, 0x64, 0xf4, 0x24, 0x9d, 0xd3, 0xb0, 0xef,
-	0x54, 0x43, 0xaa, 0x70, 0xc4, 0x38, 0x37, 0x86, 0xda, 0xc9, 0x24, 0x1d, 0xaa, 0x92, 0x21, 0x7d,
-	0x5a, 0xa8, 0x09, 0xe9, 0x5c, 0x99, 0xca, 0x6f, 0x5f, 0x87, 0xe0, 0x2b, 0xb0, 0xbe, 0xf3, 0x0c,
-	0x07, 0x8a, 0xd3, 0x2b, 0x9d, 0x38, 0xbf, 0xd2, 0x65, 0x7f, 0x25, 0x40, 0xc4, 0xdd, 0x7f, 0xd0,
-	0xcd, 0xe9, 0xe8, 0xcf, 0x75, 0xfb, 0x73, 0x06, 0x7a, 0xb6, 0x2a, 0xd7, 0x25, 0x47, 0x5c, 0xb8,
-	0xe4, 0xdc, 0x82, 0xa0, 0x4a, 0xda, 0x5a, 0x1f, 0xf3, 0x56, 0x17, 0x2d, 0x86, 0xbe, 0x9e, 0xa4,
-	0x7d, 0x07, 0x9a, 0x4e, 0xdf, 0x97, 0x67, 0x24, 0xf4, 0x3d, 0x08, 0xb6, 0xf1, 0x00, 0xb7, 0x35,
-	0x3a, 0xe2, 0xc7, 0xfe, 0x82, 0x7b, 0xe4, 0x8c, 0x3d, 0xfb, 0x01, 0x04, 0xb6, 0x30, 0x25, 0x47,
-	0xd8, 0xde, 0xce, 0xc0, 0x05, 0x57, 0xc8, 0x29, 0x9d, 0x67, 0xf7, 0xe7, 0x02, 0x44, 0xea, 0x46,
-	0xaf, 0x37, 0x83, 0xfa, 0xb7, 0x62, 0x6c, 0x7b, 0xe7, 0x37, 0xf3, 0x09, 0xd6, 0xed, 0x15, 0x13,
-	0x2c, 0x36, 0x44, 0x72, 0x0f, 0xaf, 0xe6, 0x8c, 0x52, 0x55, 0x6a, 0x4a, 0x85, 0x5d, 0xe9, 0x53,
-	0x36, 0xbe, 0x62, 0x8c, 0x92, 0xae, 0x51, 0x0d, 0xf7, 0xb4, 0x1f, 0x13, 0x15, 0xa5, 0x21, 0xc6,
-	0x19, 0xeb, 0x95, 0x6a, 0x59, 0xaa, 0x6e, 0xc5, 0xc5, 0x64, 0x78, 0x7c, 0x9c, 0x09, 0xd4, 0x89,
-	0xae, 0x6a, 0x7a, 0x07, 0xbd, 0xbc, 0x64, 0x0c, 0xe6, 0x4d, 0x46, 0xc7, 0xc7, 0x99, 0xd0, 0x6c,
-	0x02, 0x36, 0x9f, 0x59, 0xdd, 0xf9, 0x5c, 0x84, 0xb0, 0xab, 0x9d, 0xa0, 0x17, 0x21, 0x51, 0xaa,
-	0xed, 0xed, 0x15, 0xaa, 0x65, 0xa5, 0xf9, 0xb0, 0x5e, 0x59, 0xf4, 0x1b, 0xdd, 0x80, 0x17, 0x16,
-	0xa8, 0x7b, 0x52, 0xb5, 0xa9, 0x34, 0x6b, 0x3b, 0x95, 0x6a, 0x5c, 0x40, 0x37, 0xe1, 0xfa, 0x02,
-	0xb1, 0x5c, 0xa9, 0xef, 0xd6, 0x1e, 0x72, 0xb2, 0x78, 0x46, 0xb6, 0x78, 0x5f, 0xae, 0x72, 0xe2,
-	0x1a, 0x7a, 0x15, 0xb2, 0x0b, 0xc4, 0xa6, 0x5c, 0xa8, 0x36, 0xee, 0x56, 0x64, 0xa5, 0x56, 0xaf,
-	0xc8, 0x85, 0x66, 0x4d, 0x6e, 0x6c, 0x4b, 0xf5, 0xb8, 0x17, 0xbd, 0x09, 0xaf, 0x2f, 0xf0, 0x15,
-	0xea, 0x75, 0xb9, 0xf6, 0x71, 0xc5, 0x8e, 0xb5, 0x29, 0x17, 0x4a, 0x4d, 0xa5, 0x54, 0xd8, 0xdd,
-	0x55, 0x1e, 0x48, 0xcd, 0x6d, 0xe6, 0x5b, 0xdc, 0x77, 0x46, 0xf3, 0x52, 0x89, 0xb8, 0x7f, 0x96,
-	0x12, 0xcf, 0x9d, 0xff, 0x0a, 0x70, 0x75, 0x69, 0xeb, 0x41, 0x3f, 0x80, 0x97, 0x8b, 0x85, 0x66,
-	0x69, 0xbb, 0x52, 0x56, 0xb8, 0xce, 0x86, 0xb2, 0x7a, 0x42, 0xc9, 0x74, 0xb8, 0x37, 0xf9, 0x5d,
-	0x48, 0xaf, 0x12, 0x6f, 0x48, 0x5b, 0x55, 0x7b, 0x33, 0x85, 0x64, 0x7c, 0x7c, 0x9c, 0x89, 0x30,
-	0xd1, 0x86, 0xd6, 0xd1, 0xed, 0x1d, 0x3d, 0x47, 0xac, 0x50, 0xac, 0xc9, 0xce, 0xf4, 0x72, 0x2e,
-	0x56, 0x68, 0x31, 0x08, 0xa3, 0xb7, 0x21, 0x75, 0x9e, 0xb5, 0xf9, 0x30, 0x73, 0x66, 0x8c, 0xa8,
-	0x49, 0xaf, 0x9d, 0x85, 0x3b, 0x23, 0x08, 0xf0, 0x96, 0x80, 0xb2, 0xb0, 0xde, 0x90, 0xb6, 0x96,
-	0x60, 0x21, 0x19, 0x1c, 0x1f, 0x67, 0xbc, 0x55, 0x43, 0x27, 0x28, 0x09, 0xe1, 0x19, 0x4f, 0xf3,
-	0x93, 0xb8, 0x90, 0x0c, 0x8d, 0x8f, 0x33, 0x3e, 0x5b, 0xc3, 0x10, 0xbd, 0x02, 0xf1, 0x19, 0x8d,
-	0xbb, 0x11, 0x17, 0x93, 0xb1, 0xf1, 0x71, 0x06, 0x1a, 0x5a, 0x87, 0xe7, 0xd7, 0x85, 0xc7, 0x3f,
-	0x0b, 0x70, 0xc5, 0x55, 0xe3, 0x99, 0x0f, 0x05, 0xb8, 0x39, 0xc3, 0xc3, 0x4e, 0xe5, 0xe1, 0x32,
-	0x67, 0x52, 0xe3, 0xe3, 0x4c, 0xf2, 0xbe, 0x6e, 0x0d, 0x48, 0x5b, 0x7b, 0xa4, 0x11, 0xf5, 0xb4,
-	0x8a, 0x1c, 0xdc, 0x38, 0xab, 0xa2, 0xf6, 0xa0, 0x5a, 0x71, 0x80, 0x25, 0x38, 0x07, 0x64, 0x36,
-	0x22, 0x41, 0xef, 0x40, 0x6a, 0x09, 0xbf, 0x1b, 0x8b, 0x3c, 0xe5, 0xee, 0xf9, 0x41, 0xd2, 0x6f,
-	0x87, 0x91, 0x10, 0xee, 0xfc, 0x49, 0x80, 0x28, 0x7f, 0xde, 0x71, 0xe4, 0x6c, 0x40, 0xb2, 0x5c,
-	0xa9, 0xd7, 0x1a, 0x52, 0x73, 0x39, 0x60, 0xe6, 0xc9, 0xbc, 0x0d, 0xd7, 0x4e, 0x71, 0x4e, 0x0f,
-	0xba, 0xb0, 0x78, 0xd0, 0xbf, 0x03, 0x89, 0x53, 0x8c, 0xf3, 0x03, 0x2f, 0x9e, 0x3a, 0xf0, 0xe8,
-	0x16, 0x5c, 0x3d, 0xc5, 0x6c, 0x1f, 0x3f, 0x86, 0x01, 0x18, 0x1f, 0x67, 0xfc, 0xec, 0x41, 0xef,
-	0xec, 0x83, 0xc0, 0x66, 0xd9, 0xd5, 0x2f, 0xff, 0x9d, 0xf2, 0x7c, 0x79, 0x92, 0x12, 0xbe, 0x3a,
-	0x49, 0x09, 0xff, 0x3a, 0x49, 0x09, 0x9f, 0x3f, 0x4e, 0x79, 0xbe, 0x7a, 0x9c, 0xf2, 0xfc, 0xe3,
-	0x71, 0xca, 0xf3, 0xe9, 0x9b, 0x97, 0xac, 0xaa, 0xe4, 0xb0, 0xef, 0xfc, 0xc9, 0xd8, 0xf2, 0xb3,
-	0xab, 0xcd, 0xdb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x04, 0xe0, 0x0e, 0xb5, 0x87, 0x1c, 0x00,
-	0x00,
+	// 2741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x39, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xdc, 0xe5, 0xfb, 0xe3, 0xc3, 0x9b, 0x89, 0xec, 0xd0, 0x74, 0x4c, 0x32, 0x4c, 0x6c, 0x2b,
+	0xfe, 0x25, 0x64, 0xe2, 0x3c, 0x7e, 0x69, 0x91, 0x34, 0xe1, 0xcb, 0xd6, 0xda, 0x12, 0x45, 0x2c,
+	0x29, 0x3b, 0xce, 0xa1, 0x8b, 0x11, 0x77, 0x4c, 0x2e, 0x4c, 0xee, 0x12, 0xbb, 0x23, 0x89, 0xec,
+	0xad, 0x97, 0xa2, 0xd0, 0x29, 0x45, 0xcf, 0x02, 0x0a, 0xb4, 0x40, 0x8b, 0x5e, 0xda, 0x43, 0x0f,
+	0x39, 0xe4, 0x10, 0xf4, 0x14, 0x14, 0x45, 0x11, 0xf4, 0xd2, 0xa2, 0x07, 0xa1, 0x55, 0x0e, 0xbd,
+	0xf5, 0x0f, 0xc8, 0xa5, 0xc5, 0xce, 0x0e, 0x97, 0x4b, 0x89, 0x94, 0x94, 0xd4, 0x06, 0x02, 0xf4,
+	0x44, 0xce, 0xcc, 0x37, 0xdf, 0xfb, 0x35, 0xdf, 0x42, 0x0e, 0x8f, 0xc9, 0x00, 0x5b, 0x65, 0xb2,
+	0x3b, 0x2c, 0xef, 0xbe, 0xbe, 0x4d, 0x28, 0x7e, 0xbd, 0x4c, 0x27, 0x23, 0x62, 0x97, 0x46, 0x96,
+	0x49, 0x4d, 0x84, 0xdc, 0xf3, 0x12, 0xd9, 0x1d, 0x96, 0xf8, 0x79, 0xf6, 0x72, 0xcf, 0x34, 0x7b,
+	0x03, 0x52, 0x66, 0x10, 0xdb, 0x3b, 0x8f, 0xca, 0xd8, 0x98, 0xb8, 0xe0, 0xd9, 0x95, 0x9e, 0xd9,
+	0x33, 0xd9, 0xdf, 0xb2, 0xf3, 0x8f, 0xef, 0x5e, 0xee, 0x9a, 0xf6, 0xd0, 0xb4, 0x55, 0xf7, 0xc0,
+	0x5d, 0xb8, 0x47, 0xc5, 0x9f, 0x09, 0x00, 0xf7, 0x4d, 0x4a, 0x1a, 0xbb, 0xc4, 0xa0, 0x36, 0x7a,
+	0x08, 0xe1, 0x6e, 0x1f, 0xeb, 0x46, 0x46, 0x28, 0x08, 0xab, 0xf1, 0x6a, 0xed, 0xab, 0xc3, 0xfc,
+	0xfb, 0x3d, 0x9d, 0xf6, 0x77, 0xb6, 0x4b, 0x5d, 0x73, 0x58, 0x76, 0x99, 0x31, 0x08, 0xdd, 0x33,
+	0xad, 0xc7, 0x7c, 0xf5, 0x6a, 0xd7, 0xb4, 0x48, 0x79, 0x5c, 0x36, 0xc8, 0x78, 0xc7, 0x2e, 0x93,
+	0xf1, 0xc8, 0xb4, 0x28, 0xd1, 0x4a, 0x35, 0x07, 0x4d, 0x13, 0x0f, 0x89, 0xe2, 0x62, 0x44, 0xff,
+	0x0f, 0x11, 0xc2, 0x88, 0x64, 0xc4, 0x42, 0x70, 0x35, 0x71, 0xeb, 0x72, 0xe9, 0xa4, 0x68, 0x25,
+	0xc6, 0x46, 0x35, 0xf4, 0xf9, 0x61, 0x3e, 0xa0, 0x70, 0xf0, 0xe2, 0x3f, 0xe3, 0x10, 0x66, 0xfb,
+	0x4f, 0x93, 0xbb, 0x97, 0x21, 0x4c, 0xc7, 0xaa, 0xae, 0x65, 0xc4, 0x82, 0xb0, 0x9a, 0xac, 0xae,
+	0x38, 0x1c, 0xfc, 0xed, 0x30, 0x1f, 0x5a, 0xc3, 0x76, 0xff, 0xe8, 0x30, 0x1f, 0xea, 0x8c, 0xe5,
+	0xba, 0x12, 0xa2, 0x63, 0x59, 0x43, 0x2b, 0x10, 0xd6, 0x0d, 0x8d, 0x8c, 0x33, 0xc1, 0x82, 0xb0,
+	0x1a, 0x52, 0xdc, 0x05, 0x7a, 0x07, 0x22, 0x36, 0xc5, 0x74, 0xc7, 0xce, 0x84, 0x0a, 0xc2, 0x6a,
+	0xfa, 0x56, 0x61, 0xa9, 0x78, 0xa5, 0x36, 0x83, 0x53, 0x38, 0x3c, 0x7a, 0x17, 0x24, 0x7b, 0x77,
+	0xa8, 0xd2, 0xb1, 0x6a, 0xeb, 0x3d, 0x03, 0xd3, 0x1d, 0x8b, 0x64, 0x2e, 0x30, 0x01, 0xd1, 0xd1,
+	0x61, 0x3e, 0xdd, 0xbe, 0xbf, 0xd1, 0x19, 0xb7, 0xa7, 0x27, 0x4a, 0xda, 0xde, 0x1d, 0xfa, 0xd6,
+	0xe8, 0x0e, 0x00, 0x35, 0x1f, 0x13, 0x43, 0xb5, 0x89, 0x41, 0x33, 0xe1, 0x82, 0xb0, 0x9a, 0xb8,
+	0x55, 0x5c, 0x4a, 0xbb, 0xe3, 0x80, 0xb6, 0x1d, 0x1d, 0x8b, 0x19, 0x61, 0x2d, 0xa0, 0xc4, 0xe9,
+	0x74, 0x03, 0xad, 0x43, 0xaa, 0x6b, 0x1a, 0xd4, 0xc2, 0x5d, 0xaa, 0x76, 0xf1, 0x60, 0x90, 0x89,
+	0x30, 0x5c, 0xd7, 0x96, 0xe2, 0xaa, 0x71, 0xe8, 0x1a, 0x1e, 0x0c, 0xd6, 0x02, 0x4a, 0xb2, 0xeb,
+	0x5b, 0x23, 0x1d, 0x32, 0x73, 0xd8, 0xd4, 0x3d, 0x9d, 0xf6, 0x55, 0x46, 0x2d, 0x13, 0x65, 0x88,
+	0x4b, 0xe7, 0x42, 0xfc, 0x40, 0xa7, 0x7d, 0xc6, 0xf4, 0x5a, 0x40, 0xb9, 0xd8, 0x5d, 0x74, 0x80,
+	0x6a, 0x10, 0xa3, 0x16, 0x36, 0xec, 0x47, 0xc4, 0xca, 0xc4, 0x18, 0xea, 0x17, 0x96, 0xcb, 0xcf,
+	0x01, 0xb9, 0xf8, 0xde, 0x45, 0xb4, 0x09, 0x69, 0x57, 0x8d, 0x1a, 0x19, 0x0d, 0xcc, 0x09, 0xd1,
+	0x32, 0x71, 0x86, 0xea, 0xfa, 0xe9, 0xaa, 0xac, 0x73, 0xe8, 0xb5, 0x80, 0x92, 0xa2, 0xfe, 0x0d,
+	0xf4, 0x43, 0x01, 0x72, 0xc3, 0x9d, 0x01, 0xd5, 0x6d, 0xbd, 0xa7, 0x9a, 0x7b, 0x06, 0xb1, 0xec,
+	0xbe, 0x3e, 0x52, 0xa7, 0x04, 0x2d, 0xa2, 0x65, 0x80, 0x51, 0x78, 0x6b, 0x29, 0x85, 0x0d, 0x7e,
+	0x7d, 0x73, 0x7a, 0xbb, 0x33, 0xbb, 0xcc, 0x05, 0x78, 0x7e, 0x78, 0x0a, 0x0c, 0xfa, 0x91, 0x00,
+	0x2f, 0xcc, 0x78, 0x18, 0x11, 0x0b, 0x53, 0xf3, 0x24, 0x1b, 0x09, 0xc6, 0xc6, 0x3b, 0x67, 0xb3,
+	0xe1, 0x43, 0xe0, 0xa3, 0xb2, 0x16, 0x50, 0xf2, 0xc3, 0xd3, 0x41, 0xd0, 0x16, 0x48, 0x5d, 0x73,
+	0x38, 0xc4, 0x86, 0xa6, 0x92, 0x31, 0xe9, 0xee, 0x50, 0xa2, 0x65, 0xd2, 0x8c, 0xec, 0xea, 0x29,
+	0x5e, 0xc0, 0x2e, 0x34, 0x38, 0xfc, 0x5a, 0x40, 0xb9, 0xd0, 0x9d, 0xdf, 0x2a, 0x7e, 0x22, 0x40,
+	0xc4, 0x0d, 0x26, 0xf4, 0x0a, 0xa0, 0x76, 0xa7, 0xd2, 0xd9, 0x6a, 0xab, 0x5b, 0xcd, 0x76, 0xab,
+	0x51, 0x93, 0x6f, 0xcb, 0x8d, 0xba, 0x14, 0xc8, 0xae, 0xec, 0x1f, 0x14, 0x24, 0x86, 0xaf, 0x69,
+	0x1a, 0x8d, 0xb1, 0x6e, 0x53, 0xc7, 0xd7, 0x57, 0x41, 0xe2, 0xd0, 0xb5, 0xcd, 0xe6, 0x6d, 0x59,
+	0xd9, 0x68, 0xd4, 0x25, 0x21, 0x8b, 0xf6, 0x0f, 0x0a, 0xe9, 0xa9, 0x07, 0x3e, 0xd2, 0xad, 0x21,
+	0xd1, 0xe6, 0x20, 0x37, 0x5a, 0xeb, 0x8d, 0x4e, 0xa3, 0x2e, 0x89, 0x73, 0x90, 0xc3, 0xd1, 0x80,
+	0x50, 0xa2, 0xa1, 0x22, 0xa4, 0x38, 0xe4, 0xed, 0x8a, 0xbc, 0xde, 0xa8, 0x4b, 0xc1, 0xec, 0x85,
+	0xfd, 0x83, 0x42, 0x82, 0x81, 0xdd, 0xc6, 0xfa, 0x80, 0x68, 0xd9, 0xd8, 0x8f, 0x7f, 0x9e, 0x0b,
+	0xfc, 0xea, 0x17, 0x39, 0xa1, 0x1a, 0x85, 0x30, 0x4b, 0x6f, 0x77, 0x43, 0xb1, 0xa4, 0x94, 0xba,
+	0x1b, 0x8a, 0xa5, 0xa4, 0x74, 0xf1, 0xb7, 0x22, 0xa4, 0xe7, 0xc3, 0x14, 0xdd, 0x80, 0x88, 0x4d,
+	0x0c, 0x8d, 0x58, 0x2c, 0xe7, 0x25, 0xab, 0x17, 0x78, 0x62, 0x8a, 0x56, 0x34, 0xcd, 0x22, 0xb6,
+	0xad, 0xf0, 0x63, 0x34, 0x82, 0x67, 0x34, 0x62, 0x53, 0xdd, 0xc0, 0x54, 0x37, 0x0d, 0xd5, 0xcd,
+	0x93, 0xe2, 0x93, 0xcb, 0x93, 0x92, 0x0f, 0x3b, 0xdb, 0x45, 0x65, 0x78, 0xd6, 0x4f, 0x11, 0xbb,
+	0x0c, 0xb1, 0xac, 0x18, 0x57, 0x90, 0xef, 0x88, 0xb3, 0x8a, 0x2e, 0x41, 0xc4, 0x9e, 0x0c, 0xb7,
+	0xcd, 0x01, 0x4b, 0x91, 0x71, 0x85, 0xaf, 0xd0, 0xdb, 0x10, 0xc1, 0x43, 0x73, 0x87, 0xa7, 0xaf,
+	0x64, 0x35, 0xc7, 0x65, 0xbc, 0xe4, 0x56, 0x2a, 0x5b, 0x7b, 0x5c, 0xd2, 0xcd, 0xf2, 0x10, 0xd3,
+	0x7e, 0x69, 0x4b, 0x37, 0xa8, 0xc2, 0xa1, 0xbf, 0x2b, 0x66, 0x84, 0xe2, 0x27, 0x22, 0x3c, 0x73,
+	0x22, 0x69, 0x7c, 0x9b, 0xb5, 0xf6, 0xb2, 0x13, 0x0a, 0x3c, 0x31, 0xce, 0xab, 0xec, 0xc2, 0x74,
+	0x7f, 0xaa, 0xaf, 0x32, 0x24, 0x47, 0x78, 0x32, 0x30, 0xb1, 0xa6, 0xf6, 0xb1, 0xdd, 0x67, 0x5a,
+	0x4b, 0x56, 0x93, 0xfe, 0xd2, 0xa4, 0x24, 0x38, 0x84, 0xb3, 0x40, 0xaf, 0x00, 0x38, 0x95, 0x84,
+	0x8b, 0x1e, 0x66, 0x62, 0xa4, 0x8e, 0x0e, 0xf3, 0xf1, 0xf6, 0xfd, 0x8d, 0x36, 0xdb, 0x54, 0xe2,
+	0xf6, 0xee, 0xd0, 0xfd, 0x5b, 0xfc, 0x49, 0x10, 0xb2, 0xcb, 0xf3, 0xed, 0xff, 0xaa, 0x0e, 0x67,
+	0x4e, 0x1a, 0x5e, 0xe2, 0xa4, 0x91, 0xaf, 0xe3, 0xa4, 0xc7, 0x6c, 0x12, 0x3d, 0xc3, 0x26, 0x03,
+	0x48, 0xcd, 0xd5, 0x29, 0x94, 0x07, 0x91, 0x9a, 0xcb, 0x2c, 0x20, 0x52, 0xd3, 0xc7, 0x97, 0xf8,
+	0xb5, 0x83, 0x67, 0x1b, 0xd0, 0xc9, 0x52, 0xe6, 0xd3, 0x80, 0x30, 0xa7, 0x81, 0x37, 0xc1, 0x2d,
+	0x71, 0x9e, 0xca, 0xc5, 0xc5, 0x5c, 0x25, 0x19, 0x14, 0x5f, 0x15, 0x7f, 0x2a, 0xc2, 0x0b, 0x67,
+	0x56, 0x33, 0x54, 0x02, 0x18, 0x59, 0x84, 0xd7, 0xc9, 0x8c, 0x50, 0x08, 0x2e, 0x42, 0x1c, 0x1f,
+	0x59, 0xc4, 0xbd, 0x8d, 0x1a, 0x90, 0x1e, 0x59, 0x64, 0x57, 0xa5, 0x7d, 0x8b, 0xd8, 0x7d, 0x73,
+	0xa0, 0x9d, 0x53, 0xfa, 0x94, 0x73, 0xab, 0x33, 0xbd, 0xe4, 0x90, 0x35, 0xc8, 0xde, 0x94, 0x6c,
+	0x70, 0x09, 0x59, 0x83, 0xec, 0x71, 0xb2, 0x35, 0x48, 0x39, 0xf0, 0x33, 0xaa, 0xa1, 0x73, 0x51,
+	0x4d, 0x1a, 0x64, 0xcf, 0x23, 0xca, 0x34, 0xbf, 0x05, 0x2b, 0x8b, 0x8a, 0x1c, 0x7a, 0x0f, 0x60,
+	0x5a, 0x28, 0x75, 0x2d, 0x23, 0xcc, 0x61, 0x8f, 0x73, 0x60, 0xb9, 0x7e, 0xe4, 0x5f, 0x28, 0x71,
+	0x7e, 0x43, 0xd6, 0x8a, 0xff, 0x12, 0xe0, 0xa5, 0xf3, 0xd4, 0x6c, 0xf4, 0xa6, 0x2b, 0x88, 0xd7,
+	0x13, 0x2c, 0x93, 0xdd, 0xe1, 0xdc, 0xc3, 0xf1, 0x44, 0xc4, 0x47, 0xef, 0x43, 0xc2, 0x41, 0xb2,
+	0x47, 0xf4, 0x5e, 0x9f, 0xda, 0x99, 0x70, 0x21, 0x78, 0x0e, 0x14, 0x8e, 0x99, 0x1e, 0xb8, 0x37,
+	0xee, 0x86, 0x62, 0x82, 0x24, 0xde, 0x0d, 0xc5, 0x44, 0x29, 0x58, 0x6c, 0x41, 0xa2, 0xe9, 0x66,
+	0x13, 0xd9, 0x78, 0x64, 0x22, 0x04, 0x21, 0x03, 0x0f, 0x09, 0x77, 0x5c, 0xf6, 0x1f, 0xbd, 0x0a,
+	0xa2, 0xd7, 0xd6, 0x5f, 0xe5, 0x64, 0x2e, 0x9e, 0x24, 0x23, 0x1b, 0x54, 0x11, 0x75, 0xad, 0xf8,
+	0xa9, 0x08, 0x50, 0xdd, 0xb1, 0x0c, 0x62, 0x31, 0x8c, 0x6f, 0x43, 0x7a, 0x9b, 0xad, 0x3c, 0xaf,
+	0x5f, 0x12, 0x8b, 0x29, 0x17, 0x8c, 0x2f, 0xbf, 0x59, 0xb0, 0x2c, 0x4e, 0xa5, 0xc1, 0xa7, 0x99,
+	0x4a, 0x97, 0xd5, 0xe4, 0x15, 0x08, 0x63, 0xdb, 0x26, 0x94, 0x67, 0x41, 0x77, 0x81, 0x0a, 0x10,
+	0xb2, 0xf1, 0x60, 0x9a, 0x02, 0xe7, 0xb3, 0x28, 0x3b, 0x29, 0xfe, 0x49, 0x84, 0x64, 0x43, 0xa9,
+	0xdd, 0x7a, 0xad, 0x4e, 0x46, 0xa6, 0xad, 0xd3, 0xd9, 0xc3, 0x4a, 0x38, 0xf3, 0x61, 0xf5, 0x0d,
+	0x53, 0xd9, 0x8c, 0xd7, 0xa0, 0x9f, 0xd7, 0x85, 0xba, 0x0c, 0x3d, 0x4d, 0x5d, 0x9e, 0xf4, 0x95,
+	0xf0, 0xb9, 0x7c, 0xe5, 0x0a, 0xc4, 0x07, 0x66, 0x4f, 0x75, 0x1f, 0x95, 0x11, 0xf6, 0xa8, 0x8c,
+	0x0d, 0xcc, 0x9e, 0xec, 0xac, 0x8b, 0xfb, 0x41, 0x40, 0x4c, 0xa1, 0x2c, 0x49, 0x6f, 0x10, 0x8a,
+	0x35, 0x4c, 0xf1, 0x4c, 0x66, 0xc1, 0x2f, 0x73, 0x0d, 0x62, 0x4c, 0xce, 0xd9, 0x43, 0x76, 0xf5,
+	0x54, 0x8f, 0x3f, 0x3a, 0xcc, 0x47, 0x19, 0xef, 0x72, 0x5d, 0x89, 0xb2, 0x9b, 0xb2, 0x86, 0x3e,
+	0x80, 0xa8, 0x46, 0x28, 0xd6, 0x07, 0x6e, 0x51, 0x4d, 0x2c, 0x7e, 0xca, 0xf2, 0x9a, 0xc1, 0xe0,
+	0xf8, 0x83, 0x7d, 0x7a, 0xed, 0xa4, 0xf3, 0xbb, 0x6a, 0x3f, 0xc3, 0xf9, 0xaf, 0x41, 0x94, 0x8e,
+	0xdd, 0x2a, 0xed, 0xb6, 0x2e, 0xf3, 0xfe, 0x15, 0xa1, 0x63, 0xe7, 0x17, 0xdd, 0xf2, 0x1e, 0xda,
+	0x51, 0xf6, 0xd0, 0xce, 0x2e, 0xe2, 0xee, 0xd8, 0x13, 0x3b, 0x0f, 0x09, 0xdd, 0x56, 0xc9, 0x98,
+	0x12, 0xcb, 0xc0, 0x03, 0xf6, 0x4a, 0x8c, 0x29, 0xa0, 0xdb, 0x0d, 0xbe, 0xe3, 0x00, 0x70, 0xd3,
+	0x75, 0x4d, 0x8d, 0xb0, 0xb7, 0x5f, 0x52, 0x01, 0x77, 0xab, 0x66, 0x6a, 0xe4, 0x6e, 0x28, 0x16,
+	0x91, 0xa2, 0xc5, 0x16, 0x3c, 0xcb, 0xb2, 0x28, 0xee, 0x3a, 0x56, 0xf7, 0x8c, 0x51, 0x80, 0x88,
+	0x85, 0xf7, 0x54, 0x3a, 0xe6, 0x4e, 0x1e, 0x3f, 0x3a, 0xcc, 0x87, 0x15, 0xbc, 0xd7, 0xf9, 0x50,
+	0x09, 0x5b, 0x78, 0xaf, 0x33, 0x46, 0xcf, 0x41, 0x74, 0xb4, 0xb3, 0xad, 0x3e, 0x26, 0x13, 0xd7,
+	0x2e, 0x4a, 0x64, 0xb4, 0xb3, 0x7d, 0x8f, 0x4c, 0x8a, 0x9f, 0x89, 0x10, 0xe5, 0xa9, 0x1c, 0xdd,
+	0x60, 0x99, 0xca, 0x45, 0xf1, 0xdc, 0xa2, 0xa4, 0x2f, 0xca, 0x75, 0x27, 0x47, 0xa1, 0xe7, 0x21,
+	0xca, 0x73, 0x3e, 0xef, 0xb3, 0xc4, 0x8c, 0xa0, 0x4c, 0xb7, 0x9c, 0x90, 0x1e, 0x61, 0x0b, 0x0f,
+	0x5d, 0xf3, 0x25, 0x15, 0xbe, 0x42, 0xdb, 0x10, 0x79, 0x4c, 0x26, 0x8e, 0x6b, 0xb8, 0xe6, 0xb8,
+	0xe7, 0x70, 0x79, 0x8f, 0x4c, 0xe4, 0xfa, 0x57, 0x87, 0xf9, 0xef, 0x9d, 0x33, 0x1c, 0xa6, 0x2f,
+	0xbd, 0x59, 0x44, 0x30, 0x0c, 0x4a, 0xf8, 0x31, 0x99, 0xc8, 0x1a, 0x2a, 0x40, 0x72, 0x88, 0xc7,
+	0x6a, 0x0f, 0xdb, 0x6a, 0xd7, 0xb4, 0xdd, 0xec, 0x91, 0x52, 0x60, 0x88, 0xc7, 0x77, 0xb0, 0x5d,
+	0x33, 0x6d, 0x8a, 0xde, 0x80, 0x90, 0x33, 0xdf, 0x62, 0x7e, 0x9e, 0xbe, 0x95, 0x5f, 0x64, 0x3c,
+	0x2e, 0x72, 0x67, 0x32, 0x22, 0x0a, 0x03, 0x76, 0x22, 0xc4, 0x41, 0x39, 0xd0, 0x87, 0x3a, 0x65,
+	0x66, 0x0f, 0x29, 0xb1, 0x1e, 0xb6, 0xd7, 0x9d, 0x75, 0xf1, 0x8f, 0x21, 0x58, 0xe1, 0x57, 0xaa,
+	0x98, 0x76, 0xfb, 0x9e, 0x59, 0x2e, 0xf9, 0xf4, 0x19, 0xf1, 0xa9, 0xef, 0x03, 0x48, 0xcc, 0x8a,
+	0xac, 0x3b, 0x8e, 0x4a, 0x56, 0xf3, 0x8b, 0x14, 0x0e, 0xde, 0xc2, 0x56, 0xc0, 0x2b, 0xb3, 0xb6,
+	0x53, 0x67, 0x1c, 0x0a, 0x5c, 0xc1, 0xec, 0x3f, 0xba, 0x01, 0x31, 0xe7, 0x99, 0xbd, 0xb4, 0xcb,
+	0x8c, 0xda, 0x7a, 0xcf, 0xf9, 0x83, 0x2a, 0x9e, 0x03, 0x87, 0x99, 0x0e, 0x5e, 0x5e, 0xa4, 0x03,
+	0x26, 0x09, 0xd1, 0x38, 0x7d, 0xfb, 0x98, 0x3f, 0xcf, 0x4c, 0x19, 0x79, 0x6a, 0xa6, 0x54, 0x20,
+	0xc3, 0x5a, 0xac, 0x6d, 0x97, 0x13, 0x95, 0x8b, 0x6f, 0x3b, 0x54, 0xa3, 0x4c, 0xbe, 0xcb, 0x47,
+	0x87, 0xf9, 0x8b, 0x2d, 0x8b, 0xec, 0x1e, 0x63, 0x56, 0xae, 0x2b, 0x17, 0x47, 0x0b, 0xb6, 0x35,
+	0xf4, 0x7d, 0x88, 0xcf, 0x66, 0x5c, 0xee, 0xac, 0x66, 0xa5, 0xe4, 0x4e, 0x33, 0x4b, 0xd3, 0x69,
+	0x66, 0xa9, 0x62, 0x4c, 0xaa, 0x37, 0xff, 0xf0, 0xbb, 0x57, 0xaf, 0xfb, 0xe4, 0x70, 0xb3, 0x57,
+	0xd9, 0x09, 0xce, 0x6e, 0xb9, 0xe5, 0x40, 0x6e, 0x60, 0xcb, 0xee, 0xe3, 0x01, 0x6b, 0x9f, 0xa7,
+	0x28, 0x51, 0x15, 0x90, 0xb3, 0xd0, 0x8d, 0x9e, 0x6a, 0x13, 0xdb, 0x76, 0xf2, 0xbe, 0xee, 0x4e,
+	0x72, 0x42, 0xd5, 0x95, 0xa3, 0xc3, 0xbc, 0xd4, 0x76, 0x4f, 0xdb, 0xee, 0xa1, 0x5c, 0x57, 0x24,
+	0x7b, 0x7e, 0x47, 0x2b, 0x7e, 0x2a, 0x80, 0x34, 0xd7, 0x96, 0xe9, 0xa6, 0xf1, 0x5f, 0xf6, 0x65,
+	0xe8, 0x3a, 0xc4, 0x98, 0x1a, 0x67, 0x79, 0x39, 0xe1, 0xa4, 0x5e, 0xa6, 0x20, 0x27, 0xf5, 0xb2,
+	0x43, 0x59, 0x9b, 0x15, 0xcb, 0xe0, 0x99, 0xc5, 0xf2, 0x12, 0x44, 0xfa, 0xac, 0x21, 0x62, 0xce,
+	0x16, 0x54, 0xf8, 0xaa, 0xf8, 0x17, 0x01, 0x12, 0x6d, 0xbd, 0xe7, 0x05, 0x41, 0x99, 0xc7, 0x9b,
+	0xc0, 0x7c, 0xed, 0xca, 0xc2, 0x64, 0xa9, 0xf7, 0x7c, 0xb1, 0xe6, 0x0d, 0x59, 0xc5, 0x27, 0x3e,
+	0x64, 0x7d, 0x77, 0x36, 0x06, 0xf2, 0xd4, 0xe1, 0x4a, 0xca, 0x26, 0x9d, 0xfe, 0x20, 0x96, 0xeb,
+	0x4a, 0xba, 0xeb, 0x5f, 0x6b, 0xc5, 0xdf, 0x08, 0x90, 0x98, 0xf6, 0xb0, 0xf7, 0xc8, 0xe4, 0xeb,
+	0x74, 0x16, 0xa6, 0xd3, 0x73, 0x8e, 0xa9, 0xca, 0x83, 0xc6, 0xed, 0xa8, 0x36, 0x1d, 0x73, 0x35,
+	0xc9, 0x98, 0x3e, 0xa9, 0xc0, 0x89, 0x1b, 0x1c, 0x99, 0xc6, 0xbb, 0xd3, 0x5d, 0x08, 0x57, 0x58,
+	0x5d, 0x7e, 0x8a, 0x83, 0xeb, 0x69, 0xcb, 0x2b, 0xce, 0x5a, 0xde, 0xe2, 0x2f, 0x05, 0x48, 0xfa,
+	0xeb, 0x33, 0xba, 0x3a, 0x1d, 0x12, 0xfb, 0xba, 0x63, 0x77, 0xf4, 0xeb, 0xa0, 0xf2, 0x35, 0x81,
+	0xe2, 0x5c, 0x13, 0x78, 0x0d, 0x62, 0x1a, 0xe9, 0xea, 0x43, 0xcc, 0x5b, 0x81, 0x54, 0x35, 0xfe,
+	0xd5, 0x61, 0x3e, 0xbc, 0xa3, 0x1b, 0xf4, 0x1d, 0xc5, 0x3b, 0x42, 0xdf, 0x81, 0x58, 0x17, 0x8f,
+	0x70, 0x57, 0xa7, 0x93, 0x4c, 0xe8, 0x3c, 0x7d, 0xb6, 0x07, 0x5e, 0xb4, 0x20, 0x7a, 0x07, 0x53,
+	0xb2, 0x87, 0x1d, 0x73, 0x46, 0xcf, 0x68, 0xb1, 0xa7, 0xe7, 0x4e, 0xa3, 0xe5, 0xbc, 0xa9, 0x47,
+	0x96, 0xd9, 0xb3, 0xf0, 0x70, 0x66, 0x51, 0xe9, 0xe8, 0x30, 0x9f, 0x6c, 0xdf, 0xdf, 0x68, 0xb9,
+	0x07, 0x72, 0x5d, 0x49, 0xda, 0xbb, 0xc3, 0xe9, 0x6a, 0x6a, 0x95, 0x3f, 0x0b, 0x90, 0x6c, 0x99,
+	0x83, 0x81, 0x17, 0x22, 0xdf, 0x8e, 0xcf, 0x0a, 0x8b, 0x3e, 0x03, 0x04, 0xcf, 0xfb, 0x19, 0xe0,
+	0xe6, 0xaf, 0x67, 0xa3, 0xd0, 0x1b, 0x4b, 0x46, 0xa1, 0x6c, 0x1a, 0xe9, 0x9f, 0x82, 0xce, 0x00,
+	0xe5, 0xa6, 0xdc, 0x91, 0x2b, 0xeb, 0xf2, 0x47, 0x6c, 0x0e, 0xca, 0x00, 0x65, 0x43, 0xa7, 0x3a,
+	0x1e, 0xe8, 0x3f, 0x20, 0x1a, 0xca, 0x43, 0x9a, 0x03, 0xb6, 0x1a, 0xcd, 0xba, 0xdc, 0xbc, 0x23,
+	0x89, 0xd9, 0xc4, 0xfe, 0x41, 0x21, 0xda, 0x22, 0x86, 0xa6, 0x1b, 0x3d, 0xf4, 0xe2, 0x82, 0x79,
+	0x6a, 0x28, 0x9b, 0xda, 0x3f, 0x28, 0xc4, 0xbd, 0x51, 0xea, 0x6c, 0xf8, 0x79, 0xf3, 0x63, 0x11,
+	0x12, 0xbe, 0x22, 0x8f, 0x9e, 0x87, 0x4c, 0x6d, 0x73, 0x63, 0xa3, 0xd2, 0xac, 0xab, 0x9d, 0x87,
+	0xad, 0xc6, 0x3c, 0xdf, 0xe8, 0x0a, 0x3c, 0x37, 0x77, 0xba, 0x21, 0x37, 0x3b, 0x6a, 0x67, 0xf3,
+	0x5e, 0xa3, 0x29, 0x09, 0xe8, 0x2a, 0x5c, 0x9e, 0x3b, 0xac, 0x37, 0x5a, 0xeb, 0x9b, 0x0f, 0xf9,
+	0xb1, 0x78, 0xe2, 0x6e, 0x75, 0x4b, 0x69, 0xf2, 0xc3, 0x20, 0xba, 0x0e, 0xc5, 0xb9, 0xc3, 0x8e,
+	0x52, 0x69, 0xb6, 0x6f, 0x37, 0x14, 0x75, 0xb3, 0xd5, 0x50, 0x2a, 0x9d, 0x4d, 0xa5, 0xbd, 0x26,
+	0xb7, 0xa4, 0x10, 0x7a, 0x0d, 0x5e, 0x99, 0x83, 0xab, 0xb4, 0x5a, 0xca, 0xe6, 0xfd, 0x86, 0x23,
+	0x6b, 0x47, 0xa9, 0xd4, 0x3a, 0x6a, 0xad, 0xb2, 0xbe, 0xae, 0x3e, 0x90, 0x3b, 0x6b, 0x8c, 0x37,
+	0x29, 0x7c, 0x02, 0xf3, 0xc2, 0x1b, 0x52, 0xc4, 0x53, 0x49, 0xe0, 0xe6, 0xbf, 0x05, 0xb8, 0xb8,
+	0xb0, 0xe6, 0xa3, 0xf7, 0xe0, 0xc5, 0x6a, 0xa5, 0x53, 0x5b, 0x6b, 0xd4, 0x55, 0x8e, 0xb3, 0xad,
+	0x2e, 0x1f, 0x75, 0x33, 0x1c, 0x7e, 0x23, 0xbf, 0x05, 0xf9, 0x65, 0xd7, 0xdb, 0xf2, 0x9d, 0xa6,
+	0x63, 0x4c, 0x21, 0x2b, 0xed, 0x1f, 0x14, 0x92, 0xec, 0x2a, 0x2f, 0x90, 0xa7, 0x5d, 0xab, 0x54,
+	0x37, 0x15, 0x77, 0x0c, 0x3e, 0xbb, 0x56, 0xd9, 0x66, 0x01, 0x80, 0xde, 0x80, 0xdc, 0x69, 0xd4,
+	0x66, 0x53, 0x71, 0x8f, 0x18, 0xd1, 0xb2, 0x21, 0x47, 0x0b, 0x37, 0x27, 0x10, 0xe5, 0x85, 0x08,
+	0x15, 0x61, 0xa5, 0x2d, 0xdf, 0x59, 0xe0, 0x0b, 0xd9, 0xd8, 0xfe, 0x41, 0x21, 0xd4, 0x34, 0x0d,
+	0x82, 0xb2, 0x90, 0xf0, 0x60, 0x3a, 0x1f, 0x4a, 0x42, 0x36, 0xbe, 0x7f, 0x50, 0x08, 0x3b, 0x18,
+	0xc6, 0xe8, 0x25, 0x90, 0xbc, 0x33, 0xce, 0x86, 0x24, 0x66, 0xd3, 0xfb, 0x07, 0x05, 0x68, 0xeb,
+	0x3d, 0xae, 0x5f, 0x9f, 0x3f, 0xfe, 0x5e, 0x80, 0x0b, 0xbe, 0xca, 0xc2, 0x78, 0xa8, 0xc0, 0x55,
+	0xcf, 0x1f, 0xee, 0x35, 0x1e, 0x2e, 0x62, 0x26, 0xb7, 0x7f, 0x50, 0xc8, 0x6e, 0x19, 0xf6, 0x88,
+	0x74, 0xf5, 0x47, 0x3a, 0xd1, 0x8e, 0xa3, 0x28, 0xc1, 0x95, 0x93, 0x28, 0x36, 0x1f, 0x34, 0x1b,
+	0xae, 0x63, 0x09, 0x6e, 0x80, 0x78, 0xf3, 0x30, 0xf4, 0x26, 0xe4, 0x16, 0xc0, 0xfb, 0x7d, 0x91,
+	0xab, 0xdc, 0x3f, 0xd5, 0xc9, 0x46, 0x1c, 0x31, 0x32, 0xc2, 0xcd, 0xcf, 0x04, 0x48, 0xf1, 0x47,
+	0x37, 0xf7, 0x9c, 0x55, 0xc8, 0xd6, 0x1b, 0xad, 0xcd, 0xb6, 0xdc, 0x59, 0xec, 0x30, 0x33, 0x65,
+	0xde, 0x80, 0x4b, 0xc7, 0x20, 0xa7, 0x81, 0x2e, 0xcc, 0x07, 0xfa, 0xff, 0x41, 0xe6, 0x18, 0xe0,
+	0x2c, 0xe0, 0xc5, 0x63, 0x01, 0x8f, 0xae, 0xc1, 0xc5, 0x63, 0xc0, 0x4e, 0xf8, 0x31, 0x1f, 0x80,
+	0xfd, 0x83, 0x42, 0x84, 0x8d, 0x59, 0x5c, 0x3b, 0x08, 0xec, 0xa3, 0x48, 0xf3, 0xf3, 0x7f, 0xe4,
+	0x02, 0x9f, 0x1f, 0xe5, 0x84, 0x2f, 0x8e, 0x72, 0xc2, 0xdf, 0x8f, 0x72, 0xc2, 0xc7, 0x5f, 0xe6,
+	0x02, 0x5f, 0x7c, 0x99, 0x0b, 0xfc, 0xf5, 0xcb, 0x5c, 0xe0, 0xa3, 0xd7, 0xce, 0x99, 0x93, 0x9d,
+	0x4f, 0xe9, 0xec, 0x13, 0xfa, 0x76, 0x84, 0xf5, 0x94, 0x6f, 0xfc, 0x67, 0x00, 0x14, 0xd8, 0xbc,
+	0x9b, 0x65, 0x1f, 0x00, 0x00,
 }
 
 func (m *VoteEvents) Marshal() (dAtA []byte, err error) {
@@ -1517,6 +1644,13 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMTxSignature) > 0 {
+		i -= len(m.SVMTxSignature)
+		copy(dAtA[i:], m.SVMTxSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SVMTxSignature)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Event != nil {
 		{
 			size := m.Event.Size()
@@ -1703,6 +1837,27 @@ func (m *Event_MultisigOperatorshipTransferred) MarshalToSizedBuffer(dAtA []byte
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Event_CommandExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_CommandExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommandExecuted != nil {
+		{
+			size, err := m.CommandExecuted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventTokenSent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1787,6 +1942,13 @@ func (m *EventContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMSender) > 0 {
+		i -= len(m.SVMSender)
+		copy(dAtA[i:], m.SVMSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SVMSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.PayloadHash.Size()
 		i -= size
@@ -1844,6 +2006,13 @@ func (m *EventContractCallWithToken) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMSender) > 0 {
+		i -= len(m.SVMSender)
+		copy(dAtA[i:], m.SVMSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SVMSender)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -2052,6 +2221,39 @@ func (m *EventMultisigOwnershipTransferred) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCommandExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommandExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommandExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommandID.Size()
+		i -= size
+		if _, err := m.CommandID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMultisigOperatorshipTransferred) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2448,6 +2650,11 @@ func (m *Command) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Type != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
 		i--
@@ -2512,6 +2719,11 @@ func (m *CommandBatchMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigningSessionID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigningSessionID))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Signature != nil {
 		{
 			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
@@ -2584,6 +2796,61 @@ func (m *CommandBatchMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommandExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommandExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TxID.Size()
+		i -= size
+		if _, err := m.TxID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BatchID) > 0 {
+		i -= len(m.BatchID)
+		copy(dAtA[i:], m.BatchID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BatchID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.CommandID.Size()
+		i -= size
+		if _, err := m.CommandID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SigMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2775,6 +3042,13 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMProgramID) > 0 {
+		i -= len(m.SVMProgramID)
+		copy(dAtA[i:], m.SVMProgramID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SVMProgramID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Address.Size()
 		i -= size
@@ -2808,6 +3082,13 @@ func (m *PollMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SVMTxSignature) > 0 {
+		i -= len(m.SVMTxSignature)
+		copy(dAtA[i:], m.SVMTxSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SVMTxSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.TxID.Size()
 		i -= size
@@ -2879,6 +3160,10 @@ func (m *Event) Size() (n int) {
 	if m.Event != nil {
 		n += m.Event.Size()
 	}
+	l = len(m.SVMTxSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2966,6 +3251,18 @@ func (m *Event_MultisigOperatorshipTransferred) Size() (n int) {
 	}
 	return n
 }
+func (m *Event_CommandExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommandExecuted != nil {
+		l = m.CommandExecuted.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *EventTokenSent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3009,6 +3306,10 @@ func (m *EventContractCall) Size() (n int) {
 	}
 	l = m.PayloadHash.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SVMSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3036,6 +3337,10 @@ func (m *EventContractCallWithToken) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SVMSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3092,6 +3397,17 @@ func (m *EventMultisigOwnershipTransferred) Size() (n int) {
 	return n
 }
 
+func (m *EventCommandExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommandID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *EventMultisigOperatorshipTransferred) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3257,6 +3573,9 @@ func (m *Command) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovTypes(uint64(m.Type))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -3297,6 +3616,29 @@ func (m *CommandBatchMetadata) Size() (n int) {
 		l = m.Signature.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.SigningSessionID != 0 {
+		n += 1 + sovTypes(uint64(m.SigningSessionID))
+	}
+	return n
+}
+
+func (m *CommandExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommandID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BatchID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TxID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
 	return n
 }
 
@@ -3382,6 +3724,10 @@ func (m *Gateway) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SVMProgramID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3397,6 +3743,10 @@ func (m *PollMetadata) Size() (n int) {
 	}
 	l = m.TxID.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SVMTxSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3899,13 +4249,80 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_MultisigOperatorshipTransferred{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandExecuted", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventCommandExecuted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_CommandExecuted{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMTxSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMTxSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if (iNdEx + skippy) > l {
@@ -4291,6 +4708,38 @@ func (m *EventContractCall) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4536,6 +4985,38 @@ func (m *EventContractCallWithToken) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4974,6 +5455,89 @@ func (m *EventMultisigOwnershipTransferred) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCommandExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommandExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommandExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommandID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMultisigOperatorshipTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6324,6 +6888,25 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6631,6 +7214,194 @@ func (m *CommandBatchMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSessionID", wireType)
+			}
+			m.SigningSessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningSessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommandExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommandID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchID = append(m.BatchID[:0], dAtA[iNdEx:postIndex]...)
+			if m.BatchID == nil {
+				m.BatchID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7244,6 +8015,38 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMProgramID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMProgramID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7359,6 +8162,38 @@ func (m *PollMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SVMTxSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SVMTxSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
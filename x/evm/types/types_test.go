@@ -9,11 +9,14 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
+	multisigtypestestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
@@ -41,6 +44,38 @@ func TestNewCommandBatchMetadata(t *testing.T) {
 	assert.Equal(t, expectedData, common.Bytes2Hex(actual.Data))
 }
 
+func TestDecodeCommandBatchData(t *testing.T) {
+	chainID := math.NewInt(rand.PosI64())
+	keyID := multisigTestutils.KeyID()
+	commands := []Command{
+		NewMintTokenCommand(keyID, nexus.TransferID(rand.PosI64()), rand.Denom(3, 10), common.BytesToAddress(rand.Bytes(common.AddressLength)), big.NewInt(rand.PosI64())),
+		NewApproveContractCallCommand(chainID, keyID, nexus.ChainName(rand.Denom(3, 10)), Hash(common.BytesToHash(rand.Bytes(common.HashLength))), uint64(rand.PosI64()), EventContractCall{
+			Sender:           Address(common.BytesToAddress(rand.Bytes(common.AddressLength))),
+			ContractAddress:  common.BytesToAddress(rand.Bytes(common.AddressLength)).Hex(),
+			PayloadHash:      Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+			DestinationChain: nexus.ChainName(rand.Denom(3, 10)),
+		}),
+	}
+
+	batch, err := NewCommandBatchMetadata(rand.PosI64(), chainID, keyID, commands)
+	assert.NoError(t, err)
+
+	actualChainID, actualCommands, err := DecodeCommandBatchData(batch.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, chainID, actualChainID)
+	assert.Len(t, actualCommands, len(commands))
+
+	for i, command := range commands {
+		assert.Equal(t, command.ID, actualCommands[i].ID)
+		assert.Equal(t, command.Type, actualCommands[i].Type)
+		assert.Equal(t, command.Params, actualCommands[i].Params)
+		assert.Equal(t, funcs.Must(command.DecodeParams()), funcs.Must(actualCommands[i].DecodeParams()))
+	}
+
+	_, _, err = DecodeCommandBatchData(rand.Bytes(100))
+	assert.Error(t, err)
+}
+
 func TestDeployToken(t *testing.T) {
 	chainID := math.NewInt(1)
 	keyID := multisigTestutils.KeyID()
@@ -252,3 +287,63 @@ func TestVoteEvents_ValidateBasic(t *testing.T) {
 		assert.ErrorContains(t, err, "too many events")
 	})
 }
+
+func TestEvent_ValidateBasic_SVM(t *testing.T) {
+	newEvent := func() Event {
+		return Event{
+			Chain:          nexus.ChainName("solana"),
+			SVMTxSignature: base58.Encode(rand.Bytes(64)),
+			Index:          uint64(rand.PosI64()),
+			Event: &Event_ContractCall{
+				ContractCall: &EventContractCall{
+					SVMSender:        base58.Encode(rand.Bytes(32)),
+					DestinationChain: nexus.ChainName("ethereum"),
+					ContractAddress:  "0x" + common.Bytes2Hex(rand.Bytes(common.AddressLength)),
+					PayloadHash:      Hash(common.BytesToHash(rand.Bytes(common.HashLength))),
+				},
+			},
+		}
+	}
+
+	t.Run("valid svm event should pass", func(t *testing.T) {
+		event := newEvent()
+		assert.NoError(t, event.ValidateBasic())
+		assert.Equal(t, base58.Decode(event.SVMTxSignature), event.GetSourceTxID())
+		assert.Equal(t, event.GetContractCall().SVMSender, event.GetContractCall().GetSenderAddress())
+		assert.NoError(t, event.GetID().Validate())
+	})
+
+	t.Run("svm event with tx id should fail", func(t *testing.T) {
+		event := newEvent()
+		event.TxID = Hash(common.BytesToHash(rand.Bytes(common.HashLength)))
+		assert.ErrorContains(t, event.ValidateBasic(), "cannot both be set")
+	})
+
+	t.Run("svm event with invalid signature should fail", func(t *testing.T) {
+		event := newEvent()
+		event.SVMTxSignature = base58.Encode(rand.Bytes(32))
+		assert.ErrorContains(t, event.ValidateBasic(), "invalid svm tx signature")
+	})
+
+	t.Run("svm event with evm sender should fail", func(t *testing.T) {
+		event := newEvent()
+		event.GetContractCall().Sender = Address(common.BytesToAddress(rand.Bytes(common.AddressLength)))
+		assert.ErrorContains(t, event.ValidateBasic(), "cannot both be set")
+	})
+
+	t.Run("svm event of a non gateway call type should fail", func(t *testing.T) {
+		event := newEvent()
+		event.Event = &Event_TokenDeployed{TokenDeployed: &EventTokenDeployed{Symbol: "AXL", TokenAddress: Address(common.BytesToAddress(rand.Bytes(common.AddressLength)))}}
+		assert.ErrorContains(t, event.ValidateBasic(), "unsupported type of svm event")
+	})
+}
+
+func TestValidateMultisigKeyType(t *testing.T) {
+	key := multisigtypestestutils.Key()
+	assert.NoError(t, ValidateMultisigKeyType(&key))
+
+	for _, keyType := range []multisig.KeyType{multisig.Ed25519, multisig.Schnorr} {
+		key.KeyType = keyType
+		assert.ErrorContains(t, ValidateMultisigKeyType(&key), "EVM chains require")
+	}
+}
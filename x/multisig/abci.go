@@ -9,6 +9,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/utils/events"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
 )
@@ -28,7 +29,7 @@ func handleKeygens(ctx sdk.Context, k types.Keeper, rewarder types.Rewarder) {
 		k.DeleteKeygenSession(ctx, keygen.GetKeyID())
 
 		pool := rewarder.GetPool(ctx, types.ModuleName)
-		slices.ForEach(keygen.GetMissingParticipants(), pool.ClearRewards)
+		slices.ForEach(keygen.GetMissingParticipants(), func(p sdk.ValAddress) { pool.ClearRewards(p, reward.MissedKeygen) })
 
 		if keygen.State != exported.Completed {
 			events.Emit(ctx, types.NewKeygenExpired(keygen.GetKeyID()))
@@ -55,7 +56,8 @@ func handleSignings(ctx sdk.Context, k types.Keeper, rewarder types.Rewarder) {
 			module := signing.GetModule()
 
 			pool := rewarder.GetPool(cachedCtx, types.ModuleName)
-			slices.ForEach(signing.GetMissingParticipants(), pool.ClearRewards)
+			slices.ForEach(signing.GetMissingParticipants(), func(p sdk.ValAddress) { pool.ClearRewards(p, reward.MissedSignature) })
+			k.RecordSigningParticipation(cachedCtx, signing)
 
 			if signing.State != exported.Completed {
 				events.Emit(cachedCtx, types.NewSigningExpired(signing.GetID()))
@@ -46,6 +46,7 @@ func TestEndBlocker(t *testing.T) {
 			LoggerFunc:                     func(sdk.Context) log.Logger { return log.NewTestLogger(t) },
 			GetKeygenSessionsByExpiryFunc:  func(sdk.Context, int64) []types.KeygenSession { return nil },
 			GetSigningSessionsByExpiryFunc: func(sdk.Context, int64) []types.SigningSession { return nil },
+			RecordSigningParticipationFunc: func(sdk.Context, types.SigningSession) {},
 		}
 		rewarder = &mock.RewarderMock{}
 	})
@@ -69,7 +70,7 @@ func TestEndBlocker(t *testing.T) {
 			}).
 			Then("should delete and penalize missing participants", func(t *testing.T) {
 				pool := rewardmock.RewardPoolMock{
-					ClearRewardsFunc: func(sdk.ValAddress) {},
+					ClearRewardsFunc: func(sdk.ValAddress, reward.ClearReason) {},
 				}
 
 				k.DeleteKeygenSessionFunc = func(sdk.Context, exported.KeyID) {}
@@ -80,6 +81,7 @@ func TestEndBlocker(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Len(t, k.DeleteKeygenSessionCalls(), 1)
 				assert.Len(t, pool.ClearRewardsCalls(), 10)
+				assert.Equal(t, reward.MissedKeygen, pool.ClearRewardsCalls()[0].ClearReason)
 			}).
 			Run(t, 20)
 
@@ -132,7 +134,7 @@ func TestEndBlocker(t *testing.T) {
 			}).
 			Then("should delete and set key", func(t *testing.T) {
 				pool := rewardmock.RewardPoolMock{
-					ClearRewardsFunc:   func(sdk.ValAddress) {},
+					ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 					ReleaseRewardsFunc: func(sdk.ValAddress) error { return nil },
 				}
 				rewarder.GetPoolFunc = func(sdk.Context, string) reward.RewardPool { return &pool }
@@ -195,7 +197,7 @@ func TestEndBlocker(t *testing.T) {
 				}).
 					Then("should delete and penalize missing participants", func(t *testing.T) {
 						pool := rewardmock.RewardPoolMock{
-							ClearRewardsFunc: func(sdk.ValAddress) {},
+							ClearRewardsFunc: func(sdk.ValAddress, reward.ClearReason) {},
 						}
 
 						k.DeleteSigningSessionFunc = func(sdk.Context, uint64) {}
@@ -207,7 +209,9 @@ func TestEndBlocker(t *testing.T) {
 						assert.NoError(t, err)
 						assert.Len(t, k.DeleteSigningSessionCalls(), 1)
 						assert.Len(t, pool.ClearRewardsCalls(), 10)
+						assert.Equal(t, reward.MissedSignature, pool.ClearRewardsCalls()[0].ClearReason)
 						assert.Len(t, sigHandler.HandleFailedCalls(), 1)
+						assert.Len(t, k.RecordSigningParticipationCalls(), 1)
 					}),
 
 				When("a completed signing session expiry equal to the block height", func() {
@@ -234,6 +238,7 @@ func TestEndBlocker(t *testing.T) {
 						assert.Len(t, k.DeleteSigningSessionCalls(), 1)
 						assert.Len(t, sigHandler.HandleCompletedCalls(), 1)
 						assert.Len(t, pool.ReleaseRewardsCalls(), len(signingSession.Key.GetParticipants()))
+						assert.Len(t, k.RecordSigningParticipationCalls(), 1)
 					}),
 
 				When("a completed signing session with missing participants and expiry equal to the block height", func() {
@@ -249,7 +254,7 @@ func TestEndBlocker(t *testing.T) {
 				}).
 					Then("should delete and set sig", func(t *testing.T) {
 						pool := rewardmock.RewardPoolMock{
-							ClearRewardsFunc:   func(sdk.ValAddress) {},
+							ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 							ReleaseRewardsFunc: func(sdk.ValAddress) error { return nil },
 						}
 						rewarder.GetPoolFunc = func(sdk.Context, string) reward.RewardPool { return &pool }
@@ -280,7 +285,7 @@ func TestEndBlocker(t *testing.T) {
 				}).
 					Then("should delete and set sig", func(t *testing.T) {
 						pool := rewardmock.RewardPoolMock{
-							ClearRewardsFunc:   func(sdk.ValAddress) {},
+							ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 							ReleaseRewardsFunc: func(sdk.ValAddress) error { return nil },
 						}
 						rewarder.GetPoolFunc = func(sdk.Context, string) reward.RewardPool { return &pool }
@@ -314,7 +319,7 @@ func TestEndBlocker(t *testing.T) {
 					}).
 					Then("recover and roll back state", func(t *testing.T) {
 						pool := rewardmock.RewardPoolMock{
-							ClearRewardsFunc:   func(sdk.ValAddress) {},
+							ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 							ReleaseRewardsFunc: func(sdk.ValAddress) error { return nil },
 						}
 						rewarder.GetPoolFunc = func(sdk.Context, string) reward.RewardPool { return &pool }
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,8 +27,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	multisigQueryCmd.AddCommand(
 		GetCmdKeyID(),
 		GetCmdNextKeyID(),
+		GetCmdActiveKeyIDs(),
 		GetCmdKey(),
 		GetCmdKeygenSession(),
+		GetCmdSigningSession(),
+		GetCmdSigningSessions(),
+		GetCmdValidatorSigningStats(),
 		GetParams(),
 	)
 
@@ -93,6 +99,36 @@ func GetCmdNextKeyID() *cobra.Command {
 	return cmd
 }
 
+// GetCmdActiveKeyIDs returns the key IDs of all active keys of a given chain
+func GetCmdActiveKeyIDs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-key-ids [chain]",
+		Short: "Returns the key IDs of all active keys of a given chain, starting with the key of the current epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := utils.NormalizeString(args[0])
+			queryClient := types.NewQueryServiceClient(clientCtx)
+			res, err := queryClient.ActiveKeyIDs(cmd.Context(),
+				&types.ActiveKeyIDsRequest{
+					Chain: chain,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdKey returns the key of the given ID
 func GetCmdKey() *cobra.Command {
 	cmd := &cobra.Command{
@@ -153,6 +189,129 @@ func GetCmdKeygenSession() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSigningSession returns the signing session info for the given signing session ID
+func GetCmdSigningSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-session [sig-id]",
+		Short: "Returns the signing session info for the given signing session ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sigID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid signing session ID %s", args[0])
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+			res, err := queryClient.SigningSession(cmd.Context(),
+				&types.SigningSessionRequest{
+					ID: sigID,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSigningSessions returns the signing sessions matching the given filters
+func GetCmdSigningSessions() *cobra.Command {
+	cmdName := "signing-sessions"
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "Returns the signing sessions that have not been handled yet matching the given filters",
+		Args:  cobra.ExactArgs(0),
+	}
+
+	keyID := cmd.Flags().String("key-id", "", "the ID of the key the signing sessions sign with")
+	module := cmd.Flags().String("module", "", "the module that started the signing sessions")
+	state := cmd.Flags().String("state", "", "the signing session state [pending|completed]")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		signingState := multisig.NonExistent
+		if *state != "" {
+			s, ok := multisig.MultisigState_value["MULTISIG_STATE_"+strings.ToUpper(*state)]
+			if !ok || multisig.MultisigState(s) == multisig.NonExistent {
+				return fmt.Errorf("unrecognized signing session state %s", *state)
+			}
+
+			signingState = multisig.MultisigState(s)
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+		res, err := queryClient.SigningSessions(cmd.Context(),
+			&types.SigningSessionsRequest{
+				KeyID:      multisig.KeyID(utils.NormalizeString(*keyID)),
+				Module:     *module,
+				State:      signingState,
+				Pagination: pageReq,
+			})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
+// GetCmdValidatorSigningStats returns the participation of the given validator in its most recent signing sessions
+func GetCmdValidatorSigningStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-signing-stats [validator]",
+		Short: "Returns how many of its most recent signing sessions the given validator signed or missed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+			res, err := queryClient.ValidatorSigningStats(cmd.Context(),
+				&types.ValidatorSigningStatsRequest{
+					Validator: args[0],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParams returns the multisig params
 func GetParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -95,6 +95,7 @@ func getCmdStart() *cobra.Command {
 	if err := cmd.MarkFlagRequired("id"); err != nil {
 		panic("id flag not set")
 	}
+	keyType := cmd.Flags().String("key-type", "ecdsa", "signature scheme of the new key (ecdsa|ed25519|schnorr)")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		cliCtx, err := client.GetClientTxContext(cmd)
@@ -102,7 +103,12 @@ func getCmdStart() *cobra.Command {
 			return err
 		}
 
-		msg := types.NewStartKeygenRequest(cliCtx.FromAddress, exported.KeyID(*keyID))
+		keyType, err := exported.KeyTypeFromString(*keyType)
+		if err != nil {
+			return err
+		}
+
+		msg := types.NewStartKeygenRequest(cliCtx.FromAddress, exported.KeyID(*keyID), keyType)
 
 		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 	}
@@ -159,6 +159,9 @@ var _ multisigexported.Key = &KeyMock{}
 //			GetHeightFunc: func() int64 {
 //				panic("mock out the GetHeight method")
 //			},
+//			GetKeyTypeFunc: func() multisigexported.KeyType {
+//				panic("mock out the GetKeyType method")
+//			},
 //			GetMinPassingWeightFunc: func() math.Uint {
 //				panic("mock out the GetMinPassingWeight method")
 //			},
@@ -193,6 +196,9 @@ type KeyMock struct {
 	// GetHeightFunc mocks the GetHeight method.
 	GetHeightFunc func() int64
 
+	// GetKeyTypeFunc mocks the GetKeyType method.
+	GetKeyTypeFunc func() multisigexported.KeyType
+
 	// GetMinPassingWeightFunc mocks the GetMinPassingWeight method.
 	GetMinPassingWeightFunc func() math.Uint
 
@@ -222,6 +228,9 @@ type KeyMock struct {
 		// GetHeight holds details about calls to the GetHeight method.
 		GetHeight []struct {
 		}
+		// GetKeyType holds details about calls to the GetKeyType method.
+		GetKeyType []struct {
+		}
 		// GetMinPassingWeight holds details about calls to the GetMinPassingWeight method.
 		GetMinPassingWeight []struct {
 		}
@@ -250,6 +259,7 @@ type KeyMock struct {
 	}
 	lockGetBondedWeight     sync.RWMutex
 	lockGetHeight           sync.RWMutex
+	lockGetKeyType          sync.RWMutex
 	lockGetMinPassingWeight sync.RWMutex
 	lockGetParticipants     sync.RWMutex
 	lockGetPubKey           sync.RWMutex
@@ -313,6 +323,33 @@ func (mock *KeyMock) GetHeightCalls() []struct {
 	return calls
 }
 
+// GetKeyType calls GetKeyTypeFunc.
+func (mock *KeyMock) GetKeyType() multisigexported.KeyType {
+	if mock.GetKeyTypeFunc == nil {
+		panic("KeyMock.GetKeyTypeFunc: method is nil but Key.GetKeyType was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetKeyType.Lock()
+	mock.calls.GetKeyType = append(mock.calls.GetKeyType, callInfo)
+	mock.lockGetKeyType.Unlock()
+	return mock.GetKeyTypeFunc()
+}
+
+// GetKeyTypeCalls gets all the calls that were made to GetKeyType.
+// Check the length with:
+//
+//	len(mockedKey.GetKeyTypeCalls())
+func (mock *KeyMock) GetKeyTypeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetKeyType.RLock()
+	calls = mock.calls.GetKeyType
+	mock.lockGetKeyType.RUnlock()
+	return calls
+}
+
 // GetMinPassingWeight calls GetMinPassingWeightFunc.
 func (mock *KeyMock) GetMinPassingWeight() math.Uint {
 	if mock.GetMinPassingWeightFunc == nil {
@@ -3,14 +3,17 @@ package exported
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
 	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -32,6 +35,7 @@ type Key interface {
 	GetTimestamp() time.Time
 	GetBondedWeight() math.Uint
 	GetSnapshot() exported.Snapshot
+	GetKeyType() KeyType
 }
 
 // MultiSig provides an interface to work with the multi sig
@@ -97,6 +101,50 @@ func (pk PublicKey) String() string {
 	return hex.EncodeToString(pk)
 }
 
+// KeyTypes returns all supported key types
+func KeyTypes() []KeyType {
+	return []KeyType{ECDSA, Ed25519, Schnorr}
+}
+
+// KeyTypeFromString converts a describing key type string, e.g. "ed25519", to the corresponding KeyType
+func KeyTypeFromString(s string) (KeyType, error) {
+	keyType, ok := KeyType_value["KEY_TYPE_"+strings.ToUpper(s)]
+	if !ok {
+		return ECDSA, fmt.Errorf("unknown key type %s", s)
+	}
+
+	return KeyType(keyType), nil
+}
+
+// ValidateBasic returns an error if the given key type is invalid; nil otherwise
+func (t KeyType) ValidateBasic() error {
+	switch t {
+	case ECDSA, Ed25519, Schnorr:
+		return nil
+	default:
+		return fmt.Errorf("invalid key type %s", t)
+	}
+}
+
+// ValidatePubKey returns an error if the given public key is not a valid public key of the given key type; nil otherwise
+func (t KeyType) ValidatePubKey(pk PublicKey) error {
+	switch t {
+	case ECDSA:
+		return pk.ValidateBasic()
+	case Ed25519:
+		if len(pk) != ed25519.PublicKeySize {
+			return fmt.Errorf("ed25519 public key must be %d bytes", ed25519.PublicKeySize)
+		}
+
+		return nil
+	case Schnorr:
+		_, err := schnorr.ParsePubKey(pk)
+		return err
+	default:
+		return fmt.Errorf("invalid key type %s", t)
+	}
+}
+
 // ToECDSAPubKey returns the ECDSA public key
 func (pk PublicKey) ToECDSAPubKey() ecdsa.PublicKey {
 	btcecKey := funcs.Must(btcec.ParsePubKey(pk))
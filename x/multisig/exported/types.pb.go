@@ -77,9 +77,41 @@ func (KeyState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b14433678c926388, []int{1}
 }
 
+// KeyType is the signature scheme of a key. ECDSA is the zero value, so keys
+// generated before key types were introduced are ECDSA keys.
+type KeyType int32
+
+const (
+	ECDSA   KeyType = 0
+	Ed25519 KeyType = 1
+	// BIP-340 Schnorr signatures over secp256k1
+	Schnorr KeyType = 2
+)
+
+var KeyType_name = map[int32]string{
+	0: "KEY_TYPE_ECDSA",
+	1: "KEY_TYPE_ED25519",
+	2: "KEY_TYPE_SCHNORR",
+}
+
+var KeyType_value = map[string]int32{
+	"KEY_TYPE_ECDSA":   0,
+	"KEY_TYPE_ED25519": 1,
+	"KEY_TYPE_SCHNORR": 2,
+}
+
+func (x KeyType) String() string {
+	return proto.EnumName(KeyType_name, int32(x))
+}
+
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b14433678c926388, []int{2}
+}
+
 func init() {
 	proto.RegisterEnum("axelar.multisig.exported.v1beta1.MultisigState", MultisigState_name, MultisigState_value)
 	proto.RegisterEnum("axelar.multisig.exported.v1beta1.KeyState", KeyState_name, KeyState_value)
+	proto.RegisterEnum("axelar.multisig.exported.v1beta1.KeyType", KeyType_name, KeyType_value)
 }
 
 func init() {
@@ -87,29 +119,33 @@ func init() {
 }
 
 var fileDescriptor_b14433678c926388 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0xc1, 0x8a, 0xda, 0x40,
-	0x1c, 0x06, 0xf0, 0x8c, 0x07, 0x6b, 0xc7, 0x4a, 0x43, 0x68, 0x4b, 0xc9, 0x61, 0xc8, 0xa1, 0x20,
-	0xd8, 0x36, 0x83, 0xf4, 0xd2, 0x6b, 0x9a, 0x4c, 0x65, 0x50, 0xd3, 0x40, 0xa2, 0xd0, 0x5e, 0x24,
-	0x9a, 0x21, 0x0d, 0xd5, 0x4c, 0x48, 0x46, 0x1b, 0x1f, 0xa0, 0x50, 0x72, 0xea, 0x0b, 0x04, 0x0a,
-	0xed, 0xa1, 0x8f, 0xe2, 0xd1, 0x63, 0x8f, 0xbb, 0xfa, 0x22, 0xcb, 0x1a, 0xc5, 0xc5, 0xdd, 0xdb,
-	0x0c, 0xfc, 0xbe, 0x3f, 0x1f, 0x7c, 0xf0, 0x8d, 0x9f, 0xb3, 0xb9, 0x9f, 0xe2, 0xc5, 0x72, 0x2e,
-	0xa2, 0x2c, 0x0a, 0x31, 0xcb, 0x13, 0x9e, 0x0a, 0x16, 0xe0, 0x55, 0x77, 0xca, 0x84, 0xdf, 0xc5,
-	0x62, 0x9d, 0xb0, 0x4c, 0x4f, 0x52, 0x2e, 0xb8, 0xa2, 0x55, 0x5a, 0x3f, 0x69, 0xfd, 0xa4, 0xf5,
-	0xa3, 0x56, 0x9f, 0x85, 0x3c, 0xe4, 0x07, 0x8c, 0x6f, 0x5f, 0x55, 0xae, 0xf3, 0x1b, 0xc0, 0xd6,
-	0xf0, 0x98, 0x71, 0x85, 0x2f, 0x98, 0x82, 0xa1, 0x3a, 0x1c, 0x0d, 0x3c, 0xea, 0xd2, 0xde, 0xc4,
-	0xf5, 0x0c, 0x8f, 0x4c, 0x46, 0xb6, 0xeb, 0x10, 0x93, 0x7e, 0xa4, 0xc4, 0x92, 0x25, 0xf5, 0x69,
-	0x51, 0x6a, 0x4d, 0x9b, 0xc7, 0x24, 0x8f, 0x32, 0xc1, 0x62, 0xa1, 0xb4, 0xe1, 0x8b, 0x8b, 0x80,
-	0x43, 0x6c, 0x8b, 0xda, 0x3d, 0x19, 0xa8, 0xcd, 0xa2, 0xd4, 0x1e, 0x39, 0x2c, 0x0e, 0xa2, 0x38,
-	0x54, 0x5e, 0xc3, 0x97, 0x17, 0xd0, 0xfc, 0x34, 0x74, 0x06, 0xc4, 0x23, 0x96, 0x5c, 0x53, 0x5b,
-	0x45, 0xa9, 0x3d, 0x36, 0xf9, 0x22, 0x99, 0x33, 0xc1, 0x02, 0xb5, 0xf1, 0xf3, 0x0f, 0x92, 0xfe,
-	0xfd, 0x45, 0xa0, 0xf3, 0x03, 0xc0, 0x46, 0x9f, 0xad, 0xab, 0x76, 0x6d, 0xf8, 0xbc, 0x4f, 0x3e,
-	0x3f, 0x58, 0xec, 0x49, 0x51, 0x6a, 0x0d, 0x1a, 0xfb, 0x33, 0x11, 0xad, 0x98, 0xf2, 0x0a, 0x2a,
-	0x67, 0x68, 0xb8, 0x2e, 0xed, 0xd9, 0xc4, 0x92, 0x41, 0xa5, 0x8c, 0x2c, 0x8b, 0xc2, 0x98, 0x05,
-	0x8a, 0x06, 0xe5, 0x3b, 0xca, 0xf4, 0xe8, 0x98, 0xc8, 0x35, 0x15, 0x16, 0xa5, 0x56, 0x37, 0x0e,
-	0x77, 0xce, 0x3d, 0x3e, 0x8c, 0x37, 0xd7, 0x48, 0xda, 0xec, 0x10, 0xd8, 0xee, 0x10, 0xb8, 0xda,
-	0x21, 0xf0, 0x6b, 0x8f, 0xa4, 0xed, 0x1e, 0x49, 0xff, 0xf7, 0x48, 0xfa, 0xf2, 0x3e, 0x8c, 0xc4,
-	0xd7, 0xe5, 0x54, 0x9f, 0xf1, 0x05, 0xae, 0xb6, 0x88, 0x99, 0xf8, 0xce, 0xd3, 0x6f, 0xc7, 0xdf,
-	0xdb, 0x19, 0x4f, 0x19, 0xce, 0xef, 0xcf, 0x39, 0xad, 0x1f, 0x96, 0x78, 0x77, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0xf2, 0x0f, 0x7f, 0x2c, 0xf1, 0x01, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd2, 0xd1, 0x6a, 0xd3, 0x50,
+	0x18, 0x07, 0xf0, 0xa4, 0xe0, 0xd6, 0x9d, 0x39, 0x0d, 0x07, 0x15, 0x09, 0x78, 0x88, 0x20, 0x0c,
+	0xa6, 0x26, 0x54, 0x19, 0xe8, 0x65, 0x4c, 0x8e, 0x35, 0x74, 0xcd, 0xc2, 0x4e, 0x36, 0x98, 0x37,
+	0x25, 0x6d, 0x3e, 0xb2, 0x60, 0x9b, 0x13, 0x92, 0xb3, 0x99, 0x3e, 0x80, 0x20, 0xb9, 0xf2, 0x05,
+	0x02, 0x82, 0x5e, 0xf8, 0x28, 0xbb, 0xdc, 0xa5, 0x97, 0xda, 0xbe, 0x88, 0x2c, 0x69, 0xe9, 0xac,
+	0xde, 0xe5, 0x23, 0xbf, 0xff, 0x39, 0x1f, 0x87, 0x3f, 0x7a, 0x16, 0x14, 0x30, 0x0e, 0x32, 0x63,
+	0x72, 0x3e, 0x16, 0x71, 0x1e, 0x47, 0x06, 0x14, 0x29, 0xcf, 0x04, 0x84, 0xc6, 0x45, 0x67, 0x08,
+	0x22, 0xe8, 0x18, 0x62, 0x9a, 0x42, 0xae, 0xa7, 0x19, 0x17, 0x1c, 0x6b, 0x8d, 0xd6, 0x97, 0x5a,
+	0x5f, 0x6a, 0x7d, 0xa1, 0xd5, 0x7b, 0x11, 0x8f, 0x78, 0x8d, 0x8d, 0xeb, 0xaf, 0x26, 0xb7, 0xf7,
+	0x55, 0x46, 0x3b, 0xfd, 0x45, 0x86, 0x89, 0x40, 0x00, 0x36, 0x90, 0xda, 0x3f, 0x3e, 0xf0, 0x1d,
+	0xe6, 0x74, 0x07, 0xcc, 0x37, 0x7d, 0x3a, 0x38, 0x76, 0x99, 0x47, 0x2d, 0xe7, 0xad, 0x43, 0x6d,
+	0x45, 0x52, 0xef, 0x96, 0x95, 0xb6, 0xed, 0xf2, 0x84, 0x16, 0x71, 0x2e, 0x20, 0x11, 0x78, 0x17,
+	0x3d, 0x58, 0x0b, 0x78, 0xd4, 0xb5, 0x1d, 0xb7, 0xab, 0xc8, 0xea, 0x76, 0x59, 0x69, 0x9b, 0x1e,
+	0x24, 0x61, 0x9c, 0x44, 0xf8, 0x29, 0x7a, 0xb8, 0x06, 0xad, 0xc3, 0xbe, 0x77, 0x40, 0x7d, 0x6a,
+	0x2b, 0x2d, 0x75, 0xa7, 0xac, 0xb4, 0x2d, 0x8b, 0x4f, 0xd2, 0x31, 0x08, 0x08, 0xd5, 0xf6, 0xe7,
+	0x6f, 0x44, 0xfa, 0xf1, 0x9d, 0xc8, 0x7b, 0x9f, 0x64, 0xd4, 0xee, 0xc1, 0xb4, 0xd9, 0x6e, 0x17,
+	0xdd, 0xef, 0xd1, 0xd3, 0xff, 0x2e, 0x76, 0xbb, 0xac, 0xb4, 0xb6, 0x93, 0x04, 0x23, 0x11, 0x5f,
+	0x00, 0x7e, 0x82, 0xf0, 0x0a, 0x9a, 0x8c, 0x39, 0x5d, 0x97, 0xda, 0x8a, 0xdc, 0x28, 0x33, 0xcf,
+	0xe3, 0x28, 0x81, 0x10, 0x6b, 0x48, 0xb9, 0xa1, 0x2c, 0xdf, 0x39, 0xa1, 0x4a, 0x4b, 0x45, 0x65,
+	0xa5, 0x6d, 0x98, 0xf5, 0x39, 0x37, 0xf6, 0x28, 0xd0, 0x66, 0x0f, 0xa6, 0xfe, 0x34, 0x05, 0xfc,
+	0x08, 0xdd, 0xb9, 0x8e, 0xf9, 0xa7, 0x1e, 0x1d, 0x50, 0xcb, 0x66, 0xa6, 0x22, 0xa9, 0x5b, 0x65,
+	0xa5, 0xdd, 0xaa, 0x07, 0xfc, 0x18, 0x29, 0xab, 0xdf, 0xf6, 0x8b, 0xfd, 0xfd, 0xce, 0xeb, 0xe5,
+	0x5b, 0xd0, 0xb0, 0x1e, 0xff, 0x22, 0xcc, 0x7a, 0xe7, 0x1e, 0x1e, 0x1d, 0x29, 0xad, 0x86, 0xb0,
+	0xd1, 0x59, 0xc2, 0xb3, 0x6c, 0x75, 0xf3, 0x9b, 0x93, 0xcb, 0xdf, 0x44, 0xba, 0x9c, 0x11, 0xf9,
+	0x6a, 0x46, 0xe4, 0x5f, 0x33, 0x22, 0x7f, 0x99, 0x13, 0xe9, 0x6a, 0x4e, 0xa4, 0x9f, 0x73, 0x22,
+	0xbd, 0x7f, 0x15, 0xc5, 0xe2, 0xec, 0x7c, 0xa8, 0x8f, 0xf8, 0xc4, 0x68, 0x5a, 0x90, 0x80, 0xf8,
+	0xc8, 0xb3, 0x0f, 0x8b, 0xe9, 0xf9, 0x88, 0x67, 0x60, 0x14, 0xff, 0x16, 0x69, 0xb8, 0x51, 0x77,
+	0xe0, 0xe5, 0x9f, 0x01, 0x00, 0xe4, 0x42, 0xad, 0xe5, 0x6b, 0x02, 0x00, 0x00,
 }
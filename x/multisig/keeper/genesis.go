@@ -21,6 +21,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	slices.ForEach(state.Keys, withContext(ctx, k.setKey))
 	slices.ForEach(state.SigningSessions, withContext(ctx, k.setSigningSession))
 	slices.ForEach(state.KeyEpochs, withContext(ctx, k.setKeyEpoch))
+	slices.ForEach(state.SigningParticipations, withContext(ctx, k.setSigningParticipation))
 
 	keyEpochsByChain := slices.GroupBy(state.KeyEpochs, func(keyEpoch types.KeyEpoch) nexus.ChainName { return keyEpoch.GetChain() })
 	for chain, keyEpochs := range keyEpochsByChain {
@@ -49,6 +50,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getSigningSessions(ctx),
 		k.getKeys(ctx),
 		k.getKeyEpochs(ctx),
+		k.getSigningParticipations(ctx),
 	)
 }
 
@@ -70,7 +70,7 @@ func TestInitExportGenesis(t *testing.T) {
 		}
 		pool := rewardmock.RewardPoolMock{
 			ReleaseRewardsFunc: func(valAddress sdk.ValAddress) error { return nil },
-			ClearRewardsFunc:   func(valAddress sdk.ValAddress) {},
+			ClearRewardsFunc:   func(sdk.ValAddress, reward.ClearReason) {},
 		}
 		rewardK = &mock.RewarderMock{
 			GetPoolFunc: func(ctx sdk.Context, name string) reward.RewardPool { return &pool },
@@ -82,13 +82,13 @@ func TestInitExportGenesis(t *testing.T) {
 	givenMsgServer := Given("a multisig msg server", setup)
 
 	whenKeygenSessionExists := When("some keygen session exists", func() {
-		msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand.AccAddr(), testutils.KeyID()))
+		msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand.AccAddr(), testutils.KeyID(), exported.ECDSA))
 	})
 
 	whenKeyExists := When("some key exists", func() {
 		keyID = testutils.KeyID()
 
-		msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand.AccAddr(), keyID))
+		msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand.AccAddr(), keyID, exported.ECDSA))
 		for _, v := range validators {
 			snapshotter.GetOperatorFunc = func(sdk.Context, sdk.AccAddress) sdk.ValAddress { return v.Address }
 
@@ -106,6 +106,12 @@ func TestInitExportGenesis(t *testing.T) {
 		k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module)
 	})
 
+	whenSigningParticipationIsRecorded := When("the signing participation is recorded", func() {
+		for _, signing := range k.ExportGenesis(ctx).SigningSessions {
+			k.RecordSigningParticipation(ctx, signing)
+		}
+	})
+
 	whenKeyIsAssigned := When("some key is assigned", func() {
 		k.AssignKey(ctx, chain.Name, keyID)
 	})
@@ -150,6 +156,24 @@ func TestInitExportGenesis(t *testing.T) {
 			}).
 			Run(t)
 
+		givenMsgServer.
+			When2(whenKeyExists).
+			When2(whenKeyIsAssigned).
+			When2(whenKeyIsRotated).
+			When2(whenSigningSessionExists).
+			When2(whenSigningParticipationIsRecorded).
+			Then("should export the signing participation of every participant", func(t *testing.T) {
+				actual := k.ExportGenesis(ctx)
+
+				assert.Len(t, actual.SigningParticipations, len(validators))
+				for _, participation := range actual.SigningParticipations {
+					assert.EqualValues(t, 1, participation.SessionCount)
+					assert.EqualValues(t, 1, participation.MissedCount)
+				}
+				assert.NoError(t, actual.Validate())
+			}).
+			Run(t)
+
 		givenMsgServer.
 			When2(whenKeyExists).
 			When2(whenKeyIsAssigned).
@@ -172,6 +196,7 @@ func TestInitExportGenesis(t *testing.T) {
 			When2(whenKeyIsAssigned).
 			When2(whenKeyIsRotated).
 			When2(whenSigningSessionExists).
+			When2(whenSigningParticipationIsRecorded).
 			When2(whenKeyExists).
 			When2(whenKeyIsAssigned).
 			Then("should init", func(t *testing.T) {
@@ -183,10 +208,13 @@ func TestInitExportGenesis(t *testing.T) {
 
 				assert.NoError(t, actual.Validate())
 				assert.Equal(t, expected, actual)
-				assert.Error(t, k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module))
+				assert.Len(t, actual.SigningParticipations, len(validators))
+				_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module)
+				assert.Error(t, err)
 				assert.Error(t, k.AssignKey(ctx, chain.Name, keyID))
 				assert.NoError(t, k.RotateKey(ctx, chain.Name))
-				assert.NoError(t, k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module))
+				_, err = k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module)
+				assert.NoError(t, err)
 				assert.Len(t, k.ExportGenesis(ctx).SigningSessions, 2)
 			}).
 			Run(t)
@@ -207,7 +235,8 @@ func TestInitExportGenesis(t *testing.T) {
 
 				assert.NoError(t, actual.Validate())
 				assert.Equal(t, expected, actual)
-				assert.Error(t, k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module))
+				_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module)
+				assert.Error(t, err)
 				assert.NoError(t, k.AssignKey(ctx, chain.Name, keyID))
 				assert.NoError(t, k.RotateKey(ctx, chain.Name))
 			}).
@@ -228,7 +257,8 @@ func TestInitExportGenesis(t *testing.T) {
 
 				assert.NoError(t, actual.Validate())
 				assert.Equal(t, expected, actual)
-				assert.NoError(t, k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module))
+				_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), chain.Module)
+				assert.NoError(t, err)
 				assert.Error(t, k.AssignKey(ctx, chain.Name, keyID))
 				assert.Error(t, k.RotateKey(ctx, chain.Name))
 			}).
@@ -58,6 +58,13 @@ func (q Querier) NextKeyID(c context.Context, req *types.NextKeyIDRequest) (*typ
 	return &types.NextKeyIDResponse{KeyID: keyID}, nil
 }
 
+// ActiveKeyIDs returns the key IDs of all active keys of a given chain, starting with the key of the current epoch
+func (q Querier) ActiveKeyIDs(c context.Context, req *types.ActiveKeyIDsRequest) (*types.ActiveKeyIDsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.ActiveKeyIDsResponse{KeyIDs: q.keeper.GetActiveKeyIDs(ctx, nexus.ChainName(req.Chain))}, nil
+}
+
 // Key returns the key corresponding to a given key ID
 func (q Querier) Key(c context.Context, req *types.KeyRequest) (*types.KeyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -90,6 +97,7 @@ func (q Querier) Key(c context.Context, req *types.KeyRequest) (*types.KeyRespon
 		ThresholdWeight:    key.GetMinPassingWeight(),
 		BondedWeight:       key.GetBondedWeight(),
 		Participants:       participants,
+		KeyType:            key.GetKeyType(),
 	}, nil
 }
 
@@ -159,3 +167,85 @@ func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.Par
 		Params: params,
 	}, nil
 }
+
+// SigningSession returns the signing session info for the given signing session ID
+func (q Querier) SigningSession(c context.Context, req *types.SigningSessionRequest) (*types.SigningSessionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signing, ok := q.keeper.GetSigningSession(ctx, req.ID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrMultisig, fmt.Sprintf("signing session not found for id [%d]", req.ID)).Error())
+	}
+
+	return &types.SigningSessionResponse{SigningSession: getSigningSessionInfo(signing)}, nil
+}
+
+// SigningSessions returns the signing sessions that have not been handled yet, filtered by key ID, module and state
+func (q Querier) SigningSessions(c context.Context, req *types.SigningSessionsRequest) (*types.SigningSessionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.KeyID != "" {
+		if err := req.KeyID.ValidateBasic(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid key id").Error())
+		}
+	}
+
+	if _, ok := exported.MultisigState_name[int32(req.State)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid signing session state %d", req.State))
+	}
+
+	filter := types.SigningSessionFilter{
+		KeyID:  req.KeyID,
+		Module: req.Module,
+		State:  req.State,
+	}
+
+	signingSessions, pageResp, err := q.keeper.GetSigningSessionsPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.SigningSessionsResponse{
+		SigningSessions: slices.Map(signingSessions, getSigningSessionInfo),
+		Pagination:      pageResp,
+	}, nil
+}
+
+func getSigningSessionInfo(signing types.SigningSession) types.SigningSessionInfo {
+	return types.SigningSessionInfo{
+		ID:              signing.GetID(),
+		KeyID:           signing.MultiSig.KeyID,
+		Module:          signing.GetModule(),
+		State:           signing.GetState(),
+		PayloadHash:     signing.MultiSig.PayloadHash,
+		ExpiresAt:       signing.GetExpiresAt(),
+		CompletedAt:     signing.GetCompletedAt(),
+		GracePeriod:     signing.GetGracePeriod(),
+		SignedWeight:    signing.GetParticipantsWeight(),
+		ThresholdWeight: signing.Key.GetMinPassingWeight(),
+		Signers:         slices.Map(signing.MultiSig.GetParticipants(), sdk.ValAddress.String),
+		MissingSigners:  slices.Map(signing.GetMissingParticipants(), sdk.ValAddress.String),
+		ModuleMetadata:  signing.ModuleMetadata,
+	}
+}
+
+// ValidatorSigningStats returns the participation of the given validator in its most recent signing sessions
+func (q Querier) ValidatorSigningStats(c context.Context, req *types.ValidatorSigningStatsRequest) (*types.ValidatorSigningStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid validator address").Error())
+	}
+
+	participation := q.keeper.GetSigningParticipation(ctx, validator)
+	sessionCount := participation.GetWindowSessionCount()
+
+	return &types.ValidatorSigningStatsResponse{
+		Validator:    validator.String(),
+		Window:       types.SigningParticipationWindow,
+		SessionCount: sessionCount,
+		SignedCount:  sessionCount - participation.MissedCount,
+		MissedCount:  participation.MissedCount,
+	}, nil
+}
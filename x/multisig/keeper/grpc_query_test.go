@@ -5,13 +5,18 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	store "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/testutils"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	multisig "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	multisigTestutils "github.com/axelarnetwork/axelar-core/x/multisig/exported/testutils"
@@ -21,6 +26,7 @@ import (
 	typesTestutils "github.com/axelarnetwork/axelar-core/x/multisig/types/testutils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -152,6 +158,49 @@ func TestNextKeyID(t *testing.T) {
 	}).Repeat(repeatCount))
 }
 
+func TestActiveKeyIDs(t *testing.T) {
+	var (
+		multisigKeeper *mock.KeeperMock
+		querier        keeper.Querier
+		chain          nexus.ChainName
+		activeKeyIDs   []multisig.KeyID
+	)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: rand.PosI64()}, false, log.NewTestLogger(t))
+
+	Given("multisig querier", func() {
+		chain = nexus.ChainName(rand.Str(5))
+		multisigKeeper = &mock.KeeperMock{
+			GetActiveKeyIDsFunc: func(_ sdk.Context, c nexus.ChainName) []multisig.KeyID {
+				if c == chain {
+					return activeKeyIDs
+				}
+
+				return nil
+			},
+		}
+
+		querier = keeper.NewGRPCQuerier(multisigKeeper, &mock.StakerMock{})
+	}).
+		When("the chain has active keys", func() {
+			activeKeyIDs = []multisig.KeyID{multisigTestutils.KeyID(), multisigTestutils.KeyID()}
+		}).
+		Branch(
+			Then("should return the active keys of the chain", func(t *testing.T) {
+				res, err := querier.ActiveKeyIDs(sdk.WrapSDKContext(ctx), &types.ActiveKeyIDsRequest{Chain: chain.String()})
+				assert.NoError(t, err)
+				assert.Equal(t, activeKeyIDs, res.KeyIDs)
+			}),
+
+			Then("should return no keys for other chains", func(t *testing.T) {
+				res, err := querier.ActiveKeyIDs(sdk.WrapSDKContext(ctx), &types.ActiveKeyIDsRequest{Chain: rand.Str(6)})
+				assert.NoError(t, err)
+				assert.Empty(t, res.KeyIDs)
+			}),
+		).
+		Run(t)
+}
+
 func TestKey(t *testing.T) {
 	var (
 		multisigKeeper *mock.KeeperMock
@@ -330,3 +379,177 @@ func TestKeygenSession(t *testing.T) {
 		}).
 		Run(t, 10)
 }
+
+func TestSigningSessions(t *testing.T) {
+	var (
+		k           keeper.Keeper
+		ctx         sdk.Context
+		querier     keeper.Querier
+		evmKey      types.Key
+		otherKey    types.Key
+		evmSigIDs   []uint64
+		otherSigIDs []uint64
+	)
+
+	Given("signing sessions of different modules", func() {
+		encCfg := app.MakeEncodingConfig()
+		subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "multisig")
+		k = keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+		ctx = rand.Context(fake.NewMultiStore(), t)
+		querier = keeper.NewGRPCQuerier(k, &mock.StakerMock{})
+
+		evmKey = typesTestutils.Key()
+		otherKey = typesTestutils.Key()
+
+		var signingSessions []types.SigningSession
+		evmSigIDs, otherSigIDs = nil, nil
+		for i := 0; i < 10; i++ {
+			key, module := evmKey, "evm"
+			if i%2 == 1 {
+				key, module = otherKey, "other"
+			}
+
+			signing := types.NewSigningSession(uint64(i), key, rand.Bytes(multisig.HashLength), ctx.BlockHeight()+10, 1, module)
+			if i < 2 {
+				signing.State = multisig.Completed
+			}
+
+			signingSessions = append(signingSessions, signing)
+			if module == "evm" {
+				evmSigIDs = append(evmSigIDs, signing.GetID())
+			} else {
+				otherSigIDs = append(otherSigIDs, signing.GetID())
+			}
+		}
+
+		k.InitGenesis(ctx, types.NewGenesisState(types.DefaultParams(), nil, signingSessions, nil, nil, nil))
+	}).
+		Branch(
+			Then("should return the signing session with its progress", func(t *testing.T) {
+				res, err := querier.SigningSession(sdk.WrapSDKContext(ctx), &types.SigningSessionRequest{ID: evmSigIDs[0]})
+				assert.NoError(t, err)
+
+				assert.Equal(t, evmSigIDs[0], res.SigningSession.ID)
+				assert.Equal(t, evmKey.ID, res.SigningSession.KeyID)
+				assert.Equal(t, "evm", res.SigningSession.Module)
+				assert.Equal(t, multisig.Completed, res.SigningSession.State)
+				assert.Equal(t, evmKey.GetMinPassingWeight(), res.SigningSession.ThresholdWeight)
+				assert.Equal(t, math.ZeroUint(), res.SigningSession.SignedWeight)
+				assert.Empty(t, res.SigningSession.Signers)
+				assert.Len(t, res.SigningSession.MissingSigners, len(evmKey.GetParticipants()))
+			}),
+
+			Then("should fail for unknown signing sessions", func(t *testing.T) {
+				_, err := querier.SigningSession(sdk.WrapSDKContext(ctx), &types.SigningSessionRequest{ID: 1000})
+
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.NotFound, s.Code())
+			}),
+
+			Then("should filter signing sessions by key ID, module and state", func(t *testing.T) {
+				res, err := querier.SigningSessions(sdk.WrapSDKContext(ctx), &types.SigningSessionsRequest{KeyID: evmKey.ID})
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, evmSigIDs, signingSessionIDs(res.SigningSessions))
+
+				res, err = querier.SigningSessions(sdk.WrapSDKContext(ctx), &types.SigningSessionsRequest{Module: "other", State: multisig.Completed})
+				assert.NoError(t, err)
+				assert.Equal(t, otherSigIDs[:1], signingSessionIDs(res.SigningSessions))
+
+				res, err = querier.SigningSessions(sdk.WrapSDKContext(ctx), &types.SigningSessionsRequest{State: multisig.Pending})
+				assert.NoError(t, err)
+				assert.Len(t, res.SigningSessions, len(evmSigIDs)+len(otherSigIDs)-2)
+
+				_, err = querier.SigningSessions(sdk.WrapSDKContext(ctx), &types.SigningSessionsRequest{State: multisig.MultisigState(10)})
+				assert.Error(t, err)
+			}),
+
+			Then("should paginate signing sessions", func(t *testing.T) {
+				var signingSessions []types.SigningSessionInfo
+
+				pageRequest := &query.PageRequest{Limit: 2}
+				for {
+					res, err := querier.SigningSessions(sdk.WrapSDKContext(ctx), &types.SigningSessionsRequest{Module: "evm", Pagination: pageRequest})
+					assert.NoError(t, err)
+					assert.LessOrEqual(t, len(res.SigningSessions), 2)
+
+					signingSessions = append(signingSessions, res.SigningSessions...)
+					if len(res.Pagination.NextKey) == 0 {
+						break
+					}
+
+					pageRequest = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+				}
+
+				assert.ElementsMatch(t, evmSigIDs, signingSessionIDs(signingSessions))
+			}),
+		).
+		Run(t)
+}
+
+func TestValidatorSigningStats(t *testing.T) {
+	var (
+		k       keeper.Keeper
+		ctx     sdk.Context
+		querier keeper.Querier
+		key     types.Key
+		signer  sdk.ValAddress
+	)
+
+	Given("a multisig querier", func() {
+		encCfg := app.MakeEncodingConfig()
+		subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "multisig")
+		k = keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+		ctx = rand.Context(fake.NewMultiStore(), t)
+		querier = keeper.NewGRPCQuerier(k, &mock.StakerMock{})
+	}).
+		When("signing participation is recorded", func() {
+			key = typesTestutils.Key()
+			signer = key.GetParticipants()[0]
+
+			for i := 0; i < 3; i++ {
+				signing := types.NewSigningSession(uint64(i), key, rand.Bytes(multisig.HashLength), ctx.BlockHeight()+10, 1, "evm")
+				if i > 0 {
+					signing.MultiSig.Sigs = map[string]types.Signature{signer.String(): rand.Bytes(64)}
+				}
+
+				k.RecordSigningParticipation(ctx, signing)
+			}
+		}).
+		Branch(
+			Then("should return the signing stats of a participant", func(t *testing.T) {
+				res, err := querier.ValidatorSigningStats(sdk.WrapSDKContext(ctx), &types.ValidatorSigningStatsRequest{Validator: signer.String()})
+				assert.NoError(t, err)
+
+				assert.Equal(t, types.SigningParticipationWindow, res.Window)
+				assert.EqualValues(t, 3, res.SessionCount)
+				assert.EqualValues(t, 2, res.SignedCount)
+				assert.EqualValues(t, 1, res.MissedCount)
+
+				res, err = querier.ValidatorSigningStats(sdk.WrapSDKContext(ctx), &types.ValidatorSigningStatsRequest{Validator: key.GetParticipants()[1].String()})
+				assert.NoError(t, err)
+				assert.EqualValues(t, 3, res.MissedCount)
+			}),
+
+			Then("should return empty stats for validators without signing sessions", func(t *testing.T) {
+				res, err := querier.ValidatorSigningStats(sdk.WrapSDKContext(ctx), &types.ValidatorSigningStatsRequest{Validator: rand.ValAddr().String()})
+				assert.NoError(t, err)
+
+				assert.Zero(t, res.SessionCount)
+				assert.Zero(t, res.MissedCount)
+			}),
+
+			Then("should fail for invalid validator addresses", func(t *testing.T) {
+				_, err := querier.ValidatorSigningStats(sdk.WrapSDKContext(ctx), &types.ValidatorSigningStatsRequest{Validator: rand.StrBetween(5, 10)})
+
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, s.Code())
+			}),
+		).
+		Run(t)
+}
+
+func signingSessionIDs(signingSessions []types.SigningSessionInfo) []uint64 {
+	return slices.Map(signingSessions, func(signing types.SigningSessionInfo) uint64 { return signing.ID })
+}
@@ -24,7 +24,8 @@ var (
 	keyRotationCountPrefix = utils.KeyFromInt(7)
 	signingSessionCountKey = utils.KeyFromInt(100)
 
-	keygenOptOutPrefix = key.RegisterStaticKey(types.ModuleName, 8)
+	keygenOptOutPrefix         = key.RegisterStaticKey(types.ModuleName, 8)
+	signingParticipationPrefix = key.RegisterStaticKey(types.ModuleName, 9)
 )
 
 var _ types.Keeper = &Keeper{}
@@ -99,7 +99,7 @@ func (k Keeper) HasOptedOut(ctx sdk.Context, participant sdk.AccAddress) bool {
 	return k.getStore(ctx).HasNew(keygenOptOutPrefix.Append(key.FromBz(participant)))
 }
 
-func (k Keeper) createKeygenSession(ctx sdk.Context, id exported.KeyID, snapshot snapshot.Snapshot) error {
+func (k Keeper) createKeygenSession(ctx sdk.Context, id exported.KeyID, keyType exported.KeyType, snapshot snapshot.Snapshot) error {
 	if _, ok := k.getKeygenSession(ctx, id); ok {
 		return fmt.Errorf("key %s already being generated", id)
 	}
@@ -111,7 +111,7 @@ func (k Keeper) createKeygenSession(ctx sdk.Context, id exported.KeyID, snapshot
 	params := k.GetParams(ctx)
 
 	expiresAt := ctx.BlockHeight() + params.KeygenTimeout
-	keygenSession := types.NewKeygenSession(id, params.KeygenThreshold, params.SigningThreshold, snapshot, expiresAt, params.KeygenGracePeriod)
+	keygenSession := types.NewKeygenSession(id, keyType, params.KeygenThreshold, params.SigningThreshold, snapshot, expiresAt, params.KeygenGracePeriod)
 	if err := keygenSession.ValidateBasic(); err != nil {
 		return err
 	}
@@ -119,10 +119,11 @@ func (k Keeper) createKeygenSession(ctx sdk.Context, id exported.KeyID, snapshot
 	k.setKeygenSession(ctx, keygenSession)
 
 	participants := snapshot.GetParticipantAddresses()
-	events.Emit(ctx, types.NewKeygenStarted(id, participants))
+	events.Emit(ctx, types.NewKeygenStarted(id, keyType, participants))
 
 	k.Logger(ctx).Info("keygen session started",
 		"key_id", id,
+		"key_type", keyType.String(),
 		"participant_count", len(participants),
 		"participants", strings.Join(slices.Map(participants, sdk.ValAddress.String), ", "),
 		"participants_weight", snapshot.GetParticipantsWeight().String(),
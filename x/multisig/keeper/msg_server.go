@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/axelarnetwork/axelar-core/utils/events"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 )
 
@@ -40,7 +41,7 @@ func (s msgServer) StartKeygen(c context.Context, req *types.StartKeygenRequest)
 		return nil, errorsmod.Wrap(err, "unable to create snapshot for keygen")
 	}
 
-	err = s.createKeygenSession(ctx, req.KeyID, snap)
+	err = s.createKeygenSession(ctx, req.KeyID, req.KeyType, snap)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "unable to start keygen")
 	}
@@ -128,7 +129,8 @@ func (s msgServer) SubmitSignature(c context.Context, req *types.SubmitSignature
 func (s msgServer) RotateKey(c context.Context, req *types.RotateKeyRequest) (*types.RotateKeyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if _, ok := s.nexus.GetChain(ctx, req.Chain); !ok {
+	chain, ok := s.nexus.GetChain(ctx, req.Chain)
+	if !ok {
 		return nil, fmt.Errorf("unknown chain")
 	}
 
@@ -136,6 +138,17 @@ func (s msgServer) RotateKey(c context.Context, req *types.RotateKeyRequest) (*t
 		return nil, fmt.Errorf("manual key rotation is only allowed when no key is active")
 	}
 
+	if chain.IsFrom(evmtypes.ModuleName) {
+		key, ok := s.GetKey(ctx, req.KeyID)
+		if !ok {
+			return nil, fmt.Errorf("key %s not found", req.KeyID)
+		}
+
+		if err := evmtypes.ValidateMultisigKeyType(key); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.AssignKey(ctx, req.Chain, req.KeyID); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to assign the next key")
 	}
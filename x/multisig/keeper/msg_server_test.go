@@ -16,6 +16,7 @@ import (
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/utils"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	exportedmock "github.com/axelarnetwork/axelar-core/x/multisig/exported/mock"
 	"github.com/axelarnetwork/axelar-core/x/multisig/keeper"
@@ -67,7 +68,7 @@ func TestMsgServer(t *testing.T) {
 	})
 	keySessionExists := When("a key session exists", func() {
 		keyID = exported.KeyID(rand.HexStr(5))
-		_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand2.AccAddr(), keyID))
+		_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand2.AccAddr(), keyID, exported.ECDSA))
 		expiresAt = ctx.BlockHeight() + 20 // KeygenTimeout from custom params
 
 		assert.NoError(t, err)
@@ -132,7 +133,7 @@ func TestMsgServer(t *testing.T) {
 						}
 					}).
 					Then("keygen fails", func(t *testing.T) {
-						req := types.NewStartKeygenRequest(rand2.AccAddr(), exported.KeyID(rand.HexStr(5)))
+						req := types.NewStartKeygenRequest(rand2.AccAddr(), exported.KeyID(rand.HexStr(5)), exported.ECDSA)
 						_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), req)
 						assert.Error(t, err)
 					}),
@@ -140,7 +141,7 @@ func TestMsgServer(t *testing.T) {
 				whenSenderIsProxy.
 					When2(keySessionExists).
 					Then("keygen with same KeyID fails", func(t *testing.T) {
-						req := types.NewStartKeygenRequest(rand2.AccAddr(), keyID)
+						req := types.NewStartKeygenRequest(rand2.AccAddr(), keyID, exported.ECDSA)
 						_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), req)
 						assert.Error(t, err)
 					}),
@@ -154,7 +155,7 @@ func TestMsgServer(t *testing.T) {
 						})
 					}).
 					Then("keygen with same KeyID fails", func(t *testing.T) {
-						req := types.NewStartKeygenRequest(rand2.AccAddr(), keyID)
+						req := types.NewStartKeygenRequest(rand2.AccAddr(), keyID, exported.ECDSA)
 						_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), req)
 						assert.Error(t, err)
 					}),
@@ -199,7 +200,7 @@ func TestMsgServer(t *testing.T) {
 				}).
 					When("a key session exists", func() {
 						keyID = exported.KeyID(rand.HexStr(5))
-						_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand2.AccAddr(), keyID))
+						_, err := msgServer.StartKeygen(sdk.WrapSDKContext(ctx), types.NewStartKeygenRequest(rand2.AccAddr(), keyID, exported.ECDSA))
 						expiresAt = ctx.BlockHeight() + 10 // KeygenTimeout
 						assert.NoError(t, err)
 					}).
@@ -296,13 +297,13 @@ func TestMsgServer(t *testing.T) {
 				}),
 
 				Then("should fail if the key does not exist", func(t *testing.T) {
-					err := k.Sign(ctx, exported.KeyID(rand.HexStr(5)), rand.Bytes(exported.HashLength), module)
+					_, err := k.Sign(ctx, exported.KeyID(rand.HexStr(5)), rand.Bytes(exported.HashLength), module)
 
 					assert.Error(t, err)
 				}),
 
 				Then("should fail if the key is inactive", func(t *testing.T) {
-					err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
+					_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
 
 					assert.ErrorContains(t, err, "not activated")
 				}),
@@ -311,7 +312,7 @@ func TestMsgServer(t *testing.T) {
 					key.State = exported.Assigned
 					k.SetKey(ctx, key)
 
-					err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
+					_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
 
 					assert.ErrorContains(t, err, "not activated")
 				}),
@@ -321,16 +322,16 @@ func TestMsgServer(t *testing.T) {
 					k.SetKey(ctx, key)
 				}).Branch(
 					Then("should fail if payload hash is invalid", func(t *testing.T) {
-						err := k.Sign(ctx, keyID, rand.Bytes(100), module)
+						_, err := k.Sign(ctx, keyID, rand.Bytes(100), module)
 						assert.Error(t, err)
 
 						var zeroHash [exported.HashLength]byte
-						err = k.Sign(ctx, keyID, zeroHash[:], module)
+						_, err = k.Sign(ctx, keyID, zeroHash[:], module)
 						assert.Error(t, err)
 					}),
 
 					Then("should start signing if the key exists", func(t *testing.T) {
-						err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
+						_, err := k.Sign(ctx, keyID, rand.Bytes(exported.HashLength), module)
 
 						assert.NoError(t, err)
 						assert.Len(t, k.GetSigningSessionsByExpiry(ctx, ctx.BlockHeight()+20), 1) // SigningTimeout=20
@@ -338,7 +339,7 @@ func TestMsgServer(t *testing.T) {
 
 					When("signing session exists", func() {
 						payloadHash = rand.Bytes(exported.HashLength)
-						funcs.MustNoErr(k.Sign(ctx, keyID, payloadHash, module))
+						funcs.Must(k.Sign(ctx, keyID, payloadHash, module))
 
 						events := ctx.EventManager().Events().ToABCIEvents()
 						sigID = funcs.Must(sdk.ParseTypedEvent(events[len(events)-1])).(*types.SigningStarted).SigID
@@ -425,6 +426,33 @@ func TestMsgServer(t *testing.T) {
 						_, err = msgServer.RotateKey(sdk.WrapSDKContext(ctx), types.NewRotateKeyRequest(rand2.AccAddr(), chain, keyID))
 						assert.Error(t, err)
 					}),
+
+				When("chain is an EVM chain", func() {
+					chain = nexus.ChainName(rand.AlphaStrBetween(1, 5))
+					nexusK.GetChainFunc = func(ctx sdk.Context, cn nexus.ChainName) (nexus.Chain, bool) {
+						return nexus.Chain{Name: chain, Module: evmtypes.ModuleName}, cn == chain
+					}
+				}).
+					Branch(
+						When("key is not an ECDSA key", func() {
+							key := funcs.MustOk(k.GetKey(ctx, keyID)).(*types.Key)
+							key.KeyType = exported.Ed25519
+							k.SetKey(ctx, *key)
+						}).
+							Then("should fail", func(t *testing.T) {
+								_, err := msgServer.RotateKey(sdk.WrapSDKContext(ctx), types.NewRotateKeyRequest(rand2.AccAddr(), chain, keyID))
+								assert.ErrorContains(t, err, "EVM chains require")
+
+								_, ok := k.GetCurrentKeyID(ctx, chain)
+								assert.False(t, ok)
+							}),
+
+						When("key is an ECDSA key", func() {}).
+							Then("should succeed", func(t *testing.T) {
+								_, err := msgServer.RotateKey(sdk.WrapSDKContext(ctx), types.NewRotateKeyRequest(rand2.AccAddr(), chain, keyID))
+								assert.NoError(t, err)
+							}),
+					),
 			).
 			Run(t)
 	})
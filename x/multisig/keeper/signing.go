@@ -4,14 +4,18 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	gogoprototypes "github.com/gogo/protobuf/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
 	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/utils/key"
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
+	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/math"
 	"github.com/axelarnetwork/utils/slices"
 )
@@ -63,18 +67,18 @@ func (k Keeper) GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []type
 }
 
 // Sign starts a signing session to sign the given payload's hash with the given
-// key ID
-func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) error {
+// key ID, and returns the ID of the signing session
+func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported.Hash, module string, moduleMetadata ...codec.ProtoMarshaler) (uint64, error) {
 	if !k.GetSigRouter().HasHandler(module) {
 		panic(fmt.Errorf("sig handler not registered for module %s", module))
 	}
 
 	key, ok := k.getKey(ctx, keyID)
 	if !ok {
-		return fmt.Errorf("key %s not found", keyID)
+		return 0, fmt.Errorf("key %s not found", keyID)
 	}
 	if key.State != exported.Active {
-		return fmt.Errorf("key %s is not activated yet", keyID)
+		return 0, fmt.Errorf("key %s is not activated yet", keyID)
 	}
 
 	params := k.GetParams(ctx)
@@ -82,7 +86,7 @@ func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported
 	expiresAt := ctx.BlockHeight() + params.SigningTimeout
 	signingSession := types.NewSigningSession(k.nextSigID(ctx), key, payloadHash, expiresAt, params.SigningGracePeriod, module, moduleMetadata...)
 	if err := signingSession.ValidateBasic(); err != nil {
-		return err
+		return 0, err
 	}
 
 	k.setSigningSession(ctx, signingSession)
@@ -99,7 +103,7 @@ func (k Keeper) Sign(ctx sdk.Context, keyID exported.KeyID, payloadHash exported
 		"expires_at", expiresAt,
 	)
 
-	return nil
+	return signingSession.GetID(), nil
 }
 
 // DeleteSigningSession deletes the signing session with the given ID
@@ -121,6 +125,86 @@ func (k Keeper) setSigningSession(ctx sdk.Context, signing types.SigningSession)
 	k.getStore(ctx).Set(getSigningSessionKey(signing.GetID()), &signing)
 }
 
+// GetSigningSession returns the signing session with the given ID, if it still exists
+func (k Keeper) GetSigningSession(ctx sdk.Context, id uint64) (types.SigningSession, bool) {
+	return k.getSigningSession(ctx, id)
+}
+
+// GetSigningSessionsPaginated returns the signing sessions that have not been handled yet and match the given filter with the given pagination properties
+func (k Keeper) GetSigningSessionsPaginated(ctx sdk.Context, filter types.SigningSessionFilter, pageRequest *query.PageRequest) ([]types.SigningSession, *query.PageResponse, error) {
+	var signingSessions []types.SigningSession
+
+	store := prefix.NewStore(k.getStore(ctx).KVStore, append(signingPrefix.AsKey(), []byte(utils.DefaultDelimiter)...))
+	resp, err := query.FilteredPaginate(store, pageRequest, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var signing types.SigningSession
+		k.cdc.MustUnmarshalLengthPrefixed(value, &signing)
+
+		if !filter.Matches(signing) {
+			return false, nil
+		}
+
+		if accumulate {
+			signingSessions = append(signingSessions, signing)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signingSessions, resp, nil
+}
+
+// RecordSigningParticipation records for every participant of the given signing session whether it submitted its signature
+func (k Keeper) RecordSigningParticipation(ctx sdk.Context, signing types.SigningSession) {
+	for _, participant := range signing.Key.GetParticipants() {
+		_, signed := signing.MultiSig.Sigs[participant.String()]
+
+		participation := k.GetSigningParticipation(ctx, participant)
+		participation.Record(signed)
+
+		k.setSigningParticipation(ctx, participation)
+	}
+}
+
+func (k Keeper) setSigningParticipation(ctx sdk.Context, participation types.SigningParticipation) {
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getSigningParticipationKey(participation.Validator), &participation))
+}
+
+func (k Keeper) getSigningParticipations(ctx sdk.Context) (participations []types.SigningParticipation) {
+	iter := k.getStore(ctx).IteratorNew(signingParticipationPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var participation types.SigningParticipation
+		iter.UnmarshalValue(&participation)
+
+		participations = append(participations, participation)
+	}
+
+	return participations
+}
+
+// GetSigningParticipation returns the participation of the given validator in its most recent signing sessions
+func (k Keeper) GetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress) types.SigningParticipation {
+	var participation types.SigningParticipation
+	if !k.getStore(ctx).GetNew(getSigningParticipationKey(validator), &participation) {
+		return types.NewSigningParticipation(validator)
+	}
+
+	return participation
+}
+
+// ResetSigningParticipation discards the recorded signing participation of the given validator
+func (k Keeper) ResetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress) {
+	k.getStore(ctx).DeleteNew(getSigningParticipationKey(validator))
+}
+
+func getSigningParticipationKey(validator sdk.ValAddress) key.Key {
+	return signingParticipationPrefix.Append(key.FromBz(validator))
+}
+
 func (k Keeper) getSigningSession(ctx sdk.Context, id uint64) (signing types.SigningSession, ok bool) {
 	return signing, k.getStore(ctx).Get(getSigningSessionKey(id), &signing)
 }
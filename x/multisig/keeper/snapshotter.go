@@ -83,5 +83,5 @@ func (sc SnapshotCreator) CreateSnapshot(ctx sdk.Context, threshold utils.Thresh
 		}
 		candidates = append(candidates, valAddress)
 	})
-	return sc.snapshotter.CreateSnapshot(ctx, candidates, filter, snapshot.QuadraticWeightFunc, threshold)
+	return sc.snapshotter.CreateWeightedSnapshot(ctx, candidates, filter, threshold)
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
@@ -79,12 +78,11 @@ func TestSnapshotCreator_CreateSnapshot(t *testing.T) {
 
 	creator := keeper.NewSnapshotCreator(keygen, snapshotter, staker, slasher)
 
-	snapshotter.CreateSnapshotFunc =
+	snapshotter.CreateWeightedSnapshotFunc =
 		func(
 			_ sdk.Context,
 			candidates []sdk.ValAddress,
 			filterFunc func(snapshot.ValidatorI) bool,
-			weightFunc func(consensusPower math.Uint) math.Uint,
 			threshold utils.Threshold,
 		) (snapshot.Snapshot, error) {
 			assert.ElementsMatch(t,
@@ -97,11 +95,6 @@ func TestSnapshotCreator_CreateSnapshot(t *testing.T) {
 			assert.False(t, filterFunc(inactiveVal))
 			assert.True(t, filterFunc(activeVal))
 
-			assert.True(t, math.NewUint(5).Equal(weightFunc(math.NewUint(25))))
-			assert.True(t, math.NewUint(6).Equal(weightFunc(math.NewUint(36))))
-			assert.True(t, math.NewUint(16).Equal(weightFunc(math.NewUint(256))))
-			assert.True(t, math.NewUint(9).Equal(weightFunc(math.NewUint(99))))
-
 			assert.Equal(t, expectedThreshold, threshold)
 
 			return snapshot.Snapshot{}, nil
@@ -8,11 +8,12 @@ import (
 )
 
 // NewKeygenStarted is the constructor for event keygen started
-func NewKeygenStarted(keyID exported.KeyID, participants []sdk.ValAddress) *KeygenStarted {
+func NewKeygenStarted(keyID exported.KeyID, keyType exported.KeyType, participants []sdk.ValAddress) *KeygenStarted {
 	return &KeygenStarted{
 		Module:       ModuleName,
 		KeyID:        keyID,
 		Participants: participants,
+		KeyType:      keyType,
 	}
 }
 
@@ -51,6 +52,7 @@ func NewSigningStarted(sigID uint64, key Key, payloadHash exported.Hash, request
 		PubKeys:          key.GetPubKeys(),
 		PayloadHash:      payloadHash,
 		RequestingModule: requestingModule,
+		KeyType:          key.GetKeyType(),
 	}
 }
 
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,7 @@ type KeygenStarted struct {
 	Module       string                                                         `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	KeyID        github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	Participants []github_com_cosmos_cosmos_sdk_types.ValAddress                `protobuf:"bytes,3,rep,name=participants,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"participants,omitempty"`
+	KeyType      exported.KeyType                                               `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *KeygenStarted) Reset()         { *m = KeygenStarted{} }
@@ -87,6 +89,13 @@ func (m *KeygenStarted) GetParticipants() []github_com_cosmos_cosmos_sdk_types.V
 	return nil
 }
 
+func (m *KeygenStarted) GetKeyType() exported.KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return exported.ECDSA
+}
+
 type KeygenCompleted struct {
 	Module string                                                         `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	KeyID  github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
@@ -274,6 +283,7 @@ type SigningStarted struct {
 	PubKeys          map[string]github_com_axelarnetwork_axelar_core_x_multisig_exported.PublicKey `protobuf:"bytes,4,rep,name=pub_keys,json=pubKeys,proto3,castvalue=github.com/axelarnetwork/axelar-core/x/multisig/exported.PublicKey" json:"pub_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PayloadHash      github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash                 `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.Hash" json:"payload_hash,omitempty"`
 	RequestingModule string                                                                        `protobuf:"bytes,6,opt,name=requesting_module,json=requestingModule,proto3" json:"requesting_module,omitempty"`
+	KeyType          exported.KeyType                                                              `protobuf:"varint,7,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *SigningStarted) Reset()         { *m = SigningStarted{} }
@@ -347,6 +357,13 @@ func (m *SigningStarted) GetRequestingModule() string {
 	return ""
 }
 
+func (m *SigningStarted) GetKeyType() exported.KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return exported.ECDSA
+}
+
 type SigningCompleted struct {
 	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
 	SigID  uint64 `protobuf:"varint,2,opt,name=sig_id,json=sigId,proto3" json:"sig_id,omitempty"`
@@ -748,53 +765,56 @@ func init() {
 }
 
 var fileDescriptor_36b18b0391cba3fc = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xee, 0x36, 0x1f, 0x7d, 0xb3, 0x4d, 0xdf, 0xf6, 0xb5, 0xaa, 0x97, 0xa8, 0x87, 0x38, 0xb2,
-	0x38, 0x54, 0xaa, 0xea, 0xa8, 0x7c, 0x48, 0x28, 0x12, 0xa0, 0xa4, 0xb4, 0x22, 0x04, 0x68, 0x95,
-	0x08, 0x24, 0xb8, 0x44, 0x6b, 0x7b, 0xe5, 0xac, 0x62, 0x7b, 0x8d, 0x77, 0x5d, 0xe2, 0x7f, 0x80,
-	0x38, 0x81, 0x38, 0xc0, 0x85, 0x03, 0xfc, 0x15, 0x2e, 0x1c, 0x7b, 0xe4, 0x64, 0x50, 0xc2, 0xaf,
-	0xc8, 0x09, 0xad, 0xed, 0x36, 0x29, 0x02, 0x4c, 0x2b, 0x4a, 0x11, 0xa7, 0xec, 0xec, 0x8e, 0x9e,
-	0x99, 0x79, 0xe6, 0x99, 0x89, 0xe1, 0x79, 0x34, 0xc0, 0x16, 0xf2, 0xaa, 0xb6, 0x6f, 0x71, 0xc2,
-	0x88, 0x59, 0xdd, 0xdb, 0xd0, 0x30, 0x47, 0x1b, 0x55, 0xbc, 0x87, 0x1d, 0xce, 0x54, 0xd7, 0xa3,
-	0x9c, 0x4a, 0xe7, 0x62, 0x2f, 0xf5, 0xc0, 0x4b, 0x4d, 0xbc, 0x56, 0x96, 0x4d, 0x6a, 0xd2, 0xc8,
-	0xa7, 0x2a, 0x4e, 0xb1, 0xbb, 0x32, 0x04, 0x70, 0xa1, 0x85, 0x03, 0x13, 0x3b, 0x1d, 0x8e, 0x3c,
-	0x8e, 0x0d, 0xe9, 0x7f, 0x98, 0xb7, 0xa9, 0xe1, 0x5b, 0xb8, 0x04, 0x2a, 0x60, 0xb5, 0xd0, 0x4e,
-	0x2c, 0x49, 0x83, 0xf9, 0x3e, 0x0e, 0xba, 0xc4, 0x28, 0xcd, 0x8a, 0xfb, 0x46, 0x6b, 0x18, 0xca,
-	0xb9, 0x16, 0x0e, 0x9a, 0x37, 0xc6, 0xa1, 0x7c, 0xcd, 0x24, 0xbc, 0xe7, 0x6b, 0xaa, 0x4e, 0xed,
-	0x6a, 0x9c, 0x80, 0x83, 0xf9, 0x63, 0xea, 0xf5, 0x13, 0x6b, 0x5d, 0xa7, 0x1e, 0xae, 0x0e, 0x26,
-	0xb9, 0xe3, 0x81, 0x4b, 0x45, 0x38, 0x35, 0x42, 0x68, 0xe7, 0xfa, 0x38, 0x68, 0x1a, 0xd2, 0x3d,
-	0x58, 0x74, 0x91, 0xc7, 0x89, 0x4e, 0x5c, 0xe4, 0x70, 0x56, 0xca, 0x54, 0x32, 0xab, 0xc5, 0xc6,
-	0xc6, 0x38, 0x94, 0xd7, 0xa7, 0x02, 0xe8, 0x94, 0xd9, 0x94, 0x25, 0x3f, 0xeb, 0xcc, 0xe8, 0x57,
-	0x79, 0xe0, 0x62, 0xa6, 0xde, 0x47, 0x56, 0xdd, 0x30, 0x3c, 0xcc, 0x58, 0xfb, 0x08, 0x8c, 0xf2,
-	0x02, 0xc0, 0xc5, 0xb8, 0xc8, 0x4d, 0x6a, 0xbb, 0x16, 0x3e, 0xe3, 0x32, 0x6b, 0xd9, 0x27, 0x6f,
-	0x65, 0xa0, 0x3c, 0x3f, 0xa4, 0x7e, 0x6b, 0xe0, 0x12, 0xef, 0x8f, 0xc8, 0xe9, 0xdd, 0x2c, 0x5c,
-	0xdc, 0xf5, 0xb5, 0x16, 0x0e, 0x3a, 0xbe, 0x66, 0x13, 0x7e, 0xd6, 0x82, 0xe8, 0xc0, 0xf9, 0xa9,
-	0x4e, 0x96, 0x32, 0x15, 0x70, 0x32, 0x3d, 0x4c, 0xa3, 0x48, 0x5d, 0x38, 0xe7, 0xfa, 0x5a, 0xb7,
-	0x8f, 0x83, 0x52, 0x36, 0x02, 0xdc, 0x1e, 0x87, 0x72, 0xe3, 0xc4, 0x09, 0xef, 0xfa, 0x9a, 0x45,
-	0xf4, 0x16, 0x0e, 0xda, 0x79, 0x37, 0xa2, 0x4e, 0x79, 0x9d, 0x85, 0xff, 0x76, 0x88, 0xe9, 0x10,
-	0xc7, 0x4c, 0x9b, 0xaa, 0x0a, 0xcc, 0x33, 0x62, 0x1e, 0x90, 0x98, 0x6d, 0x14, 0x04, 0x89, 0x1d,
-	0x62, 0x0a, 0x0a, 0x18, 0x31, 0x9b, 0xc6, 0x14, 0xcd, 0x99, 0x53, 0xa3, 0xf9, 0x25, 0x80, 0xff,
-	0x24, 0x94, 0xb0, 0x52, 0xb6, 0x92, 0x59, 0x9d, 0xbf, 0x70, 0x49, 0xfd, 0xce, 0x22, 0x51, 0x8f,
-	0x56, 0xa6, 0xc6, 0x72, 0x61, 0x5b, 0x0e, 0xf7, 0x82, 0xc6, 0xf6, 0xd3, 0x8f, 0xbf, 0x84, 0xc9,
-	0xb9, 0x98, 0x49, 0x26, 0x19, 0x62, 0x23, 0x04, 0x16, 0x45, 0x46, 0xb7, 0x87, 0x58, 0xaf, 0x94,
-	0x8b, 0x1a, 0x56, 0x1f, 0x87, 0xf2, 0xd5, 0x13, 0x87, 0xb9, 0x89, 0x58, 0x4f, 0x28, 0x22, 0x82,
-	0x15, 0x86, 0xb4, 0x06, 0xff, 0xf3, 0xf0, 0x23, 0x1f, 0x33, 0x4e, 0x1c, 0xb3, 0x9b, 0x34, 0x2a,
-	0x1f, 0x35, 0x6a, 0x69, 0xf2, 0x70, 0x27, 0xba, 0x5f, 0xa9, 0xc1, 0xe2, 0x74, 0xcd, 0xd2, 0x12,
-	0xcc, 0x08, 0x29, 0xc5, 0x7d, 0x15, 0x47, 0x69, 0x19, 0xe6, 0xf6, 0x90, 0xe5, 0xe3, 0xa8, 0xa7,
-	0xc5, 0x76, 0x6c, 0xd4, 0x66, 0xaf, 0x80, 0x5a, 0xf6, 0xd5, 0x1b, 0x19, 0x28, 0xb7, 0xe1, 0x52,
-	0x42, 0x62, 0xfa, 0x3e, 0x4a, 0x15, 0x88, 0x72, 0xeb, 0x50, 0x6c, 0x69, 0x7b, 0x24, 0x1d, 0x6b,
-	0x1f, 0x40, 0x49, 0x80, 0x21, 0xee, 0x7b, 0x38, 0x7d, 0x05, 0xa4, 0xab, 0xf7, 0x54, 0x06, 0x78,
-	0x0d, 0x16, 0xd8, 0x41, 0x92, 0xc9, 0x08, 0x2f, 0x8c, 0x43, 0xb9, 0x70, 0x98, 0x79, 0x7b, 0xf2,
-	0xae, 0x7c, 0x06, 0x70, 0xbe, 0x85, 0x83, 0x3a, 0x13, 0x57, 0x3f, 0xa8, 0xe5, 0x01, 0xcc, 0xe9,
-	0x3d, 0x44, 0x9c, 0x64, 0x9b, 0x6d, 0x8e, 0x43, 0xf9, 0xfa, 0x4f, 0x4a, 0xcc, 0xc1, 0x03, 0x9f,
-	0x4d, 0xf4, 0xb5, 0x29, 0x60, 0xee, 0x22, 0x1b, 0xb7, 0x63, 0xc4, 0xdf, 0x31, 0xc2, 0xca, 0x08,
-	0x40, 0x28, 0x26, 0x87, 0x72, 0xc4, 0xff, 0xde, 0x2a, 0x75, 0x58, 0x8c, 0xff, 0x32, 0x77, 0x5c,
-	0xbe, 0xe3, 0xf3, 0xaf, 0xe5, 0x05, 0x8e, 0x25, 0xaf, 0xba, 0xae, 0x7f, 0x4b, 0x5e, 0x8a, 0x16,
-	0x09, 0x26, 0x0e, 0xd2, 0x74, 0x4e, 0x25, 0x46, 0x63, 0xe7, 0xfd, 0xb0, 0x0c, 0xf6, 0x87, 0x65,
-	0xf0, 0x69, 0x58, 0x06, 0xcf, 0x46, 0xe5, 0x99, 0xfd, 0x51, 0x79, 0xe6, 0xc3, 0xa8, 0x3c, 0xf3,
-	0xf0, 0xf2, 0x71, 0x99, 0x8a, 0x02, 0x69, 0xf9, 0xe8, 0x7b, 0xee, 0xe2, 0x97, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xbb, 0x0a, 0xb6, 0x26, 0x0a, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x48,
+	0x18, 0xee, 0xe4, 0xb3, 0x99, 0xa4, 0x1f, 0x6b, 0x55, 0xbb, 0x51, 0x0f, 0x71, 0x64, 0xed, 0x21,
+	0xab, 0x6e, 0x1d, 0xb5, 0xbb, 0x2b, 0xad, 0x22, 0xed, 0xae, 0x92, 0x7e, 0x68, 0x43, 0x80, 0x56,
+	0x0e, 0x20, 0xc1, 0x25, 0x1a, 0xdb, 0x23, 0x67, 0x14, 0xc7, 0x36, 0x9e, 0x71, 0x89, 0xff, 0x01,
+	0xe2, 0x04, 0xe2, 0x00, 0x57, 0x38, 0xf1, 0x3f, 0xb8, 0x70, 0xec, 0x91, 0x53, 0x40, 0x09, 0xbf,
+	0x22, 0x27, 0x34, 0xb6, 0x93, 0xa6, 0xe5, 0x23, 0x34, 0xa2, 0x14, 0x71, 0xca, 0x8c, 0xfd, 0xea,
+	0x79, 0x9f, 0x79, 0xde, 0x67, 0x9e, 0x18, 0xfe, 0x8a, 0x7a, 0xd8, 0x44, 0x6e, 0xb9, 0xeb, 0x99,
+	0x8c, 0x50, 0x62, 0x94, 0x8f, 0xb6, 0x54, 0xcc, 0xd0, 0x56, 0x19, 0x1f, 0x61, 0x8b, 0x51, 0xd9,
+	0x71, 0x6d, 0x66, 0x0b, 0xbf, 0x84, 0x55, 0xf2, 0xb8, 0x4a, 0x8e, 0xaa, 0xd6, 0xd7, 0x0c, 0xdb,
+	0xb0, 0x83, 0x9a, 0x32, 0x5f, 0x85, 0xe5, 0xeb, 0xbf, 0x9f, 0x05, 0xc5, 0x3d, 0xc7, 0x76, 0x19,
+	0xd6, 0x27, 0xe8, 0xcc, 0x77, 0x70, 0x04, 0x2e, 0xbd, 0x88, 0xc1, 0xa5, 0x06, 0xf6, 0x0d, 0x6c,
+	0x35, 0x19, 0xe2, 0x55, 0xc2, 0xcf, 0x30, 0xd5, 0xb5, 0x75, 0xcf, 0xc4, 0x79, 0x50, 0x04, 0xa5,
+	0x8c, 0x12, 0xed, 0x04, 0x15, 0xa6, 0x3a, 0xd8, 0x6f, 0x11, 0x3d, 0x1f, 0xe3, 0xcf, 0x6b, 0x8d,
+	0x41, 0x5f, 0x4c, 0x36, 0xb0, 0x5f, 0xdf, 0x1d, 0xf5, 0xc5, 0x7f, 0x0d, 0xc2, 0xda, 0x9e, 0x2a,
+	0x6b, 0x76, 0xb7, 0x1c, 0xf6, 0xb7, 0x30, 0xbb, 0x67, 0xbb, 0x9d, 0x68, 0xb7, 0xa9, 0xd9, 0x2e,
+	0x2e, 0xf7, 0x3e, 0x24, 0x25, 0x07, 0x08, 0x4a, 0xb2, 0x83, 0xfd, 0xba, 0x2e, 0xdc, 0x84, 0x39,
+	0x07, 0xb9, 0x8c, 0x68, 0xc4, 0x41, 0x16, 0xa3, 0xf9, 0x78, 0x31, 0x5e, 0xca, 0xd5, 0xb6, 0x46,
+	0x7d, 0x71, 0x73, 0xaa, 0x81, 0x66, 0xd3, 0xae, 0x4d, 0xa3, 0x9f, 0x4d, 0xaa, 0x77, 0xa2, 0x13,
+	0xdd, 0x42, 0x66, 0x55, 0xd7, 0x5d, 0x4c, 0xa9, 0x72, 0x0a, 0x46, 0xd8, 0x85, 0x8b, 0x9c, 0x3a,
+	0xaf, 0xca, 0x27, 0x8a, 0xa0, 0xb4, 0xbc, 0xfd, 0x9b, 0x7c, 0x56, 0xd4, 0x09, 0xa1, 0x48, 0x25,
+	0x4e, 0xec, 0x86, 0xef, 0x60, 0x25, 0xdd, 0x09, 0x17, 0xd2, 0x63, 0x00, 0x57, 0x42, 0xa9, 0x76,
+	0xec, 0xae, 0x63, 0xe2, 0x4b, 0x16, 0xab, 0x92, 0xb8, 0xff, 0x5c, 0x04, 0xd2, 0x23, 0x30, 0x1e,
+	0xe0, 0x5e, 0xcf, 0x21, 0xee, 0x77, 0xc1, 0xe9, 0x65, 0x0c, 0xae, 0x1c, 0x7a, 0x6a, 0x03, 0xfb,
+	0x4d, 0x4f, 0xed, 0x12, 0x76, 0xd9, 0xb6, 0x6a, 0xc2, 0xec, 0x94, 0x1f, 0xf2, 0xf1, 0x22, 0x98,
+	0xcf, 0x55, 0xd3, 0x28, 0x42, 0x0b, 0xa6, 0x1d, 0x4f, 0x6d, 0x75, 0xb0, 0x1f, 0x78, 0x2a, 0x57,
+	0xdb, 0x1f, 0xf5, 0xc5, 0xda, 0xdc, 0x84, 0x0f, 0x3d, 0xd5, 0x24, 0x5a, 0x03, 0xfb, 0x4a, 0xca,
+	0x09, 0xa4, 0x93, 0x86, 0x09, 0xb8, 0xdc, 0x24, 0x86, 0x45, 0x2c, 0x63, 0xd6, 0xdd, 0x2c, 0xc2,
+	0x14, 0x25, 0xc6, 0x58, 0xc4, 0x44, 0x2d, 0xc3, 0x45, 0x6c, 0x12, 0x83, 0x4b, 0x40, 0x89, 0x51,
+	0xd7, 0xa7, 0x64, 0x8e, 0x5f, 0x98, 0xcc, 0x4f, 0x00, 0x5c, 0x8c, 0x24, 0xa1, 0xf9, 0x44, 0x31,
+	0x5e, 0xca, 0x6e, 0xff, 0x29, 0x7f, 0x22, 0xbc, 0xe4, 0xd3, 0x27, 0x93, 0x43, 0xbb, 0xd0, 0x3d,
+	0x8b, 0xb9, 0x7e, 0x6d, 0xff, 0xc1, 0x9b, 0xaf, 0xa2, 0x64, 0x3a, 0x54, 0x92, 0x0a, 0x3a, 0xcf,
+	0x15, 0xdf, 0xb4, 0x91, 0xde, 0x6a, 0x23, 0xda, 0xce, 0x27, 0x83, 0x81, 0x55, 0x47, 0x7d, 0xf1,
+	0x9f, 0xb9, 0xdb, 0xfc, 0x8f, 0x68, 0x5b, 0xc9, 0x46, 0xb0, 0x7c, 0x23, 0x6c, 0xc0, 0x9f, 0x5c,
+	0x7c, 0xd7, 0xc3, 0x94, 0x11, 0xcb, 0x68, 0x45, 0x83, 0x4a, 0x05, 0x83, 0x5a, 0x3d, 0x79, 0x71,
+	0x2d, 0x1c, 0xd9, 0x74, 0x26, 0xa5, 0xe7, 0xcd, 0xa4, 0xf5, 0x0a, 0xcc, 0x4d, 0x2b, 0x27, 0xac,
+	0xc2, 0x38, 0x37, 0x64, 0xe8, 0x0e, 0xbe, 0x14, 0xd6, 0x60, 0xf2, 0x08, 0x99, 0x1e, 0x0e, 0x9c,
+	0x91, 0x53, 0xc2, 0x4d, 0x25, 0xf6, 0x37, 0xa8, 0x24, 0x9e, 0x3e, 0x13, 0x81, 0x74, 0x15, 0xae,
+	0x46, 0xa3, 0x98, 0x9d, 0x6a, 0x33, 0x6d, 0x26, 0x5d, 0x99, 0x58, 0x76, 0x56, 0x1a, 0xcd, 0xc6,
+	0x3a, 0x06, 0x50, 0xe0, 0x60, 0x88, 0x79, 0x2e, 0x9e, 0x1d, 0x24, 0xb3, 0xef, 0xc0, 0x85, 0xc4,
+	0xc0, 0x06, 0xcc, 0xd0, 0x31, 0xc9, 0x28, 0x08, 0x96, 0x46, 0x7d, 0x31, 0x33, 0x61, 0xae, 0x9c,
+	0xbc, 0x97, 0xde, 0x01, 0x98, 0x6d, 0x60, 0xbf, 0x4a, 0xf9, 0xa3, 0xcf, 0x9c, 0xe5, 0x36, 0x4c,
+	0x6a, 0x6d, 0x44, 0xac, 0x28, 0x13, 0x77, 0x46, 0x7d, 0xf1, 0xbf, 0x2f, 0x34, 0xaa, 0x85, 0x7b,
+	0x1e, 0x3d, 0x71, 0xe9, 0x0e, 0x87, 0xb9, 0x8e, 0xba, 0x58, 0x09, 0x11, 0xbf, 0x45, 0x10, 0x48,
+	0x43, 0x00, 0x21, 0xbf, 0x7f, 0x36, 0x43, 0xec, 0xc7, 0x3d, 0xa5, 0x06, 0x73, 0xe1, 0x1f, 0xef,
+	0x81, 0xc3, 0x0e, 0x3c, 0x76, 0xd6, 0x5e, 0xe0, 0x5c, 0xf6, 0xaa, 0x6a, 0xda, 0xc7, 0xec, 0x25,
+	0xa9, 0x30, 0x3b, 0x69, 0x52, 0xb7, 0x2e, 0xa4, 0x47, 0xed, 0xe0, 0xd5, 0xa0, 0x00, 0x8e, 0x07,
+	0x05, 0xf0, 0x76, 0x50, 0x00, 0x0f, 0x87, 0x85, 0x85, 0xe3, 0x61, 0x61, 0xe1, 0xf5, 0xb0, 0xb0,
+	0x70, 0xe7, 0xaf, 0xf3, 0x2a, 0x15, 0x34, 0x52, 0x53, 0xc1, 0xb7, 0xe5, 0x1f, 0xef, 0x07, 0x00,
+	0x1b, 0xfb, 0xc0, 0xe1, 0xe0, 0x0a, 0x00, 0x00,
 }
 
 func (m *KeygenStarted) Marshal() (dAtA []byte, err error) {
@@ -817,6 +837,11 @@ func (m *KeygenStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Participants) > 0 {
 		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Participants[iNdEx])
@@ -988,6 +1013,11 @@ func (m *SigningStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.RequestingModule) > 0 {
 		i -= len(m.RequestingModule)
 		copy(dAtA[i:], m.RequestingModule)
@@ -1348,6 +1378,9 @@ func (m *KeygenStarted) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovEvents(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -1447,6 +1480,9 @@ func (m *SigningStarted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovEvents(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -1705,6 +1741,25 @@ func (m *KeygenStarted) Unmarshal(dAtA []byte) error {
 			m.Participants = append(m.Participants, make([]byte, postIndex-iNdEx))
 			copy(m.Participants[len(m.Participants)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2442,6 +2497,25 @@ func (m *SigningStarted) Unmarshal(dAtA []byte) error {
 			}
 			m.RequestingModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
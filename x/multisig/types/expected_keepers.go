@@ -4,8 +4,8 @@ import (
 	"context"
 
 	"cosmossdk.io/log"
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
@@ -23,6 +23,7 @@ type Keeper interface {
 	GetParams(ctx sdk.Context) (params Params)
 	GetCurrentKeyID(ctx sdk.Context, chainName nexus.ChainName) (exported.KeyID, bool)
 	GetNextKeyID(ctx sdk.Context, chainName nexus.ChainName) (exported.KeyID, bool)
+	GetActiveKeyIDs(ctx sdk.Context, chainName nexus.ChainName) []exported.KeyID
 	GetKeygenSession(ctx sdk.Context, id exported.KeyID) (KeygenSession, bool)
 	GetKeygenSessionsByExpiry(ctx sdk.Context, expiry int64) []KeygenSession
 	GetKey(ctx sdk.Context, keyID exported.KeyID) (exported.Key, bool)
@@ -30,6 +31,10 @@ type Keeper interface {
 	DeleteKeygenSession(ctx sdk.Context, id exported.KeyID)
 	GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []SigningSession
 	DeleteSigningSession(ctx sdk.Context, id uint64)
+	GetSigningSession(ctx sdk.Context, id uint64) (SigningSession, bool)
+	GetSigningSessionsPaginated(ctx sdk.Context, filter SigningSessionFilter, pageRequest *query.PageRequest) ([]SigningSession, *query.PageResponse, error)
+	RecordSigningParticipation(ctx sdk.Context, signing SigningSession)
+	GetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress) SigningParticipation
 	GetSigRouter() SigRouter
 }
 
@@ -40,11 +45,10 @@ type KeygenParticipator interface {
 
 // Snapshotter provides snapshot keeper functionality
 type Snapshotter interface {
-	CreateSnapshot(
+	CreateWeightedSnapshot(
 		ctx sdk.Context,
 		candidates []sdk.ValAddress,
 		filterFunc func(snapshot.ValidatorI) bool,
-		weightFunc func(consensusPower math.Uint) math.Uint,
 		threshold utils.Threshold,
 	) (snapshot.Snapshot, error)
 	GetProxy(ctx sdk.Context, operator sdk.ValAddress) (addr sdk.AccAddress, active bool)
@@ -14,13 +14,14 @@ import (
 )
 
 // NewGenesisState is the constructor for GenesisState
-func NewGenesisState(params Params, keygenSessions []KeygenSession, signingSessions []SigningSession, keys []Key, keyEpochs []KeyEpoch) *GenesisState {
+func NewGenesisState(params Params, keygenSessions []KeygenSession, signingSessions []SigningSession, keys []Key, keyEpochs []KeyEpoch, signingParticipations []SigningParticipation) *GenesisState {
 	return &GenesisState{
-		Params:          params,
-		KeygenSessions:  keygenSessions,
-		SigningSessions: signingSessions,
-		Keys:            keys,
-		KeyEpochs:       keyEpochs,
+		Params:                params,
+		KeygenSessions:        keygenSessions,
+		SigningSessions:       signingSessions,
+		Keys:                  keys,
+		KeyEpochs:             keyEpochs,
+		SigningParticipations: signingParticipations,
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultGenesisState() *GenesisState {
 		[]SigningSession{},
 		[]Key{},
 		[]KeyEpoch{},
+		[]SigningParticipation{},
 	)
 }
 
@@ -55,6 +57,10 @@ func (m GenesisState) Validate() error {
 		return getValidateError(err)
 	}
 
+	if err := validateSigningParticipations(m.SigningParticipations); err != nil {
+		return getValidateError(err)
+	}
+
 	return nil
 }
 
@@ -117,6 +123,22 @@ func validateSigningSessions(keys map[exported.KeyID]Key, signingSessions []Sign
 	return nil
 }
 
+func validateSigningParticipations(participations []SigningParticipation) error {
+	validatorSeen := make(map[string]bool, len(participations))
+	for _, participation := range participations {
+		if validatorSeen[participation.Validator.String()] {
+			return fmt.Errorf("duplicate validator %s seen in signing participations", participation.Validator)
+		}
+		validatorSeen[participation.Validator.String()] = true
+
+		if err := participation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateKeys(keygenSessions []KeygenSession, keys []Key) error {
 	keyIDSeen := make(map[string]bool, len(keygenSessions)+len(keys))
 	for _, keygenSession := range keygenSessions {
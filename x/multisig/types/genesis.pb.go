@@ -25,11 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params          Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	KeygenSessions  []KeygenSession  `protobuf:"bytes,2,rep,name=keygen_sessions,json=keygenSessions,proto3" json:"keygen_sessions"`
-	SigningSessions []SigningSession `protobuf:"bytes,3,rep,name=signing_sessions,json=signingSessions,proto3" json:"signing_sessions"`
-	Keys            []Key            `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys"`
-	KeyEpochs       []KeyEpoch       `protobuf:"bytes,5,rep,name=key_epochs,json=keyEpochs,proto3" json:"key_epochs"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	KeygenSessions        []KeygenSession        `protobuf:"bytes,2,rep,name=keygen_sessions,json=keygenSessions,proto3" json:"keygen_sessions"`
+	SigningSessions       []SigningSession       `protobuf:"bytes,3,rep,name=signing_sessions,json=signingSessions,proto3" json:"signing_sessions"`
+	Keys                  []Key                  `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys"`
+	KeyEpochs             []KeyEpoch             `protobuf:"bytes,5,rep,name=key_epochs,json=keyEpochs,proto3" json:"key_epochs"`
+	SigningParticipations []SigningParticipation `protobuf:"bytes,6,rep,name=signing_participations,json=signingParticipations,proto3" json:"signing_participations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,29 +75,30 @@ func init() {
 }
 
 var fileDescriptor_dcca0fc43925718a = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x93, 0xb6, 0xb7, 0x70, 0xa7, 0x97, 0x5b, 0x09, 0x82, 0xa1, 0xc8, 0xb4, 0xfe, 0xef,
-	0xc6, 0x84, 0x56, 0x74, 0xe7, 0xa6, 0xa0, 0x2e, 0xdc, 0x88, 0x41, 0x10, 0x37, 0x25, 0x2d, 0x87,
-	0xe9, 0x90, 0x36, 0x13, 0x72, 0xa6, 0xda, 0xbc, 0x85, 0x8f, 0xd5, 0x65, 0x97, 0xae, 0x44, 0xdb,
-	0xf7, 0x10, 0xe9, 0xcc, 0x48, 0xed, 0x22, 0xdd, 0xcd, 0x1c, 0x7e, 0xdf, 0x8f, 0x0f, 0x3e, 0x72,
-	0x14, 0x4e, 0x60, 0x18, 0xa6, 0xfe, 0x68, 0x3c, 0x94, 0x1c, 0x39, 0xf3, 0x9f, 0x5b, 0x3d, 0x90,
-	0x61, 0xcb, 0x67, 0x10, 0x03, 0x72, 0xf4, 0x92, 0x54, 0x48, 0xe1, 0xec, 0x68, 0xcc, 0xfb, 0xc1,
-	0x3c, 0x83, 0xd5, 0xb6, 0x99, 0x60, 0x42, 0x31, 0xfe, 0xf2, 0xa5, 0xf1, 0xda, 0x61, 0x9e, 0x35,
-	0x09, 0xd3, 0x70, 0x64, 0xa4, 0xb5, 0x83, 0x3c, 0x4a, 0x66, 0x09, 0x18, 0x68, 0xff, 0xab, 0x40,
-	0xfe, 0xdd, 0xe8, 0x2e, 0x81, 0x0c, 0x25, 0x38, 0x97, 0xa4, 0xac, 0x2d, 0xae, 0xdd, 0xb0, 0x9b,
-	0x95, 0x76, 0xdd, 0xcb, 0xe9, 0xe6, 0xdd, 0x29, 0xac, 0x53, 0x9a, 0xbe, 0xd7, 0xad, 0x7b, 0x13,
-	0x72, 0x1e, 0x48, 0x35, 0x82, 0x8c, 0x41, 0xdc, 0x45, 0x40, 0xe4, 0x22, 0x46, 0xb7, 0xd0, 0x28,
-	0x36, 0x2b, 0xed, 0xe3, 0x5c, 0xcf, 0xad, 0xe2, 0x03, 0x8d, 0x1b, 0xdd, 0xff, 0xe8, 0xf7, 0x11,
-	0x9d, 0x47, 0xb2, 0x85, 0x9c, 0xc5, 0x3c, 0x66, 0x2b, 0x6f, 0x51, 0x79, 0x4f, 0x72, 0xbd, 0x81,
-	0x0e, 0xac, 0x8b, 0xab, 0xb8, 0x76, 0x45, 0xe7, 0x82, 0x94, 0x22, 0xc8, 0xd0, 0x2d, 0x29, 0xdb,
-	0xee, 0xa6, 0x96, 0x46, 0xa1, 0x78, 0xe7, 0x9a, 0x90, 0x08, 0xb2, 0x2e, 0x24, 0xa2, 0x3f, 0x40,
-	0xf7, 0x8f, 0x4a, 0xef, 0x6d, 0x4a, 0x5f, 0x2d, 0x49, 0xa3, 0xf8, 0x1b, 0x99, 0x3f, 0x76, 0x82,
-	0xe9, 0x27, 0xb5, 0xa6, 0x73, 0x6a, 0xcf, 0xe6, 0xd4, 0xfe, 0x98, 0x53, 0xfb, 0x75, 0x41, 0xad,
-	0xd9, 0x82, 0x5a, 0x6f, 0x0b, 0x6a, 0x3d, 0x9d, 0x33, 0x2e, 0x07, 0xe3, 0x9e, 0xd7, 0x17, 0x23,
-	0x5f, 0xbb, 0x63, 0x90, 0x2f, 0x22, 0x8d, 0xcc, 0xef, 0xb4, 0x2f, 0x52, 0xf0, 0x27, 0xab, 0x8d,
-	0xd5, 0xb6, 0xbd, 0xb2, 0x1a, 0xf7, 0xec, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xb4, 0x74, 0x13,
-	0x7f, 0x02, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
+	0x10, 0xc7, 0x5b, 0x41, 0x12, 0x17, 0x23, 0xa6, 0xf1, 0xa3, 0x21, 0xa6, 0xe0, 0x37, 0x17, 0xda,
+	0x80, 0xd1, 0x9b, 0x17, 0x12, 0xf5, 0xe0, 0x85, 0xd8, 0x98, 0x18, 0x2f, 0x64, 0x69, 0x36, 0xcb,
+	0x5a, 0xe8, 0x36, 0x9d, 0x45, 0xe9, 0x5b, 0xf8, 0x58, 0x1c, 0x39, 0x7a, 0x32, 0x0a, 0x37, 0x9f,
+	0xc2, 0xb0, 0x5d, 0x02, 0x24, 0x16, 0x6f, 0x9d, 0xe9, 0xef, 0xff, 0xdb, 0x99, 0x64, 0xd0, 0x29,
+	0x1e, 0x90, 0x2e, 0x8e, 0x9c, 0x5e, 0xbf, 0x2b, 0x18, 0x30, 0xea, 0xbc, 0xd6, 0xda, 0x44, 0xe0,
+	0x9a, 0x43, 0x49, 0x40, 0x80, 0x81, 0x1d, 0x46, 0x5c, 0x70, 0x63, 0x3f, 0xc1, 0xec, 0x19, 0x66,
+	0x2b, 0xac, 0xb8, 0x43, 0x39, 0xe5, 0x92, 0x71, 0xa6, 0x5f, 0x09, 0x5e, 0x3c, 0x49, 0xb3, 0x86,
+	0x38, 0xc2, 0x3d, 0x25, 0x2d, 0x1e, 0xa7, 0x51, 0x22, 0x0e, 0x89, 0x82, 0x8e, 0x7e, 0x32, 0x68,
+	0xf3, 0x2e, 0x99, 0xc5, 0x15, 0x58, 0x10, 0xe3, 0x1a, 0xe5, 0x12, 0x8b, 0xa9, 0x97, 0xf5, 0x4a,
+	0xbe, 0x5e, 0xb2, 0x53, 0x66, 0xb3, 0x9b, 0x12, 0x6b, 0x64, 0x87, 0x9f, 0x25, 0xed, 0x41, 0x85,
+	0x8c, 0x47, 0x54, 0xf0, 0x49, 0x4c, 0x49, 0xd0, 0x02, 0x02, 0xc0, 0x78, 0x00, 0xe6, 0x5a, 0x39,
+	0x53, 0xc9, 0xd7, 0xcf, 0x52, 0x3d, 0xf7, 0x92, 0x77, 0x13, 0x5c, 0xe9, 0xb6, 0xfc, 0xc5, 0x26,
+	0x18, 0x4f, 0x68, 0x1b, 0x18, 0x0d, 0x58, 0x40, 0xe7, 0xde, 0x8c, 0xf4, 0x9e, 0xa7, 0x7a, 0xdd,
+	0x24, 0xb0, 0x2c, 0x2e, 0xc0, 0x52, 0x17, 0x8c, 0x2b, 0x94, 0xf5, 0x49, 0x0c, 0x66, 0x56, 0xda,
+	0x0e, 0x56, 0x4d, 0xa9, 0x14, 0x92, 0x37, 0x6e, 0x11, 0xf2, 0x49, 0xdc, 0x22, 0x21, 0xf7, 0x3a,
+	0x60, 0xae, 0xcb, 0xf4, 0xe1, 0xaa, 0xf4, 0xcd, 0x94, 0x54, 0x8a, 0x0d, 0x5f, 0xd5, 0x60, 0xbc,
+	0xa0, 0xbd, 0xd9, 0x66, 0x21, 0x8e, 0x04, 0xf3, 0x58, 0x88, 0x85, 0xdc, 0x2f, 0x27, 0x9d, 0xd5,
+	0xff, 0xf6, 0x6b, 0x2e, 0xa6, 0x94, 0x7f, 0x17, 0xfe, 0xf8, 0x07, 0x0d, 0x77, 0xf8, 0x6d, 0x69,
+	0xc3, 0xb1, 0xa5, 0x8f, 0xc6, 0x96, 0xfe, 0x35, 0xb6, 0xf4, 0xf7, 0x89, 0xa5, 0x8d, 0x26, 0x96,
+	0xf6, 0x31, 0xb1, 0xb4, 0xe7, 0x4b, 0xca, 0x44, 0xa7, 0xdf, 0xb6, 0x3d, 0xde, 0x73, 0x92, 0x37,
+	0x03, 0x22, 0xde, 0x78, 0xe4, 0xab, 0xaa, 0xea, 0xf1, 0x88, 0x38, 0x83, 0xf9, 0x3d, 0xc9, 0x3b,
+	0x6a, 0xe7, 0xe4, 0x21, 0x5d, 0xfc, 0x0e, 0x00, 0xf8, 0x75, 0x3a, 0x9c, 0xeb, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +121,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigningParticipations) > 0 {
+		for iNdEx := len(m.SigningParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.KeyEpochs) > 0 {
 		for iNdEx := len(m.KeyEpochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +247,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SigningParticipations) > 0 {
+		for _, e := range m.SigningParticipations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -438,6 +460,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningParticipations = append(m.SigningParticipations, SigningParticipation{})
+			if err := m.SigningParticipations[len(m.SigningParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
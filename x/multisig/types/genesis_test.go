@@ -5,9 +5,27 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
 )
 
 func TestDefaultGenesisState(t *testing.T) {
 	assert.NoError(t, types.DefaultGenesisState().Validate())
 }
+
+func TestGenesisState_Validate(t *testing.T) {
+	participation := types.NewSigningParticipation(rand.ValAddr())
+	participation.Record(false)
+
+	genesis := types.DefaultGenesisState()
+	genesis.SigningParticipations = []types.SigningParticipation{participation, types.NewSigningParticipation(rand.ValAddr())}
+	assert.NoError(t, genesis.Validate())
+
+	genesis.SigningParticipations = append(genesis.SigningParticipations, participation)
+	assert.ErrorContains(t, genesis.Validate(), "duplicate validator")
+
+	invalid := types.NewSigningParticipation(rand.ValAddr())
+	invalid.MissedSessions = nil
+	genesis.SigningParticipations = []types.SigningParticipation{invalid}
+	assert.Error(t, genesis.Validate())
+}
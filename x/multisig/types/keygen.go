@@ -16,12 +16,13 @@ import (
 )
 
 // NewKeygenSession is the contructor for keygen session
-func NewKeygenSession(id exported.KeyID, keygenThreshold utils.Threshold, signingThreshold utils.Threshold, snapshot snapshot.Snapshot, expiresAt int64, gracePeriod int64) KeygenSession {
+func NewKeygenSession(id exported.KeyID, keyType exported.KeyType, keygenThreshold utils.Threshold, signingThreshold utils.Threshold, snapshot snapshot.Snapshot, expiresAt int64, gracePeriod int64) KeygenSession {
 	return KeygenSession{
 		Key: Key{
 			ID:               id,
 			Snapshot:         snapshot,
 			SigningThreshold: signingThreshold,
+			KeyType:          keyType,
 		},
 		State:           exported.Pending,
 		KeygenThreshold: keygenThreshold,
@@ -100,6 +101,10 @@ func (m *KeygenSession) AddKey(blockHeight int64, participant sdk.ValAddress, pu
 		return fmt.Errorf("duplicate public key received")
 	}
 
+	if err := m.Key.KeyType.ValidatePubKey(pubKey); err != nil {
+		return fmt.Errorf("invalid %s public key received: %w", m.Key.KeyType, err)
+	}
+
 	if m.State == exported.Completed && !m.isWithinGracePeriod(blockHeight) {
 		return fmt.Errorf("keygen session %s has closed", m.GetKeyID())
 	}
@@ -218,6 +223,10 @@ func validateBasicPendingKey(key Key) error {
 		return err
 	}
 
+	if err := key.KeyType.ValidateBasic(); err != nil {
+		return err
+	}
+
 	pubKeySeen := make(map[string]bool, len(key.PubKeys))
 	for address, pubkey := range key.PubKeys {
 		pubkeyStr := pubkey.String()
@@ -231,7 +240,7 @@ func validateBasicPendingKey(key Key) error {
 			return err
 		}
 
-		if err := pubkey.ValidateBasic(); err != nil {
+		if err := key.KeyType.ValidatePubKey(pubkey); err != nil {
 			return err
 		}
 
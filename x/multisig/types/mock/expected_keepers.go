@@ -6,7 +6,6 @@ package mock
 import (
 	context "context"
 	"cosmossdk.io/log"
-	cosmossdk_io_math "cosmossdk.io/math"
 	utils "github.com/axelarnetwork/axelar-core/utils"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	"github.com/axelarnetwork/axelar-core/x/multisig/types"
@@ -14,6 +13,7 @@ import (
 	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"sync"
 )
@@ -34,6 +34,9 @@ var _ types.Keeper = &KeeperMock{}
 //			DeleteSigningSessionFunc: func(ctx sdk.Context, id uint64)  {
 //				panic("mock out the DeleteSigningSession method")
 //			},
+//			GetActiveKeyIDsFunc: func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) []github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID {
+//				panic("mock out the GetActiveKeyIDs method")
+//			},
 //			GetCurrentKeyIDFunc: func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, bool) {
 //				panic("mock out the GetCurrentKeyID method")
 //			},
@@ -55,12 +58,24 @@ var _ types.Keeper = &KeeperMock{}
 //			GetSigRouterFunc: func() types.SigRouter {
 //				panic("mock out the GetSigRouter method")
 //			},
+//			GetSigningParticipationFunc: func(ctx sdk.Context, validator sdk.ValAddress) types.SigningParticipation {
+//				panic("mock out the GetSigningParticipation method")
+//			},
+//			GetSigningSessionFunc: func(ctx sdk.Context, id uint64) (types.SigningSession, bool) {
+//				panic("mock out the GetSigningSession method")
+//			},
 //			GetSigningSessionsByExpiryFunc: func(ctx sdk.Context, expiry int64) []types.SigningSession {
 //				panic("mock out the GetSigningSessionsByExpiry method")
 //			},
+//			GetSigningSessionsPaginatedFunc: func(ctx sdk.Context, filter types.SigningSessionFilter, pageRequest *query.PageRequest) ([]types.SigningSession, *query.PageResponse, error) {
+//				panic("mock out the GetSigningSessionsPaginated method")
+//			},
 //			LoggerFunc: func(ctx sdk.Context) log.Logger {
 //				panic("mock out the Logger method")
 //			},
+//			RecordSigningParticipationFunc: func(ctx sdk.Context, signing types.SigningSession)  {
+//				panic("mock out the RecordSigningParticipation method")
+//			},
 //			SetKeyFunc: func(ctx sdk.Context, key types.Key)  {
 //				panic("mock out the SetKey method")
 //			},
@@ -77,6 +92,9 @@ type KeeperMock struct {
 	// DeleteSigningSessionFunc mocks the DeleteSigningSession method.
 	DeleteSigningSessionFunc func(ctx sdk.Context, id uint64)
 
+	// GetActiveKeyIDsFunc mocks the GetActiveKeyIDs method.
+	GetActiveKeyIDsFunc func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) []github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID
+
 	// GetCurrentKeyIDFunc mocks the GetCurrentKeyID method.
 	GetCurrentKeyIDFunc func(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, bool)
 
@@ -98,12 +116,24 @@ type KeeperMock struct {
 	// GetSigRouterFunc mocks the GetSigRouter method.
 	GetSigRouterFunc func() types.SigRouter
 
+	// GetSigningParticipationFunc mocks the GetSigningParticipation method.
+	GetSigningParticipationFunc func(ctx sdk.Context, validator sdk.ValAddress) types.SigningParticipation
+
+	// GetSigningSessionFunc mocks the GetSigningSession method.
+	GetSigningSessionFunc func(ctx sdk.Context, id uint64) (types.SigningSession, bool)
+
 	// GetSigningSessionsByExpiryFunc mocks the GetSigningSessionsByExpiry method.
 	GetSigningSessionsByExpiryFunc func(ctx sdk.Context, expiry int64) []types.SigningSession
 
+	// GetSigningSessionsPaginatedFunc mocks the GetSigningSessionsPaginated method.
+	GetSigningSessionsPaginatedFunc func(ctx sdk.Context, filter types.SigningSessionFilter, pageRequest *query.PageRequest) ([]types.SigningSession, *query.PageResponse, error)
+
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx sdk.Context) log.Logger
 
+	// RecordSigningParticipationFunc mocks the RecordSigningParticipation method.
+	RecordSigningParticipationFunc func(ctx sdk.Context, signing types.SigningSession)
+
 	// SetKeyFunc mocks the SetKey method.
 	SetKeyFunc func(ctx sdk.Context, key types.Key)
 
@@ -123,6 +153,13 @@ type KeeperMock struct {
 			// ID is the id argument value.
 			ID uint64
 		}
+		// GetActiveKeyIDs holds details about calls to the GetActiveKeyIDs method.
+		GetActiveKeyIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// ChainName is the chainName argument value.
+			ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		}
 		// GetCurrentKeyID holds details about calls to the GetCurrentKeyID method.
 		GetCurrentKeyID []struct {
 			// Ctx is the ctx argument value.
@@ -166,6 +203,20 @@ type KeeperMock struct {
 		// GetSigRouter holds details about calls to the GetSigRouter method.
 		GetSigRouter []struct {
 		}
+		// GetSigningParticipation holds details about calls to the GetSigningParticipation method.
+		GetSigningParticipation []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Validator is the validator argument value.
+			Validator sdk.ValAddress
+		}
+		// GetSigningSession holds details about calls to the GetSigningSession method.
+		GetSigningSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Id is the id argument value.
+			Id uint64
+		}
 		// GetSigningSessionsByExpiry holds details about calls to the GetSigningSessionsByExpiry method.
 		GetSigningSessionsByExpiry []struct {
 			// Ctx is the ctx argument value.
@@ -173,11 +224,27 @@ type KeeperMock struct {
 			// Expiry is the expiry argument value.
 			Expiry int64
 		}
+		// GetSigningSessionsPaginated holds details about calls to the GetSigningSessionsPaginated method.
+		GetSigningSessionsPaginated []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Filter is the filter argument value.
+			Filter types.SigningSessionFilter
+			// PageRequest is the pageRequest argument value.
+			PageRequest *query.PageRequest
+		}
 		// Logger holds details about calls to the Logger method.
 		Logger []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 		}
+		// RecordSigningParticipation holds details about calls to the RecordSigningParticipation method.
+		RecordSigningParticipation []struct {
+			// Ctx is the ctx argument value.
+			Ctx sdk.Context
+			// Signing is the signing argument value.
+			Signing types.SigningSession
+		}
 		// SetKey holds details about calls to the SetKey method.
 		SetKey []struct {
 			// Ctx is the ctx argument value.
@@ -186,18 +253,23 @@ type KeeperMock struct {
 			Key types.Key
 		}
 	}
-	lockDeleteKeygenSession        sync.RWMutex
-	lockDeleteSigningSession       sync.RWMutex
-	lockGetCurrentKeyID            sync.RWMutex
-	lockGetKey                     sync.RWMutex
-	lockGetKeygenSession           sync.RWMutex
-	lockGetKeygenSessionsByExpiry  sync.RWMutex
-	lockGetNextKeyID               sync.RWMutex
-	lockGetParams                  sync.RWMutex
-	lockGetSigRouter               sync.RWMutex
-	lockGetSigningSessionsByExpiry sync.RWMutex
-	lockLogger                     sync.RWMutex
-	lockSetKey                     sync.RWMutex
+	lockDeleteKeygenSession         sync.RWMutex
+	lockDeleteSigningSession        sync.RWMutex
+	lockGetActiveKeyIDs             sync.RWMutex
+	lockGetCurrentKeyID             sync.RWMutex
+	lockGetKey                      sync.RWMutex
+	lockGetKeygenSession            sync.RWMutex
+	lockGetKeygenSessionsByExpiry   sync.RWMutex
+	lockGetNextKeyID                sync.RWMutex
+	lockGetParams                   sync.RWMutex
+	lockGetSigRouter                sync.RWMutex
+	lockGetSigningParticipation     sync.RWMutex
+	lockGetSigningSession           sync.RWMutex
+	lockGetSigningSessionsByExpiry  sync.RWMutex
+	lockGetSigningSessionsPaginated sync.RWMutex
+	lockLogger                      sync.RWMutex
+	lockRecordSigningParticipation  sync.RWMutex
+	lockSetKey                      sync.RWMutex
 }
 
 // DeleteKeygenSession calls DeleteKeygenSessionFunc.
@@ -272,6 +344,42 @@ func (mock *KeeperMock) DeleteSigningSessionCalls() []struct {
 	return calls
 }
 
+// GetActiveKeyIDs calls GetActiveKeyIDsFunc.
+func (mock *KeeperMock) GetActiveKeyIDs(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) []github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID {
+	if mock.GetActiveKeyIDsFunc == nil {
+		panic("KeeperMock.GetActiveKeyIDsFunc: method is nil but Keeper.GetActiveKeyIDs was just called")
+	}
+	callInfo := struct {
+		Ctx       sdk.Context
+		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	}{
+		Ctx:       ctx,
+		ChainName: chainName,
+	}
+	mock.lockGetActiveKeyIDs.Lock()
+	mock.calls.GetActiveKeyIDs = append(mock.calls.GetActiveKeyIDs, callInfo)
+	mock.lockGetActiveKeyIDs.Unlock()
+	return mock.GetActiveKeyIDsFunc(ctx, chainName)
+}
+
+// GetActiveKeyIDsCalls gets all the calls that were made to GetActiveKeyIDs.
+// Check the length with:
+//
+//	len(mockedKeeper.GetActiveKeyIDsCalls())
+func (mock *KeeperMock) GetActiveKeyIDsCalls() []struct {
+	Ctx       sdk.Context
+	ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+} {
+	var calls []struct {
+		Ctx       sdk.Context
+		ChainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	}
+	mock.lockGetActiveKeyIDs.RLock()
+	calls = mock.calls.GetActiveKeyIDs
+	mock.lockGetActiveKeyIDs.RUnlock()
+	return calls
+}
+
 // GetCurrentKeyID calls GetCurrentKeyIDFunc.
 func (mock *KeeperMock) GetCurrentKeyID(ctx sdk.Context, chainName github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName) (github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID, bool) {
 	if mock.GetCurrentKeyIDFunc == nil {
@@ -511,6 +619,78 @@ func (mock *KeeperMock) GetSigRouterCalls() []struct {
 	return calls
 }
 
+// GetSigningParticipation calls GetSigningParticipationFunc.
+func (mock *KeeperMock) GetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress) types.SigningParticipation {
+	if mock.GetSigningParticipationFunc == nil {
+		panic("KeeperMock.GetSigningParticipationFunc: method is nil but Keeper.GetSigningParticipation was just called")
+	}
+	callInfo := struct {
+		Ctx       sdk.Context
+		Validator sdk.ValAddress
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+	}
+	mock.lockGetSigningParticipation.Lock()
+	mock.calls.GetSigningParticipation = append(mock.calls.GetSigningParticipation, callInfo)
+	mock.lockGetSigningParticipation.Unlock()
+	return mock.GetSigningParticipationFunc(ctx, validator)
+}
+
+// GetSigningParticipationCalls gets all the calls that were made to GetSigningParticipation.
+// Check the length with:
+//
+//	len(mockedKeeper.GetSigningParticipationCalls())
+func (mock *KeeperMock) GetSigningParticipationCalls() []struct {
+	Ctx       sdk.Context
+	Validator sdk.ValAddress
+} {
+	var calls []struct {
+		Ctx       sdk.Context
+		Validator sdk.ValAddress
+	}
+	mock.lockGetSigningParticipation.RLock()
+	calls = mock.calls.GetSigningParticipation
+	mock.lockGetSigningParticipation.RUnlock()
+	return calls
+}
+
+// GetSigningSession calls GetSigningSessionFunc.
+func (mock *KeeperMock) GetSigningSession(ctx sdk.Context, id uint64) (types.SigningSession, bool) {
+	if mock.GetSigningSessionFunc == nil {
+		panic("KeeperMock.GetSigningSessionFunc: method is nil but Keeper.GetSigningSession was just called")
+	}
+	callInfo := struct {
+		Ctx sdk.Context
+		Id  uint64
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetSigningSession.Lock()
+	mock.calls.GetSigningSession = append(mock.calls.GetSigningSession, callInfo)
+	mock.lockGetSigningSession.Unlock()
+	return mock.GetSigningSessionFunc(ctx, id)
+}
+
+// GetSigningSessionCalls gets all the calls that were made to GetSigningSession.
+// Check the length with:
+//
+//	len(mockedKeeper.GetSigningSessionCalls())
+func (mock *KeeperMock) GetSigningSessionCalls() []struct {
+	Ctx sdk.Context
+	Id  uint64
+} {
+	var calls []struct {
+		Ctx sdk.Context
+		Id  uint64
+	}
+	mock.lockGetSigningSession.RLock()
+	calls = mock.calls.GetSigningSession
+	mock.lockGetSigningSession.RUnlock()
+	return calls
+}
+
 // GetSigningSessionsByExpiry calls GetSigningSessionsByExpiryFunc.
 func (mock *KeeperMock) GetSigningSessionsByExpiry(ctx sdk.Context, expiry int64) []types.SigningSession {
 	if mock.GetSigningSessionsByExpiryFunc == nil {
@@ -547,6 +727,46 @@ func (mock *KeeperMock) GetSigningSessionsByExpiryCalls() []struct {
 	return calls
 }
 
+// GetSigningSessionsPaginated calls GetSigningSessionsPaginatedFunc.
+func (mock *KeeperMock) GetSigningSessionsPaginated(ctx sdk.Context, filter types.SigningSessionFilter, pageRequest *query.PageRequest) ([]types.SigningSession, *query.PageResponse, error) {
+	if mock.GetSigningSessionsPaginatedFunc == nil {
+		panic("KeeperMock.GetSigningSessionsPaginatedFunc: method is nil but Keeper.GetSigningSessionsPaginated was just called")
+	}
+	callInfo := struct {
+		Ctx         sdk.Context
+		Filter      types.SigningSessionFilter
+		PageRequest *query.PageRequest
+	}{
+		Ctx:         ctx,
+		Filter:      filter,
+		PageRequest: pageRequest,
+	}
+	mock.lockGetSigningSessionsPaginated.Lock()
+	mock.calls.GetSigningSessionsPaginated = append(mock.calls.GetSigningSessionsPaginated, callInfo)
+	mock.lockGetSigningSessionsPaginated.Unlock()
+	return mock.GetSigningSessionsPaginatedFunc(ctx, filter, pageRequest)
+}
+
+// GetSigningSessionsPaginatedCalls gets all the calls that were made to GetSigningSessionsPaginated.
+// Check the length with:
+//
+//	len(mockedKeeper.GetSigningSessionsPaginatedCalls())
+func (mock *KeeperMock) GetSigningSessionsPaginatedCalls() []struct {
+	Ctx         sdk.Context
+	Filter      types.SigningSessionFilter
+	PageRequest *query.PageRequest
+} {
+	var calls []struct {
+		Ctx         sdk.Context
+		Filter      types.SigningSessionFilter
+		PageRequest *query.PageRequest
+	}
+	mock.lockGetSigningSessionsPaginated.RLock()
+	calls = mock.calls.GetSigningSessionsPaginated
+	mock.lockGetSigningSessionsPaginated.RUnlock()
+	return calls
+}
+
 // Logger calls LoggerFunc.
 func (mock *KeeperMock) Logger(ctx sdk.Context) log.Logger {
 	if mock.LoggerFunc == nil {
@@ -579,6 +799,42 @@ func (mock *KeeperMock) LoggerCalls() []struct {
 	return calls
 }
 
+// RecordSigningParticipation calls RecordSigningParticipationFunc.
+func (mock *KeeperMock) RecordSigningParticipation(ctx sdk.Context, signing types.SigningSession) {
+	if mock.RecordSigningParticipationFunc == nil {
+		panic("KeeperMock.RecordSigningParticipationFunc: method is nil but Keeper.RecordSigningParticipation was just called")
+	}
+	callInfo := struct {
+		Ctx     sdk.Context
+		Signing types.SigningSession
+	}{
+		Ctx:     ctx,
+		Signing: signing,
+	}
+	mock.lockRecordSigningParticipation.Lock()
+	mock.calls.RecordSigningParticipation = append(mock.calls.RecordSigningParticipation, callInfo)
+	mock.lockRecordSigningParticipation.Unlock()
+	mock.RecordSigningParticipationFunc(ctx, signing)
+}
+
+// RecordSigningParticipationCalls gets all the calls that were made to RecordSigningParticipation.
+// Check the length with:
+//
+//	len(mockedKeeper.RecordSigningParticipationCalls())
+func (mock *KeeperMock) RecordSigningParticipationCalls() []struct {
+	Ctx     sdk.Context
+	Signing types.SigningSession
+} {
+	var calls []struct {
+		Ctx     sdk.Context
+		Signing types.SigningSession
+	}
+	mock.lockRecordSigningParticipation.RLock()
+	calls = mock.calls.RecordSigningParticipation
+	mock.lockRecordSigningParticipation.RUnlock()
+	return calls
+}
+
 // SetKey calls SetKeyFunc.
 func (mock *KeeperMock) SetKey(ctx sdk.Context, key types.Key) {
 	if mock.SetKeyFunc == nil {
@@ -625,8 +881,8 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //		// make and configure a mocked types.Snapshotter
 //		mockedSnapshotter := &SnapshotterMock{
-//			CreateSnapshotFunc: func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (exported.Snapshot, error) {
-//				panic("mock out the CreateSnapshot method")
+//			CreateWeightedSnapshotFunc: func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, threshold utils.Threshold) (exported.Snapshot, error) {
+//				panic("mock out the CreateWeightedSnapshot method")
 //			},
 //			GetOperatorFunc: func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress {
 //				panic("mock out the GetOperator method")
@@ -641,8 +897,8 @@ var _ types.Snapshotter = &SnapshotterMock{}
 //
 //	}
 type SnapshotterMock struct {
-	// CreateSnapshotFunc mocks the CreateSnapshot method.
-	CreateSnapshotFunc func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (exported.Snapshot, error)
+	// CreateWeightedSnapshotFunc mocks the CreateWeightedSnapshot method.
+	CreateWeightedSnapshotFunc func(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, threshold utils.Threshold) (exported.Snapshot, error)
 
 	// GetOperatorFunc mocks the GetOperator method.
 	GetOperatorFunc func(ctx sdk.Context, proxy sdk.AccAddress) sdk.ValAddress
@@ -652,16 +908,14 @@ type SnapshotterMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// CreateSnapshot holds details about calls to the CreateSnapshot method.
-		CreateSnapshot []struct {
+		// CreateWeightedSnapshot holds details about calls to the CreateWeightedSnapshot method.
+		CreateWeightedSnapshot []struct {
 			// Ctx is the ctx argument value.
 			Ctx sdk.Context
 			// Candidates is the candidates argument value.
 			Candidates []sdk.ValAddress
 			// FilterFunc is the filterFunc argument value.
 			FilterFunc func(exported.ValidatorI) bool
-			// WeightFunc is the weightFunc argument value.
-			WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 			// Threshold is the threshold argument value.
 			Threshold utils.Threshold
 		}
@@ -680,56 +934,52 @@ type SnapshotterMock struct {
 			Operator sdk.ValAddress
 		}
 	}
-	lockCreateSnapshot sync.RWMutex
-	lockGetOperator    sync.RWMutex
-	lockGetProxy       sync.RWMutex
+	lockCreateWeightedSnapshot sync.RWMutex
+	lockGetOperator            sync.RWMutex
+	lockGetProxy               sync.RWMutex
 }
 
-// CreateSnapshot calls CreateSnapshotFunc.
-func (mock *SnapshotterMock) CreateSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, weightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint, threshold utils.Threshold) (exported.Snapshot, error) {
-	if mock.CreateSnapshotFunc == nil {
-		panic("SnapshotterMock.CreateSnapshotFunc: method is nil but Snapshotter.CreateSnapshot was just called")
+// CreateWeightedSnapshot calls CreateWeightedSnapshotFunc.
+func (mock *SnapshotterMock) CreateWeightedSnapshot(ctx sdk.Context, candidates []sdk.ValAddress, filterFunc func(exported.ValidatorI) bool, threshold utils.Threshold) (exported.Snapshot, error) {
+	if mock.CreateWeightedSnapshotFunc == nil {
+		panic("SnapshotterMock.CreateWeightedSnapshotFunc: method is nil but Snapshotter.CreateWeightedSnapshot was just called")
 	}
 	callInfo := struct {
 		Ctx        sdk.Context
 		Candidates []sdk.ValAddress
 		FilterFunc func(exported.ValidatorI) bool
-		WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 		Threshold  utils.Threshold
 	}{
 		Ctx:        ctx,
 		Candidates: candidates,
 		FilterFunc: filterFunc,
-		WeightFunc: weightFunc,
 		Threshold:  threshold,
 	}
-	mock.lockCreateSnapshot.Lock()
-	mock.calls.CreateSnapshot = append(mock.calls.CreateSnapshot, callInfo)
-	mock.lockCreateSnapshot.Unlock()
-	return mock.CreateSnapshotFunc(ctx, candidates, filterFunc, weightFunc, threshold)
+	mock.lockCreateWeightedSnapshot.Lock()
+	mock.calls.CreateWeightedSnapshot = append(mock.calls.CreateWeightedSnapshot, callInfo)
+	mock.lockCreateWeightedSnapshot.Unlock()
+	return mock.CreateWeightedSnapshotFunc(ctx, candidates, filterFunc, threshold)
 }
 
-// CreateSnapshotCalls gets all the calls that were made to CreateSnapshot.
+// CreateWeightedSnapshotCalls gets all the calls that were made to CreateWeightedSnapshot.
 // Check the length with:
 //
-//	len(mockedSnapshotter.CreateSnapshotCalls())
-func (mock *SnapshotterMock) CreateSnapshotCalls() []struct {
+//	len(mockedSnapshotter.CreateWeightedSnapshotCalls())
+func (mock *SnapshotterMock) CreateWeightedSnapshotCalls() []struct {
 	Ctx        sdk.Context
 	Candidates []sdk.ValAddress
 	FilterFunc func(exported.ValidatorI) bool
-	WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 	Threshold  utils.Threshold
 } {
 	var calls []struct {
 		Ctx        sdk.Context
 		Candidates []sdk.ValAddress
 		FilterFunc func(exported.ValidatorI) bool
-		WeightFunc func(consensusPower cosmossdk_io_math.Uint) cosmossdk_io_math.Uint
 		Threshold  utils.Threshold
 	}
-	mock.lockCreateSnapshot.RLock()
-	calls = mock.calls.CreateSnapshot
-	mock.lockCreateSnapshot.RUnlock()
+	mock.lockCreateWeightedSnapshot.RLock()
+	calls = mock.calls.CreateWeightedSnapshot
+	mock.lockCreateWeightedSnapshot.RUnlock()
 	return calls
 }
 
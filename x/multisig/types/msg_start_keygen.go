@@ -11,10 +11,11 @@ import (
 var _ sdk.Msg = &StartKeygenRequest{}
 
 // NewStartKeygenRequest constructor for StartKeygenRequest
-func NewStartKeygenRequest(sender sdk.AccAddress, keyID exported.KeyID) *StartKeygenRequest {
+func NewStartKeygenRequest(sender sdk.AccAddress, keyID exported.KeyID, keyType exported.KeyType) *StartKeygenRequest {
 	return &StartKeygenRequest{
-		Sender: sender.String(),
-		KeyID:  keyID,
+		Sender:  sender.String(),
+		KeyID:   keyID,
+		KeyType: keyType,
 	}
 }
 
@@ -28,5 +29,9 @@ func (m StartKeygenRequest) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if err := m.KeyType.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 var _ sdk.Msg = &SubmitPubKeyRequest{}
@@ -32,16 +33,16 @@ func (m SubmitPubKeyRequest) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	if err := m.PubKey.ValidateBasic(); err != nil {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
-	}
-
 	if err := m.Signature.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// the key type of the keygen session is only known in the msg server, which checks that the public key matches it,
+	// so here it is sufficient that the signature proves possession of the key under any of the supported schemes
 	hash := sha256.Sum256([]byte(m.Sender))
-	if !m.Signature.Verify(hash[:], m.PubKey) {
+	if !slices.Any(exported.KeyTypes(), func(keyType exported.KeyType) bool {
+		return m.Signature.Verify(keyType, hash[:], m.PubKey)
+	}) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature does not match the public key")
 	}
 
@@ -8,6 +8,9 @@ import (
 	fmt "fmt"
 	exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
@@ -181,6 +184,82 @@ func (m *NextKeyIDResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NextKeyIDResponse proto.InternalMessageInfo
 
+type ActiveKeyIDsRequest struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *ActiveKeyIDsRequest) Reset()         { *m = ActiveKeyIDsRequest{} }
+func (m *ActiveKeyIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ActiveKeyIDsRequest) ProtoMessage()    {}
+func (*ActiveKeyIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{4}
+}
+func (m *ActiveKeyIDsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveKeyIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveKeyIDsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveKeyIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveKeyIDsRequest.Merge(m, src)
+}
+func (m *ActiveKeyIDsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveKeyIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveKeyIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveKeyIDsRequest proto.InternalMessageInfo
+
+// ActiveKeyIDsResponse contains the key IDs of all active keys of the given
+// chain, starting with the key of the current epoch
+type ActiveKeyIDsResponse struct {
+	KeyIDs []github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,1,rep,name=key_ids,json=keyIds,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_ids,omitempty"`
+}
+
+func (m *ActiveKeyIDsResponse) Reset()         { *m = ActiveKeyIDsResponse{} }
+func (m *ActiveKeyIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ActiveKeyIDsResponse) ProtoMessage()    {}
+func (*ActiveKeyIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{5}
+}
+func (m *ActiveKeyIDsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveKeyIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveKeyIDsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveKeyIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveKeyIDsResponse.Merge(m, src)
+}
+func (m *ActiveKeyIDsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveKeyIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveKeyIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveKeyIDsResponse proto.InternalMessageInfo
+
 type KeyRequest struct {
 	KeyID github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 }
@@ -189,7 +268,7 @@ func (m *KeyRequest) Reset()         { *m = KeyRequest{} }
 func (m *KeyRequest) String() string { return proto.CompactTextString(m) }
 func (*KeyRequest) ProtoMessage()    {}
 func (*KeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{4}
+	return fileDescriptor_4c5266980cca9f48, []int{6}
 }
 func (m *KeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +307,7 @@ func (m *KeygenParticipant) Reset()         { *m = KeygenParticipant{} }
 func (m *KeygenParticipant) String() string { return proto.CompactTextString(m) }
 func (*KeygenParticipant) ProtoMessage()    {}
 func (*KeygenParticipant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{5}
+	return fileDescriptor_4c5266980cca9f48, []int{7}
 }
 func (m *KeygenParticipant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,13 +346,14 @@ type KeyResponse struct {
 	BondedWeight       cosmossdk_io_math.Uint                                         `protobuf:"bytes,6,opt,name=bonded_weight,json=bondedWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"bonded_weight"`
 	// Keygen participants in descending order by weight
 	Participants []KeygenParticipant `protobuf:"bytes,7,rep,name=participants,proto3" json:"participants"`
+	KeyType      exported.KeyType    `protobuf:"varint,8,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *KeyResponse) Reset()         { *m = KeyResponse{} }
 func (m *KeyResponse) String() string { return proto.CompactTextString(m) }
 func (*KeyResponse) ProtoMessage()    {}
 func (*KeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{6}
+	return fileDescriptor_4c5266980cca9f48, []int{8}
 }
 func (m *KeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +390,7 @@ func (m *KeygenSessionRequest) Reset()         { *m = KeygenSessionRequest{} }
 func (m *KeygenSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*KeygenSessionRequest) ProtoMessage()    {}
 func (*KeygenSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{7}
+	return fileDescriptor_4c5266980cca9f48, []int{9}
 }
 func (m *KeygenSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +438,7 @@ func (m *KeygenSessionResponse) Reset()         { *m = KeygenSessionResponse{} }
 func (m *KeygenSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*KeygenSessionResponse) ProtoMessage()    {}
 func (*KeygenSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{8}
+	return fileDescriptor_4c5266980cca9f48, []int{10}
 }
 func (m *KeygenSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +475,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{9}
+	return fileDescriptor_4c5266980cca9f48, []int{11}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +512,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c5266980cca9f48, []int{10}
+	return fileDescriptor_4c5266980cca9f48, []int{12}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,11 +541,303 @@ func (m *ParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
+type SigningSessionRequest struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *SigningSessionRequest) Reset()         { *m = SigningSessionRequest{} }
+func (m *SigningSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionRequest) ProtoMessage()    {}
+func (*SigningSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{13}
+}
+func (m *SigningSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionRequest.Merge(m, src)
+}
+func (m *SigningSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionRequest proto.InternalMessageInfo
+
+// SigningSessionInfo contains the progress of a signing session
+type SigningSessionInfo struct {
+	ID              uint64                                                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyID           github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+	Module          string                                                         `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	State           exported.MultisigState                                         `protobuf:"varint,4,opt,name=state,proto3,enum=axelar.multisig.exported.v1beta1.MultisigState" json:"state,omitempty"`
+	PayloadHash     github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash  `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.Hash" json:"payload_hash,omitempty"`
+	ExpiresAt       int64                                                          `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CompletedAt     int64                                                          `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	GracePeriod     int64                                                          `protobuf:"varint,8,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	SignedWeight    cosmossdk_io_math.Uint                                         `protobuf:"bytes,9,opt,name=signed_weight,json=signedWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"signed_weight"`
+	ThresholdWeight cosmossdk_io_math.Uint                                         `protobuf:"bytes,10,opt,name=threshold_weight,json=thresholdWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"threshold_weight"`
+	Signers         []string                                                       `protobuf:"bytes,11,rep,name=signers,proto3" json:"signers,omitempty"`
+	MissingSigners  []string                                                       `protobuf:"bytes,12,rep,name=missing_signers,json=missingSigners,proto3" json:"missing_signers,omitempty"`
+	// metadata the requesting module attached to the signing session, e.g. the
+	// chain and command batch of an EVM signing session
+	ModuleMetadata *types1.Any `protobuf:"bytes,13,opt,name=module_metadata,json=moduleMetadata,proto3" json:"module_metadata,omitempty"`
+}
+
+func (m *SigningSessionInfo) Reset()         { *m = SigningSessionInfo{} }
+func (m *SigningSessionInfo) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionInfo) ProtoMessage()    {}
+func (*SigningSessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{14}
+}
+func (m *SigningSessionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionInfo.Merge(m, src)
+}
+func (m *SigningSessionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionInfo proto.InternalMessageInfo
+
+// SigningSessionResponse contains the signing session info for a given
+// signing session ID
+type SigningSessionResponse struct {
+	SigningSession SigningSessionInfo `protobuf:"bytes,1,opt,name=signing_session,json=signingSession,proto3" json:"signing_session"`
+}
+
+func (m *SigningSessionResponse) Reset()         { *m = SigningSessionResponse{} }
+func (m *SigningSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionResponse) ProtoMessage()    {}
+func (*SigningSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{15}
+}
+func (m *SigningSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionResponse.Merge(m, src)
+}
+func (m *SigningSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionResponse proto.InternalMessageInfo
+
+type SigningSessionsRequest struct {
+	KeyID      github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
+	Module     string                                                         `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	State      exported.MultisigState                                         `protobuf:"varint,3,opt,name=state,proto3,enum=axelar.multisig.exported.v1beta1.MultisigState" json:"state,omitempty"`
+	Pagination *query.PageRequest                                             `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SigningSessionsRequest) Reset()         { *m = SigningSessionsRequest{} }
+func (m *SigningSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionsRequest) ProtoMessage()    {}
+func (*SigningSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{16}
+}
+func (m *SigningSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionsRequest.Merge(m, src)
+}
+func (m *SigningSessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionsRequest proto.InternalMessageInfo
+
+// SigningSessionsResponse contains the signing sessions matching the request
+// filters
+type SigningSessionsResponse struct {
+	SigningSessions []SigningSessionInfo `protobuf:"bytes,1,rep,name=signing_sessions,json=signingSessions,proto3" json:"signing_sessions"`
+	Pagination      *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *SigningSessionsResponse) Reset()         { *m = SigningSessionsResponse{} }
+func (m *SigningSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SigningSessionsResponse) ProtoMessage()    {}
+func (*SigningSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{17}
+}
+func (m *SigningSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningSessionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningSessionsResponse.Merge(m, src)
+}
+func (m *SigningSessionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningSessionsResponse proto.InternalMessageInfo
+
+type ValidatorSigningStatsRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *ValidatorSigningStatsRequest) Reset()         { *m = ValidatorSigningStatsRequest{} }
+func (m *ValidatorSigningStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningStatsRequest) ProtoMessage()    {}
+func (*ValidatorSigningStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{18}
+}
+func (m *ValidatorSigningStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningStatsRequest.Merge(m, src)
+}
+func (m *ValidatorSigningStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningStatsRequest proto.InternalMessageInfo
+
+// ValidatorSigningStatsResponse contains the participation of a validator in
+// the most recent signing sessions it was a participant of
+type ValidatorSigningStatsResponse struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// maximum number of recent signing sessions that are tracked
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// number of tracked signing sessions the validator was a participant of
+	SessionCount uint64 `protobuf:"varint,3,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	SignedCount  uint64 `protobuf:"varint,4,opt,name=signed_count,json=signedCount,proto3" json:"signed_count,omitempty"`
+	MissedCount  uint64 `protobuf:"varint,5,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (m *ValidatorSigningStatsResponse) Reset()         { *m = ValidatorSigningStatsResponse{} }
+func (m *ValidatorSigningStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningStatsResponse) ProtoMessage()    {}
+func (*ValidatorSigningStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c5266980cca9f48, []int{19}
+}
+func (m *ValidatorSigningStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningStatsResponse.Merge(m, src)
+}
+func (m *ValidatorSigningStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningStatsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*KeyIDRequest)(nil), "axelar.multisig.v1beta1.KeyIDRequest")
 	proto.RegisterType((*KeyIDResponse)(nil), "axelar.multisig.v1beta1.KeyIDResponse")
 	proto.RegisterType((*NextKeyIDRequest)(nil), "axelar.multisig.v1beta1.NextKeyIDRequest")
 	proto.RegisterType((*NextKeyIDResponse)(nil), "axelar.multisig.v1beta1.NextKeyIDResponse")
+	proto.RegisterType((*ActiveKeyIDsRequest)(nil), "axelar.multisig.v1beta1.ActiveKeyIDsRequest")
+	proto.RegisterType((*ActiveKeyIDsResponse)(nil), "axelar.multisig.v1beta1.ActiveKeyIDsResponse")
 	proto.RegisterType((*KeyRequest)(nil), "axelar.multisig.v1beta1.KeyRequest")
 	proto.RegisterType((*KeygenParticipant)(nil), "axelar.multisig.v1beta1.KeygenParticipant")
 	proto.RegisterType((*KeyResponse)(nil), "axelar.multisig.v1beta1.KeyResponse")
@@ -473,6 +845,13 @@ func init() {
 	proto.RegisterType((*KeygenSessionResponse)(nil), "axelar.multisig.v1beta1.KeygenSessionResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.multisig.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.multisig.v1beta1.ParamsResponse")
+	proto.RegisterType((*SigningSessionRequest)(nil), "axelar.multisig.v1beta1.SigningSessionRequest")
+	proto.RegisterType((*SigningSessionInfo)(nil), "axelar.multisig.v1beta1.SigningSessionInfo")
+	proto.RegisterType((*SigningSessionResponse)(nil), "axelar.multisig.v1beta1.SigningSessionResponse")
+	proto.RegisterType((*SigningSessionsRequest)(nil), "axelar.multisig.v1beta1.SigningSessionsRequest")
+	proto.RegisterType((*SigningSessionsResponse)(nil), "axelar.multisig.v1beta1.SigningSessionsResponse")
+	proto.RegisterType((*ValidatorSigningStatsRequest)(nil), "axelar.multisig.v1beta1.ValidatorSigningStatsRequest")
+	proto.RegisterType((*ValidatorSigningStatsResponse)(nil), "axelar.multisig.v1beta1.ValidatorSigningStatsResponse")
 }
 
 func init() {
@@ -480,55 +859,87 @@ func init() {
 }
 
 var fileDescriptor_4c5266980cca9f48 = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0xe2, 0x46,
-	0x14, 0xc6, 0xe1, 0x57, 0xf2, 0x20, 0xbf, 0x2c, 0x92, 0xa0, 0x48, 0x31, 0x94, 0xe6, 0x80, 0xaa,
-	0xd6, 0x56, 0x52, 0xb5, 0xb7, 0x56, 0x0d, 0x6d, 0x0f, 0x11, 0x6a, 0x1b, 0x39, 0x34, 0xad, 0x7a,
-	0x41, 0x36, 0x7e, 0x35, 0x23, 0xb0, 0xc7, 0xf1, 0x0c, 0x0d, 0xee, 0xa1, 0xd7, 0x5e, 0xf3, 0x67,
-	0xe5, 0x98, 0x63, 0xd5, 0x03, 0xbb, 0x4b, 0xfe, 0x8b, 0x3d, 0xad, 0x18, 0x0f, 0x4e, 0x20, 0x1b,
-	0x85, 0xd5, 0x46, 0xdc, 0x98, 0x37, 0xdf, 0xf7, 0xde, 0x9b, 0xf7, 0xbe, 0x4f, 0x06, 0x3e, 0xb5,
-	0x86, 0xd8, 0xb7, 0x42, 0xc3, 0x1b, 0xf4, 0x39, 0x61, 0xc4, 0x35, 0xfe, 0x3a, 0xb2, 0x91, 0x5b,
-	0x47, 0xc6, 0xe5, 0x00, 0xc3, 0x48, 0x0f, 0x42, 0xca, 0xa9, 0xba, 0x17, 0x83, 0xf4, 0x29, 0x48,
-	0x97, 0xa0, 0xfd, 0x92, 0x4b, 0x5d, 0x2a, 0x30, 0xc6, 0xe4, 0x57, 0x0c, 0xdf, 0xaf, 0xb8, 0x94,
-	0xba, 0x7d, 0x34, 0xc4, 0xc9, 0x1e, 0xfc, 0x69, 0x70, 0xe2, 0x21, 0xe3, 0x96, 0x17, 0x48, 0xc0,
-	0xe7, 0xf3, 0x45, 0x71, 0x18, 0xd0, 0x90, 0xa3, 0x93, 0x54, 0xe7, 0x51, 0x80, 0x4c, 0xa2, 0x0f,
-	0x9f, 0x6a, 0x31, 0xb0, 0x42, 0xcb, 0x93, 0xa8, 0xda, 0x21, 0x14, 0x9b, 0x18, 0x9d, 0xfe, 0x60,
-	0xe2, 0xe5, 0x00, 0x19, 0x57, 0x4b, 0x90, 0xed, 0x74, 0x2d, 0xe2, 0x97, 0x95, 0xaa, 0x52, 0x5f,
-	0x33, 0xe3, 0x43, 0x8d, 0xc1, 0xba, 0x44, 0xb1, 0x80, 0xfa, 0x0c, 0x55, 0x1b, 0x72, 0x3d, 0x8c,
-	0xda, 0xc4, 0x89, 0x71, 0x8d, 0xe6, 0x78, 0x54, 0xc9, 0x0a, 0xc8, 0xdb, 0x51, 0xe5, 0x5b, 0x97,
-	0xf0, 0xee, 0xc0, 0xd6, 0x3b, 0xd4, 0x33, 0xe2, 0x26, 0x7c, 0xe4, 0x57, 0x34, 0xec, 0xc9, 0xd3,
-	0x17, 0x1d, 0x1a, 0xa2, 0x31, 0x7c, 0xfc, 0x0e, 0x3d, 0x2e, 0x92, 0xed, 0x61, 0x74, 0xea, 0xd4,
-	0xea, 0xb0, 0xf5, 0x33, 0x0e, 0xf9, 0x02, 0xed, 0x5d, 0xc1, 0xf6, 0x03, 0xe4, 0x12, 0x5b, 0x0c,
-	0x00, 0x9a, 0x18, 0x4d, 0x9b, 0x5b, 0x46, 0xc5, 0x7f, 0x60, 0xbb, 0x89, 0x91, 0x8b, 0xfe, 0x99,
-	0x15, 0x72, 0xd2, 0x21, 0x81, 0xe5, 0x73, 0xb5, 0x0c, 0x79, 0xcb, 0x71, 0x42, 0x64, 0x4c, 0xce,
-	0x65, 0x7a, 0x54, 0xbf, 0x86, 0xdc, 0x15, 0x12, 0xb7, 0xcb, 0xcb, 0x2b, 0x55, 0xa5, 0x5e, 0x6c,
-	0x68, 0x37, 0xa3, 0x4a, 0xea, 0xff, 0x51, 0x65, 0xb7, 0x43, 0x99, 0x47, 0x19, 0x73, 0x7a, 0x3a,
-	0xa1, 0x86, 0x67, 0xf1, 0xae, 0xfe, 0x2b, 0xf1, 0xb9, 0x29, 0xd1, 0xea, 0x1e, 0xe4, 0x83, 0x81,
-	0xdd, 0xee, 0x61, 0x54, 0x4e, 0x8b, 0x8c, 0xb9, 0x60, 0x60, 0x37, 0x31, 0xaa, 0x5d, 0x67, 0xa0,
-	0x20, 0x9e, 0xbc, 0xbc, 0x29, 0xab, 0xdf, 0x41, 0x96, 0x71, 0x8b, 0xa3, 0x78, 0xc3, 0xc6, 0xf1,
-	0x67, 0xfa, 0xbc, 0xaf, 0x12, 0x9a, 0x94, 0xf8, 0x84, 0x7e, 0x3e, 0x61, 0x98, 0x31, 0x51, 0x3d,
-	0x00, 0x60, 0xdc, 0x9a, 0x40, 0xda, 0x16, 0x17, 0x2f, 0x4a, 0x9b, 0x6b, 0x32, 0x72, 0xc2, 0xd5,
-	0x0b, 0x28, 0xdd, 0x5f, 0xb7, 0x13, 0xdb, 0x95, 0x33, 0x55, 0xa5, 0x5e, 0x38, 0xde, 0xd7, 0x63,
-	0x63, 0xea, 0x53, 0x63, 0xea, 0xad, 0x29, 0xa2, 0xb1, 0x3a, 0x99, 0xe7, 0xf5, 0xab, 0x8a, 0x62,
-	0xaa, 0x49, 0xba, 0xe4, 0x56, 0x3d, 0x85, 0x2d, 0xde, 0x0d, 0x91, 0x75, 0x69, 0xdf, 0x69, 0xcb,
-	0x3d, 0x64, 0x17, 0xda, 0xc3, 0x66, 0xc2, 0xfb, 0x2d, 0x5e, 0xc8, 0xf7, 0xb0, 0x6e, 0x53, 0xdf,
-	0xc1, 0x24, 0x4f, 0x6e, 0xa1, 0x3c, 0xc5, 0x98, 0x24, 0x93, 0xb4, 0xa0, 0x18, 0xdc, 0xcb, 0x86,
-	0x95, 0xf3, 0xd5, 0x74, 0xbd, 0xf0, 0x9e, 0x79, 0x3e, 0x18, 0xe3, 0xac, 0xd2, 0x1a, 0x99, 0x49,
-	0x3d, 0x73, 0x26, 0x4b, 0xed, 0x6f, 0x28, 0xc5, 0xc0, 0x73, 0x64, 0x8c, 0x50, 0x7f, 0x99, 0x76,
-	0xf8, 0x37, 0x0b, 0x3b, 0x73, 0xc5, 0xa5, 0x30, 0x67, 0x57, 0xae, 0x2c, 0xba, 0xf2, 0x95, 0x8f,
-	0x5c, 0xf9, 0x01, 0x00, 0x0e, 0x03, 0x12, 0x22, 0x7b, 0xa0, 0x34, 0x19, 0x39, 0xe1, 0xea, 0x27,
-	0x50, 0xec, 0x50, 0x2f, 0xe8, 0xa3, 0xec, 0x2b, 0x23, 0x00, 0x85, 0x24, 0x16, 0x43, 0xdc, 0xd0,
-	0xea, 0x60, 0x3b, 0xc0, 0x90, 0x50, 0x47, 0x08, 0x26, 0x6d, 0x16, 0x44, 0xec, 0x4c, 0x84, 0xd4,
-	0x1f, 0xa7, 0x86, 0xc8, 0x09, 0x43, 0x18, 0xcf, 0x1b, 0xe2, 0x27, 0x79, 0x33, 0xe3, 0x8a, 0x0b,
-	0xd8, 0xeb, 0x89, 0xd9, 0xb5, 0x1f, 0xa9, 0x34, 0xbf, 0x90, 0xba, 0x76, 0x62, 0x7a, 0x6b, 0x4e,
-	0xab, 0xbf, 0x43, 0x99, 0x11, 0xd7, 0x27, 0xbe, 0xfb, 0x38, 0xf1, 0xea, 0x42, 0x89, 0x77, 0x25,
-	0xbf, 0xf5, 0x9c, 0x0b, 0xd6, 0x5e, 0xc0, 0x05, 0xf0, 0x22, 0x2e, 0xd8, 0x84, 0xf5, 0x33, 0xf1,
-	0x61, 0x95, 0xf2, 0xaf, 0xfd, 0x02, 0x1b, 0xd3, 0x80, 0x94, 0xe4, 0x37, 0x90, 0x8b, 0xbf, 0xbd,
-	0x42, 0x8e, 0x85, 0xe3, 0xca, 0x93, 0x25, 0x63, 0xa2, 0xac, 0x23, 0x49, 0x8d, 0xf3, 0x9b, 0x37,
-	0x5a, 0xea, 0x66, 0xac, 0x29, 0xb7, 0x63, 0x4d, 0x79, 0x3d, 0xd6, 0x94, 0xeb, 0x3b, 0x2d, 0x75,
-	0x7b, 0xa7, 0xa5, 0xfe, 0xbb, 0xd3, 0x52, 0x7f, 0x7c, 0xf5, 0xa1, 0x86, 0x12, 0xff, 0x15, 0xec,
-	0x9c, 0x50, 0xf8, 0x97, 0xef, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x4b, 0x89, 0xfc, 0xd1, 0x08,
-	0x00, 0x00,
+	// 1274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0x8e, 0x1d, 0x7b, 0x9d, 0x1c, 0x3b, 0x49, 0xbb, 0xbf, 0x34, 0xd9, 0x5f, 0xd4, 0xda, 0x61,
+	0x5b, 0xb5, 0xa6, 0xa5, 0xbb, 0x6a, 0x11, 0x5c, 0x51, 0x44, 0xdc, 0xf2, 0x27, 0x0a, 0x85, 0x68,
+	0x13, 0x0a, 0xaa, 0x90, 0xac, 0xb1, 0x77, 0xba, 0x1e, 0xd9, 0xde, 0xd9, 0xee, 0x8c, 0x9b, 0x2c,
+	0x12, 0xdc, 0x72, 0xdb, 0x77, 0xe0, 0x15, 0x78, 0x00, 0x2e, 0x2b, 0xae, 0x7a, 0x85, 0x10, 0x17,
+	0x06, 0xdc, 0x97, 0x40, 0xbd, 0x42, 0x3b, 0x33, 0xbb, 0xfe, 0xd7, 0x34, 0x6e, 0x1b, 0x72, 0xb7,
+	0x73, 0xe6, 0x3b, 0x67, 0xce, 0x9c, 0xf3, 0x7d, 0xb3, 0x33, 0x70, 0x11, 0x1d, 0xe2, 0x0e, 0x0a,
+	0xed, 0x6e, 0xaf, 0xc3, 0x09, 0x23, 0x9e, 0xfd, 0xe8, 0x46, 0x03, 0x73, 0x74, 0xc3, 0x7e, 0xd8,
+	0xc3, 0x61, 0x64, 0x05, 0x21, 0xe5, 0x54, 0x5f, 0x97, 0x20, 0x2b, 0x01, 0x59, 0x0a, 0xb4, 0xb1,
+	0xea, 0x51, 0x8f, 0x0a, 0x8c, 0x1d, 0x7f, 0x49, 0xf8, 0xc6, 0xff, 0x3d, 0x4a, 0xbd, 0x0e, 0xb6,
+	0xc5, 0xa8, 0xd1, 0x7b, 0x60, 0x23, 0x5f, 0x45, 0xda, 0xa8, 0x4c, 0x4e, 0x71, 0xd2, 0xc5, 0x8c,
+	0xa3, 0x6e, 0x90, 0xf8, 0x36, 0x29, 0xeb, 0x52, 0x56, 0x97, 0x41, 0xe5, 0x40, 0x4d, 0x5d, 0x95,
+	0x23, 0xbb, 0x81, 0x18, 0x96, 0xe9, 0xa5, 0xc9, 0x06, 0xc8, 0x23, 0x3e, 0xe2, 0x84, 0xfa, 0x0a,
+	0xfb, 0xce, 0xe4, 0xb6, 0xf0, 0x61, 0x40, 0x43, 0x8e, 0xdd, 0xd4, 0x85, 0x47, 0x01, 0x4e, 0x22,
+	0x5f, 0x3a, 0xaa, 0x08, 0x01, 0x0a, 0x51, 0x57, 0xa1, 0xcc, 0x4b, 0x50, 0xda, 0xc1, 0xd1, 0xf6,
+	0x1d, 0x07, 0x3f, 0xec, 0x61, 0xc6, 0xf5, 0x55, 0xc8, 0x37, 0x5b, 0x88, 0xf8, 0x46, 0x66, 0x33,
+	0x53, 0x5d, 0x74, 0xe4, 0xc0, 0x64, 0xb0, 0xa4, 0x50, 0x2c, 0xa0, 0x3e, 0xc3, 0x7a, 0x03, 0xb4,
+	0x36, 0x8e, 0xea, 0xc4, 0x95, 0xb8, 0xda, 0xce, 0xa0, 0x5f, 0xc9, 0x0b, 0xc8, 0xf3, 0x7e, 0xe5,
+	0x43, 0x8f, 0xf0, 0x56, 0xaf, 0x61, 0x35, 0x69, 0xd7, 0x96, 0x49, 0xf8, 0x98, 0x1f, 0xd0, 0xb0,
+	0xad, 0x46, 0xd7, 0x9b, 0x34, 0xc4, 0xf6, 0xe1, 0xf4, 0x3e, 0x2c, 0xb9, 0x48, 0xbe, 0x8d, 0xa3,
+	0x6d, 0xd7, 0xac, 0xc2, 0x99, 0x2f, 0xf0, 0x21, 0x9f, 0x21, 0xbd, 0x03, 0x38, 0x3b, 0x82, 0x3c,
+	0xc5, 0x14, 0xaf, 0xc1, 0xff, 0xb6, 0x9a, 0x9c, 0x3c, 0xc2, 0xc2, 0xca, 0x5e, 0x9e, 0xe5, 0xf7,
+	0xb0, 0x3a, 0x0e, 0x56, 0x89, 0x62, 0x28, 0xc8, 0x44, 0x99, 0x91, 0xd9, 0x9c, 0xaf, 0x2e, 0xd6,
+	0x3e, 0x1f, 0xf4, 0x2b, 0x9a, 0x04, 0x9d, 0x40, 0xaa, 0x9a, 0x48, 0x95, 0x99, 0x01, 0xc0, 0x0e,
+	0x8e, 0x92, 0x14, 0x4f, 0xa3, 0x3a, 0x3f, 0xc0, 0xd9, 0x1d, 0x1c, 0x79, 0xd8, 0xdf, 0x45, 0x21,
+	0x27, 0x4d, 0x12, 0x20, 0x9f, 0xeb, 0x06, 0x14, 0x90, 0xeb, 0x86, 0x98, 0x31, 0x55, 0x9d, 0x64,
+	0xa8, 0xbf, 0x0f, 0xda, 0x01, 0x26, 0x5e, 0x8b, 0x1b, 0xd9, 0xcd, 0x4c, 0xb5, 0x54, 0x2b, 0x3f,
+	0xe9, 0x57, 0xe6, 0xfe, 0xe8, 0x57, 0xd6, 0xa4, 0x44, 0x98, 0xdb, 0xb6, 0x08, 0xb5, 0xbb, 0x88,
+	0xb7, 0xac, 0xaf, 0x88, 0xcf, 0x1d, 0x85, 0xd6, 0xd7, 0xa1, 0x10, 0xf4, 0x1a, 0xf5, 0x36, 0x8e,
+	0x8c, 0x79, 0x11, 0x51, 0x0b, 0x7a, 0x8d, 0x1d, 0x1c, 0x99, 0xbf, 0xe5, 0xa0, 0x28, 0xb6, 0x7c,
+	0x7a, 0x8c, 0xd0, 0x3f, 0x82, 0x3c, 0xe3, 0x88, 0x63, 0xb1, 0x87, 0xe5, 0x9b, 0x57, 0xad, 0xc9,
+	0x53, 0x26, 0x75, 0x53, 0x72, 0x8c, 0xdd, 0xf7, 0x62, 0x0f, 0x47, 0x3a, 0xea, 0x17, 0x00, 0x18,
+	0x47, 0x31, 0xa4, 0x8e, 0xb8, 0xd8, 0xd1, 0xbc, 0xb3, 0xa8, 0x2c, 0x5b, 0x5c, 0xbf, 0x07, 0xab,
+	0xc3, 0xe9, 0x7a, 0x7a, 0xd2, 0x18, 0xb9, 0xcd, 0x4c, 0xb5, 0x78, 0x73, 0xc3, 0x92, 0x67, 0x91,
+	0x95, 0x9c, 0x45, 0xd6, 0x7e, 0x82, 0xa8, 0x2d, 0xc4, 0xf5, 0x7c, 0xfc, 0x67, 0x25, 0xe3, 0xe8,
+	0x69, 0xb8, 0x74, 0x56, 0xdf, 0x86, 0x33, 0xbc, 0x15, 0x62, 0xd6, 0xa2, 0x1d, 0xb7, 0xae, 0xfa,
+	0x90, 0x9f, 0xa9, 0x0f, 0x2b, 0xa9, 0xdf, 0xd7, 0xb2, 0x21, 0xb7, 0x61, 0xa9, 0x41, 0x7d, 0x17,
+	0xa7, 0x71, 0xb4, 0x99, 0xe2, 0x94, 0xa4, 0x93, 0x0a, 0xb2, 0x0f, 0xa5, 0x60, 0x48, 0x1b, 0x66,
+	0x14, 0x36, 0xe7, 0xab, 0xc5, 0x17, 0xd4, 0x73, 0xa4, 0x8c, 0xe3, 0x4c, 0xab, 0xe5, 0xe2, 0xf5,
+	0x9c, 0xb1, 0x28, 0xfa, 0x1d, 0x58, 0x88, 0x29, 0x10, 0x9f, 0x93, 0xc6, 0x82, 0xe8, 0xd0, 0xdb,
+	0x33, 0x75, 0x68, 0x3f, 0x0a, 0xb0, 0x53, 0x68, 0xcb, 0x0f, 0xf3, 0x3b, 0x58, 0x95, 0xcb, 0xed,
+	0x61, 0xc6, 0x08, 0xf5, 0x4f, 0x53, 0x54, 0x3f, 0xe6, 0xe1, 0xdc, 0xc4, 0xe2, 0x8a, 0xde, 0xe3,
+	0xc4, 0xc9, 0xcc, 0x4a, 0x9c, 0xec, 0x1b, 0x12, 0xe7, 0x02, 0x00, 0x3e, 0x0c, 0x48, 0x88, 0xd9,
+	0x08, 0x5f, 0x95, 0x65, 0x8b, 0xeb, 0x6f, 0x41, 0xa9, 0x49, 0xbb, 0x41, 0x07, 0xab, 0xbc, 0x72,
+	0x02, 0x50, 0x4c, 0x6d, 0x12, 0xe2, 0x85, 0xa8, 0x89, 0xeb, 0x01, 0x0e, 0x09, 0x75, 0x05, 0xed,
+	0xe6, 0x9d, 0xa2, 0xb0, 0xed, 0x0a, 0x93, 0xfe, 0x71, 0x22, 0x2b, 0x4d, 0x34, 0xcd, 0x3e, 0xbe,
+	0x69, 0x77, 0xd5, 0xcc, 0x98, 0xb6, 0xee, 0xc1, 0x7a, 0x5b, 0xd4, 0xae, 0x3e, 0xc5, 0xf5, 0xc2,
+	0x4c, 0x1c, 0x3d, 0x27, 0xdd, 0xf7, 0x27, 0x18, 0xff, 0x0d, 0x18, 0x8c, 0x78, 0x3e, 0xf1, 0xbd,
+	0xe9, 0xc0, 0x0b, 0x33, 0x05, 0x5e, 0x53, 0xfe, 0xfb, 0xc7, 0x69, 0x69, 0xf1, 0x04, 0xb4, 0x04,
+	0x27, 0xa1, 0x25, 0x73, 0x05, 0x96, 0x76, 0xc5, 0x55, 0x42, 0xd1, 0xdf, 0xfc, 0x12, 0x96, 0x13,
+	0x83, 0xa2, 0xe4, 0x2d, 0xd0, 0xe4, 0x6d, 0x43, 0xd0, 0xb1, 0x78, 0xb3, 0x72, 0xe4, 0x92, 0xd2,
+	0x51, 0xad, 0xa3, 0x9c, 0x4c, 0x1b, 0xce, 0xed, 0xc9, 0xb2, 0x4c, 0x08, 0x6d, 0x0d, 0xb2, 0x4a,
+	0x64, 0xb9, 0x9a, 0x36, 0xe8, 0x57, 0xb2, 0xdb, 0x77, 0x9c, 0x2c, 0x71, 0xcd, 0x7f, 0xf2, 0xa0,
+	0x8f, 0x7b, 0x6c, 0xfb, 0x0f, 0xe8, 0x51, 0xf0, 0x11, 0xbd, 0x66, 0xff, 0xb3, 0x1f, 0xc2, 0x1a,
+	0x68, 0x5d, 0xea, 0xf6, 0x3a, 0x38, 0xf9, 0x39, 0xc9, 0xd1, 0x90, 0xd1, 0xb9, 0x37, 0x62, 0xb4,
+	0x1b, 0xb7, 0x36, 0xea, 0x50, 0xe4, 0xd6, 0x5b, 0x88, 0xb5, 0xd4, 0x91, 0xbd, 0xf5, 0xbc, 0x5f,
+	0xb9, 0xf5, 0xda, 0xf9, 0x7f, 0x86, 0x58, 0xcb, 0x29, 0xaa, 0xb0, 0xf1, 0x60, 0x42, 0xe3, 0xda,
+	0x71, 0x1a, 0x2f, 0x1c, 0xaf, 0xf1, 0x85, 0x69, 0x8d, 0xdf, 0x86, 0xa5, 0x58, 0x04, 0xaf, 0x4c,
+	0x75, 0xe9, 0xa4, 0xa8, 0xfe, 0xa2, 0xdf, 0x18, 0xbc, 0xde, 0x6f, 0xcc, 0x80, 0x82, 0x08, 0x1d,
+	0x32, 0xa3, 0x18, 0xdf, 0xcb, 0x9c, 0x64, 0xa8, 0x5f, 0x81, 0x95, 0x2e, 0x61, 0x2c, 0x96, 0x7b,
+	0x82, 0x28, 0x09, 0xc4, 0xb2, 0x32, 0xef, 0x29, 0x20, 0x81, 0x15, 0xd9, 0xee, 0x7a, 0x17, 0x73,
+	0xe4, 0x22, 0x8e, 0x8c, 0x25, 0x21, 0x84, 0xd5, 0xa9, 0xe3, 0x76, 0xcb, 0x8f, 0x6a, 0x57, 0x7f,
+	0xfd, 0xf9, 0xfa, 0xe5, 0x91, 0xb6, 0xa9, 0xb7, 0x41, 0x93, 0xba, 0xb8, 0x69, 0xef, 0xc6, 0xc8,
+	0xbb, 0x28, 0x64, 0x2d, 0xd4, 0xc1, 0xa1, 0xb3, 0x2c, 0x03, 0xdf, 0x55, 0x71, 0x4d, 0x0e, 0x6b,
+	0x93, 0x5a, 0x51, 0x22, 0xbc, 0x0f, 0x2b, 0xc9, 0xe1, 0xc4, 0xe4, 0x94, 0x52, 0xe3, 0xb5, 0x23,
+	0xd5, 0x38, 0xad, 0x21, 0xa5, 0xcc, 0x65, 0x36, 0x36, 0x63, 0xfe, 0x94, 0x9d, 0x5c, 0x96, 0x9d,
+	0xe2, 0xcf, 0x70, 0x44, 0x5c, 0xd9, 0x17, 0x8b, 0x6b, 0xfe, 0x8d, 0xc4, 0xf5, 0x09, 0xc0, 0xf0,
+	0x11, 0xa6, 0x6e, 0x58, 0x97, 0x2d, 0xf5, 0x7e, 0x8b, 0x5f, 0x6c, 0x96, 0x7c, 0x50, 0x0e, 0x0f,
+	0x31, 0x0f, 0xab, 0xed, 0x3b, 0x23, 0x9e, 0xe6, 0x2f, 0x19, 0x58, 0x9f, 0xaa, 0x92, 0xea, 0xce,
+	0xb7, 0x70, 0x66, 0xa2, 0x3b, 0xf2, 0x19, 0xf0, 0x5a, 0xed, 0x59, 0x19, 0x6f, 0x0f, 0xd3, 0x3f,
+	0x1d, 0xdb, 0x81, 0xfc, 0xd5, 0x5f, 0x39, 0x76, 0x07, 0x32, 0xb5, 0xb1, 0x2d, 0x7c, 0x00, 0xe7,
+	0xef, 0xa1, 0x0e, 0x71, 0x11, 0xa7, 0x61, 0xb2, 0x3c, 0x47, 0x3c, 0xed, 0xf6, 0x79, 0x58, 0x7c,
+	0x94, 0xcc, 0xab, 0x8b, 0xfd, 0xd0, 0x10, 0x17, 0xe0, 0xc2, 0x11, 0xee, 0xaa, 0x0c, 0x2f, 0xf5,
+	0x8f, 0xfb, 0x7c, 0x40, 0x7c, 0x97, 0x1e, 0x88, 0x2d, 0xe4, 0x1c, 0x35, 0xd2, 0x2f, 0xc2, 0x92,
+	0x2a, 0x5a, 0xbd, 0x49, 0x7b, 0xbe, 0xbc, 0x7e, 0xe4, 0x9c, 0x92, 0x32, 0xde, 0x8e, 0x6d, 0xf1,
+	0xd1, 0xa3, 0xce, 0x15, 0x89, 0xc9, 0x09, 0x4c, 0x51, 0xda, 0x52, 0x48, 0xac, 0xdc, 0x14, 0x92,
+	0x97, 0x10, 0x69, 0x13, 0x90, 0xda, 0xde, 0x93, 0xbf, 0xcb, 0x73, 0x4f, 0x06, 0xe5, 0xcc, 0xd3,
+	0x41, 0x39, 0xf3, 0xd7, 0xa0, 0x9c, 0x79, 0xfc, 0xac, 0x3c, 0xf7, 0xf4, 0x59, 0x79, 0xee, 0xf7,
+	0x67, 0xe5, 0xb9, 0xfb, 0xef, 0xbd, 0x2a, 0x9f, 0xc5, 0x4b, 0xbd, 0xa1, 0x09, 0xf9, 0xbf, 0xfb,
+	0xef, 0x00, 0xa5, 0xde, 0x53, 0x15, 0xb1, 0x10, 0x00, 0x00,
 }
 
 func (m *KeyIDRequest) Marshal() (dAtA []byte, err error) {
@@ -651,6 +1062,68 @@ func (m *NextKeyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActiveKeyIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveKeyIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveKeyIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveKeyIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveKeyIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveKeyIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for iNdEx := len(m.KeyIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyIDs[iNdEx])
+			copy(dAtA[i:], m.KeyIDs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,6 +1221,11 @@ func (m *KeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Participants) > 0 {
 		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -996,171 +1474,511 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SigningSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *KeyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *SigningSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SigningSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NextKeyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SigningSessionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NextKeyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SigningSessionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SigningSessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ModuleMetadata != nil {
+		{
+			size, err := m.ModuleMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
-}
-
-func (m *KeygenParticipant) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MissingSigners) > 0 {
+		for iNdEx := len(m.MissingSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingSigners[iNdEx])
+			copy(dAtA[i:], m.MissingSigners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MissingSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.ThresholdWeight.Size()
+		i -= size
+		if _, err := m.ThresholdWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *KeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SignedWeight.Size()
+		i -= size
+		if _, err := m.SignedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if m.GracePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompletedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.State != 0 {
-		n += 1 + sovQuery(uint64(m.State))
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.StartedAt != 0 {
-		n += 1 + sovQuery(uint64(m.StartedAt))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAtTimestamp)
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ThresholdWeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BondedWeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *KeygenSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SigningSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KeyID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.SigningSession.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *KeygenSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SigningSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartedAt != 0 {
-		n += 1 + sovQuery(uint64(m.StartedAt))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAtTimestamp)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ExpiresAt != 0 {
-		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CompletedAt != 0 {
-		n += 1 + sovQuery(uint64(m.CompletedAt))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GracePeriod != 0 {
-		n += 1 + sovQuery(uint64(m.GracePeriod))
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.State != 0 {
-		n += 1 + sovQuery(uint64(m.State))
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.KeygenThresholdWeight.Size()
+	return dAtA[:n], nil
+}
+
+func (m *SigningSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigningSessions) > 0 {
+		for iNdEx := len(m.SigningSessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningSessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SessionCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NextKeyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NextKeyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveKeyIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveKeyIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyIDs) > 0 {
+		for _, s := range m.KeyIDs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *KeygenParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Weight.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = m.SigningThresholdWeight.Size()
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovQuery(uint64(m.StartedAt))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAtTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ThresholdWeight.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.BondedWeight.Size()
 	n += 1 + l + sovQuery(uint64(l))
@@ -1170,44 +1988,1333 @@ func (m *KeygenSessionResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovQuery(uint64(m.KeyType))
+	}
 	return n
 }
 
-func (m *ParamsRequest) Size() (n int) {
+func (m *KeygenSessionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *ParamsResponse) Size() (n int) {
+func (m *KeygenSessionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
+	if m.StartedAt != 0 {
+		n += 1 + sovQuery(uint64(m.StartedAt))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAtTimestamp)
 	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *KeyIDRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedAt))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriod))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = m.KeygenThresholdWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SigningThresholdWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SigningSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *SigningSessionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiresAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedAt))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriod))
+	}
+	l = m.SignedWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ThresholdWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MissingSigners) > 0 {
+		for _, s := range m.MissingSigners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ModuleMetadata != nil {
+		l = m.ModuleMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningSession.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SigningSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SigningSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningSessions) > 0 {
+		for _, e := range m.SigningSessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSigningStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSigningStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if m.SessionCount != 0 {
+		n += 1 + sovQuery(uint64(m.SessionCount))
+	}
+	if m.SignedCount != 0 {
+		n += 1 + sovQuery(uint64(m.SignedCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovQuery(uint64(m.MissedCount))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextKeyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextKeyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextKeyIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextKeyIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveKeyIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveKeyIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveKeyIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveKeyIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveKeyIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveKeyIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIDs = append(m.KeyIDs, github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.KeyState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAtTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAtTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ThresholdWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, KeygenParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeygenSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1218,21 +3325,182 @@ func (m *KeyIDRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyIDRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeygenSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeygenSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAtTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAtTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.MultisigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenThresholdWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeygenThresholdWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningThresholdWeight", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1242,79 +3510,63 @@ func (m *KeyIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SigningThresholdWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedWeight", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BondedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1324,23 +3576,25 @@ func (m *KeyIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, KeygenParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1363,7 +3617,7 @@ func (m *KeyIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NextKeyIDRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,44 +3640,12 @@ func (m *NextKeyIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextKeyIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextKeyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1445,7 +3667,7 @@ func (m *NextKeyIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NextKeyIDResponse) Unmarshal(dAtA []byte) error {
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1468,17 +3690,17 @@ func (m *NextKeyIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextKeyIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextKeyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1488,23 +3710,24 @@ func (m *NextKeyIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1527,7 +3750,7 @@ func (m *NextKeyIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRequest) Unmarshal(dAtA []byte) error {
+func (m *SigningSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1550,17 +3773,17 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var stringLen uint64
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1570,24 +3793,11 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1609,7 +3819,7 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
+func (m *SigningSessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,15 +3842,34 @@ func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeygenParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningSessionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeygenParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningSessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1668,13 +3897,13 @@ func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1684,30 +3913,48 @@ func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= exported.MultisigState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1717,79 +3964,31 @@ func (m *KeygenParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadHash == nil {
+				m.PayloadHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1799,29 +3998,16 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
 			}
-			m.State = 0
+			m.CompletedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1831,16 +4017,16 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= exported.KeyState(b&0x7F) << shift
+				m.CompletedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
 			}
-			m.StartedAt = 0
+			m.GracePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1850,16 +4036,16 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedAt |= int64(b&0x7F) << shift
+				m.GracePeriod |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAtTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedWeight", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1869,26 +4055,26 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAtTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdWeight", wireType)
 			}
@@ -1921,11 +4107,11 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1935,28 +4121,59 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingSigners = append(m.MissingSigners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1983,8 +4200,10 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, KeygenParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ModuleMetadata == nil {
+				m.ModuleMetadata = &types1.Any{}
+			}
+			if err := m.ModuleMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2009,7 +4228,7 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeygenSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *SigningSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2032,17 +4251,17 @@ func (m *KeygenSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeygenSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeygenSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSession", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2052,23 +4271,24 @@ func (m *KeygenSessionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			if err := m.SigningSession.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2091,7 +4311,7 @@ func (m *KeygenSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *SigningSessionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,88 +4334,17 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeygenSessionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeygenSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningSessionsRequest: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			m.StartedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAtTimestamp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAtTimestamp, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			m.CompletedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2205,16 +4354,29 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.GracePeriod = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2224,12 +4386,25 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GracePeriod |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
@@ -2248,11 +4423,11 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenThresholdWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2262,63 +4437,83 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeygenThresholdWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningThresholdWeight", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SigningThresholdWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSessions", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2328,28 +4523,29 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningSessions = append(m.SigningSessions, SigningSessionInfo{})
+			if err := m.SigningSessions[len(m.SigningSessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2376,8 +4572,10 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, KeygenParticipant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2402,7 +4600,7 @@ func (m *KeygenSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSigningStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2425,12 +4623,44 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSigningStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSigningStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2452,7 +4682,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSigningStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,17 +4705,17 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSigningStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSigningStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2495,25 +4725,100 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionCount", wireType)
+			}
+			m.SessionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCount", wireType)
+			}
+			m.SignedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
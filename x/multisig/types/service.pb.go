@@ -37,51 +37,61 @@ func init() {
 }
 
 var fileDescriptor_2f253d13b0297bdf = []byte{
-	// 704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4f, 0x4f, 0xd4, 0x4c,
-	0x1c, 0xc7, 0x99, 0xe7, 0x09, 0x24, 0x0c, 0x6b, 0x4c, 0x26, 0x26, 0x26, 0x88, 0x15, 0x96, 0x7f,
-	0x61, 0x81, 0x8e, 0xa0, 0x5e, 0x38, 0x1a, 0x2e, 0x48, 0x04, 0x64, 0xd5, 0x18, 0x62, 0xb2, 0x99,
-	0x2e, 0x93, 0x32, 0x81, 0xed, 0x94, 0xce, 0x14, 0x77, 0x63, 0xbc, 0x70, 0xf1, 0x6a, 0xe2, 0x41,
-	0x13, 0x0f, 0xbe, 0x09, 0xcf, 0xc6, 0x8b, 0x89, 0x47, 0x82, 0x17, 0x8f, 0x66, 0xd7, 0x77, 0xe1,
-	0xc5, 0xcc, 0x74, 0xba, 0x74, 0x25, 0xd3, 0xd6, 0x1b, 0x84, 0xcf, 0xaf, 0xfd, 0x7c, 0x67, 0xbe,
-	0xbf, 0x06, 0x38, 0x4b, 0xda, 0xf4, 0x88, 0x44, 0xb8, 0x15, 0x1f, 0x49, 0x26, 0x98, 0x8f, 0x4f,
-	0x56, 0x3c, 0x2a, 0xc9, 0x0a, 0x16, 0x34, 0x3a, 0x61, 0x4d, 0xea, 0x86, 0x11, 0x97, 0x1c, 0x5d,
-	0x4f, 0x30, 0x37, 0xc5, 0x5c, 0x83, 0x8d, 0x5f, 0xf3, 0xb9, 0xcf, 0x35, 0x83, 0xd5, 0x4f, 0x09,
-	0x3e, 0x3e, 0xe1, 0x73, 0xee, 0x1f, 0x51, 0x4c, 0x42, 0x86, 0x49, 0x10, 0x70, 0x49, 0x24, 0xe3,
-	0x81, 0x30, 0x7f, 0x9d, 0xb4, 0xbd, 0x53, 0xb6, 0x0d, 0x31, 0x6d, 0x23, 0x8e, 0x63, 0x1a, 0x75,
-	0x12, 0x68, 0xf5, 0xf7, 0x28, 0x84, 0x0f, 0x85, 0x5f, 0x4f, 0x44, 0xd1, 0x67, 0x00, 0xc7, 0xea,
-	0x92, 0x44, 0x72, 0x93, 0x76, 0x7c, 0x1a, 0xa0, 0x45, 0xd7, 0xe2, 0xec, 0x66, 0xa8, 0x5d, 0x7a,
-	0x1c, 0x53, 0x21, 0xc7, 0x97, 0xca, 0xc1, 0x22, 0xe4, 0x81, 0xa0, 0xd5, 0xc7, 0xa7, 0xdf, 0x7f,
-	0xbd, 0xfd, 0x6f, 0x6b, 0x0d, 0xd4, 0xf6, 0xaa, 0x6b, 0xa0, 0x56, 0xbd, 0x89, 0xff, 0xf6, 0x15,
-	0x6a, 0xaa, 0x71, 0xa8, 0xc7, 0xaa, 0xb3, 0xd8, 0x7a, 0xc8, 0x19, 0x0c, 0x7d, 0x05, 0xb0, 0x52,
-	0x8f, 0xbd, 0x16, 0x93, 0x3b, 0xb1, 0xb7, 0x49, 0x3b, 0x28, 0x47, 0x2a, 0x83, 0xa5, 0x11, 0x96,
-	0x4b, 0xd2, 0x26, 0xc3, 0x33, 0x9d, 0x61, 0x57, 0x65, 0x98, 0x51, 0x19, 0x6e, 0x5d, 0xce, 0xa0,
-	0xc7, 0x1a, 0x61, 0xec, 0x29, 0xc3, 0xea, 0xbc, 0x3d, 0xc5, 0x00, 0x88, 0xce, 0x01, 0xbc, 0x9a,
-	0xbc, 0xb2, 0xce, 0xfc, 0x80, 0xc8, 0x38, 0xa2, 0x08, 0x17, 0xc8, 0xf5, 0xc9, 0x34, 0xcd, 0xed,
-	0xf2, 0x03, 0x26, 0xd0, 0x73, 0x1d, 0xe8, 0xa9, 0x0a, 0x34, 0xa7, 0x02, 0x4d, 0xd9, 0x02, 0x89,
-	0x74, 0xb4, 0xba, 0x50, 0x14, 0xa9, 0x8f, 0xa2, 0x4f, 0x00, 0x8e, 0xee, 0xaa, 0x1e, 0x53, 0x75,
-	0x33, 0x0b, 0x56, 0xbb, 0x3e, 0x93, 0x06, 0xa9, 0x95, 0x41, 0x4d, 0x84, 0x1d, 0x1d, 0xe1, 0x81,
-	0x8a, 0x30, 0xa9, 0x22, 0xdc, 0xb8, 0xe4, 0x17, 0xe9, 0x19, 0x7d, 0x1f, 0xd3, 0x56, 0xf9, 0x0b,
-	0x08, 0x7d, 0x04, 0xb0, 0x92, 0x94, 0x77, 0x3b, 0x94, 0xdb, 0xb1, 0xcc, 0xe9, 0x54, 0x16, 0x2b,
-	0xee, 0xd4, 0x20, 0x6d, 0xfc, 0x57, 0xb5, 0xff, 0x92, 0x52, 0xb7, 0xb7, 0x25, 0x69, 0x7b, 0x83,
-	0x87, 0xb2, 0xc1, 0x63, 0x89, 0x3e, 0x00, 0x38, 0xd6, 0x7f, 0xd8, 0x46, 0xde, 0xda, 0x66, 0xa8,
-	0xe2, 0xb5, 0x1d, 0x80, 0x8d, 0xde, 0x8a, 0xd6, 0x5b, 0x54, 0x7a, 0x73, 0x65, 0xf4, 0x58, 0x80,
-	0xde, 0x01, 0x58, 0x79, 0x12, 0xee, 0x13, 0x49, 0x77, 0x48, 0x44, 0x5a, 0x22, 0xe7, 0xfc, 0xb2,
-	0x58, 0xf1, 0xf9, 0x0d, 0xd2, 0x46, 0xb0, 0xa6, 0x05, 0x2d, 0xeb, 0x98, 0x0a, 0x86, 0x7a, 0x66,
-	0xf5, 0x7c, 0x18, 0x56, 0x1e, 0xa9, 0xaf, 0x61, 0xfa, 0xfd, 0x7b, 0x0d, 0xe0, 0xf0, 0x26, 0xed,
-	0x6c, 0xac, 0xa3, 0xd9, 0xbc, 0x53, 0xd9, 0x58, 0x4f, 0xe5, 0xe6, 0x8a, 0x30, 0x63, 0x85, 0xb5,
-	0xd5, 0x02, 0xca, 0xbd, 0xd2, 0x06, 0xdb, 0xc7, 0x2f, 0x9b, 0x07, 0x84, 0x05, 0xaf, 0xd0, 0x7b,
-	0x00, 0x47, 0xb7, 0x68, 0x5b, 0x26, 0x36, 0xf6, 0x5d, 0xe9, 0x33, 0xc5, 0xbb, 0x92, 0x41, 0x8d,
-	0xd5, 0x5d, 0x6d, 0xe5, 0xa2, 0x25, 0xab, 0x55, 0x40, 0xdb, 0xfa, 0xdb, 0x9a, 0x55, 0x3b, 0x81,
-	0xff, 0xab, 0xfd, 0x9d, 0xce, 0x8b, 0x9e, 0xda, 0xcc, 0xe4, 0x43, 0xc6, 0x63, 0x46, 0x7b, 0x38,
-	0x68, 0x22, 0xef, 0x74, 0xd4, 0x1e, 0x5e, 0x49, 0x2a, 0x59, 0xa7, 0x42, 0x30, 0x1e, 0xa0, 0xa2,
-	0xd5, 0x32, 0x5c, 0x2a, 0xe3, 0x96, 0xc5, 0xff, 0xe5, 0xd2, 0x54, 0xd1, 0x85, 0xf1, 0x39, 0x05,
-	0x70, 0xc4, 0x74, 0xdc, 0x5e, 0x8c, 0xc1, 0x76, 0xcf, 0x17, 0x72, 0x46, 0x66, 0x5e, 0xcb, 0x4c,
-	0xa1, 0xa2, 0x52, 0xdf, 0xaf, 0x7f, 0xeb, 0x3a, 0xe0, 0xac, 0xeb, 0x80, 0x9f, 0x5d, 0x07, 0xbc,
-	0xe9, 0x39, 0x43, 0x5f, 0x7a, 0x0e, 0x38, 0xeb, 0x39, 0x43, 0x3f, 0x7a, 0xce, 0xd0, 0xde, 0x3d,
-	0x9f, 0xc9, 0x83, 0xd8, 0x73, 0x9b, 0xbc, 0x65, 0x1e, 0x14, 0x50, 0xf9, 0x82, 0x47, 0x87, 0xe6,
-	0xb7, 0xe5, 0x26, 0x8f, 0x28, 0x6e, 0x5f, 0x3c, 0x5d, 0x76, 0x42, 0x2a, 0xbc, 0x11, 0xfd, 0xef,
-	0xc2, 0x9d, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x39, 0xfc, 0xab, 0xeb, 0x08, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4f, 0x4f, 0x1c, 0x37,
+	0x18, 0x87, 0x71, 0xab, 0x22, 0x61, 0xb6, 0xad, 0x64, 0xb5, 0xaa, 0x44, 0xe9, 0x14, 0x96, 0x7f,
+	0xdd, 0x05, 0x66, 0x58, 0x5a, 0x5a, 0x09, 0x55, 0x95, 0x5a, 0x71, 0xa1, 0xa8, 0x40, 0xd9, 0x16,
+	0x55, 0xa8, 0xd2, 0xca, 0xbb, 0x58, 0xc3, 0x88, 0xdd, 0xf1, 0x30, 0xf6, 0x6c, 0x76, 0x85, 0xb8,
+	0x70, 0xc9, 0x35, 0x52, 0x0e, 0x41, 0xca, 0x21, 0x87, 0x44, 0xca, 0x17, 0xc8, 0x39, 0xca, 0x25,
+	0x52, 0x0e, 0x39, 0xa0, 0xe4, 0x92, 0x63, 0xc4, 0xe6, 0x5b, 0xe4, 0x12, 0xd9, 0xe3, 0x19, 0x66,
+	0x20, 0x9e, 0x19, 0x6e, 0xa0, 0x79, 0x5e, 0xfb, 0xf9, 0xd9, 0x7e, 0xed, 0x85, 0x33, 0xb8, 0x47,
+	0xda, 0xd8, 0xb7, 0x3a, 0x41, 0x9b, 0x3b, 0xcc, 0xb1, 0xad, 0x6e, 0xad, 0x49, 0x38, 0xae, 0x59,
+	0x8c, 0xf8, 0x5d, 0xa7, 0x45, 0x4c, 0xcf, 0xa7, 0x9c, 0xa2, 0x6f, 0x42, 0xcc, 0x8c, 0x30, 0x53,
+	0x61, 0x63, 0x5f, 0xd9, 0xd4, 0xa6, 0x92, 0xb1, 0xc4, 0x5f, 0x21, 0x3e, 0x36, 0x6e, 0x53, 0x6a,
+	0xb7, 0x89, 0x85, 0x3d, 0xc7, 0xc2, 0xae, 0x4b, 0x39, 0xe6, 0x0e, 0x75, 0x99, 0xfa, 0x3a, 0xa1,
+	0x9b, 0x93, 0xf7, 0x14, 0x31, 0xa5, 0x23, 0x8e, 0x02, 0xe2, 0xf7, 0x43, 0x68, 0xf9, 0xfd, 0x08,
+	0x84, 0x7f, 0x31, 0xbb, 0x1e, 0x8a, 0xa2, 0xa7, 0x00, 0x8e, 0xd6, 0x39, 0xf6, 0xf9, 0x06, 0xe9,
+	0xdb, 0xc4, 0x45, 0xf3, 0xa6, 0xc6, 0xd9, 0x4c, 0x50, 0x3b, 0xe4, 0x28, 0x20, 0x8c, 0x8f, 0x2d,
+	0x14, 0x83, 0x99, 0x47, 0x5d, 0x46, 0xca, 0xff, 0x9c, 0xbe, 0x7e, 0x77, 0xf7, 0x93, 0xcd, 0x55,
+	0x50, 0xdd, 0x2b, 0xaf, 0x82, 0x6a, 0xf9, 0x3b, 0xeb, 0xaa, 0x2f, 0x13, 0x55, 0x8d, 0x43, 0x59,
+	0x56, 0x9e, 0xb1, 0xb4, 0x8b, 0x9c, 0xc0, 0xd0, 0x73, 0x00, 0x4b, 0xf5, 0xa0, 0xd9, 0x71, 0xf8,
+	0x76, 0xd0, 0xdc, 0x20, 0x7d, 0x94, 0x21, 0x95, 0xc0, 0xa2, 0x08, 0x8b, 0x05, 0x69, 0x95, 0xe1,
+	0x3f, 0x99, 0x61, 0x47, 0x64, 0x98, 0x16, 0x19, 0xbe, 0xbf, 0x9e, 0x41, 0x96, 0x35, 0xbc, 0xa0,
+	0x29, 0x0c, 0xcb, 0x73, 0xfa, 0x14, 0x29, 0x10, 0xbd, 0x02, 0xf0, 0xcb, 0x70, 0xca, 0xba, 0x63,
+	0xbb, 0x98, 0x07, 0x3e, 0x41, 0x56, 0x8e, 0x5c, 0x4c, 0x46, 0x69, 0x96, 0x8a, 0x17, 0xa8, 0x40,
+	0xff, 0xcb, 0x40, 0xbb, 0x22, 0xd0, 0xac, 0x08, 0x34, 0xa9, 0x0b, 0xc4, 0xa2, 0xd2, 0x72, 0x25,
+	0x2f, 0x52, 0x8c, 0xa2, 0x27, 0x00, 0x8e, 0xec, 0x88, 0x73, 0x4c, 0xc4, 0xce, 0x54, 0xb4, 0x76,
+	0x31, 0x13, 0x05, 0xa9, 0x16, 0x41, 0x55, 0x84, 0x6d, 0x19, 0xe1, 0x4f, 0x11, 0x61, 0x42, 0x44,
+	0xf8, 0xf6, 0x9a, 0x9f, 0x2f, 0x6b, 0xe4, 0x7e, 0x4c, 0x69, 0xe5, 0x2f, 0x21, 0xf4, 0x00, 0xc0,
+	0x52, 0x78, 0x78, 0xb7, 0x3c, 0xbe, 0x15, 0xf0, 0x8c, 0x33, 0x95, 0xc4, 0xf2, 0xcf, 0x54, 0x9a,
+	0x56, 0xfe, 0xcb, 0xd2, 0x7f, 0x41, 0xa8, 0xeb, 0x4f, 0x4b, 0x78, 0xda, 0x1b, 0xd4, 0xe3, 0x0d,
+	0x1a, 0x70, 0x74, 0x1f, 0xc0, 0xd1, 0x78, 0xb0, 0xf5, 0xac, 0xb6, 0x4d, 0x50, 0xf9, 0x6d, 0x9b,
+	0x82, 0x95, 0x5e, 0x4d, 0xea, 0xcd, 0x0b, 0xbd, 0xd9, 0x22, 0x7a, 0x8e, 0x8b, 0xee, 0x01, 0x58,
+	0xfa, 0xd7, 0xdb, 0xc7, 0x9c, 0x6c, 0x63, 0x1f, 0x77, 0x58, 0xc6, 0xfa, 0x25, 0xb1, 0xfc, 0xf5,
+	0x4b, 0xd3, 0x4a, 0xb0, 0x2a, 0x05, 0x35, 0xed, 0x18, 0x09, 0x7a, 0xb2, 0x66, 0xf9, 0x6c, 0x14,
+	0x96, 0xfe, 0x16, 0xb7, 0x61, 0x74, 0xff, 0xdd, 0x06, 0xf0, 0xb3, 0x0d, 0xd2, 0x5f, 0x5f, 0x43,
+	0x33, 0x59, 0xab, 0xb2, 0xbe, 0x16, 0xc9, 0xcd, 0xe6, 0x61, 0xca, 0xca, 0x92, 0x56, 0x15, 0x94,
+	0xb9, 0xa5, 0x0d, 0x67, 0xdf, 0x3a, 0x6e, 0x1d, 0x60, 0xc7, 0x3d, 0x41, 0x67, 0x00, 0x8e, 0x6c,
+	0x92, 0x1e, 0x0f, 0x6d, 0xf4, 0xbd, 0x12, 0x33, 0xf9, 0xbd, 0x92, 0x40, 0x95, 0xd5, 0x4f, 0xd2,
+	0xca, 0x44, 0x0b, 0x5a, 0x2b, 0x97, 0xf4, 0x78, 0xe3, 0x8a, 0xda, 0x23, 0x00, 0x4b, 0xbf, 0xb7,
+	0xb8, 0xd3, 0x25, 0x72, 0xb4, 0xac, 0xfd, 0x4c, 0x62, 0xf9, 0xfb, 0x99, 0xa6, 0x95, 0xe3, 0x2f,
+	0xd2, 0xb1, 0x86, 0x2c, 0xad, 0x23, 0x96, 0x65, 0xca, 0x92, 0xc5, 0x9a, 0x5d, 0xf8, 0xa9, 0xb8,
+	0x66, 0xa6, 0xb2, 0x76, 0x28, 0x72, 0x9a, 0xce, 0x86, 0x94, 0xca, 0xb4, 0x54, 0x31, 0xd0, 0x78,
+	0xd6, 0x26, 0x8a, 0xeb, 0xe2, 0xf3, 0xb0, 0x73, 0xea, 0x84, 0x31, 0x87, 0xba, 0x28, 0xef, 0x06,
+	0x50, 0x5c, 0x24, 0x63, 0x16, 0xc5, 0x6f, 0x72, 0xb6, 0x44, 0x3f, 0x32, 0xe5, 0x73, 0x0a, 0xe0,
+	0xb0, 0x6a, 0x45, 0xfd, 0xf9, 0x4d, 0x37, 0xe1, 0x5c, 0x2e, 0xa7, 0x64, 0xe6, 0xa4, 0xcc, 0x24,
+	0xca, 0xeb, 0x3d, 0xf4, 0x10, 0xc0, 0x2f, 0xc4, 0x03, 0xe4, 0xb8, 0x76, 0xb4, 0x4e, 0xfa, 0xe0,
+	0x69, 0x30, 0x92, 0xb2, 0x0a, 0xf3, 0x4a, 0x6e, 0x49, 0xca, 0x55, 0xd1, 0x0f, 0xfa, 0x37, 0x2b,
+	0x2c, 0x8c, 0x97, 0xea, 0xb1, 0x78, 0x87, 0x53, 0x83, 0x31, 0x54, 0x74, 0x5a, 0x56, 0xe0, 0x1d,
+	0xbe, 0x5a, 0x90, 0xbe, 0x65, 0x51, 0xa5, 0xa8, 0x28, 0x43, 0x2f, 0x01, 0xfc, 0x7a, 0x17, 0xb7,
+	0x9d, 0x7d, 0xcc, 0xa9, 0x1f, 0x8d, 0xcb, 0x31, 0x67, 0x68, 0x45, 0x3b, 0xfd, 0x47, 0xf9, 0xc8,
+	0xfa, 0xe7, 0x9b, 0x96, 0x29, 0xf7, 0x35, 0xe9, 0xfe, 0x1b, 0xfa, 0x55, 0xeb, 0xde, 0x8d, 0xea,
+	0x1b, 0x71, 0x0a, 0x31, 0x82, 0x75, 0x1c, 0x7f, 0x38, 0xf9, 0xa3, 0xfe, 0xe2, 0xc2, 0x00, 0xe7,
+	0x17, 0x06, 0x78, 0x7b, 0x61, 0x80, 0x3b, 0x03, 0x63, 0xe8, 0xd9, 0xc0, 0x00, 0xe7, 0x03, 0x63,
+	0xe8, 0xcd, 0xc0, 0x18, 0xda, 0x5b, 0xb1, 0x1d, 0x7e, 0x10, 0x34, 0xcd, 0x16, 0xed, 0xa8, 0x59,
+	0x5c, 0xc2, 0x6f, 0x51, 0xff, 0x50, 0xfd, 0xb7, 0xd8, 0xa2, 0x3e, 0xb1, 0x7a, 0x97, 0x53, 0xf3,
+	0xbe, 0x47, 0x58, 0x73, 0x58, 0xfe, 0xe8, 0xfd, 0xf1, 0xc3, 0x00, 0xcc, 0xc3, 0x5d, 0x40, 0xb1,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +401,9 @@ type QueryServiceClient interface {
 	// chain. If no key rotation is in progress, it returns the grpc NOT_FOUND
 	// error.
 	NextKeyID(ctx context.Context, in *NextKeyIDRequest, opts ...grpc.CallOption) (*NextKeyIDResponse, error)
+	// ActiveKeyIDs returns the key IDs of all active keys of a given chain,
+	// starting with the key of the current epoch.
+	ActiveKeyIDs(ctx context.Context, in *ActiveKeyIDsRequest, opts ...grpc.CallOption) (*ActiveKeyIDsResponse, error)
 	// Key returns the key corresponding to a given key ID.
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	Key(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error)
@@ -398,6 +411,16 @@ type QueryServiceClient interface {
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	KeygenSession(ctx context.Context, in *KeygenSessionRequest, opts ...grpc.CallOption) (*KeygenSessionResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// SigningSession returns the signing session info for a given signing
+	// session ID. If no signing session is found, it returns the grpc NOT_FOUND
+	// error.
+	SigningSession(ctx context.Context, in *SigningSessionRequest, opts ...grpc.CallOption) (*SigningSessionResponse, error)
+	// SigningSessions returns the signing sessions that have not been handled
+	// yet, filtered by key ID, module and state
+	SigningSessions(ctx context.Context, in *SigningSessionsRequest, opts ...grpc.CallOption) (*SigningSessionsResponse, error)
+	// ValidatorSigningStats returns the participation of a validator in the
+	// most recent signing sessions it was a participant of
+	ValidatorSigningStats(ctx context.Context, in *ValidatorSigningStatsRequest, opts ...grpc.CallOption) (*ValidatorSigningStatsResponse, error)
 }
 
 type queryServiceClient struct {
@@ -426,6 +449,15 @@ func (c *queryServiceClient) NextKeyID(ctx context.Context, in *NextKeyIDRequest
 	return out, nil
 }
 
+func (c *queryServiceClient) ActiveKeyIDs(ctx context.Context, in *ActiveKeyIDsRequest, opts ...grpc.CallOption) (*ActiveKeyIDsResponse, error) {
+	out := new(ActiveKeyIDsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/ActiveKeyIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Key(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error) {
 	out := new(KeyResponse)
 	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/Key", in, out, opts...)
@@ -453,6 +485,33 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) SigningSession(ctx context.Context, in *SigningSessionRequest, opts ...grpc.CallOption) (*SigningSessionResponse, error) {
+	out := new(SigningSessionResponse)
+	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/SigningSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) SigningSessions(ctx context.Context, in *SigningSessionsRequest, opts ...grpc.CallOption) (*SigningSessionsResponse, error) {
+	out := new(SigningSessionsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/SigningSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ValidatorSigningStats(ctx context.Context, in *ValidatorSigningStatsRequest, opts ...grpc.CallOption) (*ValidatorSigningStatsResponse, error) {
+	out := new(ValidatorSigningStatsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.multisig.v1beta1.QueryService/ValidatorSigningStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	// KeyID returns the key ID of a key assigned to a given chain.
@@ -462,6 +521,9 @@ type QueryServiceServer interface {
 	// chain. If no key rotation is in progress, it returns the grpc NOT_FOUND
 	// error.
 	NextKeyID(context.Context, *NextKeyIDRequest) (*NextKeyIDResponse, error)
+	// ActiveKeyIDs returns the key IDs of all active keys of a given chain,
+	// starting with the key of the current epoch.
+	ActiveKeyIDs(context.Context, *ActiveKeyIDsRequest) (*ActiveKeyIDsResponse, error)
 	// Key returns the key corresponding to a given key ID.
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	Key(context.Context, *KeyRequest) (*KeyResponse, error)
@@ -469,6 +531,16 @@ type QueryServiceServer interface {
 	// If no key is found, it returns the grpc NOT_FOUND error.
 	KeygenSession(context.Context, *KeygenSessionRequest) (*KeygenSessionResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// SigningSession returns the signing session info for a given signing
+	// session ID. If no signing session is found, it returns the grpc NOT_FOUND
+	// error.
+	SigningSession(context.Context, *SigningSessionRequest) (*SigningSessionResponse, error)
+	// SigningSessions returns the signing sessions that have not been handled
+	// yet, filtered by key ID, module and state
+	SigningSessions(context.Context, *SigningSessionsRequest) (*SigningSessionsResponse, error)
+	// ValidatorSigningStats returns the participation of a validator in the
+	// most recent signing sessions it was a participant of
+	ValidatorSigningStats(context.Context, *ValidatorSigningStatsRequest) (*ValidatorSigningStatsResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -481,6 +553,9 @@ func (*UnimplementedQueryServiceServer) KeyID(ctx context.Context, req *KeyIDReq
 func (*UnimplementedQueryServiceServer) NextKeyID(ctx context.Context, req *NextKeyIDRequest) (*NextKeyIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextKeyID not implemented")
 }
+func (*UnimplementedQueryServiceServer) ActiveKeyIDs(ctx context.Context, req *ActiveKeyIDsRequest) (*ActiveKeyIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveKeyIDs not implemented")
+}
 func (*UnimplementedQueryServiceServer) Key(ctx context.Context, req *KeyRequest) (*KeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Key not implemented")
 }
@@ -490,6 +565,15 @@ func (*UnimplementedQueryServiceServer) KeygenSession(ctx context.Context, req *
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServiceServer) SigningSession(ctx context.Context, req *SigningSessionRequest) (*SigningSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningSession not implemented")
+}
+func (*UnimplementedQueryServiceServer) SigningSessions(ctx context.Context, req *SigningSessionsRequest) (*SigningSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningSessions not implemented")
+}
+func (*UnimplementedQueryServiceServer) ValidatorSigningStats(ctx context.Context, req *ValidatorSigningStatsRequest) (*ValidatorSigningStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSigningStats not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -531,6 +615,24 @@ func _QueryService_NextKeyID_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_ActiveKeyIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveKeyIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ActiveKeyIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.multisig.v1beta1.QueryService/ActiveKeyIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ActiveKeyIDs(ctx, req.(*ActiveKeyIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Key_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyRequest)
 	if err := dec(in); err != nil {
@@ -585,6 +687,60 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_SigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SigningSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.multisig.v1beta1.QueryService/SigningSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SigningSession(ctx, req.(*SigningSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_SigningSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).SigningSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.multisig.v1beta1.QueryService/SigningSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).SigningSessions(ctx, req.(*SigningSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ValidatorSigningStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorSigningStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ValidatorSigningStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.multisig.v1beta1.QueryService/ValidatorSigningStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ValidatorSigningStats(ctx, req.(*ValidatorSigningStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.multisig.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -597,6 +753,10 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextKeyID",
 			Handler:    _QueryService_NextKeyID_Handler,
 		},
+		{
+			MethodName: "ActiveKeyIDs",
+			Handler:    _QueryService_ActiveKeyIDs_Handler,
+		},
 		{
 			MethodName: "Key",
 			Handler:    _QueryService_Key_Handler,
@@ -609,6 +769,18 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "SigningSession",
+			Handler:    _QueryService_SigningSession_Handler,
+		},
+		{
+			MethodName: "SigningSessions",
+			Handler:    _QueryService_SigningSessions_Handler,
+		},
+		{
+			MethodName: "ValidatorSigningStats",
+			Handler:    _QueryService_ValidatorSigningStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/multisig/v1beta1/service.proto",
@@ -30,6 +30,7 @@ func NewSigningSession(id uint64, key Key, payloadHash exported.Hash, expiresAt
 		MultiSig: MultiSig{
 			KeyID:       key.ID,
 			PayloadHash: payloadHash,
+			KeyType:     key.KeyType,
 		},
 		State:          exported.Pending,
 		Key:            key,
@@ -70,6 +71,10 @@ func (m SigningSession) ValidateBasic() error {
 		return err
 	}
 
+	if m.MultiSig.KeyType != m.Key.KeyType {
+		return fmt.Errorf("multi sig key type %s does not match key type %s", m.MultiSig.KeyType, m.Key.KeyType)
+	}
+
 	switch m.GetState() {
 	case exported.Pending:
 		if m.CompletedAt != 0 {
@@ -93,7 +98,7 @@ func (m SigningSession) ValidateBasic() error {
 			return fmt.Errorf("participant %s does not have public key submitted", addr)
 		}
 
-		if !sig.Verify(m.MultiSig.PayloadHash, pubKey) {
+		if !sig.Verify(m.Key.KeyType, m.MultiSig.PayloadHash, pubKey) {
 			return fmt.Errorf("signature does not match the public key")
 		}
 	}
@@ -119,7 +124,7 @@ func (m *SigningSession) AddSig(blockHeight int64, participant sdk.ValAddress, s
 		return fmt.Errorf("participant %s already submitted its signature for signing %d", participant.String(), m.GetID())
 	}
 
-	if !sig.Verify(m.MultiSig.PayloadHash, m.Key.PubKeys[participant.String()]) {
+	if !sig.Verify(m.Key.KeyType, m.MultiSig.PayloadHash, m.Key.PubKeys[participant.String()]) {
 		return fmt.Errorf("invalid signature received from participant %s for signing %d", participant.String(), m.GetID())
 	}
 
@@ -203,6 +208,10 @@ func (m MultiSig) ValidateBasic() error {
 		return err
 	}
 
+	if err := m.KeyType.ValidateBasic(); err != nil {
+		return err
+	}
+
 	if err := m.PayloadHash.ValidateBasic(); err != nil {
 		return err
 	}
@@ -219,7 +228,7 @@ func (m MultiSig) ValidateBasic() error {
 			return err
 		}
 
-		if err := sig.ValidateBasic(); err != nil {
+		if err := sig.Validate(m.KeyType); err != nil {
 			return err
 		}
 	}
@@ -227,10 +236,10 @@ func (m MultiSig) ValidateBasic() error {
 	return nil
 }
 
-// GetSignature returns the ECDSA signature of the given participant
+// GetSignature returns the ECDSA signature of the given participant, and false if the multi sig is not an ECDSA multi sig
 func (m MultiSig) GetSignature(p sdk.ValAddress) (ec.Signature, bool) {
 	sig, ok := m.Sigs[p.String()]
-	if !ok {
+	if !ok || m.KeyType != exported.ECDSA {
 		return ec.Signature{}, false
 	}
 
@@ -243,3 +252,93 @@ func (m MultiSig) GetParticipants() []sdk.ValAddress {
 		slices.Map(maps.Keys(m.Sigs), func(a string) sdk.ValAddress { return funcs.Must(sdk.ValAddressFromBech32(a)) }),
 	)
 }
+
+// SigningSessionFilter selects signing sessions by their properties. Empty fields match any signing session.
+type SigningSessionFilter struct {
+	KeyID  exported.KeyID
+	Module string
+	State  exported.MultisigState
+}
+
+// Matches returns true if the given signing session satisfies all the filter's constraints
+func (f SigningSessionFilter) Matches(signing SigningSession) bool {
+	return (f.KeyID == "" || signing.MultiSig.KeyID == f.KeyID) &&
+		(f.Module == "" || signing.GetModule() == f.Module) &&
+		(f.State == exported.NonExistent || signing.GetState() == f.State)
+}
+
+// SigningParticipationWindow is the number of most recent signing sessions of a validator that are tracked to compute its signing participation
+const SigningParticipationWindow uint64 = 1000
+
+// NewSigningParticipation is the constructor for signing participation
+func NewSigningParticipation(validator sdk.ValAddress) SigningParticipation {
+	return SigningParticipation{
+		Validator:      validator,
+		MissedSessions: make([]byte, SigningParticipationWindow/8),
+	}
+}
+
+// Record records whether the validator signed the signing session it most recently participated in,
+// and evicts the oldest signing session from the rolling window if necessary
+func (m *SigningParticipation) Record(signed bool) {
+	index := m.SessionCount % SigningParticipationWindow
+	byteIndex, mask := index/8, byte(1)<<(index%8)
+
+	if m.MissedSessions[byteIndex]&mask != 0 {
+		m.MissedCount--
+	}
+
+	if signed {
+		m.MissedSessions[byteIndex] &^= mask
+	} else {
+		m.MissedSessions[byteIndex] |= mask
+		m.MissedCount++
+	}
+
+	m.SessionCount++
+}
+
+// GetWindowSessionCount returns the number of signing sessions within the rolling window
+func (m SigningParticipation) GetWindowSessionCount() uint64 {
+	return min(m.SessionCount, SigningParticipationWindow)
+}
+
+// ValidateBasic returns an error if the given signing participation is invalid; nil otherwise
+func (m SigningParticipation) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+		return err
+	}
+
+	if uint64(len(m.MissedSessions)) != SigningParticipationWindow/8 {
+		return fmt.Errorf("missed sessions must be a bit array of length %d", SigningParticipationWindow)
+	}
+
+	if m.MissedCount > m.GetWindowSessionCount() {
+		return fmt.Errorf("missed count cannot exceed the number of signing sessions within the window")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m SigningSessionInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var data codec.ProtoMarshaler
+
+	return unpacker.UnpackAny(m.ModuleMetadata, &data)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m SigningSessionResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.SigningSession.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m SigningSessionsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, session := range m.SigningSessions {
+		if err := session.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
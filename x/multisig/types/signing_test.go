@@ -446,10 +446,58 @@ func TestSigningSession(t *testing.T) {
 					pubKey, ok := signingSession.Key.PubKeys[p]
 
 					assert.True(t, ok)
-					assert.True(t, sig.Verify(actual.PayloadHash, pubKey))
+					assert.True(t, sig.Verify(exported.ECDSA, actual.PayloadHash, pubKey))
 				}
 				assert.True(t, participantsWeight.GTE(signingSession.Key.GetMinPassingWeight()))
 			}).
 			Run(t)
 	})
 }
+
+func TestSigningParticipation(t *testing.T) {
+	var participation types.SigningParticipation
+
+	givenNewParticipation := Given("a new signing participation", func() {
+		participation = types.NewSigningParticipation(rand.ValAddr())
+	})
+
+	givenNewParticipation.
+		When("a few signing sessions are recorded", func() {
+			participation.Record(true)
+			participation.Record(false)
+			participation.Record(true)
+		}).
+		Then("should count the missed signing sessions", func(t *testing.T) {
+			assert.NoError(t, participation.ValidateBasic())
+			assert.EqualValues(t, 3, participation.GetWindowSessionCount())
+			assert.EqualValues(t, 1, participation.MissedCount)
+		}).
+		Run(t)
+
+	givenNewParticipation.
+		When("more signing sessions than the window are recorded", func() {
+			for i := uint64(0); i < types.SigningParticipationWindow; i++ {
+				participation.Record(false)
+			}
+
+			for i := uint64(0); i < types.SigningParticipationWindow/2; i++ {
+				participation.Record(true)
+			}
+		}).
+		Then("should only count the missed signing sessions within the window", func(t *testing.T) {
+			assert.NoError(t, participation.ValidateBasic())
+			assert.Equal(t, types.SigningParticipationWindow*3/2, participation.SessionCount)
+			assert.Equal(t, types.SigningParticipationWindow, participation.GetWindowSessionCount())
+			assert.Equal(t, types.SigningParticipationWindow/2, participation.MissedCount)
+		}).
+		Run(t)
+
+	givenNewParticipation.
+		When("the missed sessions are corrupted", func() {
+			participation.MissedSessions = participation.MissedSessions[1:]
+		}).
+		Then("should fail validation", func(t *testing.T) {
+			assert.Error(t, participation.ValidateBasic())
+		}).
+		Run(t)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_multisig_exported "github.com/axelarnetwork/axelar-core/x/multisig/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	_ "github.com/axelarnetwork/axelar-core/x/permission/exported"
@@ -37,6 +38,7 @@ type StartKeygenRequest struct {
 	// compatibility. Removing this field would break decoding of historical
 	// transactions. DO NOT use in new code.
 	SenderDeprecated github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=sender_deprecated,json=senderDeprecated,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender_deprecated,omitempty"` // Deprecated: Do not use.
+	KeyType          exported.KeyType                              `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *StartKeygenRequest) Reset()         { *m = StartKeygenRequest{} }
@@ -594,54 +596,56 @@ func init() {
 func init() { proto.RegisterFile("axelar/multisig/v1beta1/tx.proto", fileDescriptor_22993cd2eb246944) }
 
 var fileDescriptor_22993cd2eb246944 = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0x8e, 0xf3, 0xb5, 0xca, 0xec, 0xae, 0xb6, 0x75, 0xfa, 0x91, 0xf6, 0x10, 0x47, 0xd5, 0x1e,
-	0xaa, 0xee, 0xc6, 0xde, 0x6e, 0xb5, 0x7b, 0xa8, 0x04, 0xa8, 0x69, 0x85, 0x14, 0x45, 0xa2, 0x95,
-	0x23, 0x0e, 0x70, 0x09, 0xfe, 0x78, 0xe5, 0x8e, 0x52, 0x7b, 0x8c, 0x67, 0x0c, 0xf1, 0x0d, 0x71,
-	0x44, 0x1c, 0xf8, 0x03, 0xfc, 0x03, 0x0e, 0x3d, 0xc0, 0x01, 0x7e, 0x41, 0x8f, 0x15, 0x27, 0x4e,
-	0x11, 0xa4, 0x87, 0xfe, 0x03, 0x0e, 0x39, 0x21, 0x7b, 0xc6, 0x4d, 0x22, 0x5a, 0x51, 0x4a, 0x8b,
-	0xe0, 0x52, 0x77, 0xfc, 0x3e, 0x7e, 0xdf, 0xf7, 0x79, 0x9e, 0x77, 0x26, 0x83, 0x6a, 0x46, 0x0f,
-	0xf6, 0x8c, 0x40, 0x73, 0xc3, 0x3d, 0x86, 0x29, 0x76, 0xb4, 0x07, 0xab, 0x26, 0x30, 0x63, 0x55,
-	0x63, 0x3d, 0xd5, 0x0f, 0x08, 0x23, 0xf2, 0x3c, 0x47, 0xa8, 0x29, 0x42, 0x15, 0x88, 0xc5, 0x19,
-	0x87, 0x38, 0x24, 0xc1, 0x68, 0xf1, 0x7f, 0x1c, 0xbe, 0xf8, 0xe7, 0x59, 0x09, 0x7d, 0x23, 0x30,
-	0x5c, 0x2a, 0x50, 0xaa, 0x40, 0xf9, 0x10, 0xb8, 0x98, 0x52, 0x4c, 0x3c, 0x0d, 0x7a, 0x3e, 0x09,
-	0x18, 0xd8, 0xa3, 0x0e, 0x22, 0x1f, 0x52, 0xfc, 0x82, 0x45, 0xa8, 0x4b, 0x68, 0x87, 0x97, 0xe3,
-	0x0b, 0x11, 0x9a, 0xe7, 0x2b, 0xcd, 0xa5, 0x71, 0xad, 0xf8, 0x21, 0x02, 0xd3, 0x86, 0x8b, 0x3d,
-	0xa2, 0x25, 0x7f, 0xf9, 0xab, 0xa5, 0xe7, 0x59, 0x24, 0xb7, 0x99, 0x11, 0xb0, 0x16, 0x44, 0x0e,
-	0x78, 0x3a, 0xdc, 0x0f, 0x81, 0x32, 0xf9, 0x1f, 0x54, 0xa4, 0xe0, 0xd9, 0x10, 0x54, 0xa4, 0x9a,
-	0xb4, 0x5c, 0x6a, 0x54, 0xde, 0xbe, 0xac, 0xcf, 0x88, 0x22, 0x1b, 0xb6, 0x1d, 0x00, 0xa5, 0x6d,
-	0x16, 0x60, 0xcf, 0xd1, 0x05, 0x4e, 0x36, 0x51, 0xb1, 0x0b, 0x51, 0x07, 0xdb, 0x95, 0x6c, 0xf2,
-	0x45, 0x6b, 0xd0, 0x57, 0x0a, 0x2d, 0x88, 0x9a, 0x5b, 0xc3, 0xbe, 0x72, 0xdd, 0xc1, 0x6c, 0x37,
-	0x34, 0x55, 0x8b, 0xb8, 0x1a, 0xe7, 0xe9, 0x01, 0x7b, 0x48, 0x82, 0xae, 0x58, 0xd5, 0x2d, 0x12,
-	0x80, 0xd6, 0x1b, 0x49, 0x94, 0x52, 0x57, 0x93, 0x0c, 0x7a, 0xa1, 0x0b, 0x51, 0xd3, 0x96, 0xef,
-	0xa1, 0x69, 0x5e, 0xad, 0x63, 0x83, 0x1f, 0x80, 0x65, 0x30, 0xb0, 0x2b, 0xb9, 0x9a, 0xb4, 0xfc,
-	0x5b, 0x63, 0x6d, 0xd8, 0x57, 0xea, 0x63, 0x55, 0x84, 0x04, 0xfc, 0x51, 0xa7, 0x76, 0x57, 0x88,
-	0xb7, 0x61, 0x59, 0xa2, 0xff, 0x8a, 0xa4, 0x4f, 0xf1, 0x6c, 0x5b, 0x27, 0xc9, 0xd6, 0xff, 0x78,
-	0xf4, 0xaa, 0x92, 0x7d, 0x7c, 0xbc, 0xbf, 0x22, 0x68, 0x2d, 0xcd, 0xa2, 0xf2, 0x84, 0x3c, 0xd4,
-	0x27, 0x1e, 0x85, 0xa5, 0x17, 0x39, 0x54, 0x6e, 0x87, 0xa6, 0x8b, 0xd9, 0x4e, 0x68, 0xb6, 0x20,
-	0xfa, 0xb1, 0x75, 0xeb, 0xa0, 0x5f, 0xfc, 0xd0, 0xec, 0x74, 0x21, 0x12, 0x6a, 0xdd, 0x1c, 0xf6,
-	0x95, 0xc6, 0x85, 0x73, 0xef, 0x84, 0xe6, 0x1e, 0xb6, 0x62, 0xd6, 0x45, 0x3f, 0x61, 0x2f, 0xff,
-	0x85, 0x4a, 0x14, 0x3b, 0x9e, 0xc1, 0xc2, 0x00, 0x2a, 0xf9, 0xa4, 0xc4, 0xef, 0xc3, 0xbe, 0x52,
-	0x6a, 0xa7, 0x2f, 0xf5, 0x51, 0xfc, 0x74, 0x17, 0x0b, 0x97, 0xed, 0xa2, 0x34, 0xee, 0xe2, 0x1c,
-	0x9a, 0x99, 0x74, 0x4b, 0xd8, 0xf8, 0x34, 0x8b, 0xe6, 0x78, 0x60, 0xd4, 0xe9, 0x85, 0x9d, 0xac,
-	0xa1, 0x22, 0xc5, 0x4e, 0xea, 0x64, 0xbe, 0x51, 0x8a, 0x9d, 0x6c, 0x63, 0x27, 0xf6, 0x81, 0x62,
-	0xa7, 0x69, 0x4f, 0xca, 0x94, 0xbb, 0x88, 0x4c, 0xf9, 0x2b, 0x95, 0x69, 0x01, 0xcd, 0x7f, 0xa6,
-	0x86, 0x50, 0xea, 0x63, 0x16, 0x4d, 0xe9, 0x84, 0x19, 0x0c, 0xbe, 0x69, 0xda, 0xef, 0xa0, 0x82,
-	0xb5, 0x6b, 0x60, 0x4f, 0x0c, 0xfb, 0xe6, 0xb0, 0xaf, 0xdc, 0x38, 0xe7, 0x1c, 0x7a, 0xd0, 0x0b,
-	0xe9, 0x68, 0x08, 0x37, 0xe3, 0x34, 0xb7, 0x0c, 0x17, 0x74, 0x9e, 0x71, 0x6c, 0x23, 0xe5, 0xbe,
-	0xef, 0x01, 0x94, 0xbf, 0xd2, 0x03, 0xa8, 0x8c, 0xa6, 0xc7, 0x74, 0x17, 0x6e, 0xbc, 0x91, 0x50,
-	0x99, 0x9f, 0x48, 0xdb, 0x3e, 0xdb, 0x0e, 0x59, 0x6a, 0xc8, 0xa9, 0xfd, 0x49, 0x97, 0xd8, 0xdf,
-	0x98, 0xe5, 0xd9, 0xf3, 0x59, 0x7e, 0xea, 0x66, 0x9c, 0xec, 0x5d, 0x90, 0x7a, 0x2d, 0x21, 0xf9,
-	0x24, 0xd0, 0xf4, 0x7e, 0x2a, 0x4e, 0xb3, 0x63, 0x7e, 0x34, 0xbd, 0x09, 0x9f, 0x6e, 0xfb, 0xb6,
-	0xc1, 0x60, 0x27, 0xf9, 0xad, 0x4f, 0x39, 0xfd, 0x8f, 0x4a, 0x46, 0xc8, 0x76, 0x49, 0x80, 0x59,
-	0xf4, 0xc5, 0xbd, 0x33, 0x82, 0xca, 0xd7, 0x50, 0x91, 0x5f, 0x1a, 0x92, 0x4e, 0x7f, 0xfd, 0x57,
-	0x51, 0xcf, 0xb8, 0x8a, 0xa8, 0xbc, 0x5e, 0x23, 0x7f, 0xd0, 0x57, 0x32, 0xba, 0xf8, 0x68, 0xfd,
-	0xef, 0x74, 0xb8, 0x46, 0x29, 0x9f, 0x1c, 0xef, 0xaf, 0xcc, 0x9e, 0x4c, 0xfd, 0x78, 0xaf, 0xb1,
-	0x4f, 0x93, 0xbd, 0x73, 0x52, 0x8d, 0xf6, 0xc1, 0x87, 0x6a, 0xe6, 0x60, 0x50, 0x95, 0x0e, 0x07,
-	0x55, 0xe9, 0xfd, 0xa0, 0x2a, 0x3d, 0x3b, 0xaa, 0x66, 0x0e, 0x8f, 0xaa, 0x99, 0x77, 0x47, 0xd5,
-	0xcc, 0xdd, 0xff, 0xbe, 0x76, 0xa7, 0x25, 0x16, 0x99, 0xc5, 0xe4, 0x3a, 0xb2, 0xf6, 0x29, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0x2a, 0x0c, 0xb8, 0x7e, 0x09, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x8e, 0xf3, 0x55, 0x72, 0x14, 0x68, 0x9d, 0x7e, 0xa4, 0x1d, 0xe2, 0xa8, 0x62, 0x28, 0xa5,
+	0xb1, 0x69, 0x2b, 0x18, 0x2a, 0x01, 0x6a, 0x5a, 0x21, 0x45, 0x91, 0x68, 0xe5, 0xc0, 0x00, 0x4b,
+	0xf0, 0xc7, 0xc9, 0x3d, 0xa5, 0xf6, 0x19, 0xdf, 0x19, 0xe2, 0x0d, 0x31, 0x22, 0x06, 0x7e, 0x08,
+	0x43, 0x07, 0x18, 0xe0, 0x17, 0x74, 0xac, 0x98, 0x98, 0x22, 0x48, 0x86, 0xfe, 0x03, 0x86, 0x4c,
+	0xc8, 0xbe, 0xcb, 0x57, 0x3f, 0xa0, 0x94, 0x82, 0x60, 0x49, 0x7c, 0x7e, 0x1f, 0xbf, 0xef, 0xf3,
+	0x3e, 0xcf, 0x7b, 0xa7, 0x03, 0x05, 0xad, 0x01, 0x77, 0x34, 0x4f, 0xb1, 0xfd, 0x1d, 0x8a, 0x08,
+	0xb2, 0x94, 0x67, 0x4b, 0x3a, 0xa4, 0xda, 0x92, 0x42, 0x1b, 0xb2, 0xeb, 0x61, 0x8a, 0xc5, 0x69,
+	0x86, 0x90, 0xbb, 0x08, 0x99, 0x23, 0x66, 0x27, 0x2c, 0x6c, 0xe1, 0x08, 0xa3, 0x84, 0x4f, 0x0c,
+	0x3e, 0x7b, 0xf5, 0xa4, 0x84, 0xae, 0xe6, 0x69, 0x36, 0xe1, 0xa8, 0xc5, 0xc3, 0x28, 0xd8, 0x70,
+	0xb1, 0x47, 0xa1, 0xd9, 0xaf, 0x1f, 0xb8, 0xb0, 0x8b, 0x96, 0x39, 0xda, 0x85, 0x9e, 0x8d, 0x08,
+	0x41, 0xd8, 0xf9, 0x31, 0x7e, 0xc6, 0xc0, 0xc4, 0xc6, 0xa4, 0xc6, 0xc8, 0xb1, 0x05, 0x0f, 0x4d,
+	0xb3, 0x95, 0x62, 0x93, 0x90, 0x59, 0xf8, 0xc7, 0x03, 0xe3, 0x9a, 0x8d, 0x1c, 0xac, 0x44, 0xbf,
+	0xec, 0xd5, 0x5c, 0x3b, 0x0e, 0xc4, 0x2a, 0xd5, 0x3c, 0x5a, 0x81, 0x81, 0x05, 0x1d, 0x15, 0x3e,
+	0xf5, 0x21, 0xa1, 0xe2, 0x0d, 0x90, 0x26, 0xd0, 0x31, 0xa1, 0x97, 0x13, 0x0a, 0xc2, 0x7c, 0xa6,
+	0x94, 0xfb, 0xf4, 0xae, 0x38, 0xc1, 0x8b, 0xac, 0x99, 0xa6, 0x07, 0x09, 0xa9, 0x52, 0x0f, 0x39,
+	0x96, 0xca, 0x71, 0xa2, 0x0e, 0xd2, 0x75, 0x18, 0xd4, 0x90, 0x99, 0x8b, 0x47, 0x5f, 0x54, 0x5a,
+	0x4d, 0x29, 0x55, 0x81, 0x41, 0x79, 0xa3, 0xd3, 0x94, 0xee, 0x58, 0x88, 0x6e, 0xfb, 0xba, 0x6c,
+	0x60, 0x5b, 0x61, 0x7d, 0x3a, 0x90, 0x3e, 0xc7, 0x5e, 0x9d, 0xaf, 0x8a, 0x06, 0xf6, 0xa0, 0xd2,
+	0x38, 0x2a, 0x95, 0x1c, 0x65, 0x50, 0x53, 0x75, 0x18, 0x94, 0x4d, 0xf1, 0x09, 0x18, 0x67, 0xd5,
+	0x6a, 0x26, 0x74, 0x3d, 0x68, 0x68, 0x14, 0x9a, 0xb9, 0x44, 0x41, 0x98, 0x1f, 0x2d, 0xad, 0x74,
+	0x9a, 0x52, 0x71, 0xa0, 0x0a, 0x97, 0x80, 0xfd, 0x15, 0x89, 0x59, 0xe7, 0xe2, 0xad, 0x19, 0x06,
+	0xe7, 0x9f, 0x13, 0xd4, 0x31, 0x96, 0x6d, 0xa3, 0x97, 0x4c, 0xdc, 0x00, 0x17, 0xc2, 0x2e, 0x42,
+	0x6c, 0x2e, 0x59, 0x10, 0xe6, 0x2f, 0x2f, 0x5f, 0x93, 0x0f, 0xcf, 0x46, 0x8f, 0x1b, 0xb7, 0x25,
+	0xe4, 0xf8, 0x20, 0x70, 0xa1, 0x3a, 0x52, 0x67, 0x0f, 0xab, 0x57, 0x5e, 0xbc, 0xcf, 0xc5, 0x5f,
+	0x1e, 0xec, 0x2e, 0x70, 0x71, 0xe6, 0x26, 0x41, 0x76, 0x48, 0x64, 0xe2, 0x62, 0x87, 0xc0, 0xb9,
+	0xb7, 0x09, 0x90, 0xad, 0xfa, 0xba, 0x8d, 0xe8, 0x96, 0xaf, 0x57, 0x60, 0xf0, 0x6f, 0xab, 0x5f,
+	0x03, 0x23, 0xae, 0xaf, 0xd7, 0xea, 0x30, 0xe0, 0x9a, 0xdf, 0xeb, 0x34, 0xa5, 0xd2, 0x99, 0x73,
+	0x6f, 0xf9, 0xfa, 0x0e, 0x32, 0xc2, 0xae, 0xd3, 0x6e, 0xd4, 0xbd, 0x78, 0x1d, 0x64, 0x08, 0xb2,
+	0x1c, 0x8d, 0xfa, 0x1e, 0x53, 0x7f, 0xb4, 0x74, 0xa9, 0xd3, 0x94, 0x32, 0xd5, 0xee, 0x4b, 0xb5,
+	0x1f, 0x3f, 0x7e, 0x16, 0x52, 0xe7, 0x38, 0x0b, 0x91, 0x8b, 0xc2, 0xa0, 0x8b, 0x53, 0x60, 0x62,
+	0xd8, 0x2d, 0x6e, 0xe3, 0xeb, 0x38, 0x98, 0x62, 0x81, 0x3e, 0xd3, 0x33, 0x3b, 0x59, 0x00, 0x69,
+	0x82, 0xac, 0xae, 0x93, 0xc9, 0x52, 0x26, 0x74, 0xb2, 0x8a, 0xac, 0xd0, 0x07, 0x82, 0xac, 0xb2,
+	0x39, 0x2c, 0x53, 0xe2, 0x2c, 0x32, 0x25, 0xff, 0xa8, 0x4c, 0x33, 0x60, 0xfa, 0x88, 0x1a, 0x5c,
+	0xa9, 0x6f, 0x71, 0x30, 0xa6, 0x62, 0xaa, 0x51, 0xf8, 0x5b, 0xd3, 0xfe, 0x08, 0xa4, 0x8c, 0x6d,
+	0x0d, 0x39, 0x7c, 0xd8, 0xd7, 0x3b, 0x4d, 0xe9, 0xee, 0x29, 0xe7, 0xd0, 0x81, 0x0d, 0x9f, 0xf4,
+	0x87, 0x70, 0x3d, 0x4c, 0x73, 0x5f, 0xb3, 0xa1, 0xca, 0x32, 0x0e, 0x6c, 0xa4, 0xc4, 0xdf, 0x3d,
+	0xc6, 0xce, 0xdd, 0x93, 0xa1, 0x03, 0x28, 0x0b, 0xc6, 0x07, 0x74, 0xe7, 0x6e, 0x7c, 0x14, 0x40,
+	0x96, 0x9d, 0x48, 0x9b, 0x2e, 0xdd, 0xf4, 0x69, 0xd7, 0x90, 0x63, 0xf9, 0x09, 0xe7, 0x79, 0xcc,
+	0xf6, 0x2d, 0x8f, 0x9f, 0xce, 0xf2, 0x63, 0x37, 0xe3, 0x30, 0x77, 0xde, 0xd4, 0x07, 0x01, 0x88,
+	0xbd, 0x40, 0xd9, 0xf9, 0xaf, 0x7a, 0x9a, 0x04, 0xd9, 0x21, 0xea, 0x03, 0x3e, 0x3d, 0x74, 0x4d,
+	0x8d, 0xc2, 0xad, 0xe8, 0x7e, 0xd1, 0xed, 0xe9, 0x16, 0xc8, 0x68, 0x3e, 0xdd, 0xc6, 0x1e, 0xa2,
+	0xc1, 0x4f, 0xf7, 0x4e, 0x1f, 0x2a, 0xde, 0x06, 0x69, 0x76, 0x51, 0x89, 0x98, 0x5e, 0x5c, 0x96,
+	0xe4, 0x13, 0xae, 0x3f, 0x32, 0xab, 0x57, 0x4a, 0xee, 0x35, 0xa5, 0x98, 0xca, 0x3f, 0x5a, 0x5d,
+	0xec, 0x0e, 0x57, 0x3f, 0xe5, 0xab, 0x83, 0xdd, 0x85, 0xc9, 0xde, 0xd4, 0x0f, 0x72, 0x0d, 0x7d,
+	0x1a, 0xe6, 0xce, 0x9a, 0x2a, 0x55, 0xf7, 0xbe, 0xe6, 0x63, 0x7b, 0xad, 0xbc, 0xb0, 0xdf, 0xca,
+	0x0b, 0x5f, 0x5a, 0x79, 0xe1, 0x4d, 0x3b, 0x1f, 0xdb, 0x6f, 0xe7, 0x63, 0x9f, 0xdb, 0xf9, 0xd8,
+	0xe3, 0x9b, 0xbf, 0xba, 0xd3, 0x22, 0x8b, 0xf4, 0x74, 0x74, 0xa9, 0x59, 0xf9, 0x3e, 0x00, 0x49,
+	0xec, 0xab, 0x94, 0xf2, 0x09, 0x00, 0x00,
 }
 
 func (m *StartKeygenRequest) Marshal() (dAtA []byte, err error) {
@@ -664,6 +668,11 @@ func (m *StartKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.SenderDeprecated) > 0 {
 		i -= len(m.SenderDeprecated)
 		copy(dAtA[i:], m.SenderDeprecated)
@@ -1150,6 +1159,9 @@ func (m *StartKeygenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -1476,6 +1488,25 @@ func (m *StartKeygenRequest) Unmarshal(dAtA []byte) error {
 				m.SenderDeprecated = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,12 +2,14 @@ package types
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"encoding/hex"
 	"fmt"
 	"sort"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/x/multisig/exported"
@@ -18,29 +20,55 @@ import (
 // Signature is an alias for signature in raw bytes
 type Signature []byte
 
-// ValidateBasic returns an error if the signature is not a valid S256 elliptic curve signature
+// ValidateBasic returns an error if the signature is not valid under any of the supported signature schemes
 func (sig Signature) ValidateBasic() error {
-	_, err := ec.ParseDERSignature(sig)
-	if err != nil {
-		return err
+	for _, keyType := range exported.KeyTypes() {
+		if sig.Validate(keyType) == nil {
+			return nil
+		}
 	}
 
-	return nil
+	return fmt.Errorf("signature is not a valid ECDSA, Ed25519 or Schnorr signature")
 }
 
-// Verify checks if the signature matches the payload and public key
-func (sig Signature) Verify(payloadHash exported.Hash, pk exported.PublicKey) bool {
-	s, err := ec.ParseDERSignature(sig)
-	if err != nil {
-		return false
+// Validate returns an error if the signature is not a valid signature of the given key type
+func (sig Signature) Validate(keyType exported.KeyType) error {
+	switch keyType {
+	case exported.ECDSA:
+		_, err := ec.ParseDERSignature(sig)
+		return err
+	case exported.Ed25519:
+		if len(sig) != ed25519.SignatureSize {
+			return fmt.Errorf("ed25519 signature must be %d bytes", ed25519.SignatureSize)
+		}
+
+		return nil
+	case exported.Schnorr:
+		_, err := schnorr.ParseSignature(sig)
+		return err
+	default:
+		return fmt.Errorf("invalid key type %s", keyType)
 	}
+}
 
-	parsedKey, err := btcec.ParsePubKey(pk)
-	if err != nil {
+// Verify checks if the signature of the given key type matches the payload and public key
+func (sig Signature) Verify(keyType exported.KeyType, payloadHash exported.Hash, pk exported.PublicKey) bool {
+	if keyType.ValidatePubKey(pk) != nil || sig.Validate(keyType) != nil {
 		return false
 	}
 
-	return s.Verify(payloadHash[:], parsedKey)
+	switch keyType {
+	case exported.ECDSA:
+		s := sig.toECDSASignature()
+		return s.Verify(payloadHash, funcs.Must(btcec.ParsePubKey(pk)))
+	case exported.Ed25519:
+		return ed25519.Verify(ed25519.PublicKey(pk), payloadHash, sig)
+	case exported.Schnorr:
+		s := funcs.Must(schnorr.ParseSignature(sig))
+		return s.Verify(payloadHash, funcs.Must(schnorr.ParsePubKey(pk)))
+	default:
+		return false
+	}
 }
 
 // String returns the hex-encoding of signature
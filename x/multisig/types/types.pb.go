@@ -12,6 +12,7 @@ import (
 	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_sortkeys "github.com/cosmos/gogoproto/sortkeys"
@@ -37,6 +38,7 @@ type Key struct {
 	PubKeys          map[string]github_com_axelarnetwork_axelar_core_x_multisig_exported.PublicKey `protobuf:"bytes,3,rep,name=pub_keys,json=pubKeys,proto3,castvalue=github.com/axelarnetwork/axelar-core/x/multisig/exported.PublicKey" json:"pub_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	SigningThreshold utils.Threshold                                                               `protobuf:"bytes,4,opt,name=signing_threshold,json=signingThreshold,proto3" json:"signing_threshold"`
 	State            exported1.KeyState                                                            `protobuf:"varint,5,opt,name=state,proto3,enum=axelar.multisig.exported.v1beta1.KeyState" json:"state,omitempty"`
+	KeyType          exported1.KeyType                                                             `protobuf:"varint,6,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *Key) Reset()         { *m = Key{} }
@@ -103,6 +105,13 @@ func (m *Key) GetState() exported1.KeyState {
 	return exported1.Inactive
 }
 
+func (m *Key) GetKeyType() exported1.KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return exported1.ECDSA
+}
+
 type KeygenSession struct {
 	Key              Key                     `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
 	State            exported1.MultisigState `protobuf:"varint,2,opt,name=state,proto3,enum=axelar.multisig.exported.v1beta1.MultisigState" json:"state,omitempty"`
@@ -195,6 +204,7 @@ type MultiSig struct {
 	KeyID       github_com_axelarnetwork_axelar_core_x_multisig_exported.KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.KeyID" json:"key_id,omitempty"`
 	PayloadHash github_com_axelarnetwork_axelar_core_x_multisig_exported.Hash  `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3,casttype=github.com/axelarnetwork/axelar-core/x/multisig/exported.Hash" json:"payload_hash,omitempty"`
 	Sigs        map[string]Signature                                           `protobuf:"bytes,3,rep,name=sigs,proto3,castvalue=Signature" json:"sigs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KeyType     exported1.KeyType                                              `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=axelar.multisig.exported.v1beta1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *MultiSig) Reset()         { *m = MultiSig{} }
@@ -247,6 +257,13 @@ func (m *MultiSig) GetSigs() map[string]Signature {
 	return nil
 }
 
+func (m *MultiSig) GetKeyType() exported1.KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return exported1.ECDSA
+}
+
 type SigningSession struct {
 	ID             uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	MultiSig       MultiSig                `protobuf:"bytes,2,opt,name=multi_sig,json=multiSig,proto3" json:"multi_sig"`
@@ -411,6 +428,79 @@ func (m *KeyEpoch) GetKeyID() github_com_axelarnetwork_axelar_core_x_multisig_ex
 	return ""
 }
 
+// SigningParticipation tracks whether a validator signed the most recent
+// signing sessions it was a participant of
+type SigningParticipation struct {
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	// total number of signing sessions the validator was a participant of
+	SessionCount uint64 `protobuf:"varint,2,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	// number of missed signing sessions within the rolling window
+	MissedCount uint64 `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+	// bit array of the missed signing sessions within the rolling window
+	MissedSessions []byte `protobuf:"bytes,4,opt,name=missed_sessions,json=missedSessions,proto3" json:"missed_sessions,omitempty"`
+}
+
+func (m *SigningParticipation) Reset()         { *m = SigningParticipation{} }
+func (m *SigningParticipation) String() string { return proto.CompactTextString(m) }
+func (*SigningParticipation) ProtoMessage()    {}
+func (*SigningParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4411d79cd20e5e65, []int{5}
+}
+func (m *SigningParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningParticipation.Merge(m, src)
+}
+func (m *SigningParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningParticipation proto.InternalMessageInfo
+
+func (m *SigningParticipation) GetValidator() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *SigningParticipation) GetSessionCount() uint64 {
+	if m != nil {
+		return m.SessionCount
+	}
+	return 0
+}
+
+func (m *SigningParticipation) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *SigningParticipation) GetMissedSessions() []byte {
+	if m != nil {
+		return m.MissedSessions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Key)(nil), "axelar.multisig.v1beta1.Key")
 	proto.RegisterMapType((map[string]github_com_axelarnetwork_axelar_core_x_multisig_exported.PublicKey)(nil), "axelar.multisig.v1beta1.Key.PubKeysEntry")
@@ -420,6 +510,7 @@ func init() {
 	proto.RegisterMapType((map[string]Signature)(nil), "axelar.multisig.v1beta1.MultiSig.SigsEntry")
 	proto.RegisterType((*SigningSession)(nil), "axelar.multisig.v1beta1.SigningSession")
 	proto.RegisterType((*KeyEpoch)(nil), "axelar.multisig.v1beta1.KeyEpoch")
+	proto.RegisterType((*SigningParticipation)(nil), "axelar.multisig.v1beta1.SigningParticipation")
 }
 
 func init() {
@@ -427,67 +518,75 @@ func init() {
 }
 
 var fileDescriptor_4411d79cd20e5e65 = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0x8f, 0x2c, 0xdb, 0xb5, 0x37, 0x69, 0x1a, 0x44, 0x28, 0x6e, 0x06, 0xac, 0xb4, 0x30, 0x4c,
-	0x28, 0x54, 0x9a, 0x06, 0x18, 0x98, 0x0c, 0xff, 0xe2, 0x24, 0x4c, 0x33, 0x26, 0xc5, 0x23, 0x73,
-	0x00, 0x2e, 0x9a, 0xb5, 0xf4, 0x90, 0x76, 0x2c, 0x6b, 0x35, 0x5a, 0x29, 0x58, 0x5f, 0xa1, 0xc3,
-	0x81, 0x03, 0x07, 0xae, 0x7c, 0x07, 0x3e, 0x44, 0x87, 0x53, 0x8f, 0x70, 0x71, 0x18, 0xe7, 0x5b,
-	0x84, 0x0b, 0xa3, 0xdd, 0x95, 0x6c, 0x48, 0x1b, 0x93, 0x40, 0x6f, 0xbb, 0x6f, 0xdf, 0xbe, 0xfd,
-	0xfd, 0xde, 0xef, 0xbd, 0x27, 0xa1, 0xd7, 0xf0, 0x18, 0x02, 0x1c, 0x9b, 0xa3, 0x34, 0x48, 0x08,
-	0x23, 0x9e, 0x79, 0x7c, 0x7f, 0x00, 0x09, 0xbe, 0x6f, 0x26, 0x59, 0x04, 0xcc, 0x88, 0x62, 0x9a,
-	0x50, 0xed, 0x65, 0xe1, 0x64, 0x14, 0x4e, 0x86, 0x74, 0xda, 0xb8, 0xe5, 0x51, 0xea, 0x05, 0x60,
-	0x72, 0xb7, 0x41, 0xfa, 0xad, 0x89, 0xc3, 0x4c, 0xdc, 0xd9, 0x58, 0xf7, 0xa8, 0x47, 0xf9, 0xd2,
-	0xcc, 0x57, 0xd2, 0x7a, 0xcb, 0xa1, 0x6c, 0x44, 0x99, 0x2d, 0x0e, 0xc4, 0x46, 0x1e, 0xbd, 0x2e,
-	0x91, 0xa4, 0x09, 0x09, 0xd8, 0x0c, 0x86, 0x1f, 0x03, 0xf3, 0x69, 0xe0, 0x4a, 0xaf, 0xb7, 0xa5,
-	0x17, 0x0b, 0x71, 0xc4, 0x7c, 0x9a, 0x98, 0x30, 0x8e, 0x68, 0x9c, 0x80, 0xfb, 0x34, 0xe0, 0xa5,
-	0x77, 0xc9, 0xee, 0x22, 0xef, 0x3b, 0x3f, 0x56, 0x91, 0xda, 0x85, 0x4c, 0xfb, 0x0a, 0x55, 0x88,
-	0xdb, 0x52, 0x36, 0x95, 0xad, 0x66, 0xe7, 0xc1, 0x74, 0xa2, 0x57, 0x0e, 0xf7, 0xcf, 0x26, 0xfa,
-	0xc7, 0x1e, 0x49, 0xfc, 0x74, 0x60, 0x38, 0x74, 0x64, 0x8a, 0xb0, 0x21, 0x24, 0xdf, 0xd1, 0x78,
-	0x28, 0x77, 0xf7, 0x1c, 0x1a, 0x83, 0x39, 0x3e, 0xff, 0x96, 0xd1, 0x85, 0xec, 0x70, 0xdf, 0xaa,
-	0x10, 0x57, 0xfb, 0x1c, 0x35, 0x0a, 0xe0, 0xad, 0xca, 0xa6, 0xb2, 0xb5, 0xbc, 0x7d, 0xd7, 0x90,
-	0xb9, 0x2d, 0xec, 0x46, 0x79, 0x4d, 0x42, 0x34, 0xfa, 0xf2, 0xa4, 0x53, 0x7d, 0x3c, 0xd1, 0x97,
-	0xac, 0x32, 0x82, 0xf6, 0xbd, 0x82, 0x1a, 0x51, 0x3a, 0xb0, 0x87, 0x90, 0xb1, 0x96, 0xba, 0xa9,
-	0x6e, 0x2d, 0x6f, 0xbf, 0x69, 0x3c, 0x43, 0xaa, 0x1c, 0x84, 0xd1, 0x4b, 0x07, 0x5d, 0xc8, 0xd8,
-	0x41, 0x98, 0xc4, 0x59, 0xe7, 0xb3, 0x47, 0x27, 0x7a, 0xe7, 0xca, 0x9c, 0x7a, 0xe9, 0x20, 0x20,
-	0x4e, 0x17, 0x32, 0xeb, 0x5a, 0x24, 0xa2, 0x6a, 0x16, 0x7a, 0x81, 0x11, 0x2f, 0x24, 0xa1, 0x67,
-	0x97, 0xaa, 0xb5, 0xaa, 0x9c, 0xa5, 0x5e, 0xc0, 0xe2, 0xe2, 0x96, 0x98, 0xbe, 0x2c, 0xdc, 0x24,
-	0xb5, 0x35, 0x79, 0xbf, 0xb4, 0x6b, 0x9f, 0xa2, 0x1a, 0x4b, 0x70, 0x02, 0xad, 0xda, 0xa6, 0xb2,
-	0xb5, 0x3a, 0xcb, 0x56, 0x49, 0xef, 0x5c, 0xb6, 0xba, 0x90, 0xf5, 0xf3, 0x1b, 0x96, 0xb8, 0xb8,
-	0xb1, 0x83, 0x56, 0xe6, 0x69, 0x6b, 0x6b, 0x48, 0x1d, 0x42, 0x26, 0xd4, 0xb5, 0xf2, 0xa5, 0xb6,
-	0x8e, 0x6a, 0xc7, 0x38, 0x48, 0x81, 0x2b, 0xb2, 0x62, 0x89, 0xcd, 0x4e, 0xe5, 0x03, 0x65, 0xa7,
-	0xfa, 0xd3, 0xcf, 0xba, 0x72, 0xe7, 0x4f, 0x15, 0x5d, 0xef, 0x42, 0xe6, 0x41, 0xd8, 0x07, 0xc6,
-	0x08, 0x0d, 0xb5, 0x77, 0x67, 0x31, 0x96, 0xb7, 0x5f, 0xb9, 0x28, 0xe5, 0x92, 0x18, 0x7f, 0xe7,
-	0xa0, 0xe0, 0x52, 0xe1, 0x5c, 0xcc, 0xc5, 0x5c, 0x8e, 0xe4, 0xc9, 0x3c, 0x21, 0xad, 0x87, 0xd6,
-	0x86, 0x1c, 0xcd, 0x5c, 0x96, 0xd5, 0xcb, 0x64, 0xf9, 0x86, 0xb8, 0x3e, 0x4b, 0xf2, 0xab, 0x08,
-	0xc1, 0x38, 0x22, 0x31, 0x30, 0x1b, 0x27, 0x5c, 0x31, 0xd5, 0x6a, 0x4a, 0xcb, 0x6e, 0xa2, 0xdd,
-	0x46, 0x2b, 0x0e, 0x1d, 0x45, 0x01, 0x24, 0xe0, 0xe6, 0x0e, 0x35, 0xee, 0xb0, 0x5c, 0xda, 0x76,
-	0x13, 0x6d, 0x88, 0x5e, 0x24, 0xcc, 0x96, 0xb5, 0x68, 0xc7, 0xe0, 0x00, 0x39, 0x06, 0xb7, 0x55,
-	0xe7, 0x35, 0xf9, 0xe1, 0x45, 0x09, 0x9a, 0x65, 0xd5, 0x38, 0x64, 0x42, 0x28, 0x4b, 0x5e, 0xe7,
-	0x7a, 0x59, 0x6b, 0xe4, 0x1f, 0xe6, 0x1c, 0x8f, 0x17, 0x63, 0x07, 0xec, 0x08, 0x62, 0x42, 0xdd,
-	0xd6, 0x35, 0x81, 0x87, 0xdb, 0x7a, 0xdc, 0xb4, 0xb1, 0x87, 0x5e, 0x7a, 0x6a, 0xb4, 0x45, 0xea,
-	0x37, 0xce, 0xab, 0x7f, 0x5a, 0x41, 0x0d, 0xae, 0x43, 0x9f, 0x78, 0xda, 0x00, 0xd5, 0x73, 0x82,
-	0xe5, 0x74, 0xe8, 0x4e, 0x27, 0x7a, 0x8d, 0xb7, 0xf7, 0xff, 0x30, 0x20, 0x6a, 0x43, 0xc8, 0x0e,
-	0x5d, 0xcd, 0x45, 0x2b, 0x11, 0xce, 0x02, 0x8a, 0x5d, 0xdb, 0xc7, 0xcc, 0x17, 0x55, 0xd9, 0xd9,
-	0x3d, 0x9b, 0xe8, 0x1f, 0x5d, 0xf9, 0x81, 0x07, 0x98, 0xf9, 0xd6, 0xb2, 0x0c, 0x9b, 0x6f, 0xb4,
-	0x87, 0xa8, 0xca, 0x88, 0x57, 0x8c, 0x8d, 0xb7, 0x9e, 0x29, 0x51, 0x41, 0xdd, 0xe8, 0x13, 0x4f,
-	0x0e, 0x8e, 0xeb, 0x8f, 0x4e, 0xf4, 0x66, 0x9f, 0x78, 0x21, 0x4e, 0xd2, 0x18, 0x2c, 0x1e, 0x67,
-	0xe3, 0x7d, 0xd4, 0x2c, 0x3d, 0xae, 0xd0, 0x63, 0xbf, 0xab, 0x68, 0xb5, 0x2f, 0x9a, 0xbf, 0x68,
-	0xb2, 0x9b, 0xe5, 0x14, 0xae, 0x76, 0xea, 0x62, 0x0a, 0xf3, 0x19, 0xba, 0x8f, 0x9a, 0x1c, 0xa5,
-	0xcd, 0x88, 0x27, 0x87, 0xe8, 0xed, 0x85, 0xf0, 0x8b, 0xd9, 0x39, 0x2a, 0x94, 0x2c, 0x9b, 0x51,
-	0xfd, 0x4f, 0xcd, 0x28, 0x27, 0x41, 0xf5, 0x72, 0x93, 0xe0, 0xef, 0x0d, 0x57, 0x5b, 0xd4, 0x70,
-	0xf5, 0xf3, 0x0d, 0xb7, 0xb8, 0x07, 0xb4, 0x9b, 0xa8, 0x3e, 0xa2, 0x6e, 0x1a, 0x40, 0xab, 0xc1,
-	0x75, 0x90, 0x3b, 0x8d, 0xa0, 0x1b, 0x62, 0x65, 0x8f, 0x20, 0xc1, 0x2e, 0x4e, 0x70, 0xab, 0xc9,
-	0xe1, 0xaf, 0x1b, 0xe2, 0x6b, 0x6e, 0x14, 0x5f, 0x73, 0x63, 0x37, 0xcc, 0x3a, 0x77, 0x7f, 0xfd,
-	0xe5, 0xde, 0x1b, 0x73, 0x85, 0x27, 0xbe, 0xd9, 0xa6, 0x43, 0x5d, 0x70, 0xcc, 0x5e, 0xee, 0x79,
-	0x84, 0x63, 0xe6, 0xe3, 0x00, 0x62, 0x6b, 0x55, 0x04, 0x3e, 0x92, 0x71, 0xa5, 0xb6, 0x27, 0x0a,
-	0x6a, 0x74, 0x21, 0x3b, 0x88, 0xa8, 0xe3, 0xe7, 0x85, 0x00, 0xf9, 0x42, 0x08, 0x6b, 0x89, 0x8d,
-	0xf6, 0x35, 0xaa, 0x39, 0x3e, 0x26, 0x21, 0xd7, 0xb3, 0xd9, 0xd9, 0x3b, 0x9b, 0xe8, 0x9f, 0xfc,
-	0xcb, 0x62, 0x0f, 0x61, 0x9c, 0xb2, 0x59, 0xa5, 0xef, 0xe5, 0x61, 0x1e, 0xe2, 0x11, 0x58, 0x22,
-	0xe2, 0x5c, 0xcb, 0xaa, 0xcf, 0xab, 0x65, 0x3b, 0x5f, 0x3c, 0x9e, 0xb6, 0x95, 0x27, 0xd3, 0xb6,
-	0xf2, 0xc7, 0xb4, 0xad, 0xfc, 0x70, 0xda, 0x5e, 0x7a, 0x72, 0xda, 0x5e, 0xfa, 0xed, 0xb4, 0xbd,
-	0xf4, 0xcd, 0x7b, 0x97, 0x7d, 0x80, 0xff, 0x8f, 0x0c, 0xea, 0x5c, 0x82, 0x77, 0xfe, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0xdd, 0x6a, 0x56, 0xdb, 0x9e, 0x09, 0x00, 0x00,
+	// 1088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0xaf, 0xe3, 0x24, 0x4d, 0x26, 0xe9, 0x8f, 0xaf, 0xbf, 0x65, 0xc9, 0x56, 0x90, 0x74, 0xbb,
+	0x08, 0xba, 0x0b, 0xb5, 0xd5, 0x02, 0x02, 0x55, 0xfc, 0x6a, 0xda, 0xa2, 0xad, 0x42, 0x77, 0x23,
+	0x67, 0x85, 0x80, 0x8b, 0x35, 0xb1, 0x1f, 0xce, 0x28, 0x8e, 0xc7, 0xf2, 0xd8, 0xa5, 0xfe, 0x17,
+	0x56, 0x1c, 0x38, 0x72, 0xe5, 0xc8, 0x9d, 0x3f, 0x62, 0xc5, 0x69, 0x8f, 0x0b, 0x87, 0x14, 0xb5,
+	0xff, 0x45, 0xb9, 0x20, 0xcf, 0x8c, 0x9d, 0x96, 0x76, 0xdb, 0x6d, 0x81, 0x53, 0x66, 0x9e, 0x3f,
+	0xf3, 0xe6, 0xbd, 0xf7, 0x79, 0x9f, 0x97, 0x41, 0x77, 0xf1, 0x01, 0x78, 0x38, 0x34, 0x46, 0xb1,
+	0x17, 0x11, 0x46, 0x5c, 0x63, 0x7f, 0xad, 0x0f, 0x11, 0x5e, 0x33, 0xa2, 0x24, 0x00, 0xa6, 0x07,
+	0x21, 0x8d, 0xa8, 0xf6, 0xaa, 0x00, 0xe9, 0x19, 0x48, 0x97, 0xa0, 0xc5, 0xdb, 0x2e, 0xa5, 0xae,
+	0x07, 0x06, 0x87, 0xf5, 0xe3, 0x6f, 0x0d, 0xec, 0x27, 0xe2, 0xcc, 0xe2, 0x82, 0x4b, 0x5d, 0xca,
+	0x97, 0x46, 0xba, 0x92, 0xd6, 0xdb, 0x36, 0x65, 0x23, 0xca, 0x2c, 0xf1, 0x41, 0x6c, 0xe4, 0xa7,
+	0x37, 0x64, 0x24, 0x71, 0x44, 0x3c, 0x36, 0x09, 0x63, 0x10, 0x02, 0x1b, 0x50, 0xcf, 0x91, 0xa8,
+	0x77, 0x24, 0x8a, 0xf9, 0x38, 0x60, 0x03, 0x1a, 0x19, 0x70, 0x10, 0xd0, 0x30, 0x02, 0xe7, 0xa2,
+	0xc0, 0x73, 0x74, 0x9e, 0xdd, 0x65, 0xe8, 0xe5, 0xdf, 0x8b, 0x48, 0xed, 0x40, 0xa2, 0x7d, 0x85,
+	0x0a, 0xc4, 0x69, 0x28, 0x4b, 0xca, 0x4a, 0xb5, 0xfd, 0xe0, 0x68, 0xdc, 0x2a, 0xec, 0x6e, 0x9f,
+	0x8c, 0x5b, 0x9f, 0xb8, 0x24, 0x1a, 0xc4, 0x7d, 0xdd, 0xa6, 0x23, 0x43, 0xb8, 0xf5, 0x21, 0xfa,
+	0x8e, 0x86, 0x43, 0xb9, 0x5b, 0xb5, 0x69, 0x08, 0xc6, 0xc1, 0xf9, 0xbb, 0xf4, 0x0e, 0x24, 0xbb,
+	0xdb, 0x66, 0x81, 0x38, 0xda, 0x17, 0xa8, 0x92, 0x05, 0xde, 0x28, 0x2c, 0x29, 0x2b, 0xb5, 0xf5,
+	0xfb, 0xba, 0xac, 0x6d, 0x66, 0xd7, 0xf3, 0x63, 0x32, 0x44, 0xbd, 0x27, 0xbf, 0xb4, 0x8b, 0x4f,
+	0xc7, 0xad, 0x29, 0x33, 0xf7, 0xa0, 0x7d, 0xaf, 0xa0, 0x4a, 0x10, 0xf7, 0xad, 0x21, 0x24, 0xac,
+	0xa1, 0x2e, 0xa9, 0x2b, 0xb5, 0xf5, 0x7b, 0xfa, 0x0b, 0xa8, 0x4a, 0x83, 0xd0, 0xbb, 0x71, 0xbf,
+	0x03, 0x09, 0xdb, 0xf1, 0xa3, 0x30, 0x69, 0x7f, 0xfe, 0xe4, 0xb0, 0xd5, 0xbe, 0x71, 0x4e, 0xdd,
+	0xb8, 0xef, 0x11, 0xbb, 0x03, 0x89, 0x39, 0x1d, 0x08, 0xaf, 0x9a, 0x89, 0xfe, 0xc7, 0x88, 0xeb,
+	0x13, 0xdf, 0xb5, 0x72, 0xd6, 0x1a, 0x45, 0x9e, 0x65, 0x2b, 0x0b, 0x8b, 0x93, 0x9b, 0xc7, 0xf4,
+	0x38, 0x83, 0xc9, 0xd4, 0xe6, 0xe5, 0xf9, 0xdc, 0xae, 0x7d, 0x86, 0x4a, 0x2c, 0xc2, 0x11, 0x34,
+	0x4a, 0x4b, 0xca, 0xca, 0xec, 0xa4, 0x5a, 0x79, 0x7a, 0xe7, 0xaa, 0xd5, 0x81, 0xa4, 0x97, 0x9e,
+	0x30, 0xc5, 0x41, 0x6d, 0x1b, 0x55, 0x86, 0x90, 0x58, 0x29, 0xcf, 0x8d, 0x32, 0x77, 0x72, 0xef,
+	0xa5, 0x9c, 0x3c, 0x4e, 0x02, 0x30, 0xa7, 0x87, 0x62, 0xb1, 0xb8, 0x81, 0xea, 0xa7, 0x8b, 0xa7,
+	0xcd, 0x23, 0x75, 0x08, 0x89, 0xe8, 0x11, 0x33, 0x5d, 0x6a, 0x0b, 0xa8, 0xb4, 0x8f, 0xbd, 0x18,
+	0x38, 0xaf, 0x75, 0x53, 0x6c, 0x36, 0x0a, 0x1f, 0x2a, 0x1b, 0xc5, 0x1f, 0x7f, 0x6a, 0x29, 0xcb,
+	0x7f, 0xaa, 0x68, 0xa6, 0x03, 0x89, 0x0b, 0x7e, 0x0f, 0x18, 0x23, 0xd4, 0xd7, 0xde, 0x9b, 0xf8,
+	0xa8, 0xad, 0xbf, 0x76, 0x19, 0x71, 0xb2, 0x3c, 0xfc, 0x9e, 0x9d, 0xac, 0x22, 0x05, 0x9e, 0x8c,
+	0x71, 0x75, 0x32, 0x7b, 0xf2, 0xcb, 0x99, 0xb2, 0x74, 0xd1, 0xfc, 0x90, 0x47, 0x73, 0x8a, 0x2b,
+	0xf5, 0x3a, 0x5c, 0xcd, 0x89, 0xe3, 0x13, 0xaa, 0x5e, 0x47, 0x08, 0x0e, 0x02, 0x12, 0x02, 0xb3,
+	0x70, 0xc4, 0x79, 0x57, 0xcd, 0xaa, 0xb4, 0x6c, 0x46, 0xda, 0x1d, 0x54, 0xb7, 0xe9, 0x28, 0xf0,
+	0x20, 0x02, 0x27, 0x05, 0x94, 0x38, 0xa0, 0x96, 0xdb, 0x36, 0x23, 0x6d, 0x88, 0xfe, 0x4f, 0x98,
+	0x25, 0x3b, 0xda, 0x0a, 0xc1, 0x06, 0xb2, 0x0f, 0x4e, 0xa3, 0xcc, 0x3b, 0xfb, 0xa3, 0xcb, 0x0a,
+	0x34, 0xa9, 0xaa, 0xbe, 0xcb, 0x04, 0x51, 0xa6, 0x3c, 0xce, 0xf9, 0x32, 0xe7, 0xc9, 0xdf, 0xcc,
+	0x69, 0x3c, 0x6e, 0x88, 0x6d, 0xb0, 0x02, 0x08, 0x09, 0x75, 0x1a, 0xd3, 0x22, 0x1e, 0x6e, 0xeb,
+	0x72, 0xd3, 0xe2, 0x16, 0x7a, 0xe5, 0x42, 0x6f, 0x57, 0xb1, 0x5f, 0x39, 0xcf, 0xfe, 0xcf, 0x2a,
+	0xaa, 0x70, 0x1e, 0x7a, 0xc4, 0xd5, 0xfa, 0xa8, 0x9c, 0x26, 0x98, 0xcf, 0x98, 0xce, 0xd1, 0xb8,
+	0x55, 0xe2, 0x43, 0xe2, 0x5f, 0x18, 0x33, 0xa5, 0x21, 0x24, 0xbb, 0x8e, 0xe6, 0xa0, 0x7a, 0x80,
+	0x13, 0x8f, 0x62, 0xc7, 0x1a, 0x60, 0x36, 0x10, 0x5d, 0xd9, 0xde, 0x3c, 0x19, 0xb7, 0x3e, 0xbe,
+	0xf1, 0x05, 0x0f, 0x30, 0x1b, 0x98, 0x35, 0xe9, 0x36, 0xdd, 0x68, 0x0f, 0x51, 0x91, 0x11, 0x37,
+	0x1b, 0x3e, 0x6f, 0xbf, 0x90, 0xa2, 0x2c, 0x75, 0xbd, 0x47, 0x5c, 0x39, 0x7e, 0x66, 0x9e, 0x1c,
+	0xb6, 0xaa, 0x3d, 0xe2, 0xfa, 0x38, 0x8a, 0x43, 0x30, 0xb9, 0x9f, 0x33, 0x62, 0x2d, 0xde, 0x58,
+	0xac, 0x1f, 0xa0, 0x6a, 0x7e, 0xcf, 0x0d, 0x94, 0xfa, 0x9b, 0x8a, 0x66, 0x7b, 0x62, 0x10, 0x65,
+	0x52, 0xbd, 0x95, 0xff, 0x23, 0x14, 0xdb, 0x65, 0xf1, 0x8f, 0xc0, 0xe7, 0xf9, 0x36, 0xaa, 0xf2,
+	0xb8, 0x2c, 0x46, 0x5c, 0x39, 0xd0, 0xef, 0x5c, 0x59, 0x84, 0x6c, 0x8e, 0x8f, 0xb2, 0x7e, 0xc8,
+	0x25, 0xad, 0xfe, 0x23, 0x49, 0xcb, 0x79, 0x52, 0xbc, 0xde, 0x3c, 0x39, 0x2b, 0xdb, 0xd2, 0x55,
+	0xb2, 0x2d, 0x9f, 0x97, 0xed, 0xd5, 0x4a, 0xd2, 0x6e, 0xa1, 0xf2, 0x88, 0x3a, 0xb1, 0x07, 0x8d,
+	0x0a, 0xe7, 0x41, 0xee, 0x34, 0x82, 0xe6, 0xc4, 0xca, 0x1a, 0x41, 0x84, 0x1d, 0x1c, 0xe1, 0x46,
+	0x95, 0x87, 0xbf, 0xa0, 0x8b, 0x97, 0x85, 0x9e, 0xbd, 0x2c, 0xf4, 0x4d, 0x3f, 0x69, 0xdf, 0xff,
+	0xf5, 0x97, 0xd5, 0x37, 0x4f, 0xb5, 0xaf, 0x78, 0x3f, 0x18, 0x36, 0x75, 0xc0, 0x36, 0xba, 0x29,
+	0x72, 0x0f, 0x87, 0x6c, 0x80, 0x3d, 0x08, 0xcd, 0x59, 0xe1, 0x78, 0x4f, 0xfa, 0x95, 0xdc, 0x1e,
+	0x2a, 0xa8, 0xd2, 0x81, 0x64, 0x27, 0xa0, 0xf6, 0x20, 0x6d, 0x04, 0x48, 0x17, 0x82, 0x58, 0x53,
+	0x6c, 0xb4, 0xaf, 0x51, 0xc9, 0x1e, 0x60, 0xe2, 0x73, 0x3e, 0xab, 0xed, 0xad, 0x93, 0x71, 0xeb,
+	0xd3, 0x97, 0x94, 0x8c, 0x0f, 0x07, 0x31, 0x9b, 0xe8, 0x65, 0x2b, 0x75, 0xf3, 0x10, 0x8f, 0xc0,
+	0x14, 0x1e, 0x4f, 0x09, 0x5f, 0xfd, 0xaf, 0x84, 0xbf, 0xfc, 0x5c, 0x41, 0x0b, 0xb2, 0x7b, 0xbb,
+	0x38, 0x8c, 0x88, 0x4d, 0x02, 0x1c, 0xa5, 0x3d, 0xfc, 0x08, 0x55, 0xf7, 0xb1, 0x47, 0x1c, 0x1c,
+	0xd1, 0x90, 0x67, 0x5c, 0x6f, 0xaf, 0x9d, 0x8c, 0x5b, 0xab, 0x17, 0xd5, 0x33, 0xfd, 0x59, 0x65,
+	0xce, 0x50, 0x3e, 0x8f, 0xbe, 0xc4, 0xde, 0xa6, 0xe3, 0x84, 0xc0, 0x98, 0x39, 0xf1, 0xa1, 0xdd,
+	0x45, 0x33, 0x4c, 0xe8, 0xc3, 0xb2, 0x69, 0xec, 0x8b, 0x17, 0x4d, 0xd1, 0xac, 0x4b, 0xe3, 0x56,
+	0x6a, 0x4b, 0x9b, 0x63, 0x44, 0x18, 0x03, 0x47, 0x62, 0x54, 0x8e, 0xa9, 0x09, 0x9b, 0x80, 0xbc,
+	0x85, 0xe6, 0x24, 0x44, 0x9e, 0x64, 0xbc, 0x87, 0xeb, 0xe6, 0xac, 0x30, 0x4b, 0x11, 0xb2, 0xf6,
+	0xa3, 0xa7, 0x47, 0x4d, 0xe5, 0xd9, 0x51, 0x53, 0xf9, 0xe3, 0xa8, 0xa9, 0xfc, 0x70, 0xdc, 0x9c,
+	0x7a, 0x76, 0xdc, 0x9c, 0x7a, 0x7e, 0xdc, 0x9c, 0xfa, 0xe6, 0xfd, 0xeb, 0xd6, 0x8e, 0xe7, 0xd5,
+	0x2f, 0xf3, 0xee, 0x7a, 0xf7, 0xaf, 0x01, 0x00, 0x3f, 0x68, 0x43, 0x26, 0x05, 0x0b, 0x00, 0x00,
 }
 
 func (m *Key) Marshal() (dAtA []byte, err error) {
@@ -510,6 +609,11 @@ func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.State != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.State))
 		i--
@@ -681,6 +785,11 @@ func (m *MultiSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Sigs) > 0 {
 		keysForSigs := make([]string, 0, len(m.Sigs))
 		for k := range m.Sigs {
@@ -853,6 +962,53 @@ func (m *KeyEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigningParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedSessions) > 0 {
+		i -= len(m.MissedSessions)
+		copy(dAtA[i:], m.MissedSessions)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MissedSessions)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SessionCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SessionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -893,6 +1049,9 @@ func (m *Key) Size() (n int) {
 	if m.State != 0 {
 		n += 1 + sovTypes(uint64(m.State))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTypes(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -955,6 +1114,9 @@ func (m *MultiSig) Size() (n int) {
 			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
 		}
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTypes(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -1014,6 +1176,29 @@ func (m *KeyEpoch) Size() (n int) {
 	return n
 }
 
+func (m *SigningParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SessionCount != 0 {
+		n += 1 + sovTypes(uint64(m.SessionCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovTypes(uint64(m.MissedCount))
+	}
+	l = len(m.MissedSessions)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1294,6 +1479,25 @@ func (m *Key) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported1.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1845,6 +2049,25 @@ func (m *MultiSig) Unmarshal(dAtA []byte) error {
 			}
 			m.Sigs[mapkey] = ((Signature)(mapvalue))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= exported1.KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2278,6 +2501,162 @@ func (m *KeyEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SigningParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionCount", wireType)
+			}
+			m.SessionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSessions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedSessions = append(m.MissedSessions[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedSessions == nil {
+				m.MissedSessions = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
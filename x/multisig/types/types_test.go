@@ -1,10 +1,12 @@
 package types_test
 
 import (
+	"crypto/ed25519"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	ec "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
@@ -32,7 +34,7 @@ func TestKeygenSession(t *testing.T) {
 		threshold := utilstestutils.RandThreshold()
 		snapshot := snapshottestutils.Snapshot(uint64(rand.I64Between(10, 20)), threshold)
 
-		keygenSession = types.NewKeygenSession(multisigtestutils.KeyID(), threshold, threshold, snapshot, rand.I64Between(10, 100), types.DefaultParams().KeygenGracePeriod)
+		keygenSession = types.NewKeygenSession(multisigtestutils.KeyID(), exported.ECDSA, threshold, threshold, snapshot, rand.I64Between(10, 100), types.DefaultParams().KeygenGracePeriod)
 	})
 
 	t.Run("ValidateBasic", func(t *testing.T) {
@@ -107,6 +109,26 @@ func TestKeygenSession(t *testing.T) {
 			}).
 			Run(t)
 
+		givenNewKeygenSession.
+			When("the key is an ed25519 key", func() {
+				keygenSession.Key.KeyType = exported.Ed25519
+				blockHeight = keygenSession.ExpiresAt - 1
+				participant = keygenSession.GetKey().Snapshot.GetParticipantAddresses()[0]
+			}).
+			Branch(
+				Then("should reject an ECDSA public key", func(t *testing.T) {
+					err := keygenSession.AddKey(blockHeight, participant, typestestutils.PublicKey())
+					assert.ErrorContains(t, err, "invalid Ed25519 public key")
+				}),
+				Then("should accept an ed25519 public key", func(t *testing.T) {
+					pk, _, err := ed25519.GenerateKey(nil)
+					assert.NoError(t, err)
+
+					assert.NoError(t, keygenSession.AddKey(blockHeight, participant, exported.PublicKey(pk)))
+				}),
+			).
+			Run(t)
+
 		givenNewKeygenSession.
 			When("block height is after expiry", func() {
 				blockHeight = keygenSession.GetExpiresAt() + rand.I64Between(0, 10)
@@ -341,7 +363,7 @@ func TestSignature_Verify(t *testing.T) {
 				sig = s.Serialize()
 			}).
 				Then("signature verification succeeds", func(t *testing.T) {
-					assert.True(t, sig.Verify(payload, sk.PubKey().SerializeCompressed()))
+					assert.True(t, sig.Verify(exported.ECDSA, payload, sk.PubKey().SerializeCompressed()))
 				}),
 			When("a an invalid signature is created", func() {
 				wrongKey := funcs.Must(btcec.NewPrivateKey())
@@ -349,7 +371,46 @@ func TestSignature_Verify(t *testing.T) {
 				sig = s.Serialize()
 			}).
 				Then("signature verification fails", func(t *testing.T) {
-					assert.False(t, sig.Verify(payload, sk.PubKey().SerializeCompressed()))
+					assert.False(t, sig.Verify(exported.ECDSA, payload, sk.PubKey().SerializeCompressed()))
 				}),
 		).Run(t)
 }
+
+func TestSignature_VerifyKeyTypes(t *testing.T) {
+	payload := rand.Bytes(exported.HashLength)
+
+	ecdsaSK := funcs.Must(btcec.NewPrivateKey())
+	schnorrSK := funcs.Must(btcec.NewPrivateKey())
+	ed25519PK, ed25519SK, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		keyType exported.KeyType
+		pubKey  exported.PublicKey
+		sig     types.Signature
+	}{
+		{exported.ECDSA, ecdsaSK.PubKey().SerializeCompressed(), ec.Sign(ecdsaSK, payload).Serialize()},
+		{exported.Ed25519, exported.PublicKey(ed25519PK), ed25519.Sign(ed25519SK, payload)},
+		{exported.Schnorr, schnorr.SerializePubKey(schnorrSK.PubKey()), funcs.Must(schnorr.Sign(schnorrSK, payload)).Serialize()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.keyType.String(), func(t *testing.T) {
+			assert.NoError(t, testCase.keyType.ValidatePubKey(testCase.pubKey))
+			assert.NoError(t, testCase.sig.Validate(testCase.keyType))
+			assert.NoError(t, testCase.sig.ValidateBasic())
+
+			assert.True(t, testCase.sig.Verify(testCase.keyType, payload, testCase.pubKey))
+			assert.False(t, testCase.sig.Verify(testCase.keyType, rand.Bytes(exported.HashLength), testCase.pubKey))
+
+			for _, other := range exported.KeyTypes() {
+				if other != testCase.keyType {
+					assert.False(t, testCase.sig.Verify(other, payload, testCase.pubKey))
+				}
+			}
+		})
+	}
+
+	assert.Error(t, types.Signature(rand.Bytes(10)).ValidateBasic())
+	assert.Error(t, exported.KeyType(100).ValidateBasic())
+}
@@ -7,11 +7,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 )
 
 // EndBlocker called every block
 func EndBlocker(ctx sdk.Context, n types.Nexus, r types.RewardKeeper, s types.Snapshotter) ([]abci.ValidatorUpdate, error) {
+	// unhealthy chains must be detected before their absent maintainers get de-registered
+	deactivateUnhealthyChains(ctx, n)
+
 	if err := checkChainMaintainers(ctx, n, r, s); err != nil {
 		return nil, err
 	}
@@ -46,7 +52,7 @@ func checkChainMaintainers(ctx sdk.Context, n types.Nexus, r types.RewardKeeper,
 				continue
 			}
 
-			rewardPool.ClearRewards(maintainerState.GetAddress())
+			rewardPool.ClearRewards(maintainerState.GetAddress(), reward.MaintainerDeregistered)
 			if err := n.RemoveChainMaintainer(ctx, chain, maintainerState.GetAddress()); err != nil {
 				return err
 			}
@@ -68,6 +74,66 @@ func checkChainMaintainers(ctx sdk.Context, n types.Nexus, r types.RewardKeeper,
 	return nil
 }
 
+// deactivateUnhealthyChains deactivates activated chains if automatic deactivation is enabled and
+// - too many of the chain's recent polls have failed or expired, or
+// - too many of the chain's maintainers have missed voting for too many polls
+func deactivateUnhealthyChains(ctx sdk.Context, n types.Nexus) {
+	params := n.GetParams(ctx)
+	if !params.ChainAutoDeactivationEnabled {
+		return
+	}
+
+	window := int(params.ChainMaintainerCheckWindow)
+
+	for _, chain := range n.GetChains(ctx) {
+		if !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		reason, unhealthy := checkChainHealth(ctx, n, chain, params, window)
+		if !unhealthy {
+			continue
+		}
+
+		n.DeactivateChain(ctx, chain)
+		events.Emit(ctx, &types.ChainAutoDeactivated{Chain: chain.Name, Reason: reason})
+
+		n.Logger(ctx).Info(fmt.Sprintf("automatically deactivated chain %s: %s", chain.Name, reason))
+	}
+}
+
+// checkChainHealth only considers the polls recorded since the chain was last (re)activated,
+// so votes missed and polls failed before a deactivation cannot trip the check again right after reactivation
+func checkChainHealth(ctx sdk.Context, n types.Nexus, chain exported.Chain, params types.Params, window int) (string, bool) {
+	failedPollCount, observedPollCount := n.CountFailedChainPolls(ctx, chain.Name, window)
+	if observedPollCount == 0 || observedPollCount < params.ChainHealthMinPollCount {
+		return "", false
+	}
+
+	if utils.NewThreshold(int64(failedPollCount), int64(observedPollCount)).GT(params.ChainFailedPollThreshold) {
+		return fmt.Sprintf("%d of the last %d polls failed", failedPollCount, observedPollCount), true
+	}
+
+	maintainerStates := n.GetChainMaintainerStates(ctx, chain)
+	if len(maintainerStates) == 0 {
+		return "", false
+	}
+
+	absentMaintainerCount := 0
+	for _, maintainerState := range maintainerStates {
+		missingVoteCount := maintainerState.CountMissingVotes(int(observedPollCount))
+		if utils.NewThreshold(int64(missingVoteCount), int64(observedPollCount)).GT(params.ChainMaintainerMissingVoteThreshold) {
+			absentMaintainerCount++
+		}
+	}
+
+	if utils.NewThreshold(int64(absentMaintainerCount), int64(len(maintainerStates))).GT(params.ChainMaintainerMassMissingVoteThreshold) {
+		return fmt.Sprintf("%d of %d chain maintainers missed too many votes", absentMaintainerCount, len(maintainerStates)), true
+	}
+
+	return "", false
+}
+
 func routeQueuedMessages(ctx sdk.Context, n types.Nexus) {
 	params := n.GetParams(ctx)
 
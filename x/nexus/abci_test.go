@@ -15,6 +15,8 @@ import (
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types/mock"
+	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/exported"
+	rewardmock "github.com/axelarnetwork/axelar-core/x/reward/exported/mock"
 	. "github.com/axelarnetwork/utils/test"
 )
 
@@ -115,3 +117,144 @@ func TestRouteQueuedMessages(t *testing.T) {
 		}).
 		Run(t)
 }
+
+func TestDeactivateUnhealthyChains(t *testing.T) {
+	var (
+		ctx              sdk.Context
+		n                *mock.NexusMock
+		params           types.Params
+		chain            exported.Chain
+		maintainerStates []exported.MaintainerState
+		reward           *mock.RewardKeeperMock
+		snapshot         *mock.SnapshotterMock
+	)
+
+	window := int(types.DefaultParams().ChainMaintainerCheckWindow)
+	maintainerState := func(missingVotes int) exported.MaintainerState {
+		ms := types.NewMaintainerState(chain.Name, rand.ValAddr())
+		for i := 0; i < window; i++ {
+			ms.MarkMissingVote(i < missingVotes)
+		}
+
+		return ms
+	}
+	failedPolls := func(failed, observed int) func(sdk.Context, exported.ChainName, int) (uint64, uint64) {
+		return func(sdk.Context, exported.ChainName, int) (uint64, uint64) { return uint64(failed), uint64(observed) }
+	}
+
+	givenTheEndBlocker := Given("everything needed for the end blocker", func() {
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
+		params = types.DefaultParams()
+		chain = exported.Chain{Name: exported.ChainName(rand.NormalizedStr(5))}
+		maintainerStates = nil
+
+		n = &mock.NexusMock{
+			LoggerFunc:                   func(_ sdk.Context) log.Logger { return log.NewTestLogger(t) },
+			GetChainsFunc:                func(ctx sdk.Context) []exported.Chain { return []exported.Chain{chain} },
+			GetParamsFunc:                func(ctx sdk.Context) types.Params { return params },
+			IsChainActivatedFunc:         func(sdk.Context, exported.Chain) bool { return len(n.DeactivateChainCalls()) == 0 },
+			GetChainMaintainerStatesFunc: func(sdk.Context, exported.Chain) []exported.MaintainerState { return maintainerStates },
+			CountFailedChainPollsFunc:    failedPolls(0, window),
+			DeactivateChainFunc:          func(sdk.Context, exported.Chain) {},
+			RemoveChainMaintainerFunc:    func(sdk.Context, exported.Chain, sdk.ValAddress) error { return nil },
+			DequeueRouteMessageFunc: func(ctx sdk.Context) (exported.GeneralMessage, bool) {
+				return exported.GeneralMessage{}, false
+			},
+		}
+		reward = &mock.RewardKeeperMock{
+			GetPoolFunc: func(sdk.Context, string) rewardtypes.RewardPool {
+				return &rewardmock.RewardPoolMock{ClearRewardsFunc: func(sdk.ValAddress, rewardtypes.ClearReason) {}}
+			},
+		}
+		snapshot = &mock.SnapshotterMock{
+			GetProxyFunc: func(sdk.Context, sdk.ValAddress) (sdk.AccAddress, bool) { return rand.AccAddr(), true },
+		}
+	})
+
+	whenAutoDeactivationIsEnabled := When("automatic chain deactivation is enabled", func() {
+		params.ChainAutoDeactivationEnabled = true
+	})
+
+	givenTheEndBlocker.
+		When("automatic chain deactivation is disabled", func() {
+			params.ChainAutoDeactivationEnabled = false
+		}).
+		When("all polls of the chain failed", func() {
+			n.CountFailedChainPollsFunc = failedPolls(window, window)
+		}).
+		Then("should not deactivate the chain", func(t *testing.T) {
+			_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+			assert.NoError(t, err)
+			assert.Empty(t, n.DeactivateChainCalls())
+		}).
+		Run(t)
+
+	givenTheEndBlocker.
+		When2(whenAutoDeactivationIsEnabled).
+		Branch(
+			When("the chain is healthy", func() {
+				n.CountFailedChainPollsFunc = failedPolls(window/2, window)
+				maintainerStates = []exported.MaintainerState{maintainerState(0), maintainerState(window)}
+			}).
+				Then("should not deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Empty(t, n.DeactivateChainCalls())
+				}),
+
+			When("too many polls of the chain failed", func() {
+				n.CountFailedChainPollsFunc = failedPolls(window/2+1, window)
+			}).
+				Then("should deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Len(t, n.DeactivateChainCalls(), 1)
+					assert.Equal(t, chain, n.DeactivateChainCalls()[0].Chain)
+					assert.Len(t, ctx.EventManager().Events(), 1)
+					assert.Equal(t, "axelar.nexus.v1beta1.ChainAutoDeactivated", ctx.EventManager().Events()[0].Type)
+				}),
+
+			When("too many maintainers of the chain missed too many votes", func() {
+				maintainerStates = []exported.MaintainerState{maintainerState(0), maintainerState(window), maintainerState(window)}
+			}).
+				Then("should deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Len(t, n.DeactivateChainCalls(), 1)
+					assert.Equal(t, chain, n.DeactivateChainCalls()[0].Chain)
+				}),
+
+			When("the chain has not had enough polls since its reactivation", func() {
+				observed := int(params.ChainHealthMinPollCount) - 1
+				n.CountFailedChainPollsFunc = failedPolls(observed, observed)
+				maintainerStates = []exported.MaintainerState{maintainerState(window), maintainerState(window)}
+			}).
+				Then("should not deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Empty(t, n.DeactivateChainCalls())
+				}),
+
+			When("most polls since the chain's reactivation failed", func() {
+				observed := int(params.ChainHealthMinPollCount) * 2
+				n.CountFailedChainPollsFunc = failedPolls(observed/2+1, observed)
+			}).
+				Then("should deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Len(t, n.DeactivateChainCalls(), 1)
+				}),
+
+			When("maintainers only missed votes before the chain's reactivation", func() {
+				observed := int(params.ChainHealthMinPollCount) * 2
+				n.CountFailedChainPollsFunc = failedPolls(0, observed)
+				maintainerStates = []exported.MaintainerState{maintainerState(window - observed), maintainerState(window - observed)}
+			}).
+				Then("should not deactivate the chain", func(t *testing.T) {
+					_, err := nexus.EndBlocker(ctx, n, reward, snapshot)
+					assert.NoError(t, err)
+					assert.Empty(t, n.DeactivateChainCalls())
+				}),
+		).
+		Run(t)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -39,6 +40,8 @@ func GetQueryCmd() *cobra.Command {
 		getCmdChainState(),
 		getCmdChainsByAsset(),
 		getCmdMessage(),
+		getCmdMessages(),
+		getCmdTransferRateLimit(),
 		getParams(),
 	)
 
@@ -160,6 +163,37 @@ func getCmdFee() *cobra.Command {
 	return cmd
 }
 
+func getCmdTransferRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-rate-limit [chain] [asset]",
+		Short: "Returns the transfer rate limit of an asset on a chain, and the amounts transferred within the current window",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.TransferRateLimit(cmd.Context(),
+				&types.TransferRateLimitRequest{
+					Chain: args[0],
+					Asset: args[1],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getCmdTransferFee() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer-fee [source-chain] [destination-chain] [amount]",
@@ -376,6 +410,67 @@ func getCmdMessage() *cobra.Command {
 	return cmd
 }
 
+func getCmdMessages() *cobra.Command {
+	cmdName := "messages"
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "Returns the cross-chain messages matching the given filters",
+		Args:  cobra.ExactArgs(0),
+	}
+
+	status := cmd.Flags().String("status", "", "the message status [approved|processing|executed|failed|refunded]")
+	sourceChain := cmd.Flags().String("source-chain", "", "the source chain of the messages")
+	destinationChain := cmd.Flags().String("destination-chain", "", "the destination chain of the messages")
+	sender := cmd.Flags().String("sender", "", "the sender address of the messages")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		messageStatus := nexus.NonExistent
+		if *status != "" {
+			s, ok := nexus.GeneralMessage_Status_value["STATUS_"+strings.ToUpper(*status)]
+			if !ok || nexus.GeneralMessage_Status(s) == nexus.NonExistent {
+				return fmt.Errorf("unrecognized message status %s", *status)
+			}
+
+			messageStatus = nexus.GeneralMessage_Status(s)
+		}
+
+		res, err := queryClient.Messages(cmd.Context(), &types.MessagesRequest{
+			Status:           messageStatus,
+			SourceChain:      nexus.ChainName(*sourceChain),
+			DestinationChain: nexus.ChainName(*destinationChain),
+			Sender:           *sender,
+			Pagination:       pageReq,
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
 func getParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -2,17 +2,24 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
 )
 
+const (
+	directionFrom = "from"
+	directionTo   = "to"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -31,6 +38,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDeactivateChain(),
 		GetCmdRegisterAssetFee(),
 		GetCmdRetryFailedMessage(),
+		GetCmdSetTransferRateLimit(),
 	)
 
 	return txCmd
@@ -181,3 +189,51 @@ func GetCmdRetryFailedMessage() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSetTransferRateLimit returns the cli command to set the transfer rate limit of an asset on a chain
+func GetCmdSetTransferRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-rate-limit [chain] [limit] [window]",
+		Short: "set the transfer rate limit of an asset on a chain",
+		Long:  "Limit the amount of an asset that can be transferred to or from a chain within a rolling window, e.g. 1000000uusdc 6h. Without a direction, the limit applies to each direction separately. A zero window removes the limit.",
+		Args:  cobra.ExactArgs(3),
+	}
+
+	direction := cmd.Flags().String("direction", "", fmt.Sprintf("only limit transfers in the given direction [%s|%s]", directionFrom, directionTo))
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		cliCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		limit, err := sdk.ParseCoinNormalized(args[1])
+		if err != nil {
+			return err
+		}
+
+		window, err := time.ParseDuration(args[2])
+		if err != nil {
+			return err
+		}
+
+		var transferDirection exported.TransferDirection
+		switch *direction {
+		case "":
+			transferDirection = exported.Unspecified
+		case directionFrom:
+			transferDirection = exported.TransferDirectionFrom
+		case directionTo:
+			transferDirection = exported.TransferDirectionTo
+		default:
+			return fmt.Errorf("unrecognized transfer direction %s", *direction)
+		}
+
+		msg := types.NewSetTransferRateLimitRequest(cliCtx.GetFromAddress(), exported.ChainName(args[0]), limit, window, transferDirection)
+
+		return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
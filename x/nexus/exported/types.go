@@ -307,6 +307,16 @@ func (m GeneralMessage) Is(status GeneralMessage_Status) bool {
 	return m.Status == status
 }
 
+// IsRefundDue returns true if the message failed often enough to have its asset refunded.
+// The message's own refund policy takes precedence over the given network-wide maximum of failures, 0 disables refunds
+func (m GeneralMessage) IsRefundDue(maxFailures uint64) bool {
+	if m.RefundPolicy != nil {
+		maxFailures = m.RefundPolicy.MaxFailures
+	}
+
+	return maxFailures > 0 && m.FailureCount >= maxFailures
+}
+
 // Match returns true if hash of payload matches the expected
 func (m GeneralMessage) Match(payload []byte) bool {
 	return common.BytesToHash(m.PayloadHash) == crypto.Keccak256Hash(payload)
@@ -97,6 +97,7 @@ const (
 	Processing  GeneralMessage_Status = 2
 	Executed    GeneralMessage_Status = 3
 	Failed      GeneralMessage_Status = 4
+	Refunded    GeneralMessage_Status = 5
 )
 
 var GeneralMessage_Status_name = map[int32]string{
@@ -105,6 +106,7 @@ var GeneralMessage_Status_name = map[int32]string{
 	2: "STATUS_PROCESSING",
 	3: "STATUS_EXECUTED",
 	4: "STATUS_FAILED",
+	5: "STATUS_REFUNDED",
 }
 
 var GeneralMessage_Status_value = map[string]int32{
@@ -113,6 +115,7 @@ var GeneralMessage_Status_value = map[string]int32{
 	"STATUS_PROCESSING":  2,
 	"STATUS_EXECUTED":    3,
 	"STATUS_FAILED":      4,
+	"STATUS_REFUNDED":    5,
 }
 
 func (x GeneralMessage_Status) String() string {
@@ -379,6 +382,15 @@ type GeneralMessage struct {
 	Asset         *types.Coin           `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
 	SourceTxID    []byte                `protobuf:"bytes,7,opt,name=source_tx_id,json=sourceTxId,proto3" json:"source_tx_id,omitempty"`
 	SourceTxIndex uint64                `protobuf:"varint,8,opt,name=source_tx_index,json=sourceTxIndex,proto3" json:"source_tx_index,omitempty"`
+	// reason of the latest failed delivery attempt
+	FailureReason string `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// number of failed delivery attempts
+	FailureCount uint64 `protobuf:"varint,10,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// overrides the network-wide refund policy if set
+	RefundPolicy *RefundPolicy `protobuf:"bytes,11,opt,name=refund_policy,json=refundPolicy,proto3" json:"refund_policy,omitempty"`
+	// optional gas limit requested by the source for executing the message on
+	// the destination chain, 0 if not set
+	GasLimit uint64 `protobuf:"varint,12,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *GeneralMessage) Reset()         { *m = GeneralMessage{} }
@@ -414,6 +426,47 @@ func (m *GeneralMessage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeneralMessage proto.InternalMessageInfo
 
+// RefundPolicy determines when the asset of a failed general message is
+// returned to its sender on the source chain
+type RefundPolicy struct {
+	// number of failed delivery attempts after which the asset is refunded, 0
+	// disables refunds
+	MaxFailures uint64 `protobuf:"varint,1,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+}
+
+func (m *RefundPolicy) Reset()         { *m = RefundPolicy{} }
+func (m *RefundPolicy) String() string { return proto.CompactTextString(m) }
+func (*RefundPolicy) ProtoMessage()    {}
+func (*RefundPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a7a8692925fe67, []int{7}
+}
+func (m *RefundPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundPolicy.Merge(m, src)
+}
+func (m *RefundPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundPolicy proto.InternalMessageInfo
+
 type WasmMessage struct {
 	SourceChain        ChainName                                     `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3,casttype=ChainName" json:"source_chain,omitempty"`
 	SourceAddress      string                                        `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
@@ -430,7 +483,7 @@ func (m *WasmMessage) Reset()         { *m = WasmMessage{} }
 func (m *WasmMessage) String() string { return proto.CompactTextString(m) }
 func (*WasmMessage) ProtoMessage()    {}
 func (*WasmMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82a7a8692925fe67, []int{7}
+	return fileDescriptor_82a7a8692925fe67, []int{8}
 }
 func (m *WasmMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,6 +523,7 @@ func init() {
 	proto.RegisterType((*FeeInfo)(nil), "axelar.nexus.exported.v1beta1.FeeInfo")
 	proto.RegisterType((*Asset)(nil), "axelar.nexus.exported.v1beta1.Asset")
 	proto.RegisterType((*GeneralMessage)(nil), "axelar.nexus.exported.v1beta1.GeneralMessage")
+	proto.RegisterType((*RefundPolicy)(nil), "axelar.nexus.exported.v1beta1.RefundPolicy")
 	proto.RegisterType((*WasmMessage)(nil), "axelar.nexus.exported.v1beta1.WasmMessage")
 }
 
@@ -478,94 +532,102 @@ func init() {
 }
 
 var fileDescriptor_82a7a8692925fe67 = []byte{
-	// 1383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1a, 0x57,
-	0x17, 0xf6, 0x60, 0x03, 0xf6, 0xc5, 0x26, 0xf8, 0x3a, 0x8e, 0x09, 0x51, 0x80, 0x90, 0xe4, 0x8d,
-	0x13, 0xbd, 0x06, 0xc7, 0x79, 0x93, 0x45, 0x5e, 0x35, 0x2d, 0x1f, 0x43, 0x32, 0x6a, 0x02, 0x68,
-	0xc0, 0x6d, 0xd5, 0x0d, 0xba, 0x9e, 0x39, 0xc0, 0xc8, 0x66, 0x2e, 0x9a, 0x3b, 0xa4, 0xf0, 0x0f,
-	0x2a, 0xba, 0xe9, 0xa2, 0xea, 0x8e, 0x55, 0xbb, 0xa8, 0xfa, 0x13, 0xfa, 0x07, 0x9a, 0x65, 0x96,
-	0x55, 0x17, 0xb4, 0x75, 0xba, 0xea, 0xba, 0x52, 0xa5, 0xa8, 0x8b, 0xea, 0xce, 0x9d, 0xe1, 0xd3,
-	0x8d, 0xa3, 0xaa, 0x2b, 0x98, 0x39, 0xcf, 0x73, 0xce, 0xb9, 0xe7, 0x3c, 0xe7, 0xdc, 0x41, 0xb7,
-	0x49, 0x0f, 0x4e, 0x88, 0x95, 0x31, 0xa1, 0xd7, 0x65, 0x19, 0xe8, 0x75, 0xa8, 0x65, 0x83, 0x9e,
-	0x79, 0x7e, 0xf7, 0x08, 0x6c, 0x72, 0x37, 0x63, 0xf7, 0x3b, 0xc0, 0xd2, 0x1d, 0x8b, 0xda, 0x14,
-	0x5f, 0x15, 0xd0, 0xb4, 0x03, 0x4d, 0x7b, 0xd0, 0xb4, 0x0b, 0x8d, 0x5d, 0x6c, 0xd2, 0x26, 0x75,
-	0x90, 0x19, 0xfe, 0x4f, 0x90, 0x62, 0x71, 0x8d, 0xb2, 0x36, 0x65, 0x99, 0x23, 0xc2, 0x60, 0xec,
-	0x55, 0xa3, 0x86, 0xe9, 0xda, 0x6f, 0xb9, 0xf1, 0x6d, 0xf6, 0xe6, 0xe8, 0xa9, 0x3f, 0x24, 0xe4,
-	0xcf, 0xb7, 0x88, 0x61, 0xe2, 0x6b, 0x68, 0xc5, 0x24, 0x6d, 0x88, 0x4a, 0x49, 0x69, 0x77, 0x2d,
-	0xb7, 0xf1, 0x7a, 0x94, 0x58, 0x73, 0x0c, 0x25, 0xd2, 0x06, 0xd5, 0x31, 0xe1, 0x87, 0x68, 0xc7,
-	0x24, 0xb6, 0xf1, 0x1c, 0xea, 0x84, 0x31, 0xb0, 0xeb, 0x3a, 0x74, 0x2c, 0xd0, 0x88, 0x0d, 0x7a,
-	0xd4, 0xe7, 0xb0, 0x7c, 0x51, 0x49, 0xdd, 0x16, 0x90, 0x2c, 0x47, 0x14, 0xc6, 0x00, 0xfc, 0x00,
-	0xed, 0xb0, 0x6e, 0x87, 0x67, 0xc2, 0xea, 0x0d, 0x6a, 0x81, 0xd1, 0x34, 0x85, 0x17, 0x16, 0x5d,
-	0x4e, 0x4a, 0xbb, 0xab, 0xea, 0xb6, 0x67, 0x2e, 0x0a, 0xab, 0xe3, 0x80, 0xe1, 0x77, 0xd1, 0xea,
-	0x31, 0xf4, 0xeb, 0x3c, 0xe7, 0xe8, 0x4a, 0x52, 0xda, 0x0d, 0x1f, 0xdc, 0x48, 0xbb, 0x15, 0xb3,
-	0xd9, 0x62, 0xbd, 0xd2, 0xef, 0x43, 0xbf, 0xd6, 0xef, 0x80, 0x1a, 0x3c, 0x16, 0x7f, 0xf0, 0x25,
-	0x14, 0x68, 0x53, 0xbd, 0x7b, 0x02, 0x51, 0x3f, 0xcf, 0x51, 0x75, 0x9f, 0x52, 0x14, 0x6d, 0xe6,
-	0x2d, 0xca, 0x98, 0x73, 0xc8, 0xac, 0xae, 0x5b, 0xc0, 0x18, 0x7e, 0x0f, 0xf9, 0x35, 0xfe, 0xec,
-	0x54, 0x21, 0x34, 0x09, 0x75, 0x76, 0x73, 0xd2, 0x0e, 0x37, 0xb7, 0xf2, 0x62, 0x94, 0x58, 0x52,
-	0x05, 0x11, 0x47, 0x51, 0x90, 0x08, 0x67, 0xa2, 0x26, 0xaa, 0xf7, 0x98, 0xfa, 0xcc, 0x87, 0xf0,
-	0x24, 0x62, 0xcd, 0x22, 0x26, 0x6b, 0x80, 0x85, 0x6b, 0x68, 0xcd, 0x02, 0xcd, 0xe8, 0x18, 0x60,
-	0xda, 0x6e, 0xd8, 0xfd, 0xf3, 0xc2, 0xce, 0xe7, 0xed, 0xa6, 0x30, 0x71, 0x84, 0xef, 0x23, 0xbf,
-	0x53, 0x5d, 0x27, 0x89, 0xd0, 0xc1, 0xe5, 0xb4, 0x10, 0x4c, 0x9a, 0x0b, 0x66, 0xe2, 0x87, 0x4e,
-	0xb2, 0x77, 0xd0, 0xf8, 0x06, 0xf2, 0x19, 0xba, 0xd3, 0x90, 0x95, 0xdc, 0xc5, 0xd3, 0x51, 0xc2,
-	0xa7, 0x14, 0x5e, 0x8f, 0x12, 0xc8, 0x4b, 0x56, 0x29, 0xa8, 0x3e, 0x43, 0xc7, 0x39, 0xe4, 0x67,
-	0x36, 0xb1, 0xbd, 0x86, 0xfc, 0xf7, 0x9c, 0x74, 0x3d, 0x76, 0x95, 0x73, 0x54, 0x41, 0x4d, 0x75,
-	0x50, 0xc8, 0x7b, 0x5f, 0x04, 0xc0, 0x04, 0xf9, 0xb9, 0x7c, 0x59, 0x54, 0x4a, 0x2e, 0xbf, 0x39,
-	0xdf, 0x7d, 0x9e, 0xef, 0xb7, 0x3f, 0x25, 0x76, 0x9b, 0x86, 0xdd, 0xea, 0x1e, 0xa5, 0x35, 0xda,
-	0xce, 0xb8, 0xd3, 0x20, 0x7e, 0xf6, 0x98, 0x7e, 0xec, 0x6a, 0x9c, 0x13, 0x98, 0x2a, 0x3c, 0xa7,
-	0x7e, 0x97, 0x50, 0xb0, 0x08, 0xa0, 0x98, 0x0d, 0x8a, 0xaf, 0x4f, 0xf7, 0x79, 0x41, 0xed, 0x6e,
-	0x2b, 0x2f, 0x4e, 0xd7, 0x70, 0xcd, 0x2b, 0xd1, 0x23, 0xb4, 0xda, 0x00, 0xa8, 0x5b, 0xfc, 0xfc,
-	0xbc, 0x50, 0xeb, 0xb9, 0xeb, 0x3c, 0xa3, 0x1f, 0x47, 0x89, 0x2b, 0x22, 0x3e, 0xd3, 0x8f, 0xd3,
-	0x06, 0xcd, 0xb4, 0x89, 0xdd, 0x4a, 0x3f, 0x85, 0x26, 0xd1, 0xfa, 0x05, 0xd0, 0xd4, 0x60, 0x03,
-	0x40, 0x25, 0x36, 0xe0, 0x07, 0x28, 0xd8, 0x36, 0xcc, 0x7a, 0x03, 0x44, 0xf9, 0xd6, 0x73, 0x57,
-	0x5d, 0xfa, 0xf6, 0x22, 0x5d, 0x31, 0x6d, 0x35, 0xd0, 0x36, 0x4c, 0x5e, 0x21, 0xce, 0x23, 0x3d,
-	0x87, 0xe7, 0x7f, 0x3b, 0x1e, 0xe9, 0x15, 0x01, 0x52, 0x5f, 0x4a, 0xc8, 0xef, 0xcc, 0x12, 0x3f,
-	0x8f, 0x0e, 0x26, 0x6d, 0x8b, 0x43, 0xab, 0xe2, 0x01, 0x97, 0xd1, 0x36, 0xcf, 0x87, 0xb4, 0x69,
-	0xd7, 0x5c, 0x18, 0xe9, 0xf5, 0xdc, 0x95, 0xbf, 0x8d, 0x10, 0x95, 0xd4, 0xad, 0xb6, 0x61, 0x66,
-	0x1d, 0xe2, 0xd4, 0xa4, 0xff, 0x07, 0x5d, 0x30, 0x58, 0x7d, 0x7a, 0x51, 0xb8, 0x13, 0xbe, 0x61,
-	0xb0, 0xd2, 0x64, 0x37, 0xa4, 0xbe, 0xf0, 0xa3, 0xf0, 0x63, 0x30, 0xc1, 0x22, 0x27, 0xcf, 0x80,
-	0x31, 0xd2, 0xe4, 0xb3, 0xca, 0xe5, 0x27, 0x7a, 0x12, 0x10, 0xf2, 0x73, 0x04, 0x57, 0x42, 0x01,
-	0x06, 0xa6, 0x0e, 0x96, 0x2b, 0xe7, 0x7f, 0x3a, 0x20, 0xae, 0x97, 0xd9, 0x99, 0x5b, 0xfe, 0xb7,
-	0x66, 0xee, 0x1a, 0x5a, 0xef, 0x90, 0xfe, 0x09, 0x25, 0x7a, 0xbd, 0x45, 0x58, 0x4b, 0xb4, 0x57,
-	0x0d, 0xb9, 0xef, 0x9e, 0x10, 0xd6, 0xc2, 0x4f, 0x51, 0x80, 0xcb, 0xbf, 0xcb, 0x9c, 0x1e, 0x86,
-	0x0f, 0xfe, 0x77, 0x4e, 0xd4, 0xd9, 0xfa, 0xa4, 0xab, 0x0e, 0x57, 0x75, 0x7d, 0xe0, 0x8c, 0x27,
-	0xd0, 0xc0, 0x39, 0x43, 0xee, 0x69, 0x77, 0x1f, 0xad, 0x33, 0xda, 0xb5, 0x34, 0xa8, 0xdb, 0xbd,
-	0xba, 0xa1, 0x47, 0x83, 0x4e, 0x8b, 0xc3, 0xa7, 0xa3, 0x04, 0xaa, 0x3a, 0xef, 0x6b, 0x3d, 0xa5,
-	0xa0, 0x22, 0xe6, 0xfd, 0x77, 0x9a, 0x39, 0xc5, 0x30, 0x75, 0xe8, 0x45, 0x57, 0xf9, 0x76, 0x50,
-	0x37, 0xc6, 0x20, 0xfe, 0x32, 0xf5, 0xbd, 0x84, 0x02, 0x22, 0x3b, 0x7c, 0x0b, 0xe1, 0x6a, 0x2d,
-	0x5b, 0x3b, 0xac, 0xd6, 0x0f, 0x4b, 0xd5, 0x8a, 0x9c, 0x57, 0x8a, 0x8a, 0x5c, 0x88, 0x2c, 0xc5,
-	0x2e, 0x0c, 0x86, 0xc9, 0x50, 0x89, 0x9a, 0x72, 0xcf, 0x60, 0xb6, 0xa8, 0xd7, 0x05, 0x17, 0x98,
-	0xad, 0x54, 0xd4, 0xf2, 0x07, 0x72, 0x21, 0x22, 0xc5, 0xd6, 0x07, 0xc3, 0xe4, 0x6a, 0xb6, 0xd3,
-	0xb1, 0xe8, 0x73, 0xd0, 0xf1, 0x4d, 0xb4, 0xe9, 0x42, 0x2a, 0x6a, 0x39, 0x2f, 0x57, 0xab, 0x4a,
-	0xe9, 0x71, 0xc4, 0x17, 0x0b, 0x0f, 0x86, 0x49, 0x54, 0xb1, 0xa8, 0x06, 0x8c, 0x19, 0x66, 0x73,
-	0xca, 0x93, 0xfc, 0x91, 0x9c, 0x3f, 0xac, 0xc9, 0x85, 0xc8, 0xb2, 0xf0, 0x24, 0xf7, 0x40, 0xeb,
-	0x72, 0x55, 0x5e, 0x45, 0x1b, 0x2e, 0xa4, 0x98, 0x55, 0x9e, 0xca, 0x85, 0xc8, 0x4a, 0x0c, 0x0d,
-	0x86, 0xc9, 0x40, 0x91, 0x18, 0x27, 0xa0, 0xc7, 0x56, 0x3f, 0xfd, 0x2a, 0xbe, 0xf4, 0xcd, 0xd7,
-	0x71, 0x29, 0xf5, 0xeb, 0x32, 0x0a, 0x7d, 0x48, 0x58, 0xdb, 0xd3, 0xe4, 0xa4, 0x66, 0x6f, 0xd8,
-	0x18, 0x21, 0x01, 0x11, 0x37, 0xe9, 0x4d, 0x14, 0x76, 0x19, 0xb3, 0x37, 0x81, 0x5b, 0x32, 0xef,
-	0xae, 0x79, 0x88, 0x36, 0x75, 0x60, 0xb6, 0xc1, 0x27, 0x85, 0x9a, 0xae, 0xf7, 0xe5, 0xb3, 0xbc,
-	0x47, 0xa6, 0x70, 0x22, 0x44, 0x06, 0x6d, 0x4d, 0x73, 0xbd, 0x38, 0x2b, 0x4e, 0x1c, 0x3c, 0x65,
-	0xf2, 0x82, 0xed, 0xcf, 0x69, 0x53, 0xac, 0x10, 0x27, 0x0e, 0x3f, 0x6c, 0xae, 0x6f, 0x03, 0x9b,
-	0x95, 0xea, 0x3b, 0x73, 0x5a, 0x09, 0x88, 0x75, 0x30, 0xab, 0x95, 0x59, 0xfe, 0xb4, 0x70, 0xfe,
-	0xbf, 0x28, 0x9c, 0xa0, 0x73, 0xad, 0x6c, 0xfd, 0x36, 0x4a, 0xcc, 0x9b, 0xe6, 0xd4, 0x84, 0x95,
-	0xf1, 0xbc, 0xaf, 0x3a, 0x51, 0xef, 0xbe, 0x1e, 0x25, 0xf6, 0xde, 0x62, 0xdf, 0x67, 0x35, 0xcd,
-	0x3d, 0xf0, 0x78, 0xd4, 0xc5, 0x4a, 0x59, 0x9b, 0x5f, 0x29, 0x77, 0xfe, 0x94, 0xd0, 0xc6, 0xcc,
-	0xc5, 0x84, 0xe3, 0x28, 0x56, 0x53, 0xb3, 0xa5, 0x6a, 0x51, 0x56, 0xeb, 0x5c, 0x2a, 0xf2, 0xac,
-	0x7e, 0xf1, 0x2d, 0x74, 0x69, 0xce, 0x5e, 0x91, 0x4b, 0x05, 0x2e, 0x48, 0x29, 0x16, 0x1a, 0x0c,
-	0x93, 0xc1, 0x0a, 0x98, 0x3a, 0x57, 0xe3, 0x6d, 0xb4, 0x33, 0x07, 0xcc, 0xaa, 0xf9, 0x27, 0x0a,
-	0xd7, 0xb7, 0xcf, 0xd5, 0xb7, 0xa5, 0xb5, 0x0c, 0xae, 0xef, 0x47, 0x28, 0x35, 0x07, 0x55, 0x4a,
-	0xd5, 0xc3, 0x62, 0x51, 0xc9, 0x2b, 0x72, 0xa9, 0x56, 0xcf, 0x3e, 0x2b, 0x1f, 0x96, 0x6a, 0x91,
-	0xe5, 0xd8, 0xa5, 0xc1, 0x30, 0x89, 0x15, 0x93, 0x75, 0x1b, 0x0d, 0x43, 0xe3, 0xcb, 0x46, 0x6c,
-	0x5d, 0xbc, 0x87, 0xb6, 0xe7, 0xf8, 0x63, 0x75, 0xe3, 0xc1, 0x30, 0x19, 0x1e, 0x5f, 0xb1, 0x73,
-	0x2a, 0xbf, 0xf3, 0x9d, 0x84, 0x36, 0x3d, 0x63, 0xc1, 0xb0, 0x40, 0xe3, 0x62, 0xc1, 0xf7, 0x50,
-	0x7c, 0xec, 0xae, 0xa0, 0xa8, 0x72, 0xbe, 0xa6, 0x94, 0x4b, 0x67, 0x8d, 0xf1, 0xa1, 0xc9, 0x3a,
-	0xa0, 0x19, 0x0d, 0x43, 0x7c, 0xd9, 0x9d, 0x41, 0x2a, 0xaa, 0xe5, 0x67, 0x11, 0x29, 0x76, 0x79,
-	0x30, 0x4c, 0x6e, 0x2f, 0x04, 0x2a, 0x5a, 0xb4, 0x8d, 0x0f, 0xa6, 0x72, 0x9f, 0xf0, 0x6a, 0xe5,
-	0x88, 0x2f, 0xb6, 0x33, 0x18, 0x26, 0xb7, 0x16, 0x58, 0x35, 0x1a, 0x5b, 0xe1, 0x07, 0xc8, 0x55,
-	0x5f, 0xfc, 0x12, 0x5f, 0x7a, 0x71, 0x1a, 0x97, 0x5e, 0x9e, 0xc6, 0xa5, 0x9f, 0x4f, 0xe3, 0xd2,
-	0xe7, 0xaf, 0xe2, 0x4b, 0x2f, 0x5f, 0xc5, 0x97, 0x7e, 0x78, 0x15, 0x5f, 0xfa, 0xf8, 0xfe, 0x94,
-	0x50, 0xc4, 0x76, 0x35, 0xc1, 0xfe, 0x84, 0x5a, 0xc7, 0xee, 0xd3, 0x9e, 0x46, 0x2d, 0xc8, 0xf4,
-	0xe6, 0xbe, 0xcd, 0x8f, 0x02, 0xce, 0x07, 0xf1, 0xbd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff,
-	0xe3, 0xd9, 0x7a, 0xbb, 0x0b, 0x00, 0x00,
+	// 1508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1b, 0x47,
+	0x12, 0xd6, 0x50, 0xfc, 0x53, 0x93, 0x92, 0xa9, 0x96, 0x65, 0x8d, 0x69, 0x98, 0xa4, 0x69, 0x7b,
+	0x2d, 0x7b, 0x57, 0xa4, 0x24, 0xaf, 0x7d, 0xf0, 0x62, 0xbd, 0xcb, 0x9f, 0xa1, 0x3d, 0x58, 0x99,
+	0x22, 0x9a, 0xd4, 0xee, 0x62, 0x2f, 0x83, 0xd6, 0x4c, 0x93, 0x1a, 0x88, 0x9c, 0x21, 0xa6, 0x87,
+	0x5e, 0xf2, 0x0d, 0x02, 0xe6, 0x92, 0x53, 0x6e, 0x3c, 0x25, 0x87, 0x20, 0x8f, 0x90, 0x27, 0xf0,
+	0xd1, 0xc7, 0x20, 0x07, 0x26, 0x91, 0x73, 0xf2, 0x31, 0x08, 0x10, 0xc0, 0xc8, 0x21, 0xe8, 0xee,
+	0x19, 0xfe, 0x49, 0xb1, 0x0c, 0x24, 0x27, 0x4d, 0x77, 0xd7, 0x57, 0x55, 0x5d, 0xf5, 0x7d, 0xd5,
+	0x22, 0xb8, 0x8f, 0xfb, 0xa4, 0x8d, 0x9d, 0xbc, 0x45, 0xfa, 0x3d, 0x9a, 0x27, 0xfd, 0xae, 0xed,
+	0xb8, 0xc4, 0xc8, 0xbf, 0xdc, 0x3b, 0x26, 0x2e, 0xde, 0xcb, 0xbb, 0x83, 0x2e, 0xa1, 0xb9, 0xae,
+	0x63, 0xbb, 0x36, 0xbc, 0x29, 0x4c, 0x73, 0xdc, 0x34, 0xe7, 0x9b, 0xe6, 0x3c, 0xd3, 0xe4, 0xd5,
+	0x96, 0xdd, 0xb2, 0xb9, 0x65, 0x9e, 0x7d, 0x09, 0x50, 0x32, 0xa5, 0xdb, 0xb4, 0x63, 0xd3, 0xfc,
+	0x31, 0xa6, 0x64, 0xe2, 0x55, 0xb7, 0x4d, 0xcb, 0x3b, 0xbf, 0xe7, 0xc5, 0x77, 0xe9, 0xfb, 0xa3,
+	0x67, 0x7f, 0x96, 0x40, 0xa8, 0x74, 0x82, 0x4d, 0x0b, 0xde, 0x02, 0x41, 0x0b, 0x77, 0x88, 0x2c,
+	0x65, 0xa4, 0xed, 0x95, 0xe2, 0xea, 0xbb, 0x71, 0x7a, 0x85, 0x1f, 0x54, 0x71, 0x87, 0x20, 0x7e,
+	0x04, 0x9f, 0x80, 0x2d, 0x0b, 0xbb, 0xe6, 0x4b, 0xa2, 0x61, 0x4a, 0x89, 0xab, 0x19, 0xa4, 0xeb,
+	0x10, 0x1d, 0xbb, 0xc4, 0x90, 0x03, 0x1c, 0x15, 0x90, 0x25, 0xb4, 0x29, 0x4c, 0x0a, 0xcc, 0xa2,
+	0x3c, 0x31, 0x80, 0x8f, 0xc1, 0x16, 0xed, 0x75, 0x59, 0x26, 0x54, 0x6b, 0xda, 0x0e, 0x31, 0x5b,
+	0x96, 0xf0, 0x42, 0xe5, 0xe5, 0x8c, 0xb4, 0x1d, 0x45, 0x9b, 0xfe, 0x71, 0x45, 0x9c, 0x72, 0x07,
+	0x14, 0xfe, 0x03, 0x44, 0x4f, 0xc9, 0x40, 0x63, 0x39, 0xcb, 0xc1, 0x8c, 0xb4, 0xbd, 0xb6, 0x7f,
+	0x27, 0xe7, 0x55, 0xcc, 0xa5, 0xe7, 0xeb, 0x95, 0xfb, 0x17, 0x19, 0x34, 0x06, 0x5d, 0x82, 0x22,
+	0xa7, 0xe2, 0x03, 0x5e, 0x03, 0xe1, 0x8e, 0x6d, 0xf4, 0xda, 0x44, 0x0e, 0xb1, 0x1c, 0x91, 0xb7,
+	0xca, 0xda, 0x60, 0xbd, 0xe4, 0xd8, 0x94, 0xf2, 0x4b, 0x16, 0x0c, 0xc3, 0x21, 0x94, 0xc2, 0x7f,
+	0x82, 0x90, 0xce, 0xd6, 0xbc, 0x0a, 0xb1, 0x69, 0xa8, 0x8b, 0x9b, 0x93, 0xe3, 0xd8, 0x62, 0xf0,
+	0xd5, 0x38, 0xbd, 0x84, 0x04, 0x10, 0xca, 0x20, 0x82, 0x85, 0x33, 0x51, 0x13, 0xe4, 0x2f, 0xb3,
+	0x1f, 0x07, 0x00, 0x9c, 0x46, 0x6c, 0x38, 0xd8, 0xa2, 0x4d, 0xe2, 0xc0, 0x06, 0x58, 0x71, 0x88,
+	0x6e, 0x76, 0x4d, 0x62, 0xb9, 0x5e, 0xd8, 0xdd, 0xcb, 0xc2, 0x2e, 0xe6, 0xed, 0xa5, 0x30, 0x75,
+	0x04, 0x1f, 0x81, 0x10, 0xaf, 0x2e, 0x4f, 0x22, 0xb6, 0x7f, 0x3d, 0x27, 0x08, 0x93, 0x63, 0x84,
+	0x99, 0xfa, 0xb1, 0xa7, 0xd9, 0x73, 0x6b, 0x78, 0x07, 0x04, 0x4c, 0x83, 0x37, 0x24, 0x58, 0xbc,
+	0x7a, 0x36, 0x4e, 0x07, 0xd4, 0xf2, 0xbb, 0x71, 0x1a, 0xf8, 0xc9, 0xaa, 0x65, 0x14, 0x30, 0x0d,
+	0x58, 0x04, 0x21, 0xea, 0x62, 0xd7, 0x6f, 0xc8, 0x5f, 0x2e, 0x49, 0xd7, 0x47, 0xd7, 0x19, 0x06,
+	0x09, 0x68, 0xb6, 0x0b, 0x62, 0xfe, 0x7e, 0x85, 0x10, 0x88, 0x41, 0x88, 0xd1, 0x97, 0xca, 0x52,
+	0x66, 0xf9, 0xfd, 0xf9, 0xee, 0xb2, 0x7c, 0xbf, 0xfc, 0x36, 0xbd, 0xdd, 0x32, 0xdd, 0x93, 0xde,
+	0x71, 0x4e, 0xb7, 0x3b, 0x79, 0x4f, 0x0d, 0xe2, 0xcf, 0x0e, 0x35, 0x4e, 0x3d, 0x8e, 0x33, 0x00,
+	0x45, 0xc2, 0x73, 0xf6, 0x27, 0x09, 0x44, 0x2a, 0x84, 0xa8, 0x56, 0xd3, 0x86, 0xb7, 0x67, 0xfb,
+	0x7c, 0x8e, 0xed, 0x5e, 0x2b, 0xaf, 0xce, 0xd6, 0x70, 0xc5, 0x2f, 0xd1, 0x53, 0x10, 0x6d, 0x12,
+	0xa2, 0x39, 0xec, 0xfe, 0xac, 0x50, 0xf1, 0xe2, 0x6d, 0x96, 0xd1, 0x37, 0xe3, 0xf4, 0x0d, 0x11,
+	0x9f, 0x1a, 0xa7, 0x39, 0xd3, 0xce, 0x77, 0xb0, 0x7b, 0x92, 0x3b, 0x20, 0x2d, 0xac, 0x0f, 0xca,
+	0x44, 0x47, 0x91, 0x26, 0x21, 0x08, 0xbb, 0x04, 0x3e, 0x06, 0x91, 0x8e, 0x69, 0x69, 0x4d, 0x22,
+	0xca, 0x17, 0x2f, 0xde, 0xf4, 0xe0, 0x9b, 0xe7, 0xe1, 0xaa, 0xe5, 0xa2, 0x70, 0xc7, 0xb4, 0x2a,
+	0x44, 0xe0, 0x70, 0x9f, 0xe3, 0x42, 0x1f, 0x86, 0xc3, 0xfd, 0x0a, 0x21, 0xd9, 0x4f, 0x25, 0x10,
+	0xe2, 0x5a, 0x62, 0xf7, 0x31, 0x88, 0x65, 0x77, 0xc4, 0xa5, 0x91, 0x58, 0xc0, 0x43, 0xb0, 0xc9,
+	0xf2, 0xc1, 0x1d, 0xbb, 0x67, 0x9d, 0x93, 0x74, 0xbc, 0x78, 0xe3, 0x37, 0x23, 0xc8, 0x12, 0xda,
+	0xe8, 0x98, 0x56, 0x81, 0x03, 0x67, 0x94, 0xfe, 0x27, 0x70, 0xc5, 0xa4, 0xda, 0xec, 0xa0, 0xf0,
+	0x14, 0xbe, 0x6a, 0xd2, 0xea, 0x74, 0x36, 0x64, 0x7f, 0x0c, 0x83, 0xb5, 0x67, 0xc4, 0x22, 0x0e,
+	0x6e, 0xbf, 0x20, 0x94, 0xe2, 0x16, 0xd3, 0x2a, 0xa3, 0x9f, 0xe8, 0x49, 0x58, 0xd0, 0x8f, 0x13,
+	0xae, 0x0a, 0xc2, 0x94, 0x58, 0x06, 0x71, 0xe4, 0xc0, 0xef, 0x12, 0x88, 0xe7, 0x65, 0x5e, 0x73,
+	0xcb, 0x7f, 0x94, 0xe6, 0x6e, 0x81, 0x78, 0x17, 0x0f, 0xda, 0x36, 0x36, 0xb4, 0x13, 0x4c, 0x4f,
+	0x44, 0x7b, 0x51, 0xcc, 0xdb, 0x7b, 0x8e, 0xe9, 0x09, 0x3c, 0x00, 0x61, 0x46, 0xff, 0x1e, 0xe5,
+	0x3d, 0x5c, 0xdb, 0xff, 0xeb, 0x25, 0x51, 0xe7, 0xeb, 0x93, 0xab, 0x73, 0x2c, 0xf2, 0x7c, 0xc0,
+	0xbc, 0x4f, 0xd0, 0xf0, 0x25, 0x22, 0xf7, 0xb9, 0xbb, 0x0b, 0xe2, 0xd4, 0xee, 0x39, 0x3a, 0xd1,
+	0xdc, 0xbe, 0x66, 0x1a, 0x72, 0x84, 0xb7, 0x78, 0xed, 0x6c, 0x9c, 0x06, 0x75, 0xbe, 0xdf, 0xe8,
+	0xab, 0x65, 0x04, 0xa8, 0xff, 0xcd, 0x9b, 0x39, 0x83, 0xb0, 0x0c, 0xd2, 0x97, 0xa3, 0x6c, 0x3a,
+	0xa0, 0xd5, 0x89, 0x11, 0xdb, 0x84, 0x77, 0xc1, 0x5a, 0x13, 0x9b, 0xed, 0x9e, 0x43, 0x34, 0x87,
+	0x60, 0x6a, 0x5b, 0xf2, 0x0a, 0x27, 0xd9, 0xaa, 0xb7, 0x8b, 0xf8, 0x26, 0xbc, 0x0d, 0xfc, 0x0d,
+	0x4d, 0x67, 0xb4, 0x91, 0x01, 0x77, 0x16, 0xf7, 0x36, 0x4b, 0x6c, 0x0f, 0xd6, 0xc0, 0xaa, 0x43,
+	0x9a, 0x3d, 0xcb, 0xd0, 0xba, 0x76, 0xdb, 0xd4, 0x07, 0x72, 0x8c, 0x5f, 0xef, 0xcf, 0x97, 0xd4,
+	0x0a, 0x71, 0x4c, 0x8d, 0x43, 0x50, 0xdc, 0x99, 0x59, 0xc1, 0x1b, 0x60, 0xa5, 0x85, 0xa9, 0xd6,
+	0x36, 0x3b, 0xa6, 0x2b, 0xc7, 0x79, 0xc8, 0x68, 0x0b, 0xd3, 0x03, 0xb6, 0xce, 0xbe, 0x95, 0x40,
+	0x58, 0x14, 0x16, 0xde, 0x03, 0xb0, 0xde, 0x28, 0x34, 0x8e, 0xea, 0xda, 0x51, 0xb5, 0x5e, 0x53,
+	0x4a, 0x6a, 0x45, 0x55, 0xca, 0x89, 0xa5, 0xe4, 0x95, 0xe1, 0x28, 0x13, 0xab, 0xda, 0x96, 0xd2,
+	0x37, 0xa9, 0x2b, 0x5a, 0x7d, 0xc5, 0x33, 0x2c, 0xd4, 0x6a, 0xe8, 0xf0, 0xdf, 0x4a, 0x39, 0x21,
+	0x25, 0xe3, 0xc3, 0x51, 0x26, 0x5a, 0xe8, 0x76, 0x1d, 0xfb, 0x25, 0x31, 0xe0, 0x5d, 0xb0, 0xee,
+	0x99, 0xd4, 0xd0, 0x61, 0x49, 0xa9, 0xd7, 0xd5, 0xea, 0xb3, 0x44, 0x20, 0xb9, 0x36, 0x1c, 0x65,
+	0x40, 0xcd, 0xb1, 0x75, 0x42, 0xa9, 0x69, 0xb5, 0x66, 0x3c, 0x29, 0xff, 0x55, 0x4a, 0x47, 0x0d,
+	0xa5, 0x9c, 0x58, 0x16, 0x9e, 0x94, 0x3e, 0xd1, 0x7b, 0x4c, 0x50, 0x37, 0xc1, 0xaa, 0x67, 0x52,
+	0x29, 0xa8, 0x07, 0x4a, 0x39, 0x11, 0x4c, 0x82, 0xe1, 0x28, 0x13, 0xae, 0x60, 0xb3, 0x4d, 0x8c,
+	0x19, 0x0f, 0x48, 0xa9, 0x1c, 0x55, 0xcb, 0x4a, 0x39, 0x11, 0x12, 0x1e, 0x44, 0x45, 0x88, 0x91,
+	0x8c, 0x7e, 0xf4, 0x59, 0x6a, 0xe9, 0x8b, 0xcf, 0x53, 0x52, 0x76, 0x0f, 0xc4, 0x67, 0xeb, 0xc4,
+	0x38, 0xcb, 0xa7, 0x8a, 0xa8, 0x3f, 0xe5, 0xda, 0x0b, 0xa2, 0x18, 0x9b, 0x1d, 0xde, 0x56, 0xf6,
+	0x87, 0x65, 0x10, 0xfb, 0x0f, 0xa6, 0x1d, 0x5f, 0xa4, 0x53, 0x12, 0xbd, 0x67, 0x84, 0xc6, 0x84,
+	0x09, 0xdf, 0x60, 0xe4, 0xf0, 0x10, 0xf3, 0x4f, 0xa3, 0xc7, 0x21, 0xff, 0xf1, 0x7d, 0x02, 0xd6,
+	0x0d, 0x42, 0x5d, 0x93, 0x8d, 0x0e, 0xdb, 0xf2, 0xbc, 0x2f, 0x5f, 0xe4, 0x3d, 0x31, 0x63, 0x27,
+	0x42, 0xe4, 0xc1, 0xc6, 0x2c, 0xd6, 0x8f, 0x13, 0xe4, 0x71, 0xe0, 0xcc, 0x91, 0x1f, 0x6c, 0x77,
+	0x41, 0xac, 0x62, 0xa6, 0xf2, 0x38, 0xec, 0xb2, 0xc5, 0x81, 0x4b, 0xe8, 0xbc, 0x76, 0xff, 0xbe,
+	0x20, 0x9e, 0xb0, 0x98, 0x8f, 0xf3, 0xe2, 0x99, 0xc7, 0xcf, 0x2a, 0xe9, 0x6f, 0xe7, 0x95, 0x14,
+	0xe1, 0xef, 0xec, 0xc6, 0xdb, 0x71, 0x7a, 0xf1, 0x68, 0x51, 0x5e, 0xea, 0x64, 0x00, 0x46, 0x79,
+	0xd4, 0xbd, 0x77, 0xe3, 0xf4, 0xce, 0x07, 0x3c, 0x80, 0x05, 0x5d, 0xf7, 0x2e, 0x3c, 0x99, 0x7d,
+	0x62, 0xc6, 0xae, 0x2c, 0xce, 0xd8, 0x07, 0xbf, 0x48, 0x60, 0x75, 0xee, 0xa5, 0x86, 0x29, 0x90,
+	0x6c, 0xa0, 0x42, 0xb5, 0x5e, 0x51, 0x90, 0xc6, 0x18, 0xa6, 0xcc, 0xab, 0x02, 0xde, 0x03, 0xd7,
+	0x16, 0xce, 0x6b, 0x4a, 0xb5, 0xcc, 0x68, 0x2e, 0x25, 0x63, 0xc3, 0x51, 0x26, 0x52, 0x23, 0x96,
+	0xc1, 0x38, 0x7e, 0x1f, 0x6c, 0x2d, 0x18, 0x16, 0x50, 0xe9, 0xb9, 0xca, 0x54, 0x13, 0xf0, 0x54,
+	0xe3, 0xe8, 0x27, 0x26, 0x53, 0xcd, 0x53, 0x90, 0x5d, 0x30, 0x55, 0xab, 0xf5, 0xa3, 0x4a, 0x45,
+	0x2d, 0xa9, 0x4a, 0xb5, 0xa1, 0x15, 0x5e, 0x1c, 0x1e, 0x55, 0x1b, 0x89, 0xe5, 0xe4, 0xb5, 0xe1,
+	0x28, 0x03, 0x55, 0x8b, 0xf6, 0x9a, 0x4d, 0x53, 0x67, 0xd3, 0x57, 0x3c, 0x43, 0x70, 0x07, 0x6c,
+	0x2e, 0xe0, 0x27, 0x9a, 0x81, 0xc3, 0x51, 0x66, 0x6d, 0xf2, 0x3f, 0x07, 0xd7, 0xce, 0x54, 0x18,
+	0x0f, 0xbe, 0x92, 0xc0, 0xba, 0x7f, 0x58, 0x36, 0x1d, 0xa2, 0x33, 0xb2, 0xc0, 0x87, 0x20, 0x35,
+	0x71, 0x57, 0x56, 0x91, 0x52, 0x6a, 0xa8, 0x87, 0xd5, 0x8b, 0x86, 0xc3, 0x91, 0x45, 0xbb, 0x44,
+	0x37, 0x9b, 0xa6, 0xf8, 0x57, 0xf7, 0x02, 0x50, 0x05, 0x1d, 0xbe, 0x48, 0x48, 0xc9, 0xeb, 0xc3,
+	0x51, 0x66, 0xf3, 0x5c, 0xa0, 0x8a, 0x63, 0x77, 0xe0, 0x3e, 0xd8, 0xbc, 0x00, 0xd7, 0x38, 0x4c,
+	0x04, 0x92, 0x5b, 0xc3, 0x51, 0x66, 0xe3, 0x1c, 0xaa, 0x61, 0x27, 0x83, 0xec, 0x02, 0xc5, 0xfa,
+	0xab, 0xef, 0x53, 0x4b, 0xaf, 0xce, 0x52, 0xd2, 0xeb, 0xb3, 0x94, 0xf4, 0xdd, 0x59, 0x4a, 0xfa,
+	0xe4, 0x4d, 0x6a, 0xe9, 0xf5, 0x9b, 0xd4, 0xd2, 0xd7, 0x6f, 0x52, 0x4b, 0xff, 0x7b, 0x34, 0x43,
+	0x14, 0x31, 0x42, 0x2d, 0xe2, 0xfe, 0xdf, 0x76, 0x4e, 0xbd, 0xd5, 0x8e, 0x6e, 0x3b, 0x24, 0xdf,
+	0x5f, 0xf8, 0xb1, 0x72, 0x1c, 0xe6, 0xbf, 0x10, 0x1e, 0xfe, 0x3a, 0x00, 0x14, 0x1f, 0xc2, 0x12,
+	0xcc, 0x0c, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -896,6 +958,35 @@ func (m *GeneralMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RefundPolicy != nil {
+		{
+			size, err := m.RefundPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.SourceTxIndex != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SourceTxIndex))
 		i--
@@ -962,6 +1053,34 @@ func (m *GeneralMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RefundPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WasmMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1208,6 +1327,32 @@ func (m *GeneralMessage) Size() (n int) {
 	if m.SourceTxIndex != 0 {
 		n += 1 + sovTypes(uint64(m.SourceTxIndex))
 	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovTypes(uint64(m.FailureCount))
+	}
+	if m.RefundPolicy != nil {
+		l = m.RefundPolicy.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RefundPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxFailures != 0 {
+		n += 1 + sovTypes(uint64(m.MaxFailures))
+	}
 	return n
 }
 
@@ -2418,6 +2563,181 @@ func (m *GeneralMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefundPolicy == nil {
+				m.RefundPolicy = &RefundPolicy{}
+			}
+			if err := m.RefundPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
+			}
+			m.MaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -123,6 +123,8 @@ func (k Keeper) DeactivateChain(ctx sdk.Context, chain exported.Chain) {
 	chainState.Activated = false
 
 	k.setChainState(ctx, chainState)
+	// a reactivated chain should not be deactivated again because of polls that failed before
+	k.deleteChainPollState(ctx, chain.Name)
 }
 
 const wasmIsActivated = 1
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func getChainPollStateKey(chain exported.ChainName) key.Key {
+	return chainPollStatePrefix.Append(key.From(chain))
+}
+
+// RecordChainPoll records the outcome of a poll for the given chain
+func (k Keeper) RecordChainPoll(ctx sdk.Context, chain exported.ChainName, failed bool) {
+	pollState, ok := k.getChainPollState(ctx, chain)
+	if !ok {
+		pollState = types.NewChainPollState(chain)
+	}
+
+	pollState.FailedPolls.Add(failed)
+	pollState.PollCount++
+	k.setChainPollState(ctx, pollState)
+}
+
+// CountFailedChainPolls returns the number of failed polls among the latest window polls of the given chain,
+// together with the number of polls observed in that window since the chain was last (re)activated
+func (k Keeper) CountFailedChainPolls(ctx sdk.Context, chain exported.ChainName, window int) (failed uint64, observed uint64) {
+	pollState, ok := k.getChainPollState(ctx, chain)
+	if !ok {
+		return 0, 0
+	}
+
+	observed = min(pollState.PollCount, uint64(window))
+	return pollState.FailedPolls.CountTrue(int(observed)), observed
+}
+
+func (k Keeper) getChainPollState(ctx sdk.Context, chain exported.ChainName) (pollState types.ChainPollState, ok bool) {
+	return pollState, k.getStore(ctx).GetNew(getChainPollStateKey(chain), &pollState)
+}
+
+func (k Keeper) setChainPollState(ctx sdk.Context, pollState types.ChainPollState) {
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getChainPollStateKey(pollState.Chain), &pollState))
+}
+
+func (k Keeper) deleteChainPollState(ctx sdk.Context, chain exported.ChainName) {
+	k.getStore(ctx).DeleteNew(getChainPollStateKey(chain))
+}
+
+func (k Keeper) getChainPollStates(ctx sdk.Context) (pollStates []types.ChainPollState) {
+	iter := k.getStore(ctx).IteratorNew(chainPollStatePrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var pollState types.ChainPollState
+		iter.UnmarshalValue(&pollState)
+
+		pollStates = append(pollStates, pollState)
+	}
+
+	return pollStates
+}
@@ -42,3 +42,43 @@ func TestGetChainMaintainers(t *testing.T) {
 	assert.Equal(t, want, got)
 	assert.ElementsMatch(t, addresses, got)
 }
+
+func TestRecordChainPoll(t *testing.T) {
+	ctx, k := setup(t)
+
+	chain := exported.Chain{Name: exported.ChainName("ethereum")}
+	k.SetChain(ctx, chain)
+	k.ActivateChain(ctx, chain)
+
+	failed, observed := k.CountFailedChainPolls(ctx, chain.Name, 10)
+	assert.Zero(t, failed)
+	assert.Zero(t, observed)
+
+	for i := 0; i < 20; i++ {
+		k.RecordChainPoll(ctx, chain.Name, i%4 == 0)
+	}
+
+	failed, observed = k.CountFailedChainPolls(ctx, chain.Name, 20)
+	assert.EqualValues(t, 5, failed)
+	assert.EqualValues(t, 20, observed)
+	failed, observed = k.CountFailedChainPolls(ctx, chain.Name, 10)
+	assert.EqualValues(t, 3, failed)
+	assert.EqualValues(t, 10, observed)
+	failed, observed = k.CountFailedChainPolls(ctx, chain.Name, 50)
+	assert.EqualValues(t, 5, failed)
+	assert.EqualValues(t, 20, observed)
+	failed, observed = k.CountFailedChainPolls(ctx, exported.ChainName("avalanche"), 20)
+	assert.Zero(t, failed)
+	assert.Zero(t, observed)
+
+	k.DeactivateChain(ctx, chain)
+	k.ActivateChain(ctx, chain)
+	failed, observed = k.CountFailedChainPolls(ctx, chain.Name, 20)
+	assert.Zero(t, failed)
+	assert.Zero(t, observed)
+
+	k.RecordChainPoll(ctx, chain.Name, true)
+	failed, observed = k.CountFailedChainPolls(ctx, chain.Name, 20)
+	assert.EqualValues(t, 1, failed)
+	assert.EqualValues(t, 1, observed)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
@@ -18,7 +19,19 @@ import (
 	"github.com/axelarnetwork/utils/slices"
 )
 
-const routeMessageQueue = "route_message_queue"
+const (
+	routeMessageQueue = "route_message_queue"
+	// maxFailureReasonLength bounds the failure reasons recorded in state and events
+	maxFailureReasonLength = 256
+)
+
+func truncateReason(reason string) string {
+	if len(reason) <= maxFailureReasonLength {
+		return reason
+	}
+
+	return strings.ToValidUTF8(reason[:maxFailureReasonLength], "")
+}
 
 func getMessageKey(id string) key.Key {
 	return generalMessagePrefix.Append(key.FromStr(id))
@@ -60,8 +73,8 @@ func (k Keeper) SetMessageExecuted(ctx sdk.Context, id string) error {
 	return k.setMessage(ctx, m)
 }
 
-// SetMessageFailed sets the general message as failed
-func (k Keeper) SetMessageFailed(ctx sdk.Context, id string) error {
+// SetMessageFailed sets the general message as failed and records the reason of the failure
+func (k Keeper) SetMessageFailed(ctx sdk.Context, id string, reason string) error {
 	m, found := k.GetMessage(ctx, id)
 	if !found {
 		return fmt.Errorf("general message %s not found", id)
@@ -73,24 +86,93 @@ func (k Keeper) SetMessageFailed(ctx sdk.Context, id string) error {
 
 	k.deleteProcessingMessageID(ctx, m)
 
+	reason = truncateReason(reason)
+
 	m.Status = exported.Failed
+	m.FailureReason = reason
+	m.FailureCount++
 
 	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MessageFailed{
 		ID:               m.ID,
 		SourceChain:      m.GetSourceChain(),
 		DestinationChain: m.GetDestinationChain(),
+		Reason:           reason,
 	}))
 
 	return k.setMessage(ctx, m)
 }
 
+// RefundMessage returns the asset of a failed general message to its sender on the source chain if the message's refund policy is met.
+// Returns true if the refund was enqueued. A refund that cannot be enqueued leaves the message failed, so it can still be retried
+func (k Keeper) RefundMessage(ctx sdk.Context, id string) (bool, error) {
+	m, found := k.GetMessage(ctx, id)
+	if !found {
+		return false, fmt.Errorf("general message %s not found", id)
+	}
+
+	if !m.Is(exported.Failed) {
+		return false, fmt.Errorf("general message %s is not failed", id)
+	}
+
+	if m.Asset == nil || !m.IsRefundDue(k.GetParams(ctx).MessageRefundMaxFailures) {
+		return false, nil
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	transferID, err := k.EnqueueTransfer(cachedCtx, m.Recipient.Chain, m.Sender, *m.Asset)
+	if err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("failed to refund message %s: %s", m.ID, err.Error()), types.AttributeKeyMessageID, m.ID)
+
+		funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MessageRefundFailed{
+			ID:               m.ID,
+			SourceChain:      m.GetSourceChain(),
+			DestinationChain: m.GetDestinationChain(),
+			Reason:           truncateReason(err.Error()),
+		}))
+
+		return false, nil
+	}
+
+	writeCache()
+
+	k.Logger(ctx).Info(fmt.Sprintf("refunded message %s with transfer %s", m.ID, transferID.String()), types.AttributeKeyMessageID, m.ID)
+
+	m.Status = exported.Refunded
+
+	funcs.MustNoErr(ctx.EventManager().EmitTypedEvent(&types.MessageRefunded{
+		ID:               m.ID,
+		SourceChain:      m.GetSourceChain(),
+		DestinationChain: m.GetDestinationChain(),
+		TransferID:       transferID,
+		Asset:            *m.Asset,
+	}))
+
+	return true, k.setMessage(ctx, m)
+}
+
 // GetMessage returns the general message by ID
 func (k Keeper) GetMessage(ctx sdk.Context, id string) (m exported.GeneralMessage, found bool) {
 	return m, k.getStore(ctx).GetNew(getMessageKey(id), &m)
 }
 
 func (k Keeper) setMessage(ctx sdk.Context, m exported.GeneralMessage) error {
-	return k.getStore(ctx).SetNewValidated(getMessageKey(m.ID), &m)
+	prev, ok := k.GetMessage(ctx, m.ID)
+	if ok {
+		k.deleteMessageStatusIndex(ctx, prev)
+	}
+
+	if err := k.getStore(ctx).SetNewValidated(getMessageKey(m.ID), &m); err != nil {
+		return err
+	}
+
+	// only the status of a stored message changes, so its other indexes are written once
+	if ok {
+		k.setMessageStatusIndex(ctx, m)
+	} else {
+		k.setMessageIndexes(ctx, m)
+	}
+
+	return nil
 }
 
 func (k Keeper) setProcessingMessageID(ctx sdk.Context, m exported.GeneralMessage) error {
@@ -107,7 +189,6 @@ func (k Keeper) deleteProcessingMessageID(ctx sdk.Context, m exported.GeneralMes
 	k.getStore(ctx).DeleteNew(getProcessingMessageKey(m.GetDestinationChain(), m.ID))
 }
 
-//nolint:unused // TODO: add genesis import/export
 func (k Keeper) getMessages(ctx sdk.Context) (generalMessages []exported.GeneralMessage) {
 	iter := k.getStore(ctx).IteratorNew(generalMessagePrefix)
 	defer utils.CloseLogError(iter, k.Logger(ctx))
@@ -269,7 +350,8 @@ func (k Keeper) DequeueRouteMessage(ctx sdk.Context) (msg exported.GeneralMessag
 }
 
 // RouteMessage routes the given general message to the corresponding module and
-// set the message status to processing
+// set the message status to processing. Messages carrying an asset that would exceed
+// a transfer rate limit are set to failed instead
 func (k Keeper) RouteMessage(ctx sdk.Context, id string, routingCtx ...exported.RoutingContext) error {
 	err := k.setMessageProcessing(ctx, id)
 	if err != nil {
@@ -283,6 +365,16 @@ func (k Keeper) RouteMessage(ctx sdk.Context, id string, routingCtx ...exported.
 	}
 
 	msg := funcs.MustOk(k.GetMessage(ctx, id))
+
+	// messages that exceed a rate limit are failed instead of reverted, so they can be retried once the limit allows it
+	if msg.Asset != nil {
+		if err := k.rateLimitTransfer(ctx, msg.GetSourceChain(), msg.GetDestinationChain(), *msg.Asset, msg.ID); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("failed to route message %s: %s", id, err.Error()), types.AttributeKeyMessageID, id)
+
+			return k.SetMessageFailed(ctx, id, err.Error())
+		}
+	}
+
 	if err := k.getMessageRouter().Route(ctx, routingCtx[0], msg); err != nil {
 		return errorsmod.Wrapf(err, "failed to route message %s to the %s module", id, msg.Recipient.Chain.Module)
 	}
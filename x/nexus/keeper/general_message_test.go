@@ -5,7 +5,9 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/CosmWasm/wasmd/x/wasm"
@@ -474,7 +476,7 @@ func TestStatusTransitions(t *testing.T) {
 	}))
 
 	// Message doesn't exist, can't set any status
-	err := k.SetMessageFailed(ctx, msg.ID)
+	err := k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10))
 	assert.Error(t, err, fmt.Sprintf("general message %s not found", msg.ID))
 
 	err = k.RouteMessage(ctx, msg.ID)
@@ -487,7 +489,7 @@ func TestStatusTransitions(t *testing.T) {
 	err = k.SetNewMessage(ctx, msg)
 	assert.NoError(t, err)
 
-	err = k.SetMessageFailed(ctx, msg.ID)
+	err = k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10))
 	assert.Error(t, err, "general message is not processed")
 
 	err = k.SetMessageExecuted(ctx, msg.ID)
@@ -499,7 +501,7 @@ func TestStatusTransitions(t *testing.T) {
 	err = k.RouteMessage(ctx, msg.ID)
 	assert.Error(t, err, "general message is not approved or failed")
 
-	err = k.SetMessageFailed(ctx, msg.ID)
+	err = k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10))
 	assert.NoError(t, err)
 
 	err = k.SetMessageExecuted(ctx, msg.ID)
@@ -511,7 +513,7 @@ func TestStatusTransitions(t *testing.T) {
 	err = k.SetMessageExecuted(ctx, msg.ID)
 	assert.NoError(t, err)
 
-	err = k.SetMessageFailed(ctx, msg.ID)
+	err = k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10))
 	assert.Error(t, err, "general message is not processed")
 
 	err = k.RouteMessage(ctx, msg.ID)
@@ -597,7 +599,7 @@ func TestGetSentMessages(t *testing.T) {
 				assert.NoError(t, k.SetMessageExecuted(ctx, msg.ID))
 			case exported.Failed:
 				assert.NoError(t, k.RouteMessage(ctx, msg.ID))
-				assert.NoError(t, k.SetMessageFailed(ctx, msg.ID))
+				assert.NoError(t, k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10)))
 			default:
 			}
 		}
@@ -662,7 +664,7 @@ func TestGetSentMessages(t *testing.T) {
 	enqueueMsgs(msgs)
 	sent = k.GetProcessingMessages(ctx, destinationChainName, 1)
 	assert.Equal(t, len(msgs), len(sent))
-	err := k.SetMessageFailed(ctx, sent[0].ID)
+	err := k.SetMessageFailed(ctx, sent[0].ID, rand.NormalizedStr(10))
 	assert.NoError(t, err)
 	msg := msgs[sent[0].ID]
 	msg.Status = exported.Failed
@@ -723,3 +725,138 @@ func TestGetSentMessages(t *testing.T) {
 	assert.Equal(t, dest3Msgs, toMap(consumeSent(chain3.Name, 100)))
 	assert.Equal(t, dest4Msgs, toMap(consumeSent(chain4.Name, 100)))
 }
+
+func TestRefundMessage(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		keeper nexus.Keeper
+		msg    exported.GeneralMessage
+	)
+
+	cfg := app.MakeEncodingConfig()
+	asset := sdk.NewCoin("external-erc-20", math.NewInt(10*maxAmount))
+
+	givenKeeper := Given("the keeper", func() {
+		keeper, ctx = setup(cfg, t)
+		keeper.SetMessageRouter(types.NewMessageRouter().AddRoute(terra.Module, func(sdk.Context, exported.RoutingContext, exported.GeneralMessage) error { return nil }))
+	})
+
+	messageFailed := func(policy *exported.RefundPolicy) func() {
+		return func() {
+			msg = randMsg(exported.Approved)
+			msg.Sender = exported.CrossChainAddress{Chain: evm.Ethereum, Address: evmtestutils.RandomAddress().Hex()}
+			msg.Recipient = exported.CrossChainAddress{Chain: terra, Address: genCosmosAddr(terra.Name.String())}
+			msg.Asset = &asset
+			msg.RefundPolicy = policy
+
+			funcs.MustNoErr(keeper.SetNewMessage(ctx, msg))
+			funcs.MustNoErr(keeper.RouteMessage(ctx, msg.ID))
+			funcs.MustNoErr(keeper.SetMessageFailed(ctx, msg.ID, "execution reverted"))
+		}
+	}
+
+	givenFailedMessage := givenKeeper.When("a message with asset failed on a cosmos chain", messageFailed(nil))
+
+	givenFailedMessage.
+		When("refunds are disabled", func() {}).
+		Then("should record the failure and not refund", func(t *testing.T) {
+			refunded, err := keeper.RefundMessage(ctx, msg.ID)
+			assert.NoError(t, err)
+			assert.False(t, refunded)
+
+			actual := funcs.MustOk(keeper.GetMessage(ctx, msg.ID))
+			assert.Equal(t, exported.Failed, actual.Status)
+			assert.Equal(t, "execution reverted", actual.FailureReason)
+			assert.EqualValues(t, 1, actual.FailureCount)
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("a message failed with an overly long reason", func() {
+			msg = randMsg(exported.Approved)
+			msg.Recipient = exported.CrossChainAddress{Chain: terra, Address: genCosmosAddr(terra.Name.String())}
+			msg.Asset = &asset
+
+			funcs.MustNoErr(keeper.SetNewMessage(ctx, msg))
+			funcs.MustNoErr(keeper.RouteMessage(ctx, msg.ID))
+			funcs.MustNoErr(keeper.SetMessageFailed(ctx, msg.ID, strings.Repeat("a", 1000)))
+		}).
+		Then("should record a truncated reason", func(t *testing.T) {
+			actual := funcs.MustOk(keeper.GetMessage(ctx, msg.ID))
+			assert.Equal(t, strings.Repeat("a", 256), actual.FailureReason)
+		}).
+		Run(t)
+
+	givenFailedMessage.
+		When("refunds are enabled after two failures", func() {
+			params := keeper.GetParams(ctx)
+			params.MessageRefundMaxFailures = 2
+			keeper.SetParams(ctx, params)
+		}).
+		Branch(
+			Then("should not refund after the first failure", func(t *testing.T) {
+				refunded, err := keeper.RefundMessage(ctx, msg.ID)
+				assert.NoError(t, err)
+				assert.False(t, refunded)
+			}),
+
+			When("the retried message fails again", func() {
+				funcs.MustNoErr(keeper.RouteMessage(ctx, msg.ID))
+				funcs.MustNoErr(keeper.SetMessageFailed(ctx, msg.ID, "out of gas"))
+			}).
+				Then("should refund the asset to the sender", func(t *testing.T) {
+					refunded, err := keeper.RefundMessage(ctx, msg.ID)
+					assert.NoError(t, err)
+					assert.True(t, refunded)
+
+					actual := funcs.MustOk(keeper.GetMessage(ctx, msg.ID))
+					assert.Equal(t, exported.Refunded, actual.Status)
+					assert.Equal(t, "out of gas", actual.FailureReason)
+					assert.EqualValues(t, 2, actual.FailureCount)
+
+					transfers := keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending)
+					assert.Len(t, transfers, 1)
+					assert.Equal(t, msg.Sender.Address, transfers[0].Recipient.Address)
+
+					assert.ErrorContains(t, keeper.RouteMessage(ctx, msg.ID), "approved or failed")
+				}),
+		).
+		Run(t)
+
+	givenKeeper.
+		When("a message with asset whose sender opted into immediate refunds failed on a cosmos chain", messageFailed(&exported.RefundPolicy{MaxFailures: 1})).
+		Branch(
+			Then("should refund the asset to the sender", func(t *testing.T) {
+				refunded, err := keeper.RefundMessage(ctx, msg.ID)
+				assert.NoError(t, err)
+				assert.True(t, refunded)
+				assert.Equal(t, exported.Refunded, funcs.MustOk(keeper.GetMessage(ctx, msg.ID)).Status)
+			}),
+
+			When("the refund exceeds a rate limit", func() {
+				funcs.MustNoErr(keeper.SetRateLimit(ctx, terra.Name, sdk.NewCoin(asset.Denom, math.OneInt()), time.Hour, exported.TransferDirectionTo))
+			}).
+				Then("should keep the message failed", func(t *testing.T) {
+					refunded, err := keeper.RefundMessage(ctx, msg.ID)
+					assert.NoError(t, err)
+					assert.False(t, refunded)
+					assert.Equal(t, exported.Failed, funcs.MustOk(keeper.GetMessage(ctx, msg.ID)).Status)
+					assert.Empty(t, keeper.GetTransfersForChain(ctx, evm.Ethereum, exported.Pending))
+				}),
+		).
+		Run(t)
+
+	givenKeeper.
+		When("the message is not failed", func() {
+			msg = randMsg(exported.Approved, true)
+			funcs.MustNoErr(keeper.SetNewMessage(ctx, msg))
+		}).
+		Then("should return error", func(t *testing.T) {
+			_, err := keeper.RefundMessage(ctx, msg.ID)
+			assert.ErrorContains(t, err, "not failed")
+
+			_, err = keeper.RefundMessage(ctx, rand.NormalizedStr(10))
+			assert.ErrorContains(t, err, "not found")
+		}).
+		Run(t)
+}
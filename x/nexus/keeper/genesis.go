@@ -77,6 +77,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	}
 
 	utils.NewCounter[uint64](messageNonceKey, k.getStore(ctx)).Set(ctx, genState.MessageNonce)
+
+	for _, rateLimit := range genState.RateLimits {
+		if _, found := k.getRateLimit(ctx, rateLimit.Chain, rateLimit.Limit.Denom, rateLimit.Direction); found {
+			panic(fmt.Errorf("rate limit for chain %s, asset %s and direction %s already set", rateLimit.Chain, rateLimit.Limit.Denom, rateLimit.Direction))
+		}
+
+		funcs.MustNoErr(k.setRateLimit(ctx, rateLimit))
+	}
+
+	for _, transferEpoch := range genState.TransferEpochs {
+		funcs.MustNoErr(k.setTransferEpoch(ctx, transferEpoch))
+	}
+
+	for _, chainPollState := range genState.ChainPollStates {
+		if _, found := k.getChainPollState(ctx, chainPollState.Chain); found {
+			panic(fmt.Errorf("poll state for chain %s already set", chainPollState.Chain))
+		}
+
+		k.setChainPollState(ctx, chainPollState)
+	}
 }
 
 // ExportGenesis returns the nexus module's genesis state.
@@ -92,5 +112,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.getFeeInfos(ctx),
 		k.getMessages(ctx),
 		utils.NewCounter[uint64](messageNonceKey, k.getStore(ctx)).Curr(ctx),
+		k.getRateLimits(ctx),
+		k.getTransferEpochs(ctx),
+		k.getChainPollStates(ctx),
 	)
 }
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	store "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -97,6 +99,9 @@ func assertChainStatesEqual(t *testing.T, expected, actual *types.GenesisState)
 	assert.ElementsMatch(t, expected.FeeInfos, actual.FeeInfos)
 	assert.ElementsMatch(t, expected.Messages, actual.Messages)
 	assert.Equal(t, expected.MessageNonce, actual.MessageNonce)
+	assert.ElementsMatch(t, expected.RateLimits, actual.RateLimits)
+	assert.ElementsMatch(t, expected.TransferEpochs, actual.TransferEpochs)
+	assert.ElementsMatch(t, expected.ChainPollStates, actual.ChainPollStates)
 }
 
 func TestExportGenesisInitGenesis(t *testing.T) {
@@ -121,6 +126,23 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 		keeper.ActivateChain(ctx, chain)
 	}
 
+	ctx = ctx.WithBlockTime(time.Now())
+	rateLimit := types.RateLimit{Chain: evm.Ethereum.Name, Limit: sdk.NewCoin(axelarnet.NativeAsset, math.NewIntWithDecimal(1, 30)), Window: time.Hour, Direction: exported.TransferDirectionFrom}
+	funcs.MustNoErr(keeper.SetRateLimit(ctx, rateLimit.Chain, rateLimit.Limit, rateLimit.Window, rateLimit.Direction))
+	expected.RateLimits = []types.RateLimit{rateLimit}
+	incoming := types.TransferEpoch{
+		Chain:     evm.Ethereum.Name,
+		Amount:    sdk.NewCoin(axelarnet.NativeAsset, math.ZeroInt()),
+		Epoch:     uint64(ctx.BlockTime().UnixNano() / time.Hour.Nanoseconds()),
+		Direction: exported.TransferDirectionTo,
+	}
+
+	keeper.RecordChainPoll(ctx, evm.Ethereum.Name, true)
+	keeper.RecordChainPoll(ctx, evm.Ethereum.Name, false)
+	pollState := types.NewChainPollState(evm.Ethereum.Name)
+	pollState.FailedPolls.Add(true).Add(false)
+	expected.ChainPollStates = []types.ChainPollState{pollState}
+
 	linkedAddressesCount := rand.I64Between(100, 200)
 	expectedLinkedAddresses := make([]types.LinkedAddresses, linkedAddressesCount)
 	for i := 0; i < int(linkedAddressesCount); i++ {
@@ -154,6 +176,7 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 		if err != nil {
 			panic(err)
 		}
+		incoming.Amount = incoming.Amount.Add(asset)
 
 		if asset.Amount.LTE(fees.Amount) {
 			expectedTransfer := exported.NewCrossChainTransfer(uint64(i), recipientAddress, asset, exported.InsufficientAmount)
@@ -172,6 +195,8 @@ func TestExportGenesisInitGenesis(t *testing.T) {
 		expected.Fee.Coins = expected.Fee.Coins.Add(fees)
 	}
 
+	expected.TransferEpochs = []types.TransferEpoch{incoming}
+
 	expected.ChainStates = []types.ChainState{
 		{
 			Chain:     axelarnet.Axelarnet,
@@ -228,3 +228,58 @@ func (q Querier) Message(c context.Context, req *types.MessageRequest) (*types.M
 		Message: msg,
 	}, nil
 }
+
+// Messages returns the general messages matching the given filters
+func (q Querier) Messages(c context.Context, req *types.MessagesRequest) (*types.MessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := nexus.GeneralMessage_Status_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message status %d", req.Status)
+	}
+
+	filter := MessageFilter{
+		Status:           req.Status,
+		SourceChain:      req.SourceChain,
+		DestinationChain: req.DestinationChain,
+		Sender:           req.Sender,
+	}
+
+	msgs, pagination, err := q.keeper.GetMessagesPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MessagesResponse{Messages: msgs, Pagination: pagination}, nil
+}
+
+// TransferRateLimit returns the transfer rate limits of an asset on a chain, and the amounts transferred in each limited direction within the current window.
+// The response is empty if the asset is not rate limited on the chain.
+func (q Querier) TransferRateLimit(c context.Context, req *types.TransferRateLimitRequest) (*types.TransferRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, ok := q.keeper.GetChain(ctx, nexus.ChainName(req.Chain))
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNexus, "%s is not a registered chain", req.Chain)
+	}
+
+	if !q.keeper.IsAssetRegistered(ctx, chain, req.Asset) {
+		return nil, errorsmod.Wrapf(types.ErrNexus, "%s is not a registered asset on chain %s", req.Asset, chain.Name)
+	}
+
+	var rateLimits []types.TransferRateLimit
+	for _, direction := range []nexus.TransferDirection{nexus.TransferDirectionFrom, nexus.TransferDirectionTo} {
+		rateLimit, usage, ok := q.keeper.GetTransferRateLimit(ctx, chain.Name, req.Asset, direction)
+		if !ok {
+			continue
+		}
+
+		rateLimits = append(rateLimits, types.TransferRateLimit{
+			Direction: direction,
+			Limit:     rateLimit.Limit.Amount,
+			Window:    rateLimit.Window,
+			Usage:     usage,
+		})
+	}
+
+	return &types.TransferRateLimitResponse{TransferRateLimits: rateLimits}, nil
+}
@@ -34,6 +34,13 @@ var (
 	messageNonceKey            = key.RegisterStaticKey(types.ModuleName, 6)
 	wasmActivation             = key.RegisterStaticKey(types.ModuleName, 7)
 	_                          = key.RegisterStaticKey(types.ModuleName, 8) // retired
+	rateLimitPrefix            = key.RegisterStaticKey(types.ModuleName, 9)
+	transferEpochPrefix        = key.RegisterStaticKey(types.ModuleName, 10)
+	chainPollStatePrefix       = key.RegisterStaticKey(types.ModuleName, 11)
+	messageByStatusPrefix      = key.RegisterStaticKey(types.ModuleName, 12)
+	messageBySourcePrefix      = key.RegisterStaticKey(types.ModuleName, 13)
+	messageByDestinationPrefix = key.RegisterStaticKey(types.ModuleName, 14)
+	messageBySenderPrefix      = key.RegisterStaticKey(types.ModuleName, 15)
 
 	// temporary
 	// TODO: add description about what temporary means
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+// MessageFilter selects general messages by their properties. Empty fields match any message.
+type MessageFilter struct {
+	Status           exported.GeneralMessage_Status
+	SourceChain      exported.ChainName
+	DestinationChain exported.ChainName
+	Sender           string
+}
+
+// Matches returns true if the given message satisfies all the filter's constraints
+func (f MessageFilter) Matches(m exported.GeneralMessage) bool {
+	return (f.Status == exported.NonExistent || m.Is(f.Status)) &&
+		(f.SourceChain == "" || m.GetSourceChain().Equals(f.SourceChain)) &&
+		(f.DestinationChain == "" || m.GetDestinationChain().Equals(f.DestinationChain)) &&
+		(f.Sender == "" || strings.EqualFold(m.GetSourceAddress(), f.Sender))
+}
+
+// indexPrefix returns the most selective index for the filter, or false if the filter is empty
+func (f MessageFilter) indexPrefix() (key.Key, bool) {
+	switch {
+	case f.Sender != "":
+		return messageBySenderPrefix.Append(toIndexKey(f.Sender)), true
+	case f.SourceChain != "":
+		return messageBySourcePrefix.Append(toIndexKey(f.SourceChain.String())), true
+	case f.DestinationChain != "":
+		return messageByDestinationPrefix.Append(toIndexKey(f.DestinationChain.String())), true
+	case f.Status != exported.NonExistent:
+		return messageByStatusPrefix.Append(key.FromUInt(uint64(f.Status))), true
+	default:
+		return nil, false
+	}
+}
+
+func getMessageByStatusKey(m exported.GeneralMessage) key.Key {
+	return messageByStatusPrefix.Append(key.FromUInt(uint64(m.Status))).Append(key.FromStr(m.ID))
+}
+
+func getMessageIndexKeys(m exported.GeneralMessage) []key.Key {
+	return []key.Key{
+		getMessageByStatusKey(m),
+		messageBySourcePrefix.Append(toIndexKey(m.GetSourceChain().String())).Append(key.FromStr(m.ID)),
+		messageByDestinationPrefix.Append(toIndexKey(m.GetDestinationChain().String())).Append(key.FromStr(m.ID)),
+		messageBySenderPrefix.Append(toIndexKey(m.GetSourceAddress())).Append(key.FromStr(m.ID)),
+	}
+}
+
+// toIndexKey lowercases chain names and sender addresses explicitly, so the index stays consistent with the case-insensitive Matches
+func toIndexKey(value string) key.Key {
+	return key.FromStr(strings.ToLower(value))
+}
+
+// setMessageIndexes indexes the given message by status, source chain, destination chain and sender
+func (k Keeper) setMessageIndexes(ctx sdk.Context, m exported.GeneralMessage) {
+	for _, indexKey := range getMessageIndexKeys(m) {
+		k.getStore(ctx).SetRawNew(indexKey, []byte(m.ID))
+	}
+}
+
+// setMessageStatusIndex indexes the given message by its current status
+func (k Keeper) setMessageStatusIndex(ctx sdk.Context, m exported.GeneralMessage) {
+	k.getStore(ctx).SetRawNew(getMessageByStatusKey(m), []byte(m.ID))
+}
+
+// deleteMessageStatusIndex removes the status index of the given message, which is the only index that changes over the message's lifetime
+func (k Keeper) deleteMessageStatusIndex(ctx sdk.Context, m exported.GeneralMessage) {
+	k.getStore(ctx).DeleteNew(getMessageByStatusKey(m))
+}
+
+// GetMessagesPaginated returns the general messages matching the given filter with the given pagination properties
+func (k Keeper) GetMessagesPaginated(ctx sdk.Context, filter MessageFilter, pageRequest *query.PageRequest) ([]exported.GeneralMessage, *query.PageResponse, error) {
+	var msgs []exported.GeneralMessage
+
+	indexPrefix, indexed := filter.indexPrefix()
+	if !indexed {
+		indexPrefix = generalMessagePrefix
+	}
+
+	store := prefix.NewStore(k.getStore(ctx).KVStore, append(indexPrefix.Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.FilteredPaginate(store, pageRequest, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var msg exported.GeneralMessage
+		if indexed {
+			msg = funcs.MustOk(k.GetMessage(ctx, string(value)))
+		} else {
+			k.cdc.MustUnmarshalLengthPrefixed(value, &msg)
+		}
+
+		// index keys are not prefix-free (e.g. chain names can share prefixes), so every filter is re-checked
+		if !filter.Matches(msg) {
+			return false, nil
+		}
+
+		if accumulate {
+			msgs = append(msgs, msg)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return msgs, resp, nil
+}
+
+func (k Keeper) indexMessages(ctx sdk.Context) {
+	iter := k.getStore(ctx).IteratorNew(generalMessagePrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var msg exported.GeneralMessage
+		iter.UnmarshalValue(&msg)
+
+		k.setMessageIndexes(ctx, msg)
+	}
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustestutils "github.com/axelarnetwork/axelar-core/x/nexus/exported/testutils"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestGetMessagesPaginated(t *testing.T) {
+	var (
+		ctx    sdk.Context
+		k      nexus.Keeper
+		msgs   []exported.GeneralMessage
+		filter nexus.MessageFilter
+	)
+
+	sourceChain := nexustestutils.RandomChain()
+	destinationChains := []exported.Chain{nexustestutils.RandomChain(), nexustestutils.RandomChain()}
+	statuses := []exported.GeneralMessage_Status{exported.Approved, exported.Processing, exported.Failed}
+	sender := strings.ToUpper(randMsg(exported.Approved).Sender.Address)
+
+	ids := func(msgs []exported.GeneralMessage) []string {
+		return slices.Map(msgs, func(m exported.GeneralMessage) string { return m.ID })
+	}
+
+	getAll := func(filter nexus.MessageFilter, limit uint64) []exported.GeneralMessage {
+		var result []exported.GeneralMessage
+
+		pageRequest := &query.PageRequest{Limit: limit}
+		for {
+			page, pageResponse, err := k.GetMessagesPaginated(ctx, filter, pageRequest)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, uint64(len(page)), limit)
+
+			result = append(result, page...)
+			if len(pageResponse.NextKey) == 0 {
+				return result
+			}
+
+			pageRequest = &query.PageRequest{Key: pageResponse.NextKey, Limit: limit}
+		}
+	}
+
+	cfg := app.MakeEncodingConfig()
+	givenMessages := Given("messages with different statuses, chains and senders", func() {
+		k, ctx = setup(cfg, t)
+
+		msgs = nil
+		for i := 0; i < 30; i++ {
+			msg := randMsg(statuses[i%len(statuses)])
+			msg.Sender.Chain = sourceChain
+			msg.Recipient.Chain = destinationChains[i%len(destinationChains)]
+			if i%5 == 0 {
+				msg.Sender.Address = sender
+			}
+
+			msgs = append(msgs, msg)
+		}
+
+		// the chains are already set up by the keeper
+		genesis := types.DefaultGenesisState()
+		genesis.Chains = nil
+		genesis.ChainStates = nil
+		genesis.Messages = msgs
+		k.InitGenesis(ctx, genesis)
+	})
+
+	assertMessages := func(t *testing.T) {
+		expected := slices.Filter(msgs, filter.Matches)
+		assert.NotEmpty(t, expected)
+
+		assert.ElementsMatch(t, ids(expected), ids(getAll(filter, 100)))
+		assert.ElementsMatch(t, ids(expected), ids(getAll(filter, 2)))
+	}
+
+	givenMessages.
+		Branch(
+			When("no filter is set", func() { filter = nexus.MessageFilter{} }).
+				Then("should return all messages", func(t *testing.T) {
+					assert.Len(t, getAll(filter, 7), len(msgs))
+					assertMessages(t)
+				}),
+
+			When("filtering by status", func() { filter = nexus.MessageFilter{Status: exported.Failed} }).
+				Then("should return the matching messages", assertMessages),
+
+			When("filtering by source chain", func() {
+				filter = nexus.MessageFilter{SourceChain: exported.ChainName(strings.ToUpper(sourceChain.Name.String()))}
+			}).
+				Then("should return the matching messages", assertMessages),
+
+			When("filtering by destination chain and status", func() {
+				filter = nexus.MessageFilter{DestinationChain: destinationChains[0].Name, Status: exported.Approved}
+			}).
+				Then("should return the matching messages", assertMessages),
+
+			When("filtering by destination chain with different casing", func() {
+				filter = nexus.MessageFilter{DestinationChain: exported.ChainName(strings.ToLower(destinationChains[1].Name.String()))}
+			}).
+				Then("should return the matching messages", assertMessages),
+
+			When("filtering by sender", func() {
+				filter = nexus.MessageFilter{Sender: strings.ToLower(sender)}
+			}).
+				Then("should return the matching messages", assertMessages),
+
+			When("filtering by an unknown chain", func() {
+				filter = nexus.MessageFilter{DestinationChain: nexustestutils.RandomChainName()}
+			}).
+				Then("should return no messages", func(t *testing.T) {
+					assert.Empty(t, getAll(filter, 100))
+				}),
+		).
+		Run(t)
+
+	givenMessages.
+		When("a processing message fails", func() {
+			msg := msgs[1]
+			funcs.MustNoErr(k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10)))
+			msgs[1] = funcs.MustOk(k.GetMessage(ctx, msg.ID))
+		}).
+		Then("should only be returned for its new status", func(t *testing.T) {
+			failed := ids(getAll(nexus.MessageFilter{Status: exported.Failed}, 100))
+			processing := ids(getAll(nexus.MessageFilter{Status: exported.Processing}, 100))
+
+			assert.Contains(t, failed, msgs[1].ID)
+			assert.NotContains(t, processing, msgs[1].ID)
+			assert.Len(t, failed, len(slices.Filter(msgs, func(m exported.GeneralMessage) bool { return m.Is(exported.Failed) })))
+		}).
+		Then("should still be indexed by its chains and sender", func(t *testing.T) {
+			msg := msgs[1]
+
+			assert.Contains(t, ids(getAll(nexus.MessageFilter{SourceChain: msg.GetSourceChain()}, 100)), msg.ID)
+			assert.Contains(t, ids(getAll(nexus.MessageFilter{DestinationChain: msg.GetDestinationChain()}, 100)), msg.ID)
+			assert.Contains(t, ids(getAll(nexus.MessageFilter{Sender: msg.GetSourceAddress()}, 100)), msg.ID)
+		}).
+		Run(t)
+}
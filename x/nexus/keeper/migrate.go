@@ -14,6 +14,36 @@ func Migrate8to9(k Keeper) func(ctx sdk.Context) error {
 	}
 }
 
+// Migrate9to10 returns the handler that performs in-place store migrations
+func Migrate9to10(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addChainAutoDeactivationParams(ctx, k)
+		k.indexMessages(ctx)
+		return nil
+	}
+}
+
+// Migrate10to11 returns the handler that performs in-place store migrations
+func Migrate10to11(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addMessageRefundMaxFailuresParam(ctx, k)
+		return nil
+	}
+}
+
+func addMessageRefundMaxFailuresParam(ctx sdk.Context, k Keeper) {
+	k.params.Set(ctx, types.KeyMessageRefundMaxFailures, types.DefaultParams().MessageRefundMaxFailures)
+}
+
+func addChainAutoDeactivationParams(ctx sdk.Context, k Keeper) {
+	defaults := types.DefaultParams()
+
+	k.params.Set(ctx, types.KeyChainAutoDeactivationEnabled, defaults.ChainAutoDeactivationEnabled)
+	k.params.Set(ctx, types.KeyChainFailedPollThreshold, defaults.ChainFailedPollThreshold)
+	k.params.Set(ctx, types.KeyChainMaintainerMassMissingVoteThreshold, defaults.ChainMaintainerMassMissingVoteThreshold)
+	k.params.Set(ctx, types.KeyChainHealthMinPollCount, defaults.ChainHealthMinPollCount)
+}
+
 func shrinkMaintainerStateBitmaps(ctx sdk.Context, k Keeper) {
 	maxSize := types.MaxBitmapSize()
 
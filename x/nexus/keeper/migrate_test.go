@@ -75,3 +75,71 @@ func TestMigrate8to9(t *testing.T) {
 		}).
 		Run(t)
 }
+
+func TestMigrate9to10(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("nexusKey"), store.NewKVStoreKey("tNexusKey"), "nexus")
+	k := keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey("nexus"), subspace)
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
+
+	var expected types.Params
+
+	Given("the params before the automatic chain deactivation was introduced", func() {
+		expected = types.DefaultParams()
+		expected.EndBlockerLimit = uint64(rand.I64Between(1, 100))
+
+		subspace := subspace.WithKeyTable(types.KeyTable())
+		subspace.Set(ctx, types.KeyChainActivationThreshold, expected.ChainActivationThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerMissingVoteThreshold, expected.ChainMaintainerMissingVoteThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerIncorrectVoteThreshold, expected.ChainMaintainerIncorrectVoteThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerCheckWindow, expected.ChainMaintainerCheckWindow)
+		subspace.Set(ctx, types.KeyGateway, expected.Gateway)
+		subspace.Set(ctx, types.KeyEndBlockerLimit, expected.EndBlockerLimit)
+		subspace.Set(ctx, types.KeyMessageRefundMaxFailures, expected.MessageRefundMaxFailures)
+	}).
+		When("the migration runs", func() {
+			assert.NoError(t, keeper.Migrate9to10(k)(ctx))
+		}).
+		Then("should set the new params to their defaults and keep the others", func(t *testing.T) {
+			actual := k.GetParams(ctx)
+			assert.Equal(t, expected.EndBlockerLimit, actual.EndBlockerLimit)
+			assert.Equal(t, expected.ChainAutoDeactivationEnabled, actual.ChainAutoDeactivationEnabled)
+			assert.Equal(t, expected.ChainFailedPollThreshold, actual.ChainFailedPollThreshold)
+			assert.Equal(t, expected.ChainMaintainerMassMissingVoteThreshold, actual.ChainMaintainerMassMissingVoteThreshold)
+			assert.Equal(t, expected.ChainHealthMinPollCount, actual.ChainHealthMinPollCount)
+		}).
+		Run(t)
+}
+
+func TestMigrate10to11(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	subspace := params.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("nexusKey"), store.NewKVStoreKey("tNexusKey"), "nexus")
+	k := keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey("nexus"), subspace)
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
+
+	var expected types.Params
+
+	Given("the params before message refunds were introduced", func() {
+		expected = types.DefaultParams()
+		expected.EndBlockerLimit = uint64(rand.I64Between(1, 100))
+
+		subspace := subspace.WithKeyTable(types.KeyTable())
+		subspace.Set(ctx, types.KeyChainActivationThreshold, expected.ChainActivationThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerMissingVoteThreshold, expected.ChainMaintainerMissingVoteThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerIncorrectVoteThreshold, expected.ChainMaintainerIncorrectVoteThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerCheckWindow, expected.ChainMaintainerCheckWindow)
+		subspace.Set(ctx, types.KeyGateway, expected.Gateway)
+		subspace.Set(ctx, types.KeyEndBlockerLimit, expected.EndBlockerLimit)
+		subspace.Set(ctx, types.KeyChainAutoDeactivationEnabled, expected.ChainAutoDeactivationEnabled)
+		subspace.Set(ctx, types.KeyChainFailedPollThreshold, expected.ChainFailedPollThreshold)
+		subspace.Set(ctx, types.KeyChainMaintainerMassMissingVoteThreshold, expected.ChainMaintainerMassMissingVoteThreshold)
+		subspace.Set(ctx, types.KeyChainHealthMinPollCount, expected.ChainHealthMinPollCount)
+	}).
+		When("the migration runs", func() {
+			assert.NoError(t, keeper.Migrate10to11(k)(ctx))
+		}).
+		Then("should set the new param to its default and keep the others", func(t *testing.T) {
+			assert.Equal(t, expected, k.GetParams(ctx))
+		}).
+		Run(t)
+}
@@ -8,8 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/utils/events"
 	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	reward "github.com/axelarnetwork/axelar-core/x/reward/exported"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	"github.com/axelarnetwork/utils/funcs"
 	"github.com/axelarnetwork/utils/slices"
@@ -137,7 +139,7 @@ func (s msgServer) DeregisterChainMaintainer(c context.Context, req *types.Dereg
 
 		s.Logger(ctx).Info(fmt.Sprintf("validator %s deregistered maintainer for chain %s", validator.String(), chain.Name))
 
-		s.reward.GetPool(ctx, chain.Name.String()).ClearRewards(validator)
+		s.reward.GetPool(ctx, chain.Name.String()).ClearRewards(validator, reward.MaintainerDeregistered)
 		if err := s.RemoveChainMaintainer(ctx, chain, validator); err != nil {
 			return nil, err
 		}
@@ -344,3 +346,33 @@ func (s msgServer) RetryFailedMessage(c context.Context, req *types.RetryFailedM
 
 	return &types.RetryFailedMessageResponse{}, nil
 }
+
+func (s msgServer) SetTransferRateLimit(c context.Context, req *types.SetTransferRateLimitRequest) (*types.SetTransferRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	directions := []exported.TransferDirection{req.Direction}
+	if req.Direction == exported.Unspecified {
+		directions = []exported.TransferDirection{exported.TransferDirectionFrom, exported.TransferDirectionTo}
+	}
+
+	for _, direction := range directions {
+		if err := s.SetRateLimit(ctx, req.Chain, req.Limit, req.Window, direction); err != nil {
+			return nil, err
+		}
+
+		if req.Window == 0 {
+			s.Logger(ctx).Info(fmt.Sprintf("removed transfer rate limit of asset %s with direction %s on chain %s", req.Limit.Denom, direction, req.Chain), types.AttributeKeyChain, req.Chain, types.AttributeKeyAsset, req.Limit.Denom)
+		} else {
+			s.Logger(ctx).Info(fmt.Sprintf("set transfer rate limit of asset %s with direction %s on chain %s to %s within %s", req.Limit.Denom, direction, req.Chain, req.Limit, req.Window), types.AttributeKeyChain, req.Chain, types.AttributeKeyAsset, req.Limit.Denom)
+		}
+
+		events.Emit(ctx, &types.RateLimitUpdated{
+			Chain:     req.Chain,
+			Limit:     req.Limit,
+			Window:    req.Window,
+			Direction: direction,
+		})
+	}
+
+	return &types.SetTransferRateLimitResponse{}, nil
+}
@@ -172,7 +172,7 @@ func TestRetryFailedMessage(t *testing.T) {
 		err = k.RouteMessage(ctx, msg.ID)
 		assert.NoError(t, err)
 
-		err = k.SetMessageFailed(ctx, msg.ID)
+		err = k.SetMessageFailed(ctx, msg.ID, rand.NormalizedStr(10))
 		assert.NoError(t, err)
 
 		storedMsg, ok := k.GetMessage(ctx, msg.ID)
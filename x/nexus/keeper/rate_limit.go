@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+func getRateLimitKey(chain exported.ChainName, asset string, direction exported.TransferDirection) key.Key {
+	return rateLimitPrefix.
+		Append(key.FromStr(strings.ToLower(chain.String()))).
+		Append(key.FromStr(asset)).
+		Append(key.From(direction))
+}
+
+func getTransferEpochPrefix(chain exported.ChainName, asset string, direction exported.TransferDirection) key.Key {
+	return transferEpochPrefix.
+		Append(key.FromStr(strings.ToLower(chain.String()))).
+		Append(key.FromStr(asset)).
+		Append(key.From(direction))
+}
+
+func getTransferEpochKey(epoch types.TransferEpoch) key.Key {
+	return getTransferEpochPrefix(epoch.Chain, epoch.Amount.Denom, epoch.Direction).Append(key.FromUInt(epoch.Epoch))
+}
+
+// SetRateLimit limits the amount of the given asset that can be transferred in the given direction of the given chain within the rolling window.
+// A zero window removes the limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, chainName exported.ChainName, limit sdk.Coin, window time.Duration, direction exported.TransferDirection) error {
+	if err := direction.ValidateBasic(); err != nil {
+		return err
+	}
+
+	chain, ok := k.GetChain(ctx, chainName)
+	if !ok {
+		return fmt.Errorf("%s is not a registered chain", chainName)
+	}
+
+	if !k.IsAssetRegistered(ctx, chain, limit.Denom) {
+		return fmt.Errorf("%s is not a registered asset for chain %s", limit.Denom, chain.Name)
+	}
+
+	// usage is tracked in epochs of the window's length, so it cannot be carried over to a different window
+	if rateLimit, ok := k.getRateLimit(ctx, chain.Name, limit.Denom, direction); !ok || rateLimit.Window != window {
+		k.deleteTransferEpochs(ctx, chain.Name, limit.Denom, direction)
+	}
+
+	if window == 0 {
+		k.getStore(ctx).DeleteNew(getRateLimitKey(chain.Name, limit.Denom, direction))
+		return nil
+	}
+
+	return k.setRateLimit(ctx, types.RateLimit{Chain: chain.Name, Limit: limit, Window: window, Direction: direction})
+}
+
+func (k Keeper) setRateLimit(ctx sdk.Context, rateLimit types.RateLimit) error {
+	return k.getStore(ctx).SetNewValidated(getRateLimitKey(rateLimit.Chain, rateLimit.Limit.Denom, rateLimit.Direction), &rateLimit)
+}
+
+func (k Keeper) getRateLimit(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) (rateLimit types.RateLimit, ok bool) {
+	return rateLimit, k.getStore(ctx).GetNew(getRateLimitKey(chain, asset, direction), &rateLimit)
+}
+
+func (k Keeper) getRateLimits(ctx sdk.Context) (rateLimits []types.RateLimit) {
+	iter := k.getStore(ctx).IteratorNew(rateLimitPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var rateLimit types.RateLimit
+		iter.UnmarshalValue(&rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+func (k Keeper) setTransferEpoch(ctx sdk.Context, epoch types.TransferEpoch) error {
+	return k.getStore(ctx).SetNewValidated(getTransferEpochKey(epoch), &epoch)
+}
+
+func (k Keeper) getTransferEpoch(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection, epoch uint64) types.TransferEpoch {
+	transferEpoch := types.TransferEpoch{
+		Chain:     chain,
+		Amount:    sdk.NewCoin(asset, math.ZeroInt()),
+		Epoch:     epoch,
+		Direction: direction,
+	}
+	k.getStore(ctx).GetNew(getTransferEpochKey(transferEpoch), &transferEpoch)
+
+	return transferEpoch
+}
+
+func (k Keeper) getTransferEpochs(ctx sdk.Context) (transferEpochs []types.TransferEpoch) {
+	iter := k.getStore(ctx).IteratorNew(transferEpochPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var transferEpoch types.TransferEpoch
+		iter.UnmarshalValue(&transferEpoch)
+
+		transferEpochs = append(transferEpochs, transferEpoch)
+	}
+
+	return transferEpochs
+}
+
+// deleteTransferEpochsBefore deletes all transfer epochs of the given asset, chain and direction that precede the given epoch
+func (k Keeper) deleteTransferEpochsBefore(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection, epoch uint64) {
+	iter := k.getStore(ctx).IteratorNew(getTransferEpochPrefix(chain, asset, direction))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var stale []types.TransferEpoch
+	for ; iter.Valid(); iter.Next() {
+		var transferEpoch types.TransferEpoch
+		iter.UnmarshalValue(&transferEpoch)
+
+		if transferEpoch.Chain.Equals(chain) && transferEpoch.Amount.Denom == asset && transferEpoch.Direction == direction && transferEpoch.Epoch < epoch {
+			stale = append(stale, transferEpoch)
+		}
+	}
+
+	for _, transferEpoch := range stale {
+		k.getStore(ctx).DeleteNew(getTransferEpochKey(transferEpoch))
+	}
+}
+
+func (k Keeper) deleteTransferEpochs(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) {
+	k.deleteTransferEpochsBefore(ctx, chain, asset, direction, ^uint64(0))
+}
+
+// getTransferUsage returns the current transfer epoch and the amount transferred within the rolling window that ends at the current block time.
+// The amount transferred in the previous epoch is weighted by how much of it still overlaps with the window.
+func (k Keeper) getTransferUsage(ctx sdk.Context, rateLimit types.RateLimit, direction exported.TransferDirection) (types.TransferEpoch, math.Int) {
+	window := rateLimit.Window.Nanoseconds()
+	now := ctx.BlockTime().UnixNano()
+	epoch := uint64(now / window)
+
+	current := k.getTransferEpoch(ctx, rateLimit.Chain, rateLimit.Limit.Denom, direction, epoch)
+	usage := current.Amount.Amount
+
+	if epoch > 0 {
+		previous := k.getTransferEpoch(ctx, rateLimit.Chain, rateLimit.Limit.Denom, direction, epoch-1)
+		overlap := window - now%window
+		usage = usage.Add(previous.Amount.Amount.MulRaw(overlap).QuoRaw(window))
+	}
+
+	return current, usage
+}
+
+// rateLimitTransfer records the transfer of the given asset from the source chain to the destination chain.
+// It returns ErrRateLimitExceeded without recording anything if the transfer exceeds the outflow limit of the source chain
+// or the inflow limit of the destination chain.
+func (k Keeper) rateLimitTransfer(ctx sdk.Context, sourceChain exported.ChainName, destinationChain exported.ChainName, asset sdk.Coin, messageID string) error {
+	flows := []struct {
+		chain     exported.ChainName
+		direction exported.TransferDirection
+	}{
+		{sourceChain, exported.TransferDirectionFrom},
+		{destinationChain, exported.TransferDirectionTo},
+	}
+
+	var transferEpochs []types.TransferEpoch
+	for _, flow := range flows {
+		rateLimit, ok := k.getRateLimit(ctx, flow.chain, asset.Denom, flow.direction)
+		if !ok {
+			continue
+		}
+
+		transferEpoch, usage := k.getTransferUsage(ctx, rateLimit, flow.direction)
+		if usage.Add(asset.Amount).GT(rateLimit.Limit.Amount) {
+			events.Emit(ctx, &types.TransferRateLimitExceeded{
+				Chain:     rateLimit.Chain,
+				Amount:    asset,
+				Direction: flow.direction,
+				Limit:     rateLimit.Limit,
+				Usage:     usage,
+				Window:    rateLimit.Window,
+				MessageID: messageID,
+			})
+
+			return errorsmod.Wrapf(types.ErrRateLimitExceeded, "transfer of %s with direction %s exceeds the limit %s of chain %s within %s (current usage %s)",
+				asset, flow.direction, rateLimit.Limit, rateLimit.Chain, rateLimit.Window, usage)
+		}
+
+		transferEpoch.Amount = transferEpoch.Amount.Add(asset)
+		transferEpochs = append(transferEpochs, transferEpoch)
+	}
+
+	for _, transferEpoch := range transferEpochs {
+		funcs.MustNoErr(k.setTransferEpoch(ctx, transferEpoch))
+		// only the current and the previous epoch are needed to compute the usage
+		if transferEpoch.Epoch > 0 {
+			k.deleteTransferEpochsBefore(ctx, transferEpoch.Chain, transferEpoch.Amount.Denom, transferEpoch.Direction, transferEpoch.Epoch-1)
+		}
+	}
+
+	return nil
+}
+
+// GetTransferRateLimit returns the transfer rate limit of the given asset in the given direction of the given chain,
+// and the amount transferred in that direction within the current window
+func (k Keeper) GetTransferRateLimit(ctx sdk.Context, chain exported.ChainName, asset string, direction exported.TransferDirection) (rateLimit types.RateLimit, usage math.Int, ok bool) {
+	rateLimit, ok = k.getRateLimit(ctx, chain, asset, direction)
+	if !ok {
+		return types.RateLimit{}, math.Int{}, false
+	}
+
+	_, usage = k.getTransferUsage(ctx, rateLimit, direction)
+
+	return rateLimit, usage, true
+}
@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	evm "github.com/axelarnetwork/axelar-core/x/evm/exported"
+	evmtestutils "github.com/axelarnetwork/axelar-core/x/evm/types/testutils"
+	"github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/keeper"
+	"github.com/axelarnetwork/axelar-core/x/nexus/types"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestRateLimitTransfer(t *testing.T) {
+	var (
+		ctx       sdk.Context
+		keeper    nexus.Keeper
+		recipient exported.CrossChainAddress
+	)
+
+	asset := "external-erc-20"
+	limit := sdk.NewCoin(asset, math.NewInt(10*maxAmount))
+	window := time.Hour
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin(asset, math.NewInt(amount)) }
+
+	cfg := app.MakeEncodingConfig()
+	givenKeeper := Given("the keeper", func() {
+		keeper, ctx = setup(cfg, t)
+		ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(1000 * window))
+
+		recipient = exported.CrossChainAddress{Chain: avalanche, Address: evmtestutils.RandomAddress().Hex()}
+	})
+
+	givenKeeper.
+		When("the rate limit is set for an unknown chain, asset or direction", func() {}).
+		Then("should return error", func(t *testing.T) {
+			assert.ErrorContains(t, keeper.SetRateLimit(ctx, "unknown", limit, window, exported.TransferDirectionFrom), "not a registered chain")
+			assert.ErrorContains(t, keeper.SetRateLimit(ctx, evm.Ethereum.Name, sdk.NewCoin("unknown", limit.Amount), window, exported.TransferDirectionFrom), "not a registered asset")
+			assert.ErrorContains(t, keeper.SetRateLimit(ctx, evm.Ethereum.Name, limit, window, exported.Unspecified), "invalid transfer direction")
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("an outflow rate limit is set on the source chain", func() {
+			funcs.MustNoErr(keeper.SetRateLimit(ctx, evm.Ethereum.Name, limit, window, exported.TransferDirectionFrom))
+		}).
+		Branch(
+			Then("should allow transfers within the limit", func(t *testing.T) {
+				_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(6*maxAmount))
+				assert.NoError(t, err)
+				_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(4*maxAmount))
+				assert.NoError(t, err)
+
+				rateLimit, outflow, ok := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.True(t, ok)
+				assert.Equal(t, limit, rateLimit.Limit)
+				assert.Equal(t, window, rateLimit.Window)
+				assert.Equal(t, exported.TransferDirectionFrom, rateLimit.Direction)
+				assert.Equal(t, math.NewInt(10*maxAmount), outflow)
+			}),
+
+			Then("should reject transfers exceeding the limit without recording them", func(t *testing.T) {
+				_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(6*maxAmount))
+				assert.NoError(t, err)
+
+				_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(5*maxAmount))
+				assert.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+				_, outflow, _ := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.Equal(t, math.NewInt(6*maxAmount), outflow)
+			}),
+
+			Then("should not limit inflows or other assets", func(t *testing.T) {
+				_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(10*maxAmount))
+				assert.NoError(t, err)
+
+				sender := exported.CrossChainAddress{Chain: evm.Ethereum, Address: evmtestutils.RandomAddress().Hex()}
+				_, err = keeper.EnqueueTransfer(ctx, avalanche, sender, coin(20*maxAmount))
+				assert.NoError(t, err)
+
+				_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, sdk.NewCoin("uusd", math.NewInt(20*maxAmount)))
+				assert.NoError(t, err)
+
+				_, _, ok := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionTo)
+				assert.False(t, ok)
+
+				_, outflow, _ := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.Equal(t, math.NewInt(10*maxAmount), outflow)
+			}),
+
+			Then("should weigh the previous window by its overlap with the rolling window", func(t *testing.T) {
+				_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(8*maxAmount))
+				assert.NoError(t, err)
+
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window + window/4))
+				_, outflow, _ := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.Equal(t, math.NewInt(6*maxAmount), outflow)
+
+				_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(5*maxAmount))
+				assert.ErrorIs(t, err, types.ErrRateLimitExceeded)
+				_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(4*maxAmount))
+				assert.NoError(t, err)
+
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * window))
+				_, outflow, _ = keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.Equal(t, math.ZeroInt(), outflow)
+			}),
+
+			Then("should remove the limit when the window is zero", func(t *testing.T) {
+				funcs.MustNoErr(keeper.SetRateLimit(ctx, evm.Ethereum.Name, limit, 0, exported.TransferDirectionFrom))
+
+				_, _, ok := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+				assert.False(t, ok)
+
+				_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(20*maxAmount))
+				assert.NoError(t, err)
+			}),
+		).
+		Run(t)
+
+	givenKeeper.
+		When("different inflow and outflow rate limits are set on a chain", func() {
+			funcs.MustNoErr(keeper.SetRateLimit(ctx, evm.Ethereum.Name, limit, window, exported.TransferDirectionFrom))
+			funcs.MustNoErr(keeper.SetRateLimit(ctx, evm.Ethereum.Name, coin(2*maxAmount), window, exported.TransferDirectionTo))
+		}).
+		Then("should check each direction against its own limit", func(t *testing.T) {
+			_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(10*maxAmount))
+			assert.NoError(t, err)
+
+			sender := exported.CrossChainAddress{Chain: evm.Ethereum, Address: evmtestutils.RandomAddress().Hex()}
+			_, err = keeper.EnqueueTransfer(ctx, avalanche, sender, coin(3*maxAmount))
+			assert.ErrorIs(t, err, types.ErrRateLimitExceeded)
+			_, err = keeper.EnqueueTransfer(ctx, avalanche, sender, coin(2*maxAmount))
+			assert.NoError(t, err)
+
+			_, inflow, _ := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionTo)
+			assert.Equal(t, math.NewInt(2*maxAmount), inflow)
+			_, outflow, _ := keeper.GetTransferRateLimit(ctx, evm.Ethereum.Name, asset, exported.TransferDirectionFrom)
+			assert.Equal(t, math.NewInt(10*maxAmount), outflow)
+		}).
+		Run(t)
+
+	givenKeeper.
+		When("an inflow rate limit is set on the destination chain", func() {
+			funcs.MustNoErr(keeper.SetRateLimit(ctx, avalanche.Name, limit, window, exported.TransferDirectionTo))
+		}).
+		Then("should reject transfers exceeding the limit", func(t *testing.T) {
+			_, err := keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(11*maxAmount))
+			assert.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+			_, err = keeper.EnqueueTransfer(ctx, evm.Ethereum, recipient, coin(10*maxAmount))
+			assert.NoError(t, err)
+
+			_, inflow, _ := keeper.GetTransferRateLimit(ctx, avalanche.Name, asset, exported.TransferDirectionTo)
+			assert.Equal(t, math.NewInt(10*maxAmount), inflow)
+		}).
+		Run(t)
+}
+
+func TestRateLimitMessage(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		keeper     nexus.Keeper
+		msg        exported.GeneralMessage
+		routeCount uint
+	)
+
+	asset := "external-erc-20"
+	window := time.Hour
+
+	cfg := app.MakeEncodingConfig()
+	Given("the keeper with a rate limit on the destination chain", func() {
+		keeper, ctx = setup(cfg, t)
+		ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(1000 * window))
+
+		funcs.MustNoErr(keeper.SetRateLimit(ctx, avalanche.Name, sdk.NewCoin(asset, math.NewInt(100)), window, exported.TransferDirectionTo))
+
+		routeCount = 0
+		keeper.SetMessageRouter(types.NewMessageRouter().AddRoute(avalanche.Module, func(sdk.Context, exported.RoutingContext, exported.GeneralMessage) error {
+			routeCount++
+			return nil
+		}))
+	}).
+		When("a message carrying an asset is approved", func() {
+			msg = randMsg(exported.Approved)
+			msg.Sender = exported.CrossChainAddress{Chain: evm.Ethereum, Address: evmtestutils.RandomAddress().Hex()}
+			msg.Recipient = exported.CrossChainAddress{Chain: avalanche, Address: evmtestutils.RandomAddress().Hex()}
+		}).
+		Branch(
+			When("the asset is within the limit", func() {
+				msg.Asset = &sdk.Coin{Denom: asset, Amount: math.NewInt(100)}
+				funcs.MustNoErr(keeper.SetNewMessage(ctx, msg))
+			}).
+				Then("should route the message", func(t *testing.T) {
+					assert.NoError(t, keeper.RouteMessage(ctx, msg.ID))
+					assert.EqualValues(t, 1, routeCount)
+					assert.True(t, funcs.MustOk(keeper.GetMessage(ctx, msg.ID)).Is(exported.Processing))
+				}),
+
+			When("the asset exceeds the limit", func() {
+				msg.Asset = &sdk.Coin{Denom: asset, Amount: math.NewInt(101)}
+				funcs.MustNoErr(keeper.SetNewMessage(ctx, msg))
+			}).
+				Then("should fail the message without routing it", func(t *testing.T) {
+					assert.NoError(t, keeper.RouteMessage(ctx, msg.ID))
+					assert.EqualValues(t, 0, routeCount)
+					assert.True(t, funcs.MustOk(keeper.GetMessage(ctx, msg.ID)).Is(exported.Failed))
+
+					_, inflow, _ := keeper.GetTransferRateLimit(ctx, avalanche.Name, asset, exported.TransferDirectionTo)
+					assert.Equal(t, math.ZeroInt(), inflow)
+				}),
+		).
+		Run(t)
+}
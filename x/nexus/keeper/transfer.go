@@ -113,6 +113,10 @@ func (k Keeper) EnqueueTransfer(ctx sdk.Context, senderChain exported.Chain, rec
 		return 0, err
 	}
 
+	if err := k.rateLimitTransfer(ctx, senderChain.Name, recipient.Chain.Name, asset, ""); err != nil {
+		return 0, err
+	}
+
 	// merging transfers below minimum for the specified recipient
 	insufficientAmountTransfer, found := k.getTransfer(ctx, recipient, asset.Denom, exported.InsufficientAmount)
 	if found {
@@ -121,6 +121,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 9, keeper.Migrate9to10(am.keeper))
+	if err != nil {
+		panic(err)
+	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 10, keeper.Migrate10to11(am.keeper))
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis initializes the module's keeper from the given genesis state
@@ -151,7 +161,7 @@ func (AppModule) QuerierRoute() string {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 9 }
+func (AppModule) ConsensusVersion() uint64 { return 11 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() { // marker
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
@@ -190,13 +191,11 @@ func (*InsufficientFee) XXX_MessageName() string {
 	return "axelar.nexus.v1beta1.InsufficientFee"
 }
 
-// Deprecated in v1.4: rate limiting has been removed.
-//
-// Deprecated: Do not use.
 type RateLimitUpdated struct {
-	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
-	Limit  types.Coin                                                      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
-	Window time.Duration                                                   `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Limit     types.Coin                                                      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+	Window    time.Duration                                                   `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	Direction exported.TransferDirection                                      `protobuf:"varint,4,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
 }
 
 func (m *RateLimitUpdated) Reset()         { *m = RateLimitUpdated{} }
@@ -253,10 +252,162 @@ func (m *RateLimitUpdated) GetWindow() time.Duration {
 	return 0
 }
 
+func (m *RateLimitUpdated) GetDirection() exported.TransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return exported.Unspecified
+}
+
 func (*RateLimitUpdated) XXX_MessageName() string {
 	return "axelar.nexus.v1beta1.RateLimitUpdated"
 }
 
+type TransferRateLimitExceeded struct {
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Amount    types.Coin                                                      `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Direction exported.TransferDirection                                      `protobuf:"varint,3,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
+	Limit     types.Coin                                                      `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit"`
+	Usage     cosmossdk_io_math.Int                                           `protobuf:"bytes,5,opt,name=usage,proto3,customtype=cosmossdk.io/math.Int" json:"usage"`
+	Window    time.Duration                                                   `protobuf:"bytes,6,opt,name=window,proto3,stdduration" json:"window"`
+	MessageID string                                                          `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *TransferRateLimitExceeded) Reset()         { *m = TransferRateLimitExceeded{} }
+func (m *TransferRateLimitExceeded) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimitExceeded) ProtoMessage()    {}
+func (*TransferRateLimitExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{3}
+}
+func (m *TransferRateLimitExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimitExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimitExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimitExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimitExceeded.Merge(m, src)
+}
+func (m *TransferRateLimitExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimitExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimitExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimitExceeded proto.InternalMessageInfo
+
+func (m *TransferRateLimitExceeded) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *TransferRateLimitExceeded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *TransferRateLimitExceeded) GetDirection() exported.TransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return exported.Unspecified
+}
+
+func (m *TransferRateLimitExceeded) GetLimit() types.Coin {
+	if m != nil {
+		return m.Limit
+	}
+	return types.Coin{}
+}
+
+func (m *TransferRateLimitExceeded) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *TransferRateLimitExceeded) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+func (*TransferRateLimitExceeded) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.TransferRateLimitExceeded"
+}
+
+type ChainAutoDeactivated struct {
+	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Reason string                                                          `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ChainAutoDeactivated) Reset()         { *m = ChainAutoDeactivated{} }
+func (m *ChainAutoDeactivated) String() string { return proto.CompactTextString(m) }
+func (*ChainAutoDeactivated) ProtoMessage()    {}
+func (*ChainAutoDeactivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{4}
+}
+func (m *ChainAutoDeactivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainAutoDeactivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainAutoDeactivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainAutoDeactivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainAutoDeactivated.Merge(m, src)
+}
+func (m *ChainAutoDeactivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainAutoDeactivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainAutoDeactivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainAutoDeactivated proto.InternalMessageInfo
+
+func (m *ChainAutoDeactivated) GetChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ChainAutoDeactivated) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (*ChainAutoDeactivated) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.ChainAutoDeactivated"
+}
+
 type MessageReceived struct {
 	ID          string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	PayloadHash []byte                     `protobuf:"bytes,2,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
@@ -268,7 +419,7 @@ func (m *MessageReceived) Reset()         { *m = MessageReceived{} }
 func (m *MessageReceived) String() string { return proto.CompactTextString(m) }
 func (*MessageReceived) ProtoMessage()    {}
 func (*MessageReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{3}
+	return fileDescriptor_4433ea5171b09eb9, []int{5}
 }
 func (m *MessageReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +490,7 @@ func (m *MessageProcessing) Reset()         { *m = MessageProcessing{} }
 func (m *MessageProcessing) String() string { return proto.CompactTextString(m) }
 func (*MessageProcessing) ProtoMessage()    {}
 func (*MessageProcessing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{4}
+	return fileDescriptor_4433ea5171b09eb9, []int{6}
 }
 func (m *MessageProcessing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +554,7 @@ func (m *MessageExecuted) Reset()         { *m = MessageExecuted{} }
 func (m *MessageExecuted) String() string { return proto.CompactTextString(m) }
 func (*MessageExecuted) ProtoMessage()    {}
 func (*MessageExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{5}
+	return fileDescriptor_4433ea5171b09eb9, []int{7}
 }
 func (m *MessageExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,13 +612,14 @@ type MessageFailed struct {
 	ID               string                                                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
 	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
+	Reason           string                                                          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MessageFailed) Reset()         { *m = MessageFailed{} }
 func (m *MessageFailed) String() string { return proto.CompactTextString(m) }
 func (*MessageFailed) ProtoMessage()    {}
 func (*MessageFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{6}
+	return fileDescriptor_4433ea5171b09eb9, []int{8}
 }
 func (m *MessageFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,10 +669,169 @@ func (m *MessageFailed) GetDestinationChain() github_com_axelarnetwork_axelar_co
 	return ""
 }
 
+func (m *MessageFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func (*MessageFailed) XXX_MessageName() string {
 	return "axelar.nexus.v1beta1.MessageFailed"
 }
 
+type MessageRefunded struct {
+	ID               string                                                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName  `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
+	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName  `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
+	TransferID       github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID `protobuf:"varint,4,opt,name=transfer_id,json=transferId,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.TransferID" json:"transfer_id,omitempty"`
+	Asset            types.Coin                                                       `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset"`
+}
+
+func (m *MessageRefunded) Reset()         { *m = MessageRefunded{} }
+func (m *MessageRefunded) String() string { return proto.CompactTextString(m) }
+func (*MessageRefunded) ProtoMessage()    {}
+func (*MessageRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{9}
+}
+func (m *MessageRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRefunded.Merge(m, src)
+}
+func (m *MessageRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRefunded proto.InternalMessageInfo
+
+func (m *MessageRefunded) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *MessageRefunded) GetSourceChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *MessageRefunded) GetDestinationChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.DestinationChain
+	}
+	return ""
+}
+
+func (m *MessageRefunded) GetTransferID() github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID {
+	if m != nil {
+		return m.TransferID
+	}
+	return 0
+}
+
+func (m *MessageRefunded) GetAsset() types.Coin {
+	if m != nil {
+		return m.Asset
+	}
+	return types.Coin{}
+}
+
+func (*MessageRefunded) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.MessageRefunded"
+}
+
+type MessageRefundFailed struct {
+	ID               string                                                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
+	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
+	Reason           string                                                          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MessageRefundFailed) Reset()         { *m = MessageRefundFailed{} }
+func (m *MessageRefundFailed) String() string { return proto.CompactTextString(m) }
+func (*MessageRefundFailed) ProtoMessage()    {}
+func (*MessageRefundFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4433ea5171b09eb9, []int{10}
+}
+func (m *MessageRefundFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRefundFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRefundFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRefundFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRefundFailed.Merge(m, src)
+}
+func (m *MessageRefundFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRefundFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRefundFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRefundFailed proto.InternalMessageInfo
+
+func (m *MessageRefundFailed) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *MessageRefundFailed) GetSourceChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *MessageRefundFailed) GetDestinationChain() github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName {
+	if m != nil {
+		return m.DestinationChain
+	}
+	return ""
+}
+
+func (m *MessageRefundFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (*MessageRefundFailed) XXX_MessageName() string {
+	return "axelar.nexus.v1beta1.MessageRefundFailed"
+}
+
 type MessageRetried struct {
 	ID               string                                                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
@@ -531,7 +842,7 @@ func (m *MessageRetried) Reset()         { *m = MessageRetried{} }
 func (m *MessageRetried) String() string { return proto.CompactTextString(m) }
 func (*MessageRetried) ProtoMessage()    {}
 func (*MessageRetried) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{7}
+	return fileDescriptor_4433ea5171b09eb9, []int{11}
 }
 func (m *MessageRetried) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +904,7 @@ func (m *WasmMessageRouted) Reset()         { *m = WasmMessageRouted{} }
 func (m *WasmMessageRouted) String() string { return proto.CompactTextString(m) }
 func (*WasmMessageRouted) ProtoMessage()    {}
 func (*WasmMessageRouted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4433ea5171b09eb9, []int{8}
+	return fileDescriptor_4433ea5171b09eb9, []int{12}
 }
 func (m *WasmMessageRouted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,10 +947,14 @@ func init() {
 	proto.RegisterType((*FeeDeducted)(nil), "axelar.nexus.v1beta1.FeeDeducted")
 	proto.RegisterType((*InsufficientFee)(nil), "axelar.nexus.v1beta1.InsufficientFee")
 	proto.RegisterType((*RateLimitUpdated)(nil), "axelar.nexus.v1beta1.RateLimitUpdated")
+	proto.RegisterType((*TransferRateLimitExceeded)(nil), "axelar.nexus.v1beta1.TransferRateLimitExceeded")
+	proto.RegisterType((*ChainAutoDeactivated)(nil), "axelar.nexus.v1beta1.ChainAutoDeactivated")
 	proto.RegisterType((*MessageReceived)(nil), "axelar.nexus.v1beta1.MessageReceived")
 	proto.RegisterType((*MessageProcessing)(nil), "axelar.nexus.v1beta1.MessageProcessing")
 	proto.RegisterType((*MessageExecuted)(nil), "axelar.nexus.v1beta1.MessageExecuted")
 	proto.RegisterType((*MessageFailed)(nil), "axelar.nexus.v1beta1.MessageFailed")
+	proto.RegisterType((*MessageRefunded)(nil), "axelar.nexus.v1beta1.MessageRefunded")
+	proto.RegisterType((*MessageRefundFailed)(nil), "axelar.nexus.v1beta1.MessageRefundFailed")
 	proto.RegisterType((*MessageRetried)(nil), "axelar.nexus.v1beta1.MessageRetried")
 	proto.RegisterType((*WasmMessageRouted)(nil), "axelar.nexus.v1beta1.WasmMessageRouted")
 }
@@ -647,52 +962,64 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/events.proto", fileDescriptor_4433ea5171b09eb9) }
 
 var fileDescriptor_4433ea5171b09eb9 = []byte{
-	// 717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xd3, 0x4a,
-	0x10, 0x8e, 0x9d, 0x36, 0xef, 0x75, 0xd3, 0xd7, 0x36, 0x56, 0xf5, 0x14, 0x7a, 0x70, 0xda, 0x9c,
-	0x0a, 0x08, 0x9b, 0x16, 0x21, 0x24, 0x38, 0x00, 0x69, 0xa8, 0x08, 0x82, 0xaa, 0xb2, 0x8a, 0x10,
-	0x5c, 0xa2, 0x8d, 0x77, 0x92, 0xac, 0x48, 0x76, 0xa3, 0xdd, 0x75, 0x9b, 0x1e, 0x01, 0x71, 0xe0,
-	0x80, 0xc4, 0x91, 0x9f, 0xd4, 0x63, 0x8f, 0x9c, 0x02, 0x4a, 0xc4, 0x1f, 0xe0, 0xd8, 0x13, 0xf2,
-	0x7a, 0xe3, 0xb6, 0x48, 0x40, 0xa9, 0x2a, 0x21, 0x51, 0x6e, 0xce, 0x64, 0xe6, 0xfb, 0x66, 0xbe,
-	0xcf, 0x33, 0x32, 0x5a, 0xc2, 0x7d, 0xe8, 0x60, 0xe1, 0x33, 0xe8, 0x47, 0xd2, 0xdf, 0x5e, 0x69,
-	0x80, 0xc2, 0x2b, 0x3e, 0x6c, 0x03, 0x53, 0xd2, 0xeb, 0x09, 0xae, 0xb8, 0x33, 0x9f, 0xa4, 0x78,
-	0x3a, 0xc5, 0x33, 0x29, 0x0b, 0x6e, 0x8b, 0xf3, 0x56, 0x07, 0x7c, 0x9d, 0xd3, 0x88, 0x9a, 0x3e,
-	0x89, 0x04, 0x56, 0x94, 0xb3, 0xa4, 0x6a, 0x61, 0xbe, 0xc5, 0x5b, 0x5c, 0x3f, 0xfa, 0xf1, 0x93,
-	0x89, 0xba, 0x21, 0x97, 0x5d, 0x2e, 0xfd, 0x06, 0x96, 0x90, 0xb2, 0x85, 0x9c, 0x8e, 0xab, 0x2e,
-	0x1e, 0x6b, 0x07, 0xfa, 0x3d, 0x2e, 0x14, 0x90, 0x34, 0x53, 0xed, 0xf6, 0xc0, 0xb4, 0x55, 0x7e,
-	0x93, 0x45, 0xf9, 0x75, 0x80, 0x2a, 0x90, 0x28, 0x54, 0x40, 0x1c, 0x89, 0xf2, 0x4a, 0x60, 0x26,
-	0x9b, 0x20, 0xea, 0x94, 0x14, 0xad, 0x45, 0x6b, 0x79, 0xa2, 0x12, 0x0c, 0x07, 0x25, 0xb4, 0x65,
-	0xc2, 0xb5, 0xea, 0xc1, 0xa0, 0x74, 0xa7, 0x45, 0x55, 0x3b, 0x6a, 0x78, 0x21, 0xef, 0xfa, 0x09,
-	0x19, 0x03, 0xb5, 0xc3, 0xc5, 0x73, 0xf3, 0xeb, 0x4a, 0xc8, 0x05, 0xf8, 0xfd, 0x6f, 0x3a, 0xf0,
-	0x0e, 0x31, 0x02, 0x34, 0xa6, 0xa9, 0x11, 0xa7, 0x83, 0x66, 0x05, 0x84, 0xb4, 0x47, 0x81, 0xa9,
-	0x7a, 0xd8, 0xc6, 0x94, 0x15, 0xed, 0x45, 0x6b, 0x79, 0xaa, 0xb2, 0x76, 0x30, 0x28, 0xdd, 0x3e,
-	0x1d, 0xd5, 0x5a, 0x0c, 0xb3, 0x81, 0xbb, 0x10, 0xcc, 0xa4, 0xd8, 0x3a, 0xe6, 0x5c, 0x46, 0x85,
-	0x43, 0x36, 0x4c, 0x88, 0x00, 0x29, 0x8b, 0xd9, 0x98, 0x2f, 0x98, 0x4b, 0xff, 0xb8, 0x9b, 0xc4,
-	0x9d, 0x1b, 0x28, 0x87, 0xbb, 0x3c, 0x62, 0xaa, 0x38, 0xb1, 0x68, 0x2d, 0xe7, 0x57, 0x2f, 0x78,
-	0x89, 0xf6, 0x5e, 0xac, 0xfd, 0xd8, 0x46, 0x6f, 0x8d, 0x53, 0x56, 0x99, 0xd8, 0x1b, 0x94, 0x32,
-	0x81, 0x49, 0x77, 0x56, 0x50, 0xb6, 0x09, 0x50, 0x9c, 0x3c, 0x59, 0x55, 0x9c, 0x5b, 0x7e, 0x9b,
-	0x45, 0xb3, 0x35, 0x26, 0xa3, 0x66, 0x93, 0x86, 0x71, 0x0f, 0xeb, 0x00, 0x7f, 0xfd, 0xf8, 0x8d,
-	0x7e, 0x7c, 0xb6, 0xd0, 0x5c, 0x80, 0x15, 0x3c, 0xa4, 0x5d, 0xaa, 0x1e, 0xf7, 0x08, 0x8e, 0x17,
-	0xe4, 0x29, 0x9a, 0x4c, 0x14, 0xb1, 0xce, 0x4e, 0x91, 0x04, 0xd1, 0xb9, 0x8e, 0x26, 0x3b, 0x31,
-	0x95, 0x16, 0xfb, 0x04, 0x4d, 0x26, 0xd9, 0xce, 0x2d, 0x94, 0xdb, 0xa1, 0x8c, 0xf0, 0x1d, 0x2d,
-	0x5a, 0x5c, 0x97, 0x1c, 0x15, 0x6f, 0x7c, 0x54, 0xbc, 0xaa, 0x39, 0x2a, 0x95, 0x7f, 0xe3, 0xba,
-	0xf7, 0x1f, 0x4b, 0x56, 0x60, 0x4a, 0x6e, 0xda, 0x45, 0xab, 0xfc, 0xc5, 0x42, 0xb3, 0x8f, 0x40,
-	0x4a, 0xdc, 0x82, 0x00, 0x42, 0xa0, 0xdb, 0x40, 0x9c, 0xff, 0x91, 0x6d, 0x5e, 0xb7, 0xa9, 0x4a,
-	0x6e, 0x38, 0x28, 0xd9, 0xb5, 0x6a, 0x60, 0x53, 0xe2, 0x2c, 0xa1, 0xe9, 0x1e, 0xde, 0xed, 0x70,
-	0x4c, 0xea, 0x6d, 0x2c, 0xdb, 0xba, 0xd5, 0xe9, 0x20, 0x6f, 0x62, 0xf7, 0xb1, 0x6c, 0x3b, 0x1b,
-	0x28, 0x27, 0x81, 0x11, 0x10, 0xa6, 0x9f, 0xab, 0xde, 0xb1, 0xd3, 0x97, 0xce, 0x9f, 0x4e, 0x24,
-	0xb8, 0x94, 0x5a, 0x0c, 0x63, 0xf2, 0xd8, 0xb9, 0x04, 0xc5, 0xd9, 0x42, 0x53, 0xe9, 0x6b, 0x60,
-	0x5c, 0x3f, 0x2d, 0xe4, 0x21, 0x50, 0xf9, 0xb5, 0x8d, 0x0a, 0x66, 0xe8, 0x4d, 0xc1, 0x43, 0x90,
-	0x92, 0xb2, 0xd6, 0x77, 0xc7, 0x6e, 0xa2, 0x69, 0xc9, 0x23, 0x11, 0xc2, 0xd9, 0xaf, 0x43, 0x3e,
-	0x01, 0x4e, 0x76, 0xa1, 0x87, 0x0a, 0x04, 0xa4, 0xa2, 0x4c, 0xfb, 0x65, 0xc8, 0xb2, 0x67, 0x47,
-	0x36, 0x77, 0x04, 0x5d, 0x47, 0xcb, 0xaf, 0xec, 0xd4, 0xfc, 0x7b, 0x7d, 0x08, 0x23, 0xf5, 0x03,
-	0xf3, 0xff, 0x5c, 0x15, 0x5e, 0xd8, 0xe8, 0x3f, 0xa3, 0xc2, 0x3a, 0xa6, 0x9d, 0x73, 0xa9, 0xc1,
-	0x4b, 0x1b, 0xcd, 0xa4, 0x67, 0x40, 0x09, 0x7a, 0x2e, 0x45, 0xa8, 0xa3, 0xc2, 0x13, 0x2c, 0xbb,
-	0x63, 0x1d, 0xb8, 0xde, 0x87, 0x07, 0xe8, 0x9f, 0x6e, 0x12, 0xd0, 0x5a, 0xe4, 0x57, 0x2f, 0xfd,
-	0xe4, 0xfe, 0x1c, 0x81, 0x30, 0x97, 0x67, 0x0c, 0x50, 0xd9, 0xdc, 0x1b, 0xba, 0xd6, 0xfe, 0xd0,
-	0xb5, 0x3e, 0x0d, 0x5d, 0xeb, 0xdd, 0xc8, 0xcd, 0xec, 0x8d, 0x5c, 0x6b, 0x7f, 0xe4, 0x66, 0x3e,
-	0x8c, 0xdc, 0xcc, 0xb3, 0xd5, 0x5f, 0x9a, 0x48, 0x7f, 0xc8, 0x35, 0x72, 0xfa, 0xce, 0x5f, 0xfb,
-	0x1a, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x88, 0xf7, 0xfe, 0x85, 0x0a, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xae, 0x7f, 0x14, 0x3f, 0xa7, 0xf9, 0xb1, 0x84, 0xca, 0xad, 0xc4, 0x3a, 0xf5, 0x29,
+	0xfc, 0xda, 0x25, 0xa9, 0x2a, 0x0e, 0x1c, 0xa0, 0x8e, 0x1b, 0x61, 0x04, 0x51, 0xb5, 0x2a, 0x42,
+	0x70, 0x89, 0xc6, 0x3b, 0xcf, 0xf6, 0xa8, 0xf6, 0x8e, 0x35, 0x33, 0x9b, 0xb8, 0x57, 0x10, 0x87,
+	0x1e, 0x90, 0x38, 0xc2, 0x89, 0x3f, 0x01, 0xf1, 0x5f, 0xe4, 0xd8, 0x13, 0x42, 0x1c, 0x0c, 0x72,
+	0xfe, 0x03, 0x8e, 0x3d, 0xa1, 0xdd, 0x99, 0x5d, 0xdb, 0x91, 0x50, 0x13, 0x9a, 0xd2, 0x43, 0x72,
+	0xdb, 0x7d, 0xfb, 0xbd, 0xef, 0xbd, 0xfd, 0xde, 0xdb, 0xcf, 0x63, 0xb8, 0x4d, 0xc6, 0x38, 0x20,
+	0xc2, 0x8f, 0x70, 0x1c, 0x4b, 0xff, 0x70, 0xbb, 0x83, 0x8a, 0x6c, 0xfb, 0x78, 0x88, 0x91, 0x92,
+	0xde, 0x48, 0x70, 0xc5, 0x9d, 0x0d, 0x0d, 0xf1, 0x52, 0x88, 0x67, 0x20, 0xb7, 0xdc, 0x1e, 0xe7,
+	0xbd, 0x01, 0xfa, 0x29, 0xa6, 0x13, 0x77, 0x7d, 0x1a, 0x0b, 0xa2, 0x18, 0x8f, 0x74, 0xd6, 0xad,
+	0x8d, 0x1e, 0xef, 0xf1, 0xf4, 0xd2, 0x4f, 0xae, 0x4c, 0xd4, 0x0d, 0xb9, 0x1c, 0x72, 0xe9, 0x77,
+	0x88, 0xc4, 0xbc, 0x5a, 0xc8, 0x59, 0x96, 0xf5, 0xd6, 0x42, 0x3b, 0x38, 0x1e, 0x71, 0xa1, 0x90,
+	0xe6, 0x48, 0xf5, 0x78, 0x84, 0xa6, 0xad, 0xc6, 0x93, 0x02, 0x54, 0xf7, 0x10, 0x5b, 0x48, 0xe3,
+	0x50, 0x21, 0x75, 0x24, 0x54, 0x95, 0x20, 0x91, 0xec, 0xa2, 0x38, 0x60, 0xb4, 0x66, 0x6d, 0x5a,
+	0x5b, 0xc5, 0x66, 0x30, 0x9d, 0xd4, 0xe1, 0xa1, 0x09, 0xb7, 0x5b, 0xcf, 0x26, 0xf5, 0x8f, 0x7b,
+	0x4c, 0xf5, 0xe3, 0x8e, 0x17, 0xf2, 0xa1, 0xaf, 0x8b, 0x45, 0xa8, 0x8e, 0xb8, 0x78, 0x64, 0xee,
+	0xde, 0x0b, 0xb9, 0x40, 0x7f, 0x7c, 0xaa, 0x03, 0x6f, 0xc6, 0x11, 0x40, 0x56, 0xa6, 0x4d, 0x9d,
+	0x01, 0xac, 0x0a, 0x0c, 0xd9, 0x88, 0x61, 0xa4, 0x0e, 0xc2, 0x3e, 0x61, 0x51, 0xcd, 0xde, 0xb4,
+	0xb6, 0x2a, 0xcd, 0xdd, 0x67, 0x93, 0xfa, 0x47, 0xff, 0xad, 0xd4, 0x6e, 0x42, 0xb3, 0x4f, 0x86,
+	0x18, 0xac, 0xe4, 0xdc, 0x69, 0xcc, 0x79, 0x07, 0xd6, 0x67, 0xd5, 0x08, 0xa5, 0x02, 0xa5, 0xac,
+	0x15, 0x92, 0x7a, 0xc1, 0x5a, 0xfe, 0xe0, 0x9e, 0x8e, 0x3b, 0x1f, 0x40, 0x99, 0x0c, 0x79, 0x1c,
+	0xa9, 0x5a, 0x71, 0xd3, 0xda, 0xaa, 0xee, 0xdc, 0xf4, 0xb4, 0xf6, 0x5e, 0xa2, 0x7d, 0x36, 0x46,
+	0x6f, 0x97, 0xb3, 0xa8, 0x59, 0x3c, 0x9e, 0xd4, 0x97, 0x02, 0x03, 0x77, 0xb6, 0xa1, 0xd0, 0x45,
+	0xac, 0x95, 0xce, 0x96, 0x95, 0x60, 0x1b, 0xdf, 0x17, 0x60, 0xb5, 0x1d, 0xc9, 0xb8, 0xdb, 0x65,
+	0x61, 0xd2, 0xc3, 0x1e, 0xe2, 0xd5, 0x3c, 0x5e, 0xe1, 0x3c, 0x7e, 0xb5, 0x61, 0x2d, 0x20, 0x0a,
+	0x3f, 0x63, 0x43, 0xa6, 0xbe, 0x18, 0x51, 0x92, 0x7c, 0x20, 0x5f, 0x41, 0x49, 0x2b, 0x62, 0x5d,
+	0x9c, 0x22, 0x9a, 0xd1, 0xb9, 0x0b, 0xa5, 0x41, 0x52, 0xaa, 0x66, 0x9f, 0xad, 0x49, 0x8d, 0x76,
+	0x3e, 0x84, 0xf2, 0x11, 0x8b, 0x28, 0x3f, 0xaa, 0x15, 0x4c, 0x9e, 0x36, 0x15, 0x2f, 0x33, 0x15,
+	0xaf, 0x65, 0x4c, 0xa5, 0xf9, 0x5a, 0x92, 0xf7, 0xe3, 0x9f, 0x75, 0x2b, 0x30, 0x29, 0xce, 0x3e,
+	0x54, 0x28, 0x13, 0x18, 0x26, 0x8f, 0x53, 0x49, 0x57, 0x76, 0xde, 0xf7, 0x16, 0xac, 0x2a, 0xef,
+	0x37, 0xeb, 0x20, 0x5b, 0x9a, 0x56, 0x96, 0x17, 0xcc, 0x28, 0x1a, 0xbf, 0x15, 0xe0, 0x66, 0x06,
+	0xc8, 0xb5, 0xbb, 0x3f, 0x0e, 0x11, 0xe9, 0xcb, 0x15, 0x6f, 0xb6, 0x18, 0xf6, 0xf9, 0x16, 0x63,
+	0x41, 0x81, 0xc2, 0x0b, 0x2b, 0x30, 0x9b, 0x62, 0xf1, 0x5c, 0x53, 0xbc, 0x03, 0xa5, 0x58, 0x92,
+	0x9e, 0xde, 0xd0, 0xe5, 0xe6, 0x9b, 0xc9, 0xb3, 0x3f, 0x26, 0xf5, 0x37, 0x74, 0xb6, 0xa4, 0x8f,
+	0x3c, 0xc6, 0xfd, 0x21, 0x51, 0x7d, 0xaf, 0x1d, 0xa9, 0x40, 0x63, 0xe7, 0x46, 0x5f, 0x3e, 0xff,
+	0xe8, 0xdf, 0x05, 0x18, 0xa2, 0x4c, 0x78, 0x12, 0x67, 0xb9, 0x96, 0x4e, 0xe4, 0xfa, 0x74, 0x52,
+	0xaf, 0x7c, 0xae, 0xa3, 0xed, 0x56, 0x50, 0x31, 0x80, 0x36, 0x6d, 0x3c, 0xb1, 0x60, 0x23, 0x15,
+	0xfd, 0x5e, 0xac, 0x78, 0x0b, 0x49, 0xa8, 0xd8, 0xe1, 0xcb, 0xfe, 0x20, 0x6e, 0x40, 0x59, 0x20,
+	0x91, 0xdc, 0xd8, 0x4f, 0x60, 0xee, 0x1a, 0x7f, 0x5b, 0xb0, 0x6a, 0x9a, 0x0c, 0x30, 0x44, 0x76,
+	0x88, 0xd4, 0xb9, 0x01, 0xb6, 0xf1, 0xc7, 0x4a, 0xb3, 0x3c, 0x9d, 0xd4, 0xed, 0x76, 0x2b, 0xb0,
+	0x19, 0x75, 0x6e, 0xc3, 0xf2, 0x88, 0x3c, 0x1e, 0x70, 0x42, 0x0f, 0xfa, 0x44, 0xf6, 0x53, 0xa6,
+	0xe5, 0xa0, 0x6a, 0x62, 0x9f, 0x10, 0xd9, 0x77, 0xf6, 0xa1, 0x2c, 0x31, 0xa2, 0x28, 0xcc, 0x07,
+	0xf4, 0xbc, 0xf1, 0xef, 0x0a, 0x2e, 0xa5, 0xd6, 0x42, 0xbb, 0x52, 0xb6, 0x51, 0x9a, 0xc5, 0x79,
+	0x08, 0x95, 0xdc, 0xb7, 0x6a, 0xc5, 0x17, 0xa2, 0x9c, 0x11, 0x35, 0xbe, 0xb3, 0x61, 0xdd, 0xbc,
+	0xf4, 0x03, 0xc1, 0x43, 0x94, 0x92, 0x45, 0xbd, 0x7f, 0x7d, 0xed, 0x2e, 0x2c, 0x4b, 0x1e, 0x8b,
+	0x10, 0x2f, 0xde, 0xbf, 0xab, 0x9a, 0x38, 0x0d, 0x38, 0x23, 0x58, 0xa7, 0x28, 0x15, 0x8b, 0xd2,
+	0x2d, 0x33, 0xc5, 0x0a, 0x17, 0x57, 0x6c, 0x6d, 0x8e, 0x3d, 0x8d, 0x36, 0xbe, 0xb5, 0xf3, 0xe1,
+	0xdf, 0x1f, 0x63, 0x18, 0x2b, 0xa4, 0x97, 0x50, 0x85, 0x9f, 0x6c, 0xb8, 0x6e, 0x54, 0xd8, 0x23,
+	0x6c, 0x70, 0x19, 0x35, 0x98, 0xb3, 0x87, 0xe2, 0x82, 0x3d, 0xfc, 0x52, 0x98, 0xb3, 0x87, 0x6e,
+	0x1c, 0xd1, 0x4b, 0xa9, 0xce, 0xa9, 0x93, 0x63, 0xf1, 0x7f, 0x39, 0x39, 0xde, 0x85, 0x12, 0x91,
+	0x12, 0xd5, 0x59, 0xcf, 0x59, 0x1a, 0xdd, 0xf8, 0xd9, 0x86, 0xd7, 0x17, 0x26, 0x76, 0xb5, 0xd3,
+	0xa7, 0x76, 0xfa, 0x1b, 0x1b, 0x56, 0x72, 0x85, 0x94, 0x60, 0x97, 0xd2, 0xf4, 0x0e, 0x60, 0xfd,
+	0x4b, 0x22, 0x87, 0x99, 0x0e, 0x3c, 0xf5, 0xfe, 0x4f, 0xe1, 0x9a, 0x39, 0xa5, 0xa4, 0x5a, 0x54,
+	0x77, 0xde, 0x7e, 0xce, 0x6f, 0xed, 0x1c, 0x85, 0xd9, 0xc2, 0x8c, 0xa0, 0xf9, 0xe0, 0x78, 0xea,
+	0x5a, 0x4f, 0xa7, 0xae, 0xf5, 0xd7, 0xd4, 0xb5, 0x7e, 0x38, 0x71, 0x97, 0x8e, 0x4f, 0x5c, 0xeb,
+	0xe9, 0x89, 0xbb, 0xf4, 0xfb, 0x89, 0xbb, 0xf4, 0xf5, 0xce, 0xb9, 0xde, 0x28, 0xfd, 0x97, 0xdd,
+	0x29, 0xa7, 0x27, 0xb1, 0x3b, 0xff, 0x0c, 0x00, 0x7f, 0x54, 0x46, 0xc4, 0x22, 0x10, 0x00, 0x00,
 }
 
 func (m *FeeDeducted) Marshal() (dAtA []byte, err error) {
@@ -839,6 +1166,11 @@ func (m *RateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
 	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
 	if err5 != nil {
 		return 0, err5
@@ -867,7 +1199,7 @@ func (m *RateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
+func (m *TransferRateLimitExceeded) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -877,18 +1209,43 @@ func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageReceived) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferRateLimitExceeded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferRateLimitExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageID) > 0 {
+		i -= len(m.MessageID)
+		copy(dAtA[i:], m.MessageID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintEvents(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
 	{
-		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		size := m.Usage.Size()
+		i -= size
+		if _, err := m.Usage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -897,8 +1254,13 @@ func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
+	if m.Direction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -906,25 +1268,18 @@ func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.PayloadHash) > 0 {
-		i -= len(m.PayloadHash)
-		copy(dAtA[i:], m.PayloadHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+	dAtA[i] = 0x12
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
+func (m *ChainAutoDeactivated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -934,7 +1289,101 @@ func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageProcessing) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainAutoDeactivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainAutoDeactivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Recipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Sender.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageProcessing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageProcessing) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1032,6 +1481,123 @@ func (m *MessageFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TransferID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TransferID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRefundFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRefundFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRefundFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.DestinationChain) > 0 {
 		i -= len(m.DestinationChain)
 		copy(dAtA[i:], m.DestinationChain)
@@ -1206,6 +1772,54 @@ func (m *RateLimitUpdated) Size() (n int) {
 	n += 1 + l + sovEvents(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
 	n += 1 + l + sovEvents(uint64(l))
+	if m.Direction != 0 {
+		n += 1 + sovEvents(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *TransferRateLimitExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Direction != 0 {
+		n += 1 + sovEvents(uint64(m.Direction))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.MessageID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainAutoDeactivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -1290,10 +1904,14 @@ func (m *MessageFailed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
-func (m *MessageRetried) Size() (n int) {
+func (m *MessageRefunded) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1311,23 +1929,74 @@ func (m *MessageRetried) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.TransferID != 0 {
+		n += 1 + sovEvents(uint64(m.TransferID))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
-func (m *WasmMessageRouted) Size() (n int) {
+func (m *MessageRefundFailed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Message.Size()
-	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *MessageRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *WasmMessageRouted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozEvents(x uint64) (n int) {
 	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -1624,27 +2293,755 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimitExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimitExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimitExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainAutoDeactivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainAutoDeactivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainAutoDeactivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadHash == nil {
+				m.PayloadHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1671,13 +3068,13 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1704,7 +3101,7 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1729,7 +3126,7 @@ func (m *InsufficientFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
+func (m *MessageProcessing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,15 +3149,15 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimitUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageProcessing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageProcessing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1788,13 +3185,13 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1804,30 +3201,29 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1837,24 +3233,23 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1877,7 +3272,7 @@ func (m *RateLimitUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageReceived) Unmarshal(dAtA []byte) error {
+func (m *MessageExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,10 +3295,10 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1940,9 +3335,9 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1952,64 +3347,29 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.PayloadHash == nil {
-				m.PayloadHash = []byte{}
-			}
+			m.SourceChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2019,24 +3379,23 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2059,7 +3418,7 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageProcessing) Unmarshal(dAtA []byte) error {
+func (m *MessageFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2082,10 +3441,10 @@ func (m *MessageProcessing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageProcessing: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageProcessing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2184,6 +3543,38 @@ func (m *MessageProcessing) Unmarshal(dAtA []byte) error {
 			}
 			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2205,7 +3596,7 @@ func (m *MessageProcessing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageExecuted) Unmarshal(dAtA []byte) error {
+func (m *MessageRefunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2228,10 +3619,10 @@ func (m *MessageExecuted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageExecuted: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRefunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2330,6 +3721,58 @@ func (m *MessageExecuted) Unmarshal(dAtA []byte) error {
 			}
 			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferID", wireType)
+			}
+			m.TransferID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferID |= github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2351,7 +3794,7 @@ func (m *MessageExecuted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageFailed) Unmarshal(dAtA []byte) error {
+func (m *MessageRefundFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2374,10 +3817,10 @@ func (m *MessageFailed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageFailed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageRefundFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageRefundFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2476,6 +3919,38 @@ func (m *MessageFailed) Unmarshal(dAtA []byte) error {
 			}
 			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
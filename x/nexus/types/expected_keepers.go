@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -42,8 +43,10 @@ type Nexus interface {
 	GetChainMaintainerStates(ctx sdk.Context, chain exported.Chain) []exported.MaintainerState
 	LinkAddresses(ctx sdk.Context, sender exported.CrossChainAddress, recipient exported.CrossChainAddress) error
 	DeactivateChain(ctx sdk.Context, chain exported.Chain)
+	CountFailedChainPolls(ctx sdk.Context, chain exported.ChainName, window int) (uint64, uint64)
 	RegisterFee(ctx sdk.Context, chain exported.Chain, feeInfo exported.FeeInfo) error
 	GetFeeInfo(ctx sdk.Context, chain exported.Chain, asset string) exported.FeeInfo
+	SetRateLimit(ctx sdk.Context, chain exported.ChainName, limit sdk.Coin, window time.Duration, direction exported.TransferDirection) error
 	GenerateMessageID(ctx sdk.Context) (string, []byte, uint64)
 	SetNewMessage(ctx sdk.Context, msg exported.GeneralMessage) error
 	GetMessage(ctx sdk.Context, id string) (exported.GeneralMessage, bool)
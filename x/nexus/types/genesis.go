@@ -23,6 +23,9 @@ func NewGenesisState(
 	feeInfos []exported.FeeInfo,
 	messages []exported.GeneralMessage,
 	messageNonce uint64,
+	rateLimits []RateLimit,
+	transferEpochs []TransferEpoch,
+	chainPollStates []ChainPollState,
 ) *GenesisState {
 	return &GenesisState{
 		Params:          params,
@@ -35,6 +38,9 @@ func NewGenesisState(
 		FeeInfos:        feeInfos,
 		Messages:        messages,
 		MessageNonce:    messageNonce,
+		RateLimits:      rateLimits,
+		TransferEpochs:  transferEpochs,
+		ChainPollStates: chainPollStates,
 	}
 }
 
@@ -54,6 +60,9 @@ func DefaultGenesisState() *GenesisState {
 		[]exported.FeeInfo{},
 		[]exported.GeneralMessage{},
 		0,
+		[]RateLimit{},
+		[]TransferEpoch{},
+		[]ChainPollState{},
 	)
 }
 
@@ -103,6 +112,24 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	for _, rateLimit := range m.RateLimits {
+		if err := rateLimit.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	for _, transferEpoch := range m.TransferEpochs {
+		if err := transferEpoch.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
+	for _, chainPollState := range m.ChainPollStates {
+		if err := chainPollState.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -38,6 +38,7 @@ type GenesisState struct {
 	TransferEpochs  []TransferEpoch               `protobuf:"bytes,10,rep,name=transfer_epochs,json=transferEpochs,proto3" json:"transfer_epochs"`
 	Messages        []exported.GeneralMessage     `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages"`
 	MessageNonce    uint64                        `protobuf:"varint,12,opt,name=message_nonce,json=messageNonce,proto3" json:"message_nonce,omitempty"`
+	ChainPollStates []ChainPollState              `protobuf:"bytes,13,rep,name=chain_poll_states,json=chainPollStates,proto3" json:"chain_poll_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,40 +83,42 @@ func init() {
 }
 
 var fileDescriptor_e1baa72d54b23810 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x8f, 0x12, 0x4d,
-	0x10, 0x87, 0x99, 0x17, 0x96, 0x17, 0x1a, 0x74, 0x4d, 0x87, 0x43, 0x87, 0x98, 0x59, 0xdc, 0x55,
-	0x83, 0x26, 0x3b, 0x13, 0xf0, 0xe6, 0x4d, 0x8c, 0x18, 0x92, 0x55, 0x37, 0xf8, 0xe7, 0xe0, 0x65,
-	0xd2, 0x0c, 0x35, 0x30, 0x59, 0x98, 0x9e, 0x74, 0xf5, 0x2a, 0x7e, 0x0b, 0xbf, 0x91, 0x57, 0x8e,
-	0x7b, 0xf4, 0x64, 0x14, 0xbe, 0x88, 0x99, 0x9e, 0x6e, 0x5c, 0x92, 0x49, 0xb8, 0x75, 0x57, 0x9e,
-	0x7a, 0xa8, 0x1f, 0xd3, 0x45, 0x4e, 0xf9, 0x0a, 0x16, 0x5c, 0xfa, 0x09, 0xac, 0xae, 0xd1, 0xff,
-	0xd2, 0x9b, 0x80, 0xe2, 0x3d, 0x7f, 0x06, 0x09, 0x60, 0x8c, 0x5e, 0x2a, 0x85, 0x12, 0xb4, 0x95,
-	0x33, 0x9e, 0x66, 0x3c, 0xc3, 0xb4, 0x5b, 0x33, 0x31, 0x13, 0x1a, 0xf0, 0xb3, 0x53, 0xce, 0xb6,
-	0x1f, 0x14, 0xfa, 0x52, 0x2e, 0xf9, 0xd2, 0xe8, 0xda, 0x4f, 0xf6, 0x10, 0x58, 0xa5, 0x42, 0x2a,
-	0x98, 0xee, 0x58, 0xf5, 0x2d, 0x05, 0x8b, 0x76, 0x0a, 0x6d, 0xb7, 0x88, 0xd3, 0x1f, 0x55, 0xd2,
-	0x7c, 0x9d, 0x4f, 0xfb, 0x5e, 0x71, 0x05, 0xf4, 0x39, 0xa9, 0xe6, 0xbf, 0xc6, 0x9c, 0x8e, 0xd3,
-	0x6d, 0xf4, 0xef, 0x7b, 0x45, 0xd3, 0x7b, 0x97, 0x9a, 0x19, 0x54, 0xd6, 0xbf, 0x4e, 0x4a, 0x63,
-	0xd3, 0x41, 0x5b, 0xe4, 0x28, 0x11, 0x49, 0x08, 0xec, 0xbf, 0x8e, 0xd3, 0xad, 0x8c, 0xf3, 0x0b,
-	0x1d, 0x90, 0x6a, 0x38, 0xe7, 0x71, 0x82, 0xac, 0xdc, 0x29, 0x77, 0x1b, 0xfd, 0x87, 0xfb, 0x46,
-	0x1b, 0x60, 0xa7, 0x7e, 0x99, 0xc1, 0xd6, 0x9c, 0x77, 0xd2, 0x11, 0x69, 0xea, 0x53, 0x80, 0xd9,
-	0x90, 0xc8, 0x2a, 0xda, 0xd4, 0x29, 0x9e, 0x4d, 0x0b, 0x74, 0x1a, 0x63, 0x69, 0x84, 0xbb, 0x0a,
-	0xd2, 0x4f, 0xe4, 0xde, 0x22, 0x4e, 0xae, 0x60, 0x1a, 0xf0, 0xe9, 0x54, 0x02, 0x22, 0x20, 0x3b,
-	0xd2, 0xba, 0x47, 0xc5, 0xba, 0x0b, 0x4d, 0xbf, 0xb0, 0xb0, 0x71, 0x1e, 0x2f, 0xf6, 0xcb, 0xf4,
-	0x23, 0xa9, 0x2b, 0xc9, 0x13, 0x8c, 0x40, 0x22, 0xab, 0x6a, 0x61, 0xef, 0x50, 0x52, 0x29, 0x10,
-	0xf5, 0xb4, 0x1f, 0x4c, 0xa7, 0x91, 0xff, 0x33, 0xd1, 0x01, 0x29, 0x47, 0x00, 0xec, 0x7f, 0xfd,
-	0x31, 0x9e, 0x1e, 0x10, 0x5a, 0xcd, 0x10, 0x6c, 0xf4, 0xac, 0x99, 0x8e, 0x48, 0x3d, 0x02, 0x08,
-	0xe2, 0x24, 0x12, 0xc8, 0x6a, 0x7a, 0xb4, 0xc7, 0x07, 0x4c, 0x43, 0x80, 0x51, 0x12, 0x09, 0x63,
-	0xa9, 0x45, 0xf9, 0x15, 0xe9, 0x90, 0x34, 0x24, 0x57, 0x10, 0x2c, 0xe2, 0x65, 0xac, 0x90, 0xd5,
-	0xb5, 0xec, 0xa4, 0xf8, 0x8f, 0x1b, 0x73, 0x05, 0x17, 0x19, 0x67, 0x2c, 0x44, 0xda, 0x02, 0xd2,
-	0x31, 0x39, 0xb6, 0x19, 0x03, 0x48, 0x45, 0x38, 0x47, 0x46, 0xb4, 0xeb, 0xac, 0xd8, 0x65, 0x93,
-	0xbd, 0xca, 0x58, 0xe3, 0xbb, 0xab, 0x6e, 0x17, 0x91, 0xbe, 0x23, 0xb5, 0x25, 0x20, 0xf2, 0x19,
-	0x20, 0x6b, 0x68, 0xd9, 0xf9, 0x81, 0x94, 0xd9, 0xcb, 0x97, 0x7c, 0xf1, 0x26, 0xef, 0xb2, 0x61,
-	0xad, 0x84, 0x9e, 0x91, 0x3b, 0xe6, 0x1c, 0xe4, 0xef, 0xba, 0xa9, 0xdf, 0x75, 0xd3, 0x14, 0xdf,
-	0x66, 0xb5, 0xc1, 0xe5, 0xfa, 0x8f, 0x5b, 0x5a, 0x6f, 0x5c, 0xe7, 0x66, 0xe3, 0x3a, 0xbf, 0x37,
-	0xae, 0xf3, 0x7d, 0xeb, 0x96, 0x6e, 0xb6, 0x6e, 0xe9, 0xe7, 0xd6, 0x2d, 0x7d, 0xee, 0xcf, 0x62,
-	0x35, 0xbf, 0x9e, 0x78, 0xa1, 0x58, 0xfa, 0xf9, 0x2c, 0x09, 0xa8, 0xaf, 0x42, 0x5e, 0x99, 0xdb,
-	0x79, 0x28, 0x24, 0xf8, 0x2b, 0xb3, 0xa1, 0x7a, 0x33, 0x27, 0x55, 0xbd, 0x9a, 0xcf, 0xfe, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xad, 0xf4, 0x1b, 0xf4, 0x5c, 0x04, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x8f, 0xd2, 0x4e,
+	0x14, 0xc7, 0xe9, 0x6f, 0x77, 0xd9, 0x65, 0x60, 0x7f, 0xe8, 0x84, 0x43, 0x43, 0x4c, 0x17, 0x77,
+	0xd5, 0xa0, 0xc9, 0xb6, 0x01, 0x6f, 0xde, 0xc4, 0x88, 0x21, 0x59, 0x95, 0xa0, 0xee, 0xc1, 0x4b,
+	0x33, 0x94, 0x57, 0x68, 0xb6, 0x74, 0x9a, 0x79, 0xb3, 0x8a, 0xff, 0x85, 0x37, 0xff, 0x25, 0x8e,
+	0x7b, 0xf4, 0x64, 0x14, 0xfe, 0x11, 0xd3, 0xe9, 0x0c, 0x42, 0xd2, 0x84, 0xdb, 0xcc, 0xcb, 0xe7,
+	0x7d, 0xfa, 0x1e, 0x7c, 0x87, 0x9c, 0xb3, 0x05, 0xc4, 0x4c, 0x78, 0x09, 0x2c, 0x6e, 0xd1, 0xfb,
+	0xd2, 0x19, 0x83, 0x64, 0x1d, 0x6f, 0x0a, 0x09, 0x60, 0x84, 0x6e, 0x2a, 0xb8, 0xe4, 0xb4, 0x91,
+	0x33, 0xae, 0x62, 0x5c, 0xcd, 0x34, 0x1b, 0x53, 0x3e, 0xe5, 0x0a, 0xf0, 0xb2, 0x53, 0xce, 0x36,
+	0x1f, 0x16, 0xfa, 0x52, 0x26, 0xd8, 0x5c, 0xeb, 0x9a, 0x4f, 0x77, 0x10, 0x58, 0xa4, 0x5c, 0x48,
+	0x98, 0x6c, 0x58, 0xf9, 0x2d, 0x05, 0x83, 0xb6, 0x0a, 0x6d, 0x5b, 0xc4, 0xf9, 0x8f, 0x63, 0x52,
+	0x7b, 0x93, 0x4f, 0xfb, 0x41, 0x32, 0x09, 0xf4, 0x05, 0x29, 0xe7, 0x5f, 0xb3, 0xad, 0x96, 0xd5,
+	0xae, 0x76, 0x1f, 0xb8, 0x45, 0xd3, 0xbb, 0x43, 0xc5, 0xf4, 0x0e, 0x97, 0xbf, 0xce, 0x4a, 0x23,
+	0xdd, 0x41, 0x1b, 0xe4, 0x28, 0xe1, 0x49, 0x00, 0xf6, 0x7f, 0x2d, 0xab, 0x7d, 0x38, 0xca, 0x2f,
+	0xb4, 0x47, 0xca, 0xc1, 0x8c, 0x45, 0x09, 0xda, 0x07, 0xad, 0x83, 0x76, 0xb5, 0xfb, 0x68, 0xd7,
+	0x68, 0x16, 0xd8, 0xa8, 0x5f, 0x65, 0xb0, 0x31, 0xe7, 0x9d, 0x74, 0x40, 0x6a, 0xea, 0xe4, 0x63,
+	0x36, 0x24, 0xda, 0x87, 0xca, 0xd4, 0x2a, 0x9e, 0x4d, 0x09, 0xd4, 0x36, 0xda, 0x52, 0x0d, 0x36,
+	0x15, 0xa4, 0xd7, 0xe4, 0x5e, 0x1c, 0x25, 0x37, 0x30, 0xf1, 0xd9, 0x64, 0x22, 0x00, 0x11, 0xd0,
+	0x3e, 0x52, 0xba, 0xc7, 0xc5, 0xba, 0x2b, 0x45, 0xbf, 0x34, 0xb0, 0x76, 0xd6, 0xe3, 0xdd, 0x32,
+	0xfd, 0x44, 0x2a, 0x52, 0xb0, 0x04, 0x43, 0x10, 0x68, 0x97, 0x95, 0xb0, 0xb3, 0x6f, 0x53, 0xc1,
+	0x11, 0xd5, 0xb4, 0x1f, 0x75, 0xa7, 0x96, 0xff, 0x33, 0xd1, 0x1e, 0x39, 0x08, 0x01, 0xec, 0x63,
+	0xf5, 0x67, 0x3c, 0xdb, 0x23, 0x34, 0x9a, 0x3e, 0x98, 0xd5, 0xb3, 0x66, 0x3a, 0x20, 0x95, 0x10,
+	0xc0, 0x8f, 0x92, 0x90, 0xa3, 0x7d, 0xa2, 0x46, 0x7b, 0xb2, 0xc7, 0xd4, 0x07, 0x18, 0x24, 0x21,
+	0xd7, 0x96, 0x93, 0x30, 0xbf, 0x22, 0xed, 0x93, 0xaa, 0x60, 0x12, 0xfc, 0x38, 0x9a, 0x47, 0x12,
+	0xed, 0x8a, 0x92, 0x9d, 0x15, 0xff, 0x70, 0x23, 0x26, 0xe1, 0x2a, 0xe3, 0xb4, 0x85, 0x08, 0x53,
+	0x40, 0x3a, 0x22, 0x75, 0xb3, 0xa3, 0x0f, 0x29, 0x0f, 0x66, 0x68, 0x13, 0xe5, 0xba, 0x28, 0x76,
+	0x99, 0xcd, 0x5e, 0x67, 0xac, 0xf6, 0xfd, 0x2f, 0xb7, 0x8b, 0x48, 0xdf, 0x93, 0x93, 0x39, 0x20,
+	0xb2, 0x29, 0xa0, 0x5d, 0x55, 0xb2, 0xcb, 0x3d, 0x5b, 0x66, 0xc9, 0x17, 0x2c, 0x7e, 0x9b, 0x77,
+	0x99, 0x65, 0x8d, 0x84, 0x5e, 0x90, 0x53, 0x7d, 0xf6, 0xf3, 0x5c, 0xd7, 0x54, 0xae, 0x6b, 0xba,
+	0xf8, 0x4e, 0xc5, 0xfb, 0x9a, 0xdc, 0xcf, 0xa3, 0x99, 0xf2, 0x38, 0x36, 0xf9, 0x3c, 0x2d, 0x4a,
+	0xfa, 0x4e, 0x3e, 0x87, 0x3c, 0x8e, 0xb7, 0x33, 0x5a, 0x0f, 0x76, 0xaa, 0xd8, 0x1b, 0x2e, 0xff,
+	0x38, 0xa5, 0xe5, 0xca, 0xb1, 0xee, 0x56, 0x8e, 0xf5, 0x7b, 0xe5, 0x58, 0xdf, 0xd7, 0x4e, 0xe9,
+	0x6e, 0xed, 0x94, 0x7e, 0xae, 0x9d, 0xd2, 0xe7, 0xee, 0x34, 0x92, 0xb3, 0xdb, 0xb1, 0x1b, 0xf0,
+	0xb9, 0x97, 0x7f, 0x24, 0x01, 0xf9, 0x95, 0x8b, 0x1b, 0x7d, 0xbb, 0x0c, 0xb8, 0x00, 0x6f, 0xa1,
+	0x5f, 0xbe, 0x7a, 0xf1, 0xe3, 0xb2, 0x7a, 0xf2, 0xcf, 0xff, 0x0e, 0x00, 0x38, 0xed, 0x6e, 0x2c,
+	0xb4, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainPollStates) > 0 {
+		for iNdEx := len(m.ChainPollStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainPollStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.MessageNonce != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MessageNonce))
 		i--
@@ -358,6 +375,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.MessageNonce != 0 {
 		n += 1 + sovGenesis(uint64(m.MessageNonce))
 	}
+	if len(m.ChainPollStates) > 0 {
+		for _, e := range m.ChainPollStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -772,6 +795,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPollStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainPollStates = append(m.ChainPollStates, ChainPollState{})
+			if err := m.ChainPollStates[len(m.ChainPollStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,6 +17,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"sync"
+	"time"
 )
 
 // Ensure, that NexusMock does implement nexustypes.Nexus.
@@ -38,6 +39,9 @@ var _ nexustypes.Nexus = &NexusMock{}
 //			AddChainMaintainerFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, validator cosmossdktypes.ValAddress) error {
 //				panic("mock out the AddChainMaintainer method")
 //			},
+//			CountFailedChainPollsFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, window int) (uint64, uint64) {
+//				panic("mock out the CountFailedChainPolls method")
+//			},
 //			CurrIDFunc: func(ctx cosmossdktypes.Context) ([32]byte, uint64) {
 //				panic("mock out the CurrID method")
 //			},
@@ -122,6 +126,9 @@ var _ nexustypes.Nexus = &NexusMock{}
 //			SetParamsFunc: func(ctx cosmossdktypes.Context, p nexustypes.Params)  {
 //				panic("mock out the SetParams method")
 //			},
+//			SetRateLimitFunc: func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+//				panic("mock out the SetRateLimit method")
+//			},
 //		}
 //
 //		// use mockedNexus in code that requires nexustypes.Nexus
@@ -138,6 +145,9 @@ type NexusMock struct {
 	// AddChainMaintainerFunc mocks the AddChainMaintainer method.
 	AddChainMaintainerFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.Chain, validator cosmossdktypes.ValAddress) error
 
+	// CountFailedChainPollsFunc mocks the CountFailedChainPolls method.
+	CountFailedChainPollsFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, window int) (uint64, uint64)
+
 	// CurrIDFunc mocks the CurrID method.
 	CurrIDFunc func(ctx cosmossdktypes.Context) ([32]byte, uint64)
 
@@ -222,6 +232,9 @@ type NexusMock struct {
 	// SetParamsFunc mocks the SetParams method.
 	SetParamsFunc func(ctx cosmossdktypes.Context, p nexustypes.Params)
 
+	// SetRateLimitFunc mocks the SetRateLimit method.
+	SetRateLimitFunc func(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ActivateChain holds details about calls to the ActivateChain method.
@@ -245,6 +258,15 @@ type NexusMock struct {
 			// Validator is the validator argument value.
 			Validator cosmossdktypes.ValAddress
 		}
+		// CountFailedChainPolls holds details about calls to the CountFailedChainPolls method.
+		CountFailedChainPolls []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+			// Window is the window argument value.
+			Window int
+		}
 		// CurrID holds details about calls to the CurrID method.
 		CurrID []struct {
 			// Ctx is the ctx argument value.
@@ -437,10 +459,24 @@ type NexusMock struct {
 			// P is the p argument value.
 			P nexustypes.Params
 		}
+		// SetRateLimit holds details about calls to the SetRateLimit method.
+		SetRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+			// Limit is the limit argument value.
+			Limit cosmossdktypes.Coin
+			// Window is the window argument value.
+			Window time.Duration
+			// Direction is the direction argument value.
+			Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+		}
 	}
 	lockActivateChain             sync.RWMutex
 	lockActivateWasmConnection    sync.RWMutex
 	lockAddChainMaintainer        sync.RWMutex
+	lockCountFailedChainPolls     sync.RWMutex
 	lockCurrID                    sync.RWMutex
 	lockDeactivateChain           sync.RWMutex
 	lockDeactivateWasmConnection  sync.RWMutex
@@ -469,6 +505,7 @@ type NexusMock struct {
 	lockSetMessageExecuted        sync.RWMutex
 	lockSetNewMessage             sync.RWMutex
 	lockSetParams                 sync.RWMutex
+	lockSetRateLimit              sync.RWMutex
 }
 
 // ActivateChain calls ActivateChainFunc.
@@ -579,6 +616,46 @@ func (mock *NexusMock) AddChainMaintainerCalls() []struct {
 	return calls
 }
 
+// CountFailedChainPolls calls CountFailedChainPollsFunc.
+func (mock *NexusMock) CountFailedChainPolls(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, window int) (uint64, uint64) {
+	if mock.CountFailedChainPollsFunc == nil {
+		panic("NexusMock.CountFailedChainPollsFunc: method is nil but Nexus.CountFailedChainPolls was just called")
+	}
+	callInfo := struct {
+		Ctx    cosmossdktypes.Context
+		Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Window int
+	}{
+		Ctx:    ctx,
+		Chain:  chain,
+		Window: window,
+	}
+	mock.lockCountFailedChainPolls.Lock()
+	mock.calls.CountFailedChainPolls = append(mock.calls.CountFailedChainPolls, callInfo)
+	mock.lockCountFailedChainPolls.Unlock()
+	return mock.CountFailedChainPollsFunc(ctx, chain, window)
+}
+
+// CountFailedChainPollsCalls gets all the calls that were made to CountFailedChainPolls.
+// Check the length with:
+//
+//	len(mockedNexus.CountFailedChainPollsCalls())
+func (mock *NexusMock) CountFailedChainPollsCalls() []struct {
+	Ctx    cosmossdktypes.Context
+	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	Window int
+} {
+	var calls []struct {
+		Ctx    cosmossdktypes.Context
+		Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Window int
+	}
+	mock.lockCountFailedChainPolls.RLock()
+	calls = mock.calls.CountFailedChainPolls
+	mock.lockCountFailedChainPolls.RUnlock()
+	return calls
+}
+
 // CurrID calls CurrIDFunc.
 func (mock *NexusMock) CurrID(ctx cosmossdktypes.Context) ([32]byte, uint64) {
 	if mock.CurrIDFunc == nil {
@@ -1579,6 +1656,54 @@ func (mock *NexusMock) SetParamsCalls() []struct {
 	return calls
 }
 
+// SetRateLimit calls SetRateLimitFunc.
+func (mock *NexusMock) SetRateLimit(ctx cosmossdktypes.Context, chain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName, limit cosmossdktypes.Coin, window time.Duration, direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection) error {
+	if mock.SetRateLimitFunc == nil {
+		panic("NexusMock.SetRateLimitFunc: method is nil but Nexus.SetRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Limit     cosmossdktypes.Coin
+		Window    time.Duration
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}{
+		Ctx:       ctx,
+		Chain:     chain,
+		Limit:     limit,
+		Window:    window,
+		Direction: direction,
+	}
+	mock.lockSetRateLimit.Lock()
+	mock.calls.SetRateLimit = append(mock.calls.SetRateLimit, callInfo)
+	mock.lockSetRateLimit.Unlock()
+	return mock.SetRateLimitFunc(ctx, chain, limit, window, direction)
+}
+
+// SetRateLimitCalls gets all the calls that were made to SetRateLimit.
+// Check the length with:
+//
+//	len(mockedNexus.SetRateLimitCalls())
+func (mock *NexusMock) SetRateLimitCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+	Limit     cosmossdktypes.Coin
+	Window    time.Duration
+	Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		Chain     github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName
+		Limit     cosmossdktypes.Coin
+		Window    time.Duration
+		Direction github_com_axelarnetwork_axelar_core_x_nexus_exported.TransferDirection
+	}
+	mock.lockSetRateLimit.RLock()
+	calls = mock.calls.SetRateLimit
+	mock.lockSetRateLimit.RUnlock()
+	return calls
+}
+
 // Ensure, that SnapshotterMock does implement nexustypes.Snapshotter.
 // If this is not the case, regenerate this file with moq.
 var _ nexustypes.Snapshotter = &SnapshotterMock{}
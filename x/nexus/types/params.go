@@ -24,6 +24,16 @@ var (
 	KeyGateway = []byte("gateway")
 	// KeyEndBlockerLimit represents the key for the end blocker limit
 	KeyEndBlockerLimit = []byte("endBlockerLimit")
+	// KeyChainAutoDeactivationEnabled represents the key for enabling the automatic chain deactivation
+	KeyChainAutoDeactivationEnabled = []byte("chainAutoDeactivationEnabled")
+	// KeyChainFailedPollThreshold represents the key for chain failed poll threshold
+	KeyChainFailedPollThreshold = []byte("chainFailedPollThreshold")
+	// KeyChainMaintainerMassMissingVoteThreshold represents the key for chain maintainer mass missing vote threshold
+	KeyChainMaintainerMassMissingVoteThreshold = []byte("chainMaintainerMassMissingVoteThreshold")
+	// KeyMessageRefundMaxFailures represents the key for the number of failed delivery attempts after which a message's asset is refunded
+	KeyMessageRefundMaxFailures = []byte("messageRefundMaxFailures")
+	// KeyChainHealthMinPollCount represents the key for the minimum number of polls before a chain's health is checked
+	KeyChainHealthMinPollCount = []byte("chainHealthMinPollCount")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -34,12 +44,17 @@ func KeyTable() params.KeyTable {
 // DefaultParams creates the default genesis parameters
 func DefaultParams() Params {
 	return Params{
-		ChainActivationThreshold:              utils.NewThreshold(55, 100),
-		ChainMaintainerMissingVoteThreshold:   utils.NewThreshold(20, 100),
-		ChainMaintainerIncorrectVoteThreshold: utils.NewThreshold(15, 100),
-		ChainMaintainerCheckWindow:            500,
-		Gateway:                               sdk.AccAddress{},
-		EndBlockerLimit:                       50,
+		ChainActivationThreshold:                utils.NewThreshold(55, 100),
+		ChainMaintainerMissingVoteThreshold:     utils.NewThreshold(20, 100),
+		ChainMaintainerIncorrectVoteThreshold:   utils.NewThreshold(15, 100),
+		ChainMaintainerCheckWindow:              500,
+		Gateway:                                 sdk.AccAddress{},
+		EndBlockerLimit:                         50,
+		ChainAutoDeactivationEnabled:            false,
+		ChainFailedPollThreshold:                utils.NewThreshold(50, 100),
+		ChainMaintainerMassMissingVoteThreshold: utils.NewThreshold(50, 100),
+		MessageRefundMaxFailures:                0,
+		ChainHealthMinPollCount:                 20,
 	}
 }
 
@@ -59,6 +74,11 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyChainMaintainerCheckWindow, &m.ChainMaintainerCheckWindow, validateChainMaintainerCheckWindow),
 		params.NewParamSetPair(KeyGateway, &m.Gateway, validateGateway),
 		params.NewParamSetPair(KeyEndBlockerLimit, &m.EndBlockerLimit, validateEndBlockerLimit),
+		params.NewParamSetPair(KeyChainAutoDeactivationEnabled, &m.ChainAutoDeactivationEnabled, validateChainAutoDeactivationEnabled),
+		params.NewParamSetPair(KeyChainFailedPollThreshold, &m.ChainFailedPollThreshold, validateThresholdWith("ChainFailedPollThreshold")),
+		params.NewParamSetPair(KeyChainMaintainerMassMissingVoteThreshold, &m.ChainMaintainerMassMissingVoteThreshold, validateThresholdWith("ChainMaintainerMassMissingVoteThreshold")),
+		params.NewParamSetPair(KeyMessageRefundMaxFailures, &m.MessageRefundMaxFailures, validateMessageRefundMaxFailures),
+		params.NewParamSetPair(KeyChainHealthMinPollCount, &m.ChainHealthMinPollCount, validateChainHealthMinPollCount),
 	}
 }
 
@@ -88,6 +108,26 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validateChainAutoDeactivationEnabled(m.ChainAutoDeactivationEnabled); err != nil {
+		return err
+	}
+
+	if err := validateThresholdWith("ChainFailedPollThreshold")(m.ChainFailedPollThreshold); err != nil {
+		return err
+	}
+
+	if err := validateThresholdWith("ChainMaintainerMassMissingVoteThreshold")(m.ChainMaintainerMassMissingVoteThreshold); err != nil {
+		return err
+	}
+
+	if err := validateMessageRefundMaxFailures(m.MessageRefundMaxFailures); err != nil {
+		return err
+	}
+
+	if err := validateChainHealthMinPollCount(m.ChainHealthMinPollCount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -151,3 +191,32 @@ func validateEndBlockerLimit(limit interface{}) error {
 
 	return nil
 }
+
+func validateChainAutoDeactivationEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type for ChainAutoDeactivationEnabled: %T", i)
+	}
+
+	return nil
+}
+
+func validateMessageRefundMaxFailures(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type for MessageRefundMaxFailures: %T", i)
+	}
+
+	return nil
+}
+
+func validateChainHealthMinPollCount(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for ChainHealthMinPollCount: %T", i)
+	}
+
+	if val == 0 {
+		return fmt.Errorf("ChainHealthMinPollCount must be >0")
+	}
+
+	return nil
+}
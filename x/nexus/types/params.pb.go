@@ -33,6 +33,22 @@ type Params struct {
 	ChainMaintainerCheckWindow            int32                                         `protobuf:"varint,4,opt,name=chain_maintainer_check_window,json=chainMaintainerCheckWindow,proto3" json:"chain_maintainer_check_window,omitempty"`
 	Gateway                               github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=gateway,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"gateway,omitempty"`
 	EndBlockerLimit                       uint64                                        `protobuf:"varint,6,opt,name=end_blocker_limit,json=endBlockerLimit,proto3" json:"end_blocker_limit,omitempty"`
+	// enables the automatic deactivation of chains whose polls keep failing or
+	// whose maintainers keep missing votes
+	ChainAutoDeactivationEnabled bool `protobuf:"varint,7,opt,name=chain_auto_deactivation_enabled,json=chainAutoDeactivationEnabled,proto3" json:"chain_auto_deactivation_enabled,omitempty"`
+	// maximum share of the last chain_maintainer_check_window polls of a chain
+	// that can fail or expire before the chain is deactivated
+	ChainFailedPollThreshold utils.Threshold `protobuf:"bytes,8,opt,name=chain_failed_poll_threshold,json=chainFailedPollThreshold,proto3" json:"chain_failed_poll_threshold"`
+	// maximum share of the maintainers of a chain that can exceed
+	// chain_maintainer_missing_vote_threshold before the chain is deactivated
+	ChainMaintainerMassMissingVoteThreshold utils.Threshold `protobuf:"bytes,9,opt,name=chain_maintainer_mass_missing_vote_threshold,json=chainMaintainerMassMissingVoteThreshold,proto3" json:"chain_maintainer_mass_missing_vote_threshold"`
+	// number of failed delivery attempts after which the asset of a general
+	// message is refunded to its sender, unless the message has its own refund
+	// policy. 0 disables refunds
+	MessageRefundMaxFailures uint64 `protobuf:"varint,10,opt,name=message_refund_max_failures,json=messageRefundMaxFailures,proto3" json:"message_refund_max_failures,omitempty"`
+	// minimum number of polls a chain must have had since its (re)activation
+	// before it can be deactivated automatically
+	ChainHealthMinPollCount uint64 `protobuf:"varint,11,opt,name=chain_health_min_poll_count,json=chainHealthMinPollCount,proto3" json:"chain_health_min_poll_count,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,34 +91,44 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/params.proto", fileDescriptor_c78ca34850cdc1ef) }
 
 var fileDescriptor_c78ca34850cdc1ef = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc7, 0x33, 0xda, 0xad, 0x30, 0x0a, 0x62, 0xd8, 0x43, 0x28, 0x98, 0xd6, 0x7f, 0x58, 0x85,
-	0x26, 0x74, 0x7d, 0x82, 0xd6, 0x93, 0xe8, 0xc2, 0x52, 0x44, 0xc1, 0x4b, 0x98, 0x4e, 0x7e, 0x24,
-	0x43, 0x92, 0xf9, 0x95, 0x99, 0x69, 0xd3, 0xc5, 0x83, 0xaf, 0xe0, 0x5b, 0xf8, 0x2a, 0x3d, 0xee,
-	0xd1, 0xd3, 0xa2, 0xed, 0x5b, 0x78, 0x92, 0x4e, 0xfe, 0xec, 0x6e, 0xf7, 0xb4, 0xa7, 0x36, 0x93,
-	0x0f, 0x9f, 0xcf, 0x97, 0x30, 0xf4, 0x19, 0x5b, 0x43, 0xce, 0x54, 0x28, 0x61, 0xbd, 0xd4, 0xe1,
-	0x6a, 0x3c, 0x07, 0xc3, 0xc6, 0xe1, 0x82, 0x29, 0x56, 0xe8, 0x60, 0xa1, 0xd0, 0xa0, 0x7b, 0x5c,
-	0x21, 0x81, 0x45, 0x82, 0x1a, 0xe9, 0x1d, 0x27, 0x98, 0xa0, 0x05, 0xc2, 0xfd, 0xbf, 0x8a, 0xed,
-	0xbd, 0xac, 0x75, 0x4b, 0x23, 0xf2, 0x2b, 0x9d, 0x49, 0x15, 0xe8, 0x14, 0xf3, 0xb8, 0xa2, 0x9e,
-	0xff, 0xea, 0xd0, 0xee, 0x99, 0x4d, 0xb8, 0x9c, 0xf6, 0x78, 0xca, 0x84, 0x8c, 0x18, 0x37, 0x62,
-	0xc5, 0x8c, 0x40, 0x19, 0xb5, 0xb8, 0x47, 0x06, 0x64, 0xf8, 0xf0, 0xa4, 0x1f, 0xd4, 0x0b, 0xac,
-	0xb5, 0x59, 0x10, 0x7c, 0x6e, 0xb0, 0x69, 0x67, 0x73, 0xd9, 0x77, 0x66, 0x9e, 0x15, 0x4d, 0x5a,
-	0x4f, 0xfb, 0xde, 0xfd, 0x4e, 0x5f, 0x57, 0x91, 0x82, 0x09, 0x69, 0x98, 0x90, 0xa0, 0xa2, 0x42,
-	0x68, 0x2d, 0x64, 0x12, 0xad, 0xd0, 0xc0, 0xb5, 0xe2, 0xbd, 0xbb, 0x14, 0x5f, 0x58, 0xeb, 0x69,
-	0x2b, 0x3d, 0xad, 0x9c, 0x5f, 0xd0, 0xc0, 0x55, 0xfc, 0x07, 0x7d, 0x73, 0x2b, 0x2e, 0x24, 0x47,
-	0xa5, 0x80, 0x9b, 0xc3, 0xfc, 0xfd, 0xbb, 0xe4, 0x5f, 0x1d, 0xe4, 0x3f, 0x34, 0xd6, 0x9b, 0x03,
-	0x26, 0xf4, 0xe9, 0xad, 0x01, 0x3c, 0x05, 0x9e, 0x45, 0xa5, 0x90, 0x31, 0x96, 0x5e, 0x67, 0x40,
-	0x86, 0x47, 0xb3, 0xde, 0x81, 0xed, 0xfd, 0x1e, 0xf9, 0x6a, 0x09, 0xf7, 0x23, 0x7d, 0x90, 0x30,
-	0x03, 0x25, 0x3b, 0xf7, 0x8e, 0x06, 0x64, 0xf8, 0x68, 0x3a, 0xfe, 0x77, 0xd9, 0x1f, 0x25, 0xc2,
-	0xa4, 0xcb, 0x79, 0xc0, 0xb1, 0x08, 0x39, 0xea, 0x02, 0x75, 0xfd, 0x33, 0xd2, 0x71, 0x16, 0x9a,
-	0xf3, 0x05, 0xe8, 0x60, 0xc2, 0xf9, 0x24, 0x8e, 0x15, 0x68, 0x3d, 0x6b, 0x0c, 0xee, 0x5b, 0xfa,
-	0x04, 0x64, 0x1c, 0xcd, 0x73, 0xe4, 0x19, 0xa8, 0x28, 0x17, 0x85, 0x30, 0x5e, 0x77, 0x40, 0x86,
-	0x9d, 0xd9, 0x63, 0x90, 0xf1, 0xb4, 0x3a, 0xff, 0xb4, 0x3f, 0x9e, 0x9e, 0x6d, 0xfe, 0xfa, 0xce,
-	0x66, 0xeb, 0x93, 0x8b, 0xad, 0x4f, 0xfe, 0x6c, 0x7d, 0xf2, 0x73, 0xe7, 0x3b, 0x17, 0x3b, 0xdf,
-	0xf9, 0xbd, 0xf3, 0x9d, 0x6f, 0x27, 0xd7, 0x16, 0x54, 0x5f, 0x4c, 0x82, 0x29, 0x51, 0x65, 0xf5,
-	0xd3, 0x88, 0xa3, 0x82, 0x70, 0x5d, 0x5f, 0x6e, 0xbb, 0x68, 0xde, 0xb5, 0x57, 0xf0, 0xdd, 0xff,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x37, 0x0b, 0x37, 0xf9, 0x02, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0xd8, 0x1b, 0x1e, 0x12, 0x22, 0x9a, 0x44, 0xd4, 0x41, 0x5a, 0xde, 0xb4, 0x82,
+	0x58, 0xa2, 0x8d, 0x2b, 0x1c, 0xda, 0xb1, 0x09, 0x04, 0x95, 0xa6, 0x0a, 0x81, 0xc4, 0xc5, 0x72,
+	0xed, 0xff, 0x12, 0xab, 0x8e, 0x5d, 0xd9, 0xce, 0xda, 0x89, 0x03, 0x17, 0x3e, 0x00, 0x1f, 0x6b,
+	0xc7, 0x1d, 0x77, 0x9a, 0x60, 0xfb, 0x16, 0x9c, 0x50, 0x9d, 0xb4, 0x2b, 0x2d, 0x1c, 0x7a, 0x6a,
+	0x63, 0xff, 0xf2, 0x3c, 0x7f, 0x3f, 0x79, 0x64, 0xf4, 0x90, 0x0c, 0x41, 0x10, 0x1d, 0x4b, 0x18,
+	0xe6, 0x26, 0x3e, 0xde, 0xe9, 0x82, 0x25, 0x3b, 0x71, 0x9f, 0x68, 0x92, 0x99, 0xa8, 0xaf, 0x95,
+	0x55, 0xfe, 0x46, 0x81, 0x44, 0x0e, 0x89, 0x4a, 0xa4, 0xba, 0x91, 0xa8, 0x44, 0x39, 0x20, 0x1e,
+	0xfd, 0x2b, 0xd8, 0xea, 0x93, 0x52, 0x2e, 0xb7, 0x5c, 0x5c, 0xcb, 0xd9, 0x54, 0x83, 0x49, 0x95,
+	0x60, 0x05, 0xf5, 0xe8, 0x7c, 0x15, 0xad, 0x1c, 0x3a, 0x0b, 0x9f, 0xa2, 0x2a, 0x4d, 0x09, 0x97,
+	0x98, 0x50, 0xcb, 0x8f, 0x89, 0xe5, 0x4a, 0xe2, 0x09, 0x1e, 0x78, 0x75, 0xaf, 0xb1, 0xbe, 0x5b,
+	0x8b, 0xca, 0x09, 0x9c, 0xea, 0x78, 0x82, 0xe8, 0xe3, 0x18, 0x6b, 0x2d, 0x9d, 0x5e, 0xd4, 0x2a,
+	0x9d, 0xc0, 0x09, 0x35, 0x27, 0x3a, 0x93, 0x7d, 0xff, 0x2b, 0xda, 0x2a, 0x4c, 0x32, 0xc2, 0xa5,
+	0x25, 0x5c, 0x82, 0xc6, 0x19, 0x37, 0x86, 0xcb, 0x04, 0x1f, 0x2b, 0x0b, 0x53, 0x8e, 0x37, 0x16,
+	0x71, 0x7c, 0xec, 0x54, 0xdb, 0x13, 0xd1, 0x76, 0xa1, 0xf9, 0x49, 0x59, 0xb8, 0x36, 0xff, 0x86,
+	0x9e, 0xcd, 0x99, 0x73, 0x49, 0x95, 0xd6, 0x40, 0xed, 0xac, 0xfd, 0xcd, 0x45, 0xec, 0x9f, 0xce,
+	0xd8, 0xbf, 0x1b, 0xab, 0xfe, 0x3d, 0x40, 0x13, 0x3d, 0x98, 0x1b, 0x80, 0xa6, 0x40, 0x7b, 0x78,
+	0xc0, 0x25, 0x53, 0x83, 0x60, 0xa9, 0xee, 0x35, 0x96, 0x3b, 0xd5, 0x19, 0xb5, 0xbd, 0x11, 0xf2,
+	0xd9, 0x11, 0xfe, 0x7b, 0xb4, 0x9a, 0x10, 0x0b, 0x03, 0x72, 0x12, 0x2c, 0xd7, 0xbd, 0xc6, 0xed,
+	0xd6, 0xce, 0xef, 0x8b, 0xda, 0x76, 0xc2, 0x6d, 0x9a, 0x77, 0x23, 0xaa, 0xb2, 0x98, 0x2a, 0x93,
+	0x29, 0x53, 0xfe, 0x6c, 0x1b, 0xd6, 0x8b, 0xed, 0x49, 0x1f, 0x4c, 0xd4, 0xa4, 0xb4, 0xc9, 0x98,
+	0x06, 0x63, 0x3a, 0x63, 0x05, 0xff, 0x39, 0xba, 0x0b, 0x92, 0xe1, 0xae, 0x50, 0xb4, 0x07, 0x1a,
+	0x0b, 0x9e, 0x71, 0x1b, 0xac, 0xd4, 0xbd, 0xc6, 0x52, 0xe7, 0x0e, 0x48, 0xd6, 0x2a, 0xd6, 0x3f,
+	0x8c, 0x96, 0xfd, 0x7d, 0x54, 0x2b, 0xeb, 0x91, 0x5b, 0x85, 0x19, 0x4c, 0xb5, 0x04, 0x24, 0xe9,
+	0x0a, 0x60, 0xc1, 0x6a, 0xdd, 0x6b, 0xac, 0x75, 0xee, 0x17, 0x1f, 0x3f, 0xb7, 0xea, 0xcd, 0x14,
+	0xb4, 0x5f, 0x30, 0x3e, 0x43, 0x9b, 0x85, 0xcc, 0x11, 0xe1, 0x02, 0x18, 0xee, 0x2b, 0x21, 0xa6,
+	0x52, 0x5f, 0x5b, 0xbc, 0x66, 0x07, 0x4e, 0xe8, 0x50, 0x09, 0x71, 0x1d, 0xf4, 0x77, 0x0f, 0xbd,
+	0x98, 0xef, 0x19, 0x31, 0xe6, 0x7f, 0x65, 0xbb, 0xb5, 0x88, 0xef, 0xd6, 0x6c, 0xd9, 0x88, 0x31,
+	0xff, 0x2c, 0xdc, 0x6b, 0xb4, 0x99, 0x81, 0x31, 0x24, 0x01, 0xac, 0xe1, 0x28, 0x97, 0x0c, 0x67,
+	0x64, 0xe8, 0x4e, 0x9e, 0x6b, 0x30, 0x01, 0x72, 0x49, 0x07, 0x25, 0xd2, 0x71, 0x44, 0x9b, 0x0c,
+	0x0f, 0xca, 0x7d, 0xff, 0xd5, 0x38, 0xab, 0x14, 0x88, 0xb0, 0x29, 0xce, 0xb8, 0x2c, 0xf2, 0xa2,
+	0x2a, 0x97, 0x36, 0x58, 0x77, 0xaf, 0xdf, 0x73, 0xc8, 0x5b, 0x47, 0xb4, 0xb9, 0x1c, 0xe5, 0xb0,
+	0x37, 0xda, 0x6e, 0x1d, 0x9e, 0xfe, 0x0a, 0x2b, 0xa7, 0x97, 0xa1, 0x77, 0x76, 0x19, 0x7a, 0x3f,
+	0x2f, 0x43, 0xef, 0xc7, 0x55, 0x58, 0x39, 0xbb, 0x0a, 0x2b, 0xe7, 0x57, 0x61, 0xe5, 0xcb, 0xee,
+	0x54, 0x65, 0x8a, 0x43, 0x4b, 0xb0, 0x03, 0xa5, 0x7b, 0xe5, 0xd3, 0x36, 0x55, 0x1a, 0xe2, 0x61,
+	0x79, 0x1b, 0xb9, 0x0a, 0x75, 0x57, 0xdc, 0x9d, 0xf1, 0xf2, 0xcf, 0x00, 0x8c, 0x4b, 0xf3, 0xda,
+	0xaa, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +151,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainHealthMinPollCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChainHealthMinPollCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MessageRefundMaxFailures != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MessageRefundMaxFailures))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.ChainMaintainerMassMissingVoteThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.ChainFailedPollThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ChainAutoDeactivationEnabled {
+		i--
+		if m.ChainAutoDeactivationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.EndBlockerLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EndBlockerLimit))
 		i--
@@ -208,6 +274,19 @@ func (m *Params) Size() (n int) {
 	if m.EndBlockerLimit != 0 {
 		n += 1 + sovParams(uint64(m.EndBlockerLimit))
 	}
+	if m.ChainAutoDeactivationEnabled {
+		n += 2
+	}
+	l = m.ChainFailedPollThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ChainMaintainerMassMissingVoteThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MessageRefundMaxFailures != 0 {
+		n += 1 + sovParams(uint64(m.MessageRefundMaxFailures))
+	}
+	if m.ChainHealthMinPollCount != 0 {
+		n += 1 + sovParams(uint64(m.ChainHealthMinPollCount))
+	}
 	return n
 }
 
@@ -417,6 +496,130 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainAutoDeactivationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainAutoDeactivationEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFailedPollThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainFailedPollThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainMaintainerMassMissingVoteThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainMaintainerMassMissingVoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRefundMaxFailures", wireType)
+			}
+			m.MessageRefundMaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageRefundMaxFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHealthMinPollCount", wireType)
+			}
+			m.ChainHealthMinPollCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainHealthMinPollCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
@@ -12,15 +13,19 @@ import (
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -822,6 +827,207 @@ func (m *MessageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MessageResponse proto.InternalMessageInfo
 
+// MessagesRequest represents a message that queries general messages,
+// optionally filtered by status, source chain, destination chain and sender
+type MessagesRequest struct {
+	Status           exported.GeneralMessage_Status                                  `protobuf:"varint,1,opt,name=status,proto3,enum=axelar.nexus.exported.v1beta1.GeneralMessage_Status" json:"status,omitempty"`
+	SourceChain      github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"source_chain,omitempty"`
+	DestinationChain github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"destination_chain,omitempty"`
+	Sender           string                                                          `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination       *query.PageRequest                                              `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *MessagesRequest) Reset()         { *m = MessagesRequest{} }
+func (m *MessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*MessagesRequest) ProtoMessage()    {}
+func (*MessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{20}
+}
+func (m *MessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesRequest.Merge(m, src)
+}
+func (m *MessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesRequest proto.InternalMessageInfo
+
+type MessagesResponse struct {
+	Messages   []exported.GeneralMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *MessagesResponse) Reset()         { *m = MessagesResponse{} }
+func (m *MessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MessagesResponse) ProtoMessage()    {}
+func (*MessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{21}
+}
+func (m *MessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesResponse.Merge(m, src)
+}
+func (m *MessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesResponse proto.InternalMessageInfo
+
+// TransferRateLimitRequest represents a message that queries the transfer rate
+// limit of an asset on a chain
+type TransferRateLimitRequest struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *TransferRateLimitRequest) Reset()         { *m = TransferRateLimitRequest{} }
+func (m *TransferRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimitRequest) ProtoMessage()    {}
+func (*TransferRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{22}
+}
+func (m *TransferRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimitRequest.Merge(m, src)
+}
+func (m *TransferRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimitRequest proto.InternalMessageInfo
+
+type TransferRateLimitResponse struct {
+	// one rate limit per limited direction
+	TransferRateLimits []TransferRateLimit `protobuf:"bytes,1,rep,name=transfer_rate_limits,json=transferRateLimits,proto3" json:"transfer_rate_limits"`
+}
+
+func (m *TransferRateLimitResponse) Reset()         { *m = TransferRateLimitResponse{} }
+func (m *TransferRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimitResponse) ProtoMessage()    {}
+func (*TransferRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{23}
+}
+func (m *TransferRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimitResponse.Merge(m, src)
+}
+func (m *TransferRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimitResponse proto.InternalMessageInfo
+
+type TransferRateLimit struct {
+	Direction exported.TransferDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
+	Limit     cosmossdk_io_math.Int      `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	Window    time.Duration              `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	// amount of the asset transferred in the limited direction within the current
+	// window
+	Usage cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=usage,proto3,customtype=cosmossdk.io/math.Int" json:"usage"`
+}
+
+func (m *TransferRateLimit) Reset()         { *m = TransferRateLimit{} }
+func (m *TransferRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimit) ProtoMessage()    {}
+func (*TransferRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{24}
+}
+func (m *TransferRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimit.Merge(m, src)
+}
+func (m *TransferRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimit proto.InternalMessageInfo
+
 // ParamsRequest represents a message that queries the params
 type ParamsRequest struct {
 }
@@ -830,7 +1036,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{20}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{25}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,7 +1073,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{21}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{26}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +1111,7 @@ func (m *LinkDepositEnabledRequest) Reset()         { *m = LinkDepositEnabledReq
 func (m *LinkDepositEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*LinkDepositEnabledRequest) ProtoMessage()    {}
 func (*LinkDepositEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{22}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{27}
 }
 func (m *LinkDepositEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +1148,7 @@ func (m *LinkDepositEnabledResponse) Reset()         { *m = LinkDepositEnabledRe
 func (m *LinkDepositEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*LinkDepositEnabledResponse) ProtoMessage()    {}
 func (*LinkDepositEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e78aa4ff0c7b81c7, []int{23}
+	return fileDescriptor_e78aa4ff0c7b81c7, []int{28}
 }
 func (m *LinkDepositEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,6 +1199,11 @@ func init() {
 	proto.RegisterType((*ChainsByAssetResponse)(nil), "axelar.nexus.v1beta1.ChainsByAssetResponse")
 	proto.RegisterType((*MessageRequest)(nil), "axelar.nexus.v1beta1.MessageRequest")
 	proto.RegisterType((*MessageResponse)(nil), "axelar.nexus.v1beta1.MessageResponse")
+	proto.RegisterType((*MessagesRequest)(nil), "axelar.nexus.v1beta1.MessagesRequest")
+	proto.RegisterType((*MessagesResponse)(nil), "axelar.nexus.v1beta1.MessagesResponse")
+	proto.RegisterType((*TransferRateLimitRequest)(nil), "axelar.nexus.v1beta1.TransferRateLimitRequest")
+	proto.RegisterType((*TransferRateLimitResponse)(nil), "axelar.nexus.v1beta1.TransferRateLimitResponse")
+	proto.RegisterType((*TransferRateLimit)(nil), "axelar.nexus.v1beta1.TransferRateLimit")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.nexus.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.nexus.v1beta1.ParamsResponse")
 	proto.RegisterType((*LinkDepositEnabledRequest)(nil), "axelar.nexus.v1beta1.LinkDepositEnabledRequest")
@@ -1002,71 +1213,87 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/query.proto", fileDescriptor_e78aa4ff0c7b81c7) }
 
 var fileDescriptor_e78aa4ff0c7b81c7 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xf7, 0x9a, 0x60, 0xc2, 0x73, 0x6c, 0xc8, 0x96, 0x52, 0xe3, 0x44, 0xc6, 0x6c, 0x95, 0x06,
-	0xd2, 0xb0, 0x16, 0xae, 0x54, 0xa9, 0x55, 0xa4, 0xca, 0x7f, 0x13, 0x57, 0x80, 0xd0, 0xda, 0xe4,
-	0xd0, 0x1e, 0xdc, 0xb1, 0xf7, 0xd9, 0x59, 0x81, 0x77, 0x9c, 0x9d, 0x71, 0x0a, 0x52, 0x3f, 0x40,
-	0x95, 0x53, 0xd5, 0x73, 0x73, 0xea, 0xc7, 0x68, 0x3f, 0x00, 0xc7, 0x1c, 0x7b, 0x42, 0x2d, 0x7c,
-	0x8b, 0x9c, 0xaa, 0x9d, 0x3f, 0xf6, 0x3a, 0xb1, 0xb0, 0x7a, 0xe9, 0x09, 0xe6, 0xcd, 0xef, 0xfd,
-	0xde, 0xef, 0xbd, 0xf9, 0xcd, 0xac, 0x21, 0x4f, 0xce, 0xf0, 0x94, 0x04, 0x05, 0x1f, 0xcf, 0x46,
-	0xac, 0xf0, 0x6a, 0xaf, 0x83, 0x9c, 0xec, 0x15, 0x5e, 0x8e, 0x30, 0x38, 0xb7, 0x87, 0x01, 0xe5,
-	0xd4, 0x5c, 0x93, 0x08, 0x5b, 0x20, 0x6c, 0x85, 0xc8, 0xae, 0xf5, 0x69, 0x9f, 0x0a, 0x40, 0x21,
-	0xfc, 0x4f, 0x62, 0xb3, 0x3b, 0x53, 0x6c, 0x78, 0x36, 0xa4, 0x01, 0x47, 0x77, 0x4c, 0xcb, 0xcf,
-	0x87, 0xc8, 0x14, 0x74, 0x76, 0xe1, 0x28, 0xe2, 0x51, 0x97, 0xb2, 0x01, 0x65, 0x85, 0x0e, 0x61,
-	0x28, 0x15, 0x8d, 0x61, 0x43, 0xd2, 0xf7, 0x7c, 0xc2, 0x3d, 0xea, 0x2b, 0x6c, 0x2e, 0x8a, 0xd5,
-	0xa8, 0x2e, 0xf5, 0xf4, 0xfe, 0xd6, 0xcc, 0x6a, 0x43, 0x12, 0x90, 0x81, 0x2a, 0x67, 0x15, 0xe0,
-	0x93, 0xca, 0x0b, 0xe2, 0xf9, 0x07, 0xc4, 0xf3, 0x39, 0xf1, 0x7c, 0x0c, 0x98, 0x83, 0x2f, 0x47,
-	0xc8, 0xb8, 0xb9, 0x06, 0x8b, 0xdd, 0x70, 0x2b, 0x63, 0xe4, 0x8d, 0xed, 0x65, 0x47, 0x2e, 0x2c,
-	0x0a, 0x99, 0x0f, 0x13, 0xd8, 0x90, 0xfa, 0x0c, 0xcd, 0x26, 0x24, 0x07, 0x93, 0x70, 0xc6, 0xc8,
-	0x2f, 0x6c, 0xdf, 0x29, 0xef, 0xbd, 0xbb, 0xdc, 0xdc, 0xed, 0x7b, 0xfc, 0xc5, 0xa8, 0x63, 0x77,
-	0xe9, 0xa0, 0xa0, 0x34, 0xcb, 0x3f, 0xbb, 0xcc, 0x3d, 0x51, 0xed, 0x3f, 0x27, 0xa7, 0x25, 0xd7,
-	0x0d, 0x90, 0x31, 0x27, 0xca, 0x62, 0xfd, 0x6a, 0xc0, 0xbd, 0x7d, 0xc2, 0x91, 0xf1, 0x2a, 0x0e,
-	0x29, 0xf3, 0xb8, 0x46, 0x29, 0x99, 0x0f, 0x20, 0x1d, 0x60, 0xd7, 0x1b, 0x7a, 0xe8, 0xf3, 0x36,
-	0x71, 0xdd, 0x40, 0xe9, 0x4d, 0x8d, 0xa3, 0x61, 0x82, 0xf9, 0x10, 0x56, 0x26, 0x30, 0xd9, 0x57,
-	0x5c, 0xe0, 0x26, 0xd9, 0xa2, 0x2f, 0xf3, 0x53, 0x48, 0xb9, 0xb2, 0x90, 0x82, 0x2d, 0x08, 0xd8,
-	0x1d, 0x15, 0x14, 0x20, 0xab, 0x04, 0xf7, 0x67, 0x6b, 0x52, 0x93, 0xd8, 0x02, 0x8d, 0x8f, 0x4a,
-	0x4a, 0xba, 0x13, 0xb4, 0xf5, 0xa7, 0x01, 0x99, 0x56, 0x40, 0x7c, 0xd6, 0xc3, 0x80, 0xd5, 0x69,
-	0x20, 0x88, 0x6f, 0x9c, 0xbd, 0x59, 0x86, 0x45, 0xc6, 0x09, 0x47, 0xa1, 0x3c, 0x5d, 0x7c, 0x6c,
-	0x4f, 0x99, 0x54, 0x1b, 0x4f, 0xbb, 0xd5, 0xd6, 0xec, 0xcd, 0x30, 0xc7, 0x91, 0xa9, 0x66, 0x1d,
-	0x60, 0xe2, 0x23, 0xd1, 0x5b, 0xb2, 0xf8, 0x99, 0x2d, 0x4f, 0xc3, 0x0e, 0x8d, 0x64, 0xcb, 0x6b,
-	0xa0, 0x49, 0x8e, 0x48, 0x1f, 0x95, 0x2a, 0x27, 0x92, 0x69, 0xfd, 0x61, 0xc0, 0xc6, 0x0c, 0xf9,
-	0xaa, 0xff, 0x63, 0x58, 0xe6, 0x7a, 0x53, 0xf8, 0x20, 0x59, 0xdc, 0x9b, 0xa3, 0xb6, 0x12, 0x50,
-	0xc6, 0x04, 0x8b, 0xa6, 0x2d, 0xdf, 0xba, 0xb8, 0xdc, 0x8c, 0x39, 0x13, 0x26, 0xf3, 0xe9, 0x94,
-	0xf8, 0xb8, 0x10, 0xff, 0x70, 0xae, 0x78, 0xa9, 0x69, 0x4a, 0xfd, 0x13, 0x48, 0xd7, 0x11, 0x1b,
-	0x7e, 0x8f, 0xde, 0x3c, 0xf1, 0x35, 0x58, 0x24, 0x8c, 0x21, 0x57, 0x5e, 0x91, 0x0b, 0xab, 0x05,
-	0x2b, 0xe3, 0x6c, 0xd5, 0x70, 0x09, 0x6e, 0xf7, 0x10, 0xdb, 0x9e, 0xdf, 0xa3, 0x82, 0x21, 0x1c,
-	0xea, 0xcd, 0xfd, 0x6a, 0x86, 0xa5, 0x9e, 0xfc, 0xc7, 0xfa, 0x09, 0x4c, 0xdd, 0x79, 0x1d, 0xf5,
-	0xcc, 0x43, 0x27, 0x31, 0x3a, 0x0a, 0xba, 0xd8, 0x8e, 0xca, 0x4b, 0xca, 0x98, 0x74, 0xec, 0xe7,
-	0x70, 0xd7, 0x45, 0xc6, 0x55, 0x6f, 0x53, 0xe6, 0x5e, 0x8d, 0x6c, 0x48, 0xf0, 0x3a, 0x24, 0xc8,
-	0x80, 0x8e, 0x7c, 0xae, 0x7c, 0xad, 0x56, 0xd6, 0x33, 0xf8, 0x68, 0xaa, 0xba, 0xea, 0x6b, 0x0f,
-	0x16, 0x7a, 0x88, 0xaa, 0xa5, 0x8d, 0xa9, 0x51, 0x8f, 0x0f, 0x8e, 0x7a, 0xbe, 0x3a, 0xaa, 0x10,
-	0x6b, 0x7d, 0x0b, 0x29, 0x51, 0x6a, 0x7c, 0x43, 0xbf, 0x82, 0x44, 0xe8, 0xbd, 0x11, 0x13, 0x34,
-	0xe9, 0xe2, 0x96, 0x3d, 0xeb, 0x71, 0xb5, 0x45, 0x52, 0x53, 0x00, 0x1d, 0x95, 0x60, 0x0d, 0x20,
-	0xad, 0xb9, 0x94, 0xa0, 0xef, 0x21, 0x21, 0x1a, 0x94, 0xb6, 0x5a, 0x2e, 0x57, 0xde, 0x5d, 0x6e,
-	0x7e, 0x13, 0x79, 0x5e, 0x24, 0xb5, 0x8f, 0xfc, 0x47, 0x1a, 0x9c, 0xa8, 0xd5, 0x6e, 0x97, 0x06,
-	0x58, 0x38, 0x7b, 0xef, 0x81, 0x96, 0x05, 0x0f, 0xc9, 0x00, 0x1d, 0x45, 0x69, 0x3d, 0x80, 0x54,
-	0x29, 0x3c, 0xe1, 0x39, 0x6f, 0xe0, 0x36, 0xa4, 0x35, 0x4c, 0xa9, 0x0a, 0xa7, 0x2a, 0x22, 0x52,
-	0x95, 0xa3, 0x56, 0xd6, 0x0e, 0xdc, 0x1d, 0xb7, 0x85, 0x37, 0x93, 0x3a, 0x60, 0x46, 0xa1, 0x8a,
-	0xf8, 0x89, 0xbe, 0xf2, 0xf2, 0x04, 0xf2, 0x73, 0x46, 0x87, 0xea, 0x20, 0x64, 0x92, 0xf5, 0x18,
-	0xd6, 0xe4, 0xf8, 0xca, 0xe7, 0x42, 0x70, 0x44, 0x81, 0xb4, 0xb5, 0x11, 0xb5, 0x35, 0x87, 0x8f,
-	0xdf, 0x43, 0xff, 0x1f, 0x33, 0xdf, 0x86, 0xf4, 0x01, 0x32, 0x36, 0x79, 0x66, 0xcc, 0x75, 0x88,
-	0x7b, 0xae, 0x94, 0x56, 0x4e, 0x5c, 0x5d, 0x6e, 0xc6, 0x1b, 0x55, 0x27, 0xee, 0xb9, 0xd6, 0x0f,
-	0xb0, 0x32, 0x46, 0x2a, 0x65, 0x07, 0xb0, 0x34, 0x90, 0x21, 0x35, 0xa0, 0xdd, 0x39, 0xb7, 0xee,
-	0x29, 0xfa, 0x18, 0x90, 0x53, 0xc5, 0xa3, 0xa6, 0xa5, 0x39, 0xac, 0x15, 0x48, 0x1d, 0x89, 0xaf,
-	0xa3, 0x92, 0x62, 0xed, 0x43, 0x5a, 0x07, 0x54, 0xc5, 0xaf, 0x21, 0x21, 0x3f, 0xa0, 0xaa, 0xe0,
-	0xfd, 0xd9, 0x27, 0x22, 0xb3, 0x14, 0xbf, 0xca, 0xb0, 0xee, 0xc1, 0xc6, 0xbe, 0xe7, 0x9f, 0xa8,
-	0x6f, 0x46, 0xcd, 0x27, 0x9d, 0x53, 0x74, 0x75, 0xa9, 0x2f, 0x21, 0x3b, 0x6b, 0x53, 0x95, 0xcd,
-	0xc0, 0x12, 0xca, 0x90, 0xa8, 0x7b, 0xdb, 0xd1, 0xcb, 0x47, 0xbf, 0x19, 0x90, 0x8c, 0x5c, 0x1d,
-	0x73, 0x17, 0x32, 0x95, 0x67, 0xa5, 0xc6, 0x61, 0xbb, 0xd9, 0x2a, 0xb5, 0x8e, 0x9b, 0xed, 0xe3,
-	0xc3, 0xe6, 0x51, 0xad, 0xd2, 0xa8, 0x37, 0x6a, 0xd5, 0xd5, 0x58, 0x76, 0xe5, 0xf5, 0x9b, 0x7c,
-	0xf2, 0xd8, 0x67, 0x43, 0xec, 0x7a, 0x3d, 0x0f, 0x5d, 0x73, 0x07, 0xd6, 0xa7, 0xe0, 0xa5, 0x4a,
-	0xab, 0xf1, 0xbc, 0xd4, 0xaa, 0x55, 0x57, 0x8d, 0x6c, 0xea, 0xf5, 0x9b, 0xfc, 0x72, 0xa9, 0xcb,
-	0xbd, 0x57, 0x84, 0xa3, 0xfb, 0x01, 0x73, 0xb5, 0x36, 0x01, 0xc7, 0x25, 0x73, 0x15, 0x89, 0x86,
-	0x67, 0x6f, 0xfd, 0xfc, 0x7b, 0x2e, 0x56, 0x3e, 0xba, 0xf8, 0x27, 0x17, 0xbb, 0xb8, 0xca, 0x19,
-	0x6f, 0xaf, 0x72, 0xc6, 0xdf, 0x57, 0x39, 0xe3, 0x97, 0xeb, 0x5c, 0xec, 0xed, 0x75, 0x2e, 0xf6,
-	0xd7, 0x75, 0x2e, 0xf6, 0x5d, 0xf1, 0x3f, 0xb9, 0x48, 0xfc, 0x50, 0xe8, 0x24, 0xc4, 0x2f, 0x97,
-	0x2f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xae, 0x46, 0xeb, 0xeb, 0xc5, 0x09, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0xf7, 0x1a, 0x70, 0xe0, 0x39, 0x18, 0xb2, 0x5f, 0x92, 0xaf, 0x21, 0xa9, 0x21, 0x5b, 0xa5,
+	0x21, 0x69, 0x58, 0x17, 0x52, 0x55, 0x6a, 0x1b, 0xa9, 0xb2, 0x31, 0x24, 0xae, 0x08, 0x45, 0x0b,
+	0xe4, 0xd0, 0x1e, 0xdc, 0xc1, 0xfb, 0xec, 0xac, 0xc0, 0x3b, 0xce, 0xce, 0x38, 0x21, 0x52, 0xfe,
+	0x80, 0x2a, 0xa7, 0xaa, 0xa7, 0x56, 0x6a, 0x4e, 0xbd, 0xf6, 0x3f, 0x68, 0xff, 0x80, 0x1c, 0x73,
+	0xac, 0x7a, 0xa0, 0x2d, 0xf9, 0x2f, 0x72, 0xaa, 0x76, 0xe6, 0x8d, 0xbd, 0x06, 0x0b, 0x92, 0x28,
+	0xea, 0x09, 0xef, 0xcc, 0xe7, 0x7d, 0xde, 0xe7, 0xfd, 0x98, 0x37, 0x03, 0xcc, 0xb1, 0x7d, 0xdc,
+	0x63, 0x51, 0x31, 0xc4, 0xfd, 0x8e, 0x28, 0x3e, 0x5c, 0xdc, 0x41, 0xc9, 0x16, 0x8b, 0x0f, 0x3a,
+	0x18, 0x3d, 0x76, 0xdb, 0x11, 0x97, 0xdc, 0x9e, 0xd2, 0x08, 0x57, 0x21, 0x5c, 0x42, 0xcc, 0x14,
+	0x9a, 0x9c, 0x37, 0xf7, 0xb0, 0xa8, 0x30, 0x3b, 0x9d, 0x46, 0xd1, 0xef, 0x44, 0x4c, 0x06, 0x3c,
+	0xd4, 0x56, 0x33, 0x53, 0x4d, 0xde, 0xe4, 0xea, 0x67, 0x31, 0xfe, 0x45, 0xab, 0xd7, 0xfa, 0xbc,
+	0xe1, 0x7e, 0x9b, 0x47, 0x12, 0xfd, 0xae, 0x5b, 0xf9, 0xb8, 0x8d, 0x82, 0xa0, 0x83, 0x85, 0x25,
+	0x11, 0xd7, 0xeb, 0x5c, 0xb4, 0xb8, 0x28, 0xee, 0x30, 0x81, 0x5a, 0x71, 0x17, 0xd6, 0x66, 0xcd,
+	0x20, 0x4c, 0xca, 0x29, 0x24, 0xb1, 0x06, 0x55, 0xe7, 0x81, 0xd9, 0xbf, 0x3c, 0xd0, 0x5b, 0x9b,
+	0x45, 0xac, 0x45, 0xee, 0x9c, 0x22, 0xfc, 0x7f, 0xf9, 0x3e, 0x0b, 0xc2, 0xbb, 0x2c, 0x08, 0x25,
+	0x0b, 0x42, 0x8c, 0x84, 0x87, 0x0f, 0x3a, 0x28, 0xa4, 0x3d, 0x05, 0x23, 0xf5, 0x78, 0x2b, 0x6f,
+	0xcd, 0x59, 0xf3, 0x63, 0x9e, 0xfe, 0x70, 0x38, 0xe4, 0x8f, 0x1b, 0x88, 0x36, 0x0f, 0x05, 0xda,
+	0x9b, 0x90, 0x6d, 0xf5, 0x96, 0xf3, 0xd6, 0xdc, 0xd0, 0xfc, 0xd9, 0xf2, 0xe2, 0xab, 0x83, 0xd9,
+	0x85, 0x66, 0x20, 0xef, 0x77, 0x76, 0xdc, 0x3a, 0x6f, 0x15, 0x49, 0xb3, 0xfe, 0xb3, 0x20, 0xfc,
+	0x5d, 0x0a, 0xff, 0x1e, 0xdb, 0x2b, 0xf9, 0x7e, 0x84, 0x42, 0x78, 0x49, 0x16, 0xe7, 0x07, 0x0b,
+	0x2e, 0xae, 0x31, 0x89, 0x42, 0x56, 0xb0, 0xcd, 0x45, 0x20, 0x0d, 0x8a, 0x64, 0x5e, 0x81, 0x5c,
+	0x84, 0xf5, 0xa0, 0x1d, 0x60, 0x28, 0x6b, 0xcc, 0xf7, 0x23, 0xd2, 0x3b, 0xde, 0x5d, 0x8d, 0x0d,
+	0xec, 0xab, 0x30, 0xd1, 0x83, 0xe9, 0xb8, 0xd2, 0x0a, 0xd7, 0xb3, 0x56, 0x71, 0xd9, 0xef, 0xc3,
+	0xb8, 0xaf, 0x1d, 0x11, 0x6c, 0x48, 0xc1, 0xce, 0xd2, 0xa2, 0x02, 0x39, 0x25, 0xb8, 0x34, 0x58,
+	0x13, 0x65, 0xe2, 0x32, 0x18, 0x7c, 0x52, 0x52, 0xd6, 0xef, 0xa1, 0x9d, 0xdf, 0x2d, 0xc8, 0x6f,
+	0x45, 0x2c, 0x14, 0x0d, 0x8c, 0xc4, 0x2a, 0x8f, 0x14, 0xf1, 0x89, 0xb9, 0xb7, 0xcb, 0x30, 0x22,
+	0x24, 0x93, 0xa8, 0x94, 0xe7, 0x96, 0x6e, 0xb8, 0x7d, 0x4d, 0x6c, 0x1a, 0xcf, 0x74, 0xb3, 0x6b,
+	0xd8, 0x37, 0x63, 0x1b, 0x4f, 0x9b, 0xda, 0xab, 0x00, 0xbd, 0x3e, 0x52, 0xb1, 0x65, 0x97, 0x3e,
+	0x70, 0x75, 0x35, 0xdc, 0xb8, 0x91, 0x5c, 0x7d, 0x4c, 0x0c, 0xc9, 0x06, 0x6b, 0x22, 0xa9, 0xf2,
+	0x12, 0x96, 0xce, 0x6f, 0x16, 0x4c, 0x0f, 0x90, 0x4f, 0xf1, 0x6f, 0xc3, 0x98, 0x34, 0x9b, 0xaa,
+	0x0f, 0xb2, 0x4b, 0x8b, 0xa7, 0xa8, 0x5d, 0x8e, 0xb8, 0x10, 0x8a, 0xc5, 0xd0, 0x96, 0x87, 0x9f,
+	0x1f, 0xcc, 0xa6, 0xbc, 0x1e, 0x93, 0x7d, 0xbb, 0x4f, 0x7c, 0x5a, 0x89, 0xbf, 0x7a, 0xaa, 0x78,
+	0xad, 0xa9, 0x4f, 0xfd, 0x2d, 0xc8, 0xad, 0x22, 0x56, 0xc3, 0x06, 0x3f, 0x39, 0xe3, 0x53, 0x30,
+	0xc2, 0x84, 0x40, 0x49, 0xbd, 0xa2, 0x3f, 0x9c, 0x2d, 0x98, 0xe8, 0x5a, 0x53, 0xc0, 0x25, 0x18,
+	0x6d, 0x20, 0xd6, 0x82, 0xb0, 0xc1, 0xf3, 0x16, 0x25, 0xf5, 0xe4, 0x78, 0x0d, 0xc3, 0x99, 0x86,
+	0xfe, 0xe1, 0x3c, 0x01, 0xdb, 0x44, 0xbe, 0x8a, 0x26, 0xe7, 0x71, 0x27, 0x09, 0xde, 0x89, 0xea,
+	0x58, 0x4b, 0xca, 0xcb, 0xea, 0x35, 0xdd, 0xb1, 0x1f, 0xc2, 0x39, 0x1f, 0x85, 0xa4, 0xd8, 0xfa,
+	0x9a, 0x7b, 0x32, 0xb1, 0xa1, 0xc1, 0x17, 0x20, 0xc3, 0x5a, 0xbc, 0x13, 0x4a, 0xea, 0x6b, 0xfa,
+	0x72, 0xee, 0xc0, 0xff, 0xfa, 0xbc, 0x53, 0x5c, 0x8b, 0x30, 0xd4, 0x40, 0xa4, 0x90, 0xa6, 0xfb,
+	0x52, 0xdd, 0x2d, 0x1c, 0x0f, 0x42, 0x2a, 0x55, 0x8c, 0x75, 0xbe, 0x84, 0x71, 0xe5, 0xaa, 0x7b,
+	0x42, 0x3f, 0x85, 0x4c, 0xdc, 0x7b, 0x1d, 0xa1, 0x68, 0x72, 0x4b, 0x97, 0xdd, 0x41, 0xc3, 0xd7,
+	0x55, 0x46, 0x9b, 0x0a, 0xe8, 0x91, 0x81, 0xd3, 0x82, 0x9c, 0xe1, 0x22, 0x41, 0xdf, 0x40, 0x46,
+	0x05, 0xa8, 0xdb, 0x6a, 0xac, 0xbc, 0xfc, 0xea, 0x60, 0xf6, 0x8b, 0xc4, 0x78, 0xd1, 0xd4, 0x21,
+	0xca, 0x47, 0x3c, 0xda, 0xa5, 0xaf, 0x85, 0x3a, 0x8f, 0xb0, 0xb8, 0x7f, 0x64, 0x40, 0x6b, 0x87,
+	0xeb, 0xac, 0x85, 0x1e, 0x51, 0x3a, 0x57, 0x60, 0xbc, 0x14, 0x57, 0xf8, 0x94, 0x19, 0x38, 0x0f,
+	0x39, 0x03, 0x23, 0x55, 0x71, 0x56, 0xd5, 0x8a, 0x56, 0xe5, 0xd1, 0x97, 0x73, 0x0d, 0xce, 0x75,
+	0xc3, 0xc2, 0x93, 0x49, 0x3d, 0xb0, 0x93, 0x50, 0x22, 0xbe, 0x65, 0x8e, 0xbc, 0xae, 0xc0, 0xdc,
+	0x29, 0xa9, 0x43, 0x2a, 0x84, 0x36, 0x72, 0x6e, 0xc0, 0x94, 0xda, 0x12, 0xe5, 0xc7, 0x4a, 0x70,
+	0x42, 0x81, 0x6e, 0x6b, 0x2b, 0xd9, 0xd6, 0x12, 0xce, 0x1f, 0x41, 0xff, 0x17, 0x39, 0x9f, 0x87,
+	0xdc, 0x5d, 0x14, 0xa2, 0x37, 0x66, 0xec, 0x0b, 0x90, 0x0e, 0x7c, 0x2d, 0xad, 0x9c, 0x39, 0x3c,
+	0x98, 0x4d, 0x57, 0x2b, 0x5e, 0x3a, 0xf0, 0x9d, 0x6f, 0x61, 0xa2, 0x8b, 0x24, 0x65, 0x77, 0xe1,
+	0x4c, 0x4b, 0x2f, 0x51, 0x82, 0x16, 0x4e, 0x39, 0x75, 0xb7, 0x31, 0xc4, 0x88, 0xed, 0x11, 0x0f,
+	0x65, 0xcb, 0x70, 0x38, 0x3f, 0x0d, 0x75, 0x5d, 0x74, 0x5b, 0x60, 0xed, 0x48, 0xf7, 0x7e, 0xfc,
+	0x46, 0x1e, 0xdc, 0xfe, 0x86, 0xb6, 0x1b, 0x47, 0x8e, 0xb3, 0x3a, 0xa6, 0xef, 0x26, 0xa1, 0x7d,
+	0x33, 0xa1, 0x3d, 0x68, 0x26, 0x0c, 0xbd, 0x3b, 0x67, 0x03, 0x07, 0x8b, 0xc0, 0xd0, 0xc7, 0x28,
+	0x3f, 0xac, 0x07, 0x8b, 0xfe, 0x3a, 0x72, 0xe1, 0x8c, 0xbc, 0xf5, 0x85, 0xf3, 0xab, 0x05, 0x93,
+	0xbd, 0xda, 0x50, 0xfd, 0xbf, 0x82, 0x51, 0xaa, 0x9d, 0xb9, 0x66, 0xde, 0xaa, 0x01, 0xba, 0x24,
+	0xef, 0xee, 0x86, 0x59, 0xed, 0xdd, 0xee, 0x1e, 0x93, 0xb8, 0x16, 0xb4, 0x02, 0xf9, 0x36, 0x77,
+	0xcd, 0x13, 0x98, 0x1e, 0xc0, 0x43, 0xe1, 0xd7, 0x60, 0xca, 0x5c, 0x8e, 0xb5, 0x88, 0x49, 0xac,
+	0xed, 0xc5, 0xdb, 0x26, 0x15, 0x57, 0x07, 0x0f, 0x8b, 0x63, 0x74, 0x94, 0x04, 0x5b, 0x1e, 0xdd,
+	0x10, 0xce, 0xd3, 0x34, 0x9c, 0x3b, 0x86, 0xb7, 0xd7, 0x61, 0xcc, 0x0f, 0x22, 0xac, 0xab, 0x1c,
+	0xe9, 0x53, 0xf1, 0xd1, 0x6b, 0xbe, 0x45, 0x2a, 0xc6, 0xce, 0xeb, 0x51, 0xd8, 0x37, 0x61, 0x44,
+	0x09, 0x57, 0x91, 0x9f, 0x2d, 0xbf, 0x17, 0xcb, 0xf9, 0xf3, 0x60, 0xf6, 0xbc, 0x4e, 0xbb, 0xf0,
+	0x77, 0xdd, 0x80, 0x17, 0x5b, 0x4c, 0xde, 0x77, 0xab, 0xa1, 0xf4, 0x34, 0xd6, 0xfe, 0x1c, 0x32,
+	0x8f, 0x82, 0xd0, 0xe7, 0x8f, 0xe8, 0x11, 0x33, 0xed, 0xea, 0xc7, 0xbb, 0x6b, 0x1e, 0xef, 0x6e,
+	0x85, 0x1e, 0xef, 0xe5, 0xd1, 0x98, 0xf0, 0xc7, 0xbf, 0x66, 0x2d, 0x8f, 0x4c, 0x62, 0x8f, 0x1d,
+	0x35, 0x35, 0x86, 0x5f, 0xcb, 0xa3, 0xc2, 0x3a, 0x13, 0x30, 0xbe, 0xa1, 0xde, 0xce, 0x54, 0x47,
+	0x67, 0x0d, 0x72, 0x66, 0x81, 0x0a, 0xf2, 0x19, 0x64, 0xf4, 0xf3, 0x9a, 0xc6, 0xd1, 0xa5, 0xc1,
+	0x25, 0xd0, 0x56, 0x94, 0x77, 0xb2, 0x70, 0x2e, 0xc2, 0xf4, 0x5a, 0x10, 0xee, 0xd2, 0x8b, 0x72,
+	0x25, 0x64, 0x3b, 0x7b, 0xe8, 0x1b, 0x57, 0x9f, 0xc0, 0xcc, 0xa0, 0x4d, 0x72, 0x9b, 0x87, 0x33,
+	0xa8, 0x97, 0x94, 0xdf, 0x51, 0xcf, 0x7c, 0x5e, 0xff, 0xd9, 0x82, 0x6c, 0xe2, 0x62, 0xb5, 0x17,
+	0x20, 0xbf, 0x7c, 0xa7, 0x54, 0x5d, 0xaf, 0x6d, 0x6e, 0x95, 0xb6, 0xb6, 0x37, 0x6b, 0xdb, 0xeb,
+	0x9b, 0x1b, 0x2b, 0xcb, 0xd5, 0xd5, 0xea, 0x4a, 0x65, 0x32, 0x35, 0x33, 0xf1, 0xf4, 0xd9, 0x5c,
+	0x76, 0x3b, 0x14, 0x6d, 0xac, 0x07, 0x8d, 0x00, 0x7d, 0xfb, 0x1a, 0x5c, 0xe8, 0x83, 0x97, 0x96,
+	0xb7, 0xaa, 0xf7, 0x4a, 0x5b, 0x2b, 0x95, 0x49, 0x6b, 0x66, 0xfc, 0xe9, 0xb3, 0xb9, 0xb1, 0x52,
+	0x5d, 0x06, 0x0f, 0x99, 0x44, 0xff, 0x18, 0x73, 0x65, 0xa5, 0x07, 0x4e, 0x6b, 0xe6, 0x0a, 0x32,
+	0x03, 0x9f, 0x19, 0xfe, 0xee, 0x97, 0x42, 0xaa, 0xbc, 0xf1, 0xfc, 0x9f, 0x42, 0xea, 0xf9, 0x61,
+	0xc1, 0x7a, 0x71, 0x58, 0xb0, 0xfe, 0x3e, 0x2c, 0x58, 0xdf, 0xbf, 0x2c, 0xa4, 0x5e, 0xbc, 0x2c,
+	0xa4, 0xfe, 0x78, 0x59, 0x48, 0x7d, 0xbd, 0xf4, 0x46, 0x53, 0x4a, 0xfd, 0x1b, 0xb1, 0x93, 0x51,
+	0xe5, 0xbf, 0xf9, 0xef, 0x00, 0x4c, 0x6d, 0x53, 0x43, 0x03, 0x0e, 0x00, 0x00,
 }
 
 func (m *ChainMaintainersRequest) Marshal() (dAtA []byte, err error) {
@@ -1760,7 +1987,7 @@ func (m *MessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1770,20 +1997,58 @@ func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationChain) > 0 {
+		i -= len(m.DestinationChain)
+		copy(dAtA[i:], m.DestinationChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1793,30 +2058,46 @@ func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkDepositEnabledRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransferRateLimitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1826,20 +2107,34 @@ func (m *LinkDepositEnabledRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkDepositEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkDepositEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkDepositEnabledResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1849,61 +2144,233 @@ func (m *LinkDepositEnabledResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkDepositEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkDepositEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.TransferRateLimits) > 0 {
+		for iNdEx := len(m.TransferRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TransferRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChainMaintainersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *TransferRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainMaintainersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TransferRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Maintainers) > 0 {
-		for _, b := range m.Maintainers {
+	{
+		size := m.Usage.Size()
+		i -= size
+		if _, err := m.Usage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkDepositEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkDepositEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkDepositEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkDepositEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkDepositEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkDepositEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainMaintainersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainMaintainersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Maintainers) > 0 {
+		for _, b := range m.Maintainers {
 			l = len(b)
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2177,6 +2644,103 @@ func (m *MessageResponse) Size() (n int) {
 	return n
 }
 
+func (m *MessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRateLimits) > 0 {
+		for _, e := range m.TransferRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *ParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4111,6 +4675,693 @@ func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= exported.GeneralMessage_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, exported.GeneralMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRateLimits = append(m.TransferRateLimits, TransferRateLimit{})
+			if err := m.TransferRateLimits[len(m.TransferRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,68 +37,74 @@ func init() {
 }
 
 var fileDescriptor_fbc63daa8a033391 = []byte{
-	// 972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x1c, 0x52, 0x69, 0x9a, 0x8a, 0x32, 0xaa, 0x84, 0x1a, 0xda, 0xa5, 0x6c, 0x9d,
-	0x26, 0x71, 0xe3, 0x1d, 0x1c, 0x28, 0x15, 0xe5, 0x94, 0x52, 0x45, 0x42, 0x22, 0x28, 0x24, 0x05,
-	0x21, 0x1f, 0x58, 0x8d, 0x9d, 0x67, 0x67, 0x45, 0xbc, 0xe3, 0xce, 0x8c, 0x43, 0xac, 0xe0, 0x03,
-	0x7c, 0x00, 0x84, 0xe0, 0x03, 0x70, 0x87, 0x3b, 0x5c, 0x90, 0xe0, 0x84, 0xb8, 0x20, 0x55, 0xe2,
-	0x00, 0xc7, 0x2a, 0xe1, 0x03, 0x70, 0xe6, 0x54, 0xcd, 0xec, 0x8c, 0x53, 0x6f, 0x77, 0xd6, 0x9b,
-	0x53, 0x62, 0xcf, 0xff, 0xed, 0xfc, 0x7f, 0x6f, 0xe6, 0xbd, 0xb7, 0xc6, 0x21, 0x3b, 0x82, 0x03,
-	0x26, 0x68, 0x0a, 0x47, 0x43, 0x49, 0x0f, 0x9b, 0x6d, 0x50, 0xac, 0x49, 0x25, 0x88, 0xc3, 0xa4,
-	0x03, 0xd1, 0x40, 0x70, 0xc5, 0xc9, 0x95, 0x4c, 0x13, 0x19, 0x4d, 0x64, 0x35, 0x8b, 0x57, 0x7a,
-	0xbc, 0xc7, 0x8d, 0x80, 0xea, 0xff, 0x32, 0xed, 0xe2, 0xb5, 0x1e, 0xe7, 0xbd, 0x03, 0xa0, 0x6c,
-	0x90, 0x50, 0x96, 0xa6, 0x5c, 0x31, 0x95, 0xf0, 0x54, 0xda, 0xd5, 0xeb, 0x85, 0xbb, 0xa9, 0x23,
-	0xbb, 0x7c, 0xa3, 0x70, 0xf9, 0xd1, 0x10, 0xc4, 0x28, 0x53, 0xac, 0xff, 0x7a, 0x11, 0xe3, 0x2d,
-	0xd9, 0xdb, 0xcd, 0xfc, 0x91, 0xff, 0x10, 0x7e, 0x79, 0x07, 0x7a, 0x89, 0x54, 0x20, 0xde, 0xdd,
-	0x67, 0x49, 0xba, 0xc5, 0x92, 0x54, 0xb1, 0x24, 0x05, 0x41, 0xde, 0x8c, 0x8a, 0x6c, 0x47, 0x1e,
-	0xf9, 0x0e, 0x3c, 0x1a, 0x82, 0x54, 0x8b, 0x77, 0xce, 0x19, 0x25, 0x07, 0x3c, 0x95, 0x10, 0xee,
-	0x7f, 0xf5, 0xd7, 0xbf, 0xdf, 0xbd, 0xd0, 0xbe, 0x87, 0xea, 0xad, 0xb5, 0x7b, 0xa8, 0x1e, 0x2e,
-	0xd3, 0x29, 0x14, 0x61, 0xc3, 0xe3, 0x8e, 0x8e, 0x8f, 0xfb, 0x93, 0x07, 0x84, 0x94, 0x16, 0x32,
-	0x7b, 0x03, 0xc8, 0xff, 0x08, 0x5f, 0x7d, 0x00, 0xc2, 0x03, 0xfd, 0x56, 0xb1, 0x7d, 0x6f, 0x80,
-	0xc3, 0xbe, 0x7b, 0xee, 0x38, 0x0b, 0x7e, 0x60, 0xc0, 0xbb, 0x1a, 0x3c, 0xd2, 0xe0, 0xab, 0xd3,
-	0x3c, 0x7b, 0xe0, 0x47, 0x6f, 0x16, 0xa3, 0x97, 0x84, 0x90, 0x5f, 0x10, 0xbe, 0xb4, 0xd1, 0x51,
-	0xc9, 0x21, 0x53, 0x60, 0x1c, 0x91, 0x7a, 0xb1, 0xf1, 0x29, 0x91, 0x83, 0xbc, 0x5d, 0x49, 0x6b,
-	0xc1, 0x76, 0x0c, 0xd8, 0xfb, 0x1a, 0xec, 0x35, 0x0d, 0x76, 0x6d, 0xda, 0x2d, 0xb3, 0x41, 0x99,
-	0xc7, 0xb0, 0x56, 0xcc, 0x32, 0xad, 0x22, 0xbf, 0x23, 0xfc, 0xe2, 0x03, 0x60, 0x53, 0x00, 0x6b,
-	0xbe, 0xcc, 0xb3, 0x22, 0x84, 0x46, 0x45, 0xb5, 0x85, 0xf8, 0xd8, 0x40, 0x6c, 0x6b, 0x88, 0x9b,
-	0x1a, 0x22, 0xc8, 0x9f, 0x4e, 0x0e, 0xe3, 0x96, 0xef, 0x48, 0x72, 0x20, 0x7f, 0x22, 0x7c, 0xd9,
-	0x95, 0xc4, 0x86, 0x94, 0xa0, 0x36, 0x01, 0x48, 0xa3, 0xbc, 0x74, 0x9c, 0xce, 0xa1, 0x44, 0x55,
-	0xe5, 0x96, 0xa5, 0x65, 0x58, 0x1e, 0x6a, 0x96, 0x25, 0xcd, 0x72, 0xc3, 0x53, 0x62, 0x4c, 0x07,
-	0xc6, 0x5d, 0x80, 0x70, 0x65, 0x46, 0x6d, 0x4d, 0x94, 0xe4, 0x6b, 0x84, 0x17, 0x3e, 0x1a, 0xec,
-	0x31, 0x05, 0xdb, 0x4c, 0xb0, 0xbe, 0x24, 0xab, 0xc5, 0xe6, 0x9e, 0xd5, 0x38, 0x8e, 0x7a, 0x15,
-	0xa9, 0x65, 0x58, 0x36, 0x0c, 0x45, 0xf7, 0xc9, 0x99, 0x1b, 0x64, 0xfb, 0xff, 0x8d, 0x30, 0xd9,
-	0x01, 0x25, 0x46, 0x9b, 0x2c, 0x39, 0x80, 0xbd, 0x2d, 0x90, 0x92, 0xf5, 0x80, 0x50, 0x5f, 0xce,
-	0xf2, 0x4a, 0x67, 0xee, 0xf5, 0xea, 0x01, 0xd6, 0xe2, 0xa7, 0xc6, 0xe2, 0x27, 0x3a, 0xcd, 0xcb,
-	0xda, 0x67, 0x98, 0x4f, 0xb3, 0x12, 0xa3, 0x46, 0xd7, 0x84, 0x36, 0xfa, 0x59, 0x6c, 0x58, 0xf7,
-	0x25, 0x5a, 0x89, 0x51, 0x9c, 0x69, 0x63, 0xab, 0x5d, 0xff, 0x69, 0x01, 0x2f, 0x7c, 0xa8, 0x3b,
-	0xba, 0xeb, 0xe1, 0x3f, 0x23, 0xfc, 0xd2, 0x43, 0xc1, 0x52, 0xd9, 0x05, 0x21, 0x37, 0x79, 0xd6,
-	0x69, 0x88, 0xe7, 0x76, 0x3c, 0x27, 0x74, 0xa0, 0xb4, 0xb2, 0xde, 0x72, 0x6e, 0x18, 0xce, 0x77,
-	0xc8, 0xdb, 0xc5, 0xde, 0x95, 0x0b, 0x8c, 0xbb, 0xdc, 0x36, 0x22, 0x7a, 0x6c, 0xfe, 0x8c, 0xe9,
-	0xb1, 0x54, 0x4c, 0xc1, 0x98, 0xfc, 0x88, 0xf0, 0x85, 0x4d, 0x80, 0xf7, 0xd2, 0x2e, 0x27, 0xb5,
-	0xe2, 0xfd, 0xed, 0xb2, 0x73, 0xb9, 0x34, 0x43, 0x65, 0xbd, 0xed, 0x1a, 0x6f, 0x5b, 0xad, 0x57,
-	0xc8, 0xd5, 0x62, 0x77, 0xfa, 0xce, 0x46, 0xde, 0xa5, 0x38, 0x49, 0xbb, 0xfc, 0xcc, 0xad, 0xb9,
-	0xe6, 0x63, 0xf2, 0x04, 0xe1, 0x8b, 0x2e, 0x1d, 0xba, 0x5c, 0x57, 0xca, 0x33, 0xf6, 0x4c, 0xa5,
-	0xae, 0x56, 0x50, 0x5a, 0xe7, 0x5f, 0x18, 0xe7, 0x87, 0xad, 0x1a, 0x09, 0xcb, 0xf3, 0x6a, 0xca,
-	0xee, 0x83, 0xd9, 0x1a, 0x7a, 0x2c, 0xf9, 0x50, 0x74, 0x6c, 0xe3, 0x19, 0xd3, 0xe3, 0x3d, 0x90,
-	0x2a, 0x49, 0xcd, 0x5b, 0xc5, 0xe4, 0x3b, 0xd6, 0xe7, 0xc3, 0x54, 0x8d, 0xc9, 0x08, 0xcf, 0x9b,
-	0x43, 0x96, 0xe4, 0x66, 0xb1, 0xe5, 0x6c, 0xd5, 0x71, 0xd5, 0xca, 0x45, 0x16, 0xa9, 0x66, 0x90,
-	0x02, 0xe2, 0x29, 0xd8, 0x4e, 0xb6, 0xe1, 0x97, 0x08, 0xcf, 0x9b, 0x96, 0xe5, 0xdd, 0x3b, 0x5b,
-	0x9d, 0xb1, 0xb7, 0x13, 0xd9, 0xbd, 0xd7, 0xcc, 0xde, 0xb7, 0x88, 0x6f, 0xbc, 0x18, 0xb5, 0x3b,
-	0x69, 0xf2, 0x2d, 0xc2, 0xd8, 0x98, 0xdf, 0xd5, 0xf7, 0x93, 0x2c, 0x97, 0xe0, 0x19, 0x85, 0xf3,
-	0xb2, 0x32, 0x5b, 0x68, 0xfd, 0x34, 0x8d, 0x9f, 0xdb, 0x64, 0xb5, 0x24, 0x17, 0xb1, 0xa9, 0x8e,
-	0x89, 0xa9, 0xef, 0x11, 0xbe, 0x94, 0x65, 0xf4, 0xfe, 0xc8, 0xd0, 0xf9, 0x46, 0xf6, 0x94, 0x68,
-	0xc6, 0xc8, 0xce, 0x69, 0xad, 0xbb, 0x3b, 0xc6, 0x1d, 0x25, 0x8d, 0xb2, 0x93, 0x8a, 0xdb, 0xa3,
-	0xac, 0xf1, 0x4f, 0x0a, 0xe3, 0x07, 0x84, 0x2f, 0xe7, 0x5e, 0x6f, 0xa4, 0x6f, 0x98, 0xe5, 0x75,
-	0x33, 0x86, 0xd9, 0xf3, 0x72, 0x6b, 0xf5, 0xae, 0xb1, 0xda, 0x24, 0xb4, 0x2c, 0x91, 0x67, 0x2f,
-	0x3e, 0x67, 0x67, 0x3c, 0xc6, 0x17, 0xdc, 0x30, 0xf0, 0x5c, 0xa1, 0xdc, 0x04, 0x58, 0x9a, 0xa1,
-	0xb2, 0x86, 0x96, 0x8c, 0xa1, 0x57, 0xc9, 0xf5, 0x62, 0x43, 0xb6, 0x7b, 0xeb, 0x0a, 0xb3, 0x13,
-	0xd2, 0x73, 0xcb, 0xa7, 0x67, 0x63, 0xad, 0x5c, 0x54, 0xad, 0xc2, 0xb2, 0x91, 0x78, 0x7f, 0xfb,
-	0x8f, 0x93, 0x00, 0x3d, 0x3e, 0x09, 0xd0, 0x93, 0x93, 0x00, 0x7d, 0x73, 0x1a, 0xcc, 0xfd, 0x76,
-	0x1a, 0xa0, 0xc7, 0xa7, 0xc1, 0xdc, 0x3f, 0xa7, 0xc1, 0x5c, 0x6b, 0xbd, 0x97, 0xa8, 0xfd, 0x61,
-	0x3b, 0xea, 0xf0, 0xbe, 0x7d, 0x4a, 0x0a, 0xea, 0x73, 0x2e, 0x3e, 0xb3, 0x9f, 0x1a, 0x1d, 0x2e,
-	0x80, 0x1e, 0xd9, 0x47, 0xab, 0xd1, 0x00, 0x64, 0x7b, 0xde, 0xfc, 0xa6, 0x78, 0xe3, 0x69, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xef, 0x9a, 0xcb, 0x35, 0x04, 0x0d, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xcf, 0x6e, 0x5b, 0x45,
+	0x14, 0xc6, 0x3b, 0x2c, 0x52, 0x34, 0xa4, 0x50, 0x46, 0x91, 0x50, 0x43, 0x7b, 0x09, 0xae, 0xf3,
+	0xcf, 0x8d, 0x3d, 0xd8, 0x50, 0x0a, 0x65, 0x95, 0x52, 0x45, 0x42, 0x6a, 0x50, 0x70, 0x0a, 0x42,
+	0x5e, 0x70, 0x35, 0x76, 0x8e, 0x9d, 0x2b, 0xec, 0x7b, 0xdd, 0x99, 0x71, 0x88, 0x15, 0xbc, 0x28,
+	0x0f, 0x80, 0x10, 0x3c, 0x00, 0x7b, 0x78, 0x80, 0x6e, 0x58, 0xb0, 0x42, 0x6c, 0x90, 0x2a, 0xb1,
+	0x80, 0x65, 0x95, 0xf0, 0x00, 0x2c, 0xd8, 0xc0, 0x0a, 0xcd, 0xdc, 0x19, 0x27, 0x76, 0x66, 0xee,
+	0x75, 0x56, 0x6d, 0x32, 0xdf, 0x99, 0xf3, 0xfd, 0xce, 0xdc, 0x39, 0x67, 0x82, 0x0b, 0xec, 0x10,
+	0xba, 0x8c, 0xd3, 0x18, 0x0e, 0x07, 0x82, 0x1e, 0x54, 0x9b, 0x20, 0x59, 0x95, 0x0a, 0xe0, 0x07,
+	0x51, 0x0b, 0x2a, 0x7d, 0x9e, 0xc8, 0x84, 0x2c, 0xa4, 0x9a, 0x8a, 0xd6, 0x54, 0x8c, 0x66, 0x71,
+	0xa1, 0x93, 0x74, 0x12, 0x2d, 0xa0, 0xea, 0x7f, 0xa9, 0x76, 0xf1, 0x7a, 0x27, 0x49, 0x3a, 0x5d,
+	0xa0, 0xac, 0x1f, 0x51, 0x16, 0xc7, 0x89, 0x64, 0x32, 0x4a, 0x62, 0x61, 0x56, 0x6f, 0x38, 0xb3,
+	0xc9, 0x43, 0xb3, 0xbc, 0xe4, 0x5c, 0x7e, 0x34, 0x00, 0x3e, 0x4c, 0x15, 0xb5, 0x7f, 0xe7, 0x31,
+	0xde, 0x16, 0x9d, 0xdd, 0xd4, 0x1f, 0xf9, 0x1b, 0xe1, 0x57, 0xea, 0xd0, 0x89, 0x84, 0x04, 0xfe,
+	0xfe, 0x3e, 0x8b, 0xe2, 0x6d, 0x16, 0xc5, 0x92, 0x45, 0x31, 0x70, 0xf2, 0x56, 0xc5, 0x65, 0xbb,
+	0xe2, 0x91, 0xd7, 0xe1, 0xd1, 0x00, 0x84, 0x5c, 0xbc, 0x7d, 0xc1, 0x28, 0xd1, 0x4f, 0x62, 0x01,
+	0x85, 0xfd, 0xaf, 0x7e, 0xff, 0xeb, 0xbb, 0xe7, 0x9a, 0x77, 0x51, 0xa9, 0xb1, 0x71, 0x17, 0x95,
+	0x0a, 0xab, 0x74, 0x02, 0x85, 0x9b, 0xf0, 0xb0, 0xa5, 0xe2, 0xc3, 0xde, 0x78, 0x83, 0x02, 0xa5,
+	0x4e, 0x66, 0x6f, 0x00, 0xf9, 0x0f, 0xe1, 0x6b, 0xf7, 0x81, 0x7b, 0xa0, 0xdf, 0x76, 0xdb, 0xf7,
+	0x06, 0x58, 0xec, 0x3b, 0x17, 0x8e, 0x33, 0xe0, 0x5d, 0x0d, 0xde, 0x56, 0xe0, 0x15, 0x05, 0xbe,
+	0x3e, 0xc9, 0xb3, 0x07, 0x7e, 0xf4, 0xaa, 0x1b, 0x3d, 0x23, 0x84, 0xfc, 0x84, 0xf0, 0x95, 0xcd,
+	0x96, 0x8c, 0x0e, 0x98, 0x04, 0xed, 0x88, 0x94, 0xdc, 0xc6, 0x27, 0x44, 0x16, 0xf2, 0xd6, 0x4c,
+	0x5a, 0x03, 0x56, 0xd7, 0x60, 0x0f, 0x14, 0xd8, 0xeb, 0x0a, 0xec, 0xfa, 0xa4, 0x5b, 0x66, 0x82,
+	0x52, 0x8f, 0x85, 0xa2, 0x9b, 0x65, 0x52, 0x45, 0x7e, 0x41, 0xf8, 0xa5, 0xfb, 0xc0, 0x26, 0x00,
+	0x36, 0x7c, 0x95, 0x67, 0x2e, 0x84, 0xf2, 0x8c, 0x6a, 0x03, 0xf1, 0x89, 0x86, 0xd8, 0x51, 0x10,
+	0x37, 0x15, 0x44, 0x30, 0x7d, 0x3a, 0x53, 0x18, 0x2b, 0xbe, 0x23, 0x99, 0x02, 0xf9, 0x0d, 0xe1,
+	0xab, 0xf6, 0x4a, 0x6c, 0x0a, 0x01, 0x72, 0x0b, 0x80, 0x94, 0xb3, 0xaf, 0x8e, 0xd5, 0x59, 0x94,
+	0xca, 0xac, 0x72, 0xc3, 0xd2, 0xd0, 0x2c, 0x0f, 0x15, 0xcb, 0xb2, 0x62, 0x59, 0xf2, 0x5c, 0x31,
+	0xa6, 0x02, 0xc3, 0x36, 0x40, 0x61, 0x2d, 0xe7, 0x6e, 0x8d, 0x95, 0xe4, 0x6b, 0x84, 0xe7, 0x3f,
+	0xee, 0xef, 0x31, 0x09, 0x3b, 0x8c, 0xb3, 0x9e, 0x20, 0xeb, 0x6e, 0x73, 0x67, 0x35, 0x96, 0xa3,
+	0x34, 0x8b, 0xd4, 0x30, 0xac, 0x6a, 0x06, 0xd7, 0xf7, 0x64, 0xcd, 0xf5, 0xd3, 0xfc, 0x7f, 0x20,
+	0x4c, 0xea, 0x20, 0xf9, 0x70, 0x8b, 0x45, 0x5d, 0xd8, 0xdb, 0x06, 0x21, 0x58, 0x07, 0x08, 0xf5,
+	0xd5, 0x6c, 0x5a, 0x69, 0xcd, 0xbd, 0x31, 0x7b, 0x80, 0xb1, 0xf8, 0x99, 0xb6, 0xf8, 0xa9, 0x2a,
+	0xf3, 0xaa, 0xf2, 0x59, 0x98, 0x2e, 0xb3, 0xe4, 0xc3, 0x72, 0x5b, 0x87, 0x96, 0x7b, 0x69, 0x6c,
+	0xa1, 0xe4, 0x2b, 0xb4, 0xe4, 0xc3, 0x30, 0xd5, 0x86, 0x46, 0x4b, 0x9e, 0x20, 0xbc, 0xb0, 0x0b,
+	0xf2, 0x21, 0x67, 0xb1, 0x68, 0x03, 0xaf, 0x33, 0x09, 0x0f, 0xa2, 0x5e, 0x24, 0x49, 0xd5, 0x6d,
+	0xd5, 0xa5, 0xb5, 0x74, 0xb5, 0x8b, 0x84, 0x18, 0xbe, 0x77, 0x34, 0x5f, 0x4d, 0xa1, 0x95, 0xa9,
+	0x67, 0xf8, 0xc9, 0x50, 0x9a, 0xf8, 0x90, 0xab, 0x8f, 0xbe, 0xab, 0x76, 0xa8, 0xfd, 0xf3, 0x22,
+	0x9e, 0xff, 0x48, 0xcd, 0x22, 0x3b, 0x7d, 0x9e, 0x20, 0xfc, 0xb2, 0x4d, 0x24, 0xb6, 0x92, 0xb4,
+	0x47, 0x12, 0xcf, 0x77, 0x7d, 0x4e, 0x68, 0x21, 0xe8, 0xcc, 0x7a, 0x43, 0xb0, 0xa9, 0x09, 0xde,
+	0x23, 0xef, 0xba, 0xed, 0x5b, 0xeb, 0x22, 0x6c, 0x27, 0xa6, 0x85, 0xd2, 0x23, 0xfd, 0xcf, 0x88,
+	0x1e, 0x09, 0xc9, 0x24, 0x8c, 0xc8, 0x8f, 0x08, 0x5f, 0xde, 0x02, 0xf8, 0x20, 0x6e, 0x27, 0xa4,
+	0xe8, 0xce, 0x6f, 0x96, 0xad, 0xcb, 0xe5, 0x1c, 0x95, 0xf1, 0xb6, 0xab, 0xbd, 0x6d, 0x37, 0x5e,
+	0x25, 0xd7, 0xdc, 0xee, 0xd4, 0x6d, 0xab, 0x78, 0x97, 0xc2, 0x28, 0x6e, 0x27, 0xa7, 0x6e, 0xf5,
+	0x05, 0x1d, 0x91, 0x67, 0x08, 0xbf, 0x60, 0xcb, 0xa1, 0x1a, 0xcd, 0x5a, 0x76, 0xc5, 0xce, 0xf4,
+	0x98, 0xf5, 0x19, 0x94, 0xc6, 0xf9, 0x97, 0xda, 0xf9, 0x41, 0xa3, 0x48, 0x0a, 0xd9, 0x75, 0xd5,
+	0x0d, 0xe3, 0xc3, 0x7c, 0x0d, 0x3d, 0x12, 0xc9, 0x80, 0xb7, 0x4c, 0xcb, 0x1c, 0xd1, 0xa3, 0x3d,
+	0x10, 0x32, 0x8a, 0xf5, 0x7b, 0x68, 0xfc, 0x3b, 0xd6, 0x4b, 0x06, 0xb1, 0x1c, 0x91, 0x21, 0x9e,
+	0xd3, 0x87, 0x2c, 0xc8, 0x4d, 0xb7, 0xe5, 0x74, 0xd5, 0x72, 0x15, 0xb3, 0x45, 0x06, 0xa9, 0xa8,
+	0x91, 0x02, 0xe2, 0x69, 0x35, 0xad, 0x34, 0xe1, 0x63, 0x84, 0xe7, 0x74, 0xb3, 0xf5, 0xe6, 0x4e,
+	0x57, 0x73, 0x72, 0x5b, 0x91, 0xc9, 0xbd, 0xa1, 0x73, 0xaf, 0x10, 0xdf, 0x60, 0xd4, 0x6a, 0x7b,
+	0xd2, 0xe4, 0x5b, 0x84, 0xb1, 0x36, 0xbf, 0x2b, 0x99, 0x04, 0xb2, 0x9a, 0x81, 0xa7, 0x15, 0xd6,
+	0xcb, 0x5a, 0xbe, 0xd0, 0xf8, 0xa9, 0x6a, 0x3f, 0xb7, 0xc8, 0x7a, 0x46, 0x2d, 0x42, 0x7d, 0x3b,
+	0xc6, 0xa6, 0xbe, 0x47, 0xf8, 0x4a, 0x5a, 0xd1, 0x7b, 0x43, 0x4d, 0xe7, 0x7b, 0x6c, 0x4c, 0x88,
+	0x72, 0x1e, 0x1b, 0x53, 0x5a, 0xe3, 0xee, 0xb6, 0x76, 0x47, 0x49, 0x39, 0xeb, 0xa4, 0xc2, 0xe6,
+	0x30, 0x1d, 0x59, 0xe3, 0x8b, 0xf1, 0x03, 0xc2, 0x57, 0xa7, 0x1e, 0x66, 0xc2, 0x37, 0x86, 0xa7,
+	0x75, 0x39, 0x63, 0xf8, 0xbc, 0xdc, 0x58, 0xbd, 0xa3, 0xad, 0x56, 0x09, 0xcd, 0x2a, 0xe4, 0xe9,
+	0x93, 0xed, 0xf4, 0x8c, 0x47, 0xf8, 0xb2, 0x1d, 0x63, 0x9e, 0x4f, 0x68, 0x6a, 0x76, 0x2d, 0xe7,
+	0xa8, 0x8c, 0xa1, 0x65, 0x6d, 0xe8, 0x35, 0x72, 0xc3, 0x6d, 0xc8, 0xce, 0x9d, 0xc7, 0x08, 0x3f,
+	0x6f, 0x42, 0x05, 0xc9, 0xde, 0x7a, 0x5c, 0x9b, 0x95, 0x3c, 0x99, 0xb1, 0xb0, 0xa2, 0x2d, 0x2c,
+	0x91, 0x20, 0xd3, 0x82, 0x98, 0x18, 0x18, 0xa7, 0x83, 0x2f, 0x67, 0x60, 0x9c, 0x9b, 0x7a, 0x74,
+	0x66, 0xfd, 0xc5, 0x06, 0xc6, 0x99, 0x59, 0x77, 0xae, 0x05, 0x0f, 0xf1, 0x9c, 0x79, 0x19, 0x79,
+	0x7a, 0xc4, 0xe4, 0x9b, 0xa8, 0x98, 0x2d, 0x9a, 0xad, 0x3f, 0xa5, 0x4f, 0xa1, 0x7b, 0x3b, 0xbf,
+	0x1e, 0x07, 0xe8, 0xe9, 0x71, 0x80, 0x9e, 0x1d, 0x07, 0xe8, 0x9b, 0x93, 0xe0, 0xd2, 0xcf, 0x27,
+	0x01, 0x7a, 0x7a, 0x12, 0x5c, 0xfa, 0xf3, 0x24, 0xb8, 0xd4, 0xa8, 0x75, 0x22, 0xb9, 0x3f, 0x68,
+	0x56, 0x5a, 0x49, 0xcf, 0xec, 0x12, 0x83, 0xfc, 0x22, 0xe1, 0x9f, 0x9b, 0x9f, 0xca, 0xad, 0x84,
+	0x03, 0x3d, 0x34, 0x5b, 0xcb, 0x61, 0x1f, 0x44, 0x73, 0x4e, 0xff, 0x2d, 0xf9, 0xe6, 0xff, 0x03,
+	0x00, 0x86, 0x95, 0x0b, 0x98, 0xfc, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -120,6 +126,7 @@ type MsgServiceClient interface {
 	RegisterAssetFee(ctx context.Context, in *RegisterAssetFeeRequest, opts ...grpc.CallOption) (*RegisterAssetFeeResponse, error)
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
 	RetryFailedMessage(ctx context.Context, in *RetryFailedMessageRequest, opts ...grpc.CallOption) (*RetryFailedMessageResponse, error)
+	SetTransferRateLimit(ctx context.Context, in *SetTransferRateLimitRequest, opts ...grpc.CallOption) (*SetTransferRateLimitResponse, error)
 }
 
 type msgServiceClient struct {
@@ -193,6 +200,15 @@ func (c *msgServiceClient) RetryFailedMessage(ctx context.Context, in *RetryFail
 	return out, nil
 }
 
+func (c *msgServiceClient) SetTransferRateLimit(ctx context.Context, in *SetTransferRateLimitRequest, opts ...grpc.CallOption) (*SetTransferRateLimitResponse, error) {
+	out := new(SetTransferRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.MsgService/SetTransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	RegisterChainMaintainer(context.Context, *RegisterChainMaintainerRequest) (*RegisterChainMaintainerResponse, error)
@@ -202,6 +218,7 @@ type MsgServiceServer interface {
 	RegisterAssetFee(context.Context, *RegisterAssetFeeRequest) (*RegisterAssetFeeResponse, error)
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
 	RetryFailedMessage(context.Context, *RetryFailedMessageRequest) (*RetryFailedMessageResponse, error)
+	SetTransferRateLimit(context.Context, *SetTransferRateLimitRequest) (*SetTransferRateLimitResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -229,6 +246,9 @@ func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *Upd
 func (*UnimplementedMsgServiceServer) RetryFailedMessage(ctx context.Context, req *RetryFailedMessageRequest) (*RetryFailedMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedMessage not implemented")
 }
+func (*UnimplementedMsgServiceServer) SetTransferRateLimit(ctx context.Context, req *SetTransferRateLimitRequest) (*SetTransferRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferRateLimit not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -360,6 +380,24 @@ func _MsgService_RetryFailedMessage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_SetTransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).SetTransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.MsgService/SetTransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).SetTransferRateLimit(ctx, req.(*SetTransferRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.nexus.v1beta1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -392,6 +430,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetryFailedMessage",
 			Handler:    _MsgService_RetryFailedMessage_Handler,
 		},
+		{
+			MethodName: "SetTransferRateLimit",
+			Handler:    _MsgService_SetTransferRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/nexus/v1beta1/service.proto",
@@ -419,6 +461,12 @@ type QueryServiceClient interface {
 	// ChainMaintainers queries the chain maintainers for a given chain
 	ChainMaintainers(ctx context.Context, in *ChainMaintainersRequest, opts ...grpc.CallOption) (*ChainMaintainersResponse, error)
 	Message(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// Messages queries general messages, optionally filtered by status, source
+	// chain, destination chain and sender
+	Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
+	// TransferRateLimit queries the transfer rate limit of an asset on a chain,
+	// and its current usage
+	TransferRateLimit(ctx context.Context, in *TransferRateLimitRequest, opts ...grpc.CallOption) (*TransferRateLimitResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
@@ -511,6 +559,24 @@ func (c *queryServiceClient) Message(ctx context.Context, in *MessageRequest, op
 	return out, nil
 }
 
+func (c *queryServiceClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Messages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) TransferRateLimit(ctx context.Context, in *TransferRateLimitRequest, opts ...grpc.CallOption) (*TransferRateLimitResponse, error) {
+	out := new(TransferRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/TransferRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
 	out := new(ParamsResponse)
 	err := c.cc.Invoke(ctx, "/axelar.nexus.v1beta1.QueryService/Params", in, out, opts...)
@@ -540,6 +606,12 @@ type QueryServiceServer interface {
 	// ChainMaintainers queries the chain maintainers for a given chain
 	ChainMaintainers(context.Context, *ChainMaintainersRequest) (*ChainMaintainersResponse, error)
 	Message(context.Context, *MessageRequest) (*MessageResponse, error)
+	// Messages queries general messages, optionally filtered by status, source
+	// chain, destination chain and sender
+	Messages(context.Context, *MessagesRequest) (*MessagesResponse, error)
+	// TransferRateLimit queries the transfer rate limit of an asset on a chain,
+	// and its current usage
+	TransferRateLimit(context.Context, *TransferRateLimitRequest) (*TransferRateLimitResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
@@ -574,6 +646,12 @@ func (*UnimplementedQueryServiceServer) ChainMaintainers(ctx context.Context, re
 func (*UnimplementedQueryServiceServer) Message(ctx context.Context, req *MessageRequest) (*MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Message not implemented")
 }
+func (*UnimplementedQueryServiceServer) Messages(ctx context.Context, req *MessagesRequest) (*MessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Messages not implemented")
+}
+func (*UnimplementedQueryServiceServer) TransferRateLimit(ctx context.Context, req *TransferRateLimitRequest) (*TransferRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRateLimit not implemented")
+}
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -744,6 +822,42 @@ func _QueryService_Message_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Messages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Messages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/Messages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Messages(ctx, req.(*MessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_TransferRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TransferRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.nexus.v1beta1.QueryService/TransferRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TransferRateLimit(ctx, req.(*TransferRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParamsRequest)
 	if err := dec(in); err != nil {
@@ -802,6 +916,14 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Message",
 			Handler:    _QueryService_Message_Handler,
 		},
+		{
+			MethodName: "Messages",
+			Handler:    _QueryService_Messages_Handler,
+		},
+		{
+			MethodName: "TransferRateLimit",
+			Handler:    _QueryService_TransferRateLimit_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
@@ -429,15 +429,17 @@ func (m *RegisterAssetFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterAssetFeeResponse proto.InternalMessageInfo
 
-// Deprecated in v1.4: rate limiting has been removed.
-//
-// Deprecated: Do not use.
+// SetTransferRateLimitRequest represents a message to limit the amount of an
+// asset that can be transferred to or from a chain within a rolling window. An
+// unspecified direction sets the same limit in both directions. A zero window
+// removes the limit.
 type SetTransferRateLimitRequest struct {
 	SenderDeprecated github_com_cosmos_cosmos_sdk_types.AccAddress                   `protobuf:"bytes,1,opt,name=sender_deprecated,json=senderDeprecated,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender_deprecated,omitempty"` // Deprecated: Do not use.
 	Chain            github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,2,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Limit            types.Coin                                                      `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit"`
 	Window           time.Duration                                                   `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
 	Sender           string                                                          `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Direction        exported.TransferDirection                                      `protobuf:"varint,6,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
 }
 
 func (m *SetTransferRateLimitRequest) Reset()         { *m = SetTransferRateLimitRequest{} }
@@ -473,7 +475,6 @@ func (m *SetTransferRateLimitRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetTransferRateLimitRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
 type SetTransferRateLimitResponse struct {
 }
 
@@ -845,67 +846,69 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/tx.proto", fileDescriptor_c4e92eae487d1107) }
 
 var fileDescriptor_c4e92eae487d1107 = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
+	// 980 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x9b, 0xc6, 0x34, 0x53, 0x0e, 0xd4, 0x75, 0xe3, 0xb5, 0x49, 0xd7, 0xce, 0x22,
-	0x41, 0x1a, 0x91, 0xdd, 0x3a, 0x11, 0x1c, 0xcc, 0x01, 0xc5, 0x31, 0x41, 0x95, 0x5a, 0x54, 0x6d,
-	0xe1, 0x00, 0x1c, 0xc2, 0xd8, 0xfb, 0xec, 0x8c, 0x62, 0xcf, 0x2c, 0x33, 0xe3, 0xc4, 0xb9, 0x45,
-	0x08, 0x71, 0xe0, 0x84, 0xc4, 0x85, 0x33, 0x7f, 0x41, 0x84, 0x92, 0xff, 0x80, 0x43, 0x8e, 0x15,
-	0x27, 0x4e, 0x01, 0x92, 0x43, 0xff, 0x87, 0x9e, 0x90, 0x77, 0xc6, 0xbf, 0x37, 0x51, 0x81, 0xaa,
-	0x17, 0x7a, 0xb1, 0x77, 0x67, 0xde, 0xcc, 0xfb, 0xce, 0xe7, 0xbd, 0x79, 0xfb, 0xf0, 0x1d, 0xd2,
-	0x85, 0x16, 0x11, 0x3e, 0x83, 0x6e, 0x47, 0xfa, 0x7b, 0xa5, 0x1a, 0x28, 0x52, 0xf2, 0x55, 0xd7,
-	0x8b, 0x04, 0x57, 0x3c, 0x9d, 0xd1, 0xd3, 0x5e, 0x3c, 0xed, 0x99, 0xe9, 0xbc, 0xd3, 0xe4, 0xbc,
-	0xd9, 0x02, 0x3f, 0xb6, 0xa9, 0x75, 0x1a, 0x7e, 0xd8, 0x11, 0x44, 0x51, 0xce, 0xf4, 0xaa, 0x7c,
-	0xa6, 0xc9, 0x9b, 0x3c, 0x7e, 0xf4, 0x7b, 0x4f, 0x66, 0xd4, 0xa9, 0x73, 0xd9, 0xe6, 0xd2, 0xaf,
-	0x11, 0x09, 0x03, 0x4f, 0x75, 0x4e, 0xfb, 0xab, 0xee, 0x8e, 0x49, 0x81, 0x6e, 0xc4, 0x85, 0x82,
-	0x70, 0xa8, 0xe9, 0x20, 0x02, 0x69, 0x4c, 0x97, 0x12, 0x55, 0x47, 0x44, 0x90, 0x76, 0xdf, 0xc4,
-	0x33, 0x26, 0x11, 0x88, 0x36, 0x95, 0x92, 0x72, 0x76, 0xf5, 0x96, 0x39, 0xad, 0x6e, 0x5b, 0xcb,
-	0xd6, 0x2f, 0x66, 0x2a, 0x6b, 0x84, 0xb7, 0x65, 0xd3, 0xdf, 0x2b, 0xf5, 0xfe, 0xcc, 0xc4, 0x4d,
-	0xd2, 0xa6, 0x8c, 0xfb, 0xf1, 0xaf, 0x1e, 0x72, 0x7f, 0xb5, 0xb0, 0x13, 0x40, 0x93, 0x4a, 0x05,
-	0x62, 0x73, 0x87, 0x50, 0xf6, 0x90, 0x50, 0xa6, 0x08, 0x65, 0x20, 0x02, 0xf8, 0xba, 0x03, 0x52,
-	0xa5, 0xbf, 0xc2, 0x37, 0x25, 0xb0, 0x10, 0xc4, 0x76, 0x08, 0x91, 0x80, 0x3a, 0x51, 0x10, 0xda,
-	0xa8, 0x88, 0x96, 0x5f, 0xaf, 0xac, 0x3f, 0x3b, 0x2b, 0xac, 0x36, 0xa9, 0xda, 0xe9, 0xd4, 0xbc,
-	0x3a, 0x6f, 0x1b, 0x19, 0xe6, 0x6f, 0x55, 0x86, 0xbb, 0x46, 0xf2, 0x46, 0xbd, 0xbe, 0x11, 0x86,
-	0x02, 0xa4, 0xb4, 0x51, 0xf0, 0x86, 0xde, 0xad, 0x3a, 0xd8, 0x2c, 0xfd, 0x25, 0x4e, 0xd5, 0x7b,
-	0xbe, 0xa5, 0x6d, 0x15, 0x67, 0x97, 0xe7, 0x2b, 0x9b, 0xcf, 0xce, 0x0a, 0x1f, 0x8e, 0x6c, 0xab,
-	0xd1, 0x30, 0x50, 0xfb, 0x5c, 0xec, 0x9a, 0xb7, 0xd5, 0x3a, 0x17, 0xe0, 0x77, 0x27, 0xe8, 0x7b,
-	0xf1, 0x19, 0x3e, 0x21, 0x6d, 0x08, 0xcc, 0x96, 0xe9, 0x7b, 0x38, 0xa5, 0x1d, 0xda, 0xb3, 0x45,
-	0xb4, 0x3c, 0x5f, 0xb1, 0x7f, 0x3b, 0x5e, 0xcd, 0x18, 0x5e, 0x46, 0xd4, 0x63, 0x25, 0x28, 0x6b,
-	0x06, 0xc6, 0xae, 0x5c, 0x3a, 0x3c, 0xb1, 0xd1, 0x37, 0x4f, 0x8f, 0x56, 0xcc, 0xc0, 0xf7, 0x4f,
-	0x8f, 0x56, 0xee, 0x68, 0x3f, 0x97, 0xa0, 0x72, 0x97, 0x70, 0xe1, 0x52, 0x8a, 0x32, 0xe2, 0x4c,
-	0x82, 0x7b, 0x6a, 0xe1, 0x62, 0x15, 0xc4, 0x2b, 0xd6, 0x63, 0xac, 0xd7, 0x13, 0x58, 0x17, 0xb4,
-	0x9f, 0x4b, 0x61, 0xb9, 0x6f, 0xe1, 0xa5, 0x2b, 0x48, 0x1a, 0xde, 0x47, 0x16, 0xce, 0x6c, 0xd4,
-	0x15, 0xdd, 0x23, 0x0a, 0x62, 0x9b, 0xff, 0x2d, 0xe3, 0x77, 0x0e, 0x4f, 0xec, 0xd9, 0x09, 0xc6,
-	0xb7, 0xb4, 0x9f, 0x31, 0x40, 0x6e, 0x16, 0xdf, 0x9e, 0x20, 0x66, 0x58, 0x1e, 0x5b, 0x78, 0xa1,
-	0x0a, 0xe4, 0x15, 0x4d, 0x10, 0xe5, 0xbb, 0x09, 0x34, 0x6f, 0xf7, 0x33, 0x76, 0x0c, 0x91, 0x9b,
-	0xc3, 0xd9, 0x29, 0x6a, 0x86, 0xe8, 0xcf, 0x16, 0xce, 0xf6, 0x2b, 0xc6, 0x86, 0x94, 0xa0, 0xb6,
-	0x00, 0x5e, 0x1e, 0xd2, 0x8f, 0xf1, 0xf5, 0x06, 0xc0, 0x36, 0x65, 0x0d, 0x6e, 0x5b, 0x45, 0xb4,
-	0x7c, 0x63, 0xed, 0x6d, 0x6f, 0xec, 0xcb, 0x39, 0x20, 0x66, 0x3e, 0x3d, 0xde, 0x16, 0xc0, 0x7d,
-	0xd6, 0xe0, 0x95, 0x6b, 0xa7, 0x67, 0x85, 0x99, 0xe0, 0xb5, 0x86, 0x7e, 0xfd, 0x17, 0xf8, 0x56,
-	0x0e, 0x4f, 0x6c, 0x6b, 0x02, 0xdf, 0xc2, 0x78, 0x71, 0xed, 0xf3, 0x70, 0xf3, 0xd8, 0x9e, 0x66,
-	0x64, 0x00, 0xfe, 0x32, 0x8b, 0xdf, 0x7c, 0x0c, 0xea, 0x53, 0x41, 0x98, 0x6c, 0x80, 0x08, 0x88,
-	0x82, 0x07, 0xb4, 0x4d, 0xd5, 0xcb, 0x83, 0xf8, 0x39, 0x9e, 0x8b, 0x93, 0x28, 0x26, 0xf8, 0x82,
-	0xd2, 0x52, 0xef, 0x98, 0x7e, 0x0f, 0xcf, 0xb5, 0x7a, 0x87, 0x89, 0xa9, 0xde, 0x58, 0xcb, 0x79,
-	0x06, 0x69, 0xaf, 0x15, 0x19, 0x84, 0x64, 0x93, 0x53, 0x66, 0xe2, 0xa1, 0xad, 0xd3, 0x1f, 0xe0,
-	0xd4, 0x3e, 0x65, 0x21, 0xdf, 0xb7, 0xaf, 0x99, 0x75, 0xba, 0xf1, 0xf1, 0xfa, 0x8d, 0x8f, 0x57,
-	0x35, 0x8d, 0x4f, 0xe5, 0x7a, 0x6f, 0xdd, 0x4f, 0x7f, 0x14, 0x50, 0x60, 0x96, 0x8c, 0x84, 0x72,
-	0xee, 0x39, 0x43, 0x79, 0x2f, 0xe1, 0x26, 0xe4, 0xf5, 0xd1, 0x92, 0x22, 0x63, 0x23, 0xd7, 0xc5,
-	0x8b, 0xc9, 0x31, 0xd3, 0x41, 0x2d, 0x5b, 0x36, 0x72, 0xbf, 0x45, 0xd8, 0xfe, 0x88, 0x91, 0x5a,
-	0x0b, 0x1e, 0x50, 0xb6, 0x5b, 0x85, 0x88, 0xcb, 0x61, 0x54, 0xdf, 0xc7, 0xf3, 0xa4, 0xa3, 0x76,
-	0xb8, 0xa0, 0xea, 0x20, 0x8e, 0xe6, 0x55, 0x3a, 0x87, 0xa6, 0x43, 0xa9, 0xc3, 0xb1, 0x9e, 0xda,
-	0xac, 0x56, 0x3b, 0xe5, 0xce, 0x46, 0x6e, 0x01, 0xe7, 0x12, 0x54, 0x8c, 0xe8, 0xfc, 0x0e, 0xe1,
-	0x5c, 0x95, 0xca, 0x17, 0x2c, 0xb4, 0x94, 0x2c, 0xd4, 0x36, 0x05, 0x66, 0xca, 0x9f, 0x8d, 0xdc,
-	0x22, 0xce, 0x27, 0xe9, 0x18, 0x91, 0x7a, 0x8c, 0xf0, 0xad, 0xcf, 0xa2, 0x90, 0x28, 0x78, 0x14,
-	0xb7, 0x9c, 0xff, 0x51, 0x64, 0xba, 0x8c, 0x53, 0xba, 0x77, 0x35, 0xc5, 0x63, 0xd1, 0x4b, 0x6a,
-	0xbb, 0x3d, 0xed, 0xcc, 0xa4, 0xa8, 0x59, 0x11, 0x97, 0x4f, 0x6b, 0xfa, 0x80, 0x69, 0x7d, 0xc0,
-	0x51, 0x95, 0xee, 0x02, 0xce, 0x8c, 0xab, 0x36, 0x57, 0xff, 0x47, 0x84, 0x73, 0x01, 0x28, 0x71,
-	0xb0, 0x45, 0x68, 0x0b, 0xc2, 0x87, 0x20, 0x25, 0x69, 0x0e, 0xaa, 0xe7, 0x30, 0x8f, 0xd1, 0xf3,
-	0xe5, 0x71, 0x7a, 0x01, 0x5b, 0x34, 0x34, 0xb7, 0x38, 0x75, 0x7e, 0x56, 0xb0, 0xee, 0x57, 0x03,
-	0x8b, 0x86, 0xe5, 0x77, 0x13, 0x7a, 0x13, 0xbb, 0x5f, 0xaa, 0x26, 0xdd, 0xbb, 0x8b, 0x38, 0x9f,
-	0x24, 0x4a, 0x6b, 0xae, 0x3c, 0x3a, 0xfd, 0xcb, 0x99, 0x39, 0x3d, 0x77, 0xd0, 0x93, 0x73, 0x07,
-	0xfd, 0x79, 0xee, 0xa0, 0x1f, 0x2e, 0x9c, 0x99, 0x27, 0x17, 0xce, 0xcc, 0xef, 0x17, 0xce, 0xcc,
-	0x17, 0x6b, 0xff, 0xa8, 0x6e, 0xc4, 0xd5, 0xa9, 0x96, 0x8a, 0x2f, 0xf5, 0xfa, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0x24, 0x58, 0xda, 0x12, 0x0d, 0x00, 0x00,
+	0x14, 0xc7, 0x3d, 0x9b, 0xc4, 0x34, 0x53, 0x84, 0xa8, 0xeb, 0xc6, 0x6b, 0x93, 0xae, 0x9d, 0x45,
+	0x02, 0x27, 0x22, 0xbb, 0x71, 0x22, 0x38, 0x98, 0x03, 0x8a, 0x63, 0x82, 0x2a, 0xb5, 0x55, 0xb5,
+	0x85, 0x03, 0x70, 0x08, 0xe3, 0xdd, 0x67, 0x67, 0x14, 0x7b, 0xc7, 0xcc, 0x8c, 0x13, 0xe7, 0x16,
+	0x21, 0xc4, 0x81, 0x13, 0x12, 0x17, 0xce, 0xfc, 0x05, 0x39, 0x24, 0xff, 0x01, 0x87, 0x1c, 0x2b,
+	0x4e, 0x9c, 0x02, 0x24, 0x87, 0xfe, 0x0f, 0x3d, 0x21, 0xef, 0xcc, 0xda, 0xb1, 0xb3, 0x69, 0x0b,
+	0x54, 0xbd, 0xb4, 0x17, 0xdb, 0x3b, 0xf3, 0x7e, 0x7c, 0xe7, 0x33, 0x6f, 0x9f, 0x1f, 0xbe, 0x4d,
+	0xfa, 0xd0, 0x26, 0xdc, 0x0d, 0xa1, 0xdf, 0x13, 0xee, 0x6e, 0xa5, 0x01, 0x92, 0x54, 0x5c, 0xd9,
+	0x77, 0xba, 0x9c, 0x49, 0x96, 0xc9, 0xaa, 0x6d, 0x27, 0xda, 0x76, 0xf4, 0x76, 0xc1, 0x6a, 0x31,
+	0xd6, 0x6a, 0x83, 0x1b, 0xd9, 0x34, 0x7a, 0x4d, 0x37, 0xe8, 0x71, 0x22, 0x29, 0x0b, 0x95, 0x57,
+	0x21, 0xdb, 0x62, 0x2d, 0x16, 0xfd, 0x74, 0x07, 0xbf, 0xf4, 0xaa, 0xe5, 0x33, 0xd1, 0x61, 0xc2,
+	0x6d, 0x10, 0x01, 0xc3, 0x4c, 0x3e, 0xa3, 0xb1, 0xd7, 0xe2, 0x98, 0x14, 0xe8, 0x77, 0x19, 0x97,
+	0x10, 0x8c, 0x34, 0xed, 0x77, 0x41, 0x68, 0xd3, 0x85, 0x44, 0xd5, 0x5d, 0xc2, 0x49, 0x27, 0x36,
+	0x71, 0xb4, 0x49, 0x17, 0x78, 0x87, 0x0a, 0x41, 0x59, 0xf8, 0xf4, 0x90, 0x79, 0xa5, 0x6e, 0x4b,
+	0xc9, 0x56, 0x0f, 0x7a, 0x2b, 0xa7, 0x85, 0x77, 0x44, 0xcb, 0xdd, 0xad, 0x0c, 0xbe, 0xf4, 0xc6,
+	0x0d, 0xd2, 0xa1, 0x21, 0x73, 0xa3, 0x4f, 0xb5, 0x64, 0xff, 0x66, 0x60, 0xcb, 0x83, 0x16, 0x15,
+	0x12, 0xf8, 0xc6, 0x36, 0xa1, 0xe1, 0x3d, 0x42, 0x43, 0x49, 0x68, 0x08, 0xdc, 0x83, 0x6f, 0x7b,
+	0x20, 0x64, 0xe6, 0x1b, 0x7c, 0x43, 0x40, 0x18, 0x00, 0xdf, 0x0a, 0xa0, 0xcb, 0xc1, 0x27, 0x12,
+	0x02, 0x13, 0x95, 0x50, 0xf9, 0xcd, 0xda, 0xda, 0x93, 0xd3, 0xe2, 0x72, 0x8b, 0xca, 0xed, 0x5e,
+	0xc3, 0xf1, 0x59, 0x47, 0xcb, 0xd0, 0x5f, 0xcb, 0x22, 0xd8, 0xd1, 0x92, 0xd7, 0x7d, 0x7f, 0x3d,
+	0x08, 0x38, 0x08, 0x61, 0x22, 0xef, 0x6d, 0x15, 0xad, 0x3e, 0x0c, 0x96, 0xf9, 0x1a, 0xa7, 0xfd,
+	0x41, 0x6e, 0x61, 0x1a, 0xa5, 0xa9, 0xf2, 0x6c, 0x6d, 0xe3, 0xc9, 0x69, 0xf1, 0x93, 0x0b, 0x61,
+	0x15, 0x9a, 0x10, 0xe4, 0x1e, 0xe3, 0x3b, 0xfa, 0x69, 0xd9, 0x67, 0x1c, 0xdc, 0xfe, 0x04, 0x7d,
+	0x27, 0x3a, 0xc3, 0x7d, 0xd2, 0x01, 0x4f, 0x87, 0xcc, 0xac, 0xe0, 0xb4, 0x4a, 0x68, 0x4e, 0x95,
+	0x50, 0x79, 0xb6, 0x66, 0xfe, 0x7e, 0xb4, 0x9c, 0xd5, 0xbc, 0xb4, 0xa8, 0x87, 0x92, 0xd3, 0xb0,
+	0xe5, 0x69, 0xbb, 0x6a, 0xe5, 0xe0, 0xd8, 0x44, 0xdf, 0x3d, 0x3e, 0x5c, 0xd2, 0x0b, 0x3f, 0x3e,
+	0x3e, 0x5c, 0xba, 0xad, 0xf2, 0x5c, 0x81, 0xca, 0x5e, 0xc0, 0xc5, 0x2b, 0x29, 0x8a, 0x2e, 0x0b,
+	0x05, 0xd8, 0x27, 0x06, 0x2e, 0xd5, 0x81, 0xbf, 0x66, 0x3d, 0xc6, 0x7a, 0x2d, 0x81, 0x75, 0x51,
+	0xe5, 0xb9, 0x12, 0x96, 0xfd, 0x2e, 0x5e, 0x78, 0x0a, 0x49, 0xcd, 0xfb, 0xd0, 0xc0, 0xd9, 0x75,
+	0x5f, 0xd2, 0x5d, 0x22, 0x21, 0xb2, 0x79, 0x65, 0x19, 0xbf, 0x7f, 0x70, 0x6c, 0x4e, 0x4d, 0x30,
+	0xbe, 0xa9, 0xf2, 0x8c, 0x01, 0xb2, 0x73, 0xf8, 0xd6, 0x04, 0x31, 0xcd, 0xf2, 0xc8, 0xc0, 0x73,
+	0x75, 0x20, 0xaf, 0x69, 0x02, 0xaf, 0x2e, 0x26, 0xd0, 0xbc, 0x15, 0x57, 0xec, 0x18, 0x22, 0x3b,
+	0x8f, 0x73, 0x97, 0xa8, 0x69, 0xa2, 0xbf, 0x1a, 0x38, 0x17, 0x77, 0x8c, 0x75, 0x21, 0x40, 0x6e,
+	0x02, 0xbc, 0x3c, 0xa4, 0x9f, 0xe1, 0x6b, 0x4d, 0x80, 0x2d, 0x1a, 0x36, 0x99, 0x69, 0x94, 0x50,
+	0xf9, 0xfa, 0xea, 0x7b, 0xce, 0xd8, 0x3f, 0xe7, 0x90, 0x98, 0xfe, 0xeb, 0x71, 0x36, 0x01, 0xee,
+	0x84, 0x4d, 0x56, 0x9b, 0x3e, 0x39, 0x2d, 0xa6, 0xbc, 0x37, 0x9a, 0xea, 0xf1, 0x3f, 0xe0, 0x5b,
+	0x3a, 0x38, 0x36, 0x8d, 0x09, 0x7c, 0x73, 0xe3, 0xcd, 0x35, 0xe6, 0x61, 0x17, 0xb0, 0x79, 0x99,
+	0x91, 0x06, 0x78, 0x30, 0x8d, 0xdf, 0x79, 0x08, 0xf2, 0x73, 0x4e, 0x42, 0xd1, 0x04, 0xee, 0x11,
+	0x09, 0x77, 0x69, 0x87, 0xca, 0x97, 0x07, 0xf1, 0x4b, 0x3c, 0x13, 0x15, 0x51, 0x44, 0xf0, 0x05,
+	0x95, 0xa5, 0x8a, 0x98, 0xf9, 0x10, 0xcf, 0xb4, 0x07, 0x87, 0x89, 0xa8, 0x5e, 0x5f, 0xcd, 0x3b,
+	0x1a, 0xe9, 0x60, 0x14, 0x19, 0x5e, 0xc9, 0x06, 0xa3, 0xa1, 0xbe, 0x0f, 0x65, 0x9d, 0xf9, 0x18,
+	0xa7, 0xf7, 0x68, 0x18, 0xb0, 0x3d, 0x73, 0x5a, 0xfb, 0xa9, 0xc1, 0xc7, 0x89, 0x07, 0x1f, 0xa7,
+	0xae, 0x07, 0x9f, 0xda, 0xb5, 0x81, 0xdf, 0x2f, 0x7f, 0x16, 0x91, 0xa7, 0x5d, 0x2e, 0x5c, 0xe5,
+	0xcc, 0xf3, 0x5d, 0x65, 0xe6, 0x3e, 0x9e, 0x0d, 0x28, 0x07, 0x7f, 0x10, 0xd0, 0x4c, 0x97, 0x50,
+	0xf9, 0xad, 0xd5, 0x95, 0x67, 0x94, 0x51, 0x7c, 0x5d, 0xf5, 0xd8, 0xcf, 0x1b, 0x85, 0xa8, 0x3a,
+	0x09, 0x6f, 0x56, 0x41, 0xa1, 0x4a, 0xba, 0x69, 0xdb, 0xc2, 0xf3, 0xc9, 0x15, 0xa0, 0x4b, 0xe4,
+	0x7b, 0x84, 0xcd, 0x4f, 0x43, 0xd2, 0x68, 0xc3, 0x5d, 0x1a, 0xee, 0xd4, 0xa1, 0xcb, 0xc4, 0xa8,
+	0x3e, 0x3e, 0xc2, 0xb3, 0xa4, 0x27, 0xb7, 0x19, 0xa7, 0x72, 0xdf, 0x44, 0xcf, 0x38, 0xf1, 0xc8,
+	0xb4, 0xba, 0x12, 0x8b, 0x1c, 0xad, 0x0d, 0x74, 0xe6, 0x94, 0xce, 0x4b, 0xe9, 0x4c, 0x64, 0x17,
+	0x71, 0x3e, 0x41, 0x85, 0xd2, 0x58, 0x35, 0x4c, 0x64, 0xff, 0x80, 0x70, 0xbe, 0x4e, 0xc5, 0x0b,
+	0x16, 0x5a, 0x49, 0x16, 0x6a, 0xea, 0x56, 0x75, 0x29, 0x9f, 0x89, 0xec, 0x12, 0x2e, 0x24, 0xe9,
+	0xb8, 0x20, 0xf5, 0x08, 0xe1, 0x9b, 0x5f, 0x74, 0x03, 0x22, 0xe1, 0x41, 0x34, 0xbc, 0xfe, 0x4f,
+	0x91, 0x99, 0x2a, 0x4e, 0xab, 0x29, 0x58, 0xb7, 0xa1, 0x79, 0x27, 0x69, 0x80, 0x77, 0x54, 0x32,
+	0x5d, 0xec, 0xda, 0xa3, 0xba, 0x18, 0x77, 0x92, 0xf1, 0x03, 0x66, 0xd4, 0x01, 0x2f, 0xaa, 0xb4,
+	0xe7, 0x70, 0x76, 0x5c, 0xb5, 0xae, 0x90, 0x9f, 0x11, 0xce, 0x7b, 0x20, 0xf9, 0xfe, 0x26, 0xa1,
+	0x6d, 0x08, 0xee, 0x81, 0x10, 0xa4, 0x35, 0xec, 0xc3, 0xa3, 0x37, 0x02, 0x3d, 0xe7, 0x1b, 0x31,
+	0x87, 0x0d, 0x1a, 0xe8, 0x7e, 0x90, 0x3e, 0x3b, 0x2d, 0x1a, 0x77, 0xea, 0x9e, 0x41, 0x83, 0xea,
+	0x07, 0x09, 0x53, 0x8e, 0x19, 0x37, 0xbd, 0xc9, 0xf4, 0xf6, 0x3c, 0x2e, 0x24, 0x89, 0x52, 0x9a,
+	0x6b, 0x0f, 0x4e, 0xfe, 0xb6, 0x52, 0x27, 0x67, 0x16, 0x7a, 0x74, 0x66, 0xa1, 0xbf, 0xce, 0x2c,
+	0xf4, 0xd3, 0xb9, 0x95, 0x7a, 0x74, 0x6e, 0xa5, 0xfe, 0x38, 0xb7, 0x52, 0x5f, 0xad, 0xfe, 0xab,
+	0x0e, 0x14, 0xf5, 0xb9, 0x46, 0x3a, 0x6a, 0x0f, 0x6b, 0xff, 0x0c, 0x00, 0x88, 0xa3, 0xbc, 0xd4,
+	0x5c, 0x0d, 0x00, 0x00,
 }
 
 func (m *RegisterChainMaintainerRequest) Marshal() (dAtA []byte, err error) {
@@ -1274,6 +1277,11 @@ func (m *SetTransferRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1757,6 +1765,9 @@ func (m *SetTransferRateLimitRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -3053,6 +3064,25 @@ func (m *SetTransferRateLimitRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
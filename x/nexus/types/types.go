@@ -161,3 +161,62 @@ const (
 	// External means from external chains, such as EVM chains
 	External = 3
 )
+
+// ValidateBasic returns an error if the given RateLimit is invalid, nil otherwise
+func (m RateLimit) ValidateBasic() error {
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.Limit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid limit")
+	}
+
+	if m.Window <= 0 {
+		return fmt.Errorf("window must be positive")
+	}
+
+	if err := m.Direction.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateBasic returns an error if the given TransferEpoch is invalid, nil otherwise
+func (m TransferEpoch) ValidateBasic() error {
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid amount")
+	}
+
+	if err := m.Direction.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewChainPollState is the constructor for ChainPollState
+func NewChainPollState(chain exported.ChainName) ChainPollState {
+	return ChainPollState{
+		Chain:       chain,
+		FailedPolls: utils.NewBitmap(maxBitmapSize),
+	}
+}
+
+// ValidateBasic returns an error if the given ChainPollState is invalid, nil otherwise
+func (m ChainPollState) ValidateBasic() error {
+	if err := m.Chain.Validate(); err != nil {
+		return err
+	}
+
+	if m.FailedPolls.TrueCountCache == nil {
+		return fmt.Errorf("failed polls must be set")
+	}
+
+	return nil
+}
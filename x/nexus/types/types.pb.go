@@ -113,6 +113,48 @@ func (m *ChainState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChainState proto.InternalMessageInfo
 
+// ChainPollState records which of the latest polls of a chain failed or
+// expired without reaching a majority
+type ChainPollState struct {
+	Chain       github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	FailedPolls utils.Bitmap                                                    `protobuf:"bytes,2,opt,name=failed_polls,json=failedPolls,proto3" json:"failed_polls"`
+	// number of polls recorded since the chain was last (re)activated
+	PollCount uint64 `protobuf:"varint,3,opt,name=poll_count,json=pollCount,proto3" json:"poll_count,omitempty"`
+}
+
+func (m *ChainPollState) Reset()         { *m = ChainPollState{} }
+func (m *ChainPollState) String() string { return proto.CompactTextString(m) }
+func (*ChainPollState) ProtoMessage()    {}
+func (*ChainPollState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecc98625accd1ae9, []int{2}
+}
+func (m *ChainPollState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainPollState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainPollState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainPollState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainPollState.Merge(m, src)
+}
+func (m *ChainPollState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainPollState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainPollState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainPollState proto.InternalMessageInfo
+
 type LinkedAddresses struct {
 	DepositAddress   exported.CrossChainAddress `protobuf:"bytes,1,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address"`
 	RecipientAddress exported.CrossChainAddress `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address"`
@@ -122,7 +164,7 @@ func (m *LinkedAddresses) Reset()         { *m = LinkedAddresses{} }
 func (m *LinkedAddresses) String() string { return proto.CompactTextString(m) }
 func (*LinkedAddresses) ProtoMessage()    {}
 func (*LinkedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecc98625accd1ae9, []int{2}
+	return fileDescriptor_ecc98625accd1ae9, []int{3}
 }
 func (m *LinkedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,13 +197,15 @@ type RateLimit struct {
 	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,1,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
 	Limit  types.Coin                                                      `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
 	Window time.Duration                                                   `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+	// transfers from the chain are limited separately from transfers to it
+	Direction exported.TransferDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=axelar.nexus.exported.v1beta1.TransferDirection" json:"direction,omitempty"`
 }
 
 func (m *RateLimit) Reset()         { *m = RateLimit{} }
 func (m *RateLimit) String() string { return proto.CompactTextString(m) }
 func (*RateLimit) ProtoMessage()    {}
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecc98625accd1ae9, []int{3}
+	return fileDescriptor_ecc98625accd1ae9, []int{4}
 }
 func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +245,7 @@ func (m *TransferEpoch) Reset()         { *m = TransferEpoch{} }
 func (m *TransferEpoch) String() string { return proto.CompactTextString(m) }
 func (*TransferEpoch) ProtoMessage()    {}
 func (*TransferEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecc98625accd1ae9, []int{4}
+	return fileDescriptor_ecc98625accd1ae9, []int{5}
 }
 func (m *TransferEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,6 +277,7 @@ var xxx_messageInfo_TransferEpoch proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MaintainerState)(nil), "axelar.nexus.v1beta1.MaintainerState")
 	proto.RegisterType((*ChainState)(nil), "axelar.nexus.v1beta1.ChainState")
+	proto.RegisterType((*ChainPollState)(nil), "axelar.nexus.v1beta1.ChainPollState")
 	proto.RegisterType((*LinkedAddresses)(nil), "axelar.nexus.v1beta1.LinkedAddresses")
 	proto.RegisterType((*RateLimit)(nil), "axelar.nexus.v1beta1.RateLimit")
 	proto.RegisterType((*TransferEpoch)(nil), "axelar.nexus.v1beta1.TransferEpoch")
@@ -241,51 +286,54 @@ func init() {
 func init() { proto.RegisterFile("axelar/nexus/v1beta1/types.proto", fileDescriptor_ecc98625accd1ae9) }
 
 var fileDescriptor_ecc98625accd1ae9 = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x96, 0xb6, 0xb6, 0xc3, 0x8f, 0x96, 0x0d, 0x87, 0x42, 0xc8, 0xb6, 0x36, 0x9a, 0xe0,
-	0x81, 0x59, 0xc1, 0x18, 0x0f, 0x1e, 0x94, 0x05, 0x63, 0x02, 0x48, 0xcc, 0x6a, 0x48, 0xe4, 0xd2,
-	0x4c, 0x77, 0x1f, 0x65, 0x42, 0x77, 0xa7, 0x99, 0x99, 0x42, 0xfd, 0x13, 0xbc, 0x79, 0xe4, 0x4f,
-	0xe2, 0x26, 0x37, 0x8d, 0x07, 0x54, 0xf8, 0x2f, 0x38, 0x99, 0x9d, 0x99, 0xed, 0x02, 0x21, 0x01,
-	0xa3, 0x9e, 0xba, 0xf3, 0xfa, 0xbe, 0xef, 0x7d, 0xef, 0xcd, 0x37, 0x0f, 0x35, 0xc9, 0x10, 0x7a,
-	0x84, 0xbb, 0x31, 0x0c, 0x07, 0xc2, 0x3d, 0x58, 0xea, 0x80, 0x24, 0x4b, 0xae, 0xfc, 0xd8, 0x07,
-	0x81, 0xfb, 0x9c, 0x49, 0x66, 0xcf, 0xe8, 0x0c, 0xac, 0x32, 0xb0, 0xc9, 0x98, 0x73, 0xba, 0x8c,
-	0x75, 0x7b, 0xe0, 0xaa, 0x9c, 0xce, 0x60, 0xd7, 0x0d, 0x07, 0x9c, 0x48, 0xca, 0x62, 0x8d, 0x9a,
-	0x9b, 0xe9, 0xb2, 0x2e, 0x53, 0x9f, 0x6e, 0xf2, 0x65, 0xa2, 0x4e, 0xc0, 0x44, 0xc4, 0x84, 0xdb,
-	0x21, 0x02, 0x46, 0xc5, 0x02, 0x46, 0x53, 0xd4, 0xa3, 0x2b, 0x6a, 0x60, 0xd8, 0x67, 0x5c, 0x42,
-	0x78, 0x93, 0xac, 0xb9, 0xfb, 0x26, 0x75, 0x20, 0x69, 0x2f, 0x13, 0xde, 0xa1, 0x32, 0x22, 0x7d,
-	0x9d, 0xd2, 0xfa, 0x92, 0x47, 0xd5, 0x37, 0x84, 0xc6, 0x92, 0xd0, 0x18, 0xf8, 0x3b, 0x49, 0x24,
-	0xd8, 0x1b, 0xe8, 0x1e, 0x09, 0x43, 0x0e, 0x42, 0xd4, 0xad, 0xa6, 0xb5, 0x30, 0xe1, 0x2d, 0x5d,
-	0x9c, 0x36, 0x16, 0xbb, 0x54, 0xee, 0x0d, 0x3a, 0x38, 0x60, 0x91, 0x6b, 0x14, 0xea, 0x9f, 0x45,
-	0x11, 0xee, 0x9b, 0xaa, 0xdb, 0xa4, 0xb7, 0xa2, 0x81, 0x7e, 0xca, 0x60, 0xbf, 0x46, 0x93, 0x11,
-	0x15, 0x82, 0xc6, 0xdd, 0xf6, 0x01, 0x93, 0x20, 0xea, 0xf9, 0xa6, 0xb5, 0x30, 0xbe, 0x3c, 0x8f,
-	0xcd, 0xc8, 0x94, 0xb6, 0x74, 0x64, 0xd8, 0x53, 0xda, 0xbc, 0xc2, 0xf1, 0x69, 0x23, 0xe7, 0x4f,
-	0x18, 0xe0, 0x76, 0x82, 0xb3, 0x37, 0x50, 0x95, 0xc6, 0x01, 0xe3, 0x1c, 0x02, 0x69, 0xa8, 0xc6,
-	0xee, 0x4c, 0x35, 0x35, 0x82, 0x6a, 0xb2, 0x0f, 0xa8, 0x18, 0xec, 0x11, 0x1a, 0xd7, 0x0b, 0x4d,
-	0x6b, 0xa1, 0xe2, 0xad, 0x5e, 0x9c, 0x36, 0x5e, 0x5c, 0x6a, 0x50, 0x13, 0xc6, 0x20, 0x0f, 0x19,
-	0xdf, 0x37, 0xa7, 0xc5, 0x80, 0x71, 0x70, 0x87, 0xd7, 0xe6, 0x8e, 0x57, 0x13, 0x9a, 0x2d, 0x12,
-	0x81, 0xaf, 0x19, 0x5b, 0x47, 0x79, 0x84, 0x54, 0x50, 0x0f, 0xf3, 0x65, 0x5a, 0xc9, 0x52, 0x62,
-	0x1f, 0xe0, 0x2b, 0x56, 0x19, 0xd1, 0xa4, 0xaa, 0x15, 0xd2, 0x88, 0xd6, 0x40, 0x7b, 0x1e, 0x55,
-	0x48, 0x20, 0xe9, 0x01, 0x91, 0x10, 0xaa, 0x96, 0xcb, 0x7e, 0x16, 0xb0, 0x3d, 0x54, 0x22, 0x42,
-	0x80, 0x14, 0xf5, 0x62, 0x73, 0xec, 0x0e, 0x05, 0x56, 0x92, 0x64, 0x53, 0xc0, 0x20, 0xed, 0x1d,
-	0x34, 0x1d, 0x8d, 0x3c, 0xd0, 0x16, 0x89, 0x6e, 0x51, 0x2f, 0x29, 0xba, 0x87, 0xf8, 0x26, 0x6b,
-	0xe3, 0x6b, 0x96, 0xf1, 0x4a, 0x09, 0x5f, 0xdd, 0xf2, 0x6b, 0xd1, 0xd5, 0x3f, 0xc4, 0x7a, 0xa1,
-	0x5c, 0xa8, 0x15, 0xd7, 0x0b, 0xe5, 0x7c, 0x6d, 0xac, 0xf5, 0xd5, 0x42, 0xd5, 0x4d, 0x1a, 0xef,
-	0x43, 0x68, 0x6c, 0x02, 0xc2, 0x6e, 0xa3, 0x6a, 0x08, 0x7d, 0x26, 0xa8, 0x6c, 0x5f, 0x36, 0xdd,
-	0xf8, 0xf2, 0xe3, 0xdb, 0x26, 0xc5, 0x99, 0x10, 0x6a, 0x5c, 0x86, 0x2c, 0xbd, 0x6a, 0x43, 0x67,
-	0xa2, 0x76, 0x80, 0xa6, 0x39, 0x04, 0xb4, 0x4f, 0x21, 0xce, 0x4a, 0xe4, 0xff, 0xaa, 0x44, 0x6d,
-	0x44, 0x68, 0xe2, 0xad, 0xef, 0x16, 0xaa, 0xf8, 0x44, 0xc2, 0x26, 0x8d, 0xa8, 0xcc, 0xdc, 0x65,
-	0xfd, 0x6b, 0x77, 0xd9, 0x4f, 0x51, 0xb1, 0x97, 0xd4, 0x30, 0x1d, 0xcc, 0x62, 0xfd, 0x08, 0x71,
-	0xb2, 0x2d, 0x32, 0xdd, 0x2c, 0xf3, 0x90, 0xca, 0xb6, 0x9f, 0xa3, 0xd2, 0x21, 0x8d, 0x43, 0x76,
-	0x68, 0xde, 0xcc, 0x2c, 0xd6, 0xbb, 0x09, 0xa7, 0xbb, 0x09, 0xaf, 0x99, 0xdd, 0xe4, 0x95, 0x13,
-	0xdc, 0xd1, 0x8f, 0x86, 0xe5, 0x1b, 0x48, 0xeb, 0x53, 0x1e, 0x4d, 0xbe, 0xe7, 0x24, 0x16, 0xbb,
-	0xc0, 0x5f, 0xf5, 0x59, 0xb0, 0xf7, 0x3f, 0x1b, 0x7c, 0x86, 0x4a, 0x24, 0x62, 0x83, 0xf8, 0xce,
-	0x1d, 0x9a, 0x74, 0x7b, 0x06, 0x15, 0x21, 0x11, 0xa7, 0x3a, 0x2c, 0xf8, 0xfa, 0x60, 0x6f, 0xa1,
-	0x4a, 0x48, 0x93, 0x77, 0x4f, 0x99, 0x7e, 0xec, 0x53, 0xb7, 0xde, 0x7a, 0xda, 0xea, 0x5a, 0x8a,
-	0xf3, 0x33, 0x0a, 0xef, 0xed, 0xf1, 0x2f, 0x27, 0x77, 0x7c, 0xe6, 0x58, 0x27, 0x67, 0x8e, 0xf5,
-	0xf3, 0xcc, 0xb1, 0x3e, 0x9f, 0x3b, 0xb9, 0x93, 0x73, 0x27, 0xf7, 0xed, 0xdc, 0xc9, 0xed, 0x2c,
-	0xff, 0xd1, 0x10, 0xd4, 0xd2, 0xec, 0x94, 0xd4, 0x15, 0x3c, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0x46, 0x48, 0x7e, 0x36, 0x66, 0x06, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x8d, 0x4d, 0x92, 0x26, 0xc3, 0x23, 0xc1, 0x62, 0x11, 0x10, 0x75, 0xd2, 0xa8, 0x95, 0xe8,
+	0x82, 0x71, 0xa1, 0xaa, 0xba, 0xe8, 0xa2, 0xc5, 0x80, 0x2a, 0x01, 0x45, 0xc8, 0xad, 0x90, 0x60,
+	0x13, 0x4d, 0xec, 0x21, 0x8c, 0xb0, 0x3d, 0x96, 0x67, 0x02, 0xe9, 0x27, 0x74, 0xd7, 0x25, 0xdf,
+	0xd0, 0x2f, 0x61, 0x57, 0xba, 0x6a, 0x57, 0xb4, 0x85, 0xbf, 0x60, 0x55, 0xcd, 0xc3, 0x09, 0x20,
+	0x24, 0x40, 0x85, 0x15, 0xf6, 0xe5, 0x9c, 0x73, 0xcf, 0x9c, 0xb9, 0xbe, 0x01, 0x0d, 0xd4, 0xc3,
+	0x21, 0x4a, 0x9d, 0x18, 0xf7, 0xba, 0xcc, 0xd9, 0x9f, 0x6b, 0x63, 0x8e, 0xe6, 0x1c, 0xfe, 0x39,
+	0xc1, 0x0c, 0x26, 0x29, 0xe5, 0xd4, 0x9a, 0x50, 0x08, 0x28, 0x11, 0x50, 0x23, 0xa6, 0xec, 0x0e,
+	0xa5, 0x9d, 0x10, 0x3b, 0x12, 0xd3, 0xee, 0xee, 0x38, 0x41, 0x37, 0x45, 0x9c, 0xd0, 0x58, 0xb1,
+	0xa6, 0x26, 0x3a, 0xb4, 0x43, 0xe5, 0xa3, 0x23, 0x9e, 0x74, 0xd5, 0xf6, 0x29, 0x8b, 0x28, 0x73,
+	0xda, 0x88, 0xe1, 0x7e, 0x33, 0x9f, 0x92, 0x8c, 0xf5, 0xfc, 0x92, 0x1b, 0xdc, 0x4b, 0x68, 0xca,
+	0x71, 0x70, 0x9d, 0xad, 0xa9, 0x27, 0x1a, 0xda, 0xe5, 0x24, 0x1c, 0x18, 0x6f, 0x13, 0x1e, 0xa1,
+	0x44, 0x41, 0x9a, 0xdf, 0x4d, 0x50, 0xf9, 0x80, 0x48, 0xcc, 0x11, 0x89, 0x71, 0xfa, 0x91, 0x23,
+	0x8e, 0xad, 0x55, 0xf0, 0x08, 0x05, 0x41, 0x8a, 0x19, 0xab, 0x19, 0x0d, 0x63, 0x66, 0xc4, 0x9d,
+	0x3b, 0x3f, 0xa9, 0xcf, 0x76, 0x08, 0xdf, 0xed, 0xb6, 0xa1, 0x4f, 0x23, 0x47, 0x3b, 0x54, 0x7f,
+	0x66, 0x59, 0xb0, 0xa7, 0xbb, 0x6e, 0xa2, 0x70, 0x41, 0x11, 0xbd, 0x4c, 0xc1, 0x7a, 0x0f, 0x46,
+	0x23, 0xc2, 0x18, 0x89, 0x3b, 0xad, 0x7d, 0xca, 0x31, 0xab, 0x99, 0x0d, 0x63, 0x66, 0x78, 0x7e,
+	0x1a, 0xea, 0xc8, 0xa4, 0xb7, 0x2c, 0x32, 0xe8, 0x4a, 0x6f, 0x6e, 0xfe, 0xe8, 0xa4, 0x9e, 0xf3,
+	0x46, 0x34, 0x71, 0x53, 0xf0, 0xac, 0x55, 0x50, 0x21, 0xb1, 0x4f, 0xd3, 0x14, 0xfb, 0x5c, 0x4b,
+	0x0d, 0xdd, 0x5a, 0x6a, 0xac, 0x4f, 0x55, 0x62, 0x5b, 0xa0, 0xe0, 0xef, 0x22, 0x12, 0xd7, 0xf2,
+	0x0d, 0x63, 0xa6, 0xec, 0x2e, 0x9e, 0x9f, 0xd4, 0xdf, 0x5e, 0x38, 0xa0, 0x12, 0x8c, 0x31, 0x3f,
+	0xa0, 0xe9, 0x9e, 0x7e, 0x9b, 0xf5, 0x69, 0x8a, 0x9d, 0xde, 0x95, 0xdc, 0xe1, 0xa2, 0x90, 0x59,
+	0x47, 0x11, 0xf6, 0x94, 0x62, 0xf3, 0xd0, 0x04, 0x40, 0x16, 0x55, 0x98, 0xef, 0xb2, 0x4e, 0x86,
+	0x34, 0xfb, 0x14, 0x5e, 0x1a, 0x95, 0xbe, 0x4c, 0xe6, 0x5a, 0x32, 0xb5, 0x69, 0x45, 0xb4, 0xa6,
+	0x41, 0x19, 0xf9, 0x9c, 0xec, 0x23, 0x8e, 0x03, 0x79, 0xe4, 0x92, 0x37, 0x28, 0x58, 0x2e, 0x28,
+	0x22, 0xc6, 0x30, 0x67, 0xb5, 0x42, 0x63, 0xe8, 0x16, 0x0d, 0x16, 0x04, 0x58, 0x37, 0xd0, 0x4c,
+	0x6b, 0x1b, 0x8c, 0x47, 0xfd, 0x19, 0x68, 0x31, 0xe1, 0x9b, 0xd5, 0x8a, 0x52, 0xee, 0x19, 0xbc,
+	0x6e, 0xb4, 0xe1, 0x95, 0x91, 0x71, 0x8b, 0x42, 0xaf, 0x66, 0x78, 0xd5, 0xe8, 0xf2, 0x3f, 0xd8,
+	0x4a, 0xbe, 0x94, 0xaf, 0x16, 0x56, 0xf2, 0x25, 0xb3, 0x3a, 0xd4, 0xfc, 0x61, 0x80, 0x31, 0x79,
+	0xc0, 0x0d, 0x1a, 0x86, 0x2a, 0x9e, 0xad, 0x8b, 0xf1, 0xdc, 0xeb, 0x45, 0x58, 0xcb, 0x60, 0x64,
+	0x07, 0x91, 0x10, 0x07, 0xad, 0x84, 0x86, 0xe1, 0x5d, 0x06, 0x6f, 0x58, 0xf1, 0x84, 0x4b, 0x66,
+	0x3d, 0x06, 0x40, 0xf0, 0x5b, 0x3e, 0xed, 0xc6, 0x5c, 0xe6, 0x9f, 0xf7, 0xca, 0xa2, 0xb2, 0x28,
+	0x0a, 0xcd, 0x9f, 0x06, 0xa8, 0xac, 0x91, 0x78, 0x0f, 0x07, 0x7a, 0xf4, 0x31, 0xb3, 0x5a, 0xa0,
+	0x12, 0xe0, 0x84, 0x32, 0xc2, 0x5b, 0x17, 0x3f, 0xa4, 0xe1, 0xf9, 0x17, 0x37, 0xdd, 0x7e, 0x4a,
+	0x19, 0x93, 0x07, 0xd1, 0x62, 0xd9, 0xf8, 0x6a, 0x39, 0x5d, 0xb5, 0x7c, 0x30, 0x9e, 0x62, 0x9f,
+	0x24, 0x04, 0xc7, 0x83, 0x16, 0xe6, 0x7f, 0xb5, 0xa8, 0xf6, 0x05, 0x75, 0xbd, 0xf9, 0xcd, 0x04,
+	0x65, 0x0f, 0x71, 0xbc, 0x46, 0x22, 0xc2, 0x1f, 0xf2, 0xa2, 0x5e, 0x81, 0x42, 0x28, 0x7a, 0xe8,
+	0x13, 0x4c, 0x42, 0xb5, 0x58, 0xa0, 0xd8, 0x80, 0x03, 0xdf, 0x74, 0xf0, 0x5d, 0x48, 0xb4, 0xf5,
+	0x06, 0x14, 0x0f, 0x48, 0x1c, 0xd0, 0x03, 0xbd, 0x07, 0x26, 0xa1, 0xda, 0xb7, 0x30, 0xdb, 0xb7,
+	0x70, 0x49, 0xef, 0x5b, 0xb7, 0x24, 0x78, 0x87, 0xbf, 0xeb, 0x86, 0xa7, 0x29, 0xd6, 0x3a, 0x28,
+	0x07, 0x44, 0xec, 0x03, 0x42, 0xd5, 0x12, 0x18, 0xbb, 0x31, 0xb9, 0x4f, 0x29, 0x8a, 0xd9, 0x0e,
+	0x4e, 0x97, 0x32, 0x9e, 0x37, 0x90, 0x68, 0x7e, 0x31, 0xc1, 0x68, 0x06, 0x58, 0x4e, 0xa8, 0xbf,
+	0xfb, 0x90, 0x81, 0xbd, 0x06, 0x45, 0x14, 0xc9, 0x71, 0xbc, 0x65, 0x62, 0x1a, 0x6e, 0x4d, 0x80,
+	0x02, 0x16, 0xe6, 0xf4, 0x18, 0xab, 0x97, 0xfb, 0xce, 0xc2, 0xdd, 0x38, 0xfa, 0x6b, 0xe7, 0x8e,
+	0x4e, 0x6d, 0xe3, 0xf8, 0xd4, 0x36, 0xfe, 0x9c, 0xda, 0xc6, 0xd7, 0x33, 0x3b, 0x77, 0x7c, 0x66,
+	0xe7, 0x7e, 0x9d, 0xd9, 0xb9, 0xed, 0xf9, 0x3b, 0x85, 0x20, 0x7f, 0x58, 0xda, 0x45, 0x79, 0xa5,
+	0x2f, 0xff, 0x0d, 0x00, 0x15, 0x5c, 0x98, 0x5f, 0x8a, 0x07, 0x00, 0x00,
 }
 
 func (m *MaintainerState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +464,51 @@ func (m *ChainState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainPollState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainPollState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainPollState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PollCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FailedPolls.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LinkedAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -479,12 +572,17 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
-	if err6 != nil {
-		return 0, err6
+	if m.Direction != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -615,6 +713,24 @@ func (m *ChainState) Size() (n int) {
 	return n
 }
 
+func (m *ChainPollState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.FailedPolls.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PollCount != 0 {
+		n += 1 + sovTypes(uint64(m.PollCount))
+	}
+	return n
+}
+
 func (m *LinkedAddresses) Size() (n int) {
 	if m == nil {
 		return 0
@@ -642,6 +758,9 @@ func (m *RateLimit) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Direction != 0 {
+		n += 1 + sovTypes(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -1025,6 +1144,140 @@ func (m *ChainState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainPollState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainPollState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainPollState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPolls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedPolls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollCount", wireType)
+			}
+			m.PollCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LinkedAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1268,6 +1521,25 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= exported.TransferDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,65 @@
+package permission
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// EndBlocker executes the queued actions whose timelock has expired
+func EndBlocker(ctx sdk.Context, k keeper.Keeper, router *baseapp.MsgServiceRouter) ([]abci.ValidatorUpdate, error) {
+	for _, action := range k.GetDueActions(ctx) {
+		// the action is removed regardless of the outcome, so a failing action cannot block the queue
+		k.DeleteQueuedAction(ctx, action.ID)
+
+		if err := executeAction(ctx, k, router, action); err != nil {
+			events.Emit(ctx, &types.ActionExecutionFailed{
+				ID:         action.ID,
+				MsgTypeURL: action.Msg.TypeUrl,
+				Error:      err.Error(),
+			})
+			k.Logger(ctx).Info(fmt.Sprintf("failed to execute queued action %d (%s): %s", action.ID, action.Msg.TypeUrl, err.Error()))
+
+			continue
+		}
+
+		events.Emit(ctx, &types.ActionExecuted{
+			ID:         action.ID,
+			MsgTypeURL: action.Msg.TypeUrl,
+		})
+		k.Logger(ctx).Info(fmt.Sprintf("executed queued action %d (%s)", action.ID, action.Msg.TypeUrl))
+	}
+
+	return nil, nil
+}
+
+func executeAction(ctx sdk.Context, k keeper.Keeper, router *baseapp.MsgServiceRouter, action types.QueuedAction) error {
+	msg := action.UnwrapMessage()
+
+	// the sender's role might have been revoked while the action was queued
+	if err := k.CheckMessageRole(ctx, action.Sender, msg); err != nil {
+		return err
+	}
+
+	handler := router.Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("unrecognized message type: %s", sdk.MsgTypeURL(msg))
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	res, err := handler(cachedCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package permission_test
+
+import (
+	"testing"
+
+	store "cosmossdk.io/store/types"
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/permission"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+	"github.com/axelarnetwork/utils/slices"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestEndBlocker(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		k          keeper.Keeper
+		msgServer  types.MsgServer
+		router     *bam.MsgServiceRouter
+		governance sdk.AccAddress
+		controller sdk.AccAddress
+		action     *types.QueueActionResponse
+	)
+
+	encCfg := app.MakeEncodingConfig()
+
+	endBlock := func(height int64) func() {
+		return func() {
+			ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+			_, err := permission.EndBlocker(ctx, k, router)
+			assert.NoError(t, err)
+		}
+	}
+
+	hasEvent := func(event string) bool {
+		return slices.Any(ctx.EventManager().Events(), func(e sdk.Event) bool { return e.Type == event })
+	}
+
+	Given("a controller registration queued by the governance key", func() {
+		subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "permission")
+		k = keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+		ctx = rand.Context(fake.NewMultiStore(), t).WithBlockHeight(rand.I64Between(1, 1000000))
+		msgServer = keeper.NewMsgServerImpl(k, encCfg.Codec)
+
+		router = bam.NewMsgServiceRouter()
+		router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		types.RegisterMsgServer(router, msgServer)
+
+		governance = rand.AccAddr()
+		params := types.DefaultParams()
+		params.TimelockDelays = []types.TimelockDelay{{MsgTypeURL: sdk.MsgTypeURL(&types.RegisterControllerRequest{}), Delay: rand.I64Between(10, 100)}}
+		k.InitGenesis(ctx, types.NewGenesisState(params, nil, []types.GovAccount{types.NewGovAccount(governance, exported.ROLE_ACCESS_CONTROL)}, nil))
+
+		controller = rand.AccAddr()
+		var err error
+		action, err = msgServer.QueueAction(ctx, types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(governance, controller)))
+		assert.NoError(t, err)
+	}).
+		Branch(
+			When("the timelock has not expired", func() { endBlock(action.ExecuteAt - 1)() }).
+				Then("the action is not executed", func(t *testing.T) {
+					assert.Equal(t, exported.ROLE_UNRESTRICTED, k.GetRole(ctx, controller))
+
+					_, ok := k.GetQueuedAction(ctx, action.ID)
+					assert.True(t, ok)
+				}),
+
+			When("the timelock has expired", func() { endBlock(action.ExecuteAt)() }).
+				Then("the action is executed and removed from the queue", func(t *testing.T) {
+					assert.Equal(t, exported.ROLE_CHAIN_MANAGEMENT, k.GetRole(ctx, controller))
+					assert.True(t, hasEvent("axelar.permission.v1beta1.ActionExecuted"))
+
+					_, ok := k.GetQueuedAction(ctx, action.ID)
+					assert.False(t, ok)
+				}),
+
+			When("the action is cancelled", func() {
+				_, err := msgServer.CancelAction(ctx, types.NewCancelActionRequest(governance, action.ID))
+				assert.NoError(t, err)
+			}).
+				When("the timelock has expired", func() { endBlock(action.ExecuteAt)() }).
+				Then("the action is not executed", func(t *testing.T) {
+					assert.Equal(t, exported.ROLE_UNRESTRICTED, k.GetRole(ctx, controller))
+					assert.False(t, hasEvent("axelar.permission.v1beta1.ActionExecuted"))
+				}),
+
+			When("the governance key is rotated before the timelock expires", func() {
+				_, err := msgServer.UpdateGovernanceKey(ctx, types.NewUpdateGovernanceKeyRequest(governance, 1, secp256k1.GenPrivKey().PubKey()))
+				assert.NoError(t, err)
+			}).
+				When("the timelock has expired", func() { endBlock(action.ExecuteAt)() }).
+				Then("the action of the former governance key is not executed", func(t *testing.T) {
+					assert.Equal(t, exported.ROLE_UNRESTRICTED, k.GetRole(ctx, controller))
+					assert.True(t, hasEvent("axelar.permission.v1beta1.ActionExecutionFailed"))
+
+					_, ok := k.GetQueuedAction(ctx, action.ID)
+					assert.False(t, ok)
+				}),
+
+			When("the action fails", func() {
+				_, err := msgServer.RegisterController(ctx, types.NewRegisterControllerRequest(governance, controller))
+				assert.NoError(t, err)
+			}).
+				When("the timelock has expired", func() { endBlock(action.ExecuteAt)() }).
+				Then("the failure is reported and the action removed from the queue", func(t *testing.T) {
+					assert.True(t, hasEvent("axelar.permission.v1beta1.ActionExecutionFailed"))
+
+					_, ok := k.GetQueuedAction(ctx, action.ID)
+					assert.False(t, ok)
+				}),
+		).
+		Run(t)
+}
@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 	permissionQueryCmd.AddCommand(
 		GetCmdGovernanceKey(),
 		GetParams(),
+		GetCmdQueuedActions(),
 	)
 
 	return permissionQueryCmd
@@ -82,3 +83,36 @@ func GetParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueuedActions returns the cli command to query the actions waiting for their timelock to expire
+func GetCmdQueuedActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-actions",
+		Short: "Returns the actions waiting for their timelock to expire",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedActions(cmd.Context(), &types.QueuedActionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued actions")
+	return cmd
+}
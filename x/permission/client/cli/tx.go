@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 
+	txsigning "cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/axelarnetwork/axelar-core/x/permission/types"
 )
@@ -28,6 +38,12 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRegisterController(),
 		GetCmdDeregisterController(),
 		GetCmdUpdateGovernanceKey(),
+		GetCmdQueueAction(),
+		GetCmdCancelAction(),
+		GetCmdGenerateGovernanceTx(),
+		GetCmdSignGovernanceTx(),
+		GetCmdMergeGovernanceSignatures(),
+		GetCmdBroadcastGovernanceTx(),
 	)
 
 	return govTxCmd
@@ -119,3 +135,411 @@ func GetCmdDeregisterController() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueueAction returns the cli command to queue a message that is subject to a timelock
+func GetCmdQueueAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue-action [msg-file]",
+		Short: "Queue a message that is subject to a timelock",
+		Long: `Queue a message that is subject to a timelock. It is executed once the delay configured for its type has passed.
+The message file must contain a single JSON message including its "@type", signed by the sender of this transaction.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewQueueActionRequest(clientCtx.GetFromAddress(), msg))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelAction returns the cli command to cancel a queued action
+func GetCmdCancelAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-action [id]",
+		Short: "Cancel a queued action before it is executed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), types.NewCancelActionRequest(clientCtx.GetFromAddress(), id))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const flagGovernanceKey = "governance-key"
+
+// GetCmdGenerateGovernanceTx returns the cli command to generate an unsigned transaction for messages controlled by the governance key
+func GetCmdGenerateGovernanceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-governance-tx [msgs-file]",
+		Short: "Generate an unsigned transaction for messages controlled by the governance key",
+		Long: `Generate an unsigned transaction for messages controlled by the governance key.
+The messages file must contain a JSON array of messages, each including its "@type".
+The generated transaction must be signed by the governance key holders with sign-governance-tx.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governanceKey, err := getGovernanceKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := readMsgsFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateGovernanceMsgs(clientCtx.Codec, governanceKey, msgs...); err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := txf.BuildUnsignedTx(msgs...)
+			if err != nil {
+				return err
+			}
+
+			return printTx(clientCtx, txBuilder)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addGovernanceKeyFlag(cmd)
+	return cmd
+}
+
+// GetCmdSignGovernanceTx returns the cli command for a governance key holder to sign a transaction on behalf of the governance key
+func GetCmdSignGovernanceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-governance-tx [tx-file]",
+		Short: "Sign a governance transaction as one of the governance key holders",
+		Long: `Sign a transaction generated by generate-governance-tx with the key given by --from, which must be one of the governance key holders.
+The resulting partial signature is printed and can be combined with the other holders' signatures by merge-governance-signatures.
+With --offline, the governance key, account number and sequence must be provided by flags.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governanceKey, txBuilder, err := readGovernanceTx(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !isGovernanceKeyHolder(governanceKey, clientCtx.GetFromAddress()) {
+				return fmt.Errorf("%s is not a governance key holder", clientCtx.GetFromAddress())
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// amino json sign mode is required to combine the signatures into a legacy amino multisig signature
+			txf = txf.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+			if err := authclient.SignTxWithSignerAddress(txf, clientCtx, governanceKey.Address().Bytes(), clientCtx.GetFromName(), txBuilder, clientCtx.Offline, true); err != nil {
+				return err
+			}
+
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+
+			sigJSON, err := clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", sigJSON))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addGovernanceKeyFlag(cmd)
+	return cmd
+}
+
+// GetCmdMergeGovernanceSignatures returns the cli command to merge the governance key holders' signatures into a signed governance transaction
+func GetCmdMergeGovernanceSignatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-governance-signatures [tx-file] [[signature-file]...]",
+		Short: "Merge the signatures of governance key holders into a signed governance transaction",
+		Long: `Merge the signatures created by sign-governance-tx into a transaction signed by the governance key.
+Every signature is verified against the transaction and the signers must meet the governance key threshold.
+With --offline, the governance key, account number and sequence must be provided by flags.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governanceKey, txBuilder, err := readGovernanceTx(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, governanceKey.Address().Bytes())
+				if err != nil {
+					return err
+				}
+
+				txf = txf.WithAccountNumber(accNum).WithSequence(seq)
+			}
+
+			adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+			if !ok {
+				return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", txBuilder.GetTx())
+			}
+			txData := adaptableTx.GetSigningTxData()
+
+			var sigs []signing.SignatureV2
+			for _, sigFile := range args[1:] {
+				bz, err := os.ReadFile(sigFile)
+				if err != nil {
+					return err
+				}
+
+				fileSigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return fmt.Errorf("failed to read signatures from %s: %w", sigFile, err)
+				}
+
+				for _, sig := range fileSigs {
+					anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+					if err != nil {
+						return err
+					}
+
+					signerData := txsigning.SignerData{
+						ChainID:       txf.ChainID(),
+						AccountNumber: txf.AccountNumber(),
+						Sequence:      txf.Sequence(),
+						Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+						PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+					}
+
+					if err := authsigning.VerifySignature(cmd.Context(), sig.PubKey, signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), txData); err != nil {
+						return fmt.Errorf("invalid signature of %s in %s: %w", sdk.AccAddress(sig.PubKey.Address()), sigFile, err)
+					}
+				}
+
+				sigs = append(sigs, fileSigs...)
+			}
+
+			sig, err := types.MergeGovernanceSignatures(governanceKey, txf.Sequence(), sigs...)
+			if err != nil {
+				return err
+			}
+
+			if err := txBuilder.SetSignatures(sig); err != nil {
+				return err
+			}
+
+			return printTx(clientCtx, txBuilder)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addGovernanceKeyFlag(cmd)
+	return cmd
+}
+
+// GetCmdBroadcastGovernanceTx returns the cli command to broadcast a governance transaction signed by the governance key
+func GetCmdBroadcastGovernanceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-governance-tx [tx-file]",
+		Short: "Broadcast a transaction signed by the governance key",
+		Long: `Broadcast a transaction created by merge-governance-signatures.
+The transaction is checked against the current governance key before it is broadcast.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline {
+				return fmt.Errorf("cannot broadcast a transaction in offline mode")
+			}
+
+			governanceKey, txBuilder, err := readGovernanceTx(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+
+			if len(sigs) != 1 || !sigs[0].PubKey.Equals(&governanceKey) {
+				return fmt.Errorf("transaction must be signed by the governance key %s only", sdk.AccAddress(governanceKey.Address()))
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addGovernanceKeyFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagGovernanceKey, "", "JSON encoded governance key, only used in offline mode instead of querying it")
+}
+
+// getGovernanceKey returns the governance key given by flag in offline mode and queries it otherwise
+func getGovernanceKey(cmd *cobra.Command, clientCtx client.Context) (multisig.LegacyAminoPubKey, error) {
+	if clientCtx.Offline {
+		keyJSON, err := cmd.Flags().GetString(flagGovernanceKey)
+		if err != nil {
+			return multisig.LegacyAminoPubKey{}, err
+		}
+
+		if keyJSON == "" {
+			return multisig.LegacyAminoPubKey{}, fmt.Errorf("flag --%s is required in offline mode", flagGovernanceKey)
+		}
+
+		var pk crypto.PubKey
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(keyJSON), &pk); err != nil {
+			return multisig.LegacyAminoPubKey{}, err
+		}
+
+		governanceKey, ok := pk.(*multisig.LegacyAminoPubKey)
+		if !ok {
+			return multisig.LegacyAminoPubKey{}, fmt.Errorf("governance key must be a multisig key, got %T", pk)
+		}
+
+		return *governanceKey, nil
+	}
+
+	res, err := types.NewQueryClient(clientCtx).GovernanceKey(cmd.Context(), &types.QueryGovernanceKeyRequest{})
+	if err != nil {
+		return multisig.LegacyAminoPubKey{}, err
+	}
+
+	return res.GovernanceKey, nil
+}
+
+// readGovernanceTx reads the transaction from the given file and validates its messages against the governance key
+func readGovernanceTx(cmd *cobra.Command, clientCtx client.Context, txFile string) (multisig.LegacyAminoPubKey, client.TxBuilder, error) {
+	governanceKey, err := getGovernanceKey(cmd, clientCtx)
+	if err != nil {
+		return multisig.LegacyAminoPubKey{}, nil, err
+	}
+
+	stdTx, err := authclient.ReadTxFromFile(clientCtx, txFile)
+	if err != nil {
+		return multisig.LegacyAminoPubKey{}, nil, err
+	}
+
+	if err := types.ValidateGovernanceMsgs(clientCtx.Codec, governanceKey, stdTx.GetMsgs()...); err != nil {
+		return multisig.LegacyAminoPubKey{}, nil, err
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+	if err != nil {
+		return multisig.LegacyAminoPubKey{}, nil, err
+	}
+
+	return governanceKey, txBuilder, nil
+}
+
+func readMsgsFromFile(clientCtx client.Context, msgsFile string) ([]sdk.Msg, error) {
+	bz, err := os.ReadFile(msgsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+		return nil, fmt.Errorf("messages file must contain a JSON array of messages: %w", err)
+	}
+
+	var msgs []sdk.Msg
+	for _, rawMsg := range rawMsgs {
+		var msg sdk.Msg
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func isGovernanceKeyHolder(governanceKey multisig.LegacyAminoPubKey, addr sdk.AccAddress) bool {
+	for _, pk := range governanceKey.GetPubKeys() {
+		if bytes.Equal(pk.Address(), addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func printTx(clientCtx client.Context, txBuilder client.TxBuilder) error {
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintString(fmt.Sprintf("%s\n", txJSON))
+}
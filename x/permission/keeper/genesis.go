@@ -17,6 +17,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, account := range genState.GovAccounts {
 		k.setGovAccount(ctx, account)
 	}
+
+	var nextActionID uint64
+	for _, action := range genState.QueuedActions {
+		k.mustSetQueuedAction(ctx, action)
+
+		if action.ID >= nextActionID {
+			nextActionID = action.ID + 1
+		}
+	}
+	k.setNextActionID(ctx, nextActionID)
 }
 
 // ExportGenesis returns the permission module's genesis state.
@@ -27,6 +37,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			k.GetParams(ctx),
 			nil,
 			k.getGovAccounts(ctx),
+			k.getQueuedActions(ctx),
 		)
 	}
 
@@ -34,5 +45,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetParams(ctx),
 		&governanceKey,
 		k.getGovAccounts(ctx),
+		k.getQueuedActions(ctx),
 	)
 }
@@ -39,7 +39,7 @@ func TestGenesis(t *testing.T) {
 		}).
 		When("the state is initialized from a genesis state",
 			func() {
-				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts())
+				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts(), nil)
 				assert.NoError(t, initialGenesis.Validate())
 
 				ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
@@ -35,3 +35,18 @@ func (q Keeper) Params(c context.Context, req *types.ParamsRequest) (*types.Para
 		Params: params,
 	}, nil
 }
+
+// QueuedActions returns the actions waiting for their timelock to expire
+func (k Keeper) QueuedActions(c context.Context, req *types.QueuedActionsRequest) (*types.QueuedActionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	actions, pagination, err := k.GetQueuedActionsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueuedActionsResponse{
+		Actions:    actions,
+		Pagination: pagination,
+	}, nil
+}
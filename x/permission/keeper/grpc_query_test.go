@@ -35,7 +35,7 @@ func TestGrpcQuery(t *testing.T) {
 		}).
 		Given("a state that is initialized",
 			func() {
-				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts())
+				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts(), nil)
 				assert.NoError(t, initialGenesis.Validate())
 
 				ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
@@ -61,7 +61,7 @@ func TestGrpcQuery(t *testing.T) {
 		}).
 		Given("a state that is initialized",
 			func() {
-				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts())
+				initialGenesis = types.NewGenesisState(types.Params{}, randomMultisigGovernanceKey(), randomGovAccounts(), nil)
 				assert.NoError(t, initialGenesis.Validate())
 
 				ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
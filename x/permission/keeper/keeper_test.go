@@ -33,7 +33,7 @@ func TestMsgServer_UpdateParams(t *testing.T) {
 	k := keeper.NewKeeper(encCfg.Codec, key, subspace)
 	ctx := sdk.NewContext(fake.NewMultiStore(), sdk.Context{}.BlockHeader(), false, log.NewTestLogger(t))
 
-	server := keeper.NewMsgServerImpl(k)
+	server := keeper.NewMsgServerImpl(k, encCfg.Codec)
 
 	p := types.DefaultParams()
 	_, err := server.UpdateParams(ctx, &types.UpdateParamsRequest{Authority: "", Params: p})
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+// Migrate2to3 returns the handler that performs in-place store migrations
+func Migrate2to3(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addTimelockDelaysParam(ctx, k)
+		return nil
+	}
+}
+
+func addTimelockDelaysParam(ctx sdk.Context, k Keeper) {
+	k.params.Set(ctx, types.KeyTimelockDelays, types.DefaultParams().TimelockDelays)
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	store "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "permission")
+	k := keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+	ctx := sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
+
+	assert.Panics(t, func() { k.GetParams(ctx) })
+
+	assert.NoError(t, keeper.Migrate2to3(k)(ctx))
+
+	assert.NotPanics(t, func() { k.GetParams(ctx) })
+	assert.Empty(t, k.GetParams(ctx).TimelockDelays)
+}
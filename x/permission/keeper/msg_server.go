@@ -1,23 +1,27 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/axelarnetwork/axelar-core/utils/events"
 	"github.com/axelarnetwork/axelar-core/x/permission/exported"
 	"github.com/axelarnetwork/axelar-core/x/permission/types"
 )
 
 type msgServer struct {
 	Keeper
+	cdc codec.Codec
 }
 
 // NewMsgServerImpl returns a new msg server instance
-func NewMsgServerImpl(keeper Keeper) types.MsgServer {
-	return msgServer{Keeper: keeper}
+func NewMsgServerImpl(keeper Keeper, cdc codec.Codec) types.MsgServer {
+	return msgServer{Keeper: keeper, cdc: cdc}
 }
 
 func (s msgServer) UpdateGovernanceKey(c context.Context, req *types.UpdateGovernanceKeyRequest) (*types.UpdateGovernanceKeyResponse, error) {
@@ -75,3 +79,63 @@ func (s msgServer) UpdateParams(c context.Context, req *types.UpdateParamsReques
 	s.setParams(ctx, req.Params)
 	return &types.UpdateParamsResponse{}, nil
 }
+
+// QueueAction queues a message that is subject to a timelock
+func (s msgServer) QueueAction(c context.Context, req *types.QueueActionRequest) (*types.QueueActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	msg := req.UnwrapMessage()
+	signers, _, err := s.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sender: %s", err)
+	}
+
+	if len(signers) != 1 || !bytes.Equal(signers[0], sender) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("queued message %T must be signed by the sender %s", msg, sender)
+	}
+
+	// the ante handler checks the role as well, but messages dispatched by contracts skip the unwrapping of wrapper messages
+	if err := s.CheckMessageRole(ctx, sender, msg); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	action, err := s.Keeper.QueueAction(ctx, sender, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	events.Emit(ctx, &types.ActionQueued{
+		ID:         action.ID,
+		MsgTypeURL: sdk.MsgTypeURL(msg),
+		Sender:     req.Sender,
+		ExecuteAt:  action.ExecuteAt,
+	})
+	s.Logger(ctx).Info(fmt.Sprintf("queued action %d (%s) to be executed at block %d", action.ID, sdk.MsgTypeURL(msg), action.ExecuteAt))
+
+	return &types.QueueActionResponse{ID: action.ID, ExecuteAt: action.ExecuteAt}, nil
+}
+
+// CancelAction removes a queued action before it is executed
+func (s msgServer) CancelAction(c context.Context, req *types.CancelActionRequest) (*types.CancelActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	action, ok := s.GetQueuedAction(ctx, req.ID)
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrapf("queued action %d not found", req.ID)
+	}
+
+	s.DeleteQueuedAction(ctx, action.ID)
+
+	events.Emit(ctx, &types.ActionCancelled{
+		ID:         action.ID,
+		MsgTypeURL: action.Msg.TypeUrl,
+	})
+	s.Logger(ctx).Info(fmt.Sprintf("cancelled queued action %d (%s)", action.ID, action.Msg.TypeUrl))
+
+	return &types.CancelActionResponse{}, nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/protoc-gen-gogo/descriptor"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+	"github.com/axelarnetwork/utils/convert"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+var (
+	queuedActionPrefix      = key.RegisterStaticKey(types.ModuleName, 1)
+	actionIDKey             = key.RegisterStaticKey(types.ModuleName, 2)
+	actionByExecuteAtPrefix = key.RegisterStaticKey(types.ModuleName, 3)
+)
+
+func getQueuedActionKey(id uint64) key.Key {
+	return queuedActionPrefix.Append(key.FromUInt(id))
+}
+
+// getActionByExecuteAtKey orders the queue by execution height, so due actions can be found without reading the whole queue
+func getActionByExecuteAtKey(action types.QueuedAction) key.Key {
+	return actionByExecuteAtPrefix.Append(key.FromUInt(uint64(action.ExecuteAt))).Append(key.FromUInt(action.ID))
+}
+
+// GetTimelockDelay returns the number of blocks messages of the given type must be queued before they are executed,
+// and false if messages of the given type are not subject to a timelock
+func (k Keeper) GetTimelockDelay(ctx sdk.Context, msgTypeURL string) (int64, bool) {
+	return k.GetParams(ctx).GetTimelockDelay(msgTypeURL)
+}
+
+// CheckMessageRole returns an error if the given sender does not have the role required to send the given message
+func (k Keeper) CheckMessageRole(ctx sdk.Context, sender sdk.AccAddress, msg sdk.Msg) error {
+	dm, ok := msg.(descriptor.Message)
+	if !ok {
+		return fmt.Errorf("message %T does not implement descriptor.Message", msg)
+	}
+
+	switch role := exported.GetPermissionRole(dm); role {
+	case exported.ROLE_ACCESS_CONTROL, exported.ROLE_CHAIN_MANAGEMENT:
+		if k.GetRole(ctx, sender) != role {
+			return fmt.Errorf("sender %s is not authorized to send message %T", sender, msg)
+		}
+	}
+
+	return nil
+}
+
+// QueueAction queues the given message of the given sender to be executed once the timelock delay of its type has passed
+func (k Keeper) QueueAction(ctx sdk.Context, sender sdk.AccAddress, msg sdk.Msg) (types.QueuedAction, error) {
+	delay, ok := k.GetTimelockDelay(ctx, sdk.MsgTypeURL(msg))
+	if !ok {
+		return types.QueuedAction{}, fmt.Errorf("message type %s is not subject to a timelock", sdk.MsgTypeURL(msg))
+	}
+
+	msgAny, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return types.QueuedAction{}, err
+	}
+
+	id := utils.NewCounter[uint64](actionIDKey, k.getStore(ctx)).Incr(ctx)
+	action := types.NewQueuedAction(id, sender, msgAny, ctx.BlockHeight(), ctx.BlockHeight()+delay)
+	if err := k.setQueuedAction(ctx, action); err != nil {
+		return types.QueuedAction{}, err
+	}
+
+	return action, nil
+}
+
+// GetQueuedAction returns the queued action with the given ID
+func (k Keeper) GetQueuedAction(ctx sdk.Context, id uint64) (action types.QueuedAction, ok bool) {
+	return action, k.getStore(ctx).GetNew(getQueuedActionKey(id), &action)
+}
+
+// DeleteQueuedAction removes the queued action with the given ID
+func (k Keeper) DeleteQueuedAction(ctx sdk.Context, id uint64) {
+	action, ok := k.GetQueuedAction(ctx, id)
+	if !ok {
+		return
+	}
+
+	k.getStore(ctx).DeleteNew(getActionByExecuteAtKey(action))
+	k.getStore(ctx).DeleteNew(getQueuedActionKey(id))
+}
+
+// GetDueActions returns all queued actions whose timelock has expired, ordered by their execution height and then by the order they were queued.
+// Only the due actions are read from the store.
+func (k Keeper) GetDueActions(ctx sdk.Context) []types.QueuedAction {
+	iter := k.getStore(ctx).IteratorNew(actionByExecuteAtPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	var due []types.QueuedAction
+	for ; iter.Valid(); iter.Next() {
+		action := funcs.MustOk(k.GetQueuedAction(ctx, binary.BigEndian.Uint64(iter.Value())))
+		if !action.IsDue(ctx.BlockHeight()) {
+			break
+		}
+
+		due = append(due, action)
+	}
+
+	return due
+}
+
+// GetQueuedActionsPaginated returns the queued actions with the given pagination properties
+func (k Keeper) GetQueuedActionsPaginated(ctx sdk.Context, pageRequest *query.PageRequest) ([]types.QueuedAction, *query.PageResponse, error) {
+	var actions []types.QueuedAction
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(queuedActionPrefix.Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		var action types.QueuedAction
+		if err := k.cdc.UnmarshalLengthPrefixed(value, &action); err != nil {
+			return err
+		}
+
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actions, resp, nil
+}
+
+func (k Keeper) setQueuedAction(ctx sdk.Context, action types.QueuedAction) error {
+	if err := k.getStore(ctx).SetNewValidated(getQueuedActionKey(action.ID), &action); err != nil {
+		return err
+	}
+
+	k.getStore(ctx).SetRawNew(getActionByExecuteAtKey(action), convert.IntToBytes(action.ID))
+	return nil
+}
+
+func (k Keeper) getQueuedActions(ctx sdk.Context) []types.QueuedAction {
+	var actions []types.QueuedAction
+
+	iter := k.getStore(ctx).IteratorNew(queuedActionPrefix)
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var action types.QueuedAction
+		iter.UnmarshalValue(&action)
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func (k Keeper) setNextActionID(ctx sdk.Context, id uint64) {
+	utils.NewCounter[uint64](actionIDKey, k.getStore(ctx)).Set(ctx, id)
+}
+
+func (k Keeper) mustSetQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	funcs.MustNoErr(k.setQueuedAction(ctx, action))
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	store "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+	"github.com/axelarnetwork/axelar-core/x/permission/keeper"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestTimelock(t *testing.T) {
+	var (
+		ctx        sdk.Context
+		k          keeper.Keeper
+		msgServer  types.MsgServer
+		governance sdk.AccAddress
+		queueReq   *types.QueueActionRequest
+		queueRes   *types.QueueActionResponse
+		err        error
+	)
+
+	encCfg := app.MakeEncodingConfig()
+	delay := rand.I64Between(10, 100)
+
+	givenKeeper := Given("a keeper with a timelock on registering controllers", func() {
+		subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "permission")
+		k = keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+		ctx = rand.Context(fake.NewMultiStore(), t).WithBlockHeight(rand.I64Between(1, 1000000))
+		msgServer = keeper.NewMsgServerImpl(k, encCfg.Codec)
+
+		governance = rand.AccAddr()
+		params := types.DefaultParams()
+		params.TimelockDelays = []types.TimelockDelay{{MsgTypeURL: sdk.MsgTypeURL(&types.RegisterControllerRequest{}), Delay: delay}}
+		k.InitGenesis(ctx, types.NewGenesisState(params, nil, []types.GovAccount{types.NewGovAccount(governance, exported.ROLE_ACCESS_CONTROL)}, nil))
+	})
+
+	queueAction := func() {
+		queueRes, err = msgServer.QueueAction(ctx, queueReq)
+	}
+
+	givenKeeper.
+		Branch(
+			When("the governance key queues a controller registration", func() {
+				queueReq = types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(governance, rand.AccAddr()))
+			}).
+				When("the action is queued", queueAction).
+				Then("the action waits for the timelock to expire", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Equal(t, ctx.BlockHeight()+delay, queueRes.ExecuteAt)
+
+					action, ok := k.GetQueuedAction(ctx, queueRes.ID)
+					assert.True(t, ok)
+					assert.Equal(t, governance, action.Sender)
+					assert.Equal(t, ctx.BlockHeight(), action.QueuedAt)
+					assert.Equal(t, queueReq.UnwrapMessage(), action.UnwrapMessage())
+
+					assert.Empty(t, k.GetDueActions(ctx))
+					assert.Len(t, k.GetDueActions(ctx.WithBlockHeight(queueRes.ExecuteAt)), 1)
+				}),
+
+			When("a message without timelock is queued", func() {
+				queueReq = types.NewQueueActionRequest(governance, types.NewDeregisterControllerRequest(governance, rand.AccAddr()))
+			}).
+				When("the action is queued", queueAction).
+				Then("it fails", func(t *testing.T) {
+					assert.ErrorContains(t, err, "not subject to a timelock")
+				}),
+
+			When("the queued message is signed by someone else", func() {
+				queueReq = types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(rand.AccAddr(), rand.AccAddr()))
+			}).
+				When("the action is queued", queueAction).
+				Then("it fails", func(t *testing.T) {
+					assert.ErrorContains(t, err, "must be signed by the sender")
+				}),
+
+			When("the sender does not have the role of the queued message", func() {
+				sender := rand.AccAddr()
+				queueReq = types.NewQueueActionRequest(sender, types.NewRegisterControllerRequest(sender, rand.AccAddr()))
+			}).
+				When("the action is queued", queueAction).
+				Then("it fails", func(t *testing.T) {
+					assert.ErrorContains(t, err, "not authorized")
+				}),
+		).
+		Run(t)
+
+	givenKeeper.
+		When("multiple actions are queued", func() {
+			for i := 0; i < 5; i++ {
+				_, err := msgServer.QueueAction(ctx, types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(governance, rand.AccAddr())))
+				assert.NoError(t, err)
+			}
+		}).
+		Branch(
+			Then("they can be queried page by page", func(t *testing.T) {
+				res, err := k.QueuedActions(ctx, &types.QueuedActionsRequest{Pagination: &query.PageRequest{Limit: 3}})
+				assert.NoError(t, err)
+				assert.Len(t, res.Actions, 3)
+				assert.EqualValues(t, 0, res.Actions[0].ID)
+
+				res, err = k.QueuedActions(ctx, &types.QueuedActionsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+				assert.NoError(t, err)
+				assert.Len(t, res.Actions, 2)
+				assert.EqualValues(t, 4, res.Actions[1].ID)
+			}),
+
+			Then("the governance key can cancel one of them", func(t *testing.T) {
+				_, err := msgServer.CancelAction(ctx, types.NewCancelActionRequest(governance, 2))
+				assert.NoError(t, err)
+
+				_, ok := k.GetQueuedAction(ctx, 2)
+				assert.False(t, ok)
+				assert.Len(t, k.GetDueActions(ctx.WithBlockHeight(ctx.BlockHeight()+delay)), 4)
+
+				_, err = msgServer.CancelAction(ctx, types.NewCancelActionRequest(governance, 2))
+				assert.ErrorContains(t, err, "not found")
+			}),
+
+			Then("they are exported and imported with the genesis state", func(t *testing.T) {
+				genesis := k.ExportGenesis(ctx)
+				assert.NoError(t, genesis.Validate())
+				assert.Len(t, genesis.QueuedActions, 5)
+
+				subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, store.NewKVStoreKey("paramsKey"), store.NewKVStoreKey("tparamsKey"), "permission")
+				imported := keeper.NewKeeper(encCfg.Codec, store.NewKVStoreKey(types.StoreKey), subspace)
+				importedCtx := rand.Context(fake.NewMultiStore(), t).WithBlockHeight(ctx.BlockHeight())
+				imported.InitGenesis(importedCtx, genesis)
+
+				assert.Equal(t, genesis.QueuedActions, imported.ExportGenesis(importedCtx).QueuedActions)
+
+				action, err := imported.QueueAction(importedCtx, governance, types.NewRegisterControllerRequest(governance, rand.AccAddr()))
+				assert.NoError(t, err)
+				assert.EqualValues(t, 5, action.ID)
+			}),
+		).
+		Run(t)
+
+	givenKeeper.
+		When("the delay is shortened after an action was queued", func() {
+			_, err := msgServer.QueueAction(ctx, types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(governance, rand.AccAddr())))
+			assert.NoError(t, err)
+
+			params := types.DefaultParams()
+			params.TimelockDelays = []types.TimelockDelay{{MsgTypeURL: sdk.MsgTypeURL(&types.RegisterControllerRequest{}), Delay: 1}}
+			_, err = msgServer.UpdateParams(ctx, &types.UpdateParamsRequest{Params: params})
+			assert.NoError(t, err)
+		}).
+		When("another action is queued", func() {
+			queueRes, err = msgServer.QueueAction(ctx, types.NewQueueActionRequest(governance, types.NewRegisterControllerRequest(governance, rand.AccAddr())))
+			assert.NoError(t, err)
+		}).
+		Then("the actions are due in the order of their execution height", func(t *testing.T) {
+			assert.Empty(t, k.GetDueActions(ctx))
+
+			due := k.GetDueActions(ctx.WithBlockHeight(queueRes.ExecuteAt))
+			assert.Len(t, due, 1)
+			assert.Equal(t, queueRes.ID, due[0].ID)
+
+			due = k.GetDueActions(ctx.WithBlockHeight(ctx.BlockHeight() + delay))
+			assert.Len(t, due, 2)
+			assert.Equal(t, queueRes.ID, due[0].ID)
+			assert.EqualValues(t, 0, due[1].ID)
+		}).
+		Run(t)
+}
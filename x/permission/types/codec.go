@@ -13,6 +13,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterControllerRequest{}, "permission/RegisterController", nil)
 	cdc.RegisterConcrete(&DeregisterControllerRequest{}, "permission/DeregisterController", nil)
 	cdc.RegisterConcrete(&UpdateParamsRequest{}, "permission/UpdateParams", nil)
+	cdc.RegisterConcrete(&QueueActionRequest{}, "permission/QueueAction", nil)
+	cdc.RegisterConcrete(&CancelActionRequest{}, "permission/CancelAction", nil)
 }
 
 // RegisterInterfaces registers types and interfaces with the given registry
@@ -22,6 +24,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&RegisterControllerRequest{},
 		&DeregisterControllerRequest{},
 		&UpdateParamsRequest{},
+		&QueueActionRequest{},
+		&CancelActionRequest{},
 	)
 }
 
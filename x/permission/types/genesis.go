@@ -6,23 +6,27 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 
 	"github.com/axelarnetwork/axelar-core/x/permission/exported"
 )
 
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
 // NewGenesisState is the constructor for GenesisState
-func NewGenesisState(params Params, governanceKey *multisig.LegacyAminoPubKey, accounts []GovAccount) *GenesisState {
+func NewGenesisState(params Params, governanceKey *multisig.LegacyAminoPubKey, accounts []GovAccount, queuedActions []QueuedAction) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		GovernanceKey: governanceKey,
 		GovAccounts:   accounts,
+		QueuedActions: queuedActions,
 	}
 }
 
 // DefaultGenesisState returns a genesis state with default parameters
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(Params{}, nil, []GovAccount{})
+	return NewGenesisState(DefaultParams(), nil, []GovAccount{}, []QueuedAction{})
 }
 
 // Validate performs a validation check on the genesis parameters
@@ -52,6 +56,29 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	actionIDs := make(map[uint64]bool)
+	for _, action := range m.QueuedActions {
+		if err := action.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+
+		if actionIDs[action.ID] {
+			return getValidateError(fmt.Errorf("duplicate queued action %d", action.ID))
+		}
+		actionIDs[action.ID] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, action := range m.QueuedActions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -29,6 +29,7 @@ type GenesisState struct {
 	Params        Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	GovernanceKey *multisig.LegacyAminoPubKey `protobuf:"bytes,2,opt,name=governance_key,json=governanceKey,proto3" json:"governance_key,omitempty"`
 	GovAccounts   []GovAccount                `protobuf:"bytes,3,rep,name=gov_accounts,json=govAccounts,proto3" json:"gov_accounts"`
+	QueuedActions []QueuedAction              `protobuf:"bytes,4,rep,name=queued_actions,json=queuedActions,proto3" json:"queued_actions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,29 +74,31 @@ func init() {
 }
 
 var fileDescriptor_01602ee85b3c96e0 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0x2a, 0x41,
-	0x14, 0x86, 0x77, 0x2f, 0x37, 0x14, 0x0b, 0xf7, 0x16, 0x9b, 0x5b, 0x70, 0x29, 0x56, 0x30, 0x41,
-	0xb1, 0x70, 0x26, 0x60, 0x61, 0x69, 0xa0, 0xa1, 0xc0, 0x18, 0x82, 0xb1, 0xb1, 0x21, 0xb3, 0x93,
-	0x93, 0x71, 0x03, 0xbb, 0x67, 0x33, 0x33, 0xbb, 0x32, 0x6f, 0xe1, 0x63, 0x51, 0x52, 0x5a, 0x19,
-	0x85, 0x27, 0xf0, 0x0d, 0x8c, 0x3b, 0x8b, 0xd8, 0x60, 0x37, 0x73, 0xf2, 0x9d, 0xff, 0xfc, 0xff,
-	0xef, 0x9d, 0xb2, 0x25, 0x2c, 0x98, 0xa4, 0x29, 0xc8, 0x38, 0x52, 0x2a, 0xc2, 0x84, 0xe6, 0xbd,
-	0x10, 0x34, 0xeb, 0x51, 0x01, 0x09, 0xa8, 0x48, 0x91, 0x54, 0xa2, 0x46, 0xff, 0xbf, 0x05, 0xc9,
-	0x1e, 0x24, 0x25, 0xd8, 0xfc, 0x27, 0x50, 0x60, 0x41, 0xd1, 0xcf, 0x97, 0x5d, 0x68, 0xb6, 0x39,
-	0xaa, 0x18, 0x15, 0xe5, 0xd2, 0xa4, 0x1a, 0x69, 0x9c, 0x2d, 0x74, 0xa4, 0x22, 0x41, 0xe7, 0x60,
-	0x4a, 0xcd, 0x66, 0xe7, 0xf0, 0x71, 0x6d, 0x52, 0xd8, 0x61, 0x27, 0x87, 0xb1, 0x94, 0x49, 0x16,
-	0x97, 0xdc, 0xf1, 0xbb, 0xeb, 0xd5, 0x47, 0xd6, 0xf4, 0xad, 0x66, 0x1a, 0xfc, 0x2b, 0xaf, 0x6a,
-	0x81, 0x86, 0xdb, 0x72, 0xbb, 0xb5, 0x7e, 0x9b, 0x1c, 0x0c, 0x41, 0x26, 0x05, 0x38, 0xfc, 0xbd,
-	0x7a, 0x39, 0x72, 0xa6, 0xe5, 0x9a, 0x3f, 0xf1, 0xfe, 0x0a, 0xcc, 0x41, 0x26, 0x2c, 0xe1, 0x30,
-	0x9b, 0x83, 0x69, 0xfc, 0x2a, 0x84, 0xce, 0x88, 0x0d, 0x47, 0x6c, 0x38, 0xb2, 0x0b, 0x47, 0xae,
-	0x41, 0x30, 0x6e, 0x06, 0x71, 0x94, 0xe0, 0x24, 0x0b, 0xc7, 0x60, 0xa6, 0x7f, 0xf6, 0x02, 0x63,
-	0x30, 0xfe, 0x8d, 0x57, 0x17, 0x98, 0xcf, 0x18, 0xe7, 0x98, 0x25, 0x5a, 0x35, 0x2a, 0xad, 0x4a,
-	0xb7, 0xd6, 0xef, 0xfc, 0x60, 0x6c, 0x84, 0xf9, 0xc0, 0xd2, 0xa5, 0xb9, 0x9a, 0xf8, 0x9a, 0xa8,
-	0xe1, 0xdd, 0xea, 0x2d, 0x70, 0x56, 0x9b, 0xc0, 0x5d, 0x6f, 0x02, 0xf7, 0x75, 0x13, 0xb8, 0x4f,
-	0xdb, 0xc0, 0x59, 0x6f, 0x03, 0xe7, 0x79, 0x1b, 0x38, 0xf7, 0x97, 0x22, 0xd2, 0x0f, 0x59, 0x48,
-	0x38, 0xc6, 0xd4, 0x5e, 0x48, 0x40, 0x3f, 0xa2, 0x9c, 0x97, 0xbf, 0x73, 0x8e, 0x12, 0xe8, 0xf2,
-	0x7b, 0xb3, 0x45, 0xf1, 0x61, 0xb5, 0x68, 0xf4, 0xe2, 0x23, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x3d,
-	0x76, 0x4a, 0x1f, 0x02, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x93, 0xbb, 0xd3, 0x0d, 0xe9, 0xdd, 0x0d, 0x11, 0x43, 0xe8, 0x10, 0xee, 0x90, 0x8e,
+	0x2b, 0x03, 0xb6, 0x5a, 0x06, 0x46, 0xd4, 0x2e, 0x1d, 0x8a, 0x50, 0x29, 0xb0, 0xb0, 0x54, 0x8e,
+	0x79, 0x32, 0x56, 0x1b, 0xbf, 0xd4, 0x76, 0x42, 0xf3, 0x2d, 0xf8, 0x56, 0x74, 0xec, 0xc8, 0x84,
+	0xa0, 0xfd, 0x22, 0x08, 0x3b, 0xbd, 0x76, 0x69, 0x37, 0xfb, 0xe9, 0xf7, 0x7e, 0xef, 0xfd, 0xf5,
+	0xa2, 0x07, 0xb6, 0x84, 0x39, 0xd3, 0xb4, 0x00, 0x9d, 0x4b, 0x63, 0x24, 0x2a, 0x5a, 0x75, 0x33,
+	0xb0, 0xac, 0x4b, 0x05, 0x28, 0x30, 0xd2, 0x90, 0x42, 0xa3, 0xc5, 0xf8, 0xa9, 0x07, 0xc9, 0x1e,
+	0x24, 0x0d, 0xd8, 0x7e, 0x22, 0x50, 0xa0, 0xa3, 0xe8, 0xff, 0x97, 0x6f, 0x68, 0xdf, 0x71, 0x34,
+	0x39, 0x1a, 0xca, 0x75, 0x5d, 0x58, 0xa4, 0x79, 0x39, 0xb7, 0xd2, 0x48, 0x41, 0x67, 0x50, 0x37,
+	0xce, 0xf6, 0xfd, 0xf1, 0xe1, 0xb6, 0x2e, 0x60, 0x87, 0xbd, 0x38, 0x8e, 0x15, 0x4c, 0xb3, 0xbc,
+	0xe1, 0x9e, 0xff, 0x3c, 0x8b, 0xae, 0x86, 0x7e, 0xe9, 0x8f, 0x96, 0x59, 0x88, 0xdf, 0x46, 0x97,
+	0x1e, 0x48, 0xc2, 0xdb, 0xb0, 0xd3, 0xea, 0xdd, 0x91, 0xa3, 0x21, 0xc8, 0xd8, 0x81, 0x83, 0x8b,
+	0xd5, 0xef, 0x67, 0xc1, 0xa4, 0x69, 0x8b, 0xc7, 0xd1, 0x8d, 0xc0, 0x0a, 0xb4, 0x62, 0x8a, 0xc3,
+	0x74, 0x06, 0x75, 0x72, 0xe6, 0x44, 0x2f, 0x89, 0x0f, 0x47, 0x7c, 0x38, 0xb2, 0x0b, 0x47, 0xde,
+	0x81, 0x60, 0xbc, 0xee, 0xe7, 0x52, 0xe1, 0xb8, 0xcc, 0x46, 0x50, 0x4f, 0xae, 0xf7, 0x82, 0x11,
+	0xd4, 0xf1, 0xfb, 0xe8, 0x4a, 0x60, 0x35, 0x65, 0x9c, 0x63, 0xa9, 0xac, 0x49, 0xce, 0x6f, 0xcf,
+	0x3b, 0xad, 0xde, 0xfd, 0x89, 0xc5, 0x86, 0x58, 0xf5, 0x3d, 0xdd, 0x2c, 0xd7, 0x12, 0x8f, 0x15,
+	0x13, 0x7f, 0x8a, 0x6e, 0x16, 0x25, 0x94, 0xf0, 0x75, 0xca, 0xb8, 0x95, 0xa8, 0x4c, 0x72, 0xe1,
+	0x8c, 0x0f, 0x27, 0x8c, 0x1f, 0x5c, 0x43, 0xdf, 0xf1, 0x8d, 0xf3, 0x7a, 0x71, 0x50, 0x33, 0x83,
+	0xcf, 0xab, 0xbf, 0x69, 0xb0, 0xda, 0xa4, 0xe1, 0x7a, 0x93, 0x86, 0x7f, 0x36, 0x69, 0xf8, 0x63,
+	0x9b, 0x06, 0xeb, 0x6d, 0x1a, 0xfc, 0xda, 0xa6, 0xc1, 0x97, 0x37, 0x42, 0xda, 0x6f, 0x65, 0x46,
+	0x38, 0xe6, 0xd4, 0x4f, 0x51, 0x60, 0xbf, 0xa3, 0x9e, 0x35, 0xbf, 0x57, 0x1c, 0x35, 0xd0, 0xe5,
+	0xe1, 0xbd, 0xdc, 0x39, 0xb3, 0x4b, 0x77, 0xa7, 0xd7, 0xff, 0x06, 0x00, 0x0a, 0x46, 0x48, 0xa3,
+	0x75, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -118,6 +121,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueuedActions) > 0 {
+		for iNdEx := len(m.QueuedActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GovAccounts) > 0 {
 		for iNdEx := len(m.GovAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -186,6 +203,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.QueuedActions) > 0 {
+		for _, e := range m.QueuedActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -327,6 +350,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedActions = append(m.QueuedActions, QueuedAction{})
+			if err := m.QueuedActions[len(m.QueuedActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptomultisig "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/gogoproto/protoc-gen-gogo/descriptor"
+
+	"github.com/axelarnetwork/axelar-core/x/permission/exported"
+)
+
+// ValidateGovernanceMsgs returns an error if the given messages cannot be sent in a transaction signed by the governance key,
+// i.e. if any of them (or the message it queues) is not gated behind ROLE_ACCESS_CONTROL or is signed by anyone other than the governance key
+func ValidateGovernanceMsgs(cdc codec.Codec, governanceKey multisig.LegacyAminoPubKey, msgs ...sdk.Msg) error {
+	if len(msgs) == 0 {
+		return fmt.Errorf("no messages to sign")
+	}
+
+	for _, msg := range msgs {
+		if err := validateGovernanceSigners(cdc, governanceKey, msg); err != nil {
+			return err
+		}
+
+		// queued messages are controlled by the governance key if the message they wrap is
+		if queued, ok := msg.(*QueueActionRequest); ok {
+			msg = queued.UnwrapMessage()
+			if err := validateGovernanceSigners(cdc, governanceKey, msg); err != nil {
+				return err
+			}
+		}
+
+		dm, ok := msg.(descriptor.Message)
+		if !ok || exported.GetPermissionRole(dm) != exported.ROLE_ACCESS_CONTROL {
+			return fmt.Errorf("message %T is not controlled by the governance key", msg)
+		}
+	}
+
+	return nil
+}
+
+func validateGovernanceSigners(cdc codec.Codec, governanceKey multisig.LegacyAminoPubKey, msg sdk.Msg) error {
+	if msg == nil {
+		return fmt.Errorf("message must not be empty")
+	}
+
+	signers, _, err := cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return fmt.Errorf("failed to get signers for message %T: %w", msg, err)
+	}
+
+	for _, signer := range signers {
+		if !bytes.Equal(signer, governanceKey.Address()) {
+			return fmt.Errorf("message %T must be signed by the governance key %s instead of %s",
+				msg, sdk.AccAddress(governanceKey.Address()), sdk.AccAddress(signer))
+		}
+	}
+
+	return nil
+}
+
+// MergeGovernanceSignatures combines the partial signatures of individual governance key holders into a signature of the governance key.
+// It returns an error if any signature does not belong to a key holder or if the governance key's threshold is not met
+func MergeGovernanceSignatures(governanceKey multisig.LegacyAminoPubKey, sequence uint64, sigs ...signing.SignatureV2) (signing.SignatureV2, error) {
+	pubKeys := governanceKey.GetPubKeys()
+	multiSig := cryptomultisig.NewMultisig(len(pubKeys))
+
+	signed := make(map[string]bool)
+	for _, sig := range sigs {
+		if sig.Sequence != sequence {
+			return signing.SignatureV2{}, fmt.Errorf("signature of %s is for sequence %d instead of %d", sdk.AccAddress(sig.PubKey.Address()), sig.Sequence, sequence)
+		}
+
+		if _, ok := sig.Data.(*signing.SingleSignatureData); !ok {
+			return signing.SignatureV2{}, fmt.Errorf("signature of %s is not a single signature", sdk.AccAddress(sig.PubKey.Address()))
+		}
+
+		signer := sdk.AccAddress(sig.PubKey.Address()).String()
+		if signed[signer] {
+			return signing.SignatureV2{}, fmt.Errorf("duplicate signature of %s", signer)
+		}
+		signed[signer] = true
+
+		if err := cryptomultisig.AddSignatureV2(multiSig, sig, pubKeys); err != nil {
+			return signing.SignatureV2{}, fmt.Errorf("%s is not a governance key holder: %w", signer, err)
+		}
+	}
+
+	if len(signed) < int(governanceKey.Threshold) {
+		return signing.SignatureV2{}, fmt.Errorf("collected %d signatures, but the governance key requires %d", len(signed), governanceKey.Threshold)
+	}
+
+	return signing.SignatureV2{
+		PubKey:   &governanceKey,
+		Data:     multiSig,
+		Sequence: sequence,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/permission/types"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestValidateGovernanceMsgs(t *testing.T) {
+	var (
+		governanceKey *multisig.LegacyAminoPubKey
+		msgs          []sdk.Msg
+	)
+
+	cdc := app.MakeEncodingConfig().Codec
+
+	Given("a governance key", func() {
+		governanceKey, _ = randomGovernanceKey(2, 3)
+	}).
+		Branch(
+			When("messages are signed by the governance key", func() {
+				govAddr := sdk.AccAddress(governanceKey.Address())
+				msgs = []sdk.Msg{
+					types.NewRegisterControllerRequest(govAddr, rand.AccAddr()),
+					types.NewDeregisterControllerRequest(govAddr, rand.AccAddr()),
+				}
+			}).
+				Then("should pass validation", func(t *testing.T) {
+					assert.NoError(t, types.ValidateGovernanceMsgs(cdc, *governanceKey, msgs...))
+				}),
+
+			When("a message is signed by another account", func() {
+				msgs = []sdk.Msg{
+					types.NewRegisterControllerRequest(sdk.AccAddress(governanceKey.Address()), rand.AccAddr()),
+					types.NewRegisterControllerRequest(rand.AccAddr(), rand.AccAddr()),
+				}
+			}).
+				Then("should fail validation", func(t *testing.T) {
+					assert.ErrorContains(t, types.ValidateGovernanceMsgs(cdc, *governanceKey, msgs...), "must be signed by the governance key")
+				}),
+
+			When("a message is not controlled by the governance key", func() {
+				msgs = []sdk.Msg{
+					&types.UpdateParamsRequest{Authority: sdk.AccAddress(governanceKey.Address()).String(), Params: types.DefaultParams()},
+				}
+			}).
+				Then("should fail validation", func(t *testing.T) {
+					assert.ErrorContains(t, types.ValidateGovernanceMsgs(cdc, *governanceKey, msgs...), "not controlled by the governance key")
+				}),
+
+			When("there are no messages", func() {
+				msgs = nil
+			}).
+				Then("should fail validation", func(t *testing.T) {
+					assert.Error(t, types.ValidateGovernanceMsgs(cdc, *governanceKey, msgs...))
+				}),
+		).
+		Run(t)
+}
+
+func TestMergeGovernanceSignatures(t *testing.T) {
+	var (
+		governanceKey *multisig.LegacyAminoPubKey
+		privKeys      []cryptotypes.PrivKey
+		sequence      uint64
+		sigs          []signing.SignatureV2
+	)
+
+	signWith := func(privKey cryptotypes.PrivKey, seq uint64) signing.SignatureV2 {
+		return signing.SignatureV2{
+			PubKey: privKey.PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: rand.Bytes(64),
+			},
+			Sequence: seq,
+		}
+	}
+
+	Given("a 2-of-3 governance key", func() {
+		governanceKey, privKeys = randomGovernanceKey(2, 3)
+		sequence = uint64(rand.PosI64())
+	}).
+		Branch(
+			When("enough key holders signed", func() {
+				sigs = []signing.SignatureV2{signWith(privKeys[2], sequence), signWith(privKeys[0], sequence)}
+			}).
+				Then("should merge the signatures into a multisig signature", func(t *testing.T) {
+					sig, err := types.MergeGovernanceSignatures(*governanceKey, sequence, sigs...)
+					assert.NoError(t, err)
+
+					assert.Equal(t, sequence, sig.Sequence)
+					assert.True(t, sig.PubKey.Equals(governanceKey))
+
+					multiSig, ok := sig.Data.(*signing.MultiSignatureData)
+					assert.True(t, ok)
+					assert.Len(t, multiSig.Signatures, 2)
+					assert.True(t, multiSig.BitArray.GetIndex(0))
+					assert.False(t, multiSig.BitArray.GetIndex(1))
+					assert.True(t, multiSig.BitArray.GetIndex(2))
+				}),
+
+			When("too few key holders signed", func() {
+				sigs = []signing.SignatureV2{signWith(privKeys[1], sequence)}
+			}).
+				Then("should fail", func(t *testing.T) {
+					_, err := types.MergeGovernanceSignatures(*governanceKey, sequence, sigs...)
+					assert.ErrorContains(t, err, "requires 2")
+				}),
+
+			When("a key holder signed twice", func() {
+				sigs = []signing.SignatureV2{signWith(privKeys[1], sequence), signWith(privKeys[1], sequence)}
+			}).
+				Then("should fail", func(t *testing.T) {
+					_, err := types.MergeGovernanceSignatures(*governanceKey, sequence, sigs...)
+					assert.ErrorContains(t, err, "duplicate")
+				}),
+
+			When("an outsider signed", func() {
+				sigs = []signing.SignatureV2{signWith(privKeys[1], sequence), signWith(secp256k1.GenPrivKey(), sequence)}
+			}).
+				Then("should fail", func(t *testing.T) {
+					_, err := types.MergeGovernanceSignatures(*governanceKey, sequence, sigs...)
+					assert.ErrorContains(t, err, "not a governance key holder")
+				}),
+
+			When("a signature is for a different sequence", func() {
+				sigs = []signing.SignatureV2{signWith(privKeys[0], sequence), signWith(privKeys[1], sequence+1)}
+			}).
+				Then("should fail", func(t *testing.T) {
+					_, err := types.MergeGovernanceSignatures(*governanceKey, sequence, sigs...)
+					assert.ErrorContains(t, err, "sequence")
+				}),
+		).
+		Run(t)
+}
+
+func randomGovernanceKey(threshold int, count int) (*multisig.LegacyAminoPubKey, []cryptotypes.PrivKey) {
+	var privKeys []cryptotypes.PrivKey
+	var pubKeys []cryptotypes.PubKey
+	for i := 0; i < count; i++ {
+		privKey := secp256k1.GenPrivKey()
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, privKey.PubKey())
+	}
+
+	return multisig.NewLegacyAminoPubKey(threshold, pubKeys), privKeys
+}
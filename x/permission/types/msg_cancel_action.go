@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCancelActionRequest is the constructor for CancelActionRequest
+func NewCancelActionRequest(sender sdk.AccAddress, id uint64) *CancelActionRequest {
+	return &CancelActionRequest{
+		Sender: sender.String(),
+		ID:     id,
+	}
+}
+
+// Route returns the route for this message
+func (m CancelActionRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m CancelActionRequest) Type() string {
+	return "CancelAction"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m CancelActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, errorsmod.Wrap(err, "sender").Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes that need to be signed
+func (m CancelActionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+var (
+	_ sdk.Msg = &QueueActionRequest{}
+
+	_ cdctypes.UnpackInterfacesMessage = &QueueActionRequest{}
+)
+
+// NewQueueActionRequest is the constructor for QueueActionRequest
+func NewQueueActionRequest(sender sdk.AccAddress, msg sdk.Msg) *QueueActionRequest {
+	return &QueueActionRequest{
+		Sender: sender.String(),
+		Msg:    funcs.Must(cdctypes.NewAnyWithValue(msg)),
+	}
+}
+
+// Route returns the route for this message
+func (m QueueActionRequest) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (m QueueActionRequest) Type() string {
+	return "QueueAction"
+}
+
+// ValidateBasic executes a stateless message validation
+func (m QueueActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, errorsmod.Wrap(err, "sender").Error())
+	}
+
+	msg := m.UnwrapMessage()
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing message to queue")
+	}
+
+	if _, ok := msg.(*QueueActionRequest); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nested queue action requests are not allowed")
+	}
+
+	if msg, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m QueueActionRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if m.Msg == nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnwrapMessage returns the queued message
+func (m QueueActionRequest) UnwrapMessage() sdk.Msg {
+	if m.Msg == nil {
+		return nil
+	}
+
+	msg, _ := m.Msg.GetCachedValue().(sdk.Msg)
+	return msg
+}
@@ -1,9 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Parameter keys
+var (
+	// KeyTimelockDelays represents the key for the timelock delays of message types
+	KeyTimelockDelays = []byte("timelockDelays")
+)
+
 // KeyTable retrieves a subspace table for the module
 func KeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -11,7 +19,9 @@ func KeyTable() params.KeyTable {
 
 // DefaultParams creates the default genesis parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		TimelockDelays: []TimelockDelay{},
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -23,10 +33,58 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 		parameter values as pointer arguments, otherwise either the internal type reflection panics or the value will not be
 		set on the correct Params data struct
 	*/
-	return params.ParamSetPairs{}
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyTimelockDelays, &m.TimelockDelays, validateTimelockDelays),
+	}
 }
 
 // Validate checks if the parameters are valid
 func (m Params) Validate() error {
+	return validateTimelockDelays(m.TimelockDelays)
+}
+
+// GetTimelockDelay returns the number of blocks messages of the given type must be queued before they are executed,
+// and false if messages of the given type are not subject to a timelock
+func (m Params) GetTimelockDelay(msgTypeURL string) (int64, bool) {
+	for _, delay := range m.TimelockDelays {
+		if delay.MsgTypeURL == msgTypeURL {
+			return delay.Delay, true
+		}
+	}
+
+	return 0, false
+}
+
+func validateTimelockDelays(i interface{}) error {
+	delays, ok := i.([]TimelockDelay)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for TimelockDelays: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, delay := range delays {
+		if err := delay.Validate(); err != nil {
+			return err
+		}
+
+		if seen[delay.MsgTypeURL] {
+			return fmt.Errorf("duplicate timelock delay for %s", delay.MsgTypeURL)
+		}
+		seen[delay.MsgTypeURL] = true
+	}
+
+	return nil
+}
+
+// Validate returns an error if the timelock delay is invalid
+func (m TimelockDelay) Validate() error {
+	if m.MsgTypeURL == "" {
+		return fmt.Errorf("timelock delay message type url must not be empty")
+	}
+
+	if m.Delay <= 0 {
+		return fmt.Errorf("timelock delay for %s must be positive", m.MsgTypeURL)
+	}
+
 	return nil
 }
@@ -25,6 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params represent the genesis parameters for the module
 type Params struct {
+	// timelock_delays lists the message types that must be queued before they
+	// are executed, together with their delays. Messages executed by governance
+	// proposals bypass the timelock, the proposal's voting period acts as their
+	// delay instead
+	TimelockDelays []TimelockDelay `protobuf:"bytes,1,rep,name=timelock_delays,json=timelockDelays,proto3" json:"timelock_delays"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,8 +65,49 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// TimelockDelay is the number of blocks a queued message of the given type
+// has to wait before it is executed
+type TimelockDelay struct {
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Delay      int64  `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (m *TimelockDelay) Reset()         { *m = TimelockDelay{} }
+func (m *TimelockDelay) String() string { return proto.CompactTextString(m) }
+func (*TimelockDelay) ProtoMessage()    {}
+func (*TimelockDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72a2085057d41b41, []int{1}
+}
+func (m *TimelockDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockDelay.Merge(m, src)
+}
+func (m *TimelockDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockDelay proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "axelar.permission.v1beta1.Params")
+	proto.RegisterType((*TimelockDelay)(nil), "axelar.permission.v1beta1.TimelockDelay")
 }
 
 func init() {
@@ -69,18 +115,25 @@ func init() {
 }
 
 var fileDescriptor_72a2085057d41b41 = []byte{
-	// 169 bytes of a gzipped FileDescriptorProto
+	// 280 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xac, 0x48, 0xcd,
 	0x49, 0x2c, 0xd2, 0x2f, 0x48, 0x2d, 0xca, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x33,
 	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0x92, 0x84, 0xa8, 0xd3, 0x43, 0xa8, 0xd3, 0x83, 0xaa, 0x93, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x07, 0xab, 0xd2, 0x07, 0xb1, 0x20, 0x1a, 0x94, 0x38, 0xb8, 0xd8, 0x02, 0xc0, 0x06,
-	0x38, 0x85, 0x9e, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0xe6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x3b, 0xf2,
-	0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0xfd, 0x0a, 0x64,
-	0x07, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xed, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x49, 0x81, 0x83, 0x50, 0xc2, 0x00, 0x00, 0x00,
+	0x4f, 0xcf, 0x07, 0xab, 0xd2, 0x07, 0xb1, 0x20, 0x1a, 0x94, 0x12, 0xb9, 0xd8, 0x02, 0xc0, 0x06,
+	0x08, 0x85, 0x73, 0xf1, 0x97, 0x64, 0xe6, 0xa6, 0xe6, 0xe4, 0x27, 0x67, 0xc7, 0xa7, 0xa4, 0xe6,
+	0x24, 0x56, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x69, 0xe8, 0xe1, 0x34, 0x54, 0x2f,
+	0x04, 0xaa, 0xc3, 0x05, 0xa4, 0xc1, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xbe, 0x12, 0x64,
+	0xc1, 0x62, 0xa5, 0x70, 0x2e, 0x5e, 0x14, 0x65, 0x42, 0x06, 0x5c, 0x3c, 0xb9, 0xc5, 0xe9, 0xf1,
+	0x25, 0x95, 0x05, 0xa9, 0xf1, 0xa5, 0x45, 0x39, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x7c,
+	0x8f, 0xee, 0xc9, 0x73, 0xf9, 0x16, 0xa7, 0x87, 0x54, 0x16, 0xa4, 0x86, 0x06, 0xf9, 0x04, 0x71,
+	0xe5, 0x42, 0xd9, 0x45, 0x39, 0x42, 0x22, 0x5c, 0xac, 0x60, 0x27, 0x49, 0x30, 0x29, 0x30, 0x6a,
+	0x30, 0x07, 0x41, 0x38, 0x4e, 0xa1, 0x27, 0x1e, 0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
+	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
+	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x79, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
+	0x3e, 0xc4, 0x03, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0x50, 0x9e, 0x6e, 0x72, 0x7e, 0x51,
+	0xaa, 0x7e, 0x05, 0x72, 0x90, 0x82, 0x5c, 0x54, 0x9c, 0xc4, 0x06, 0x0e, 0x19, 0x63, 0xc0, 0x00,
+	0x52, 0x91, 0xa6, 0x60, 0x74, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -103,6 +156,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimelockDelays) > 0 {
+		for iNdEx := len(m.TimelockDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -123,6 +225,28 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.TimelockDelays) > 0 {
+		for _, e := range m.TimelockDelays {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimelockDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Delay != 0 {
+		n += 1 + sovParams(uint64(m.Delay))
+	}
 	return n
 }
 
@@ -161,6 +285,141 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockDelays = append(m.TimelockDelays, TimelockDelay{})
+			if err := m.TimelockDelays[len(m.TimelockDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	multisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -175,11 +176,90 @@ func (m *ParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
+// QueuedActionsRequest represents a message that queries the actions waiting
+// for their timelock to expire
+type QueuedActionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueuedActionsRequest) Reset()         { *m = QueuedActionsRequest{} }
+func (m *QueuedActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueuedActionsRequest) ProtoMessage()    {}
+func (*QueuedActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8183035d07a1606, []int{4}
+}
+func (m *QueuedActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedActionsRequest.Merge(m, src)
+}
+func (m *QueuedActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedActionsRequest proto.InternalMessageInfo
+
+type QueuedActionsResponse struct {
+	Actions    []QueuedAction      `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueuedActionsResponse) Reset()         { *m = QueuedActionsResponse{} }
+func (m *QueuedActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueuedActionsResponse) ProtoMessage()    {}
+func (*QueuedActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8183035d07a1606, []int{5}
+}
+func (m *QueuedActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedActionsResponse.Merge(m, src)
+}
+func (m *QueuedActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedActionsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryGovernanceKeyRequest)(nil), "axelar.permission.v1beta1.QueryGovernanceKeyRequest")
 	proto.RegisterType((*QueryGovernanceKeyResponse)(nil), "axelar.permission.v1beta1.QueryGovernanceKeyResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.permission.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.permission.v1beta1.ParamsResponse")
+	proto.RegisterType((*QueuedActionsRequest)(nil), "axelar.permission.v1beta1.QueuedActionsRequest")
+	proto.RegisterType((*QueuedActionsResponse)(nil), "axelar.permission.v1beta1.QueuedActionsResponse")
 }
 
 func init() {
@@ -187,28 +267,35 @@ func init() {
 }
 
 var fileDescriptor_e8183035d07a1606 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4f, 0x3a, 0x31,
-	0x14, 0xc7, 0xef, 0x92, 0x5f, 0x18, 0xfa, 0x0b, 0x98, 0x10, 0x07, 0xc1, 0xa4, 0x0a, 0x89, 0x46,
-	0x07, 0xdb, 0xa0, 0x83, 0xa3, 0x91, 0xc5, 0x01, 0x07, 0x20, 0xd1, 0xc1, 0xc5, 0xf4, 0x2e, 0x2f,
-	0xf5, 0x02, 0x77, 0xef, 0x68, 0x7b, 0x48, 0xff, 0x0b, 0xff, 0x2c, 0x46, 0x46, 0x27, 0xa3, 0xf0,
-	0x8f, 0x18, 0x68, 0x4f, 0x19, 0x64, 0x6b, 0xfb, 0x3e, 0xef, 0x7d, 0x3f, 0x7d, 0xe4, 0x44, 0xcc,
-	0x60, 0x2c, 0x14, 0xcf, 0x41, 0xa5, 0x89, 0xd6, 0x09, 0x66, 0x7c, 0xda, 0x89, 0xc0, 0x88, 0x0e,
-	0x9f, 0x14, 0xa0, 0x2c, 0xcb, 0x15, 0x1a, 0xac, 0x37, 0x1c, 0xc6, 0x7e, 0x31, 0xe6, 0xb1, 0xe6,
-	0xbe, 0x44, 0x89, 0x1b, 0x8a, 0xaf, 0x4f, 0xae, 0xa1, 0xd9, 0x8a, 0x51, 0xa7, 0xa8, 0x79, 0xac,
-	0x6c, 0x6e, 0x90, 0xa7, 0xc5, 0xd8, 0x24, 0x3a, 0x91, 0x7c, 0x04, 0x56, 0x7b, 0xe4, 0x74, 0x77,
-	0x74, 0x2e, 0x94, 0x48, 0x3d, 0xd7, 0x3e, 0x24, 0x8d, 0xc1, 0x5a, 0xe5, 0x0e, 0xa7, 0xa0, 0x32,
-	0x91, 0xc5, 0xd0, 0x03, 0x3b, 0x84, 0x49, 0x01, 0xda, 0xb4, 0x0d, 0x69, 0xfe, 0x55, 0xd4, 0x39,
-	0x66, 0x1a, 0xea, 0x8f, 0xa4, 0x26, 0x7f, 0x0a, 0xcf, 0x23, 0xb0, 0x07, 0xe1, 0x71, 0x78, 0xf6,
-	0xff, 0xf2, 0x9c, 0x39, 0x3d, 0xe6, 0xf4, 0x58, 0xa9, 0xc7, 0xee, 0x41, 0x8a, 0xd8, 0xde, 0xa6,
-	0x49, 0x86, 0xfd, 0x22, 0xea, 0x81, 0xed, 0xfe, 0x9b, 0x7f, 0x1c, 0x05, 0xc3, 0xaa, 0xdc, 0x9e,
-	0xdf, 0xde, 0x23, 0xd5, 0xfe, 0x46, 0xb1, 0xd4, 0x18, 0x90, 0x5a, 0xf9, 0xe0, 0xa3, 0x6f, 0x48,
-	0xc5, 0xfd, 0xc2, 0x47, 0xb6, 0xd8, 0xce, 0x15, 0x32, 0xd7, 0xea, 0xa3, 0x7c, 0x5b, 0xf7, 0x61,
-	0xfe, 0x45, 0x83, 0xf9, 0x92, 0x86, 0x8b, 0x25, 0x0d, 0x3f, 0x97, 0x34, 0x7c, 0x5b, 0xd1, 0x60,
-	0xb1, 0xa2, 0xc1, 0xfb, 0x8a, 0x06, 0x4f, 0xd7, 0x32, 0x31, 0x2f, 0x45, 0xc4, 0x62, 0x4c, 0xb9,
-	0x1b, 0x9c, 0x81, 0x79, 0x45, 0x35, 0xf2, 0xb7, 0x8b, 0x18, 0x15, 0xf0, 0xd9, 0xf6, 0x72, 0x8d,
-	0xcd, 0x41, 0x47, 0x95, 0xcd, 0x52, 0xaf, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xb9, 0xb8,
-	0x45, 0xf9, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8e, 0xd2, 0x40,
+	0x1c, 0xc6, 0x5b, 0x35, 0x6b, 0x32, 0x9b, 0x5d, 0x93, 0x66, 0x4d, 0x76, 0x31, 0xa9, 0x4b, 0x13,
+	0x01, 0x4d, 0x9c, 0x09, 0x78, 0xf0, 0x68, 0xe0, 0x20, 0x07, 0x3c, 0x00, 0x89, 0x1e, 0x3c, 0x68,
+	0xa6, 0xf5, 0x9f, 0xb1, 0x81, 0x76, 0xca, 0xcc, 0x14, 0x99, 0xb7, 0xf0, 0x35, 0x7c, 0x13, 0x8e,
+	0x1c, 0x3d, 0x19, 0x85, 0x17, 0x31, 0xed, 0x4c, 0xa1, 0x1a, 0xc1, 0x1b, 0xcc, 0x7c, 0xdf, 0xf7,
+	0xff, 0x7d, 0x9d, 0x3f, 0x7a, 0x42, 0x57, 0x30, 0xa7, 0x82, 0x64, 0x20, 0x92, 0x58, 0xca, 0x98,
+	0xa7, 0x64, 0xd9, 0x0d, 0x41, 0xd1, 0x2e, 0x59, 0xe4, 0x20, 0x34, 0xce, 0x04, 0x57, 0xdc, 0xbb,
+	0x31, 0x32, 0x7c, 0x90, 0x61, 0x2b, 0x6b, 0x5c, 0x31, 0xce, 0x78, 0xa9, 0x22, 0xc5, 0x2f, 0x63,
+	0x68, 0x3c, 0x8b, 0xb8, 0x4c, 0xb8, 0x24, 0x21, 0x95, 0x60, 0x92, 0xf6, 0xb9, 0x19, 0x65, 0x71,
+	0x4a, 0x55, 0x91, 0x61, 0xb4, 0x4d, 0xab, 0x8d, 0x84, 0xce, 0x14, 0x27, 0x49, 0x3e, 0x57, 0xb1,
+	0x8c, 0x19, 0x99, 0x81, 0x96, 0x56, 0xd2, 0x3a, 0x8e, 0x99, 0x51, 0x41, 0x93, 0x4a, 0x77, 0xa2,
+	0x8e, 0xd2, 0x19, 0x58, 0x59, 0xf0, 0x08, 0xdd, 0x4c, 0x0a, 0xa6, 0x21, 0x5f, 0x82, 0x48, 0x69,
+	0x1a, 0xc1, 0x08, 0xf4, 0x14, 0x16, 0x39, 0x48, 0x15, 0x28, 0xd4, 0xf8, 0xd7, 0xa5, 0xcc, 0x78,
+	0x2a, 0xc1, 0x7b, 0x87, 0x2e, 0xd9, 0xfe, 0xe2, 0xe3, 0x0c, 0xf4, 0xb5, 0x7b, 0xeb, 0x76, 0xce,
+	0x7b, 0x4f, 0xb1, 0x69, 0x81, 0x4d, 0x0b, 0x5c, 0xb5, 0xc0, 0x6f, 0x80, 0xd1, 0x48, 0xf7, 0x93,
+	0x38, 0xe5, 0xe3, 0x3c, 0x1c, 0x81, 0x1e, 0xdc, 0x5b, 0xff, 0x78, 0xec, 0x4c, 0x2f, 0x58, 0x3d,
+	0x3f, 0x78, 0x80, 0x2e, 0xc6, 0x65, 0x93, 0x0a, 0x63, 0x82, 0x2e, 0xab, 0x03, 0x3b, 0xfa, 0x15,
+	0x3a, 0x33, 0x65, 0xed, 0xc8, 0x26, 0x3e, 0xfa, 0x2a, 0xd8, 0x58, 0xed, 0x28, 0x6b, 0x0b, 0x3e,
+	0xa0, 0xab, 0x49, 0x0e, 0x39, 0x7c, 0xea, 0x47, 0xc5, 0xe7, 0xaf, 0x46, 0x79, 0xaf, 0x11, 0x3a,
+	0x3c, 0x8a, 0x0d, 0x6f, 0x55, 0x7d, 0x8a, 0x17, 0xc4, 0x66, 0x17, 0x0e, 0xe1, 0x0c, 0xac, 0x77,
+	0x5a, 0x73, 0x06, 0xdf, 0x5c, 0xf4, 0xf0, 0xaf, 0x01, 0x16, 0x7d, 0x88, 0xee, 0x53, 0x73, 0x74,
+	0xed, 0xde, 0xde, 0xed, 0x9c, 0xf7, 0xda, 0x27, 0xd8, 0xeb, 0x11, 0xb6, 0x41, 0xe5, 0xf6, 0x86,
+	0x7f, 0xa0, 0xde, 0x29, 0x51, 0xdb, 0xff, 0x45, 0x35, 0x14, 0x75, 0xd6, 0xc1, 0xdb, 0xf5, 0x2f,
+	0xdf, 0x59, 0x6f, 0x7d, 0x77, 0xb3, 0xf5, 0xdd, 0x9f, 0x5b, 0xdf, 0xfd, 0xba, 0xf3, 0x9d, 0xcd,
+	0xce, 0x77, 0xbe, 0xef, 0x7c, 0xe7, 0xfd, 0x4b, 0x16, 0xab, 0xcf, 0x79, 0x88, 0x23, 0x9e, 0x10,
+	0x03, 0x9a, 0x82, 0xfa, 0xc2, 0xc5, 0xcc, 0xfe, 0x7b, 0x1e, 0x71, 0x01, 0x64, 0x55, 0xdf, 0xb3,
+	0x72, 0xbf, 0xc2, 0xb3, 0x72, 0xc1, 0x5e, 0xfc, 0x1e, 0x00, 0xfb, 0xc3, 0x80, 0x00, 0x58, 0x03,
+	0x00, 0x00,
 }
 
 func (m *QueryGovernanceKeyRequest) Marshal() (dAtA []byte, err error) {
@@ -323,6 +410,90 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -374,6 +545,38 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueuedActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -646,6 +849,212 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, QueuedAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,38 +37,45 @@ func init() {
 }
 
 var fileDescriptor_5d763a569c6664cc = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0x13, 0x31,
-	0x18, 0x86, 0xe3, 0x22, 0x32, 0x58, 0xb0, 0x98, 0x2e, 0x44, 0xe8, 0x24, 0x82, 0x28, 0x4d, 0x4b,
-	0xcf, 0xa4, 0xd0, 0x56, 0xea, 0x08, 0x48, 0x0c, 0x08, 0x09, 0x2a, 0x75, 0xe9, 0x12, 0x9c, 0xcb,
-	0xc7, 0x71, 0x34, 0xf1, 0x77, 0xb5, 0x9d, 0x90, 0xac, 0x4c, 0x8c, 0x48, 0xcc, 0xfc, 0x06, 0xfe,
-	0x02, 0x03, 0x03, 0x63, 0x25, 0x16, 0x46, 0x94, 0xb0, 0xb0, 0x21, 0xb1, 0x31, 0xa1, 0xf3, 0x39,
-	0x85, 0x36, 0x17, 0x37, 0xb7, 0xdd, 0xe9, 0xde, 0xc7, 0x7e, 0x9f, 0xcf, 0xf2, 0xd1, 0x5b, 0x62,
-	0x08, 0x5d, 0xa1, 0x78, 0x0a, 0xaa, 0x97, 0x68, 0x9d, 0xa0, 0xe4, 0x83, 0x66, 0x1b, 0x8c, 0x68,
-	0x72, 0x0d, 0x6a, 0x90, 0x44, 0x10, 0xa6, 0x0a, 0x0d, 0xb2, 0xab, 0x79, 0x30, 0xfc, 0x17, 0x0c,
-	0x5d, 0xb0, 0xb6, 0x1c, 0x63, 0x8c, 0x36, 0xc5, 0xb3, 0xa7, 0x1c, 0xa8, 0x5d, 0x8b, 0x11, 0xe3,
-	0x2e, 0x70, 0x91, 0x26, 0x5c, 0x48, 0x89, 0x46, 0x98, 0x04, 0xa5, 0x76, 0x5f, 0xeb, 0xf3, 0xf7,
-	0x35, 0x43, 0x97, 0xb9, 0x39, 0x3f, 0x73, 0xd4, 0x07, 0x35, 0xca, 0x63, 0x9b, 0x7f, 0xaa, 0xf4,
-	0xc2, 0x13, 0x1d, 0xb3, 0x9f, 0x84, 0xb2, 0x3d, 0x88, 0x13, 0x6d, 0x40, 0x3d, 0x40, 0x69, 0x14,
-	0x76, 0xbb, 0xa0, 0xd8, 0xbd, 0x70, 0x6e, 0xf3, 0x70, 0x36, 0xbe, 0x07, 0x47, 0x7d, 0xd0, 0xa6,
-	0xb6, 0x55, 0x92, 0xd2, 0x29, 0x4a, 0x0d, 0xf5, 0x17, 0x6f, 0xbe, 0xfe, 0x78, 0xbf, 0xf4, 0x7c,
-	0x97, 0xac, 0x1d, 0xac, 0xef, 0x92, 0xb5, 0xfa, 0x0a, 0x9f, 0x75, 0x50, 0x0e, 0x6f, 0x45, 0x27,
-	0x7c, 0x3d, 0xe4, 0xf3, 0x5d, 0x0b, 0xf2, 0xec, 0x37, 0xa1, 0xcb, 0x0f, 0x41, 0xcd, 0xda, 0x6e,
-	0x7b, 0x7a, 0x17, 0x01, 0x53, 0xdf, 0x9d, 0xd2, 0x9c, 0x33, 0x7e, 0x65, 0x8d, 0x3b, 0x99, 0xf1,
-	0x46, 0x66, 0xbc, 0x5a, 0x60, 0xd2, 0x81, 0x22, 0xe7, 0x3b, 0x1e, 0xe7, 0x42, 0x82, 0xfd, 0x22,
-	0xf4, 0xca, 0x7e, 0xda, 0x11, 0x06, 0x1e, 0xe1, 0x00, 0x94, 0x14, 0x32, 0x82, 0xc7, 0x30, 0x62,
-	0xbe, 0xc3, 0x2a, 0xc8, 0x4f, 0x9d, 0xb7, 0xcb, 0x62, 0x25, 0x94, 0xfb, 0x96, 0x6f, 0xc5, 0x27,
-	0x0b, 0xb4, 0x0e, 0x61, 0xe4, 0x55, 0x2e, 0x24, 0xd8, 0x07, 0x42, 0x2f, 0xe5, 0x5d, 0x9e, 0x0a,
-	0x25, 0x7a, 0x9a, 0x85, 0xe7, 0x96, 0xce, 0x83, 0x53, 0x49, 0xbe, 0x70, 0xde, 0xd9, 0xdd, 0xb6,
-	0x76, 0x2b, 0x99, 0xd8, 0x75, 0x4f, 0xdd, 0xd4, 0x52, 0x9b, 0x9f, 0x97, 0xe8, 0xc5, 0x67, 0xd9,
-	0x65, 0x64, 0x1f, 0x09, 0xbd, 0x7c, 0xfa, 0x58, 0x7c, 0x37, 0xcf, 0x32, 0x85, 0xa7, 0xb2, 0x55,
-	0x92, 0x72, 0xb5, 0x9b, 0xb6, 0xf6, 0x3a, 0x6b, 0x78, 0x3a, 0x9f, 0x99, 0xed, 0x5b, 0x42, 0xab,
-	0x6e, 0xaa, 0xab, 0x9e, 0x4d, 0x4f, 0xcf, 0xb3, 0xb1, 0x40, 0xd2, 0x55, 0x6a, 0xd8, 0x4a, 0x37,
-	0xd8, 0xf9, 0x63, 0xbc, 0xbf, 0xff, 0x65, 0x1c, 0x90, 0xe3, 0x71, 0x40, 0xbe, 0x8f, 0x03, 0xf2,
-	0x6e, 0x12, 0x54, 0x3e, 0x4d, 0x02, 0x72, 0x3c, 0x09, 0x2a, 0xdf, 0x26, 0x41, 0xe5, 0x60, 0x27,
-	0x4e, 0xcc, 0xcb, 0x7e, 0x3b, 0x8c, 0xb0, 0xe7, 0x96, 0x92, 0x60, 0x5e, 0xa3, 0x3a, 0x74, 0x6f,
-	0x1b, 0x11, 0x2a, 0xe0, 0xc3, 0xff, 0xd7, 0x37, 0xa3, 0x14, 0x74, 0xbb, 0x6a, 0xff, 0x90, 0x77,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x40, 0x8b, 0x91, 0x46, 0xe6, 0x05, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0xdf, 0xea, 0x5b, 0x89, 0xa3, 0x5d, 0x8e, 0x2e, 0x44, 0xc8, 0x12, 0x41, 0xb4,
+	0x4d, 0xdb, 0xf8, 0x9a, 0xf4, 0x97, 0xd4, 0x0d, 0x8a, 0xc4, 0x80, 0x90, 0x68, 0xa5, 0x2e, 0x5d,
+	0x82, 0xe3, 0xbc, 0x18, 0xd3, 0xc4, 0xe7, 0xde, 0x9d, 0x43, 0xb2, 0x32, 0x31, 0x22, 0x31, 0x23,
+	0x31, 0xc0, 0xcc, 0xbf, 0xc0, 0xc0, 0xc0, 0x58, 0x89, 0x85, 0x11, 0x25, 0x2c, 0x48, 0x0c, 0x48,
+	0xfc, 0x03, 0xc8, 0xe7, 0x73, 0x49, 0x88, 0x73, 0xb5, 0xb7, 0x44, 0x79, 0x1e, 0xbf, 0x9f, 0xe7,
+	0xbd, 0x27, 0x67, 0xbc, 0xe2, 0xf4, 0xa1, 0xe3, 0x70, 0x1a, 0x02, 0xef, 0xfa, 0x42, 0xf8, 0x2c,
+	0xa0, 0xbd, 0x7a, 0x0b, 0xa4, 0x53, 0xa7, 0x02, 0x78, 0xcf, 0x77, 0xc1, 0x0e, 0x39, 0x93, 0x8c,
+	0x5c, 0x4f, 0x84, 0xf6, 0x5f, 0xa1, 0xad, 0x85, 0xe5, 0x25, 0x8f, 0x79, 0x4c, 0xa9, 0x68, 0xfc,
+	0x29, 0x31, 0x94, 0x6f, 0x78, 0x8c, 0x79, 0x1d, 0xa0, 0x4e, 0xe8, 0x53, 0x27, 0x08, 0x98, 0x74,
+	0xa4, 0xcf, 0x02, 0xa1, 0x7f, 0xad, 0xcc, 0x9e, 0x2b, 0xfb, 0x5a, 0x73, 0x7b, 0xb6, 0xe6, 0x2c,
+	0x02, 0x3e, 0x48, 0x64, 0x8d, 0x4f, 0x57, 0xf0, 0xdc, 0x43, 0xe1, 0x91, 0x1f, 0x08, 0x93, 0x23,
+	0xf0, 0x7c, 0x21, 0x81, 0x1f, 0xb0, 0x40, 0x72, 0xd6, 0xe9, 0x00, 0x27, 0xdb, 0xf6, 0x4c, 0x72,
+	0x7b, 0x5a, 0x7e, 0x04, 0x67, 0x11, 0x08, 0x59, 0xde, 0x29, 0xe8, 0x12, 0x21, 0x0b, 0x04, 0x54,
+	0x9e, 0xbc, 0xf8, 0xf2, 0xfd, 0xf5, 0x7f, 0x8f, 0xf7, 0xd1, 0xda, 0xc9, 0xfa, 0x3e, 0x5a, 0xab,
+	0x2c, 0xd3, 0xe9, 0x0c, 0x5c, 0xdb, 0x9b, 0xee, 0x85, 0xbf, 0x62, 0xd3, 0xd9, 0x59, 0x33, 0xf4,
+	0xe4, 0x37, 0xc2, 0x4b, 0xf7, 0x80, 0x4f, 0xa7, 0xdd, 0x35, 0x70, 0x67, 0x19, 0xd2, 0xbc, 0x7b,
+	0x85, 0x7d, 0x3a, 0xf1, 0x33, 0x95, 0xb8, 0x1d, 0x27, 0xae, 0xc5, 0x89, 0x57, 0x33, 0x92, 0xb4,
+	0x21, 0x2b, 0xf3, 0xa6, 0x21, 0x73, 0xa6, 0x83, 0xfc, 0x42, 0xf8, 0xda, 0x71, 0xd8, 0x76, 0x24,
+	0xdc, 0x67, 0x3d, 0xe0, 0x81, 0x13, 0xb8, 0xf0, 0x00, 0x06, 0xc4, 0x74, 0x58, 0x19, 0xfa, 0x34,
+	0xf3, 0x6e, 0x51, 0x5b, 0x81, 0xc8, 0x91, 0xf2, 0x37, 0xbd, 0x8b, 0x07, 0x34, 0x4f, 0x61, 0x60,
+	0x8c, 0x9c, 0xe9, 0x20, 0x6f, 0x10, 0x5e, 0x48, 0x58, 0x1e, 0x39, 0xdc, 0xe9, 0x0a, 0x62, 0x5f,
+	0x0a, 0x9d, 0x08, 0xd3, 0x90, 0x34, 0xb7, 0x5e, 0xa7, 0xdb, 0x50, 0xe9, 0x96, 0xe3, 0x60, 0x37,
+	0x0d, 0xb8, 0x61, 0x82, 0xf3, 0x16, 0xe1, 0xab, 0x87, 0x11, 0x44, 0x70, 0xc7, 0x8d, 0xff, 0xde,
+	0xa4, 0x66, 0x18, 0x37, 0xa6, 0x4b, 0xe9, 0xec, 0xbc, 0x72, 0x0d, 0xd7, 0x50, 0x70, 0x1b, 0x31,
+	0xdc, 0x0a, 0x35, 0x5e, 0x0f, 0x11, 0x34, 0x9d, 0x04, 0xe9, 0x1d, 0xc2, 0x0b, 0x07, 0xf1, 0x42,
+	0x3b, 0x9a, 0xd1, 0x34, 0x74, 0x5c, 0x98, 0x67, 0x85, 0x93, 0x7a, 0x4d, 0xb9, 0xa5, 0x28, 0x67,
+	0x76, 0x23, 0xa5, 0x74, 0x95, 0x57, 0x63, 0x36, 0x7e, 0xce, 0xe1, 0xff, 0x0f, 0xe3, 0x6b, 0x8d,
+	0x7c, 0x40, 0x78, 0x71, 0xb2, 0xe0, 0xdb, 0xe6, 0x35, 0xf1, 0x41, 0x66, 0xbf, 0x77, 0x0a, 0xba,
+	0x34, 0x7d, 0x5d, 0xd1, 0xaf, 0x93, 0xaa, 0x01, 0xfd, 0x9f, 0x96, 0xbe, 0x44, 0x78, 0x5e, 0xf7,
+	0x73, 0xd5, 0x30, 0x74, 0xb2, 0x99, 0xd5, 0x1c, 0x4a, 0x8d, 0x54, 0x55, 0x48, 0xb7, 0x48, 0x8e,
+	0x42, 0xbe, 0x47, 0x78, 0x51, 0x35, 0xa7, 0x9d, 0x1c, 0x8a, 0x20, 0xf4, 0xb2, 0x8e, 0xa5, 0xca,
+	0x14, 0x6c, 0x33, 0xbf, 0xa1, 0xc0, 0xca, 0x54, 0x27, 0xdb, 0xfa, 0xb4, 0xc5, 0xdd, 0xe3, 0xcf,
+	0x43, 0x0b, 0x9d, 0x0f, 0x2d, 0xf4, 0x6d, 0x68, 0xa1, 0x57, 0x23, 0xab, 0xf4, 0x71, 0x64, 0xa1,
+	0xf3, 0x91, 0x55, 0xfa, 0x3a, 0xb2, 0x4a, 0x27, 0x7b, 0x9e, 0x2f, 0x9f, 0x46, 0x2d, 0xdb, 0x65,
+	0x5d, 0xfd, 0xc8, 0x00, 0xe4, 0x73, 0xc6, 0x4f, 0xf5, 0xb7, 0x9a, 0xcb, 0x38, 0xd0, 0xfe, 0xf8,
+	0x1c, 0x39, 0x08, 0x41, 0xb4, 0xe6, 0xd5, 0x3b, 0x71, 0xeb, 0xcf, 0x00, 0x33, 0xd6, 0xf8, 0x5c,
+	0xd8, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -87,6 +94,8 @@ type MsgClient interface {
 	DeregisterController(ctx context.Context, in *DeregisterControllerRequest, opts ...grpc.CallOption) (*DeregisterControllerResponse, error)
 	UpdateGovernanceKey(ctx context.Context, in *UpdateGovernanceKeyRequest, opts ...grpc.CallOption) (*UpdateGovernanceKeyResponse, error)
 	UpdateParams(ctx context.Context, in *UpdateParamsRequest, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+	QueueAction(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error)
+	CancelAction(ctx context.Context, in *CancelActionRequest, opts ...grpc.CallOption) (*CancelActionResponse, error)
 }
 
 type msgClient struct {
@@ -133,12 +142,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *UpdateParamsRequest, o
 	return out, nil
 }
 
+func (c *msgClient) QueueAction(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error) {
+	out := new(QueueActionResponse)
+	err := c.cc.Invoke(ctx, "/axelar.permission.v1beta1.Msg/QueueAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAction(ctx context.Context, in *CancelActionRequest, opts ...grpc.CallOption) (*CancelActionResponse, error) {
+	out := new(CancelActionResponse)
+	err := c.cc.Invoke(ctx, "/axelar.permission.v1beta1.Msg/CancelAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	RegisterController(context.Context, *RegisterControllerRequest) (*RegisterControllerResponse, error)
 	DeregisterController(context.Context, *DeregisterControllerRequest) (*DeregisterControllerResponse, error)
 	UpdateGovernanceKey(context.Context, *UpdateGovernanceKeyRequest) (*UpdateGovernanceKeyResponse, error)
 	UpdateParams(context.Context, *UpdateParamsRequest) (*UpdateParamsResponse, error)
+	QueueAction(context.Context, *QueueActionRequest) (*QueueActionResponse, error)
+	CancelAction(context.Context, *CancelActionRequest) (*CancelActionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -157,6 +186,12 @@ func (*UnimplementedMsgServer) UpdateGovernanceKey(ctx context.Context, req *Upd
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *UpdateParamsRequest) (*UpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) QueueAction(ctx context.Context, req *QueueActionRequest) (*QueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueAction not implemented")
+}
+func (*UnimplementedMsgServer) CancelAction(ctx context.Context, req *CancelActionRequest) (*CancelActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -234,6 +269,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_QueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.permission.v1beta1.Msg/QueueAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueueAction(ctx, req.(*QueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.permission.v1beta1.Msg/CancelAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAction(ctx, req.(*CancelActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.permission.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -254,6 +325,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "QueueAction",
+			Handler:    _Msg_QueueAction_Handler,
+		},
+		{
+			MethodName: "CancelAction",
+			Handler:    _Msg_CancelAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/permission/v1beta1/service.proto",
@@ -266,6 +345,8 @@ type QueryClient interface {
 	// GovernanceKey returns the multisig governance key
 	GovernanceKey(ctx context.Context, in *QueryGovernanceKeyRequest, opts ...grpc.CallOption) (*QueryGovernanceKeyResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// QueuedActions returns the actions waiting for their timelock to expire
+	QueuedActions(ctx context.Context, in *QueuedActionsRequest, opts ...grpc.CallOption) (*QueuedActionsResponse, error)
 }
 
 type queryClient struct {
@@ -294,11 +375,22 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) QueuedActions(ctx context.Context, in *QueuedActionsRequest, opts ...grpc.CallOption) (*QueuedActionsResponse, error) {
+	out := new(QueuedActionsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.permission.v1beta1.Query/QueuedActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GovernanceKey returns the multisig governance key
 	GovernanceKey(context.Context, *QueryGovernanceKeyRequest) (*QueryGovernanceKeyResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// QueuedActions returns the actions waiting for their timelock to expire
+	QueuedActions(context.Context, *QueuedActionsRequest) (*QueuedActionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -311,6 +403,9 @@ func (*UnimplementedQueryServer) GovernanceKey(ctx context.Context, req *QueryGo
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) QueuedActions(ctx context.Context, req *QueuedActionsRequest) (*QueuedActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedActions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -352,6 +447,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.permission.v1beta1.Query/QueuedActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedActions(ctx, req.(*QueuedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.permission.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -364,6 +477,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "QueuedActions",
+			Handler:    _Query_QueuedActions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/permission/v1beta1/service.proto",
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/axelarnetwork/axelar-core/x/permission/exported"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	multisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -339,6 +340,159 @@ func (m *UpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateParamsResponse proto.InternalMessageInfo
 
+// QueueActionRequest queues a message that is subject to a timelock. The
+// message is executed once its delay has passed unless it gets cancelled
+type QueueActionRequest struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Msg    *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueueActionRequest) Reset()         { *m = QueueActionRequest{} }
+func (m *QueueActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueActionRequest) ProtoMessage()    {}
+func (*QueueActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94583825d46fd31c, []int{8}
+}
+func (m *QueueActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueActionRequest.Merge(m, src)
+}
+func (m *QueueActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueActionRequest proto.InternalMessageInfo
+
+type QueueActionResponse struct {
+	ID        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecuteAt int64  `protobuf:"varint,2,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+}
+
+func (m *QueueActionResponse) Reset()         { *m = QueueActionResponse{} }
+func (m *QueueActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueActionResponse) ProtoMessage()    {}
+func (*QueueActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94583825d46fd31c, []int{9}
+}
+func (m *QueueActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueActionResponse.Merge(m, src)
+}
+func (m *QueueActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueActionResponse proto.InternalMessageInfo
+
+// CancelActionRequest cancels a queued action before it is executed
+type CancelActionRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ID     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *CancelActionRequest) Reset()         { *m = CancelActionRequest{} }
+func (m *CancelActionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelActionRequest) ProtoMessage()    {}
+func (*CancelActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94583825d46fd31c, []int{10}
+}
+func (m *CancelActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelActionRequest.Merge(m, src)
+}
+func (m *CancelActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelActionRequest proto.InternalMessageInfo
+
+type CancelActionResponse struct {
+}
+
+func (m *CancelActionResponse) Reset()         { *m = CancelActionResponse{} }
+func (m *CancelActionResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelActionResponse) ProtoMessage()    {}
+func (*CancelActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94583825d46fd31c, []int{11}
+}
+func (m *CancelActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelActionResponse.Merge(m, src)
+}
+func (m *CancelActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelActionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UpdateGovernanceKeyRequest)(nil), "axelar.permission.v1beta1.UpdateGovernanceKeyRequest")
 	proto.RegisterType((*UpdateGovernanceKeyResponse)(nil), "axelar.permission.v1beta1.UpdateGovernanceKeyResponse")
@@ -348,6 +502,10 @@ func init() {
 	proto.RegisterType((*DeregisterControllerResponse)(nil), "axelar.permission.v1beta1.DeregisterControllerResponse")
 	proto.RegisterType((*UpdateParamsRequest)(nil), "axelar.permission.v1beta1.UpdateParamsRequest")
 	proto.RegisterType((*UpdateParamsResponse)(nil), "axelar.permission.v1beta1.UpdateParamsResponse")
+	proto.RegisterType((*QueueActionRequest)(nil), "axelar.permission.v1beta1.QueueActionRequest")
+	proto.RegisterType((*QueueActionResponse)(nil), "axelar.permission.v1beta1.QueueActionResponse")
+	proto.RegisterType((*CancelActionRequest)(nil), "axelar.permission.v1beta1.CancelActionRequest")
+	proto.RegisterType((*CancelActionResponse)(nil), "axelar.permission.v1beta1.CancelActionResponse")
 }
 
 func init() {
@@ -355,46 +513,56 @@ func init() {
 }
 
 var fileDescriptor_94583825d46fd31c = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x14, 0x55, 0xea, 0x51, 0x10, 0x0d, 0x15, 0x75, 0xd2, 0xd6, 0x49, 0x3d, 0xa0,
-	0x50, 0x29, 0x36, 0x69, 0x24, 0x90, 0xba, 0xa0, 0x84, 0x4a, 0x0c, 0x65, 0x28, 0x46, 0x65, 0x60,
-	0x09, 0x17, 0xfb, 0xc9, 0xb5, 0x12, 0xfb, 0xcc, 0xdd, 0x25, 0xc4, 0x5b, 0xc5, 0xc8, 0xc4, 0x47,
-	0xe9, 0x40, 0x06, 0x24, 0xc4, 0x9c, 0xb1, 0x62, 0x62, 0xaa, 0x20, 0x19, 0xfa, 0x1d, 0x98, 0x50,
-	0x7c, 0x97, 0xc4, 0x28, 0x2e, 0x12, 0x0c, 0x2c, 0x2c, 0xb9, 0xdc, 0xdd, 0xff, 0xfe, 0xef, 0xbd,
-	0xdf, 0xf9, 0x1e, 0xd2, 0x71, 0x1f, 0x3a, 0x98, 0x9a, 0x21, 0x50, 0xdf, 0x63, 0xcc, 0x23, 0x81,
-	0xd9, 0xab, 0xb6, 0x80, 0xe3, 0xaa, 0xc9, 0xfb, 0x46, 0x48, 0x09, 0x27, 0xb9, 0xbc, 0xd0, 0x18,
-	0x73, 0x8d, 0x21, 0x35, 0x85, 0x75, 0x97, 0xb8, 0x24, 0x56, 0x99, 0x93, 0x7f, 0xe2, 0x40, 0xe1,
-	0xee, 0xd5, 0xa6, 0x21, 0xa6, 0xd8, 0x67, 0x52, 0xb7, 0x63, 0x13, 0xe6, 0x13, 0x66, 0xda, 0x34,
-	0x0a, 0x39, 0x31, 0xfd, 0x6e, 0x87, 0x7b, 0xcc, 0x73, 0xcd, 0x36, 0x44, 0x53, 0x89, 0xb1, 0x68,
-	0x05, 0xfd, 0x90, 0x50, 0x0e, 0xce, 0x3c, 0xd1, 0x28, 0x84, 0xa9, 0x3e, 0x2f, 0x2c, 0x9b, 0x22,
-	0x27, 0x31, 0x91, 0x5b, 0x1b, 0x32, 0x9a, 0xcf, 0x5c, 0xb3, 0x57, 0x9d, 0x0c, 0x72, 0x63, 0x0d,
-	0xfb, 0x5e, 0x40, 0xcc, 0xf8, 0x57, 0x2c, 0xe9, 0x9f, 0xb2, 0xa8, 0x70, 0x1c, 0x3a, 0x98, 0xc3,
-	0x13, 0xd2, 0x03, 0x1a, 0xe0, 0xc0, 0x86, 0x43, 0x88, 0x2c, 0x78, 0xdd, 0x05, 0xc6, 0x73, 0xaf,
-	0xd0, 0x1a, 0x83, 0xc0, 0x01, 0xda, 0x74, 0x20, 0xa4, 0x60, 0x63, 0x0e, 0x8e, 0xaa, 0x94, 0x94,
-	0xf2, 0x6a, 0xa3, 0xf6, 0xe3, 0xa2, 0x58, 0x71, 0x3d, 0x7e, 0xd2, 0x6d, 0x19, 0x36, 0xf1, 0xcd,
-	0x69, 0x89, 0xf1, 0x50, 0x61, 0x4e, 0x5b, 0xa6, 0x5b, 0xb7, 0xed, 0xba, 0xe3, 0x50, 0x60, 0x4c,
-	0x55, 0xac, 0x5b, 0xc2, 0xed, 0x60, 0x66, 0x96, 0x7b, 0x81, 0x6e, 0xba, 0xb3, 0xc8, 0xcd, 0x36,
-	0x44, 0x6a, 0xb6, 0xa4, 0x94, 0xaf, 0xef, 0xdd, 0x33, 0x64, 0x4d, 0x82, 0x99, 0x31, 0x65, 0x66,
-	0x3c, 0x05, 0x17, 0xdb, 0x51, 0x7d, 0x52, 0xc3, 0x51, 0xb7, 0x75, 0x08, 0x51, 0xe3, 0xda, 0xf0,
-	0xa2, 0x98, 0xb1, 0x6e, 0xb8, 0xc9, 0x02, 0x72, 0xf7, 0xd1, 0xb2, 0x88, 0xa5, 0x2e, 0x95, 0x94,
-	0xf2, 0x4a, 0x43, 0xfd, 0xf2, 0xa1, 0xb2, 0x2e, 0x2d, 0x65, 0x3e, 0xcf, 0x39, 0xf5, 0x02, 0xd7,
-	0x92, 0xba, 0xfd, 0xbd, 0xd3, 0x81, 0xba, 0xf4, 0xf6, 0xf2, 0x6c, 0x57, 0x2e, 0xbc, 0xbb, 0x3c,
-	0xdb, 0xd5, 0x12, 0xd7, 0x91, 0x82, 0x49, 0xdf, 0x46, 0x9b, 0xa9, 0xf4, 0x58, 0x48, 0x02, 0x06,
-	0xfa, 0x20, 0x8b, 0xf2, 0x16, 0xb8, 0x1e, 0xe3, 0x40, 0x1f, 0x93, 0x80, 0x53, 0xd2, 0xe9, 0x00,
-	0xfd, 0x77, 0x70, 0x9f, 0x21, 0x64, 0xcf, 0xc2, 0xc6, 0x60, 0x57, 0x1b, 0xd5, 0x3f, 0xb6, 0xb6,
-	0x12, 0x26, 0x7f, 0xc1, 0xb5, 0x9a, 0xc2, 0x75, 0x3b, 0xc1, 0x75, 0x11, 0x90, 0xbe, 0x85, 0x0a,
-	0x69, 0xd8, 0x24, 0xd5, 0x8f, 0x59, 0xb4, 0x79, 0x00, 0xf4, 0x7f, 0xe7, 0x5a, 0x4b, 0xe1, 0x5a,
-	0x4c, 0x70, 0x4d, 0x43, 0xa4, 0x6b, 0x68, 0x2b, 0x1d, 0x9d, 0x64, 0xfb, 0x59, 0x41, 0xb7, 0xc5,
-	0x17, 0x7d, 0x14, 0x37, 0xb0, 0x29, 0xd3, 0x07, 0x68, 0x05, 0x77, 0xf9, 0x09, 0xa1, 0x1e, 0x8f,
-	0x62, 0x96, 0xbf, 0xcb, 0x70, 0x2e, 0xcd, 0x3d, 0x42, 0xcb, 0xa2, 0x13, 0xca, 0x67, 0xbd, 0x63,
-	0x5c, 0xd9, 0x63, 0x0d, 0x11, 0x51, 0x3e, 0x67, 0x79, 0x6c, 0xdf, 0x38, 0x1d, 0xa8, 0xd9, 0x49,
-	0x95, 0x73, 0xd3, 0x49, 0xa1, 0x1b, 0x0b, 0x0f, 0x53, 0x9c, 0xd6, 0xef, 0xa0, 0xf5, 0x5f, 0xf3,
-	0x17, 0x85, 0x35, 0x8e, 0x87, 0xdf, 0xb5, 0xcc, 0x70, 0xa4, 0x29, 0xe7, 0x23, 0x4d, 0xf9, 0x36,
-	0xd2, 0x94, 0xf7, 0x63, 0x2d, 0x73, 0x3e, 0xd6, 0x32, 0x5f, 0xc7, 0x5a, 0xe6, 0xe5, 0xc3, 0xc4,
-	0xc5, 0x89, 0x04, 0x03, 0xe0, 0x6f, 0x08, 0x6d, 0xcb, 0x59, 0xc5, 0x26, 0x14, 0xcc, 0x7e, 0xb2,
-	0x3b, 0xc7, 0xb7, 0xd9, 0x5a, 0x8e, 0xdb, 0x68, 0xed, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4,
-	0xfd, 0x30, 0x63, 0x5f, 0x06, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0x3f, 0x6f, 0xfb, 0x44,
+	0x18, 0xc7, 0x63, 0xa7, 0x8a, 0xd4, 0xa3, 0x20, 0xea, 0x46, 0xad, 0x93, 0xb6, 0x4e, 0xeb, 0x01,
+	0x95, 0xa2, 0xd8, 0xa4, 0x95, 0x40, 0xca, 0x82, 0x92, 0x56, 0x42, 0xa8, 0x45, 0x6a, 0x8d, 0xca,
+	0xc0, 0x12, 0x1c, 0xfb, 0xc1, 0xb5, 0x12, 0xfb, 0xcc, 0xdd, 0xb9, 0xc4, 0x5b, 0xc5, 0x88, 0x18,
+	0x78, 0x29, 0x1d, 0x1a, 0x21, 0x24, 0xc4, 0x5c, 0x31, 0x55, 0x4c, 0x4c, 0x15, 0xa4, 0x43, 0xdf,
+	0x03, 0x13, 0xb2, 0xef, 0x92, 0xb8, 0xad, 0x2b, 0xfe, 0x49, 0x2c, 0xbf, 0x25, 0xb1, 0xcf, 0xcf,
+	0x3d, 0xcf, 0xf7, 0xfb, 0xb9, 0xbb, 0xe7, 0x90, 0x6e, 0x8f, 0x60, 0x68, 0x13, 0x33, 0x02, 0x12,
+	0xf8, 0x94, 0xfa, 0x38, 0x34, 0x2f, 0x5a, 0x7d, 0x60, 0x76, 0xcb, 0x64, 0x23, 0x23, 0x22, 0x98,
+	0x61, 0xa5, 0xc6, 0x63, 0x8c, 0x79, 0x8c, 0x21, 0x62, 0xea, 0x35, 0x0f, 0x63, 0x6f, 0x08, 0x66,
+	0x16, 0xd8, 0x8f, 0xbf, 0x30, 0xed, 0x30, 0xe1, 0xb3, 0xea, 0x55, 0x0f, 0x7b, 0x38, 0x7b, 0x34,
+	0xd3, 0x27, 0x31, 0xfa, 0xd6, 0xcb, 0xf5, 0x22, 0x9b, 0xd8, 0x01, 0x15, 0x71, 0xdb, 0x0e, 0xa6,
+	0x01, 0xa6, 0xa6, 0x43, 0x92, 0x88, 0x61, 0x33, 0x88, 0x87, 0xcc, 0xa7, 0xbe, 0x67, 0x0e, 0x20,
+	0x99, 0x86, 0x18, 0xcf, 0x53, 0xc1, 0x28, 0xc2, 0x84, 0x81, 0x3b, 0xf7, 0x90, 0x44, 0x30, 0x8d,
+	0xaf, 0xf1, 0x94, 0x3d, 0xae, 0x89, 0xbf, 0x88, 0x4f, 0x6b, 0xa2, 0x5a, 0x40, 0x3d, 0xf3, 0xa2,
+	0x95, 0xfe, 0x89, 0x0f, 0xcb, 0x76, 0xe0, 0x87, 0xd8, 0xcc, 0x7e, 0xf9, 0x90, 0xfe, 0xa3, 0x8c,
+	0xea, 0x67, 0x91, 0x6b, 0x33, 0xf8, 0x10, 0x5f, 0x00, 0x09, 0xed, 0xd0, 0x81, 0x23, 0x48, 0x2c,
+	0xf8, 0x32, 0x06, 0xca, 0x94, 0xcf, 0xd1, 0x32, 0x85, 0xd0, 0x05, 0xd2, 0x73, 0x21, 0x22, 0xe0,
+	0xd8, 0x0c, 0x5c, 0x55, 0xda, 0x92, 0x76, 0x96, 0xba, 0xfb, 0x7f, 0xdc, 0x35, 0x9a, 0x9e, 0xcf,
+	0xce, 0xe3, 0xbe, 0xe1, 0xe0, 0xc0, 0x9c, 0x5a, 0xcc, 0xfe, 0x9a, 0xd4, 0x1d, 0x08, 0xb9, 0x1d,
+	0xc7, 0xe9, 0xb8, 0x2e, 0x01, 0x4a, 0x55, 0xc9, 0x7a, 0x93, 0x67, 0x3b, 0x9c, 0x25, 0x53, 0x3e,
+	0x45, 0x6f, 0x78, 0xb3, 0xca, 0xbd, 0x01, 0x24, 0xaa, 0xbc, 0x25, 0xed, 0xbc, 0xb6, 0xf7, 0xb6,
+	0x21, 0x3c, 0x71, 0x66, 0xc6, 0x94, 0x99, 0x71, 0x0c, 0x9e, 0xed, 0x24, 0x9d, 0xd4, 0xc3, 0x49,
+	0xdc, 0x3f, 0x82, 0xa4, 0xbb, 0x70, 0x73, 0xd7, 0x28, 0x59, 0xaf, 0x7b, 0x79, 0x03, 0xca, 0xbb,
+	0xa8, 0xc2, 0x6b, 0xa9, 0xe5, 0x2d, 0x69, 0x67, 0xb1, 0xab, 0xfe, 0x72, 0xdd, 0xac, 0x8a, 0x94,
+	0x42, 0xcf, 0x27, 0x8c, 0xf8, 0xa1, 0x67, 0x89, 0xb8, 0xf6, 0xde, 0xe5, 0x58, 0x2d, 0x7f, 0xfd,
+	0x70, 0xb5, 0x2b, 0x06, 0xbe, 0x79, 0xb8, 0xda, 0xd5, 0x72, 0xcb, 0x51, 0x80, 0x49, 0xdf, 0x44,
+	0xeb, 0x85, 0xf4, 0x68, 0x84, 0x43, 0x0a, 0xfa, 0x58, 0x46, 0x35, 0x0b, 0x3c, 0x9f, 0x32, 0x20,
+	0x07, 0x38, 0x64, 0x04, 0x0f, 0x87, 0x40, 0xfe, 0x3f, 0xb8, 0xa7, 0x08, 0x39, 0xb3, 0xb2, 0x19,
+	0xd8, 0xa5, 0x6e, 0xeb, 0x1f, 0xa7, 0xb6, 0x72, 0x49, 0xfe, 0x05, 0xd7, 0x56, 0x01, 0xd7, 0xcd,
+	0x1c, 0xd7, 0xe7, 0x80, 0xf4, 0x0d, 0x54, 0x2f, 0xc2, 0x26, 0xa8, 0xfe, 0x20, 0xa3, 0xf5, 0x43,
+	0x20, 0xaf, 0x3a, 0xd7, 0xfd, 0x02, 0xae, 0x8d, 0x1c, 0xd7, 0x22, 0x44, 0xba, 0x86, 0x36, 0x8a,
+	0xd1, 0x09, 0xb6, 0x3f, 0x49, 0x68, 0x85, 0xef, 0xe8, 0x93, 0xac, 0x81, 0x4d, 0x99, 0xbe, 0x87,
+	0x16, 0xed, 0x98, 0x9d, 0x63, 0xe2, 0xb3, 0x44, 0x95, 0xfe, 0x42, 0xe1, 0x3c, 0x54, 0xf9, 0x00,
+	0x55, 0x78, 0x27, 0x14, 0xc7, 0x7a, 0xdb, 0x78, 0xb1, 0xfd, 0x1a, 0xbc, 0xa2, 0x38, 0xce, 0x62,
+	0x5a, 0xdb, 0xb8, 0x1c, 0xab, 0x72, 0xea, 0x72, 0x9e, 0x34, 0x35, 0xba, 0xf6, 0xec, 0x60, 0xf2,
+	0xd9, 0xfa, 0x2a, 0xaa, 0x3e, 0xd6, 0x2f, 0x8c, 0x7d, 0x2f, 0x21, 0xe5, 0x34, 0x86, 0x18, 0x3a,
+	0x0e, 0xf3, 0x71, 0x38, 0xf5, 0x35, 0xc7, 0x2e, 0xfd, 0x3d, 0xec, 0xca, 0x01, 0x2a, 0x07, 0xd4,
+	0x13, 0x76, 0xaa, 0x06, 0xbf, 0x32, 0x8c, 0xe9, 0x95, 0x61, 0x74, 0xc2, 0xa4, 0xbb, 0xfe, 0xf3,
+	0x75, 0x53, 0x34, 0x61, 0xa3, 0x6f, 0x53, 0x98, 0x39, 0xfc, 0x98, 0x7a, 0x56, 0x3a, 0xbb, 0xbd,
+	0x7b, 0x39, 0x56, 0xa5, 0x27, 0x6b, 0xb7, 0x9a, 0xb3, 0x94, 0x53, 0xaa, 0x1f, 0xa3, 0x95, 0x47,
+	0xc2, 0xb9, 0x21, 0x65, 0x15, 0xc9, 0x3e, 0xdf, 0xd6, 0x0b, 0xdd, 0xca, 0xe4, 0xae, 0x21, 0x7f,
+	0x74, 0x68, 0xc9, 0xbe, 0xab, 0x6c, 0x22, 0x04, 0x23, 0x70, 0x62, 0x06, 0x3d, 0x9b, 0x65, 0x32,
+	0xcb, 0xd6, 0xa2, 0x18, 0xe9, 0x30, 0xfd, 0x5b, 0x09, 0xad, 0x1c, 0xa4, 0x7d, 0x6a, 0xf8, 0x5f,
+	0x41, 0x70, 0x01, 0xf2, 0x53, 0x01, 0xed, 0x77, 0x0a, 0xf6, 0x65, 0x7e, 0xb9, 0xf2, 0xd5, 0xd3,
+	0xe5, 0x7a, 0xac, 0x86, 0xbb, 0xeb, 0x9e, 0xdd, 0xfc, 0xae, 0x95, 0x6e, 0x26, 0x9a, 0x74, 0x3b,
+	0xd1, 0xa4, 0xdf, 0x26, 0x9a, 0xf4, 0xdd, 0xbd, 0x56, 0xba, 0xbd, 0xd7, 0x4a, 0xbf, 0xde, 0x6b,
+	0xa5, 0xcf, 0xde, 0xcf, 0x9d, 0x33, 0xbe, 0x9f, 0x42, 0x60, 0x5f, 0x61, 0x32, 0x10, 0x6f, 0x4d,
+	0x07, 0x13, 0x30, 0x47, 0xf9, 0xcb, 0x34, 0x3b, 0x7c, 0xfd, 0x4a, 0xb6, 0x4e, 0xfb, 0x7f, 0x0e,
+	0x00, 0x82, 0x2b, 0x9e, 0xc9, 0x29, 0x08, 0x00, 0x00,
 }
 
 func (m *UpdateGovernanceKeyRequest) Marshal() (dAtA []byte, err error) {
@@ -664,6 +832,139 @@ func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueueActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -787,6 +1088,63 @@ func (m *UpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueueActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteAt))
+	}
+	return n
+}
+
+func (m *CancelActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *CancelActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1557,6 +1915,363 @@ func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueueActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
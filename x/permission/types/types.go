@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axelarnetwork/axelar-core/x/permission/exported"
@@ -23,3 +26,52 @@ func (m GovAccount) Validate() error {
 
 	return nil
 }
+
+var _ cdctypes.UnpackInterfacesMessage = QueuedAction{}
+
+// NewQueuedAction is the constructor for QueuedAction
+func NewQueuedAction(id uint64, sender sdk.AccAddress, msg *cdctypes.Any, queuedAt int64, executeAt int64) QueuedAction {
+	return QueuedAction{ID: id, Sender: sender, Msg: msg, QueuedAt: queuedAt, ExecuteAt: executeAt}
+}
+
+// ValidateBasic performs a stateless check to ensure the QueuedAction object has been initialized correctly
+func (m QueuedAction) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Sender); err != nil {
+		return fmt.Errorf("queued action %d has an invalid sender: %w", m.ID, err)
+	}
+
+	if m.UnwrapMessage() == nil {
+		return fmt.Errorf("queued action %d has no message", m.ID)
+	}
+
+	if m.QueuedAt < 0 || m.ExecuteAt <= m.QueuedAt {
+		return fmt.Errorf("queued action %d must be executed after it was queued", m.ID)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (m QueuedAction) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if m.Msg == nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnwrapMessage returns the queued message
+func (m QueuedAction) UnwrapMessage() sdk.Msg {
+	if m.Msg == nil {
+		return nil
+	}
+
+	msg, _ := m.Msg.GetCachedValue().(sdk.Msg)
+	return msg
+}
+
+// IsDue returns true if the action's timelock has expired at the given block height
+func (m QueuedAction) IsDue(blockHeight int64) bool {
+	return blockHeight >= m.ExecuteAt
+}
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	exported "github.com/axelarnetwork/axelar-core/x/permission/exported"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -63,8 +65,53 @@ func (m *GovAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GovAccount proto.InternalMessageInfo
 
+// QueuedAction is a role-gated message waiting for its timelock to expire
+type QueuedAction struct {
+	ID        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Msg       *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	QueuedAt  int64      `protobuf:"varint,3,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	ExecuteAt int64      `protobuf:"varint,4,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	// the account that queued the action, its role is checked again before
+	// the action is executed
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+}
+
+func (m *QueuedAction) Reset()         { *m = QueuedAction{} }
+func (m *QueuedAction) String() string { return proto.CompactTextString(m) }
+func (*QueuedAction) ProtoMessage()    {}
+func (*QueuedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26f62b2f68507141, []int{1}
+}
+func (m *QueuedAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedAction.Merge(m, src)
+}
+func (m *QueuedAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedAction proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GovAccount)(nil), "axelar.permission.v1beta1.GovAccount")
+	proto.RegisterType((*QueuedAction)(nil), "axelar.permission.v1beta1.QueuedAction")
 }
 
 func init() {
@@ -72,24 +119,34 @@ func init() {
 }
 
 var fileDescriptor_26f62b2f68507141 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xac, 0x48, 0xcd,
-	0x49, 0x2c, 0xd2, 0x2f, 0x48, 0x2d, 0xca, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x84, 0x28, 0xd3, 0x43, 0x28, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd2, 0x07, 0xb1, 0x20, 0x1a, 0xa4, 0xf4, 0x30, 0xcd, 0x4d, 0xad, 0x28, 0xc8,
-	0x2f, 0x2a, 0x49, 0x4d, 0xc1, 0x66, 0x81, 0xd2, 0x74, 0x46, 0x2e, 0x2e, 0xf7, 0xfc, 0x32, 0xc7,
-	0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0x21, 0x6f, 0x2e, 0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x1e, 0x27, 0xc3, 0x5f, 0xf7, 0xe4, 0x75, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xa1, 0x94,
-	0x6e, 0x71, 0x4a, 0x36, 0xd4, 0x34, 0xc7, 0xe4, 0x64, 0x47, 0x88, 0xc6, 0x20, 0x98, 0x09, 0x42,
-	0x36, 0x5c, 0x2c, 0x45, 0xf9, 0x39, 0xa9, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0x1a, 0x7a,
-	0x98, 0x7e, 0x81, 0x39, 0x0d, 0xe6, 0x29, 0xbd, 0xa0, 0xfc, 0x9c, 0xd4, 0x20, 0xb0, 0x2e, 0xa7,
-	0xd0, 0x13, 0x0f, 0xe5, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x1c, 0xc9, 0x4d, 0x10, 0x73, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4,
-	0xfc, 0xa2, 0x54, 0xfd, 0x0a, 0xe4, 0x70, 0x00, 0x3b, 0x34, 0x89, 0x0d, 0xec, 0x6f, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0a, 0xca, 0x98, 0x73, 0x81, 0x01, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x49, 0x08, 0xf4, 0xa8, 0x18, 0x4e, 0x15, 0x24, 0xad, 0x70, 0xa3, 0x4a, 0x48,
+	0x59, 0x72, 0x56, 0xca, 0xc0, 0xc2, 0xe2, 0x80, 0x84, 0x2a, 0xc4, 0x80, 0x25, 0x16, 0x96, 0xca,
+	0xbe, 0x7b, 0x31, 0x56, 0x63, 0xbf, 0xe6, 0xee, 0x5c, 0x9c, 0x6f, 0xc1, 0xc6, 0x17, 0xe1, 0x43,
+	0x54, 0x4c, 0x1d, 0x99, 0x2a, 0x70, 0xbe, 0x01, 0x23, 0x13, 0xca, 0xdd, 0x19, 0x2a, 0x95, 0x89,
+	0xc9, 0xf7, 0xfe, 0x7b, 0x9e, 0x47, 0x3f, 0xd3, 0x47, 0x49, 0x03, 0xab, 0x44, 0x85, 0x15, 0xa8,
+	0x22, 0xd7, 0x3a, 0xc7, 0x32, 0x3c, 0x5f, 0xa4, 0x60, 0x92, 0x45, 0x68, 0xd6, 0x15, 0x68, 0x5e,
+	0x29, 0x34, 0xc8, 0x26, 0x6e, 0x8d, 0xff, 0x5d, 0xe3, 0x7e, 0x6d, 0x7f, 0x92, 0x21, 0x66, 0x2b,
+	0x08, 0xed, 0x62, 0x5a, 0xbf, 0x0b, 0x93, 0x72, 0xed, 0xae, 0xf6, 0xf7, 0x32, 0xcc, 0xd0, 0x3e,
+	0xc3, 0xed, 0xcb, 0x77, 0x27, 0x02, 0x75, 0x81, 0xfa, 0xd4, 0x0d, 0x5c, 0xe1, 0x47, 0xfc, 0x66,
+	0x1a, 0x68, 0x2a, 0x54, 0x06, 0xe4, 0xbf, 0x62, 0x1d, 0x7d, 0x26, 0x94, 0xbe, 0xc0, 0xf3, 0x48,
+	0x08, 0xac, 0x4b, 0xc3, 0x5e, 0xd2, 0xdb, 0x89, 0x94, 0x0a, 0xb4, 0x1e, 0x93, 0x29, 0x99, 0xed,
+	0x2e, 0x17, 0xbf, 0xae, 0x0e, 0xe7, 0x59, 0x6e, 0xde, 0xd7, 0x29, 0x17, 0x58, 0x78, 0x33, 0xff,
+	0x99, 0x6b, 0x79, 0xe6, 0xd5, 0x22, 0x21, 0x22, 0x77, 0x18, 0x77, 0x0a, 0xec, 0x29, 0x1d, 0x2a,
+	0x5c, 0xc1, 0xb8, 0x3f, 0x25, 0xb3, 0x7b, 0xc7, 0x33, 0x7e, 0x93, 0x40, 0x17, 0xad, 0x43, 0xc1,
+	0x63, 0x5c, 0x41, 0x6c, 0xaf, 0x8e, 0x7e, 0x12, 0xba, 0xfb, 0xba, 0x86, 0x1a, 0x64, 0x24, 0x4c,
+	0x8e, 0x25, 0xbb, 0x4f, 0xfb, 0xb9, 0xb4, 0xb1, 0x86, 0xcb, 0x51, 0x7b, 0x75, 0xd8, 0x3f, 0x79,
+	0x1e, 0xf7, 0x73, 0xc9, 0x9e, 0xd1, 0x41, 0xa1, 0x33, 0xeb, 0x72, 0xf7, 0x78, 0x8f, 0x3b, 0x98,
+	0xbc, 0x83, 0xc9, 0xa3, 0x72, 0xbd, 0x3c, 0xf8, 0xfa, 0x65, 0xfe, 0xc0, 0x73, 0x4a, 0x13, 0x0d,
+	0x7f, 0xfc, 0x5e, 0xe9, 0x2c, 0xde, 0x5e, 0xb3, 0x03, 0xba, 0xf3, 0xc1, 0x9a, 0x9d, 0x26, 0x66,
+	0x3c, 0x98, 0x92, 0xd9, 0x20, 0xbe, 0xe3, 0x1a, 0x91, 0x61, 0x0f, 0x29, 0x85, 0x06, 0x44, 0x6d,
+	0x60, 0x3b, 0x1d, 0xda, 0xe9, 0x8e, 0xef, 0x44, 0x86, 0x9d, 0xd0, 0x91, 0x86, 0x52, 0x82, 0x1a,
+	0xdf, 0xfa, 0x5f, 0x66, 0x5e, 0x60, 0xf9, 0xe6, 0xe2, 0x47, 0xd0, 0xbb, 0x68, 0x03, 0x72, 0xd9,
+	0x06, 0xe4, 0x7b, 0x1b, 0x90, 0x4f, 0x9b, 0xa0, 0x77, 0xb9, 0x09, 0x7a, 0xdf, 0x36, 0x41, 0xef,
+	0xed, 0x93, 0x6b, 0xa2, 0x0e, 0x66, 0x09, 0xe6, 0x23, 0xaa, 0x33, 0x5f, 0xcd, 0x05, 0x2a, 0x08,
+	0x9b, 0xeb, 0x3f, 0xdf, 0x3a, 0xa5, 0x23, 0x4b, 0xe3, 0xf1, 0xef, 0x01, 0x00, 0x60, 0x7e, 0x96,
+	0xee, 0xac, 0x02, 0x00, 0x00,
 }
 
 func (m *GovAccount) Marshal() (dAtA []byte, err error) {
@@ -127,6 +184,63 @@ func (m *GovAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecuteAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueuedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueuedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -154,6 +268,32 @@ func (m *GovAccount) Size() (n int) {
 	return n
 }
 
+func (m *QueuedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.QueuedAt != 0 {
+		n += 1 + sovTypes(uint64(m.QueuedAt))
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteAt))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -263,6 +403,183 @@ func (m *GovAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
+			}
+			m.QueuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
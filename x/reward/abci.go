@@ -1,13 +1,18 @@
 package reward
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/axelarnetwork/axelar-core/utils"
 	multisigTypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
@@ -18,9 +23,11 @@ import (
 // EndBlocker is called at the end of every block, process external chain voting inflation
 func EndBlocker(ctx sdk.Context, k types.Rewarder, n types.Nexus, m mintkeeper.Keeper, s types.Staker, slasher types.Slasher, msig types.MultiSig, ss types.Snapshotter) ([]abci.ValidatorUpdate, error) {
 	handleExternalChainVotingInflation(ctx, k, n, m, s, slasher, ss)
-	err := handleKeyMgmtInflation(ctx, k, m, s, slasher, msig, ss)
+	if err := handleKeyMgmtInflation(ctx, k, m, s, slasher, msig, ss); err != nil {
+		return nil, err
+	}
 
-	return nil, err
+	return nil, handlePenalties(ctx, k, n, s, slasher, msig)
 }
 
 func addRewardsByConsensusPower(ctx sdk.Context, s types.Staker, rewardPool exported.RewardPool, validators []snapshot.ValidatorI, totalReward sdk.DecCoin) {
@@ -152,6 +159,147 @@ func handleExternalChainVotingInflation(ctx sdk.Context, k types.Rewarder, n typ
 	}
 }
 
+// handlePenalties penalizes chronically absent validators if penalties are enabled
+// - chain maintainers that missed or voted incorrectly in too many polls are jailed if configured so.
+// The nexus module deregisters these maintainers in its own end blocker, so this must run before it
+// - validators that missed too many signatures are jailed
+//
+// The reward end blocker runs after the staking end blocker, so jailed validators are only removed
+// from the validator set by the staking end blocker of the next block
+func handlePenalties(ctx sdk.Context, k types.Rewarder, n types.Nexus, s types.Staker, slasher types.Slasher, mSig types.MultiSig) error {
+	params := k.GetParams(ctx).Penalty
+	if !params.Enabled {
+		return nil
+	}
+
+	if err := penalizeAbsentChainMaintainers(ctx, k, n, s, slasher, params); err != nil {
+		return err
+	}
+
+	return penalizeAbsentSigners(ctx, k, s, slasher, mSig, params)
+}
+
+func penalizeAbsentChainMaintainers(ctx sdk.Context, k types.Rewarder, n types.Nexus, s types.Staker, slasher types.Slasher, params types.PenaltyParams) error {
+	if params.MaintainerAction != types.PenaltyActionJail {
+		return nil
+	}
+
+	nexusParams := n.GetParams(ctx)
+	window := uint64(nexusParams.ChainMaintainerCheckWindow)
+
+	for _, chain := range n.GetChains(ctx) {
+		if !n.IsChainActivated(ctx, chain) {
+			continue
+		}
+
+		for _, maintainerState := range n.GetChainMaintainerStates(ctx, chain) {
+			reason, count, ok := checkMaintainerState(maintainerState, nexusParams)
+			if !ok {
+				continue
+			}
+
+			validator := maintainerState.GetAddress()
+			jailed, err := jailValidator(ctx, s, slasher, validator, params.JailDuration)
+			if err != nil {
+				return err
+			}
+
+			if !jailed {
+				continue
+			}
+
+			k.GetPool(ctx, chain.Name.String()).ClearRewards(validator, exported.Jailed)
+			k.RecordPenalty(ctx, types.NewMaintainerPenalty(ctx, validator, types.PenaltyActionJail, reason, chain.Name, count, window))
+		}
+	}
+
+	return nil
+}
+
+// checkMaintainerState applies the same thresholds the nexus module uses to deregister chain maintainers
+func checkMaintainerState(maintainerState nexus.MaintainerState, params nexustypes.Params) (types.PenaltyReason, uint64, bool) {
+	window := int(params.ChainMaintainerCheckWindow)
+
+	if missingVoteCount := maintainerState.CountMissingVotes(window); utils.NewThreshold(int64(missingVoteCount), int64(window)).GT(params.ChainMaintainerMissingVoteThreshold) {
+		return types.PenaltyReasonMissedVotes, missingVoteCount, true
+	}
+
+	if incorrectVoteCount := maintainerState.CountIncorrectVotes(window); utils.NewThreshold(int64(incorrectVoteCount), int64(window)).GT(params.ChainMaintainerIncorrectVoteThreshold) {
+		return types.PenaltyReasonIncorrectVotes, incorrectVoteCount, true
+	}
+
+	return types.PenaltyReasonUnspecified, 0, false
+}
+
+func penalizeAbsentSigners(ctx sdk.Context, k types.Rewarder, s types.Staker, slasher types.Slasher, mSig types.MultiSig, params types.PenaltyParams) error {
+	var validators []sdk.ValAddress
+	err := s.IterateBondedValidatorsByPower(ctx, func(_ int64, v stakingtypes.ValidatorI) bool {
+		validators = append(validators, toValAddress(v.GetOperator()))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// validators are only jailed after the iteration, because jailing changes the power index that is being iterated over
+	for _, validator := range validators {
+		participation := mSig.GetSigningParticipation(ctx, validator)
+
+		sessionCount := participation.GetWindowSessionCount()
+		if sessionCount < params.MinSigningSessions {
+			continue
+		}
+
+		if utils.NewThreshold(int64(participation.MissedCount), int64(sessionCount)).LTE(params.MissingSignatureThreshold) {
+			continue
+		}
+
+		jailed, err := jailValidator(ctx, s, slasher, validator, params.JailDuration)
+		if err != nil {
+			return err
+		}
+
+		if !jailed {
+			continue
+		}
+
+		// the validator starts over once it gets unjailed, so the same missed signatures cannot be used as evidence against it again
+		mSig.ResetSigningParticipation(ctx, validator)
+		k.GetPool(ctx, multisigTypes.ModuleName).ClearRewards(validator, exported.Jailed)
+		k.RecordPenalty(ctx, types.NewSignerPenalty(ctx, validator, participation.MissedCount, sessionCount))
+	}
+
+	return nil
+}
+
+// jailValidator jails the given validator until the jail duration has passed.
+// Returns false if the validator cannot be jailed because it is not bonded, already jailed or tombstoned
+func jailValidator(ctx sdk.Context, s types.Staker, slasher types.Slasher, validator sdk.ValAddress, jailDuration time.Duration) (bool, error) {
+	v, err := s.Validator(ctx, validator)
+	if err != nil || v == nil {
+		return false, nil
+	}
+
+	if !v.IsBonded() || excludeJailedOrTombstoned(ctx, slasher, v) {
+		return false, nil
+	}
+
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		return false, err
+	}
+
+	if err := slasher.Jail(ctx, consAddr); err != nil {
+		return false, err
+	}
+
+	if err := slasher.JailUntil(ctx, consAddr, ctx.BlockTime().Add(jailDuration)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func toValAddress(addr string) sdk.ValAddress {
 	return funcs.Must(sdk.ValAddressFromBech32(addr))
 }
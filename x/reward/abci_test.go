@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -22,13 +23,16 @@ import (
 	"github.com/axelarnetwork/axelar-core/app"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	rand2 "github.com/axelarnetwork/axelar-core/testutils/rand"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/reward"
 	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	exportedmock "github.com/axelarnetwork/axelar-core/x/reward/exported/mock"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/types/mock"
 	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
 )
 
 // TestEndBlocker_ExternalChainVotingInflation tests the reward distribution for external chain maintainers.
@@ -65,6 +69,167 @@ func TestEndBlocker_ExternalChainVotingInflation(t *testing.T) {
 	})
 }
 
+func TestEndBlocker_Penalties(t *testing.T) {
+	var (
+		s         *endBlockerTestSetup
+		validator stakingtypes.Validator
+		params    types.PenaltyParams
+		err       error
+	)
+
+	givenSetup := Given("a bonded validator", func() {
+		s = newEndBlockerTestSetup(t)
+		s.ctx = s.ctx.WithBlockTime(time.Now())
+
+		validator = funcs.Must(stakingtypes.NewValidator(s.maintainer.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{}))
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = math.NewInt(1000000000000)
+
+		s.staker.ValidatorFunc = func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) { return validator, nil }
+		s.nexusKeeper.GetChainMaintainerStatesFunc = func(sdk.Context, nexus.Chain) []nexus.MaintainerState { return nil }
+		s.msig.GetSigningParticipationFunc = func(_ sdk.Context, v sdk.ValAddress) multisigtypes.SigningParticipation {
+			return multisigtypes.NewSigningParticipation(v)
+		}
+		s.slasher.JailFunc = func(context.Context, sdk.ConsAddress) error { return nil }
+		s.slasher.JailUntilFunc = func(context.Context, sdk.ConsAddress, time.Time) error { return nil }
+		s.rewardPool.ClearRewardsFunc = func(sdk.ValAddress, exported.ClearReason) {}
+		s.rewarder.RecordPenaltyFunc = func(sdk.Context, types.Penalty) {}
+
+		params = types.DefaultPenaltyParams()
+		params.Enabled = true
+		params.MinSigningSessions = 10
+		s.rewarder.GetParamsFunc = func(sdk.Context) types.Params {
+			return types.Params{
+				KeyMgmtRelativeInflationRate:     math.LegacyZeroDec(),
+				ExternalChainVotingInflationRate: math.LegacyZeroDec(),
+				Penalty:                          params,
+			}
+		}
+		s.nexusKeeper.GetParamsFunc = func(sdk.Context) nexustypes.Params {
+			nexusParams := nexustypes.DefaultParams()
+			nexusParams.ChainMaintainerCheckWindow = 10
+			return nexusParams
+		}
+	})
+
+	runEndBlocker := func() { err = s.runEndBlocker() }
+
+	givenSetup.
+		When("the validator is a chain maintainer that missed too many votes", func() {
+			maintainerState := nexustypes.NewMaintainerState("ethereum", s.maintainer)
+			for i := 0; i < 10; i++ {
+				maintainerState.MarkMissingVote(i%5 != 0)
+			}
+
+			s.nexusKeeper.GetChainMaintainerStatesFunc = func(sdk.Context, nexus.Chain) []nexus.MaintainerState {
+				return []nexus.MaintainerState{maintainerState}
+			}
+		}).
+		Branch(
+			When("maintainers are configured to be jailed", func() { params.MaintainerAction = types.PenaltyActionJail }).
+				When("the end blocker runs", runEndBlocker).
+				Then("should jail the maintainer, clear its chain rewards and leave deregistration to nexus", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Len(t, s.rewardPool.ClearRewardsCalls(), 1)
+					assert.Equal(t, exported.Jailed, s.rewardPool.ClearRewardsCalls()[0].ClearReason)
+					assert.Equal(t, "ethereum", s.rewarder.GetPoolCalls()[len(s.rewarder.GetPoolCalls())-1].Name)
+					assert.Len(t, s.slasher.JailCalls(), 1)
+					assert.Len(t, s.slasher.JailUntilCalls(), 1)
+					assert.Equal(t, s.ctx.BlockTime().Add(params.JailDuration), s.slasher.JailUntilCalls()[0].JailTime)
+
+					assert.Len(t, s.rewarder.RecordPenaltyCalls(), 1)
+					penalty := s.rewarder.RecordPenaltyCalls()[0].Penalty
+					assert.Equal(t, types.NewMaintainerPenalty(s.ctx, s.maintainer, types.PenaltyActionJail, types.PenaltyReasonMissedVotes, "ethereum", 8, 10), penalty)
+					assert.NoError(t, penalty.ValidateBasic())
+				}),
+
+			When("maintainers are configured to be deregistered", func() { params.MaintainerAction = types.PenaltyActionDeregister }).
+				When("the end blocker runs", runEndBlocker).
+				Then("should leave the maintainer to be deregistered by nexus", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Empty(t, s.slasher.JailCalls())
+					assert.Empty(t, s.rewardPool.ClearRewardsCalls())
+					assert.Empty(t, s.rewarder.RecordPenaltyCalls())
+				}),
+
+			When("the validator is already jailed", func() {
+				params.MaintainerAction = types.PenaltyActionJail
+				validator.Jailed = true
+			}).
+				When("the end blocker runs", runEndBlocker).
+				Then("should not jail it again", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Empty(t, s.slasher.JailCalls())
+					assert.Empty(t, s.rewarder.RecordPenaltyCalls())
+				}),
+		).
+		Run(t)
+
+	givenSetup.
+		When("the validator is bonded", func() {
+			s.staker.IterateBondedValidatorsByPowerFunc = func(_ context.Context, fn func(int64, stakingtypes.ValidatorI) bool) error {
+				fn(0, validator)
+				return nil
+			}
+			s.msig.ResetSigningParticipationFunc = func(sdk.Context, sdk.ValAddress) {}
+		}).
+		Branch(
+			When("it missed too many signatures", func() {
+				s.msig.GetSigningParticipationFunc = func(_ sdk.Context, v sdk.ValAddress) multisigtypes.SigningParticipation {
+					participation := multisigtypes.NewSigningParticipation(v)
+					for i := 0; i < 10; i++ {
+						participation.Record(i < 4)
+					}
+
+					return participation
+				}
+			}).
+				When("the end blocker runs", runEndBlocker).
+				Then("should jail the validator, clear its multisig rewards and reset its signing participation", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Len(t, s.slasher.JailCalls(), 1)
+					assert.Len(t, s.msig.ResetSigningParticipationCalls(), 1)
+					assert.Len(t, s.rewardPool.ClearRewardsCalls(), 1)
+					assert.Equal(t, exported.Jailed, s.rewardPool.ClearRewardsCalls()[0].ClearReason)
+					assert.Equal(t, multisigtypes.ModuleName, s.rewarder.GetPoolCalls()[len(s.rewarder.GetPoolCalls())-1].Name)
+
+					assert.Len(t, s.rewarder.RecordPenaltyCalls(), 1)
+					penalty := s.rewarder.RecordPenaltyCalls()[0].Penalty
+					assert.Equal(t, types.NewSignerPenalty(s.ctx, s.maintainer, 6, 10), penalty)
+					assert.NoError(t, penalty.ValidateBasic())
+				}),
+
+			When("it participated in too few signing sessions", func() {
+				s.msig.GetSigningParticipationFunc = func(_ sdk.Context, v sdk.ValAddress) multisigtypes.SigningParticipation {
+					participation := multisigtypes.NewSigningParticipation(v)
+					for i := 0; i < 9; i++ {
+						participation.Record(false)
+					}
+
+					return participation
+				}
+			}).
+				When("the end blocker runs", runEndBlocker).
+				Then("should not penalize the validator", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Empty(t, s.slasher.JailCalls())
+					assert.Empty(t, s.rewarder.RecordPenaltyCalls())
+				}),
+
+			When("penalties are disabled", func() {
+				params.Enabled = false
+				s.msig.GetSigningParticipationFunc = nil
+			}).
+				When("the end blocker runs", runEndBlocker).
+				Then("should not penalize the validator", func(t *testing.T) {
+					assert.NoError(t, err)
+					assert.Empty(t, s.slasher.JailCalls())
+					assert.Empty(t, s.rewarder.RecordPenaltyCalls())
+				}),
+		).
+		Run(t)
+}
+
 type endBlockerTestSetup struct {
 	ctx         sdk.Context
 	mintK       mintkeeper.Keeper
@@ -24,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 
 	rewardQueryCmd.AddCommand(
 		GetCmdInflationRate(),
+		GetCmdPendingRewards(),
+		GetCmdPenalties(),
+		GetCmdRefundBudget(),
 		GetParams(),
 	)
 
@@ -65,6 +68,126 @@ func GetCmdInflationRate() *cobra.Command {
 	return cmd
 }
 
+// GetCmdPendingRewards returns the rewards of a validator that have not been released yet
+func GetCmdPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [validator]",
+		Short: "Returns the rewards the given validator has accumulated in each reward pool that have not been released yet",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	pool := cmd.Flags().String("pool", "", "the reward pool to retrieve the pending rewards for")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+			return errorsmod.Wrap(err, "invalid validator address")
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+		res, err := queryClient.PendingRewards(cmd.Context(), &types.PendingRewardsRequest{
+			Validator: args[0],
+			Pool:      *pool,
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPenalties returns the penalties imposed on validators
+func GetCmdPenalties() *cobra.Command {
+	cmdName := "penalties"
+	cmd := &cobra.Command{
+		Use:   "penalties [validator]",
+		Short: "Returns the penalties imposed on validators for being absent, including the evidence. If a validator is provided, only its penalties are returned.",
+		Args:  cobra.RangeArgs(0, 1),
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		var validator string
+		if len(args) > 0 {
+			if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+				return errorsmod.Wrap(err, "invalid validator address")
+			}
+
+			validator = args[0]
+		}
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+		res, err := queryClient.Penalties(cmd.Context(), &types.PenaltiesRequest{
+			Validator:  validator,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+	return cmd
+}
+
+// GetCmdRefundBudget returns the refunds a validator has claimed in the current refund epoch
+func GetCmdRefundBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-budget [validator]",
+		Short: "Returns the number of refunds the given validator has claimed in the current refund epoch",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+			return errorsmod.Wrap(err, "invalid validator address")
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+		res, err := queryClient.RefundBudget(cmd.Context(), &types.RefundBudgetRequest{
+			Validator: args[0],
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParams returns the reward params
 func GetParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,7 +25,7 @@ var _ exported.RewardPool = &RewardPoolMock{}
 //			AddRewardsFunc: func(rewards []exported.Reward)  {
 //				panic("mock out the AddRewards method")
 //			},
-//			ClearRewardsFunc: func(valAddress sdk.ValAddress)  {
+//			ClearRewardsFunc: func(valAddress sdk.ValAddress, clearReason exported.ClearReason)  {
 //				panic("mock out the ClearRewards method")
 //			},
 //			ReleaseRewardsFunc: func(valAddress sdk.ValAddress) error {
@@ -45,7 +45,7 @@ type RewardPoolMock struct {
 	AddRewardsFunc func(rewards []exported.Reward)
 
 	// ClearRewardsFunc mocks the ClearRewards method.
-	ClearRewardsFunc func(valAddress sdk.ValAddress)
+	ClearRewardsFunc func(valAddress sdk.ValAddress, clearReason exported.ClearReason)
 
 	// ReleaseRewardsFunc mocks the ReleaseRewards method.
 	ReleaseRewardsFunc func(valAddress sdk.ValAddress) error
@@ -68,6 +68,8 @@ type RewardPoolMock struct {
 		ClearRewards []struct {
 			// ValAddress is the valAddress argument value.
 			ValAddress sdk.ValAddress
+			// ClearReason is the clearReason argument value.
+			ClearReason exported.ClearReason
 		}
 		// ReleaseRewards holds details about calls to the ReleaseRewards method.
 		ReleaseRewards []struct {
@@ -150,19 +152,21 @@ func (mock *RewardPoolMock) AddRewardsCalls() []struct {
 }
 
 // ClearRewards calls ClearRewardsFunc.
-func (mock *RewardPoolMock) ClearRewards(valAddress sdk.ValAddress) {
+func (mock *RewardPoolMock) ClearRewards(valAddress sdk.ValAddress, clearReason exported.ClearReason) {
 	if mock.ClearRewardsFunc == nil {
 		panic("RewardPoolMock.ClearRewardsFunc: method is nil but RewardPool.ClearRewards was just called")
 	}
 	callInfo := struct {
-		ValAddress sdk.ValAddress
+		ValAddress  sdk.ValAddress
+		ClearReason exported.ClearReason
 	}{
-		ValAddress: valAddress,
+		ValAddress:  valAddress,
+		ClearReason: clearReason,
 	}
 	mock.lockClearRewards.Lock()
 	mock.calls.ClearRewards = append(mock.calls.ClearRewards, callInfo)
 	mock.lockClearRewards.Unlock()
-	mock.ClearRewardsFunc(valAddress)
+	mock.ClearRewardsFunc(valAddress, clearReason)
 }
 
 // ClearRewardsCalls gets all the calls that were made to ClearRewards.
@@ -170,10 +174,12 @@ func (mock *RewardPoolMock) ClearRewards(valAddress sdk.ValAddress) {
 //
 //	len(mockedRewardPool.ClearRewardsCalls())
 func (mock *RewardPoolMock) ClearRewardsCalls() []struct {
-	ValAddress sdk.ValAddress
+	ValAddress  sdk.ValAddress
+	ClearReason exported.ClearReason
 } {
 	var calls []struct {
-		ValAddress sdk.ValAddress
+		ValAddress  sdk.ValAddress
+		ClearReason exported.ClearReason
 	}
 	mock.lockClearRewards.RLock()
 	calls = mock.calls.ClearRewards
@@ -15,7 +15,7 @@ type Reward struct {
 type RewardPool interface {
 	AddReward(sdk.ValAddress, sdk.Coin)
 	AddRewards([]Reward)
-	ClearRewards(sdk.ValAddress)
+	ClearRewards(sdk.ValAddress, ClearReason)
 	ReleaseRewards(sdk.ValAddress) error
 }
 
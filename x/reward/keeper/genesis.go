@@ -13,6 +13,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, pool := range genState.Pools {
 		k.setPool(ctx, pool)
 	}
+
+	for _, penalty := range genState.Penalties {
+		k.setPenalty(ctx, penalty)
+	}
 }
 
 // ExportGenesis returns the reward module's genesis state.
@@ -20,5 +24,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		k.getPools(ctx),
+		k.getPenalties(ctx),
 	)
 }
@@ -8,12 +8,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 )
 
 func TestExportGenesis(t *testing.T) {
 	ctx, keeper, _, _, _ := setup(t)
-	keeper.InitGenesis(ctx, types.NewGenesisState(types.DefaultParams(), []types.Pool{}))
+	keeper.InitGenesis(ctx, types.NewGenesisState(types.DefaultParams(), []types.Pool{}, []types.Penalty{}))
 
 	poolName1 := "aaaaa"
 	pool1 := keeper.GetPool(ctx, poolName1)
@@ -26,7 +27,7 @@ func TestExportGenesis(t *testing.T) {
 
 	validator3 := rand.ValAddr()
 	pool1.AddReward(validator3, sdk.NewCoin(denom, math.ZeroInt()))
-	pool1.ClearRewards(validator3)
+	pool1.ClearRewards(validator3, exported.MissedVote)
 
 	poolName2 := "bbbbb"
 	pool2 := keeper.GetPool(ctx, poolName2)
@@ -52,6 +53,7 @@ func TestExportGenesis(t *testing.T) {
 	expected := types.NewGenesisState(
 		types.DefaultParams(),
 		[]types.Pool{expectedPool1, expectedPool2},
+		nil,
 	)
 	actual := keeper.ExportGenesis(ctx)
 
@@ -86,9 +88,11 @@ func TestInitGenesis(t *testing.T) {
 	}
 	expectedPool3 := types.NewPool("ccccc")
 	expectedPool3.Rewards = nil
+	penalty := types.NewMaintainerPenalty(ctx.WithBlockHeight(rand.PosI64()), rand.ValAddr(), types.PenaltyActionJail, types.PenaltyReasonMissedVotes, "ethereum", 8, 10)
 	expected := types.NewGenesisState(
 		types.DefaultParams(),
 		[]types.Pool{expectedPool1, expectedPool2, expectedPool3},
+		[]types.Penalty{penalty},
 	)
 
 	keeper.InitGenesis(ctx, expected)
@@ -3,9 +3,13 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/axelarnetwork/axelar-core/utils"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 	"github.com/axelarnetwork/utils/slices"
@@ -84,3 +88,69 @@ func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.Par
 		Params: params,
 	}, nil
 }
+
+// PendingRewards returns the rewards the given validator has accumulated in each reward pool that have not been released yet
+func (q Querier) PendingRewards(c context.Context, req *types.PendingRewardsRequest) (*types.PendingRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid validator address").Error())
+	}
+
+	pools := q.keeper.getPools(ctx)
+	if req.Pool != "" {
+		pools = slices.Filter(pools, func(pool types.Pool) bool { return pool.Name == utils.NormalizeString(req.Pool) })
+	}
+
+	res := types.PendingRewardsResponse{Rewards: []types.PendingRewardsResponse_PoolRewards{}, Total: sdk.NewCoins()}
+	for _, pool := range pools {
+		coins, ok := pool.GetRewards(validator)
+		if !ok {
+			continue
+		}
+
+		res.Rewards = append(res.Rewards, types.PendingRewardsResponse_PoolRewards{Pool: pool.Name, Coins: coins})
+		res.Total = res.Total.Add(coins...)
+	}
+
+	return &res, nil
+}
+
+// Penalties returns the penalties imposed on the given validator, or on all validators if none is given
+func (q Querier) Penalties(c context.Context, req *types.PenaltiesRequest) (*types.PenaltiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var validator sdk.ValAddress
+	if req.Validator != "" {
+		var err error
+		if validator, err = sdk.ValAddressFromBech32(req.Validator); err != nil {
+			return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid validator address").Error())
+		}
+	}
+
+	penalties, pageResp, err := q.keeper.GetPenaltiesPaginated(ctx, validator, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.PenaltiesResponse{Penalties: penalties, Pagination: pageResp}, nil
+}
+
+// RefundBudget returns the number of refunds the given validator has claimed in the current refund epoch
+func (q Querier) RefundBudget(c context.Context, req *types.RefundBudgetRequest) (*types.RefundBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid validator address").Error())
+	}
+
+	budget := q.keeper.GetRefundBudget(ctx, validator)
+
+	return &types.RefundBudgetResponse{
+		Epoch: budget.Epoch,
+		Used:  budget.Used,
+		Max:   q.keeper.GetRefundPolicy(ctx).MaxRefundsPerEpoch,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
@@ -69,6 +70,8 @@ func TestKeeper_Inflation(t *testing.T) {
 		paramsSubspace.SetParamSet(ctx, &types.Params{
 			KeyMgmtRelativeInflationRate:     keyRelativeInflation,
 			ExternalChainVotingInflationRate: externalChainInflation,
+			Penalty:                          types.DefaultPenaltyParams(),
+			RefundPolicy:                     types.DefaultRefundPolicy(),
 		})
 
 		tmInflation = rand.ThresholdDec()
@@ -155,3 +158,126 @@ func TestKeeper_Inflation(t *testing.T) {
 		}).
 		Run(t, 10)
 }
+
+func TestKeeper_PendingRewards(t *testing.T) {
+	var (
+		k         rewardKeeper.Keeper
+		q         rewardKeeper.Querier
+		ctx       sdk.Context
+		validator sdk.ValAddress
+		coin      sdk.Coin
+	)
+
+	Given("a reward keeper", func() {
+		encCfg := app.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{}, false, log.NewTestLogger(t))
+		paramsSubspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, storetypes.NewKVStoreKey("rewardKey"), storetypes.NewKVStoreKey("trewardKey"), "reward")
+
+		k = rewardKeeper.NewKeeper(encCfg.Codec, storetypes.NewKVStoreKey("reward"), paramsSubspace, nil, nil, nil)
+		q = rewardKeeper.NewGRPCQuerier(k, mintkeeper.Keeper{}, &mock.NexusMock{})
+	}).
+		When("rewards are added to multiple pools", func() {
+			validator = rand.ValAddr()
+			coin = sdk.NewCoin("uaxl", math.NewInt(rand.I64Between(1, 1000)))
+
+			k.GetPool(ctx, "multisig").AddReward(validator, coin)
+			k.GetPool(ctx, "ethereum").AddReward(validator, coin)
+			k.GetPool(ctx, "avalanche").AddReward(rand.ValAddr(), coin)
+		}).
+		Branch(
+			Then("should return the pending rewards of all pools", func(t *testing.T) {
+				res, err := q.PendingRewards(sdk.WrapSDKContext(ctx), &types.PendingRewardsRequest{Validator: validator.String()})
+				assert.NoError(t, err)
+
+				assert.ElementsMatch(t, []types.PendingRewardsResponse_PoolRewards{
+					{Pool: "multisig", Coins: sdk.NewCoins(coin)},
+					{Pool: "ethereum", Coins: sdk.NewCoins(coin)},
+				}, res.Rewards)
+				assert.Equal(t, sdk.NewCoins(coin.Add(coin)), res.Total)
+			}),
+
+			Then("should return the pending rewards of the given pool", func(t *testing.T) {
+				res, err := q.PendingRewards(sdk.WrapSDKContext(ctx), &types.PendingRewardsRequest{Validator: validator.String(), Pool: "Ethereum"})
+				assert.NoError(t, err)
+
+				assert.Equal(t, []types.PendingRewardsResponse_PoolRewards{{Pool: "ethereum", Coins: sdk.NewCoins(coin)}}, res.Rewards)
+				assert.Equal(t, sdk.NewCoins(coin), res.Total)
+			}),
+
+			Then("should return no rewards for validators without pending rewards", func(t *testing.T) {
+				res, err := q.PendingRewards(sdk.WrapSDKContext(ctx), &types.PendingRewardsRequest{Validator: rand.ValAddr().String()})
+				assert.NoError(t, err)
+
+				assert.Empty(t, res.Rewards)
+				assert.True(t, res.Total.IsZero())
+			}),
+
+			Then("should fail for invalid validator addresses", func(t *testing.T) {
+				_, err := q.PendingRewards(sdk.WrapSDKContext(ctx), &types.PendingRewardsRequest{Validator: rand.Str(10)})
+				assert.Error(t, err)
+			}),
+		).
+		Run(t)
+}
+
+func TestKeeper_Penalties(t *testing.T) {
+	var (
+		k         rewardKeeper.Keeper
+		q         rewardKeeper.Querier
+		ctx       sdk.Context
+		validator sdk.ValAddress
+		penalties []types.Penalty
+	)
+
+	Given("a reward keeper", func() {
+		encCfg := app.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: rand.PosI64()}, false, log.NewTestLogger(t))
+		paramsSubspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, storetypes.NewKVStoreKey("rewardKey"), storetypes.NewKVStoreKey("trewardKey"), "reward")
+
+		k = rewardKeeper.NewKeeper(encCfg.Codec, storetypes.NewKVStoreKey("reward"), paramsSubspace, nil, nil, nil)
+		q = rewardKeeper.NewGRPCQuerier(k, mintkeeper.Keeper{}, &mock.NexusMock{})
+	}).
+		When("penalties are recorded", func() {
+			validator = rand.ValAddr()
+			penalties = []types.Penalty{
+				types.NewMaintainerPenalty(ctx, validator, types.PenaltyActionDeregister, types.PenaltyReasonMissedVotes, "ethereum", 60, 100),
+				types.NewMaintainerPenalty(ctx, validator, types.PenaltyActionJail, types.PenaltyReasonIncorrectVotes, "avalanche", 40, 100),
+				types.NewSignerPenalty(ctx, validator, 70, 100),
+			}
+
+			for _, penalty := range penalties {
+				k.RecordPenalty(ctx, penalty)
+			}
+			k.RecordPenalty(ctx, types.NewSignerPenalty(ctx, rand.ValAddr(), 70, 100))
+		}).
+		Branch(
+			Then("should emit an event for each penalty", func(t *testing.T) {
+				assert.Len(t, ctx.EventManager().Events().ToABCIEvents(), len(penalties)+1)
+			}),
+
+			Then("should return the penalties of the given validator", func(t *testing.T) {
+				res, err := q.Penalties(sdk.WrapSDKContext(ctx), &types.PenaltiesRequest{Validator: validator.String()})
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, penalties, res.Penalties)
+			}),
+
+			Then("should return the penalties of all validators", func(t *testing.T) {
+				res, err := q.Penalties(sdk.WrapSDKContext(ctx), &types.PenaltiesRequest{})
+				assert.NoError(t, err)
+				assert.Len(t, res.Penalties, len(penalties)+1)
+			}),
+
+			Then("should paginate penalties", func(t *testing.T) {
+				res, err := q.Penalties(sdk.WrapSDKContext(ctx), &types.PenaltiesRequest{Validator: validator.String(), Pagination: &query.PageRequest{Limit: 2}})
+				assert.NoError(t, err)
+				assert.Len(t, res.Penalties, 2)
+				assert.NotEmpty(t, res.Pagination.NextKey)
+			}),
+
+			Then("should fail for an invalid validator address", func(t *testing.T) {
+				_, err := q.Penalties(sdk.WrapSDKContext(ctx), &types.PenaltiesRequest{Validator: rand.StrBetween(5, 10)})
+				assert.Error(t, err)
+			}),
+		).
+		Run(t)
+}
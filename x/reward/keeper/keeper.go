@@ -20,6 +20,8 @@ import (
 var (
 	poolNamePrefix      = "pool"
 	pendingRefundPrefix = "refund"
+	penaltyPrefix       = "penalty"
+	refundBudgetPrefix  = "refund_budget"
 )
 
 var _ types.Rewarder = Keeper{}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+)
+
+// Migrate2to3 returns the handler that performs in-place store migrations
+func Migrate2to3(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addPenaltyParams(ctx, k)
+		return nil
+	}
+}
+
+func addPenaltyParams(ctx sdk.Context, k Keeper) {
+	k.paramSpace.Set(ctx, types.KeyPenalty, types.DefaultPenaltyParams())
+}
+
+// Migrate3to4 returns the handler that performs in-place store migrations
+func Migrate3to4(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addRefundPolicyParams(ctx, k)
+		return nil
+	}
+}
+
+func addRefundPolicyParams(ctx sdk.Context, k Keeper) {
+	k.paramSpace.Set(ctx, types.KeyRefundPolicy, types.DefaultRefundPolicy())
+}
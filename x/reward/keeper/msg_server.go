@@ -63,13 +63,11 @@ func (s msgServer) RefundMsg(c context.Context, req *types.RefundMsgRequest) (*t
 
 	refund, found := s.Refunder.GetPendingRefund(ctx, *req)
 	if found {
-		// refund tx fee to the given account.
-		err = s.bank.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refund.Payer, refund.Fees)
-		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed to refund tx fee")
-		}
-
 		s.Refunder.DeletePendingRefund(ctx, *req)
+
+		if err := s.payRefund(ctx, msg, refund); err != nil {
+			return nil, err
+		}
 	}
 
 	ctx.EventManager().EmitEvents(result.GetEvents())
@@ -77,6 +75,27 @@ func (s msgServer) RefundMsg(c context.Context, req *types.RefundMsgRequest) (*t
 	return &types.RefundMsgResponse{Data: result.Data, Log: result.Log}, nil
 }
 
+// payRefund refunds the tx fee to the payer, unless the validator's refund budget is exhausted,
+// in which case the message has been executed at the sender's cost
+func (s msgServer) payRefund(ctx sdk.Context, msg sdk.Msg, refund types.Refund) error {
+	if err := s.Refunder.ConsumeRefundBudget(ctx, refund.Validator, 1); err != nil {
+		s.Logger(ctx).Info("refund budget exhausted, tx fee is not refunded",
+			"msg_type", sdk.MsgTypeURL(msg),
+			"validator", refund.Validator.String(),
+			"error", err.Error(),
+		)
+
+		return nil
+	}
+
+	// refund tx fee to the given account.
+	if err := s.bank.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refund.Payer, refund.Fees); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund tx fee")
+	}
+
+	return nil
+}
+
 func (s msgServer) routeInnerMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 	var msgResult *sdk.Result
 	var err error
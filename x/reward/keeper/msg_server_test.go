@@ -48,6 +48,7 @@ func TestHandleMsgRefundRequest(t *testing.T) {
 		refundKeeper = &mock.RefunderMock{
 			LoggerFunc:              func(ctx sdk.Context) log.Logger { return log.NewTestLogger(t) },
 			DeletePendingRefundFunc: func(sdk.Context, types.RefundMsgRequest) {},
+			ConsumeRefundBudgetFunc: func(sdk.Context, sdk.ValAddress, uint64) error { return nil },
 		}
 		bankKeeper = &mock.BankerMock{
 			SendCoinsFromModuleToAccountFunc: func(context.Context, string, sdk.AccAddress, sdk.Coins) error { return nil },
@@ -132,7 +133,7 @@ func TestHandleMsgRefundRequest(t *testing.T) {
 				msg = types.NewRefundMsgRequest(sender, votetypes.NewVoteRequest(sender, vote.PollID(rand.PosI64()), evmTypes.NewVoteEvents(nexus.ChainName(rand2.NormalizedStr(3)))))
 
 				refundKeeper.GetPendingRefundFunc = func(sdk.Context, types.RefundMsgRequest) (types.Refund, bool) {
-					return types.Refund{Payer: rand2.AccAddr(), Fees: sdk.NewCoins(sdk.Coin{Denom: "uaxl", Amount: math.NewInt(1000)})}, true
+					return types.Refund{Payer: rand2.AccAddr(), Fees: sdk.NewCoins(sdk.Coin{Denom: "uaxl", Amount: math.NewInt(1000)}), Validator: rand2.ValAddr()}, true
 				}
 				registerTestService(msgServiceRouter, succeededHandler)
 
@@ -140,8 +141,27 @@ func TestHandleMsgRefundRequest(t *testing.T) {
 				_, err := server.RefundMsg(sdk.WrapSDKContext(ctx), msg)
 				assert.NoError(t, err)
 				assert.Len(t, refundKeeper.GetPendingRefundCalls(), 1)
+				assert.Len(t, refundKeeper.ConsumeRefundBudgetCalls(), 1)
+				assert.EqualValues(t, 1, refundKeeper.ConsumeRefundBudgetCalls()[0].Count)
+				assert.Len(t, refundKeeper.DeletePendingRefundCalls(), 1)
 				assert.Len(t, bankKeeper.SendCoinsFromModuleToAccountCalls(), 1)
 			}),
+			When("executed inner message successfully but the validator's refund budget is exhausted", func() {
+				sender := rand2.AccAddr()
+				msg = types.NewRefundMsgRequest(sender, votetypes.NewVoteRequest(sender, vote.PollID(rand.PosI64()), evmTypes.NewVoteEvents(nexus.ChainName(rand2.NormalizedStr(3)))))
+
+				refundKeeper.GetPendingRefundFunc = func(sdk.Context, types.RefundMsgRequest) (types.Refund, bool) {
+					return types.Refund{Payer: rand2.AccAddr(), Fees: sdk.NewCoins(sdk.Coin{Denom: "uaxl", Amount: math.NewInt(1000)}), Validator: rand2.ValAddr()}, true
+				}
+				refundKeeper.ConsumeRefundBudgetFunc = func(sdk.Context, sdk.ValAddress, uint64) error { return fmt.Errorf("budget exhausted") }
+				registerTestService(msgServiceRouter, succeededHandler)
+
+			}).Then("should execute the message without refunding the transaction fee", func(t *testing.T) {
+				_, err := server.RefundMsg(sdk.WrapSDKContext(ctx), msg)
+				assert.NoError(t, err)
+				assert.Len(t, refundKeeper.DeletePendingRefundCalls(), 1)
+				assert.Len(t, bankKeeper.SendCoinsFromModuleToAccountCalls(), 0)
+			}),
 		).
 		Run(t)
 
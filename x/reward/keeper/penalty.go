@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/utils/events"
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+// RecordPenalty stores the given penalty as evidence and emits an event for it
+func (k Keeper) RecordPenalty(ctx sdk.Context, penalty types.Penalty) {
+	k.setPenalty(ctx, penalty)
+	events.Emit(ctx, &types.ValidatorPenalized{Penalty: penalty})
+
+	k.Logger(ctx).Info("penalized validator",
+		"validator", penalty.Validator.String(),
+		"action", penalty.Action.String(),
+		"reason", penalty.Reason.String(),
+		"chain", penalty.Chain.String(),
+		"count", penalty.Count,
+		"window", penalty.Window,
+	)
+}
+
+// GetPenaltiesPaginated returns the penalties imposed on the given validator with the given pagination properties.
+// If validator is empty, the penalties of all validators are returned
+func (k Keeper) GetPenaltiesPaginated(ctx sdk.Context, validator sdk.ValAddress, pageRequest *query.PageRequest) ([]types.Penalty, *query.PageResponse, error) {
+	var penalties []types.Penalty
+
+	prefixKey := key.FromStr(penaltyPrefix)
+	if !validator.Empty() {
+		prefixKey = prefixKey.Append(key.FromBz(validator))
+	}
+
+	store := prefix.NewStore(k.getStore(ctx).KVStore, append(prefixKey.Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.Paginate(store, pageRequest, func(_ []byte, value []byte) error {
+		var penalty types.Penalty
+		k.cdc.MustUnmarshalLengthPrefixed(value, &penalty)
+
+		penalties = append(penalties, penalty)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return penalties, resp, nil
+}
+
+func (k Keeper) setPenalty(ctx sdk.Context, penalty types.Penalty) {
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getPenaltyKey(penalty), &penalty))
+}
+
+func (k Keeper) getPenalties(ctx sdk.Context) []types.Penalty {
+	var penalties []types.Penalty
+
+	iter := k.getStore(ctx).IteratorNew(key.FromStr(penaltyPrefix))
+	defer utils.CloseLogError(iter, k.Logger(ctx))
+
+	for ; iter.Valid(); iter.Next() {
+		var penalty types.Penalty
+		iter.UnmarshalValue(&penalty)
+
+		penalties = append(penalties, penalty)
+	}
+
+	return penalties
+}
+
+func getPenaltyKey(penalty types.Penalty) key.Key {
+	return key.FromStr(penaltyPrefix).
+		Append(key.FromBz(penalty.Validator)).
+		Append(key.FromUInt(uint64(penalty.Height))).
+		Append(key.From(penalty.Reason)).
+		Append(key.FromStr(penalty.Chain.String()))
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+	"github.com/axelarnetwork/utils/funcs"
+)
+
+// GetRefundPolicy returns the policy that determines which messages get their fees refunded
+func (k Keeper) GetRefundPolicy(ctx sdk.Context) types.RefundPolicy {
+	return k.GetParams(ctx).RefundPolicy
+}
+
+// GetRefundBudget returns the refunds the given validator has claimed in the current refund epoch
+func (k Keeper) GetRefundBudget(ctx sdk.Context, validator sdk.ValAddress) types.RefundBudget {
+	epoch := k.GetRefundPolicy(ctx).Epoch(ctx.BlockHeight())
+
+	var budget types.RefundBudget
+	if ok := k.getStore(ctx).GetNew(getRefundBudgetKey(validator), &budget); !ok || budget.Epoch != epoch {
+		return types.NewRefundBudget(validator, epoch)
+	}
+
+	return budget
+}
+
+// ConsumeRefundBudget claims the given number of refunds for the given validator in the current refund epoch,
+// and returns an error if the validator's budget does not allow for it
+func (k Keeper) ConsumeRefundBudget(ctx sdk.Context, validator sdk.ValAddress, count uint64) error {
+	policy := k.GetRefundPolicy(ctx)
+	budget := k.GetRefundBudget(ctx, validator)
+
+	if policy.MaxRefundsPerEpoch > 0 && budget.Used+count > policy.MaxRefundsPerEpoch {
+		return fmt.Errorf("validator %s has claimed %d of %d refunds in epoch %d, cannot claim %d more",
+			validator.String(), budget.Used, policy.MaxRefundsPerEpoch, budget.Epoch, count)
+	}
+
+	budget.Used += count
+	funcs.MustNoErr(k.getStore(ctx).SetNewValidated(getRefundBudgetKey(validator), &budget))
+
+	return nil
+}
+
+func getRefundBudgetKey(validator sdk.ValAddress) key.Key {
+	return key.FromStr(refundBudgetPrefix).Append(key.FromBz(validator))
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/app"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	rewardKeeper "github.com/axelarnetwork/axelar-core/x/reward/keeper"
+	"github.com/axelarnetwork/axelar-core/x/reward/types"
+	"github.com/axelarnetwork/axelar-core/x/reward/types/mock"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestKeeper_RefundBudget(t *testing.T) {
+	var (
+		k         rewardKeeper.Keeper
+		q         rewardKeeper.Querier
+		ctx       sdk.Context
+		validator sdk.ValAddress
+	)
+
+	Given("a reward keeper with a refund budget", func() {
+		encCfg := app.MakeEncodingConfig()
+		ctx = sdk.NewContext(fake.NewMultiStore(), tmproto.Header{Height: 100}, false, log.NewTestLogger(t))
+		paramsSubspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, storetypes.NewKVStoreKey("rewardKey"), storetypes.NewKVStoreKey("trewardKey"), "reward")
+
+		k = rewardKeeper.NewKeeper(encCfg.Codec, storetypes.NewKVStoreKey("reward"), paramsSubspace, nil, nil, nil)
+		q = rewardKeeper.NewGRPCQuerier(k, mintkeeper.Keeper{}, &mock.NexusMock{})
+
+		params := types.DefaultParams()
+		params.RefundPolicy.EpochLength = 10
+		params.RefundPolicy.MaxRefundsPerEpoch = 3
+		k.SetParams(ctx, params)
+
+		validator = rand.ValAddr()
+	}).
+		When("a validator claims refunds", func() {
+			assert.NoError(t, k.ConsumeRefundBudget(ctx, validator, 2))
+		}).
+		Branch(
+			Then("should track the claimed refunds", func(t *testing.T) {
+				res, err := q.RefundBudget(sdk.WrapSDKContext(ctx), &types.RefundBudgetRequest{Validator: validator.String()})
+				assert.NoError(t, err)
+				assert.EqualValues(t, 10, res.Epoch)
+				assert.EqualValues(t, 2, res.Used)
+				assert.EqualValues(t, 3, res.Max)
+
+				assert.Zero(t, k.GetRefundBudget(ctx, rand.ValAddr()).Used)
+			}),
+
+			Then("should reject refunds that exceed the budget", func(t *testing.T) {
+				assert.ErrorContains(t, k.ConsumeRefundBudget(ctx, validator, 2), "cannot claim")
+				assert.NoError(t, k.ConsumeRefundBudget(ctx, validator, 1))
+				assert.Error(t, k.ConsumeRefundBudget(ctx, validator, 1))
+			}),
+
+			Then("should reset the budget in the next epoch", func(t *testing.T) {
+				ctx := ctx.WithBlockHeight(110)
+				assert.Zero(t, k.GetRefundBudget(ctx, validator).Used)
+				assert.NoError(t, k.ConsumeRefundBudget(ctx, validator, 3))
+			}),
+
+			Then("should not limit refunds without a max", func(t *testing.T) {
+				params := k.GetParams(ctx)
+				params.RefundPolicy.MaxRefundsPerEpoch = 0
+				k.SetParams(ctx, params)
+
+				assert.NoError(t, k.ConsumeRefundBudget(ctx, validator, 100))
+			}),
+
+			Then("should fail for an invalid validator address", func(t *testing.T) {
+				_, err := q.RefundBudget(sdk.WrapSDKContext(ctx), &types.RefundBudgetRequest{Validator: rand.StrBetween(5, 10)})
+				assert.Error(t, err)
+			}),
+		).
+		Run(t)
+}
@@ -7,6 +7,7 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/axelarnetwork/axelar-core/utils/events"
 	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 )
@@ -33,16 +34,6 @@ func newPool(ctx sdk.Context, k Keeper, banker types.Banker, distributor types.D
 	}
 }
 
-func (p rewardPool) getRewards(validator sdk.ValAddress) (sdk.Coins, bool) {
-	for _, reward := range p.Rewards {
-		if reward.Validator.Equals(validator) {
-			return reward.Coins, true
-		}
-	}
-
-	return sdk.Coins{}, false
-}
-
 func (p *rewardPool) AddReward(validator sdk.ValAddress, coin sdk.Coin) {
 	p.AddRewards([]exported.Reward{{Validator: validator, Coin: coin}})
 }
@@ -66,6 +57,12 @@ func (p *rewardPool) addReward(validator sdk.ValAddress, coin sdk.Coin) {
 		return
 	}
 
+	events.Emit(p.ctx, &types.RewardAdded{
+		Pool:      p.Name,
+		Validator: validator,
+		Coin:      coin,
+	})
+
 	for i, reward := range p.Rewards {
 		if reward.Validator.Equals(validator) {
 			p.Rewards[i].Coins = reward.Coins.Add(coin)
@@ -81,16 +78,17 @@ func (p *rewardPool) addReward(validator sdk.ValAddress, coin sdk.Coin) {
 }
 
 func (p *rewardPool) ReleaseRewards(validator sdk.ValAddress) error {
-	rewards, ok := p.getRewards(validator)
+	rewards, ok := p.GetRewards(validator)
 	if !ok {
 		return nil
 	}
 
-	defer p.ClearRewards(validator)
+	defer p.removeRewards(validator)
 
 	v, err := p.staker.Validator(p.ctx, validator)
 	if err != nil {
 		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			p.ClearRewards(validator, exported.ValidatorNotFound)
 			return nil
 		}
 		return err
@@ -106,18 +104,43 @@ func (p *rewardPool) ReleaseRewards(validator sdk.ValAddress) error {
 
 	p.k.Logger(p.ctx).Info("releasing rewards in pool", "pool", p.Name, "validator", validator.String())
 
-	return p.distributor.AllocateTokensToValidator(
+	if err := p.distributor.AllocateTokensToValidator(
 		p.ctx,
 		v,
 		sdk.NewDecCoinsFromCoins(rewards...),
-	)
+	); err != nil {
+		return err
+	}
+
+	events.Emit(p.ctx, &types.RewardsReleased{
+		Pool:      p.Name,
+		Validator: validator,
+		Coins:     rewards,
+	})
+
+	return nil
+}
+
+func (p *rewardPool) ClearRewards(validator sdk.ValAddress, reason exported.ClearReason) {
+	rewards, ok := p.GetRewards(validator)
+	if !ok {
+		return
+	}
+
+	p.k.Logger(p.ctx).Info("clearing rewards in pool", "pool", p.Name, "validator", validator.String(), "reason", reason.String())
+
+	p.removeRewards(validator)
+	events.Emit(p.ctx, &types.RewardsCleared{
+		Pool:      p.Name,
+		Validator: validator,
+		Coins:     rewards,
+		Reason:    reason,
+	})
 }
 
-func (p *rewardPool) ClearRewards(validator sdk.ValAddress) {
+func (p *rewardPool) removeRewards(validator sdk.ValAddress) {
 	for i, reward := range p.Rewards {
 		if reward.Validator.Equals(validator) {
-			p.k.Logger(p.ctx).Info("clearing rewards in pool", "pool", p.Name, "validator", validator.String())
-
 			p.Rewards = append(p.Rewards[:i], p.Rewards[i+1:]...)
 			p.k.setPool(p.ctx, p.Pool)
 
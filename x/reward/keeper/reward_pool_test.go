@@ -7,17 +7,22 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	store "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/axelarnetwork/axelar-core/app/params"
 	"github.com/axelarnetwork/axelar-core/testutils/fake"
 	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	"github.com/axelarnetwork/axelar-core/x/reward/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/types/mock"
+	"github.com/axelarnetwork/utils/funcs"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 const denom = "test"
@@ -59,6 +64,12 @@ func TestAddReward(t *testing.T) {
 	assert.Equal(t, validator, p.Rewards[0].Validator)
 	assert.Equal(t, coin1.Add(coin2), p.Rewards[0].Coins[0])
 	assert.Equal(t, p, keeper.GetPool(ctx, p.Name).(*rewardPool))
+
+	pool.AddReward(validator, sdk.NewCoin(denom, math.ZeroInt()))
+	assert.Equal(t, []proto.Message{
+		&types.RewardAdded{Pool: p.Name, Validator: validator, Coin: coin1},
+		&types.RewardAdded{Pool: p.Name, Validator: validator, Coin: coin2},
+	}, typedEvents(ctx))
 }
 
 func TestReleaseRewards(t *testing.T) {
@@ -79,6 +90,7 @@ func TestReleaseRewards(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, p.Rewards, 0)
 		assert.Len(t, keeper.GetPool(ctx, p.Name).(*rewardPool).Rewards, 0)
+		assert.Contains(t, typedEvents(ctx), &types.RewardsCleared{Pool: p.Name, Validator: validator, Coins: sdk.NewCoins(coin), Reason: exported.ValidatorNotFound})
 	})
 
 	t.Run("when validator is found", func(t *testing.T) {
@@ -114,6 +126,8 @@ func TestReleaseRewards(t *testing.T) {
 		assert.Equal(t, sdk.NewDecCoinFromCoin(coin), distributor.AllocateTokensToValidatorCalls()[0].Tokens[0])
 		assert.Len(t, p.Rewards, 0)
 		assert.Len(t, keeper.GetPool(ctx, p.Name).(*rewardPool).Rewards, 0)
+		assert.Contains(t, typedEvents(ctx), &types.RewardsReleased{Pool: p.Name, Validator: validator, Coins: sdk.NewCoins(coin)})
+		assert.NotContains(t, slices.Map(typedEvents(ctx), proto.MessageName), proto.MessageName(&types.RewardsCleared{}))
 	})
 }
 
@@ -124,16 +138,27 @@ func TestClearRewards(t *testing.T) {
 	coin := sdk.NewCoin(denom, math.NewInt(rand.I64Between(10, 10000000)))
 
 	pool.AddReward(validator, coin)
-	pool.ClearRewards(validator)
+	pool.ClearRewards(validator, exported.MissedSignature)
 	p := pool.(*rewardPool)
 
 	assert.Len(t, p.Rewards, 0)
 	assert.Len(t, keeper.GetPool(ctx, p.Name).(*rewardPool).Rewards, 0)
+	assert.Contains(t, typedEvents(ctx), &types.RewardsCleared{Pool: p.Name, Validator: validator, Coins: sdk.NewCoins(coin), Reason: exported.MissedSignature})
 
 	pool.AddReward(validator, coin)
 	pool.AddReward(rand.ValAddr(), coin)
 
-	pool.ClearRewards(validator)
+	pool.ClearRewards(validator, exported.MissedVote)
 	assert.Len(t, p.Rewards, 1)
 	assert.Len(t, keeper.GetPool(ctx, p.Name).(*rewardPool).Rewards, 1)
+
+	eventCount := len(ctx.EventManager().Events())
+	pool.ClearRewards(rand.ValAddr(), exported.MissedVote)
+	assert.Len(t, ctx.EventManager().Events(), eventCount)
+}
+
+func typedEvents(ctx sdk.Context) []proto.Message {
+	return slices.Map(ctx.EventManager().ABCIEvents(), func(event abci.Event) proto.Message {
+		return funcs.Must(sdk.ParseTypedEvent(event))
+	})
 }
@@ -2,13 +2,16 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	multisig "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 )
 
@@ -20,6 +23,7 @@ type Rewarder interface {
 
 	GetParams(ctx sdk.Context) (params Params)
 	GetPool(ctx sdk.Context, name string) exported.RewardPool
+	RecordPenalty(ctx sdk.Context, penalty Penalty)
 }
 
 // Refunder provides refunding functionality
@@ -27,6 +31,7 @@ type Refunder interface {
 	Logger(ctx sdk.Context) log.Logger
 	GetPendingRefund(ctx sdk.Context, req RefundMsgRequest) (Refund, bool)
 	DeletePendingRefund(ctx sdk.Context, req RefundMsgRequest)
+	ConsumeRefundBudget(ctx sdk.Context, validator sdk.ValAddress, count uint64) error
 	SetParams(ctx sdk.Context, params Params)
 }
 
@@ -35,6 +40,8 @@ type Nexus interface {
 	GetChains(ctx sdk.Context) []nexus.Chain
 	GetChainMaintainers(ctx sdk.Context, chain nexus.Chain) []sdk.ValAddress
 	IsChainActivated(ctx sdk.Context, chain nexus.Chain) bool
+	GetChainMaintainerStates(ctx sdk.Context, chain nexus.Chain) []nexus.MaintainerState
+	GetParams(ctx sdk.Context) nexustypes.Params
 }
 
 // Distributor provides distribution functionality
@@ -54,6 +61,8 @@ type Staker interface {
 // Slasher provides necessary functions to the validator information
 type Slasher interface {
 	IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool // whether a validator is tombstoned
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
 }
 
 // Banker provides bank functionality
@@ -66,6 +75,8 @@ type Banker interface {
 // MultiSig provides mutlisig functionality
 type MultiSig interface {
 	HasOptedOut(ctx sdk.Context, participant sdk.AccAddress) bool
+	GetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress) multisig.SigningParticipation
+	ResetSigningParticipation(ctx sdk.Context, validator sdk.ValAddress)
 }
 
 // Snapshotter provides snapshot functionality
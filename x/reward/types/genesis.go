@@ -8,16 +8,17 @@ import (
 )
 
 // NewGenesisState is the constructor for GenesisState
-func NewGenesisState(params Params, pools []Pool) *GenesisState {
+func NewGenesisState(params Params, pools []Pool, penalties []Penalty) *GenesisState {
 	return &GenesisState{
-		Params: params,
-		Pools:  pools,
+		Params:    params,
+		Pools:     pools,
+		Penalties: penalties,
 	}
 }
 
 // DefaultGenesisState returns a genesis state with default parameters
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Pool{})
+	return NewGenesisState(DefaultParams(), []Pool{}, []Penalty{})
 }
 
 // Validate performs a validation check on the genesis parameters
@@ -32,6 +33,12 @@ func (m GenesisState) Validate() error {
 		}
 	}
 
+	for _, penalty := range m.Penalties {
+		if err := penalty.ValidateBasic(); err != nil {
+			return getValidateError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -25,8 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState represents the genesis state
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Pools  []Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	Params    Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Pools     []Pool    `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	Penalties []Penalty `protobuf:"bytes,3,rep,name=penalties,proto3" json:"penalties"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,23 +72,24 @@ func init() {
 }
 
 var fileDescriptor_4b6b279c16313544 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xac, 0x48, 0xcd,
-	0x49, 0x2c, 0xd2, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x94, 0xb0, 0x9b, 0x58, 0x90, 0x58, 0x94, 0x98, 0x0b,
-	0x35, 0x50, 0x4a, 0x11, 0xbb, 0x9a, 0x92, 0xca, 0x82, 0x54, 0xa8, 0x12, 0xa5, 0x16, 0x46, 0x2e,
-	0x1e, 0x77, 0x88, 0x2b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xac, 0xb9, 0xd8, 0x20, 0x66, 0x48,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xea, 0x61, 0x75, 0x95, 0x5e, 0x00, 0x58, 0x91, 0x13,
-	0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x2d, 0x42, 0xe6, 0x5c, 0xac, 0x05, 0xf9, 0xf9, 0x39,
-	0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xd2, 0xb8, 0xf4, 0xe6, 0xe7, 0xe7, 0x40, 0x75,
-	0x42, 0xd4, 0x3b, 0x05, 0x9e, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xc6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10,
-	0x13, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xa1, 0x3c, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0xfd,
-	0x0a, 0x98, 0x3f, 0xc1, 0xfe, 0x4b, 0x62, 0x03, 0x7b, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x83, 0x0e, 0x58, 0xb7, 0x7b, 0x01, 0x00, 0x00,
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x46, 0x63, 0x0a, 0x95, 0x70, 0x99, 0x22, 0x90, 0xa2, 0x20, 0x4c, 0x29, 0x4b, 0x17, 0x6c,
+	0xb5, 0x1d, 0x18, 0xd8, 0xb2, 0xb0, 0xf2, 0xb3, 0xb1, 0x39, 0xe5, 0x2a, 0x44, 0xa4, 0xb9, 0x96,
+	0x63, 0x68, 0xfb, 0x16, 0x3c, 0x56, 0xc6, 0x8e, 0x4c, 0x08, 0x92, 0x17, 0x41, 0xd8, 0x46, 0x5d,
+	0xd2, 0xcd, 0x96, 0xce, 0x39, 0xba, 0xfa, 0xe8, 0xa5, 0x5c, 0x41, 0x21, 0xb5, 0xd0, 0xb0, 0x94,
+	0xfa, 0x59, 0xbc, 0x4f, 0x52, 0x30, 0x72, 0x22, 0x32, 0x28, 0xa1, 0xca, 0x2b, 0xae, 0x34, 0x1a,
+	0x0c, 0x4f, 0x1c, 0xc4, 0x1d, 0xc4, 0x3d, 0x14, 0x1f, 0x67, 0x98, 0xa1, 0x25, 0xc4, 0xdf, 0xcb,
+	0xc1, 0xf1, 0xa8, 0xbb, 0xa8, 0xa4, 0x96, 0x0b, 0x1f, 0x8c, 0x2f, 0xba, 0x19, 0xb3, 0x56, 0xe0,
+	0x91, 0x51, 0x4d, 0xe8, 0xd1, 0xad, 0xbb, 0xe2, 0xd1, 0x48, 0x03, 0xe1, 0x0d, 0xed, 0xbb, 0x46,
+	0x44, 0x86, 0x64, 0x3c, 0x98, 0x9e, 0xf1, 0xce, 0xab, 0xf8, 0x9d, 0x85, 0x92, 0xfd, 0xfa, 0xeb,
+	0x3c, 0x78, 0xf0, 0x4a, 0x78, 0x4d, 0x0f, 0x14, 0x62, 0x51, 0x45, 0x7b, 0xc3, 0xde, 0x78, 0x30,
+	0x3d, 0xdd, 0xe5, 0x22, 0x16, 0xde, 0x74, 0x7c, 0x98, 0xd0, 0x43, 0x05, 0xa5, 0x2c, 0x4c, 0x0e,
+	0x55, 0xd4, 0xb3, 0x32, 0xdb, 0x25, 0x5b, 0x6e, 0xed, 0xfd, 0xad, 0x96, 0xdc, 0xd7, 0x3f, 0x2c,
+	0xa8, 0x1b, 0x46, 0x36, 0x0d, 0x23, 0xdf, 0x0d, 0x23, 0x1f, 0x2d, 0x0b, 0x36, 0x2d, 0x0b, 0x3e,
+	0x5b, 0x16, 0x3c, 0xcd, 0xb2, 0xdc, 0xbc, 0xbc, 0xa5, 0x7c, 0x8e, 0x0b, 0xe1, 0xc2, 0x25, 0x98,
+	0x25, 0xea, 0x57, 0xff, 0xbb, 0x9a, 0xa3, 0x06, 0xb1, 0xfa, 0xdf, 0xca, 0x6e, 0x94, 0xf6, 0xed,
+	0x48, 0xb3, 0xdf, 0x01, 0x00, 0x6b, 0xfa, 0x10, 0x5c, 0xbf, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +112,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Penalties) > 0 {
+		for iNdEx := len(m.Penalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Pools) > 0 {
 		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +178,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +289,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalties = append(m.Penalties, Penalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,12 +7,15 @@ import (
 	context "context"
 	"cosmossdk.io/log"
 	cosmossdk_io_math "cosmossdk.io/math"
+	multisigtypes "github.com/axelarnetwork/axelar-core/x/multisig/types"
 	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+	nexustypes "github.com/axelarnetwork/axelar-core/x/nexus/types"
 	"github.com/axelarnetwork/axelar-core/x/reward/exported"
 	rewardtypes "github.com/axelarnetwork/axelar-core/x/reward/types"
 	cosmossdktypes "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"sync"
+	"time"
 )
 
 // Ensure, that RewarderMock does implement rewardtypes.Rewarder.
@@ -34,6 +37,9 @@ var _ rewardtypes.Rewarder = &RewarderMock{}
 //			LoggerFunc: func(ctx cosmossdktypes.Context) log.Logger {
 //				panic("mock out the Logger method")
 //			},
+//			RecordPenaltyFunc: func(ctx cosmossdktypes.Context, penalty rewardtypes.Penalty)  {
+//				panic("mock out the RecordPenalty method")
+//			},
 //		}
 //
 //		// use mockedRewarder in code that requires rewardtypes.Rewarder
@@ -50,6 +56,9 @@ type RewarderMock struct {
 	// LoggerFunc mocks the Logger method.
 	LoggerFunc func(ctx cosmossdktypes.Context) log.Logger
 
+	// RecordPenaltyFunc mocks the RecordPenalty method.
+	RecordPenaltyFunc func(ctx cosmossdktypes.Context, penalty rewardtypes.Penalty)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetParams holds details about calls to the GetParams method.
@@ -69,10 +78,18 @@ type RewarderMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// RecordPenalty holds details about calls to the RecordPenalty method.
+		RecordPenalty []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Penalty is the penalty argument value.
+			Penalty rewardtypes.Penalty
+		}
 	}
-	lockGetParams sync.RWMutex
-	lockGetPool   sync.RWMutex
-	lockLogger    sync.RWMutex
+	lockGetParams     sync.RWMutex
+	lockGetPool       sync.RWMutex
+	lockLogger        sync.RWMutex
+	lockRecordPenalty sync.RWMutex
 }
 
 // GetParams calls GetParamsFunc.
@@ -175,6 +192,42 @@ func (mock *RewarderMock) LoggerCalls() []struct {
 	return calls
 }
 
+// RecordPenalty calls RecordPenaltyFunc.
+func (mock *RewarderMock) RecordPenalty(ctx cosmossdktypes.Context, penalty rewardtypes.Penalty) {
+	if mock.RecordPenaltyFunc == nil {
+		panic("RewarderMock.RecordPenaltyFunc: method is nil but Rewarder.RecordPenalty was just called")
+	}
+	callInfo := struct {
+		Ctx     cosmossdktypes.Context
+		Penalty rewardtypes.Penalty
+	}{
+		Ctx:     ctx,
+		Penalty: penalty,
+	}
+	mock.lockRecordPenalty.Lock()
+	mock.calls.RecordPenalty = append(mock.calls.RecordPenalty, callInfo)
+	mock.lockRecordPenalty.Unlock()
+	mock.RecordPenaltyFunc(ctx, penalty)
+}
+
+// RecordPenaltyCalls gets all the calls that were made to RecordPenalty.
+// Check the length with:
+//
+//	len(mockedRewarder.RecordPenaltyCalls())
+func (mock *RewarderMock) RecordPenaltyCalls() []struct {
+	Ctx     cosmossdktypes.Context
+	Penalty rewardtypes.Penalty
+} {
+	var calls []struct {
+		Ctx     cosmossdktypes.Context
+		Penalty rewardtypes.Penalty
+	}
+	mock.lockRecordPenalty.RLock()
+	calls = mock.calls.RecordPenalty
+	mock.lockRecordPenalty.RUnlock()
+	return calls
+}
+
 // Ensure, that RefunderMock does implement rewardtypes.Refunder.
 // If this is not the case, regenerate this file with moq.
 var _ rewardtypes.Refunder = &RefunderMock{}
@@ -185,6 +238,9 @@ var _ rewardtypes.Refunder = &RefunderMock{}
 //
 //		// make and configure a mocked rewardtypes.Refunder
 //		mockedRefunder := &RefunderMock{
+//			ConsumeRefundBudgetFunc: func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress, count uint64) error {
+//				panic("mock out the ConsumeRefundBudget method")
+//			},
 //			DeletePendingRefundFunc: func(ctx cosmossdktypes.Context, req rewardtypes.RefundMsgRequest)  {
 //				panic("mock out the DeletePendingRefund method")
 //			},
@@ -204,6 +260,9 @@ var _ rewardtypes.Refunder = &RefunderMock{}
 //
 //	}
 type RefunderMock struct {
+	// ConsumeRefundBudgetFunc mocks the ConsumeRefundBudget method.
+	ConsumeRefundBudgetFunc func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress, count uint64) error
+
 	// DeletePendingRefundFunc mocks the DeletePendingRefund method.
 	DeletePendingRefundFunc func(ctx cosmossdktypes.Context, req rewardtypes.RefundMsgRequest)
 
@@ -218,6 +277,15 @@ type RefunderMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// ConsumeRefundBudget holds details about calls to the ConsumeRefundBudget method.
+		ConsumeRefundBudget []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Validator is the validator argument value.
+			Validator cosmossdktypes.ValAddress
+			// Count is the count argument value.
+			Count uint64
+		}
 		// DeletePendingRefund holds details about calls to the DeletePendingRefund method.
 		DeletePendingRefund []struct {
 			// Ctx is the ctx argument value.
@@ -245,12 +313,53 @@ type RefunderMock struct {
 			Params rewardtypes.Params
 		}
 	}
+	lockConsumeRefundBudget sync.RWMutex
 	lockDeletePendingRefund sync.RWMutex
 	lockGetPendingRefund    sync.RWMutex
 	lockLogger              sync.RWMutex
 	lockSetParams           sync.RWMutex
 }
 
+// ConsumeRefundBudget calls ConsumeRefundBudgetFunc.
+func (mock *RefunderMock) ConsumeRefundBudget(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress, count uint64) error {
+	if mock.ConsumeRefundBudgetFunc == nil {
+		panic("RefunderMock.ConsumeRefundBudgetFunc: method is nil but Refunder.ConsumeRefundBudget was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+		Count     uint64
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+		Count:     count,
+	}
+	mock.lockConsumeRefundBudget.Lock()
+	mock.calls.ConsumeRefundBudget = append(mock.calls.ConsumeRefundBudget, callInfo)
+	mock.lockConsumeRefundBudget.Unlock()
+	return mock.ConsumeRefundBudgetFunc(ctx, validator, count)
+}
+
+// ConsumeRefundBudgetCalls gets all the calls that were made to ConsumeRefundBudget.
+// Check the length with:
+//
+//	len(mockedRefunder.ConsumeRefundBudgetCalls())
+func (mock *RefunderMock) ConsumeRefundBudgetCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	Validator cosmossdktypes.ValAddress
+	Count     uint64
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+		Count     uint64
+	}
+	mock.lockConsumeRefundBudget.RLock()
+	calls = mock.calls.ConsumeRefundBudget
+	mock.lockConsumeRefundBudget.RUnlock()
+	return calls
+}
+
 // DeletePendingRefund calls DeletePendingRefundFunc.
 func (mock *RefunderMock) DeletePendingRefund(ctx cosmossdktypes.Context, req rewardtypes.RefundMsgRequest) {
 	if mock.DeletePendingRefundFunc == nil {
@@ -401,12 +510,18 @@ var _ rewardtypes.Nexus = &NexusMock{}
 //
 //		// make and configure a mocked rewardtypes.Nexus
 //		mockedNexus := &NexusMock{
+//			GetChainMaintainerStatesFunc: func(ctx cosmossdktypes.Context, chain nexus.Chain) []nexus.MaintainerState {
+//				panic("mock out the GetChainMaintainerStates method")
+//			},
 //			GetChainMaintainersFunc: func(ctx cosmossdktypes.Context, chain nexus.Chain) []cosmossdktypes.ValAddress {
 //				panic("mock out the GetChainMaintainers method")
 //			},
 //			GetChainsFunc: func(ctx cosmossdktypes.Context) []nexus.Chain {
 //				panic("mock out the GetChains method")
 //			},
+//			GetParamsFunc: func(ctx cosmossdktypes.Context) nexustypes.Params {
+//				panic("mock out the GetParams method")
+//			},
 //			IsChainActivatedFunc: func(ctx cosmossdktypes.Context, chain nexus.Chain) bool {
 //				panic("mock out the IsChainActivated method")
 //			},
@@ -417,17 +532,30 @@ var _ rewardtypes.Nexus = &NexusMock{}
 //
 //	}
 type NexusMock struct {
+	// GetChainMaintainerStatesFunc mocks the GetChainMaintainerStates method.
+	GetChainMaintainerStatesFunc func(ctx cosmossdktypes.Context, chain nexus.Chain) []nexus.MaintainerState
+
 	// GetChainMaintainersFunc mocks the GetChainMaintainers method.
 	GetChainMaintainersFunc func(ctx cosmossdktypes.Context, chain nexus.Chain) []cosmossdktypes.ValAddress
 
 	// GetChainsFunc mocks the GetChains method.
 	GetChainsFunc func(ctx cosmossdktypes.Context) []nexus.Chain
 
+	// GetParamsFunc mocks the GetParams method.
+	GetParamsFunc func(ctx cosmossdktypes.Context) nexustypes.Params
+
 	// IsChainActivatedFunc mocks the IsChainActivated method.
 	IsChainActivatedFunc func(ctx cosmossdktypes.Context, chain nexus.Chain) bool
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetChainMaintainerStates holds details about calls to the GetChainMaintainerStates method.
+		GetChainMaintainerStates []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Chain is the chain argument value.
+			Chain nexus.Chain
+		}
 		// GetChainMaintainers holds details about calls to the GetChainMaintainers method.
 		GetChainMaintainers []struct {
 			// Ctx is the ctx argument value.
@@ -440,6 +568,11 @@ type NexusMock struct {
 			// Ctx is the ctx argument value.
 			Ctx cosmossdktypes.Context
 		}
+		// GetParams holds details about calls to the GetParams method.
+		GetParams []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+		}
 		// IsChainActivated holds details about calls to the IsChainActivated method.
 		IsChainActivated []struct {
 			// Ctx is the ctx argument value.
@@ -448,9 +581,47 @@ type NexusMock struct {
 			Chain nexus.Chain
 		}
 	}
-	lockGetChainMaintainers sync.RWMutex
-	lockGetChains           sync.RWMutex
-	lockIsChainActivated    sync.RWMutex
+	lockGetChainMaintainerStates sync.RWMutex
+	lockGetChainMaintainers      sync.RWMutex
+	lockGetChains                sync.RWMutex
+	lockGetParams                sync.RWMutex
+	lockIsChainActivated         sync.RWMutex
+}
+
+// GetChainMaintainerStates calls GetChainMaintainerStatesFunc.
+func (mock *NexusMock) GetChainMaintainerStates(ctx cosmossdktypes.Context, chain nexus.Chain) []nexus.MaintainerState {
+	if mock.GetChainMaintainerStatesFunc == nil {
+		panic("NexusMock.GetChainMaintainerStatesFunc: method is nil but Nexus.GetChainMaintainerStates was just called")
+	}
+	callInfo := struct {
+		Ctx   cosmossdktypes.Context
+		Chain nexus.Chain
+	}{
+		Ctx:   ctx,
+		Chain: chain,
+	}
+	mock.lockGetChainMaintainerStates.Lock()
+	mock.calls.GetChainMaintainerStates = append(mock.calls.GetChainMaintainerStates, callInfo)
+	mock.lockGetChainMaintainerStates.Unlock()
+	return mock.GetChainMaintainerStatesFunc(ctx, chain)
+}
+
+// GetChainMaintainerStatesCalls gets all the calls that were made to GetChainMaintainerStates.
+// Check the length with:
+//
+//	len(mockedNexus.GetChainMaintainerStatesCalls())
+func (mock *NexusMock) GetChainMaintainerStatesCalls() []struct {
+	Ctx   cosmossdktypes.Context
+	Chain nexus.Chain
+} {
+	var calls []struct {
+		Ctx   cosmossdktypes.Context
+		Chain nexus.Chain
+	}
+	mock.lockGetChainMaintainerStates.RLock()
+	calls = mock.calls.GetChainMaintainerStates
+	mock.lockGetChainMaintainerStates.RUnlock()
+	return calls
 }
 
 // GetChainMaintainers calls GetChainMaintainersFunc.
@@ -521,6 +692,38 @@ func (mock *NexusMock) GetChainsCalls() []struct {
 	return calls
 }
 
+// GetParams calls GetParamsFunc.
+func (mock *NexusMock) GetParams(ctx cosmossdktypes.Context) nexustypes.Params {
+	if mock.GetParamsFunc == nil {
+		panic("NexusMock.GetParamsFunc: method is nil but Nexus.GetParams was just called")
+	}
+	callInfo := struct {
+		Ctx cosmossdktypes.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetParams.Lock()
+	mock.calls.GetParams = append(mock.calls.GetParams, callInfo)
+	mock.lockGetParams.Unlock()
+	return mock.GetParamsFunc(ctx)
+}
+
+// GetParamsCalls gets all the calls that were made to GetParams.
+// Check the length with:
+//
+//	len(mockedNexus.GetParamsCalls())
+func (mock *NexusMock) GetParamsCalls() []struct {
+	Ctx cosmossdktypes.Context
+} {
+	var calls []struct {
+		Ctx cosmossdktypes.Context
+	}
+	mock.lockGetParams.RLock()
+	calls = mock.calls.GetParams
+	mock.lockGetParams.RUnlock()
+	return calls
+}
+
 // IsChainActivated calls IsChainActivatedFunc.
 func (mock *NexusMock) IsChainActivated(ctx cosmossdktypes.Context, chain nexus.Chain) bool {
 	if mock.IsChainActivatedFunc == nil {
@@ -902,6 +1105,12 @@ var _ rewardtypes.Slasher = &SlasherMock{}
 //			IsTombstonedFunc: func(ctx context.Context, consAddr cosmossdktypes.ConsAddress) bool {
 //				panic("mock out the IsTombstoned method")
 //			},
+//			JailFunc: func(ctx context.Context, consAddr cosmossdktypes.ConsAddress) error {
+//				panic("mock out the Jail method")
+//			},
+//			JailUntilFunc: func(ctx context.Context, consAddr cosmossdktypes.ConsAddress, jailTime time.Time) error {
+//				panic("mock out the JailUntil method")
+//			},
 //		}
 //
 //		// use mockedSlasher in code that requires rewardtypes.Slasher
@@ -912,6 +1121,12 @@ type SlasherMock struct {
 	// IsTombstonedFunc mocks the IsTombstoned method.
 	IsTombstonedFunc func(ctx context.Context, consAddr cosmossdktypes.ConsAddress) bool
 
+	// JailFunc mocks the Jail method.
+	JailFunc func(ctx context.Context, consAddr cosmossdktypes.ConsAddress) error
+
+	// JailUntilFunc mocks the JailUntil method.
+	JailUntilFunc func(ctx context.Context, consAddr cosmossdktypes.ConsAddress, jailTime time.Time) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// IsTombstoned holds details about calls to the IsTombstoned method.
@@ -921,8 +1136,26 @@ type SlasherMock struct {
 			// ConsAddr is the consAddr argument value.
 			ConsAddr cosmossdktypes.ConsAddress
 		}
+		// Jail holds details about calls to the Jail method.
+		Jail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ConsAddr is the consAddr argument value.
+			ConsAddr cosmossdktypes.ConsAddress
+		}
+		// JailUntil holds details about calls to the JailUntil method.
+		JailUntil []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ConsAddr is the consAddr argument value.
+			ConsAddr cosmossdktypes.ConsAddress
+			// JailTime is the jailTime argument value.
+			JailTime time.Time
+		}
 	}
 	lockIsTombstoned sync.RWMutex
+	lockJail         sync.RWMutex
+	lockJailUntil    sync.RWMutex
 }
 
 // IsTombstoned calls IsTombstonedFunc.
@@ -961,6 +1194,82 @@ func (mock *SlasherMock) IsTombstonedCalls() []struct {
 	return calls
 }
 
+// Jail calls JailFunc.
+func (mock *SlasherMock) Jail(ctx context.Context, consAddr cosmossdktypes.ConsAddress) error {
+	if mock.JailFunc == nil {
+		panic("SlasherMock.JailFunc: method is nil but Slasher.Jail was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ConsAddr cosmossdktypes.ConsAddress
+	}{
+		Ctx:      ctx,
+		ConsAddr: consAddr,
+	}
+	mock.lockJail.Lock()
+	mock.calls.Jail = append(mock.calls.Jail, callInfo)
+	mock.lockJail.Unlock()
+	return mock.JailFunc(ctx, consAddr)
+}
+
+// JailCalls gets all the calls that were made to Jail.
+// Check the length with:
+//
+//	len(mockedSlasher.JailCalls())
+func (mock *SlasherMock) JailCalls() []struct {
+	Ctx      context.Context
+	ConsAddr cosmossdktypes.ConsAddress
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ConsAddr cosmossdktypes.ConsAddress
+	}
+	mock.lockJail.RLock()
+	calls = mock.calls.Jail
+	mock.lockJail.RUnlock()
+	return calls
+}
+
+// JailUntil calls JailUntilFunc.
+func (mock *SlasherMock) JailUntil(ctx context.Context, consAddr cosmossdktypes.ConsAddress, jailTime time.Time) error {
+	if mock.JailUntilFunc == nil {
+		panic("SlasherMock.JailUntilFunc: method is nil but Slasher.JailUntil was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ConsAddr cosmossdktypes.ConsAddress
+		JailTime time.Time
+	}{
+		Ctx:      ctx,
+		ConsAddr: consAddr,
+		JailTime: jailTime,
+	}
+	mock.lockJailUntil.Lock()
+	mock.calls.JailUntil = append(mock.calls.JailUntil, callInfo)
+	mock.lockJailUntil.Unlock()
+	return mock.JailUntilFunc(ctx, consAddr, jailTime)
+}
+
+// JailUntilCalls gets all the calls that were made to JailUntil.
+// Check the length with:
+//
+//	len(mockedSlasher.JailUntilCalls())
+func (mock *SlasherMock) JailUntilCalls() []struct {
+	Ctx      context.Context
+	ConsAddr cosmossdktypes.ConsAddress
+	JailTime time.Time
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ConsAddr cosmossdktypes.ConsAddress
+		JailTime time.Time
+	}
+	mock.lockJailUntil.RLock()
+	calls = mock.calls.JailUntil
+	mock.lockJailUntil.RUnlock()
+	return calls
+}
+
 // Ensure, that BankerMock does implement rewardtypes.Banker.
 // If this is not the case, regenerate this file with moq.
 var _ rewardtypes.Banker = &BankerMock{}
@@ -1339,9 +1648,15 @@ var _ rewardtypes.MultiSig = &MultiSigMock{}
 //
 //		// make and configure a mocked rewardtypes.MultiSig
 //		mockedMultiSig := &MultiSigMock{
+//			GetSigningParticipationFunc: func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress) multisigtypes.SigningParticipation {
+//				panic("mock out the GetSigningParticipation method")
+//			},
 //			HasOptedOutFunc: func(ctx cosmossdktypes.Context, participant cosmossdktypes.AccAddress) bool {
 //				panic("mock out the HasOptedOut method")
 //			},
+//			ResetSigningParticipationFunc: func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress)  {
+//				panic("mock out the ResetSigningParticipation method")
+//			},
 //		}
 //
 //		// use mockedMultiSig in code that requires rewardtypes.MultiSig
@@ -1349,11 +1664,24 @@ var _ rewardtypes.MultiSig = &MultiSigMock{}
 //
 //	}
 type MultiSigMock struct {
+	// GetSigningParticipationFunc mocks the GetSigningParticipation method.
+	GetSigningParticipationFunc func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress) multisigtypes.SigningParticipation
+
 	// HasOptedOutFunc mocks the HasOptedOut method.
 	HasOptedOutFunc func(ctx cosmossdktypes.Context, participant cosmossdktypes.AccAddress) bool
 
+	// ResetSigningParticipationFunc mocks the ResetSigningParticipation method.
+	ResetSigningParticipationFunc func(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetSigningParticipation holds details about calls to the GetSigningParticipation method.
+		GetSigningParticipation []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Validator is the validator argument value.
+			Validator cosmossdktypes.ValAddress
+		}
 		// HasOptedOut holds details about calls to the HasOptedOut method.
 		HasOptedOut []struct {
 			// Ctx is the ctx argument value.
@@ -1361,8 +1689,53 @@ type MultiSigMock struct {
 			// Participant is the participant argument value.
 			Participant cosmossdktypes.AccAddress
 		}
+		// ResetSigningParticipation holds details about calls to the ResetSigningParticipation method.
+		ResetSigningParticipation []struct {
+			// Ctx is the ctx argument value.
+			Ctx cosmossdktypes.Context
+			// Validator is the validator argument value.
+			Validator cosmossdktypes.ValAddress
+		}
 	}
-	lockHasOptedOut sync.RWMutex
+	lockGetSigningParticipation   sync.RWMutex
+	lockHasOptedOut               sync.RWMutex
+	lockResetSigningParticipation sync.RWMutex
+}
+
+// GetSigningParticipation calls GetSigningParticipationFunc.
+func (mock *MultiSigMock) GetSigningParticipation(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress) multisigtypes.SigningParticipation {
+	if mock.GetSigningParticipationFunc == nil {
+		panic("MultiSigMock.GetSigningParticipationFunc: method is nil but MultiSig.GetSigningParticipation was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+	}
+	mock.lockGetSigningParticipation.Lock()
+	mock.calls.GetSigningParticipation = append(mock.calls.GetSigningParticipation, callInfo)
+	mock.lockGetSigningParticipation.Unlock()
+	return mock.GetSigningParticipationFunc(ctx, validator)
+}
+
+// GetSigningParticipationCalls gets all the calls that were made to GetSigningParticipation.
+// Check the length with:
+//
+//	len(mockedMultiSig.GetSigningParticipationCalls())
+func (mock *MultiSigMock) GetSigningParticipationCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	Validator cosmossdktypes.ValAddress
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+	}
+	mock.lockGetSigningParticipation.RLock()
+	calls = mock.calls.GetSigningParticipation
+	mock.lockGetSigningParticipation.RUnlock()
+	return calls
 }
 
 // HasOptedOut calls HasOptedOutFunc.
@@ -1401,6 +1774,42 @@ func (mock *MultiSigMock) HasOptedOutCalls() []struct {
 	return calls
 }
 
+// ResetSigningParticipation calls ResetSigningParticipationFunc.
+func (mock *MultiSigMock) ResetSigningParticipation(ctx cosmossdktypes.Context, validator cosmossdktypes.ValAddress) {
+	if mock.ResetSigningParticipationFunc == nil {
+		panic("MultiSigMock.ResetSigningParticipationFunc: method is nil but MultiSig.ResetSigningParticipation was just called")
+	}
+	callInfo := struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+	}{
+		Ctx:       ctx,
+		Validator: validator,
+	}
+	mock.lockResetSigningParticipation.Lock()
+	mock.calls.ResetSigningParticipation = append(mock.calls.ResetSigningParticipation, callInfo)
+	mock.lockResetSigningParticipation.Unlock()
+	mock.ResetSigningParticipationFunc(ctx, validator)
+}
+
+// ResetSigningParticipationCalls gets all the calls that were made to ResetSigningParticipation.
+// Check the length with:
+//
+//	len(mockedMultiSig.ResetSigningParticipationCalls())
+func (mock *MultiSigMock) ResetSigningParticipationCalls() []struct {
+	Ctx       cosmossdktypes.Context
+	Validator cosmossdktypes.ValAddress
+} {
+	var calls []struct {
+		Ctx       cosmossdktypes.Context
+		Validator cosmossdktypes.ValAddress
+	}
+	mock.lockResetSigningParticipation.RLock()
+	calls = mock.calls.ResetSigningParticipation
+	mock.lockResetSigningParticipation.RUnlock()
+	return calls
+}
+
 // Ensure, that SnapshotterMock does implement rewardtypes.Snapshotter.
 // If this is not the case, regenerate this file with moq.
 var _ rewardtypes.Snapshotter = &SnapshotterMock{}
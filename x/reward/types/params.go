@@ -2,15 +2,21 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/axelarnetwork/axelar-core/utils"
 )
 
 // Parameter store keys
 var (
 	KeyExternalChainVotingInflationRate = []byte("ExternalChainVotingInflationRate")
 	KeyKeyMgmtRelativeInflationRate     = []byte("KeyMgmtRelativeInflationRate")
+	KeyPenalty                          = []byte("Penalty")
+	KeyRefundPolicy                     = []byte("RefundPolicy")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -23,6 +29,19 @@ func DefaultParams() Params {
 	return Params{
 		ExternalChainVotingInflationRate: math.LegacyZeroDec(),
 		KeyMgmtRelativeInflationRate:     math.LegacyZeroDec(),
+		Penalty:                          DefaultPenaltyParams(),
+		RefundPolicy:                     DefaultRefundPolicy(),
+	}
+}
+
+// DefaultPenaltyParams returns the default penalty parameters, penalties are disabled by default
+func DefaultPenaltyParams() PenaltyParams {
+	return PenaltyParams{
+		Enabled:                   false,
+		MaintainerAction:          PenaltyActionDeregister,
+		MissingSignatureThreshold: utils.NewThreshold(50, 100),
+		MinSigningSessions:        100,
+		JailDuration:              24 * time.Hour,
 	}
 }
 
@@ -38,6 +57,8 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyExternalChainVotingInflationRate, &m.ExternalChainVotingInflationRate, validateExternalChainVotingInflationRate),
 		paramtypes.NewParamSetPair(KeyKeyMgmtRelativeInflationRate, &m.KeyMgmtRelativeInflationRate, validateKeyMgmtRelativeInflationRate),
+		paramtypes.NewParamSetPair(KeyPenalty, &m.Penalty, validatePenalty),
+		paramtypes.NewParamSetPair(KeyRefundPolicy, &m.RefundPolicy, validateRefundPolicy),
 	}
 }
 
@@ -51,6 +72,14 @@ func (m Params) Validate() error {
 		return err
 	}
 
+	if err := validatePenalty(m.Penalty); err != nil {
+		return err
+	}
+
+	if err := validateRefundPolicy(m.RefundPolicy); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,3 +114,37 @@ func validateKeyMgmtRelativeInflationRate(i interface{}) error {
 
 	return nil
 }
+
+func validatePenalty(i interface{}) error {
+	v, ok := i.(PenaltyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.MaintainerAction.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := v.MissingSignatureThreshold.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid missing signature threshold")
+	}
+
+	if v.MinSigningSessions == 0 {
+		return fmt.Errorf("min signing sessions must be >0")
+	}
+
+	if v.JailDuration <= 0 {
+		return fmt.Errorf("jail duration must be >0")
+	}
+
+	return nil
+}
+
+func validateRefundPolicy(i interface{}) error {
+	v, ok := i.(RefundPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.ValidateBasic()
+}
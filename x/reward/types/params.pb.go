@@ -6,17 +6,22 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	utils "github.com/axelarnetwork/axelar-core/utils"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	ExternalChainVotingInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=external_chain_voting_inflation_rate,json=externalChainVotingInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"external_chain_voting_inflation_rate"`
 	KeyMgmtRelativeInflationRate     cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=key_mgmt_relative_inflation_rate,json=keyMgmtRelativeInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"key_mgmt_relative_inflation_rate"`
+	Penalty                          PenaltyParams               `protobuf:"bytes,3,opt,name=penalty,proto3" json:"penalty"`
+	RefundPolicy                     RefundPolicy                `protobuf:"bytes,4,opt,name=refund_policy,json=refundPolicy,proto3" json:"refund_policy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,8 +70,144 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// RefundPolicy configures which messages wrapped in a RefundMsgRequest get
+// their fees refunded, and how many refunds each validator can claim
+type RefundPolicy struct {
+	RefundableMsgs []RefundableMsg `protobuf:"bytes,1,rep,name=refundable_msgs,json=refundableMsgs,proto3" json:"refundable_msgs"`
+	// number of blocks in a refund epoch
+	EpochLength int64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// max number of refunded messages per validator in a refund epoch, 0 means
+	// unlimited
+	MaxRefundsPerEpoch uint64 `protobuf:"varint,3,opt,name=max_refunds_per_epoch,json=maxRefundsPerEpoch,proto3" json:"max_refunds_per_epoch,omitempty"`
+}
+
+func (m *RefundPolicy) Reset()         { *m = RefundPolicy{} }
+func (m *RefundPolicy) String() string { return proto.CompactTextString(m) }
+func (*RefundPolicy) ProtoMessage()    {}
+func (*RefundPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc8c8df034e5ffb0, []int{1}
+}
+func (m *RefundPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundPolicy.Merge(m, src)
+}
+func (m *RefundPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundPolicy proto.InternalMessageInfo
+
+// RefundableMsg is a message type whose fees can be refunded
+type RefundableMsg struct {
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// max gas a transaction can request per refunded message of this type, 0
+	// means unlimited
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *RefundableMsg) Reset()         { *m = RefundableMsg{} }
+func (m *RefundableMsg) String() string { return proto.CompactTextString(m) }
+func (*RefundableMsg) ProtoMessage()    {}
+func (*RefundableMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc8c8df034e5ffb0, []int{2}
+}
+func (m *RefundableMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundableMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundableMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundableMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundableMsg.Merge(m, src)
+}
+func (m *RefundableMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundableMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundableMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundableMsg proto.InternalMessageInfo
+
+// PenaltyParams configure how validators that are chronically absent as chain
+// maintainers or keygen participants get penalized
+type PenaltyParams struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// penalty for chain maintainers that missed or voted incorrectly in too many
+	// polls. The nexus module deregisters them by its own thresholds either way,
+	// PENALTY_ACTION_JAIL additionally jails them
+	MaintainerAction          PenaltyAction   `protobuf:"varint,5,opt,name=maintainer_action,json=maintainerAction,proto3,enum=axelar.reward.v1beta1.PenaltyAction" json:"maintainer_action,omitempty"`
+	MissingSignatureThreshold utils.Threshold `protobuf:"bytes,6,opt,name=missing_signature_threshold,json=missingSignatureThreshold,proto3" json:"missing_signature_threshold"`
+	// min number of signing sessions a validator must have participated in
+	// before it can be penalized for missing signatures
+	MinSigningSessions uint64        `protobuf:"varint,7,opt,name=min_signing_sessions,json=minSigningSessions,proto3" json:"min_signing_sessions,omitempty"`
+	JailDuration       time.Duration `protobuf:"bytes,8,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *PenaltyParams) Reset()         { *m = PenaltyParams{} }
+func (m *PenaltyParams) String() string { return proto.CompactTextString(m) }
+func (*PenaltyParams) ProtoMessage()    {}
+func (*PenaltyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc8c8df034e5ffb0, []int{3}
+}
+func (m *PenaltyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyParams.Merge(m, src)
+}
+func (m *PenaltyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyParams proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "axelar.reward.v1beta1.Params")
+	proto.RegisterType((*RefundPolicy)(nil), "axelar.reward.v1beta1.RefundPolicy")
+	proto.RegisterType((*RefundableMsg)(nil), "axelar.reward.v1beta1.RefundableMsg")
+	proto.RegisterType((*PenaltyParams)(nil), "axelar.reward.v1beta1.PenaltyParams")
 }
 
 func init() {
@@ -72,26 +215,52 @@ func init() {
 }
 
 var fileDescriptor_bc8c8df034e5ffb0 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xb1, 0x4e, 0x32, 0x41,
-	0x10, 0xc7, 0xef, 0xbe, 0x82, 0xe2, 0xf2, 0x55, 0x44, 0x13, 0xa3, 0x66, 0x21, 0x68, 0x61, 0xe3,
-	0x6d, 0x08, 0x6f, 0x80, 0x36, 0x26, 0x9a, 0x28, 0x85, 0x85, 0xcd, 0x65, 0x58, 0xc6, 0x65, 0x73,
-	0xec, 0x0e, 0xd9, 0x1d, 0x81, 0x7b, 0x0b, 0x1f, 0x8b, 0x92, 0xd2, 0x58, 0x10, 0x85, 0xce, 0xa7,
-	0x30, 0xdc, 0x71, 0x85, 0x56, 0x76, 0x33, 0x99, 0xdf, 0xfc, 0xfe, 0xc9, 0x3f, 0xe9, 0xc0, 0x02,
-	0x27, 0xe0, 0xa5, 0xc7, 0x39, 0xf8, 0x91, 0x9c, 0x75, 0x87, 0xc8, 0xd0, 0x95, 0x53, 0xf0, 0x60,
-	0x43, 0x3a, 0xf5, 0xc4, 0xd4, 0x3c, 0xac, 0x98, 0xb4, 0x62, 0xd2, 0x3d, 0x73, 0x7c, 0xa0, 0x49,
-	0x53, 0x49, 0xc8, 0xdd, 0x54, 0xc1, 0x9d, 0xaf, 0x38, 0x69, 0xdc, 0x97, 0xdf, 0xcd, 0x90, 0x9c,
-	0xe3, 0x82, 0xd1, 0x3b, 0x98, 0x64, 0x6a, 0x0c, 0xc6, 0x65, 0x33, 0x62, 0xe3, 0x74, 0x66, 0xdc,
-	0xf3, 0x04, 0xd8, 0x90, 0xcb, 0x3c, 0x30, 0x1e, 0xc5, 0xed, 0xf8, 0xe2, 0x7f, 0xff, 0x6c, 0xb9,
-	0x6e, 0x45, 0xef, 0xeb, 0xd6, 0x89, 0xa2, 0x60, 0x29, 0x84, 0x51, 0x9e, 0x1a, 0x92, 0x16, 0x78,
-	0x9c, 0xde, 0xa2, 0x06, 0x55, 0x5c, 0xa3, 0x1a, 0xb4, 0x6b, 0xe1, 0xd5, 0xce, 0xf7, 0x58, 0xea,
-	0x6e, 0x6a, 0xdb, 0x00, 0x18, 0x9b, 0x79, 0xd2, 0xce, 0xb1, 0xc8, 0xac, 0xb6, 0x9c, 0x79, 0xdc,
-	0x1d, 0x66, 0xf8, 0x3b, 0xf0, 0xdf, 0xdf, 0x03, 0x4f, 0x73, 0x2c, 0xee, 0xb4, 0xe5, 0xc1, 0x5e,
-	0xf5, 0x23, 0xac, 0xff, 0xb0, 0xfc, 0x14, 0xd1, 0x72, 0x23, 0xe2, 0xd5, 0x46, 0xc4, 0x1f, 0x1b,
-	0x11, 0xbf, 0x6e, 0x45, 0xb4, 0xda, 0x8a, 0xe8, 0x6d, 0x2b, 0xa2, 0xa7, 0x9e, 0x36, 0x3c, 0x7e,
-	0x19, 0xa6, 0x8a, 0xac, 0xac, 0x2a, 0x74, 0xc8, 0x73, 0xf2, 0xf9, 0x7e, 0xbb, 0x54, 0xe4, 0x51,
-	0x2e, 0xea, 0xee, 0xb9, 0x98, 0x62, 0x18, 0x36, 0xca, 0x1a, 0x7b, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xaf, 0xea, 0x10, 0x2a, 0x99, 0x01, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x52, 0xdb, 0x3c,
+	0x14, 0x86, 0x63, 0x12, 0x92, 0x7c, 0x22, 0xe1, 0xa3, 0x1e, 0x98, 0x1a, 0xe8, 0x38, 0x21, 0xb0,
+	0x60, 0x53, 0x1b, 0xca, 0x15, 0x34, 0xa5, 0xd3, 0x9f, 0x81, 0x4e, 0x70, 0x68, 0x17, 0x6c, 0x34,
+	0x8a, 0x23, 0x14, 0x35, 0x96, 0xe4, 0x91, 0x14, 0x48, 0x76, 0xbd, 0x84, 0x2e, 0x7b, 0x2b, 0xbd,
+	0x03, 0x96, 0x2c, 0x3b, 0x5d, 0xd0, 0x36, 0x4c, 0xef, 0xa3, 0x63, 0xd9, 0x4e, 0xa1, 0x43, 0x7f,
+	0x76, 0xd6, 0x39, 0x8f, 0xde, 0xf7, 0x58, 0x47, 0x3a, 0xa0, 0x85, 0xc6, 0x38, 0x42, 0xd2, 0x97,
+	0xf8, 0x1c, 0xc9, 0xbe, 0x7f, 0xb6, 0xdb, 0xc3, 0x1a, 0xed, 0xfa, 0x31, 0x92, 0x88, 0x29, 0x2f,
+	0x96, 0x42, 0x0b, 0x7b, 0x25, 0x65, 0xbc, 0x94, 0xf1, 0x32, 0x66, 0x6d, 0x99, 0x08, 0x22, 0x0c,
+	0xe1, 0x27, 0x5f, 0x29, 0xbc, 0xe6, 0x12, 0x21, 0x48, 0x84, 0x7d, 0xb3, 0xea, 0x8d, 0x4e, 0xfd,
+	0xfe, 0x48, 0x22, 0x4d, 0x05, 0xcf, 0xf2, 0x5b, 0x99, 0xe1, 0x48, 0xd3, 0x48, 0xcd, 0xfc, 0xf4,
+	0x40, 0x62, 0x35, 0x10, 0x51, 0x3f, 0xa3, 0x36, 0xee, 0x2e, 0x4b, 0x4f, 0x62, 0x9c, 0x55, 0xd5,
+	0x7a, 0x57, 0x04, 0xe5, 0x8e, 0x29, 0xd3, 0x56, 0x60, 0x0b, 0x8f, 0x35, 0x96, 0x1c, 0x45, 0x30,
+	0x1c, 0x20, 0xca, 0xe1, 0x99, 0xd0, 0x94, 0x13, 0x48, 0xf9, 0x69, 0x64, 0xcc, 0xa1, 0x44, 0x1a,
+	0x3b, 0x56, 0xd3, 0xda, 0xae, 0xb5, 0x37, 0x2f, 0xae, 0x1a, 0x85, 0xcf, 0x57, 0x8d, 0xf5, 0x50,
+	0x28, 0x26, 0x94, 0xea, 0x0f, 0x3d, 0x2a, 0x7c, 0x86, 0xf4, 0xc0, 0x3b, 0xc0, 0x04, 0x85, 0x93,
+	0x7d, 0x1c, 0x06, 0xcd, 0x5c, 0xf0, 0x49, 0xa2, 0xf7, 0xc6, 0xc8, 0xbd, 0xc8, 0xd5, 0x02, 0xa4,
+	0xb1, 0x3d, 0x04, 0xcd, 0x21, 0x9e, 0x40, 0x46, 0x98, 0x86, 0x12, 0x27, 0x89, 0x33, 0xfc, 0xab,
+	0xe1, 0xdc, 0xbf, 0x1b, 0x3e, 0x18, 0xe2, 0xc9, 0x21, 0x61, 0x3a, 0xc8, 0xa4, 0x6e, 0x9b, 0xed,
+	0x83, 0x4a, 0x8c, 0x39, 0x8a, 0xf4, 0xc4, 0x29, 0x36, 0xad, 0xed, 0x85, 0x47, 0x5b, 0xde, 0x9d,
+	0x4d, 0xf1, 0x3a, 0x29, 0x95, 0x1e, 0x4c, 0xbb, 0x94, 0x38, 0x07, 0xf9, 0x56, 0xfb, 0x15, 0xa8,
+	0x4b, 0x7c, 0x3a, 0xe2, 0x7d, 0x18, 0x8b, 0x88, 0x86, 0x13, 0xa7, 0x64, 0xb4, 0x36, 0x7f, 0xa3,
+	0x15, 0x18, 0xb6, 0x63, 0xd0, 0x4c, 0xaa, 0x26, 0x6f, 0xc4, 0x5a, 0x1f, 0x2d, 0x50, 0xbb, 0x09,
+	0xd9, 0x5d, 0xf0, 0x7f, 0x0a, 0xa0, 0x5e, 0x84, 0x21, 0x53, 0x44, 0x39, 0x56, 0xb3, 0xf8, 0x87,
+	0x72, 0x83, 0x19, 0x7d, 0xa8, 0x48, 0xe6, 0xb1, 0x28, 0x6f, 0x06, 0x95, 0xbd, 0x01, 0x6a, 0x38,
+	0x16, 0xe1, 0x00, 0x46, 0x98, 0x13, 0x3d, 0x30, 0x87, 0x5a, 0x0c, 0x16, 0x4c, 0xec, 0xc0, 0x84,
+	0xec, 0x5d, 0xb0, 0xc2, 0xd0, 0x18, 0xa6, 0x1b, 0x15, 0x8c, 0xb1, 0x84, 0x26, 0x6d, 0x0e, 0xab,
+	0x14, 0xd8, 0x0c, 0x8d, 0x53, 0x27, 0xd5, 0xc1, 0xf2, 0x69, 0x92, 0x69, 0x9d, 0x80, 0xfa, 0x2d,
+	0x73, 0x7b, 0x07, 0xd4, 0x98, 0x22, 0x30, 0xb9, 0x62, 0x70, 0x24, 0x23, 0x73, 0x59, 0xfe, 0x6b,
+	0x2f, 0x4e, 0xaf, 0x1a, 0xe0, 0x50, 0x91, 0xe3, 0x49, 0x8c, 0x5f, 0x07, 0x07, 0x01, 0x60, 0xd9,
+	0xb7, 0x8c, 0xec, 0xfb, 0xa0, 0x92, 0xb8, 0x12, 0xa4, 0x4c, 0x4d, 0xa5, 0xa0, 0xcc, 0xd0, 0xf8,
+	0x19, 0x52, 0xad, 0xef, 0x73, 0xa0, 0x7e, 0xab, 0x11, 0xb6, 0x03, 0x2a, 0x98, 0x27, 0x4e, 0x7d,
+	0xa3, 0x5b, 0x0d, 0xf2, 0xa5, 0x7d, 0x04, 0xee, 0x31, 0x44, 0xb9, 0x46, 0x94, 0x63, 0x09, 0x51,
+	0x98, 0xb4, 0xdc, 0x99, 0x6f, 0x5a, 0xdb, 0x8b, 0x7f, 0xeb, 0xf1, 0x63, 0xc3, 0x06, 0x4b, 0x3f,
+	0xb7, 0xa7, 0x11, 0x1b, 0x83, 0x75, 0x46, 0x95, 0x4a, 0x1e, 0x80, 0xa2, 0x84, 0x23, 0x3d, 0x92,
+	0x18, 0xce, 0x5e, 0x98, 0x53, 0x36, 0x4d, 0x6f, 0xe4, 0xe2, 0xe6, 0x21, 0xce, 0xb4, 0x8f, 0x73,
+	0x2c, 0x6b, 0xc6, 0x6a, 0xa6, 0xd4, 0xcd, 0x85, 0x66, 0x80, 0xbd, 0x03, 0x96, 0x19, 0xe5, 0xc6,
+	0xc2, 0x58, 0x61, 0xa5, 0xa8, 0xe0, 0xca, 0xa9, 0x64, 0x67, 0x4e, 0x79, 0x37, 0x4d, 0x75, 0xb3,
+	0x8c, 0xfd, 0x1c, 0xd4, 0xdf, 0x22, 0x1a, 0xc1, 0x7c, 0x24, 0x38, 0x55, 0x53, 0xca, 0xaa, 0x97,
+	0xce, 0x0c, 0x2f, 0x9f, 0x19, 0xde, 0x7e, 0x06, 0xb4, 0xab, 0x49, 0x11, 0x1f, 0xbe, 0x34, 0xac,
+	0xa0, 0x96, 0xec, 0xcc, 0xe3, 0x2f, 0x4b, 0xd5, 0xb9, 0xa5, 0xf9, 0xf6, 0xd1, 0xc5, 0x37, 0xb7,
+	0x70, 0x31, 0x75, 0xad, 0xcb, 0xa9, 0x6b, 0x7d, 0x9d, 0xba, 0xd6, 0xfb, 0x6b, 0xb7, 0x70, 0x79,
+	0xed, 0x16, 0x3e, 0x5d, 0xbb, 0x85, 0x93, 0x3d, 0x42, 0xf5, 0x60, 0xd4, 0xf3, 0x42, 0xc1, 0xfc,
+	0xf4, 0x5f, 0x39, 0xd6, 0xe7, 0x42, 0x0e, 0xb3, 0xd5, 0xc3, 0x50, 0x48, 0xec, 0x8f, 0xf3, 0x19,
+	0x63, 0x66, 0x4b, 0xaf, 0x6c, 0x6a, 0xd8, 0xfb, 0x31, 0x00, 0x14, 0xf5, 0xe9, 0xb5, 0x18, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +283,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.RefundPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.KeyMgmtRelativeInflationRate.Size()
 		i -= size
@@ -137,6 +326,149 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RefundPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRefundsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRefundsPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RefundableMsgs) > 0 {
+		for iNdEx := len(m.RefundableMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundableMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundableMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundableMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundableMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.MinSigningSessions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinSigningSessions))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.MissingSignatureThreshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaintainerAction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintainerAction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +490,69 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.KeyMgmtRelativeInflationRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RefundPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RefundPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundableMsgs) > 0 {
+		for _, e := range m.RefundableMsgs {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovParams(uint64(m.EpochLength))
+	}
+	if m.MaxRefundsPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxRefundsPerEpoch))
+	}
+	return n
+}
+
+func (m *RefundableMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *PenaltyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaintainerAction != 0 {
+		n += 1 + sovParams(uint64(m.MaintainerAction))
+	}
+	l = m.MissingSignatureThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinSigningSessions != 0 {
+		n += 1 + sovParams(uint64(m.MinSigningSessions))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -262,6 +657,469 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundableMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundableMsgs = append(m.RefundableMsgs, RefundableMsg{})
+			if err := m.RefundableMsgs[len(m.RefundableMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundsPerEpoch", wireType)
+			}
+			m.MaxRefundsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundableMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundableMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundableMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintainerAction", wireType)
+			}
+			m.MaintainerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintainerAction |= PenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSignatureThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissingSignatureThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSigningSessions", wireType)
+			}
+			m.MinSigningSessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSigningSessions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nexus "github.com/axelarnetwork/axelar-core/x/nexus/exported"
+)
+
+// NewMaintainerPenalty returns the penalty for a chain maintainer that missed or voted incorrectly in too many polls
+func NewMaintainerPenalty(ctx sdk.Context, validator sdk.ValAddress, action PenaltyAction, reason PenaltyReason, chain nexus.ChainName, count, window uint64) Penalty {
+	return Penalty{
+		Validator: validator,
+		Action:    action,
+		Reason:    reason,
+		Chain:     chain,
+		Height:    ctx.BlockHeight(),
+		Count:     count,
+		Window:    window,
+	}
+}
+
+// NewSignerPenalty returns the penalty for a validator that missed too many signatures
+func NewSignerPenalty(ctx sdk.Context, validator sdk.ValAddress, count, window uint64) Penalty {
+	return Penalty{
+		Validator: validator,
+		Action:    PenaltyActionJail,
+		Reason:    PenaltyReasonMissedSignatures,
+		Height:    ctx.BlockHeight(),
+		Count:     count,
+		Window:    window,
+	}
+}
+
+// ValidateBasic returns an error if the given penalty action is invalid; nil otherwise
+func (a PenaltyAction) ValidateBasic() error {
+	switch a {
+	case PenaltyActionDeregister, PenaltyActionJail:
+		return nil
+	default:
+		return fmt.Errorf("invalid penalty action %s", a)
+	}
+}
+
+// ValidateBasic returns an error if the given penalty is invalid; nil otherwise
+func (m Penalty) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+		return err
+	}
+
+	if err := m.Action.ValidateBasic(); err != nil {
+		return err
+	}
+
+	switch m.Reason {
+	case PenaltyReasonMissedVotes, PenaltyReasonIncorrectVotes:
+		if err := m.Chain.Validate(); err != nil {
+			return err
+		}
+	case PenaltyReasonMissedSignatures:
+		if m.Chain != "" {
+			return fmt.Errorf("penalty for missed signatures cannot be for chain %s", m.Chain)
+		}
+
+		if m.Action != PenaltyActionJail {
+			return fmt.Errorf("penalty for missed signatures must jail the validator")
+		}
+	default:
+		return fmt.Errorf("invalid penalty reason %s", m.Reason)
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("penalty height must be >0")
+	}
+
+	if m.Count > m.Window {
+		return fmt.Errorf("penalty count cannot exceed the window")
+	}
+
+	return nil
+}
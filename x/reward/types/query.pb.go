@@ -6,6 +6,9 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -177,37 +180,338 @@ func (m *ParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
 
+// PendingRewardsRequest represents a message that queries the rewards a
+// validator has accumulated in the reward pools but that have not been
+// released yet. If pool is empty, the rewards of all pools are returned.
+type PendingRewardsRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Pool      string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *PendingRewardsRequest) Reset()         { *m = PendingRewardsRequest{} }
+func (m *PendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingRewardsRequest) ProtoMessage()    {}
+func (*PendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{4}
+}
+func (m *PendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewardsRequest.Merge(m, src)
+}
+func (m *PendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewardsRequest proto.InternalMessageInfo
+
+type PendingRewardsResponse struct {
+	Rewards []PendingRewardsResponse_PoolRewards     `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	Total   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *PendingRewardsResponse) Reset()         { *m = PendingRewardsResponse{} }
+func (m *PendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingRewardsResponse) ProtoMessage()    {}
+func (*PendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{5}
+}
+func (m *PendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewardsResponse.Merge(m, src)
+}
+func (m *PendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewardsResponse proto.InternalMessageInfo
+
+type PendingRewardsResponse_PoolRewards struct {
+	Pool  string                                   `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *PendingRewardsResponse_PoolRewards) Reset()         { *m = PendingRewardsResponse_PoolRewards{} }
+func (m *PendingRewardsResponse_PoolRewards) String() string { return proto.CompactTextString(m) }
+func (*PendingRewardsResponse_PoolRewards) ProtoMessage()    {}
+func (*PendingRewardsResponse_PoolRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{5, 0}
+}
+func (m *PendingRewardsResponse_PoolRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewardsResponse_PoolRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewardsResponse_PoolRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewardsResponse_PoolRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewardsResponse_PoolRewards.Merge(m, src)
+}
+func (m *PendingRewardsResponse_PoolRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewardsResponse_PoolRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewardsResponse_PoolRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewardsResponse_PoolRewards proto.InternalMessageInfo
+
+// PenaltiesRequest represents a message that queries the penalties that were
+// imposed on a validator. If validator is empty, the penalties of all
+// validators are returned.
+type PenaltiesRequest struct {
+	Validator  string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PenaltiesRequest) Reset()         { *m = PenaltiesRequest{} }
+func (m *PenaltiesRequest) String() string { return proto.CompactTextString(m) }
+func (*PenaltiesRequest) ProtoMessage()    {}
+func (*PenaltiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{6}
+}
+func (m *PenaltiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltiesRequest.Merge(m, src)
+}
+func (m *PenaltiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltiesRequest proto.InternalMessageInfo
+
+type PenaltiesResponse struct {
+	Penalties  []Penalty           `protobuf:"bytes,1,rep,name=penalties,proto3" json:"penalties"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PenaltiesResponse) Reset()         { *m = PenaltiesResponse{} }
+func (m *PenaltiesResponse) String() string { return proto.CompactTextString(m) }
+func (*PenaltiesResponse) ProtoMessage()    {}
+func (*PenaltiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{7}
+}
+func (m *PenaltiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltiesResponse.Merge(m, src)
+}
+func (m *PenaltiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltiesResponse proto.InternalMessageInfo
+
+// RefundBudgetRequest represents a message that queries the refunds a
+// validator has claimed in the current refund epoch
+type RefundBudgetRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *RefundBudgetRequest) Reset()         { *m = RefundBudgetRequest{} }
+func (m *RefundBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundBudgetRequest) ProtoMessage()    {}
+func (*RefundBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{8}
+}
+func (m *RefundBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundBudgetRequest.Merge(m, src)
+}
+func (m *RefundBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundBudgetRequest proto.InternalMessageInfo
+
+type RefundBudgetResponse struct {
+	Epoch int64  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Used  uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// max number of refunds per epoch, 0 means unlimited
+	Max uint64 `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *RefundBudgetResponse) Reset()         { *m = RefundBudgetResponse{} }
+func (m *RefundBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundBudgetResponse) ProtoMessage()    {}
+func (*RefundBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea20e5bdb695fbb5, []int{9}
+}
+func (m *RefundBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundBudgetResponse.Merge(m, src)
+}
+func (m *RefundBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*InflationRateRequest)(nil), "axelar.reward.v1beta1.InflationRateRequest")
 	proto.RegisterType((*InflationRateResponse)(nil), "axelar.reward.v1beta1.InflationRateResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "axelar.reward.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "axelar.reward.v1beta1.ParamsResponse")
+	proto.RegisterType((*PendingRewardsRequest)(nil), "axelar.reward.v1beta1.PendingRewardsRequest")
+	proto.RegisterType((*PendingRewardsResponse)(nil), "axelar.reward.v1beta1.PendingRewardsResponse")
+	proto.RegisterType((*PendingRewardsResponse_PoolRewards)(nil), "axelar.reward.v1beta1.PendingRewardsResponse.PoolRewards")
+	proto.RegisterType((*PenaltiesRequest)(nil), "axelar.reward.v1beta1.PenaltiesRequest")
+	proto.RegisterType((*PenaltiesResponse)(nil), "axelar.reward.v1beta1.PenaltiesResponse")
+	proto.RegisterType((*RefundBudgetRequest)(nil), "axelar.reward.v1beta1.RefundBudgetRequest")
+	proto.RegisterType((*RefundBudgetResponse)(nil), "axelar.reward.v1beta1.RefundBudgetResponse")
 }
 
 func init() { proto.RegisterFile("axelar/reward/v1beta1/query.proto", fileDescriptor_ea20e5bdb695fbb5) }
 
 var fileDescriptor_ea20e5bdb695fbb5 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4e, 0xf2, 0x40,
-	0x14, 0xc5, 0xdb, 0xe4, 0x0b, 0x09, 0xf3, 0x09, 0x26, 0x04, 0x12, 0x83, 0x3a, 0x68, 0xdd, 0xb8,
-	0x71, 0x26, 0x88, 0x3b, 0x77, 0xc4, 0x8d, 0x46, 0x13, 0xed, 0xd2, 0x8d, 0xb9, 0x94, 0x6b, 0x69,
-	0xa0, 0xbd, 0x65, 0x66, 0xf8, 0xf7, 0x16, 0x3e, 0x16, 0x4b, 0x96, 0xc6, 0x05, 0x51, 0x78, 0x11,
-	0x43, 0xa7, 0x44, 0x63, 0xd8, 0xcd, 0xdc, 0xf9, 0x9d, 0x73, 0xcf, 0x1c, 0x76, 0x0a, 0x53, 0x1c,
-	0x80, 0x92, 0x0a, 0x27, 0xa0, 0xba, 0x72, 0xdc, 0xec, 0xa0, 0x81, 0xa6, 0x1c, 0x8e, 0x50, 0xcd,
-	0x44, 0xaa, 0xc8, 0x50, 0xa5, 0x66, 0x11, 0x61, 0x11, 0x91, 0x23, 0xf5, 0x6a, 0x48, 0x21, 0x65,
-	0x84, 0xdc, 0x9c, 0x2c, 0x5c, 0xf7, 0x76, 0xfb, 0xa5, 0xa0, 0x20, 0xd6, 0x96, 0xf1, 0xae, 0x58,
-	0xf5, 0x36, 0x79, 0x1d, 0x80, 0x89, 0x28, 0xf1, 0xc1, 0xa0, 0x8f, 0xc3, 0x11, 0x6a, 0x53, 0x39,
-	0x62, 0xc5, 0x31, 0x0c, 0xa2, 0x2e, 0x18, 0x52, 0x07, 0xee, 0x89, 0x7b, 0x5e, 0xf4, 0x7f, 0x06,
-	0x5e, 0xc0, 0x6a, 0x7f, 0x54, 0x3a, 0xa5, 0x44, 0x63, 0xe5, 0x8e, 0x95, 0xa3, 0xed, 0xc3, 0x8b,
-	0x02, 0x83, 0x99, 0x76, 0xaf, 0x7d, 0x36, 0x5f, 0x36, 0x9c, 0x8f, 0x65, 0xe3, 0x30, 0x20, 0x1d,
-	0x93, 0xd6, 0xdd, 0xbe, 0x88, 0x48, 0xc6, 0x60, 0x7a, 0xe2, 0x1e, 0x43, 0x08, 0x66, 0x37, 0x18,
-	0xf8, 0xa5, 0xe8, 0xb7, 0xa7, 0xb7, 0xcf, 0x4a, 0x8f, 0x59, 0xd4, 0x3c, 0x93, 0xf7, 0xc0, 0xca,
-	0xdb, 0x41, 0xbe, 0xee, 0x9a, 0x15, 0xec, 0x6f, 0xb2, 0x35, 0xff, 0x2f, 0x8f, 0xc5, 0xce, 0x7e,
-	0x84, 0x95, 0xb5, 0xff, 0x6d, 0x52, 0xf8, 0xb9, 0xa4, 0xfd, 0x34, 0xff, 0xe2, 0xce, 0x7c, 0xc5,
-	0xdd, 0xc5, 0x8a, 0xbb, 0x9f, 0x2b, 0xee, 0xbe, 0xad, 0xb9, 0xb3, 0x58, 0x73, 0xe7, 0x7d, 0xcd,
-	0x9d, 0xe7, 0x56, 0x18, 0x99, 0xde, 0xa8, 0x23, 0x02, 0x8a, 0xa5, 0x35, 0x4d, 0xd0, 0x4c, 0x48,
-	0xf5, 0xf3, 0xdb, 0x45, 0x40, 0x0a, 0xe5, 0x74, 0x5b, 0xae, 0x99, 0xa5, 0xa8, 0x3b, 0x85, 0xac,
-	0xd4, 0xd6, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x54, 0x13, 0x2b, 0xca, 0x01, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x26, 0x2d, 0xca, 0x86, 0x96, 0x62, 0x12, 0x14, 0x02, 0x38, 0x60, 0x24, 0x88,
+	0x90, 0x6a, 0xd3, 0x84, 0x0b, 0xe2, 0x66, 0x10, 0xa8, 0x08, 0xa4, 0xb0, 0x37, 0xb8, 0xa0, 0x8d,
+	0xbd, 0x75, 0xac, 0x38, 0x5e, 0xd7, 0xbb, 0x6e, 0x93, 0x3b, 0x0f, 0xc0, 0x23, 0x70, 0xe6, 0x49,
+	0x72, 0xec, 0x11, 0x21, 0x51, 0x20, 0x79, 0x11, 0xb4, 0x7f, 0x9c, 0x38, 0x55, 0x22, 0x72, 0xe1,
+	0xe4, 0xf5, 0xec, 0x7c, 0x33, 0xbf, 0xdd, 0x6f, 0x6c, 0x70, 0x1f, 0x8d, 0x70, 0x88, 0x12, 0x3b,
+	0xc1, 0x67, 0x28, 0xf1, 0xec, 0xd3, 0xc3, 0x1e, 0x66, 0xe8, 0xd0, 0x3e, 0x49, 0x71, 0x32, 0xb6,
+	0xe2, 0x84, 0x30, 0xa2, 0xd7, 0x64, 0x8a, 0x25, 0x53, 0x2c, 0x95, 0xd2, 0xa8, 0xfa, 0xc4, 0x27,
+	0x22, 0xc3, 0xe6, 0x2b, 0x99, 0xdc, 0x30, 0x5c, 0x42, 0x87, 0x84, 0xda, 0x3d, 0x44, 0xf1, 0xbc,
+	0x9a, 0x4b, 0x82, 0x48, 0xed, 0x9b, 0xab, 0xfb, 0xc5, 0x28, 0x41, 0x43, 0xaa, 0x72, 0xd6, 0x30,
+	0xb1, 0x71, 0x8c, 0xb3, 0x94, 0xc7, 0xf9, 0x36, 0x02, 0x36, 0x57, 0xca, 0x0f, 0x22, 0xc4, 0x02,
+	0xa2, 0x5a, 0x9a, 0x4f, 0x41, 0xf5, 0x28, 0x3a, 0x0e, 0x45, 0x08, 0x22, 0x86, 0x21, 0x3e, 0x49,
+	0x31, 0x65, 0xfa, 0x1d, 0x50, 0x3e, 0x45, 0x61, 0xe0, 0x21, 0x46, 0x92, 0xba, 0x76, 0x4f, 0x6b,
+	0x95, 0xe1, 0x22, 0x60, 0xba, 0xa0, 0x76, 0x49, 0x45, 0x63, 0x12, 0x51, 0xac, 0xbf, 0x01, 0x7b,
+	0x41, 0xb6, 0xf1, 0x29, 0x41, 0x0c, 0x0b, 0xed, 0x55, 0xe7, 0xc1, 0xe4, 0xa2, 0x59, 0xf8, 0x71,
+	0xd1, 0xbc, 0x2d, 0xd1, 0xa8, 0x37, 0xb0, 0x02, 0x62, 0x0f, 0x11, 0xeb, 0x5b, 0x6f, 0xb1, 0x8f,
+	0xdc, 0xf1, 0x4b, 0xec, 0xc2, 0xdd, 0x20, 0x5f, 0xd3, 0xbc, 0x06, 0x76, 0xbb, 0xe2, 0xe4, 0x8a,
+	0xc9, 0x7c, 0x07, 0xf6, 0xb2, 0x80, 0x6a, 0xf7, 0x1c, 0xec, 0xc8, 0xcb, 0x11, 0x6d, 0x2a, 0xed,
+	0xbb, 0xd6, 0x4a, 0x3b, 0x2c, 0x29, 0x73, 0x4a, 0x9c, 0x02, 0x2a, 0x89, 0x79, 0x04, 0x6a, 0x5d,
+	0x1c, 0x79, 0x41, 0xe4, 0x43, 0x91, 0x4d, 0x37, 0x3a, 0xbb, 0xae, 0x83, 0x52, 0x4c, 0x48, 0x58,
+	0xdf, 0x12, 0x1b, 0x62, 0x6d, 0xfe, 0xdc, 0x02, 0x37, 0x2f, 0xd7, 0x52, 0x88, 0x1f, 0xc0, 0x15,
+	0x09, 0xc3, 0x19, 0x8b, 0xad, 0x4a, 0xfb, 0xd9, 0x3a, 0xc6, 0x95, 0x7a, 0xab, 0x4b, 0x48, 0xa8,
+	0x62, 0x8a, 0x3f, 0xab, 0xa7, 0x23, 0xb0, 0xcd, 0x08, 0x43, 0x1c, 0x85, 0x17, 0xbe, 0x65, 0xc9,
+	0xcb, 0xb5, 0xb8, 0xef, 0xf3, 0xb2, 0x2f, 0x48, 0x10, 0x39, 0x4f, 0xb8, 0xf0, 0xdb, 0xaf, 0x66,
+	0xcb, 0x0f, 0x58, 0x3f, 0xed, 0x59, 0x2e, 0x19, 0xda, 0x6a, 0x48, 0xe4, 0xe3, 0x80, 0x7a, 0x03,
+	0x35, 0x43, 0x5c, 0x40, 0xa1, 0xac, 0xdc, 0xf8, 0xac, 0x81, 0x4a, 0x8e, 0x60, 0x7e, 0x78, 0x6d,
+	0x71, 0x78, 0x8e, 0xc1, 0x67, 0x98, 0xfe, 0x17, 0x0c, 0x51, 0xd9, 0x1c, 0x81, 0xfd, 0x2e, 0x8e,
+	0x50, 0xc8, 0x02, 0xbc, 0xa1, 0x4b, 0xaf, 0x00, 0x58, 0xcc, 0xba, 0xf0, 0xaa, 0xd2, 0x7e, 0xb8,
+	0x44, 0x26, 0xbf, 0xe2, 0xc5, 0x84, 0xf8, 0xd9, 0xec, 0xc3, 0x9c, 0xd2, 0xfc, 0xaa, 0x81, 0xeb,
+	0xb9, 0xd6, 0xca, 0x54, 0x07, 0x94, 0xe3, 0x2c, 0xa8, 0x6c, 0x35, 0xd6, 0xdb, 0x8a, 0x42, 0x36,
+	0x56, 0xde, 0x2d, 0x64, 0xfa, 0xeb, 0x15, 0x84, 0x8f, 0xfe, 0x49, 0x28, 0x01, 0x96, 0x10, 0x3b,
+	0xe0, 0x06, 0xc4, 0xc7, 0x69, 0xe4, 0x39, 0xa9, 0xe7, 0x63, 0xb6, 0xd9, 0x17, 0x0c, 0x41, 0x75,
+	0x59, 0xa4, 0x4e, 0x56, 0x05, 0xdb, 0x38, 0x26, 0x6e, 0x5f, 0x28, 0x8a, 0x50, 0xbe, 0x70, 0xdb,
+	0x53, 0x8a, 0x3d, 0x41, 0x59, 0x82, 0x62, 0xad, 0xef, 0x83, 0xe2, 0x10, 0x8d, 0xea, 0x45, 0x11,
+	0xe2, 0x4b, 0xe7, 0xfd, 0xe4, 0x8f, 0x51, 0x98, 0x4c, 0x0d, 0xed, 0x7c, 0x6a, 0x68, 0xbf, 0xa7,
+	0x86, 0xf6, 0x65, 0x66, 0x14, 0xce, 0x67, 0x46, 0xe1, 0xfb, 0xcc, 0x28, 0x7c, 0xec, 0xe4, 0x4c,
+	0x97, 0x57, 0x15, 0x61, 0x76, 0x46, 0x92, 0x81, 0x7a, 0x3b, 0x70, 0x49, 0x82, 0xed, 0x51, 0xf6,
+	0x63, 0x13, 0x53, 0xd0, 0xdb, 0x11, 0x7f, 0xa9, 0xce, 0xdf, 0x01, 0x00, 0x25, 0x39, 0x0f, 0x53,
+	0x8a, 0x05, 0x00, 0x00,
 }
 
 func (m *InflationRateRequest) Marshal() (dAtA []byte, err error) {
@@ -329,68 +633,1013 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InflationRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingRewardsResponse_PoolRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRewardsResponse_PoolRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewardsResponse_PoolRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PenaltiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Penalties) > 0 {
+		for iNdEx := len(m.Penalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingRewardsResponse_PoolRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PenaltiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PenaltiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Used != 0 {
+		n += 1 + sovQuery(uint64(m.Used))
+	}
+	if m.Max != 0 {
+		n += 1 + sovQuery(uint64(m.Max))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, PendingRewardsResponse_PoolRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingRewardsResponse_PoolRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -413,15 +1662,15 @@ func (m *InflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -449,7 +1698,41 @@ func (m *InflationRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -472,7 +1755,7 @@ func (m *InflationRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InflationRateResponse) Unmarshal(dAtA []byte) error {
+func (m *PenaltiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -495,17 +1778,17 @@ func (m *InflationRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PenaltiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PenaltiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -515,22 +1798,57 @@ func (m *InflationRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -555,7 +1873,7 @@ func (m *InflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PenaltiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -578,12 +1896,82 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PenaltiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PenaltiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalties = append(m.Penalties, Penalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -605,7 +1993,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *RefundBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -628,17 +2016,17 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefundBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefundBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -648,25 +2036,131 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// type URLs of the messages vald sends wrapped in a RefundMsgRequest
+const (
+	voteRequestTypeURL            = "/axelar.vote.v1beta1.VoteRequest"
+	submitPubKeyRequestTypeURL    = "/axelar.multisig.v1beta1.SubmitPubKeyRequest"
+	submitSignatureRequestTypeURL = "/axelar.multisig.v1beta1.SubmitSignatureRequest"
+)
+
+// DefaultRefundPolicy returns the default refund policy, which refunds all messages sent by vald without any limits
+func DefaultRefundPolicy() RefundPolicy {
+	return RefundPolicy{
+		RefundableMsgs: []RefundableMsg{
+			{MsgTypeURL: voteRequestTypeURL},
+			{MsgTypeURL: submitPubKeyRequestTypeURL},
+			{MsgTypeURL: submitSignatureRequestTypeURL},
+		},
+		EpochLength:        100,
+		MaxRefundsPerEpoch: 0,
+	}
+}
+
+// GetRefundableMsg returns the refund configuration of the given message type, and false if it is not refundable
+func (m RefundPolicy) GetRefundableMsg(msgTypeURL string) (RefundableMsg, bool) {
+	for _, msg := range m.RefundableMsgs {
+		if msg.MsgTypeURL == msgTypeURL {
+			return msg, true
+		}
+	}
+
+	return RefundableMsg{}, false
+}
+
+// Epoch returns the refund epoch the given block height belongs to
+func (m RefundPolicy) Epoch(height int64) int64 {
+	return height / m.EpochLength
+}
+
+// ValidateBasic returns an error if the refund policy is invalid; nil otherwise
+func (m RefundPolicy) ValidateBasic() error {
+	if m.EpochLength <= 0 {
+		return fmt.Errorf("refund epoch length must be >0")
+	}
+
+	seen := make(map[string]bool)
+	for _, msg := range m.RefundableMsgs {
+		if msg.MsgTypeURL == "" {
+			return fmt.Errorf("refundable message type URL must not be empty")
+		}
+
+		if seen[msg.MsgTypeURL] {
+			return fmt.Errorf("duplicate refundable message type %s", msg.MsgTypeURL)
+		}
+		seen[msg.MsgTypeURL] = true
+	}
+
+	return nil
+}
+
+// NewRefundBudget returns an unused refund budget of the given validator in the given epoch
+func NewRefundBudget(validator sdk.ValAddress, epoch int64) RefundBudget {
+	return RefundBudget{
+		Validator: validator,
+		Epoch:     epoch,
+		Used:      0,
+	}
+}
+
+// ValidateBasic returns an error if the refund budget is invalid; nil otherwise
+func (m RefundBudget) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+		return err
+	}
+
+	if m.Epoch < 0 {
+		return fmt.Errorf("refund epoch must be >=0")
+	}
+
+	return nil
+}
@@ -37,34 +37,41 @@ func init() {
 }
 
 var fileDescriptor_fd7e16fa610c528d = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0x87, 0xeb, 0x0e, 0x57, 0xc2, 0xba, 0x2c, 0x16, 0x2c, 0x15, 0x18, 0x11, 0xa8, 0xee, 0x55,
-	0x81, 0x58, 0xbd, 0x77, 0xbb, 0x23, 0x1b, 0xc3, 0x45, 0xb4, 0xc0, 0x52, 0x21, 0x55, 0x4e, 0x73,
-	0x6a, 0x22, 0xd2, 0x38, 0xb5, 0x9d, 0xfe, 0x11, 0xb0, 0xf0, 0x04, 0x20, 0x5e, 0x85, 0x07, 0x60,
-	0x64, 0x42, 0x95, 0x18, 0x60, 0x41, 0x42, 0x0d, 0x0f, 0x82, 0xea, 0x38, 0x88, 0x48, 0x09, 0xed,
-	0x96, 0xe8, 0x7c, 0xe7, 0x9c, 0xcf, 0x3f, 0xcb, 0xf8, 0x0e, 0x5f, 0x41, 0xcc, 0x15, 0x53, 0xb0,
-	0xe4, 0x2a, 0x64, 0x8b, 0x7e, 0x00, 0x86, 0xf7, 0x99, 0x06, 0xb5, 0x88, 0x26, 0xe0, 0xa7, 0x4a,
-	0x1a, 0x49, 0xae, 0x17, 0x90, 0x5f, 0x40, 0xbe, 0x83, 0x3a, 0xd7, 0x84, 0x14, 0xd2, 0x12, 0x6c,
-	0xf7, 0x55, 0xc0, 0x9d, 0x1b, 0x42, 0x4a, 0x11, 0x03, 0xe3, 0x69, 0xc4, 0x78, 0x92, 0x48, 0xc3,
-	0x4d, 0x24, 0x13, 0xed, 0xaa, 0xb4, 0x7e, 0x9f, 0x59, 0xb9, 0xfa, 0xed, 0xfa, 0xfa, 0x3c, 0x03,
-	0xb5, 0x2e, 0x90, 0xb3, 0xef, 0x6d, 0x8c, 0x2f, 0xb5, 0x78, 0x5a, 0x28, 0x92, 0x4f, 0x08, 0x5f,
-	0x19, 0xc2, 0x34, 0x4b, 0xc2, 0x4b, 0x2d, 0xc8, 0x89, 0x5f, 0xeb, 0xea, 0xff, 0x25, 0x86, 0x30,
-	0xcf, 0x40, 0x9b, 0xce, 0xe9, 0x7e, 0x50, 0xa7, 0x32, 0xd1, 0xe0, 0x3d, 0x7b, 0xf7, 0xed, 0xf7,
-	0xc7, 0xf6, 0xe3, 0x0b, 0xd4, 0x1b, 0x79, 0x17, 0xa8, 0xe7, 0xdd, 0x64, 0x55, 0x47, 0x65, 0x3b,
-	0xc6, 0x33, 0xd0, 0x9a, 0x0b, 0xf0, 0xba, 0xac, 0xfe, 0x08, 0x55, 0x8c, 0x7c, 0x40, 0xf8, 0xf8,
-	0x79, 0x1a, 0x72, 0x03, 0x4f, 0xb8, 0xe2, 0x33, 0x4d, 0x7a, 0x0d, 0x42, 0xff, 0x42, 0xa5, 0xfc,
-	0xbd, 0x83, 0x58, 0xe7, 0x7f, 0x6a, 0xfd, 0x6b, 0xd5, 0x4b, 0xb7, 0xd4, 0x76, 0x9c, 0xfd, 0x6c,
-	0xe3, 0xe3, 0xc1, 0x2e, 0xe9, 0x32, 0xdb, 0xaf, 0x08, 0x5f, 0x7d, 0x94, 0x4c, 0x63, 0x7b, 0x85,
-	0x43, 0x6e, 0x80, 0x34, 0x6d, 0xae, 0x50, 0xa5, 0xe6, 0xfd, 0xc3, 0x60, 0xe7, 0x19, 0x58, 0xcf,
-	0x17, 0xa3, 0x13, 0xd2, 0x14, 0x61, 0x54, 0xf6, 0x8d, 0xd5, 0xce, 0xa5, 0x7f, 0x10, 0xc6, 0x5e,
-	0x2f, 0x78, 0x1c, 0x85, 0xdc, 0x48, 0xf5, 0x96, 0xbc, 0xc1, 0x47, 0x2e, 0xee, 0xbb, 0x0d, 0x6e,
-	0xd5, 0xa0, 0xbb, 0x7b, 0x28, 0xa7, 0xde, 0xb5, 0xea, 0xb7, 0xc8, 0xff, 0xf3, 0x7d, 0x38, 0xf8,
-	0xb2, 0xa5, 0x68, 0xb3, 0xa5, 0xe8, 0xd7, 0x96, 0xa2, 0xf7, 0x39, 0x6d, 0x7d, 0xce, 0x29, 0xda,
-	0xe4, 0xb4, 0xf5, 0x23, 0xa7, 0xad, 0xd1, 0xb9, 0x88, 0xcc, 0xcb, 0x2c, 0xf0, 0x27, 0x72, 0xe6,
-	0xc6, 0x24, 0x60, 0x96, 0x52, 0xbd, 0x72, 0x7f, 0x0f, 0x26, 0x52, 0x01, 0x5b, 0x95, 0xb3, 0xcd,
-	0x3a, 0x05, 0x1d, 0x1c, 0xd9, 0x37, 0x71, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xba, 0x0e, 0x20,
-	0x86, 0xc8, 0x03, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x1d, 0x22, 0xf5, 0x14, 0x18, 0x4e, 0xb0, 0x44, 0x60, 0x20, 0x10, 0xb5, 0x0a,
+	0xad, 0x8f, 0xa4, 0x0c, 0x28, 0x63, 0x37, 0x86, 0xa2, 0x34, 0x80, 0x84, 0x22, 0xa4, 0xe8, 0x12,
+	0xbf, 0x1e, 0x16, 0xc9, 0x9d, 0x7b, 0x77, 0x49, 0x53, 0x01, 0x0b, 0x9f, 0x00, 0xc4, 0x27, 0x60,
+	0x64, 0x67, 0x60, 0x64, 0x64, 0x42, 0x95, 0x18, 0x60, 0x44, 0x09, 0x03, 0x1f, 0xa3, 0xca, 0xf9,
+	0x5c, 0xc5, 0x92, 0x5d, 0x67, 0xb3, 0x7d, 0xbf, 0xe7, 0xf7, 0x7b, 0x7f, 0xeb, 0x19, 0xdf, 0x65,
+	0x33, 0x18, 0x31, 0x45, 0x15, 0x9c, 0x30, 0x15, 0xd0, 0x69, 0x73, 0x00, 0x86, 0x35, 0xa9, 0x06,
+	0x35, 0x0d, 0x87, 0xe0, 0x47, 0x4a, 0x1a, 0x49, 0xae, 0xc7, 0x90, 0x1f, 0x43, 0xbe, 0x83, 0xaa,
+	0xd7, 0xb8, 0xe4, 0xd2, 0x12, 0x74, 0x79, 0x15, 0xc3, 0xd5, 0x1b, 0x5c, 0x4a, 0x3e, 0x02, 0xca,
+	0xa2, 0x90, 0x32, 0x21, 0xa4, 0x61, 0x26, 0x94, 0x42, 0xbb, 0x53, 0x2f, 0xbb, 0x9f, 0x99, 0xb9,
+	0xf3, 0x3b, 0xd9, 0xe7, 0xc7, 0x13, 0x50, 0xa7, 0x31, 0xd2, 0xfa, 0xbd, 0x81, 0xf1, 0x81, 0xe6,
+	0x4f, 0x63, 0x45, 0xf2, 0x15, 0xe1, 0xcd, 0x2e, 0x1c, 0x4d, 0x44, 0x70, 0xa0, 0x39, 0xd9, 0xf2,
+	0x33, 0x5d, 0xfd, 0x0b, 0xa2, 0x0b, 0xc7, 0x13, 0xd0, 0xa6, 0xba, 0x5d, 0x0c, 0xea, 0x48, 0x0a,
+	0x0d, 0xb5, 0x67, 0xef, 0x7f, 0xfd, 0xfb, 0xb4, 0xf1, 0xa4, 0x8d, 0x1a, 0xbd, 0x5a, 0x1b, 0x35,
+	0x6a, 0x37, 0x69, 0xda, 0x51, 0xd9, 0x8a, 0xfe, 0x18, 0xb4, 0x66, 0x1c, 0x6a, 0x75, 0x9a, 0x3d,
+	0x42, 0x1a, 0x23, 0x1f, 0x11, 0xae, 0x3c, 0x8f, 0x02, 0x66, 0xa0, 0xc3, 0x14, 0x1b, 0x6b, 0xd2,
+	0xc8, 0x11, 0x5a, 0x85, 0x12, 0xf9, 0xfb, 0x6b, 0xb1, 0xce, 0x7f, 0xdb, 0xfa, 0x67, 0xaa, 0x27,
+	0x6e, 0x91, 0xad, 0x68, 0xfd, 0x2f, 0xe3, 0xca, 0xe1, 0x32, 0xe9, 0x24, 0xdb, 0x9f, 0x08, 0x5f,
+	0x79, 0x2c, 0x8e, 0x46, 0xf6, 0x13, 0x76, 0x99, 0x01, 0x92, 0xd7, 0x39, 0x45, 0x25, 0x9a, 0x3b,
+	0xeb, 0xc1, 0xce, 0x73, 0x60, 0x3d, 0x5f, 0xf6, 0xb6, 0x48, 0x5e, 0x84, 0x61, 0x52, 0xd7, 0x57,
+	0x4b, 0x97, 0xe6, 0x5a, 0x18, 0x7d, 0x33, 0x65, 0xa3, 0x30, 0x60, 0x46, 0xaa, 0x77, 0xe4, 0x2d,
+	0x2e, 0xbb, 0xb8, 0xef, 0xe5, 0xb8, 0xa5, 0x83, 0xae, 0x17, 0x50, 0x4e, 0xbd, 0x6e, 0xd5, 0x6f,
+	0x91, 0xcb, 0xf3, 0x25, 0x5f, 0x10, 0xbe, 0xda, 0x01, 0x11, 0x84, 0x82, 0x77, 0x2d, 0xa0, 0x49,
+	0x5e, 0x44, 0x69, 0x2c, 0xd1, 0xd9, 0x5d, 0x93, 0x76, 0x5a, 0x6d, 0xab, 0xf5, 0x90, 0xb4, 0xf2,
+	0xb4, 0xe2, 0xb2, 0x7e, 0xfc, 0x58, 0xa7, 0x92, 0xfa, 0x86, 0xf0, 0x66, 0x07, 0x04, 0x1b, 0x99,
+	0x10, 0x74, 0xee, 0x5a, 0x5d, 0x10, 0x45, 0x6b, 0xb5, 0x02, 0x3a, 0xb9, 0x17, 0x56, 0xae, 0xdb,
+	0xab, 0x91, 0xdb, 0xf9, 0x7a, 0xae, 0xfd, 0x4e, 0x11, 0x91, 0x52, 0xff, 0x8c, 0x70, 0x25, 0x5e,
+	0xe3, 0xfd, 0x49, 0xc0, 0xc1, 0xe4, 0xae, 0xd6, 0x2a, 0x54, 0xb4, 0x5a, 0x69, 0xd6, 0xcd, 0xf0,
+	0xc8, 0xce, 0xd0, 0x22, 0x0f, 0x2e, 0xdf, 0xf9, 0x81, 0xad, 0x5a, 0x75, 0xdc, 0x3f, 0xfc, 0x31,
+	0xf7, 0xd0, 0xd9, 0xdc, 0x43, 0x7f, 0xe7, 0x1e, 0xfa, 0xb0, 0xf0, 0x4a, 0xdf, 0x17, 0x1e, 0x3a,
+	0x5b, 0x78, 0xa5, 0x3f, 0x0b, 0xaf, 0xd4, 0xdb, 0xe3, 0xa1, 0x79, 0x35, 0x19, 0xf8, 0x43, 0x39,
+	0x76, 0x6f, 0x16, 0x60, 0x4e, 0xa4, 0x7a, 0xed, 0xee, 0x76, 0x87, 0x52, 0x01, 0x9d, 0x25, 0xed,
+	0xcc, 0x69, 0x04, 0x7a, 0x50, 0xb6, 0xbf, 0xc7, 0xbd, 0xf3, 0x01, 0x00, 0xa1, 0x32, 0xdf, 0xa0,
+	0xd3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -189,6 +196,15 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 type QueryServiceClient interface {
 	InflationRate(ctx context.Context, in *InflationRateRequest, opts ...grpc.CallOption) (*InflationRateResponse, error)
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// PendingRewards returns the rewards a validator has accumulated in each
+	// reward pool that have not been released yet
+	PendingRewards(ctx context.Context, in *PendingRewardsRequest, opts ...grpc.CallOption) (*PendingRewardsResponse, error)
+	// Penalties returns the penalties imposed on validators for being absent as
+	// chain maintainers or keygen participants, including the evidence
+	Penalties(ctx context.Context, in *PenaltiesRequest, opts ...grpc.CallOption) (*PenaltiesResponse, error)
+	// RefundBudget returns the number of refunds a validator has claimed in the
+	// current refund epoch
+	RefundBudget(ctx context.Context, in *RefundBudgetRequest, opts ...grpc.CallOption) (*RefundBudgetResponse, error)
 }
 
 type queryServiceClient struct {
@@ -217,10 +233,46 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) PendingRewards(ctx context.Context, in *PendingRewardsRequest, opts ...grpc.CallOption) (*PendingRewardsResponse, error) {
+	out := new(PendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.reward.v1beta1.QueryService/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Penalties(ctx context.Context, in *PenaltiesRequest, opts ...grpc.CallOption) (*PenaltiesResponse, error) {
+	out := new(PenaltiesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.reward.v1beta1.QueryService/Penalties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) RefundBudget(ctx context.Context, in *RefundBudgetRequest, opts ...grpc.CallOption) (*RefundBudgetResponse, error) {
+	out := new(RefundBudgetResponse)
+	err := c.cc.Invoke(ctx, "/axelar.reward.v1beta1.QueryService/RefundBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	InflationRate(context.Context, *InflationRateRequest) (*InflationRateResponse, error)
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// PendingRewards returns the rewards a validator has accumulated in each
+	// reward pool that have not been released yet
+	PendingRewards(context.Context, *PendingRewardsRequest) (*PendingRewardsResponse, error)
+	// Penalties returns the penalties imposed on validators for being absent as
+	// chain maintainers or keygen participants, including the evidence
+	Penalties(context.Context, *PenaltiesRequest) (*PenaltiesResponse, error)
+	// RefundBudget returns the number of refunds a validator has claimed in the
+	// current refund epoch
+	RefundBudget(context.Context, *RefundBudgetRequest) (*RefundBudgetResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -233,6 +285,15 @@ func (*UnimplementedQueryServiceServer) InflationRate(ctx context.Context, req *
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServiceServer) PendingRewards(ctx context.Context, req *PendingRewardsRequest) (*PendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServiceServer) Penalties(ctx context.Context, req *PenaltiesRequest) (*PenaltiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Penalties not implemented")
+}
+func (*UnimplementedQueryServiceServer) RefundBudget(ctx context.Context, req *RefundBudgetRequest) (*RefundBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundBudget not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -274,6 +335,60 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.reward.v1beta1.QueryService/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).PendingRewards(ctx, req.(*PendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Penalties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PenaltiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Penalties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.reward.v1beta1.QueryService/Penalties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Penalties(ctx, req.(*PenaltiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_RefundBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).RefundBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.reward.v1beta1.QueryService/RefundBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).RefundBudget(ctx, req.(*RefundBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.reward.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -286,6 +401,18 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _QueryService_PendingRewards_Handler,
+		},
+		{
+			MethodName: "Penalties",
+			Handler:    _QueryService_Penalties_Handler,
+		},
+		{
+			MethodName: "RefundBudget",
+			Handler:    _QueryService_RefundBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/reward/v1beta1/service.proto",
@@ -18,6 +18,17 @@ func NewPool(name string) Pool {
 	}
 }
 
+// GetRewards returns the rewards of the given validator that have not been released yet
+func (m Pool) GetRewards(validator sdk.ValAddress) (sdk.Coins, bool) {
+	for _, reward := range m.Rewards {
+		if reward.Validator.Equals(validator) {
+			return reward.Coins, true
+		}
+	}
+
+	return sdk.Coins{}, false
+}
+
 // ValidateBasic returns an error if the Pool is not valid; nil otherwise
 func (m Pool) ValidateBasic() error {
 	if err := utils.ValidateString(m.Name); err != nil {
@@ -59,5 +70,9 @@ func (m Refund) ValidateBasic() error {
 		return err
 	}
 
+	if err := sdk.VerifyAddressFormat(m.Validator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, errorsmod.Wrap(err, "validator").Error())
+	}
+
 	return nil
 }
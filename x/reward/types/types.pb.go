@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_axelarnetwork_axelar_core_x_nexus_exported "github.com/axelarnetwork/axelar-core/x/nexus/exported"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,6 +26,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type PenaltyAction int32
+
+const (
+	PenaltyActionUnspecified PenaltyAction = 0
+	PenaltyActionDeregister  PenaltyAction = 1
+	PenaltyActionJail        PenaltyAction = 2
+)
+
+var PenaltyAction_name = map[int32]string{
+	0: "PENALTY_ACTION_UNSPECIFIED",
+	1: "PENALTY_ACTION_DEREGISTER",
+	2: "PENALTY_ACTION_JAIL",
+}
+
+var PenaltyAction_value = map[string]int32{
+	"PENALTY_ACTION_UNSPECIFIED": 0,
+	"PENALTY_ACTION_DEREGISTER":  1,
+	"PENALTY_ACTION_JAIL":        2,
+}
+
+func (x PenaltyAction) String() string {
+	return proto.EnumName(PenaltyAction_name, int32(x))
+}
+
+func (PenaltyAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4523777bf7a8dc5, []int{0}
+}
+
+type PenaltyReason int32
+
+const (
+	PenaltyReasonUnspecified      PenaltyReason = 0
+	PenaltyReasonMissedVotes      PenaltyReason = 1
+	PenaltyReasonIncorrectVotes   PenaltyReason = 2
+	PenaltyReasonMissedSignatures PenaltyReason = 3
+)
+
+var PenaltyReason_name = map[int32]string{
+	0: "PENALTY_REASON_UNSPECIFIED",
+	1: "PENALTY_REASON_MISSED_VOTES",
+	2: "PENALTY_REASON_INCORRECT_VOTES",
+	3: "PENALTY_REASON_MISSED_SIGNATURES",
+}
+
+var PenaltyReason_value = map[string]int32{
+	"PENALTY_REASON_UNSPECIFIED":       0,
+	"PENALTY_REASON_MISSED_VOTES":      1,
+	"PENALTY_REASON_INCORRECT_VOTES":   2,
+	"PENALTY_REASON_MISSED_SIGNATURES": 3,
+}
+
+func (x PenaltyReason) String() string {
+	return proto.EnumName(PenaltyReason_name, int32(x))
+}
+
+func (PenaltyReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4523777bf7a8dc5, []int{1}
+}
+
 type Pool struct {
 	Name    string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Rewards []Pool_Reward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
@@ -104,6 +164,8 @@ var xxx_messageInfo_Pool_Reward proto.InternalMessageInfo
 type Refund struct {
 	Payer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=payer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payer,omitempty"`
 	Fees  github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// validator whose refund budget is charged when the refund is paid out
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,3,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
 }
 
 func (m *Refund) Reset()         { *m = Refund{} }
@@ -139,39 +201,157 @@ func (m *Refund) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Refund proto.InternalMessageInfo
 
+// RefundBudget tracks the refunds a validator has claimed in a refund epoch
+type RefundBudget struct {
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	Epoch     int64                                         `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Used      uint64                                        `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (m *RefundBudget) Reset()         { *m = RefundBudget{} }
+func (m *RefundBudget) String() string { return proto.CompactTextString(m) }
+func (*RefundBudget) ProtoMessage()    {}
+func (*RefundBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4523777bf7a8dc5, []int{2}
+}
+func (m *RefundBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundBudget.Merge(m, src)
+}
+func (m *RefundBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundBudget proto.InternalMessageInfo
+
+// Penalty records a validator being penalized together with the evidence
+// that led to it
+type Penalty struct {
+	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
+	Action    PenaltyAction                                 `protobuf:"varint,2,opt,name=action,proto3,enum=axelar.reward.v1beta1.PenaltyAction" json:"action,omitempty"`
+	Reason    PenaltyReason                                 `protobuf:"varint,3,opt,name=reason,proto3,enum=axelar.reward.v1beta1.PenaltyReason" json:"reason,omitempty"`
+	// chain the validator maintains, empty for missed signatures
+	Chain  github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName `protobuf:"bytes,4,opt,name=chain,proto3,casttype=github.com/axelarnetwork/axelar-core/x/nexus/exported.ChainName" json:"chain,omitempty"`
+	Height int64                                                           `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// number of missed or incorrect votes or signatures within the window
+	Count uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// number of polls or signing sessions that were checked
+	Window uint64 `protobuf:"varint,7,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *Penalty) Reset()         { *m = Penalty{} }
+func (m *Penalty) String() string { return proto.CompactTextString(m) }
+func (*Penalty) ProtoMessage()    {}
+func (*Penalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4523777bf7a8dc5, []int{3}
+}
+func (m *Penalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Penalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Penalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Penalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Penalty.Merge(m, src)
+}
+func (m *Penalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Penalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Penalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Penalty proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("axelar.reward.v1beta1.PenaltyAction", PenaltyAction_name, PenaltyAction_value)
+	proto.RegisterEnum("axelar.reward.v1beta1.PenaltyReason", PenaltyReason_name, PenaltyReason_value)
 	proto.RegisterType((*Pool)(nil), "axelar.reward.v1beta1.Pool")
 	proto.RegisterType((*Pool_Reward)(nil), "axelar.reward.v1beta1.Pool.Reward")
 	proto.RegisterType((*Refund)(nil), "axelar.reward.v1beta1.Refund")
+	proto.RegisterType((*RefundBudget)(nil), "axelar.reward.v1beta1.RefundBudget")
+	proto.RegisterType((*Penalty)(nil), "axelar.reward.v1beta1.Penalty")
 }
 
 func init() { proto.RegisterFile("axelar/reward/v1beta1/types.proto", fileDescriptor_a4523777bf7a8dc5) }
 
 var fileDescriptor_a4523777bf7a8dc5 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x3d, 0x52, 0xc2, 0x40,
-	0x14, 0xc7, 0x13, 0x0c, 0x38, 0xac, 0x56, 0x3b, 0x3a, 0x83, 0x14, 0x0b, 0x52, 0xd1, 0xb0, 0x11,
-	0x39, 0x01, 0xb1, 0xb0, 0x54, 0x53, 0x58, 0xd8, 0x38, 0x9b, 0x64, 0xc1, 0x0c, 0x21, 0x8f, 0xd9,
-	0x0d, 0x5f, 0xb7, 0xf0, 0x02, 0x5e, 0xc0, 0xd2, 0xda, 0x03, 0x50, 0x52, 0x5a, 0xa1, 0xc2, 0x2d,
-	0xac, 0x9c, 0xec, 0x26, 0x68, 0x61, 0xa1, 0x85, 0x55, 0x36, 0xd9, 0xff, 0xc7, 0xef, 0x4d, 0x1e,
-	0x3a, 0x66, 0x33, 0x1e, 0x31, 0x61, 0x0b, 0x3e, 0x65, 0x22, 0xb0, 0x27, 0x6d, 0x8f, 0x27, 0xac,
-	0x6d, 0x27, 0xf3, 0x11, 0x97, 0x74, 0x24, 0x20, 0x01, 0x7c, 0xa8, 0x25, 0x54, 0x4b, 0x68, 0x26,
-	0xa9, 0x1e, 0xf4, 0xa1, 0x0f, 0x4a, 0x61, 0xa7, 0x27, 0x2d, 0xae, 0x12, 0x1f, 0xe4, 0x10, 0xa4,
-	0xed, 0x31, 0xc9, 0xb7, 0x69, 0x3e, 0x84, 0xb1, 0xbe, 0x6f, 0x3c, 0x14, 0x90, 0x75, 0x09, 0x10,
-	0x61, 0x8c, 0xac, 0x98, 0x0d, 0x79, 0xc5, 0xac, 0x9b, 0xcd, 0xb2, 0xab, 0xce, 0xd8, 0x41, 0xbb,
-	0xba, 0x44, 0x56, 0x0a, 0xf5, 0x9d, 0xe6, 0xde, 0x69, 0x83, 0xfe, 0xd8, 0x4d, 0xd3, 0x04, 0xea,
-	0xaa, 0x6f, 0x8e, 0xb5, 0x58, 0xd5, 0x0c, 0x37, 0x37, 0x56, 0x9f, 0x4d, 0x54, 0xd2, 0x37, 0xf8,
-	0x02, 0x95, 0x27, 0x2c, 0x0a, 0x03, 0x96, 0x80, 0x50, 0x3d, 0xfb, 0x4e, 0xfb, 0x63, 0x55, 0x6b,
-	0xf5, 0xc3, 0xe4, 0x6e, 0xec, 0x51, 0x1f, 0x86, 0x76, 0x46, 0xab, 0x1f, 0x2d, 0x19, 0x0c, 0xb2,
-	0xc9, 0xaf, 0x59, 0xd4, 0x0d, 0x02, 0xc1, 0xa5, 0x74, 0xbf, 0x32, 0x30, 0x43, 0xc5, 0x74, 0x94,
-	0x9c, 0xee, 0x88, 0x6a, 0x1f, 0x4d, 0x87, 0xdd, 0xb2, 0x9d, 0x41, 0x18, 0x3b, 0x27, 0x29, 0xd4,
-	0xe3, 0x6b, 0xad, 0xf9, 0x8b, 0xae, 0xd4, 0x20, 0x5d, 0x9d, 0xdc, 0x78, 0x52, 0xf8, 0xbd, 0x71,
-	0x1c, 0xe0, 0x73, 0x54, 0x1c, 0xb1, 0x39, 0xff, 0x2b, 0x7a, 0xd7, 0xf7, 0x73, 0x74, 0xed, 0xc7,
-	0xb7, 0xc8, 0xea, 0x71, 0xfe, 0x2f, 0xd4, 0x2a, 0xd8, 0xb9, 0x5a, 0xbc, 0x13, 0x63, 0xb1, 0x26,
-	0xe6, 0x72, 0x4d, 0xcc, 0xb7, 0x35, 0x31, 0xef, 0x37, 0xc4, 0x58, 0x6e, 0x88, 0xf1, 0xb2, 0x21,
-	0xc6, 0x4d, 0xe7, 0x5b, 0x9a, 0xfe, 0x9d, 0x31, 0x4f, 0xa6, 0x20, 0x06, 0xd9, 0x5b, 0xcb, 0x07,
-	0xc1, 0xed, 0x59, 0xbe, 0x82, 0x2a, 0xde, 0x2b, 0xa9, 0x75, 0xe9, 0x7c, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x44, 0x11, 0x7b, 0xa0, 0x02, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0x63, 0xe7, 0x4f, 0xd9, 0x61, 0x17, 0x05, 0xb3, 0x0b, 0x5e, 0x17, 0x1c, 0x6f, 0xc4,
+	0x21, 0x5a, 0xa9, 0x36, 0xdd, 0xbd, 0xa1, 0x45, 0xc8, 0x71, 0x4c, 0xe4, 0xaa, 0x4d, 0xc2, 0x38,
+	0xad, 0x54, 0x2e, 0xd1, 0xc4, 0x9e, 0x26, 0x56, 0x13, 0x4f, 0xe4, 0x99, 0x34, 0xe9, 0x99, 0x0b,
+	0xe4, 0xc4, 0x17, 0xc8, 0x89, 0x1e, 0x10, 0x9f, 0x81, 0x1b, 0x97, 0x1e, 0x7b, 0xe4, 0x54, 0xa0,
+	0xfd, 0x10, 0x48, 0x3d, 0x21, 0x7b, 0xdc, 0x3f, 0x89, 0x02, 0x2a, 0xa2, 0x7b, 0xf2, 0xbc, 0xf6,
+	0xfb, 0x7b, 0xe6, 0x79, 0x5e, 0x8f, 0x65, 0xf0, 0x02, 0x4d, 0xf1, 0x00, 0x45, 0x46, 0x84, 0x27,
+	0x28, 0xf2, 0x8d, 0xa3, 0xcd, 0x2e, 0x66, 0x68, 0xd3, 0x60, 0xc7, 0x23, 0x4c, 0xf5, 0x51, 0x44,
+	0x18, 0x91, 0x9e, 0xf1, 0x16, 0x9d, 0xb7, 0xe8, 0x69, 0x8b, 0xf2, 0xb4, 0x47, 0x7a, 0x24, 0xe9,
+	0x30, 0xe2, 0x15, 0x6f, 0x56, 0x54, 0x8f, 0xd0, 0x21, 0xa1, 0x46, 0x17, 0x51, 0x7c, 0xa3, 0xe6,
+	0x91, 0x20, 0xe4, 0xcf, 0xcb, 0x73, 0x11, 0xe4, 0x5a, 0x84, 0x0c, 0x24, 0x09, 0xe4, 0x42, 0x34,
+	0xc4, 0xb2, 0xa0, 0x09, 0x95, 0x47, 0x30, 0x59, 0x4b, 0x55, 0xb0, 0xc6, 0x37, 0xa1, 0xb2, 0xa8,
+	0x65, 0x2b, 0xef, 0xbe, 0x2a, 0xeb, 0x2b, 0xf7, 0xd6, 0x63, 0x05, 0x1d, 0x26, 0xf7, 0xaa, 0xb9,
+	0xd3, 0xf3, 0x52, 0x06, 0x5e, 0x83, 0xca, 0x2f, 0x02, 0x28, 0xf0, 0x27, 0x52, 0x13, 0x3c, 0x3a,
+	0x42, 0x83, 0xc0, 0x47, 0x8c, 0x44, 0xc9, 0x3e, 0x8f, 0xab, 0x9b, 0x57, 0xe7, 0xa5, 0x8d, 0x5e,
+	0xc0, 0xfa, 0xe3, 0xae, 0xee, 0x91, 0xa1, 0x91, 0xba, 0xe5, 0x97, 0x0d, 0xea, 0x1f, 0xa6, 0xc9,
+	0xf7, 0xd0, 0xc0, 0xf4, 0xfd, 0x08, 0x53, 0x0a, 0x6f, 0x35, 0x24, 0x04, 0xf2, 0x71, 0x94, 0x6b,
+	0x77, 0xcf, 0x75, 0xce, 0xe9, 0x71, 0xd8, 0x1b, 0x6f, 0x16, 0x09, 0xc2, 0xea, 0x67, 0xb1, 0xa9,
+	0x9f, 0x7f, 0x2f, 0x55, 0xee, 0xb1, 0x57, 0x0c, 0x50, 0xc8, 0x95, 0xcb, 0xdf, 0x8a, 0xb1, 0xfd,
+	0x83, 0x71, 0xe8, 0x4b, 0x75, 0x90, 0x1f, 0xa1, 0x63, 0xfc, 0x5f, 0xad, 0x9b, 0x9e, 0x77, 0x6d,
+	0x9d, 0xf3, 0x52, 0x07, 0xe4, 0x0e, 0x30, 0x7e, 0x2b, 0xae, 0x13, 0xe1, 0xc5, 0x41, 0x67, 0xff,
+	0xff, 0xa0, 0xcb, 0xdf, 0x0b, 0xe0, 0x31, 0x9f, 0x42, 0x75, 0xec, 0xf7, 0x30, 0x7b, 0xf8, 0x57,
+	0xf9, 0x14, 0xe4, 0xf1, 0x88, 0x78, 0x7d, 0x59, 0xd4, 0x84, 0x4a, 0x16, 0xf2, 0x22, 0x3e, 0x94,
+	0x63, 0x8a, 0xfd, 0x24, 0x43, 0x0e, 0x26, 0xeb, 0xf2, 0x5f, 0x22, 0x58, 0x6b, 0xe1, 0x10, 0x0d,
+	0xd8, 0xf1, 0xc3, 0xdb, 0x78, 0x03, 0x0a, 0xc8, 0x63, 0x01, 0x09, 0x13, 0x1f, 0xef, 0xbd, 0xfa,
+	0xf4, 0x9f, 0x0e, 0x3c, 0x37, 0x60, 0x26, 0xbd, 0x30, 0x65, 0x62, 0x3a, 0xc2, 0x88, 0x92, 0x50,
+	0xce, 0xde, 0x87, 0x86, 0x49, 0x2f, 0x4c, 0x19, 0x69, 0x1f, 0xe4, 0xbd, 0x3e, 0x0a, 0x42, 0x39,
+	0x17, 0x7f, 0x82, 0x55, 0xeb, 0xea, 0xbc, 0xf4, 0xe5, 0x9d, 0x20, 0x5c, 0x2a, 0xc4, 0x6c, 0x42,
+	0xa2, 0xc3, 0xb4, 0xda, 0xf0, 0x48, 0x84, 0x8d, 0xa9, 0x11, 0xe2, 0xe9, 0x98, 0x1a, 0x78, 0x3a,
+	0x22, 0x11, 0xc3, 0xbe, 0x6e, 0xc5, 0x32, 0x0d, 0x34, 0xc4, 0x90, 0x2b, 0x4a, 0x1f, 0x82, 0x42,
+	0x1f, 0x07, 0xbd, 0x3e, 0x93, 0xf3, 0xc9, 0x78, 0xd3, 0x2a, 0x9e, 0xba, 0x47, 0xc6, 0x21, 0x93,
+	0x0b, 0xc9, 0x80, 0x79, 0x11, 0x77, 0x4f, 0x82, 0xd0, 0x27, 0x13, 0x79, 0x2d, 0xb9, 0x9d, 0x56,
+	0x2f, 0x7f, 0x15, 0xc0, 0x93, 0x85, 0xe0, 0xd2, 0x1b, 0xa0, 0xb4, 0xec, 0x86, 0xb9, 0xdd, 0xde,
+	0xef, 0x98, 0x56, 0xdb, 0x69, 0x36, 0x3a, 0xbb, 0x0d, 0xb7, 0x65, 0x5b, 0xce, 0x57, 0x8e, 0x5d,
+	0x2b, 0x66, 0x94, 0x8f, 0x67, 0x73, 0x4d, 0x5e, 0x40, 0x76, 0x43, 0x3a, 0xc2, 0x5e, 0x70, 0x10,
+	0x60, 0x5f, 0xfa, 0x1c, 0x3c, 0x5f, 0xa2, 0x6b, 0x36, 0xb4, 0xeb, 0x8e, 0xdb, 0xb6, 0x61, 0x51,
+	0x50, 0xd6, 0x67, 0x73, 0xed, 0xa3, 0x05, 0xb8, 0x86, 0x23, 0xdc, 0x0b, 0x28, 0xc3, 0x91, 0xa4,
+	0x83, 0x0f, 0x96, 0xd8, 0x2d, 0xd3, 0xd9, 0x2e, 0x8a, 0xca, 0xb3, 0xd9, 0x5c, 0x7b, 0x7f, 0x81,
+	0xda, 0x42, 0xc1, 0x40, 0x79, 0xe7, 0xbb, 0x1f, 0xd5, 0xcc, 0x4f, 0x27, 0xaa, 0xf0, 0xf2, 0x44,
+	0x04, 0x4f, 0x16, 0x5e, 0xc0, 0xdd, 0x14, 0xd0, 0x36, 0xdd, 0x7f, 0x4d, 0xc1, 0x91, 0xbb, 0x29,
+	0xbe, 0x00, 0xeb, 0x4b, 0xf4, 0x8e, 0xe3, 0xba, 0x76, 0xad, 0xb3, 0xd7, 0x6c, 0xdb, 0x6e, 0x51,
+	0x58, 0x81, 0xef, 0x04, 0x94, 0x62, 0x7f, 0x8f, 0x30, 0x4c, 0x25, 0x0b, 0xa8, 0x4b, 0xb8, 0xd3,
+	0xb0, 0x9a, 0x10, 0xda, 0x56, 0x3b, 0x55, 0x10, 0x95, 0xd2, 0x6c, 0xae, 0xad, 0x2f, 0x28, 0x38,
+	0xa1, 0x47, 0xa2, 0x08, 0x7b, 0x8c, 0x8b, 0xd4, 0x81, 0xb6, 0xda, 0x83, 0xeb, 0xd4, 0x1b, 0x66,
+	0x7b, 0x17, 0xda, 0x6e, 0x31, 0xab, 0xbc, 0x98, 0xcd, 0xb5, 0x4f, 0x56, 0x18, 0x71, 0x83, 0x5e,
+	0x88, 0xd8, 0x38, 0xc2, 0xf4, 0x76, 0x4c, 0xd5, 0xaf, 0x4f, 0xff, 0x54, 0x33, 0xa7, 0x17, 0xaa,
+	0x70, 0x76, 0xa1, 0x0a, 0x7f, 0x5c, 0xa8, 0xc2, 0x0f, 0x97, 0x6a, 0xe6, 0xec, 0x52, 0xcd, 0xfc,
+	0x76, 0xa9, 0x66, 0xbe, 0x79, 0x7d, 0xcf, 0x83, 0x99, 0xfe, 0xc6, 0x92, 0x4f, 0xae, 0x5b, 0x48,
+	0x7e, 0x39, 0xaf, 0xff, 0x1e, 0x00, 0x25, 0x8e, 0x56, 0x4d, 0xe4, 0x06, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -282,6 +462,13 @@ func (m *Refund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Fees) > 0 {
 		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -306,6 +493,108 @@ func (m *Refund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RefundBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Penalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Penalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Penalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Action != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -371,6 +660,61 @@ func (m *Refund) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.Used != 0 {
+		n += 1 + sovTypes(uint64(m.Used))
+	}
+	return n
+}
+
+func (m *Penalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTypes(uint64(m.Action))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	if m.Window != 0 {
+		n += 1 + sovTypes(uint64(m.Window))
+	}
 	return n
 }
 
@@ -711,6 +1055,373 @@ func (m *Refund) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Penalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Penalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Penalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= PenaltyReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = github_com_axelarnetwork_axelar_core_x_nexus_exported.ChainName(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -74,6 +74,12 @@ func (m Snapshot) ValidateBasic() error {
 		return errors.New("snapshot cannot have sum of participants weight greater than bonded weight")
 	}
 
+	if m.WeightingStrategy.Type != WeightingUnspecified {
+		if err := m.WeightingStrategy.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
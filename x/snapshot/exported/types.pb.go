@@ -6,6 +6,7 @@ package exported
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	utils "github.com/axelarnetwork/axelar-core/utils"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type WeightingType int32
+
+const (
+	WeightingUnspecified WeightingType = 0
+	WeightingQuadratic   WeightingType = 1
+	WeightingCapped      WeightingType = 2
+	WeightingEqual       WeightingType = 3
+)
+
+var WeightingType_name = map[int32]string{
+	0: "WEIGHTING_TYPE_UNSPECIFIED",
+	1: "WEIGHTING_TYPE_QUADRATIC",
+	2: "WEIGHTING_TYPE_CAPPED",
+	3: "WEIGHTING_TYPE_EQUAL",
+}
+
+var WeightingType_value = map[string]int32{
+	"WEIGHTING_TYPE_UNSPECIFIED": 0,
+	"WEIGHTING_TYPE_QUADRATIC":   1,
+	"WEIGHTING_TYPE_CAPPED":      2,
+	"WEIGHTING_TYPE_EQUAL":       3,
+}
+
+func (x WeightingType) String() string {
+	return proto.EnumName(WeightingType_name, int32(x))
+}
+
+func (WeightingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eabe75f8f44c6f51, []int{0}
+}
+
 type Participant struct {
 	Address github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"address,omitempty"`
 	Weight  cosmossdk_io_math.Uint                        `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.Uint" json:"weight"`
@@ -73,6 +105,9 @@ type Snapshot struct {
 	Height       int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 	Participants map[string]Participant `protobuf:"bytes,8,rep,name=participants,proto3" json:"participants" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	BondedWeight cosmossdk_io_math.Uint `protobuf:"bytes,9,opt,name=bonded_weight,json=bondedWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"bonded_weight"`
+	// strategy used to weigh the participants, unspecified if the snapshot was
+	// created with a custom weight function
+	WeightingStrategy WeightingStrategy `protobuf:"bytes,10,opt,name=weighting_strategy,json=weightingStrategy,proto3" json:"weighting_strategy"`
 }
 
 func (m *Snapshot) Reset()         { *m = Snapshot{} }
@@ -104,10 +139,57 @@ func (m *Snapshot) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 
+// WeightingStrategy determines how the consensus power of a validator is
+// translated into its weight in a snapshot
+type WeightingStrategy struct {
+	Type WeightingType `protobuf:"varint,1,opt,name=type,proto3,enum=axelar.snapshot.exported.v1beta1.WeightingType" json:"type,omitempty"`
+	// max share of the total bonded consensus power a single validator's weight
+	// can reach, only used by WEIGHTING_TYPE_CAPPED
+	Cap utils.Threshold `protobuf:"bytes,2,opt,name=cap,proto3" json:"cap"`
+	// min consensus power a validator needs to be given any weight, only used
+	// by WEIGHTING_TYPE_EQUAL
+	MinConsensusPower uint64 `protobuf:"varint,3,opt,name=min_consensus_power,json=minConsensusPower,proto3" json:"min_consensus_power,omitempty"`
+}
+
+func (m *WeightingStrategy) Reset()         { *m = WeightingStrategy{} }
+func (m *WeightingStrategy) String() string { return proto.CompactTextString(m) }
+func (*WeightingStrategy) ProtoMessage()    {}
+func (*WeightingStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eabe75f8f44c6f51, []int{2}
+}
+func (m *WeightingStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightingStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightingStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightingStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightingStrategy.Merge(m, src)
+}
+func (m *WeightingStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightingStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightingStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightingStrategy proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("axelar.snapshot.exported.v1beta1.WeightingType", WeightingType_name, WeightingType_value)
 	proto.RegisterType((*Participant)(nil), "axelar.snapshot.exported.v1beta1.Participant")
 	proto.RegisterType((*Snapshot)(nil), "axelar.snapshot.exported.v1beta1.Snapshot")
 	proto.RegisterMapType((map[string]Participant)(nil), "axelar.snapshot.exported.v1beta1.Snapshot.ParticipantsEntry")
+	proto.RegisterType((*WeightingStrategy)(nil), "axelar.snapshot.exported.v1beta1.WeightingStrategy")
 }
 
 func init() {
@@ -115,38 +197,55 @@ func init() {
 }
 
 var fileDescriptor_eabe75f8f44c6f51 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0xc5, 0x8e, 0xe3, 0x5c, 0x82, 0x64, 0x2c, 0x54, 0x45, 0x19, 0xec, 0xa8, 0x53, 0x06,
-	0x72, 0xa7, 0x14, 0x09, 0x55, 0x15, 0x0b, 0xae, 0x58, 0xcc, 0x82, 0x0c, 0x14, 0x89, 0x05, 0x9d,
-	0xe3, 0xab, 0x6d, 0x25, 0xf6, 0x59, 0xbe, 0x4b, 0x9b, 0xfc, 0x8b, 0x8a, 0x89, 0x95, 0x89, 0xbf,
-	0x92, 0xb1, 0x23, 0x62, 0x08, 0x90, 0xfc, 0x0b, 0x26, 0x14, 0x9f, 0x4d, 0x83, 0x3a, 0xa4, 0x93,
-	0xef, 0x93, 0xde, 0x7b, 0xf7, 0xde, 0xfb, 0x7c, 0xf0, 0x29, 0x59, 0xd0, 0x19, 0x29, 0x30, 0xcf,
-	0x48, 0xce, 0x63, 0x26, 0x30, 0x5d, 0xe4, 0xac, 0x10, 0x34, 0xc4, 0x57, 0xe3, 0x80, 0x0a, 0x32,
-	0xc6, 0x62, 0x99, 0x53, 0x8e, 0xf2, 0x82, 0x09, 0x66, 0x0d, 0x24, 0x1a, 0xd5, 0x68, 0x54, 0xa3,
-	0x51, 0x85, 0xee, 0x3f, 0x89, 0x58, 0xc4, 0x4a, 0x30, 0xde, 0x9d, 0x24, 0xaf, 0xef, 0x44, 0x8c,
-	0x45, 0x33, 0x8a, 0xcb, 0x29, 0x98, 0x5f, 0x62, 0x91, 0xa4, 0x94, 0x0b, 0x92, 0xe6, 0x12, 0x70,
-	0xfc, 0x19, 0xc0, 0xce, 0x1b, 0x52, 0x88, 0x64, 0x92, 0xe4, 0x24, 0x13, 0xd6, 0x6b, 0xd8, 0x22,
-	0x61, 0x58, 0x50, 0xce, 0x7b, 0x60, 0x00, 0x86, 0x5d, 0x77, 0xfc, 0x67, 0xed, 0x8c, 0xa2, 0x44,
-	0xc4, 0xf3, 0x00, 0x4d, 0x58, 0x8a, 0x27, 0x8c, 0xa7, 0x8c, 0x57, 0x9f, 0x11, 0x0f, 0xa7, 0x95,
-	0xcf, 0x0b, 0x32, 0x7b, 0x29, 0x89, 0x7e, 0xad, 0x60, 0x3d, 0x87, 0xfa, 0x35, 0x4d, 0xa2, 0x58,
-	0xf4, 0x1a, 0xa5, 0x96, 0xbd, 0x5a, 0x3b, 0xca, 0x8f, 0xb5, 0x73, 0x24, 0xd9, 0x3c, 0x9c, 0xa2,
-	0x84, 0xe1, 0x94, 0x88, 0x18, 0xbd, 0x4f, 0x32, 0xe1, 0x57, 0xe8, 0xe3, 0x6f, 0x2a, 0x34, 0xde,
-	0x56, 0x49, 0x2d, 0x17, 0xb6, 0xff, 0x99, 0x2e, 0x75, 0x3a, 0x27, 0x7d, 0x24, 0x63, 0xa1, 0x3a,
-	0x16, 0x7a, 0x57, 0x23, 0x5c, 0x63, 0x77, 0xc7, 0xcd, 0x4f, 0x07, 0xf8, 0x77, 0x34, 0xeb, 0x08,
-	0xea, 0xb1, 0x34, 0xa2, 0x0e, 0xc0, 0x50, 0xf5, 0xab, 0xc9, 0xba, 0x84, 0xdd, 0xfc, 0x2e, 0x3c,
-	0xef, 0x19, 0x03, 0x75, 0xd8, 0x39, 0x79, 0x81, 0x0e, 0xb5, 0x8d, 0x6a, 0x77, 0x68, 0xaf, 0x3b,
-	0xfe, 0x2a, 0x13, 0xc5, 0xd2, 0xd5, 0x76, 0x06, 0xfc, 0xff, 0x74, 0xad, 0x73, 0xf8, 0x28, 0x60,
-	0x59, 0x48, 0xc3, 0x4f, 0x55, 0x1f, 0xed, 0x07, 0xf5, 0xd1, 0x95, 0xa4, 0x0f, 0x25, 0xa7, 0x9f,
-	0xc1, 0xc7, 0xf7, 0x6e, 0xb3, 0x4c, 0xa8, 0x4e, 0xe9, 0xb2, 0xdc, 0x55, 0xdb, 0xdf, 0x1d, 0xad,
-	0x73, 0xd8, 0xbc, 0x22, 0xb3, 0x39, 0xad, 0xba, 0x1a, 0x1d, 0x0e, 0xb3, 0xa7, 0xea, 0x4b, 0xee,
-	0x59, 0xe3, 0x14, 0x9c, 0x69, 0x5f, 0xbe, 0x3a, 0xc0, 0xd3, 0x0c, 0x60, 0x36, 0x3c, 0xcd, 0xd0,
-	0xcc, 0xa6, 0xa7, 0x19, 0x4d, 0x53, 0xf7, 0x34, 0x43, 0x37, 0x5b, 0x9e, 0x66, 0xb4, 0x4c, 0xc3,
-	0xbd, 0x58, 0xfd, 0xb6, 0x95, 0xd5, 0xc6, 0x06, 0xb7, 0x1b, 0x1b, 0xfc, 0xda, 0xd8, 0xe0, 0x66,
-	0x6b, 0x2b, 0xb7, 0x5b, 0x5b, 0xf9, 0xbe, 0xb5, 0x95, 0x8f, 0xa7, 0x7b, 0xff, 0x8d, 0x74, 0x91,
-	0x51, 0x71, 0xcd, 0x8a, 0x69, 0x35, 0x8d, 0x26, 0xac, 0xa0, 0x78, 0x71, 0xff, 0x0d, 0x04, 0x7a,
-	0xb9, 0xd9, 0x67, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x57, 0xe7, 0xae, 0x5c, 0x26, 0x03, 0x00,
-	0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x4f, 0xfb, 0x46,
+	0x18, 0xc7, 0xe3, 0xc4, 0x04, 0x73, 0xfc, 0xa9, 0x73, 0x50, 0x14, 0x79, 0x70, 0x2c, 0xd4, 0x01,
+	0x55, 0xc4, 0x16, 0x50, 0xb5, 0x08, 0x75, 0x49, 0x8c, 0x4b, 0x93, 0x56, 0x28, 0x98, 0x04, 0xd4,
+	0x2e, 0xd1, 0xc5, 0x3e, 0x6c, 0x2b, 0x89, 0xcf, 0xf5, 0x5d, 0x08, 0x79, 0x07, 0x55, 0x26, 0xda,
+	0xa9, 0x4b, 0x86, 0xaa, 0x1d, 0xfa, 0x36, 0xba, 0x31, 0x32, 0x56, 0x1d, 0x68, 0x0b, 0x43, 0xdf,
+	0x43, 0xa7, 0x2a, 0xfe, 0x13, 0x20, 0x0c, 0xfc, 0x7e, 0x93, 0x7d, 0xba, 0xef, 0xe7, 0xf1, 0xf7,
+	0xf9, 0xfa, 0xb9, 0x03, 0x3b, 0xe8, 0x1a, 0xf7, 0x50, 0xa8, 0x51, 0x1f, 0x05, 0xd4, 0x25, 0x4c,
+	0xc3, 0xd7, 0x01, 0x09, 0x19, 0xb6, 0xb5, 0xab, 0xdd, 0x0e, 0x66, 0x68, 0x57, 0x63, 0xa3, 0x00,
+	0x53, 0x35, 0x08, 0x09, 0x23, 0x50, 0x89, 0xd5, 0x6a, 0xaa, 0x56, 0x53, 0xb5, 0x9a, 0xa8, 0xa5,
+	0x0d, 0x87, 0x38, 0x24, 0x12, 0x6b, 0xd3, 0xb7, 0x98, 0x93, 0x4a, 0x0e, 0x21, 0x4e, 0x0f, 0x6b,
+	0xd1, 0xaa, 0x33, 0xb8, 0xd4, 0x98, 0xd7, 0xc7, 0x94, 0xa1, 0x7e, 0x90, 0x08, 0x3e, 0x4a, 0x6c,
+	0x0c, 0x98, 0xd7, 0xa3, 0x4f, 0x9f, 0x76, 0x43, 0x4c, 0x5d, 0xd2, 0xb3, 0x63, 0xd5, 0xd6, 0x8f,
+	0x1c, 0x58, 0x6e, 0xa0, 0x90, 0x79, 0x96, 0x17, 0x20, 0x9f, 0xc1, 0xaf, 0xc0, 0x22, 0xb2, 0xed,
+	0x10, 0x53, 0x5a, 0xe4, 0x14, 0x6e, 0x7b, 0xa5, 0xba, 0xfb, 0xdf, 0x7d, 0xa9, 0xec, 0x78, 0xcc,
+	0x1d, 0x74, 0x54, 0x8b, 0xf4, 0x35, 0x8b, 0xd0, 0x3e, 0xa1, 0xc9, 0xa3, 0x4c, 0xed, 0x6e, 0xd2,
+	0xcd, 0x39, 0xea, 0x55, 0x62, 0xd0, 0x4c, 0x2b, 0xc0, 0x4f, 0x41, 0x7e, 0x88, 0x3d, 0xc7, 0x65,
+	0xc5, 0x6c, 0x54, 0x4b, 0xbe, 0xbd, 0x2f, 0x65, 0xfe, 0xbc, 0x2f, 0x6d, 0xc6, 0x34, 0xb5, 0xbb,
+	0xaa, 0x47, 0xb4, 0x3e, 0x62, 0xae, 0xda, 0xf2, 0x7c, 0x66, 0x26, 0xea, 0xad, 0x1f, 0x78, 0x20,
+	0x9c, 0x25, 0x79, 0xc0, 0x2a, 0x58, 0x9a, 0xb5, 0x16, 0xd5, 0x59, 0xde, 0x93, 0xd4, 0xb8, 0x79,
+	0x35, 0x6d, 0x5e, 0x6d, 0xa6, 0x8a, 0xaa, 0x30, 0xfd, 0xc6, 0xcd, 0x5f, 0x25, 0xce, 0x7c, 0xc2,
+	0xe0, 0x26, 0xc8, 0xbb, 0xb1, 0x91, 0x9c, 0xc2, 0x6d, 0xe7, 0xcc, 0x64, 0x05, 0x2f, 0xc1, 0x4a,
+	0xf0, 0xd4, 0x3c, 0x2d, 0x0a, 0x4a, 0x6e, 0x7b, 0x79, 0xef, 0x73, 0xf5, 0xad, 0x7f, 0xa2, 0xa6,
+	0xee, 0xd4, 0x67, 0xd9, 0x51, 0xc3, 0x67, 0xe1, 0xa8, 0xca, 0x4f, 0x0d, 0x98, 0x2f, 0xea, 0x42,
+	0x1d, 0xac, 0x76, 0x88, 0x6f, 0x63, 0xbb, 0x9d, 0xe4, 0xb1, 0xf4, 0x4e, 0x79, 0xac, 0xc4, 0xd0,
+	0x45, 0x6c, 0xd6, 0x05, 0x30, 0xa6, 0x3d, 0xdf, 0x69, 0x53, 0x16, 0x22, 0x86, 0x9d, 0x51, 0x11,
+	0x44, 0x89, 0xec, 0xbf, 0x6d, 0xf9, 0x22, 0x65, 0xcf, 0x12, 0x34, 0x71, 0x5a, 0x18, 0xce, 0x6f,
+	0x48, 0x3e, 0x28, 0xbc, 0xea, 0x0b, 0x8a, 0x20, 0xd7, 0xc5, 0xa3, 0x68, 0x2a, 0x96, 0xcc, 0xe9,
+	0x2b, 0xd4, 0xc1, 0xc2, 0x15, 0xea, 0x0d, 0x70, 0xf2, 0x57, 0xca, 0x6f, 0x7b, 0x78, 0x56, 0xd5,
+	0x8c, 0xd9, 0xc3, 0xec, 0x01, 0x77, 0xc8, 0xff, 0xf4, 0x73, 0x89, 0xab, 0xf3, 0x02, 0x27, 0x66,
+	0xeb, 0xbc, 0xc0, 0x8b, 0x0b, 0x75, 0x5e, 0x58, 0x10, 0xf3, 0x75, 0x5e, 0xc8, 0x8b, 0x8b, 0x75,
+	0x5e, 0x58, 0x14, 0x85, 0xad, 0xdf, 0x39, 0x50, 0x78, 0xd5, 0x02, 0xd4, 0x01, 0x3f, 0x1d, 0xbf,
+	0xc8, 0xd5, 0xda, 0x9e, 0xf6, 0x1e, 0x29, 0x34, 0x47, 0x01, 0x36, 0x23, 0x18, 0x7e, 0x06, 0x72,
+	0x16, 0x4a, 0x67, 0xab, 0x94, 0xd6, 0x88, 0xce, 0xcd, 0x8c, 0x6b, 0xa6, 0xe7, 0x26, 0x49, 0x6d,
+	0x4a, 0x40, 0x15, 0xac, 0xf7, 0x3d, 0xbf, 0x6d, 0x11, 0x9f, 0x62, 0x9f, 0x0e, 0x68, 0x3b, 0x20,
+	0x43, 0x1c, 0x46, 0x33, 0xc6, 0x9b, 0x85, 0xbe, 0xe7, 0xeb, 0xe9, 0x4e, 0x63, 0xba, 0xf1, 0xf1,
+	0xbf, 0x1c, 0x58, 0x7d, 0x61, 0x00, 0x1e, 0x00, 0xe9, 0xc2, 0xa8, 0x1d, 0x7f, 0xd9, 0xac, 0x9d,
+	0x1c, 0xb7, 0x9b, 0xdf, 0x34, 0x8c, 0x76, 0xeb, 0xe4, 0xac, 0x61, 0xe8, 0xb5, 0x2f, 0x6a, 0xc6,
+	0x91, 0x98, 0x91, 0x8a, 0xe3, 0x89, 0xb2, 0x31, 0x43, 0x5a, 0x3e, 0x0d, 0xb0, 0xe5, 0x5d, 0x7a,
+	0xd8, 0x86, 0x9f, 0x80, 0xe2, 0x1c, 0x79, 0xda, 0xaa, 0x1c, 0x99, 0x95, 0x66, 0x4d, 0x17, 0x39,
+	0x69, 0x73, 0x3c, 0x51, 0xe0, 0x8c, 0x3b, 0x1d, 0x20, 0x3b, 0x44, 0xcc, 0xb3, 0xa0, 0x0a, 0x3e,
+	0x9c, 0xa3, 0xf4, 0x4a, 0xa3, 0x61, 0x1c, 0x89, 0x59, 0x69, 0x7d, 0x3c, 0x51, 0x3e, 0x98, 0x21,
+	0x3a, 0x0a, 0x02, 0x6c, 0xc3, 0x1d, 0xb0, 0x31, 0xa7, 0x37, 0x4e, 0x5b, 0x95, 0xaf, 0xc5, 0x9c,
+	0x04, 0xc7, 0x13, 0x65, 0x6d, 0x26, 0x37, 0xbe, 0x1b, 0xa0, 0x9e, 0x24, 0x7c, 0xff, 0x8b, 0x9c,
+	0xf9, 0xed, 0x57, 0x99, 0xab, 0x9e, 0xdf, 0xfe, 0x23, 0x67, 0x6e, 0x1f, 0x64, 0xee, 0xee, 0x41,
+	0xe6, 0xfe, 0x7e, 0x90, 0xb9, 0x9b, 0x47, 0x39, 0x73, 0xf7, 0x28, 0x67, 0xfe, 0x78, 0x94, 0x33,
+	0xdf, 0x1e, 0x3c, 0xbb, 0x4f, 0xe2, 0xb4, 0x7d, 0xcc, 0x86, 0x24, 0xec, 0x26, 0xab, 0xb2, 0x45,
+	0x42, 0xac, 0x5d, 0xbf, 0xbe, 0x41, 0x3b, 0xf9, 0xe8, 0xc4, 0xef, 0xff, 0x3f, 0x00, 0x75, 0x1e,
+	0xfb, 0x9c, 0x64, 0x05, 0x00, 0x00,
 }
 
 func (m *Participant) Marshal() (dAtA []byte, err error) {
@@ -209,6 +308,16 @@ func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.WeightingStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.BondedWeight.Size()
 		i -= size
@@ -253,14 +362,57 @@ func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightingStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightingStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightingStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinConsensusPower != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinConsensusPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i -= n2
-	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -312,6 +464,25 @@ func (m *Snapshot) Size() (n int) {
 	}
 	l = m.BondedWeight.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = m.WeightingStrategy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *WeightingStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MinConsensusPower != 0 {
+		n += 1 + sovTypes(uint64(m.MinConsensusPower))
+	}
 	return n
 }
 
@@ -681,6 +852,160 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightingStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightingStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightingStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightingStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= WeightingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusPower", wireType)
+			}
+			m.MinConsensusPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
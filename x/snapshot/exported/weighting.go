@@ -0,0 +1,66 @@
+package exported
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+)
+
+// NewQuadraticWeighting returns a strategy that weighs validators by the square root of their consensus power
+func NewQuadraticWeighting() WeightingStrategy {
+	return WeightingStrategy{Type: WeightingQuadratic}
+}
+
+// NewCappedWeighting returns a strategy that weighs validators by their consensus power,
+// but no validator's weight can exceed the given share of the total bonded consensus power
+func NewCappedWeighting(cap utils.Threshold) WeightingStrategy {
+	return WeightingStrategy{Type: WeightingCapped, Cap: cap}
+}
+
+// NewEqualWeighting returns a strategy that gives every validator with at least the given consensus power the same weight
+func NewEqualWeighting(minConsensusPower uint64) WeightingStrategy {
+	return WeightingStrategy{Type: WeightingEqual, MinConsensusPower: minConsensusPower}
+}
+
+// WeightFunc returns the function translating a validator's consensus power into its weight,
+// given the total consensus power of all bonded validators
+func (m WeightingStrategy) WeightFunc(totalConsensusPower math.Uint) func(consensusPower math.Uint) math.Uint {
+	switch m.Type {
+	case WeightingCapped:
+		maxWeight := totalConsensusPower.
+			MulUint64(uint64(m.Cap.Numerator)).
+			QuoUint64(uint64(m.Cap.Denominator))
+
+		return func(consensusPower math.Uint) math.Uint {
+			return math.MinUint(consensusPower, maxWeight)
+		}
+	case WeightingEqual:
+		return func(consensusPower math.Uint) math.Uint {
+			if consensusPower.IsZero() || consensusPower.LT(math.NewUint(m.MinConsensusPower)) {
+				return math.ZeroUint()
+			}
+
+			return math.OneUint()
+		}
+	default:
+		return QuadraticWeightFunc
+	}
+}
+
+// ValidateBasic returns an error if the given weighting strategy is invalid; nil otherwise
+func (m WeightingStrategy) ValidateBasic() error {
+	switch m.Type {
+	case WeightingQuadratic, WeightingEqual:
+		return nil
+	case WeightingCapped:
+		if err := m.Cap.Validate(); err != nil {
+			return fmt.Errorf("invalid weighting cap: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid weighting type %s", m.Type)
+	}
+}
@@ -0,0 +1,57 @@
+package exported_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/axelar-core/utils"
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+)
+
+func TestWeightingStrategy_WeightFunc(t *testing.T) {
+	total := math.NewUint(1000)
+
+	t.Run("quadratic", func(t *testing.T) {
+		weightFunc := exported.NewQuadraticWeighting().WeightFunc(total)
+
+		assert.Equal(t, math.NewUint(5), weightFunc(math.NewUint(25)))
+		assert.Equal(t, math.NewUint(16), weightFunc(math.NewUint(256)))
+		assert.Equal(t, math.NewUint(9), weightFunc(math.NewUint(99)))
+	})
+
+	t.Run("unspecified falls back to quadratic", func(t *testing.T) {
+		weightFunc := exported.WeightingStrategy{}.WeightFunc(total)
+
+		assert.Equal(t, math.NewUint(6), weightFunc(math.NewUint(36)))
+	})
+
+	t.Run("capped", func(t *testing.T) {
+		weightFunc := exported.NewCappedWeighting(utils.NewThreshold(1, 10)).WeightFunc(total)
+
+		assert.Equal(t, math.NewUint(99), weightFunc(math.NewUint(99)))
+		assert.Equal(t, math.NewUint(100), weightFunc(math.NewUint(100)))
+		assert.Equal(t, math.NewUint(100), weightFunc(math.NewUint(500)))
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		weightFunc := exported.NewEqualWeighting(10).WeightFunc(total)
+
+		assert.Equal(t, math.ZeroUint(), weightFunc(math.NewUint(9)))
+		assert.Equal(t, math.OneUint(), weightFunc(math.NewUint(10)))
+		assert.Equal(t, math.OneUint(), weightFunc(math.NewUint(500)))
+		assert.Equal(t, math.ZeroUint(), exported.NewEqualWeighting(0).WeightFunc(total)(math.ZeroUint()))
+	})
+}
+
+func TestWeightingStrategy_ValidateBasic(t *testing.T) {
+	assert.NoError(t, exported.NewQuadraticWeighting().ValidateBasic())
+	assert.NoError(t, exported.NewEqualWeighting(0).ValidateBasic())
+	assert.NoError(t, exported.NewCappedWeighting(utils.NewThreshold(1, 3)).ValidateBasic())
+
+	assert.Error(t, exported.WeightingStrategy{}.ValidateBasic())
+	assert.Error(t, exported.WeightingStrategy{Type: 10}.ValidateBasic())
+	assert.Error(t, exported.NewCappedWeighting(utils.ZeroThreshold).ValidateBasic())
+	assert.Error(t, exported.NewCappedWeighting(utils.NewThreshold(3, 2)).ValidateBasic())
+}
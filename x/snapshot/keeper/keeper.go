@@ -68,6 +68,13 @@ func (k Keeper) GetMinProxyBalance(ctx sdk.Context) math.Int {
 	return math.NewInt(minBalance)
 }
 
+// GetWeightingStrategy returns the strategy used to weigh validators in snapshots
+func (k Keeper) GetWeightingStrategy(ctx sdk.Context) exported.WeightingStrategy {
+	var strategy exported.WeightingStrategy
+	k.params.Get(ctx, types.KeyWeightingStrategy, &strategy)
+	return strategy
+}
+
 // ActivateProxy registers a proxy address for a given operator, which can broadcast messages in the principal's name
 // The proxy will be marked as active and to be included in the next snapshot by default
 func (k Keeper) ActivateProxy(ctx sdk.Context, operator sdk.ValAddress, proxy sdk.AccAddress) error {
@@ -252,3 +259,50 @@ func (k Keeper) CreateSnapshot(
 
 	return snapshot, nil
 }
+
+// CreateWeightedSnapshot returns a new snapshot weighing each candidate according to the weighting strategy
+// set in the module's parameters. The strategy is recorded in the snapshot so its weights can be reproduced
+func (k Keeper) CreateWeightedSnapshot(
+	ctx sdk.Context,
+	candidates []sdk.ValAddress,
+	filterFunc func(exported.ValidatorI) bool,
+	threshold utils.Threshold,
+) (exported.Snapshot, error) {
+	strategy := k.GetWeightingStrategy(ctx)
+
+	totalConsensusPower, err := k.getBondedConsensusPower(ctx)
+	if err != nil {
+		return exported.Snapshot{}, err
+	}
+
+	snapshot, err := k.CreateSnapshot(ctx, candidates, filterFunc, strategy.WeightFunc(totalConsensusPower), threshold)
+	if err != nil {
+		return exported.Snapshot{}, err
+	}
+
+	snapshot.WeightingStrategy = strategy
+	if err := snapshot.ValidateBasic(); err != nil {
+		return exported.Snapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+func (k Keeper) getBondedConsensusPower(ctx sdk.Context) (math.Uint, error) {
+	powerReduction := k.staking.PowerReduction(ctx)
+
+	total := math.ZeroUint()
+	err := k.staking.IterateBondedValidatorsByPower(ctx, func(_ int64, v stakingtypes.ValidatorI) (stop bool) {
+		if v == nil {
+			panic("nil bonded validator received")
+		}
+
+		total = total.AddUint64(uint64(v.GetConsensusPower(powerReduction)))
+		return false
+	})
+	if err != nil {
+		return math.ZeroUint(), err
+	}
+
+	return total, nil
+}
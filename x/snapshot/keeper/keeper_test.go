@@ -397,6 +397,66 @@ func TestKeeper(t *testing.T) {
 			}).
 			Run(t)
 
+		givenKeeper.
+			When2(whenAllParamsAreGood).
+			When("the default weighting strategy is used", func() {}).
+			Then("should create a quadratically weighted snapshot recording the strategy", func(t *testing.T) {
+				actual, err := k.CreateWeightedSnapshot(ctx, candidates, filterFunc, threshold)
+
+				assert.NoError(t, err)
+				assert.Equal(t, exported.NewQuadraticWeighting(), actual.WeightingStrategy)
+				for addr, v := range validatorMap {
+					power := math.NewUint(uint64(v.GetConsensusPower(math.OneInt())))
+					assert.Equal(t, exported.QuadraticWeightFunc(power), actual.Participants[addr].Weight)
+				}
+			}).
+			Run(t)
+
+		givenKeeper.
+			When2(whenAllParamsAreGood).
+			When("the weighting strategy caps validator weights", func() {
+				params := types.DefaultParams()
+				params.WeightingStrategy = exported.NewCappedWeighting(utils.NewThreshold(1, 100))
+				k.SetParams(ctx, params)
+			}).
+			Then("should create a snapshot with no weight above the cap", func(t *testing.T) {
+				actual, err := k.CreateWeightedSnapshot(ctx, candidates, filterFunc, threshold)
+
+				assert.NoError(t, err)
+				assert.Equal(t, exported.NewCappedWeighting(utils.NewThreshold(1, 100)), actual.WeightingStrategy)
+
+				// total consensus power is 5050, so no validator can have a weight above 50
+				expectedBondedWeight := math.ZeroUint()
+				for addr, v := range validatorMap {
+					weight := math.MinUint(math.NewUint(uint64(v.GetConsensusPower(math.OneInt()))), math.NewUint(50))
+					assert.Equal(t, weight, actual.Participants[addr].Weight)
+
+					expectedBondedWeight = expectedBondedWeight.Add(weight)
+				}
+				assert.Equal(t, expectedBondedWeight, actual.BondedWeight)
+			}).
+			Run(t)
+
+		givenKeeper.
+			When2(whenAllParamsAreGood).
+			When("the weighting strategy weighs validators above a min stake equally", func() {
+				params := types.DefaultParams()
+				params.WeightingStrategy = exported.NewEqualWeighting(91)
+				k.SetParams(ctx, params)
+				threshold = utils.ZeroThreshold
+			}).
+			Then("should create a snapshot with equal weights", func(t *testing.T) {
+				actual, err := k.CreateWeightedSnapshot(ctx, candidates, filterFunc, threshold)
+
+				assert.NoError(t, err)
+				assert.Equal(t, exported.NewEqualWeighting(91), actual.WeightingStrategy)
+				assert.Len(t, actual.Participants, 10)
+				assert.True(t, slices.All(maps.Values(actual.Participants), func(p exported.Participant) bool {
+					return p.Weight.Equal(math.OneUint())
+				}))
+				assert.Equal(t, math.NewUint(10), actual.BondedWeight)
+			}).
+			Run(t)
 	})
 }
 
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/types"
+)
+
+// Migrate1to2 returns the handler that performs in-place store migrations
+func Migrate1to2(k Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		addWeightingStrategyParam(ctx, k)
+		return nil
+	}
+}
+
+func addWeightingStrategyParam(ctx sdk.Context, k Keeper) {
+	k.params.Set(ctx, types.KeyWeightingStrategy, types.DefaultParams().WeightingStrategy)
+}
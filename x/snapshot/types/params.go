@@ -6,11 +6,16 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 )
 
 var (
 	// KeyMinProxyBalance is the key for the minimum proxy balance
 	KeyMinProxyBalance = []byte("minproxybalance")
+
+	// KeyWeightingStrategy is the key for the strategy used to weigh validators in snapshots
+	KeyWeightingStrategy = []byte("weightingstrategy")
 )
 
 // KeyTable retrieves a subspace table for the module
@@ -21,7 +26,8 @@ func KeyTable() params.KeyTable {
 // DefaultParams - the module's default parameters
 func DefaultParams() Params {
 	return Params{
-		MinProxyBalance: 5000000,
+		MinProxyBalance:   5000000,
+		WeightingStrategy: exported.NewQuadraticWeighting(),
 	}
 }
 
@@ -36,6 +42,7 @@ func (m *Params) ParamSetPairs() params.ParamSetPairs {
 	*/
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMinProxyBalance, &m.MinProxyBalance, validateProxyBalance),
+		params.NewParamSetPair(KeyWeightingStrategy, &m.WeightingStrategy, validateWeightingStrategy),
 	}
 }
 
@@ -50,11 +57,24 @@ func validateProxyBalance(balance interface{}) error {
 	return nil
 }
 
+func validateWeightingStrategy(strategy interface{}) error {
+	value, ok := strategy.(exported.WeightingStrategy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for weighting strategy: %T", strategy)
+	}
+
+	return value.ValidateBasic()
+}
+
 // Validate performs a validation check on the parameters
 func (m Params) Validate() error {
 	if err := validateProxyBalance(m.MinProxyBalance); err != nil {
 		return err
 	}
 
+	if err := validateWeightingStrategy(m.WeightingStrategy); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,7 +26,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params represent the genesis parameters for the module
 type Params struct {
-	MinProxyBalance int64 `protobuf:"varint,1,opt,name=min_proxy_balance,json=minProxyBalance,proto3" json:"min_proxy_balance,omitempty"`
+	MinProxyBalance   int64                      `protobuf:"varint,1,opt,name=min_proxy_balance,json=minProxyBalance,proto3" json:"min_proxy_balance,omitempty"`
+	WeightingStrategy exported.WeightingStrategy `protobuf:"bytes,2,opt,name=weighting_strategy,json=weightingStrategy,proto3" json:"weighting_strategy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,20 +72,25 @@ func init() {
 }
 
 var fileDescriptor_4a91a6f8263ca1ea = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
+	// 276 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xac, 0x48, 0xcd,
 	0x49, 0x2c, 0xd2, 0x2f, 0xce, 0x4b, 0x2c, 0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0x12, 0x87, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0x95, 0x4c, 0xb8, 0xd8, 0x02, 0xc0, 0xda, 0x85, 0xb4,
-	0xb8, 0x04, 0x73, 0x33, 0xf3, 0xe2, 0x0b, 0x8a, 0xf2, 0x2b, 0x2a, 0xe3, 0x93, 0x12, 0x73, 0x12,
-	0xf3, 0x92, 0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83, 0xf8, 0x73, 0x33, 0xf3, 0x02, 0x40,
-	0xe2, 0x4e, 0x10, 0x61, 0xa7, 0xe0, 0x13, 0x0f, 0xe5, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x1f, 0xe2, 0x9a, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x28, 0x4f, 0x37, 0x39, 0xbf, 0x28,
-	0x55, 0xbf, 0x02, 0xe1, 0x91, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x8b, 0x8c, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0x70, 0x50, 0xe6, 0xe8, 0x00, 0x00, 0x00,
+	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x74, 0xd0, 0x0d, 0x4d, 0xad, 0x28, 0xc8, 0x2f,
+	0x2a, 0x49, 0x4d, 0x81, 0x9b, 0x5e, 0x52, 0x59, 0x90, 0x0a, 0x35, 0x5c, 0x69, 0x1e, 0x23, 0x17,
+	0x5b, 0x00, 0xd8, 0x36, 0x21, 0x2d, 0x2e, 0xc1, 0xdc, 0xcc, 0xbc, 0xf8, 0x82, 0xa2, 0xfc, 0x8a,
+	0xca, 0xf8, 0xa4, 0xc4, 0x9c, 0xc4, 0xbc, 0xe4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xe6, 0x20,
+	0xfe, 0xdc, 0xcc, 0xbc, 0x00, 0x90, 0xb8, 0x13, 0x44, 0x58, 0x28, 0x83, 0x4b, 0xa8, 0x3c, 0x35,
+	0x33, 0x3d, 0xa3, 0x24, 0x33, 0x2f, 0x3d, 0xbe, 0xb8, 0xa4, 0x28, 0xb1, 0x24, 0x35, 0xbd, 0x52,
+	0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0xc8, 0x58, 0x0f, 0xdd, 0xc1, 0x30, 0x17, 0xc0, 0x5c, 0xae,
+	0x17, 0x0e, 0xd3, 0x1b, 0x0c, 0xd5, 0xea, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90, 0x60, 0x39,
+	0x86, 0x44, 0xf0, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
+	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
+	0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb1, 0x35,
+	0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x1b, 0xca, 0xd3, 0x4d, 0xce, 0x2f, 0x4a, 0xd5, 0xaf, 0x40,
+	0x04, 0x06, 0xd8, 0xef, 0x49, 0x6c, 0x60, 0xcf, 0x1b, 0x03, 0x06, 0x00, 0x58, 0xc7, 0xf9, 0x77,
+	0x81, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -106,6 +113,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.WeightingStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.MinProxyBalance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinProxyBalance))
 		i--
@@ -134,6 +151,8 @@ func (m *Params) Size() (n int) {
 	if m.MinProxyBalance != 0 {
 		n += 1 + sovParams(uint64(m.MinProxyBalance))
 	}
+	l = m.WeightingStrategy.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -191,6 +210,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightingStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
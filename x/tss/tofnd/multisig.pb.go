@@ -22,9 +22,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type Algorithm int32
+
+const (
+	Algorithm_ALGORITHM_ECDSA   Algorithm = 0
+	Algorithm_ALGORITHM_ED25519 Algorithm = 1
+	Algorithm_ALGORITHM_SCHNORR Algorithm = 2
+)
+
+var Algorithm_name = map[int32]string{
+	0: "ALGORITHM_ECDSA",
+	1: "ALGORITHM_ED25519",
+	2: "ALGORITHM_SCHNORR",
+}
+
+var Algorithm_value = map[string]int32{
+	"ALGORITHM_ECDSA":   0,
+	"ALGORITHM_ED25519": 1,
+	"ALGORITHM_SCHNORR": 2,
+}
+
+func (x Algorithm) String() string {
+	return proto.EnumName(Algorithm_name, int32(x))
+}
+
+func (Algorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4478b3adebfdcdf0, []int{0}
+}
+
 type KeygenRequest struct {
-	KeyUid   string `protobuf:"bytes,1,opt,name=key_uid,json=keyUid,proto3" json:"key_uid,omitempty"`
-	PartyUid string `protobuf:"bytes,2,opt,name=party_uid,json=partyUid,proto3" json:"party_uid,omitempty"`
+	KeyUid    string    `protobuf:"bytes,1,opt,name=key_uid,json=keyUid,proto3" json:"key_uid,omitempty"`
+	PartyUid  string    `protobuf:"bytes,2,opt,name=party_uid,json=partyUid,proto3" json:"party_uid,omitempty"`
+	Algorithm Algorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=axelar.tss.tofnd.v1beta1.Algorithm" json:"algorithm,omitempty"`
 }
 
 func (m *KeygenRequest) Reset()         { *m = KeygenRequest{} }
@@ -74,9 +103,15 @@ func (m *KeygenRequest) GetPartyUid() string {
 	return ""
 }
 
+func (m *KeygenRequest) GetAlgorithm() Algorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return Algorithm_ALGORITHM_ECDSA
+}
+
 type KeygenResponse struct {
 	// Types that are valid to be assigned to KeygenResponse:
-	//
 	//	*KeygenResponse_PubKey
 	//	*KeygenResponse_Error
 	KeygenResponse isKeygenResponse_KeygenResponse `protobuf_oneof:"keygen_response"`
@@ -165,6 +200,8 @@ type SignRequest struct {
 	MsgToSign []byte `protobuf:"bytes,2,opt,name=msg_to_sign,json=msgToSign,proto3" json:"msg_to_sign,omitempty"`
 	PartyUid  string `protobuf:"bytes,3,opt,name=party_uid,json=partyUid,proto3" json:"party_uid,omitempty"`
 	PubKey    []byte `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// mnemonic. Latest is used, if empty.
+	Algorithm Algorithm `protobuf:"varint,5,opt,name=algorithm,proto3,enum=axelar.tss.tofnd.v1beta1.Algorithm" json:"algorithm,omitempty"`
 }
 
 func (m *SignRequest) Reset()         { *m = SignRequest{} }
@@ -228,9 +265,15 @@ func (m *SignRequest) GetPubKey() []byte {
 	return nil
 }
 
+func (m *SignRequest) GetAlgorithm() Algorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return Algorithm_ALGORITHM_ECDSA
+}
+
 type SignResponse struct {
 	// Types that are valid to be assigned to SignResponse:
-	//
 	//	*SignResponse_Signature
 	//	*SignResponse_Error
 	SignResponse isSignResponse_SignResponse `protobuf_oneof:"sign_response"`
@@ -315,6 +358,7 @@ func (*SignResponse) XXX_OneofWrappers() []interface{} {
 }
 
 func init() {
+	proto.RegisterEnum("axelar.tss.tofnd.v1beta1.Algorithm", Algorithm_name, Algorithm_value)
 	proto.RegisterType((*KeygenRequest)(nil), "axelar.tss.tofnd.v1beta1.KeygenRequest")
 	proto.RegisterType((*KeygenResponse)(nil), "axelar.tss.tofnd.v1beta1.KeygenResponse")
 	proto.RegisterType((*SignRequest)(nil), "axelar.tss.tofnd.v1beta1.SignRequest")
@@ -326,29 +370,34 @@ func init() {
 }
 
 var fileDescriptor_4478b3adebfdcdf0 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0xef, 0xd3, 0xd6, 0x4c, 0x5b, 0x8b, 0x59, 0x68, 0x45, 0x18, 0x24, 0x1b, 0xdd,
-	0x98, 0x58, 0x7c, 0x83, 0x82, 0x50, 0xec, 0x2e, 0x5a, 0x05, 0x37, 0x21, 0x69, 0xae, 0xe3, 0x90,
-	0x26, 0x13, 0xe7, 0x8f, 0x36, 0xe0, 0x43, 0xf8, 0x58, 0x2e, 0xbb, 0x74, 0x29, 0xed, 0x8b, 0x48,
-	0x26, 0x69, 0x4b, 0x17, 0xe2, 0x72, 0xee, 0x3d, 0xf7, 0x37, 0xe7, 0x70, 0xd0, 0x59, 0x38, 0x83,
-	0x69, 0xc8, 0x3d, 0x29, 0x84, 0x27, 0xd9, 0x53, 0x16, 0x7b, 0xaf, 0xfd, 0x08, 0x64, 0xd8, 0xf7,
-	0x52, 0x35, 0x95, 0x54, 0x50, 0xe2, 0xe6, 0x9c, 0x49, 0x66, 0xf7, 0x2a, 0xa1, 0x2b, 0x85, 0x70,
-	0xb5, 0xd0, 0xad, 0x85, 0xce, 0x35, 0xea, 0x8c, 0xa0, 0x20, 0x90, 0xf9, 0xf0, 0xa2, 0x40, 0x48,
-	0xfb, 0x08, 0x35, 0x13, 0x28, 0x02, 0x45, 0xe3, 0x9e, 0x79, 0x6a, 0x9e, 0x5b, 0x7e, 0x23, 0x81,
-	0x62, 0x4c, 0x63, 0xfb, 0x04, 0x59, 0x79, 0xc8, 0x65, 0xb5, 0xfa, 0xa7, 0x57, 0x7b, 0x7a, 0x30,
-	0xa6, 0xb1, 0x73, 0x8f, 0xf6, 0x57, 0x18, 0x91, 0xb3, 0x4c, 0x80, 0x7d, 0x8c, 0x9a, 0xb9, 0x8a,
-	0x82, 0x04, 0x0a, 0xcd, 0x69, 0x0f, 0x0d, 0xbf, 0x91, 0xab, 0x68, 0x04, 0x85, 0x7d, 0x88, 0x76,
-	0x81, 0x73, 0xc6, 0x2b, 0xca, 0xd0, 0xf0, 0xab, 0xe7, 0xe0, 0x00, 0x75, 0x13, 0x0d, 0x09, 0x78,
-	0x4d, 0x71, 0xde, 0x51, 0xeb, 0x96, 0x92, 0xbf, 0xcd, 0x61, 0xd4, 0x4a, 0x05, 0x09, 0x24, 0x0b,
-	0x04, 0x25, 0x99, 0x06, 0xb7, 0x7d, 0x2b, 0x15, 0xe4, 0x8e, 0x95, 0xf7, 0xdb, 0xe6, 0xff, 0x6f,
-	0x9b, 0x2f, 0xa9, 0x2b, 0xab, 0x3b, 0xfa, 0xb0, 0x36, 0xea, 0x3c, 0xa0, 0x76, 0xf5, 0x7b, 0x9d,
-	0x09, 0x23, 0xab, 0xc4, 0x87, 0x52, 0x71, 0x58, 0xa7, 0xda, 0x8c, 0x7e, 0x0d, 0xd6, 0x45, 0x9d,
-	0x52, 0xb4, 0x8e, 0x35, 0xb8, 0xf9, 0x5c, 0x60, 0x73, 0xbe, 0xc0, 0xe6, 0xf7, 0x02, 0x9b, 0x1f,
-	0x4b, 0x6c, 0xcc, 0x97, 0xd8, 0xf8, 0x5a, 0x62, 0xe3, 0xf1, 0x92, 0x50, 0xf9, 0xac, 0x22, 0x77,
-	0xc2, 0x52, 0xaf, 0x2a, 0x2d, 0x03, 0xf9, 0xc6, 0x78, 0x52, 0xbf, 0x2e, 0x26, 0x8c, 0x83, 0x37,
-	0xdb, 0x54, 0x1e, 0x35, 0x74, 0xc5, 0x57, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xa4, 0xa9,
-	0xd1, 0x0d, 0x02, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0x8d, 0x75, 0xe4, 0xad, 0x5b, 0xbb, 0x20, 0x58, 0x11, 0x92, 0x35, 0x95, 0x03,
+	0x13, 0x12, 0x09, 0x1d, 0xda, 0x81, 0x63, 0xb7, 0x21, 0x0a, 0x83, 0x4d, 0x72, 0x07, 0x48, 0x5c,
+	0xa2, 0x64, 0x35, 0x9e, 0x95, 0x26, 0x0e, 0xb6, 0x03, 0xcb, 0x37, 0xe0, 0xc8, 0x37, 0xe2, 0xca,
+	0x71, 0x47, 0x8e, 0xa8, 0xfd, 0x22, 0x28, 0x4e, 0xda, 0x50, 0xa4, 0x0a, 0x69, 0x47, 0xff, 0xdf,
+	0xff, 0x3d, 0xff, 0xdf, 0x4f, 0x0f, 0x1e, 0x05, 0x57, 0x74, 0x1c, 0x48, 0x4f, 0x2b, 0xe5, 0x69,
+	0xf1, 0x29, 0x19, 0x79, 0x5f, 0x7a, 0x21, 0xd5, 0x41, 0xcf, 0x8b, 0xb3, 0xb1, 0xe6, 0x8a, 0x33,
+	0x37, 0x95, 0x42, 0x0b, 0xa7, 0x53, 0x1a, 0x5d, 0xad, 0x94, 0x6b, 0x8c, 0x6e, 0x65, 0xec, 0x7e,
+	0x43, 0xb0, 0x79, 0x42, 0x73, 0x46, 0x13, 0x42, 0x3f, 0x67, 0x54, 0x69, 0x67, 0x07, 0xd6, 0x23,
+	0x9a, 0xfb, 0x19, 0x1f, 0x75, 0xd0, 0x2e, 0xda, 0xb3, 0x49, 0x23, 0xa2, 0xf9, 0x3b, 0x3e, 0x72,
+	0x1e, 0x80, 0x9d, 0x06, 0x52, 0x97, 0xa5, 0x15, 0x53, 0xba, 0x6d, 0x84, 0xa2, 0xd8, 0x07, 0x3b,
+	0x18, 0x33, 0x21, 0xb9, 0xbe, 0x8c, 0x3b, 0xab, 0xbb, 0x68, 0x6f, 0x6b, 0xff, 0xa1, 0xbb, 0xec,
+	0x57, 0xb7, 0x3f, 0xb3, 0x92, 0xba, 0xab, 0xfb, 0x1e, 0xb6, 0x66, 0x49, 0x54, 0x2a, 0x12, 0x45,
+	0x9d, 0xfb, 0xb0, 0x9e, 0x66, 0xa1, 0x1f, 0xd1, 0xdc, 0x44, 0x69, 0x0e, 0x2c, 0xd2, 0x48, 0xb3,
+	0xf0, 0x84, 0xe6, 0xce, 0x3d, 0x58, 0xa3, 0x52, 0x0a, 0x59, 0x06, 0x19, 0x58, 0xa4, 0x7c, 0x1e,
+	0x6e, 0x43, 0x2b, 0x32, 0x43, 0x7c, 0x59, 0x4d, 0xe9, 0xfe, 0x40, 0xb0, 0x31, 0xe4, 0xec, 0xff,
+	0x0b, 0x62, 0xd8, 0x88, 0x15, 0xf3, 0xb5, 0xf0, 0x15, 0x67, 0x89, 0x99, 0xdc, 0x24, 0x76, 0xac,
+	0xd8, 0xb9, 0x28, 0xfa, 0x17, 0x01, 0xac, 0xfe, 0x03, 0x60, 0xa7, 0xce, 0x7a, 0xcb, 0x34, 0xce,
+	0x92, 0x2e, 0x90, 0x59, 0xbb, 0x11, 0x99, 0x0f, 0xd0, 0x2c, 0x17, 0xa8, 0xb8, 0x60, 0xb0, 0x8b,
+	0x84, 0x81, 0xce, 0x24, 0x9d, 0x93, 0xa9, 0xa5, 0xa5, 0x70, 0x5a, 0xb0, 0x59, 0x98, 0xe6, 0x68,
+	0x1e, 0x9f, 0x82, 0x3d, 0xff, 0xd0, 0xb9, 0x03, 0xad, 0xfe, 0x9b, 0x97, 0x67, 0xe4, 0xd5, 0xf9,
+	0xe0, 0xad, 0xff, 0xe2, 0xe8, 0x78, 0xd8, 0x6f, 0x5b, 0xce, 0x5d, 0xd8, 0xfe, 0x4b, 0x3c, 0xde,
+	0x3f, 0x38, 0xe8, 0x3d, 0x6f, 0xa3, 0x45, 0x79, 0x78, 0x34, 0x38, 0x3d, 0x23, 0xa4, 0xbd, 0x72,
+	0xf8, 0xfa, 0xe7, 0x04, 0xa3, 0xeb, 0x09, 0x46, 0xbf, 0x27, 0x18, 0x7d, 0x9f, 0x62, 0xeb, 0x7a,
+	0x8a, 0xad, 0x5f, 0x53, 0x6c, 0x7d, 0x7c, 0xca, 0xb8, 0xbe, 0xcc, 0x42, 0xf7, 0x42, 0xc4, 0x5e,
+	0xb9, 0x7c, 0x42, 0xf5, 0x57, 0x21, 0xa3, 0xea, 0xf5, 0xe4, 0x42, 0x48, 0xea, 0x5d, 0xd5, 0xa7,
+	0x1c, 0x36, 0xcc, 0xe9, 0x3e, 0xfb, 0x33, 0x00, 0x6e, 0x0c, 0xac, 0x3c, 0xe5, 0x02, 0x00, 0x00,
 }
 
 func (m *KeygenRequest) Marshal() (dAtA []byte, err error) {
@@ -371,6 +420,11 @@ func (m *KeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.PartyUid) > 0 {
 		i -= len(m.PartyUid)
 		copy(dAtA[i:], m.PartyUid)
@@ -470,6 +524,11 @@ func (m *SignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.PubKey) > 0 {
 		i -= len(m.PubKey)
 		copy(dAtA[i:], m.PubKey)
@@ -588,6 +647,9 @@ func (m *KeygenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMultisig(uint64(l))
 	}
+	if m.Algorithm != 0 {
+		n += 1 + sovMultisig(uint64(m.Algorithm))
+	}
 	return n
 }
 
@@ -647,6 +709,9 @@ func (m *SignRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMultisig(uint64(l))
 	}
+	if m.Algorithm != 0 {
+		n += 1 + sovMultisig(uint64(m.Algorithm))
+	}
 	return n
 }
 
@@ -784,6 +849,25 @@ func (m *KeygenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartyUid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= Algorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -1081,6 +1165,25 @@ func (m *SignRequest) Unmarshal(dAtA []byte) error {
 				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= Algorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
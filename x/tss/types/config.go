@@ -1,19 +1,46 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// signer backends vald can use to generate keys and sign
+const (
+	// TofndSignerBackend delegates key generation and signing to an external tofnd process
+	TofndSignerBackend = "tofnd"
+	// LocalSignerBackend derives keys in-process from an encrypted local mnemonic file
+	LocalSignerBackend = "local"
+)
 
 // TssConfig contains all configurations values for tss
 type TssConfig struct {
 	Host        string        `mapstructure:"tofnd-host"`
 	Port        string        `mapstructure:"tofnd-port"`
 	DialTimeout time.Duration `mapstructure:"tofnd-dial-timeout"`
+	// SignerBackend selects where keys are held, either "tofnd" or "local"
+	SignerBackend string `mapstructure:"signer-backend"`
+	// LocalSignerFile is the path of the encrypted mnemonic used by the local signer backend.
+	// If empty, it defaults to local_signer.json in the vald home directory.
+	LocalSignerFile string `mapstructure:"local-signer-file"`
 }
 
 // DefaultConfig returns the default tss configuration
 func DefaultConfig() TssConfig {
 	return TssConfig{
-		Host:        "localhost",
-		Port:        "50051",
-		DialTimeout: 15 * time.Second,
+		Host:          "localhost",
+		Port:          "50051",
+		DialTimeout:   15 * time.Second,
+		SignerBackend: TofndSignerBackend,
+	}
+}
+
+// ValidateSignerBackend returns an error if the configured signer backend is unknown
+func (c TssConfig) ValidateSignerBackend() error {
+	switch c.SignerBackend {
+	case TofndSignerBackend, LocalSignerBackend:
+		return nil
+	default:
+		return fmt.Errorf("unknown signer backend %s, must be one of %s|%s", c.SignerBackend, TofndSignerBackend, LocalSignerBackend)
 	}
 }
@@ -2,11 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 	"github.com/axelarnetwork/axelar-core/x/vote/types"
 )
 
@@ -22,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 
 	voteQueryCmd.AddCommand(
 		GetParams(),
+		GetPoll(),
+		GetPolls(),
+		GetPollVotes(),
 	)
 
 	return voteQueryCmd
@@ -54,3 +60,127 @@ func GetParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPoll returns the query for a poll that has not expired yet
+func GetPoll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poll [poll-id]",
+		Short: "Returns the poll with the given ID, including who has voted so far",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid poll ID %s", args[0])
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.Poll(cmd.Context(), &types.PollRequest{PollID: exported.PollID(pollID)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPolls returns the query for the polls that have not expired yet
+func GetPolls() *cobra.Command {
+	cmdName := "polls"
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "Returns the polls that have not expired yet matching the given filters",
+		Args:  cobra.ExactArgs(0),
+	}
+
+	module := cmd.Flags().String("module", "", "the module that started the polls")
+	state := cmd.Flags().String("state", "", "the poll state [pending|completed|failed]")
+	voter := cmd.Flags().String("voter", "", "the address of a validator that is eligible to vote in the polls")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		pageReq, err := client.ReadPageRequest(cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		// the Key field is read as []byte{""} if the key flag is not set, so need to reset it manually
+		if len(pageReq.Key) == 0 && pageReq.Offset > 0 {
+			pageReq.Key = nil
+		}
+
+		pollState := exported.NonExistent
+		if *state != "" {
+			s, ok := exported.PollState_value["POLL_STATE_"+strings.ToUpper(*state)]
+			if !ok || exported.PollState(s) == exported.NonExistent {
+				return fmt.Errorf("unrecognized poll state %s", *state)
+			}
+
+			pollState = exported.PollState(s)
+		}
+
+		queryClient := types.NewQueryServiceClient(clientCtx)
+
+		res, err := queryClient.Polls(cmd.Context(), &types.PollsRequest{
+			Module:     *module,
+			State:      pollState,
+			Voter:      *voter,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmdName)
+
+	return cmd
+}
+
+// GetPollVotes returns the query for the tallied votes of a poll that has not expired yet
+func GetPollVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poll-votes [poll-id]",
+		Short: "Returns the votes of the poll with the given ID, tallied by the voted data",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid poll ID %s", args[0])
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.PollVotes(cmd.Context(), &types.PollVotesRequest{PollID: exported.PollID(pollID)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,9 +3,14 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
 	"github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/utils/slices"
 )
 
 var _ types.QueryServiceServer = Querier{}
@@ -32,3 +37,59 @@ func (q Querier) Params(c context.Context, req *types.ParamsRequest) (*types.Par
 		Params: params,
 	}, nil
 }
+
+// Poll returns the poll with the given ID and its voting progress
+func (q Querier) Poll(c context.Context, req *types.PollRequest) (*types.PollResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata, ok := q.keeper.getPollMetadata(ctx, req.PollID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrVote, "poll %s not found", req.PollID.String()).Error())
+	}
+
+	return &types.PollResponse{Poll: q.keeper.getPollInfo(ctx, metadata)}, nil
+}
+
+// Polls returns the polls matching the given filters
+func (q Querier) Polls(c context.Context, req *types.PollsRequest) (*types.PollsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := exported.PollState_name[int32(req.State)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid poll state %d", req.State)
+	}
+
+	filter := PollFilter{
+		Module: req.Module,
+		State:  req.State,
+	}
+
+	if req.Voter != "" {
+		voter, err := sdk.ValAddressFromBech32(req.Voter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(err, "invalid voter").Error())
+		}
+
+		filter.Voter = voter
+	}
+
+	polls, pagination, err := q.keeper.GetPollsPaginated(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PollsResponse{
+		Polls:      slices.Map(polls, func(metadata exported.PollMetadata) types.PollInfo { return q.keeper.getPollInfo(ctx, metadata) }),
+		Pagination: pagination,
+	}, nil
+}
+
+// PollVotes returns the tallied votes of the poll with the given ID
+func (q Querier) PollVotes(c context.Context, req *types.PollVotesRequest) (*types.PollVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, ok := q.keeper.getPollMetadata(ctx, req.PollID); !ok {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrVote, "poll %s not found", req.PollID.String()).Error())
+	}
+
+	return &types.PollVotesResponse{Votes: q.keeper.getTalliedVotes(ctx, req.PollID)}, nil
+}
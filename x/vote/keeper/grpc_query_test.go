@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	store "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	appParams "github.com/axelarnetwork/axelar-core/app/params"
+	"github.com/axelarnetwork/axelar-core/testutils/fake"
+	"github.com/axelarnetwork/axelar-core/testutils/rand"
+	"github.com/axelarnetwork/axelar-core/utils"
+	evmtypes "github.com/axelarnetwork/axelar-core/x/evm/types"
+	snapshot "github.com/axelarnetwork/axelar-core/x/snapshot/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/keeper"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+	"github.com/axelarnetwork/axelar-core/x/vote/types/mock"
+	"github.com/axelarnetwork/utils/funcs"
+	. "github.com/axelarnetwork/utils/test"
+)
+
+func TestQuerier_Polls(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		k        keeper.Keeper
+		querier  keeper.Querier
+		voters   []sdk.ValAddress
+		outsider sdk.ValAddress
+		evmPolls []exported.PollID
+		otherIDs []exported.PollID
+	)
+
+	Given("polls of different modules", func() {
+		enc := appParams.MakeEncodingConfig()
+		types.RegisterInterfaces(enc.InterfaceRegistry)
+		enc.InterfaceRegistry.RegisterImplementations((*codec.ProtoMarshaler)(nil), &evmtypes.VoteEvents{})
+		subspace := paramstypes.NewSubspace(enc.Codec, enc.Amino, store.NewKVStoreKey("voteParams"), store.NewKVStoreKey("tvoteParams"), "vote")
+
+		k = keeper.NewKeeper(enc.Codec, store.NewKVStoreKey(types.StoreKey), subspace, &mock.SnapshotterMock{}, &mock.StakingKeeperMock{}, &mock.RewarderMock{})
+		ctx = sdk.NewContext(fake.NewMultiStore(), sdk.Context{}.BlockHeader(), false, log.NewTestLogger(t)).WithBlockHeight(100)
+		k.SetParams(ctx, types.DefaultParams())
+		querier = keeper.NewGRPCQuerier(k)
+
+		voters = []sdk.ValAddress{rand.ValAddr(), rand.ValAddr(), rand.ValAddr()}
+		outsider = rand.ValAddr()
+		participants := []snapshot.Participant{
+			snapshot.NewParticipant(voters[0], math.NewUint(100)),
+			snapshot.NewParticipant(voters[1], math.NewUint(100)),
+			snapshot.NewParticipant(voters[2], math.NewUint(100)),
+		}
+		newPoll := func(module string) exported.PollID {
+			return funcs.Must(k.InitializePoll(ctx, exported.NewPollBuilder(
+				module,
+				utils.NewThreshold(51, 100),
+				snapshot.NewSnapshot(time.Now(), 1, participants, math.NewUint(300)),
+				ctx.BlockHeight()+100,
+			)))
+		}
+
+		evmPolls, otherIDs = nil, nil
+		for i := 0; i < 5; i++ {
+			evmPolls = append(evmPolls, newPoll("evm"))
+			otherIDs = append(otherIDs, newPoll("other"))
+		}
+	}).
+		When("votes are cast", func() {
+			poll := funcs.MustOk(k.GetPoll(ctx, evmPolls[0]))
+			funcs.Must(poll.Vote(voters[0], ctx.BlockHeight(), &evmtypes.VoteEvents{Events: []evmtypes.Event{{}}}))
+
+			poll = funcs.MustOk(k.GetPoll(ctx, evmPolls[1]))
+			funcs.Must(poll.Vote(voters[0], ctx.BlockHeight(), &evmtypes.VoteEvents{Events: []evmtypes.Event{{}}}))
+			funcs.Must(poll.Vote(voters[1], ctx.BlockHeight(), &evmtypes.VoteEvents{Events: []evmtypes.Event{{}, {}}}))
+			funcs.Must(poll.Vote(voters[2], ctx.BlockHeight(), &evmtypes.VoteEvents{Events: []evmtypes.Event{{}, {}, {}}}))
+		}).
+		Branch(
+			Then("should return a poll with its voting progress", func(t *testing.T) {
+				res, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.PollRequest{PollID: evmPolls[0]})
+				assert.NoError(t, err)
+
+				assert.Equal(t, evmPolls[0], res.Poll.Metadata.ID)
+				assert.Equal(t, exported.Pending, res.Poll.Metadata.State)
+				assert.Equal(t, math.NewUint(100), res.Poll.TalliedWeight)
+				assert.Equal(t, math.NewUint(153), res.Poll.PassingWeight)
+				assert.Equal(t, []string{voters[0].String()}, res.Poll.Voted)
+				assert.ElementsMatch(t, []string{voters[1].String(), voters[2].String()}, res.Poll.NotVoted)
+				assert.Empty(t, res.Poll.FailureReason)
+			}),
+
+			Then("should explain why a poll failed", func(t *testing.T) {
+				res, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.PollRequest{PollID: evmPolls[1]})
+				assert.NoError(t, err)
+
+				assert.Equal(t, exported.Failed, res.Poll.Metadata.State)
+				assert.Len(t, res.Poll.Voted, 3)
+				assert.Empty(t, res.Poll.NotVoted)
+				assert.NotEmpty(t, res.Poll.FailureReason)
+			}),
+
+			Then("should return the tallied votes", func(t *testing.T) {
+				res, err := querier.PollVotes(sdk.WrapSDKContext(ctx), &types.PollVotesRequest{PollID: evmPolls[1]})
+				assert.NoError(t, err)
+
+				assert.Len(t, res.Votes, 3)
+				for _, vote := range res.Votes {
+					assert.Equal(t, math.NewUint(100), vote.Tally)
+					assert.Len(t, vote.IsVoterLate, 1)
+				}
+			}),
+
+			Then("should fail for unknown polls", func(t *testing.T) {
+				_, err := querier.Poll(sdk.WrapSDKContext(ctx), &types.PollRequest{PollID: exported.PollID(1000)})
+				assert.ErrorContains(t, err, "not found")
+
+				_, err = querier.PollVotes(sdk.WrapSDKContext(ctx), &types.PollVotesRequest{PollID: exported.PollID(1000)})
+				assert.ErrorContains(t, err, "not found")
+			}),
+
+			Then("should filter polls by module and state", func(t *testing.T) {
+				res, err := querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Module: "evm"})
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, evmPolls, pollIDs(res.Polls))
+
+				res, err = querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Module: "evm", State: exported.Failed})
+				assert.NoError(t, err)
+				assert.Equal(t, []exported.PollID{evmPolls[1]}, pollIDs(res.Polls))
+
+				res, err = querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{State: exported.Pending})
+				assert.NoError(t, err)
+				assert.Len(t, res.Polls, len(evmPolls)+len(otherIDs)-1)
+			}),
+
+			Then("should filter polls by voter", func(t *testing.T) {
+				res, err := querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Voter: voters[2].String()})
+				assert.NoError(t, err)
+				assert.Len(t, res.Polls, len(evmPolls)+len(otherIDs))
+
+				res, err = querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Voter: outsider.String()})
+				assert.NoError(t, err)
+				assert.Empty(t, res.Polls)
+
+				_, err = querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Voter: rand.Str(10)})
+				assert.Error(t, err)
+			}),
+
+			Then("should paginate polls", func(t *testing.T) {
+				var polls []types.PollInfo
+
+				pageRequest := &query.PageRequest{Limit: 3}
+				for {
+					res, err := querier.Polls(sdk.WrapSDKContext(ctx), &types.PollsRequest{Module: "other", Pagination: pageRequest})
+					assert.NoError(t, err)
+					assert.LessOrEqual(t, len(res.Polls), 3)
+
+					polls = append(polls, res.Polls...)
+					if len(res.Pagination.NextKey) == 0 {
+						break
+					}
+
+					pageRequest = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}
+				}
+
+				assert.ElementsMatch(t, otherIDs, pollIDs(polls))
+			}),
+		).
+		Run(t)
+}
+
+func pollIDs(polls []types.PollInfo) []exported.PollID {
+	var ids []exported.PollID
+	for _, poll := range polls {
+		ids = append(ids, poll.Metadata.ID)
+	}
+
+	return ids
+}
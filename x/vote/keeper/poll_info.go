@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/axelarnetwork/axelar-core/utils/key"
+	"github.com/axelarnetwork/axelar-core/x/vote/exported"
+	"github.com/axelarnetwork/axelar-core/x/vote/types"
+)
+
+// PollFilter selects polls by their properties. Empty fields match any poll.
+type PollFilter struct {
+	Module string
+	State  exported.PollState
+	Voter  sdk.ValAddress
+}
+
+// Matches returns true if the given poll satisfies all the filter's constraints
+func (f PollFilter) Matches(metadata exported.PollMetadata) bool {
+	return (f.Module == "" || metadata.Module == f.Module) &&
+		(f.State == exported.NonExistent || metadata.Is(f.State)) &&
+		(f.Voter.Empty() || !metadata.Snapshot.GetParticipantWeight(f.Voter).IsZero())
+}
+
+// GetPollsPaginated returns the polls that have not expired yet and match the given filter with the given pagination properties
+func (k Keeper) GetPollsPaginated(ctx sdk.Context, filter PollFilter, pageRequest *query.PageRequest) ([]exported.PollMetadata, *query.PageResponse, error) {
+	var polls []exported.PollMetadata
+
+	store := prefix.NewStore(k.getKVStore(ctx).KVStore, append(key.FromStr(pollPrefix).Bytes(), []byte(key.DefaultDelimiter)...))
+	resp, err := query.FilteredPaginate(store, pageRequest, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var metadata exported.PollMetadata
+		k.cdc.MustUnmarshalLengthPrefixed(value, &metadata)
+
+		if !filter.Matches(metadata) {
+			return false, nil
+		}
+
+		if accumulate {
+			polls = append(polls, metadata)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return polls, resp, nil
+}
+
+func (k Keeper) getPollInfo(ctx sdk.Context, metadata exported.PollMetadata) types.PollInfo {
+	poll := newPoll(ctx, k, metadata)
+
+	talliedWeight := math.ZeroUint()
+	for _, talliedVote := range poll.getCachedTalliedVotes() {
+		talliedWeight = talliedWeight.Add(talliedVote.Tally)
+	}
+
+	info := types.PollInfo{
+		Metadata:      metadata,
+		PassingWeight: poll.passingWeight.Value(),
+		TalliedWeight: talliedWeight,
+	}
+
+	for _, voter := range poll.GetVoters() {
+		if poll.HasVoted(voter) {
+			info.Voted = append(info.Voted, voter.String())
+		} else {
+			info.NotVoted = append(info.NotVoted, voter.String())
+		}
+	}
+
+	// polls fail as soon as no result can reach the passing weight anymore, expired polls are deleted right away
+	if metadata.Is(exported.Failed) {
+		info.FailureReason = "voters could not agree on a single result: no result can reach the passing weight with the remaining voting power"
+	}
+
+	return info
+}
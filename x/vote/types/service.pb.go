@@ -35,29 +35,35 @@ func init() {
 }
 
 var fileDescriptor_030f863ebca64631 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x4a, 0x2b, 0x41,
-	0x14, 0xc6, 0x33, 0xe1, 0x92, 0x62, 0x48, 0x73, 0xf7, 0x5e, 0x04, 0xa3, 0x8e, 0xc9, 0x06, 0x44,
-	0x03, 0xee, 0x90, 0x08, 0x16, 0xc1, 0xca, 0x5e, 0xfc, 0x87, 0x16, 0xe9, 0x26, 0xf1, 0xb0, 0x2e,
-	0x26, 0x7b, 0x36, 0x33, 0x93, 0x35, 0x69, 0x2d, 0x04, 0xb1, 0x11, 0x7c, 0x07, 0x9f, 0xc3, 0xd2,
-	0x32, 0x60, 0x63, 0x29, 0x59, 0x1f, 0x44, 0x76, 0x77, 0x02, 0x06, 0x86, 0x60, 0x37, 0x87, 0xef,
-	0xc7, 0xf9, 0x7e, 0x1c, 0x86, 0xd6, 0xc4, 0x18, 0xfa, 0x42, 0xf2, 0x18, 0x35, 0xf0, 0xb8, 0xd9,
-	0x05, 0x2d, 0x9a, 0x5c, 0x81, 0x8c, 0x83, 0x1e, 0x78, 0x91, 0x44, 0x8d, 0xce, 0xbf, 0x1c, 0xf1,
-	0x52, 0xc4, 0x33, 0x48, 0xe5, 0xbf, 0x8f, 0x3e, 0x66, 0x39, 0x4f, 0x5f, 0x39, 0x5a, 0x59, 0xf7,
-	0x11, 0xfd, 0x3e, 0x70, 0x11, 0x05, 0x5c, 0x84, 0x21, 0x6a, 0xa1, 0x03, 0x0c, 0xd5, 0x3c, 0xb5,
-	0x75, 0xe9, 0xb1, 0x49, 0x37, 0x6d, 0xe9, 0x70, 0x04, 0x72, 0x92, 0x03, 0xad, 0x97, 0x22, 0xa5,
-	0x47, 0xca, 0x3f, 0xcf, 0xe5, 0x9c, 0x07, 0x42, 0xff, 0x5c, 0xa2, 0x06, 0xa7, 0xea, 0x59, 0x04,
-	0xbd, 0x34, 0x3a, 0x83, 0xe1, 0x08, 0x94, 0xae, 0xd4, 0x96, 0x10, 0x2a, 0xc2, 0x50, 0x81, 0x7b,
-	0x70, 0xf7, 0xfe, 0xf5, 0x5c, 0xdc, 0x6f, 0x93, 0x46, 0x67, 0xa5, 0x4d, 0x1a, 0xee, 0x5f, 0xbe,
-	0xa0, 0x83, 0x1a, 0xdc, 0x55, 0x6e, 0x33, 0x4c, 0x07, 0xe7, 0x91, 0xd0, 0xf2, 0x45, 0x74, 0x25,
-	0x34, 0x9c, 0x08, 0x29, 0x06, 0xca, 0xd9, 0xb6, 0x36, 0xfe, 0x44, 0xe6, 0x6e, 0x3b, 0xbf, 0x20,
-	0x8d, 0xe3, 0x56, 0xe6, 0x58, 0x4d, 0xf5, 0xd6, 0xac, 0x2e, 0x51, 0xc6, 0xb7, 0xee, 0x09, 0x2d,
-	0x9f, 0xa6, 0x87, 0x9b, 0x9f, 0x2a, 0xa6, 0x25, 0xe3, 0xe5, 0x5a, 0xdb, 0x16, 0x8d, 0xea, 0x4b,
-	0x19, 0xe3, 0x52, 0xcf, 0x5c, 0x36, 0x9c, 0x65, 0x22, 0x87, 0xc7, 0x6f, 0x33, 0x46, 0xa6, 0x33,
-	0x46, 0x3e, 0x67, 0x8c, 0x3c, 0x25, 0xac, 0xf0, 0x9a, 0x30, 0x32, 0x4d, 0x58, 0xe1, 0x23, 0x61,
-	0x85, 0x4e, 0xd3, 0x0f, 0xf4, 0xf5, 0xa8, 0xeb, 0xf5, 0x70, 0x60, 0x96, 0x84, 0xa0, 0x6f, 0x51,
-	0xde, 0x98, 0x69, 0xb7, 0x87, 0x12, 0xf8, 0x38, 0xdf, 0xac, 0x27, 0x11, 0xa8, 0x6e, 0x29, 0xfb,
-	0x09, 0x7b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10, 0xb8, 0xee, 0xeb, 0xb6, 0x02, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x6e, 0xd4, 0x30,
+	0x18, 0xc7, 0xcf, 0xa5, 0x54, 0xc2, 0xea, 0x82, 0x41, 0x48, 0xa4, 0x25, 0x6d, 0x52, 0x51, 0xa0,
+	0x12, 0xb1, 0xae, 0x48, 0x0c, 0x15, 0x13, 0x3b, 0xa2, 0x80, 0x60, 0xe8, 0x82, 0x7c, 0xc7, 0xa7,
+	0x10, 0x91, 0xcb, 0x97, 0xb3, 0x7d, 0xe1, 0x6e, 0x85, 0x09, 0xb1, 0x20, 0xf1, 0x0e, 0x3c, 0x07,
+	0x23, 0xe3, 0x49, 0x2c, 0x8c, 0xe8, 0xc2, 0x03, 0xf0, 0x08, 0xc8, 0x8e, 0x83, 0x38, 0xc9, 0x09,
+	0xdd, 0xce, 0xf7, 0xff, 0xf9, 0xfb, 0xff, 0x62, 0x27, 0x34, 0x12, 0x73, 0xc8, 0x85, 0xe4, 0x15,
+	0x6a, 0xe0, 0xd5, 0x70, 0x04, 0x5a, 0x0c, 0xb9, 0x02, 0x59, 0x65, 0x63, 0x48, 0x4a, 0x89, 0x1a,
+	0xd9, 0x95, 0x06, 0x49, 0x0c, 0x92, 0x38, 0x24, 0xb8, 0x9a, 0x62, 0x8a, 0x36, 0xe7, 0xe6, 0x57,
+	0x83, 0x06, 0xbb, 0x29, 0x62, 0x9a, 0x03, 0x17, 0x65, 0xc6, 0x45, 0x51, 0xa0, 0x16, 0x3a, 0xc3,
+	0x42, 0xb5, 0xa9, 0xaf, 0x4b, 0xcf, 0x5d, 0xba, 0xe7, 0x4b, 0xa7, 0x33, 0x90, 0x8b, 0x06, 0x38,
+	0xfe, 0xb2, 0x41, 0xe9, 0x23, 0x95, 0x3e, 0x6b, 0xe4, 0xd8, 0x07, 0x42, 0x37, 0x5f, 0xa0, 0x06,
+	0xb6, 0x9f, 0x78, 0x04, 0x13, 0x13, 0x3d, 0x85, 0xe9, 0x0c, 0x94, 0x0e, 0xa2, 0x1e, 0x42, 0x95,
+	0x58, 0x28, 0x88, 0x1f, 0xbc, 0xfb, 0xfe, 0xeb, 0xf3, 0xc6, 0xfd, 0x13, 0x72, 0x74, 0x76, 0xed,
+	0x84, 0x1c, 0xc5, 0x97, 0xf9, 0x9a, 0x0e, 0x6a, 0x88, 0xaf, 0x73, 0x9f, 0xa1, 0x59, 0xb0, 0x8f,
+	0x84, 0x6e, 0x3f, 0x2f, 0x5f, 0x09, 0x0d, 0xa7, 0x42, 0x8a, 0x89, 0x62, 0xb7, 0xbd, 0x8d, 0xff,
+	0x22, 0xad, 0xdb, 0x9d, 0x73, 0x90, 0xce, 0xf1, 0xd0, 0x3a, 0xee, 0x1b, 0xbd, 0x1d, 0xaf, 0x4b,
+	0x69, 0xf9, 0xe3, 0xdf, 0x17, 0xe8, 0xf6, 0x13, 0x73, 0x70, 0xed, 0x51, 0x55, 0x74, 0xcb, 0x79,
+	0xc5, 0xde, 0xb6, 0x75, 0xa3, 0x83, 0x5e, 0xc6, 0xb9, 0x1c, 0x58, 0x97, 0x1b, 0xac, 0x4f, 0x84,
+	0x15, 0x74, 0xf3, 0x14, 0xf3, 0xbc, 0xe3, 0x86, 0x4c, 0xd4, 0x7f, 0x43, 0x0d, 0xe1, 0x1a, 0x23,
+	0xdb, 0xb8, 0xc3, 0xfc, 0xd7, 0x50, 0x9a, 0x1e, 0x49, 0x2f, 0x9a, 0x2d, 0x8a, 0x75, 0x8f, 0xfb,
+	0xfb, 0x94, 0x71, 0x1f, 0xe2, 0x2a, 0x63, 0x5b, 0xb9, 0xcb, 0x82, 0xce, 0x4a, 0xc5, 0xde, 0x13,
+	0x7a, 0xc9, 0xec, 0x32, 0x6f, 0x93, 0x62, 0x37, 0x3b, 0xa7, 0xda, 0xbc, 0x2d, 0x3f, 0xfc, 0x1f,
+	0xe6, 0x04, 0x6e, 0x59, 0x81, 0x88, 0xed, 0x75, 0x0a, 0xbc, 0x34, 0xff, 0xa8, 0x87, 0x8f, 0xbf,
+	0xad, 0x42, 0xb2, 0x5c, 0x85, 0xe4, 0xe7, 0x2a, 0x24, 0x9f, 0xea, 0x70, 0xf0, 0xb5, 0x0e, 0xc9,
+	0xb2, 0x0e, 0x07, 0x3f, 0xea, 0x70, 0x70, 0x36, 0x4c, 0x33, 0xfd, 0x7a, 0x36, 0x4a, 0xc6, 0x38,
+	0x71, 0x83, 0x0a, 0xd0, 0x6f, 0x51, 0xbe, 0x71, 0xab, 0xbb, 0x63, 0x94, 0xc0, 0xe7, 0xcd, 0x74,
+	0xbd, 0x28, 0x41, 0x8d, 0xb6, 0xec, 0x37, 0x77, 0xef, 0xcf, 0x00, 0x36, 0xba, 0x7d, 0x03, 0x20,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -181,6 +187,13 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Poll queries a poll that has not expired yet by its ID
+	Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error)
+	// Polls queries the polls that have not expired yet, optionally filtered by
+	// module, state and eligible voter
+	Polls(ctx context.Context, in *PollsRequest, opts ...grpc.CallOption) (*PollsResponse, error)
+	// PollVotes queries the tallied votes of a poll that has not expired yet
+	PollVotes(ctx context.Context, in *PollVotesRequest, opts ...grpc.CallOption) (*PollVotesResponse, error)
 }
 
 type queryServiceClient struct {
@@ -200,9 +213,43 @@ func (c *queryServiceClient) Params(ctx context.Context, in *ParamsRequest, opts
 	return out, nil
 }
 
+func (c *queryServiceClient) Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error) {
+	out := new(PollResponse)
+	err := c.cc.Invoke(ctx, "/axelar.vote.v1beta1.QueryService/Poll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Polls(ctx context.Context, in *PollsRequest, opts ...grpc.CallOption) (*PollsResponse, error) {
+	out := new(PollsResponse)
+	err := c.cc.Invoke(ctx, "/axelar.vote.v1beta1.QueryService/Polls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) PollVotes(ctx context.Context, in *PollVotesRequest, opts ...grpc.CallOption) (*PollVotesResponse, error) {
+	out := new(PollVotesResponse)
+	err := c.cc.Invoke(ctx, "/axelar.vote.v1beta1.QueryService/PollVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Poll queries a poll that has not expired yet by its ID
+	Poll(context.Context, *PollRequest) (*PollResponse, error)
+	// Polls queries the polls that have not expired yet, optionally filtered by
+	// module, state and eligible voter
+	Polls(context.Context, *PollsRequest) (*PollsResponse, error)
+	// PollVotes queries the tallied votes of a poll that has not expired yet
+	PollVotes(context.Context, *PollVotesRequest) (*PollVotesResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -212,6 +259,15 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServiceServer) Poll(ctx context.Context, req *PollRequest) (*PollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
+func (*UnimplementedQueryServiceServer) Polls(ctx context.Context, req *PollsRequest) (*PollsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Polls not implemented")
+}
+func (*UnimplementedQueryServiceServer) PollVotes(ctx context.Context, req *PollVotesRequest) (*PollVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollVotes not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -235,6 +291,60 @@ func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.vote.v1beta1.QueryService/Poll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Poll(ctx, req.(*PollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Polls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Polls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.vote.v1beta1.QueryService/Polls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Polls(ctx, req.(*PollsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_PollVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).PollVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/axelar.vote.v1beta1.QueryService/PollVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).PollVotes(ctx, req.(*PollVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "axelar.vote.v1beta1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -243,6 +353,18 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _QueryService_Params_Handler,
 		},
+		{
+			MethodName: "Poll",
+			Handler:    _QueryService_Poll_Handler,
+		},
+		{
+			MethodName: "Polls",
+			Handler:    _QueryService_Polls_Handler,
+		},
+		{
+			MethodName: "PollVotes",
+			Handler:    _QueryService_PollVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "axelar/vote/v1beta1/service.proto",
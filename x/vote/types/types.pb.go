@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	github_com_axelarnetwork_axelar_core_x_vote_exported "github.com/axelarnetwork/axelar-core/x/vote/exported"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -71,46 +72,101 @@ func (m *TalliedVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TalliedVote proto.InternalMessageInfo
 
+// PollInfo represents a poll together with its voting progress
+type PollInfo struct {
+	Metadata exported.PollMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	// passing_weight is the voting power a single result needs to complete the
+	// poll
+	PassingWeight cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=passing_weight,json=passingWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"passing_weight"`
+	// tallied_weight is the voting power of all votes cast so far
+	TalliedWeight cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=tallied_weight,json=talliedWeight,proto3,customtype=cosmossdk.io/math.Uint" json:"tallied_weight"`
+	Voted         []string               `protobuf:"bytes,4,rep,name=voted,proto3" json:"voted,omitempty"`
+	NotVoted      []string               `protobuf:"bytes,5,rep,name=not_voted,json=notVoted,proto3" json:"not_voted,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *PollInfo) Reset()         { *m = PollInfo{} }
+func (m *PollInfo) String() string { return proto.CompactTextString(m) }
+func (*PollInfo) ProtoMessage()    {}
+func (*PollInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584be12bf9f97fd2, []int{1}
+}
+func (m *PollInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PollInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PollInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollInfo.Merge(m, src)
+}
+func (m *PollInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollInfo proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TalliedVote)(nil), "axelar.vote.v1beta1.TalliedVote")
 	proto.RegisterMapType((map[string]bool)(nil), "axelar.vote.v1beta1.TalliedVote.IsVoterLateEntry")
+	proto.RegisterType((*PollInfo)(nil), "axelar.vote.v1beta1.PollInfo")
 }
 
 func init() { proto.RegisterFile("axelar/vote/v1beta1/types.proto", fileDescriptor_584be12bf9f97fd2) }
 
 var fileDescriptor_584be12bf9f97fd2 = []byte{
-	// 491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0x33, 0xf9, 0xf7, 0x7d, 0x4c, 0x8a, 0x14, 0x4c, 0x84, 0xdc, 0x2c, 0x6c, 0x8b, 0x05,
-	0xb2, 0x90, 0x32, 0x56, 0x5a, 0x16, 0xa8, 0x48, 0x48, 0x8d, 0xca, 0x22, 0x12, 0x15, 0x95, 0xd5,
-	0x76, 0xc1, 0x26, 0x4c, 0x32, 0xb7, 0x8e, 0x95, 0x89, 0xc7, 0x9a, 0x99, 0x84, 0x78, 0xc7, 0x23,
-	0xb0, 0x64, 0xcb, 0x3b, 0xf0, 0x10, 0x11, 0xab, 0x2e, 0x11, 0x8b, 0x08, 0x92, 0xb7, 0x60, 0x85,
-	0xec, 0x31, 0x50, 0xa1, 0x6e, 0x58, 0xcd, 0xb9, 0x33, 0x3f, 0x9f, 0xeb, 0x7b, 0x0f, 0x76, 0xe9,
-	0x0a, 0x38, 0x95, 0xc1, 0x52, 0x68, 0x08, 0x96, 0xfd, 0x31, 0x68, 0xda, 0x0f, 0x74, 0x96, 0x82,
-	0x22, 0xa9, 0x14, 0x5a, 0x58, 0xf7, 0x0d, 0x40, 0x72, 0x80, 0x94, 0x40, 0x77, 0x3f, 0x12, 0x22,
-	0xe2, 0x10, 0x14, 0xc8, 0x78, 0x71, 0x15, 0xd0, 0x24, 0x33, 0x7c, 0xb7, 0x13, 0x89, 0x48, 0x14,
-	0x32, 0xc8, 0x55, 0x79, 0xbb, 0x3f, 0x11, 0x6a, 0x2e, 0xd4, 0xc8, 0x3c, 0x98, 0xc2, 0x3c, 0x3d,
-	0x7c, 0x57, 0xc7, 0xad, 0x73, 0xca, 0x79, 0x0c, 0xec, 0x52, 0x68, 0xb0, 0x9e, 0xe0, 0x86, 0xa6,
-	0x9c, 0x67, 0x36, 0xf2, 0x90, 0xbf, 0x37, 0x70, 0xd6, 0x1b, 0xb7, 0xf2, 0x75, 0xe3, 0x3e, 0x30,
-	0x1f, 0x29, 0x36, 0x23, 0xb1, 0x08, 0xe6, 0x54, 0x4f, 0xc9, 0x45, 0x9c, 0xe8, 0xd0, 0xc0, 0xd6,
-	0x1b, 0x7c, 0x2f, 0xff, 0x43, 0xa9, 0x46, 0x0c, 0x52, 0x09, 0x13, 0xaa, 0x81, 0xd9, 0x55, 0xaf,
-	0xe6, 0xef, 0x0d, 0x0e, 0x7f, 0x6c, 0xdc, 0x5e, 0x14, 0xeb, 0xe9, 0x62, 0x4c, 0x26, 0x62, 0x5e,
-	0x76, 0x2f, 0x8f, 0x9e, 0x62, 0xb3, 0x72, 0xde, 0x4b, 0xca, 0x8f, 0x19, 0x93, 0xa0, 0x94, 0x8d,
-	0xc2, 0xb6, 0x71, 0x3b, 0xf9, 0x6d, 0x66, 0x9d, 0xe3, 0x3a, 0xa3, 0x9a, 0xda, 0x35, 0x0f, 0xf9,
-	0xad, 0x83, 0x0e, 0x31, 0x2b, 0x20, 0xbf, 0x56, 0x40, 0x8e, 0x93, 0x6c, 0xf0, 0xf8, 0xf3, 0xa7,
-	0xde, 0xa3, 0xdb, 0x5a, 0x31, 0x98, 0x04, 0x67, 0x39, 0x79, 0x4a, 0xa5, 0x9a, 0x52, 0x0e, 0x32,
-	0x2c, 0xdc, 0xac, 0x2b, 0xfc, 0x5f, 0x2a, 0x38, 0x1f, 0xc5, 0xcc, 0xae, 0x7b, 0xc8, 0xaf, 0x0f,
-	0x4e, 0xcb, 0x79, 0x9f, 0xdd, 0xb0, 0x31, 0x11, 0x24, 0xa0, 0xdf, 0x0a, 0x39, 0x2b, 0xab, 0xde,
-	0x44, 0x48, 0x08, 0x56, 0x26, 0x38, 0x58, 0xa5, 0x42, 0x6a, 0x60, 0xe4, 0x4c, 0x70, 0x3e, 0x3c,
-	0xd9, 0x6e, 0xdc, 0xa6, 0x51, 0x61, 0x33, 0x77, 0x1f, 0x32, 0xeb, 0x02, 0xdf, 0x8d, 0xd5, 0xa8,
-	0x18, 0x6a, 0xc4, 0xa9, 0x06, 0xbb, 0xe1, 0xd5, 0xfc, 0xd6, 0x41, 0x9f, 0xdc, 0x12, 0x2f, 0xb9,
-	0x11, 0x07, 0x19, 0xaa, 0xfc, 0x90, 0x2f, 0xa9, 0x86, 0x17, 0x89, 0x96, 0x59, 0xd8, 0x8a, 0xff,
-	0xdc, 0x74, 0x9f, 0xe3, 0xf6, 0xdf, 0x80, 0xd5, 0xc6, 0xb5, 0x19, 0x98, 0xf8, 0xee, 0x84, 0xb9,
-	0xb4, 0x3a, 0xb8, 0xb1, 0xa4, 0x7c, 0x01, 0x76, 0xd5, 0x43, 0xfe, 0xff, 0xa1, 0x29, 0x8e, 0xaa,
-	0x4f, 0xd1, 0x51, 0xfd, 0xc3, 0x47, 0x17, 0x0d, 0x5e, 0xad, 0xbf, 0x3b, 0x95, 0xf5, 0xd6, 0x41,
-	0xd7, 0x5b, 0x07, 0x7d, 0xdb, 0x3a, 0xe8, 0xfd, 0xce, 0xa9, 0x5c, 0xef, 0x9c, 0xca, 0x97, 0x9d,
-	0x53, 0x79, 0xdd, 0xff, 0x97, 0x4d, 0x14, 0x51, 0x8e, 0x9b, 0x45, 0x2a, 0x87, 0x3f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x3c, 0x61, 0x19, 0xde, 0x02, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x4b, 0x1b, 0x4f,
+	0x18, 0xce, 0xe6, 0xdf, 0x2f, 0x4e, 0x54, 0xfc, 0x6d, 0xa5, 0xac, 0x16, 0x36, 0x8b, 0xd0, 0xb2,
+	0x08, 0x99, 0x25, 0xda, 0x43, 0xb1, 0x50, 0x30, 0xe8, 0x41, 0x50, 0x2a, 0x8b, 0x5a, 0xe8, 0x65,
+	0x3b, 0xc9, 0xbe, 0xd9, 0x2c, 0x99, 0xec, 0x84, 0x99, 0x89, 0x9a, 0x5b, 0x3f, 0x42, 0x8f, 0xbd,
+	0xf6, 0x3b, 0xf4, 0x03, 0xf4, 0x28, 0x3d, 0x79, 0x2c, 0x3d, 0x84, 0x36, 0x7e, 0x8b, 0x9e, 0xca,
+	0xce, 0x8c, 0x56, 0x8b, 0x07, 0x7b, 0xda, 0xf7, 0xcf, 0xf3, 0x3e, 0xf3, 0xcc, 0x33, 0xef, 0xa2,
+	0x06, 0x39, 0x07, 0x4a, 0x78, 0x70, 0xca, 0x24, 0x04, 0xa7, 0xad, 0x0e, 0x48, 0xd2, 0x0a, 0xe4,
+	0x64, 0x04, 0x02, 0x8f, 0x38, 0x93, 0xcc, 0x7e, 0xa4, 0x01, 0x38, 0x07, 0x60, 0x03, 0x58, 0x5d,
+	0x49, 0x18, 0x4b, 0x28, 0x04, 0x0a, 0xd2, 0x19, 0xf7, 0x02, 0x92, 0x4d, 0x34, 0x7e, 0x75, 0x39,
+	0x61, 0x09, 0x53, 0x61, 0x90, 0x47, 0xa6, 0xba, 0xd2, 0x65, 0x62, 0xc8, 0x44, 0xa4, 0x1b, 0x3a,
+	0x31, 0x2d, 0xff, 0xb6, 0x02, 0x38, 0x1f, 0x31, 0x2e, 0x21, 0xbe, 0x4f, 0xca, 0xda, 0xfb, 0x32,
+	0xaa, 0x1f, 0x11, 0x4a, 0x53, 0x88, 0x4f, 0x98, 0x04, 0xfb, 0x39, 0xaa, 0x48, 0x42, 0xe9, 0xc4,
+	0xb1, 0x3c, 0xcb, 0x9f, 0x6f, 0xbb, 0x17, 0xd3, 0x46, 0xe1, 0xfb, 0xb4, 0xf1, 0x58, 0xd3, 0x8b,
+	0x78, 0x80, 0x53, 0x16, 0x0c, 0x89, 0xec, 0xe3, 0xe3, 0x34, 0x93, 0xa1, 0x06, 0xdb, 0xef, 0xd0,
+	0xff, 0xf9, 0x51, 0x5c, 0x44, 0x31, 0x8c, 0x38, 0x74, 0x89, 0x84, 0xd8, 0x29, 0x7a, 0x25, 0x7f,
+	0xbe, 0xbd, 0xf9, 0x6b, 0xda, 0x68, 0x26, 0xa9, 0xec, 0x8f, 0x3b, 0xb8, 0xcb, 0x86, 0x46, 0xa7,
+	0xf9, 0x34, 0x45, 0x3c, 0x30, 0x72, 0x4e, 0x08, 0xdd, 0x8e, 0x63, 0x0e, 0x42, 0x38, 0x56, 0xb8,
+	0xa4, 0xd9, 0x76, 0x6e, 0xc8, 0xec, 0x23, 0x54, 0x8e, 0x89, 0x24, 0x4e, 0xc9, 0xb3, 0xfc, 0xfa,
+	0xc6, 0x32, 0xd6, 0x66, 0xe1, 0x6b, 0xb3, 0xf0, 0x76, 0x36, 0x69, 0xaf, 0x7f, 0xfd, 0xdc, 0x7c,
+	0x76, 0xdf, 0x51, 0x31, 0x74, 0x83, 0xc3, 0x1c, 0x79, 0x40, 0xb8, 0xe8, 0x13, 0x0a, 0x3c, 0x54,
+	0x6c, 0x76, 0x0f, 0xfd, 0x37, 0x62, 0x94, 0x46, 0x69, 0xec, 0x94, 0x3d, 0xcb, 0x2f, 0xb7, 0x0f,
+	0xcc, 0x7d, 0x5f, 0xde, 0xa2, 0xd1, 0x5e, 0x66, 0x20, 0xcf, 0x18, 0x1f, 0x98, 0xac, 0xd9, 0x65,
+	0x1c, 0x82, 0xf3, 0xbb, 0x06, 0xe3, 0x43, 0x46, 0xe9, 0xde, 0xce, 0x6c, 0xda, 0xa8, 0xea, 0x28,
+	0xac, 0xe6, 0xec, 0x7b, 0xb1, 0x7d, 0x8c, 0x16, 0x52, 0x11, 0xa9, 0x4b, 0x45, 0x94, 0x48, 0x70,
+	0x2a, 0x5e, 0xc9, 0xaf, 0x6f, 0xb4, 0xf0, 0x3d, 0x8b, 0x80, 0x6f, 0x3d, 0x07, 0xde, 0x13, 0xf9,
+	0x87, 0xef, 0x13, 0x09, 0xbb, 0x99, 0xe4, 0x93, 0xb0, 0x9e, 0xfe, 0xa9, 0xac, 0xbe, 0x42, 0x4b,
+	0x7f, 0x03, 0xec, 0x25, 0x54, 0x1a, 0x80, 0x7e, 0xbe, 0xb9, 0x30, 0x0f, 0xed, 0x65, 0x54, 0x39,
+	0x25, 0x74, 0x0c, 0x4e, 0xd1, 0xb3, 0xfc, 0x5a, 0xa8, 0x93, 0xad, 0xe2, 0x0b, 0x6b, 0xab, 0xfc,
+	0xf1, 0x53, 0xc3, 0x5a, 0xfb, 0x52, 0x44, 0x35, 0xa5, 0x37, 0xeb, 0x31, 0x7b, 0x1f, 0xd5, 0x86,
+	0x20, 0x89, 0xf2, 0xda, 0x52, 0x5e, 0xaf, 0xdf, 0x11, 0x79, 0x73, 0xd7, 0x6b, 0xb5, 0xf9, 0xe4,
+	0x81, 0x99, 0x68, 0x97, 0x73, 0xfb, 0xc2, 0x1b, 0x06, 0x7b, 0x17, 0x2d, 0x8e, 0x88, 0x10, 0x69,
+	0x96, 0x44, 0x67, 0x90, 0x26, 0x7d, 0xe9, 0x14, 0x1f, 0xb4, 0x56, 0x0b, 0x66, 0xea, 0x8d, 0x1a,
+	0xca, 0x69, 0xa4, 0x36, 0xe5, 0x9a, 0xa6, 0xf4, 0x30, 0x1a, 0x33, 0x65, 0x68, 0x72, 0x23, 0x58,
+	0xbe, 0x99, 0x65, 0xaf, 0xe4, 0xcf, 0x85, 0x3a, 0xb1, 0x9f, 0xa0, 0xb9, 0x8c, 0xc9, 0x48, 0x77,
+	0x2a, 0xaa, 0x53, 0xcb, 0x98, 0x3c, 0x51, 0xcd, 0xa7, 0x68, 0xb1, 0x47, 0x52, 0x3a, 0xe6, 0x10,
+	0x71, 0x20, 0x82, 0x65, 0x4e, 0x55, 0x19, 0xbb, 0x60, 0xaa, 0xa1, 0x2a, 0xb6, 0x5f, 0x5f, 0xfc,
+	0x74, 0x0b, 0x17, 0x33, 0xd7, 0xba, 0x9c, 0xb9, 0xd6, 0x8f, 0x99, 0x6b, 0x7d, 0xb8, 0x72, 0x0b,
+	0x97, 0x57, 0x6e, 0xe1, 0xdb, 0x95, 0x5b, 0x78, 0xdb, 0xfa, 0x97, 0x65, 0x52, 0x7f, 0x43, 0xa7,
+	0xaa, 0x16, 0x7b, 0xf3, 0xf7, 0x00, 0xa5, 0x1d, 0x4c, 0x05, 0x4b, 0x04, 0x00, 0x00,
 }
 
 func (m *TalliedVote) Marshal() (dAtA []byte, err error) {
@@ -199,6 +255,84 @@ func (m *TalliedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PollInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NotVoted) > 0 {
+		for iNdEx := len(m.NotVoted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotVoted[iNdEx])
+			copy(dAtA[i:], m.NotVoted[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.NotVoted[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Voted) > 0 {
+		for iNdEx := len(m.Voted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voted[iNdEx])
+			copy(dAtA[i:], m.Voted[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Voted[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TalliedWeight.Size()
+		i -= size
+		if _, err := m.TalliedWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PassingWeight.Size()
+		i -= size
+		if _, err := m.PassingWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -242,6 +376,37 @@ func (m *TalliedVote) Size() (n int) {
 	return n
 }
 
+func (m *PollInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PassingWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TalliedWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Voted) > 0 {
+		for _, s := range m.Voted {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.NotVoted) > 0 {
+		for _, s := range m.NotVoted {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -533,6 +698,251 @@ func (m *TalliedVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PollInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassingWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PassingWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TalliedWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TalliedWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voted = append(m.Voted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotVoted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotVoted = append(m.NotVoted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0